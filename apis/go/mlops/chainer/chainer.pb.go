@@ -128,7 +128,163 @@ func (x PipelineStepUpdate_PipelineJoinType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PipelineStepUpdate_PipelineJoinType.Descriptor instead.
 func (PipelineStepUpdate_PipelineJoinType) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{2, 0}
+	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type PipelineTransform_TransformType int32
+
+const (
+	PipelineTransform_CONCAT   PipelineTransform_TransformType = 0
+	PipelineTransform_SPLIT    PipelineTransform_TransformType = 1
+	PipelineTransform_SELECT   PipelineTransform_TransformType = 2
+	PipelineTransform_CAST     PipelineTransform_TransformType = 3
+	PipelineTransform_RESHAPE  PipelineTransform_TransformType = 4
+	PipelineTransform_CONSTANT PipelineTransform_TransformType = 5
+	PipelineTransform_JSONPATH PipelineTransform_TransformType = 6
+)
+
+// Enum value maps for PipelineTransform_TransformType.
+var (
+	PipelineTransform_TransformType_name = map[int32]string{
+		0: "CONCAT",
+		1: "SPLIT",
+		2: "SELECT",
+		3: "CAST",
+		4: "RESHAPE",
+		5: "CONSTANT",
+		6: "JSONPATH",
+	}
+	PipelineTransform_TransformType_value = map[string]int32{
+		"CONCAT":   0,
+		"SPLIT":    1,
+		"SELECT":   2,
+		"CAST":     3,
+		"RESHAPE":  4,
+		"CONSTANT": 5,
+		"JSONPATH": 6,
+	}
+)
+
+func (x PipelineTransform_TransformType) Enum() *PipelineTransform_TransformType {
+	p := new(PipelineTransform_TransformType)
+	*p = x
+	return p
+}
+
+func (x PipelineTransform_TransformType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PipelineTransform_TransformType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mlops_chainer_chainer_proto_enumTypes[2].Descriptor()
+}
+
+func (PipelineTransform_TransformType) Type() protoreflect.EnumType {
+	return &file_mlops_chainer_chainer_proto_enumTypes[2]
+}
+
+func (x PipelineTransform_TransformType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PipelineTransform_TransformType.Descriptor instead.
+func (PipelineTransform_TransformType) EnumDescriptor() ([]byte, []int) {
+	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type KafkaAdapter_Format int32
+
+const (
+	KafkaAdapter_JSON  KafkaAdapter_Format = 0
+	KafkaAdapter_CSV   KafkaAdapter_Format = 1
+	KafkaAdapter_BYTES KafkaAdapter_Format = 2
+)
+
+// Enum value maps for KafkaAdapter_Format.
+var (
+	KafkaAdapter_Format_name = map[int32]string{
+		0: "JSON",
+		1: "CSV",
+		2: "BYTES",
+	}
+	KafkaAdapter_Format_value = map[string]int32{
+		"JSON":  0,
+		"CSV":   1,
+		"BYTES": 2,
+	}
+)
+
+func (x KafkaAdapter_Format) Enum() *KafkaAdapter_Format {
+	p := new(KafkaAdapter_Format)
+	*p = x
+	return p
+}
+
+func (x KafkaAdapter_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KafkaAdapter_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_mlops_chainer_chainer_proto_enumTypes[3].Descriptor()
+}
+
+func (KafkaAdapter_Format) Type() protoreflect.EnumType {
+	return &file_mlops_chainer_chainer_proto_enumTypes[3]
+}
+
+func (x KafkaAdapter_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KafkaAdapter_Format.Descriptor instead.
+func (KafkaAdapter_Format) EnumDescriptor() ([]byte, []int) {
+	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type KafkaAdapter_Direction int32
+
+const (
+	KafkaAdapter_SOURCE KafkaAdapter_Direction = 0 // read the single source topic as records and write V2 requests to the sink
+	KafkaAdapter_SINK   KafkaAdapter_Direction = 1 // read V2 responses from the single source topic and write records to the sink
+)
+
+// Enum value maps for KafkaAdapter_Direction.
+var (
+	KafkaAdapter_Direction_name = map[int32]string{
+		0: "SOURCE",
+		1: "SINK",
+	}
+	KafkaAdapter_Direction_value = map[string]int32{
+		"SOURCE": 0,
+		"SINK":   1,
+	}
+)
+
+func (x KafkaAdapter_Direction) Enum() *KafkaAdapter_Direction {
+	p := new(KafkaAdapter_Direction)
+	*p = x
+	return p
+}
+
+func (x KafkaAdapter_Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KafkaAdapter_Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_mlops_chainer_chainer_proto_enumTypes[4].Descriptor()
+}
+
+func (KafkaAdapter_Direction) Type() protoreflect.EnumType {
+	return &file_mlops_chainer_chainer_proto_enumTypes[4]
+}
+
+func (x KafkaAdapter_Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KafkaAdapter_Direction.Descriptor instead.
+func (KafkaAdapter_Direction) EnumDescriptor() ([]byte, []int) {
+	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{9, 1}
 }
 
 type PipelineSubscriptionRequest struct {
@@ -183,11 +339,14 @@ type PipelineUpdateMessage struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Op       PipelineUpdateMessage_PipelineOperation `protobuf:"varint,1,opt,name=op,proto3,enum=seldon.mlops.chainer.PipelineUpdateMessage_PipelineOperation" json:"op,omitempty"`
-	Pipeline string                                  `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	Version  uint32                                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	Uid      string                                  `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
-	Updates  []*PipelineStepUpdate                   `protobuf:"bytes,5,rep,name=updates,proto3" json:"updates,omitempty"`
+	Op          PipelineUpdateMessage_PipelineOperation `protobuf:"varint,1,opt,name=op,proto3,enum=seldon.mlops.chainer.PipelineUpdateMessage_PipelineOperation" json:"op,omitempty"`
+	Pipeline    string                                  `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Version     uint32                                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Uid         string                                  `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	Updates     []*PipelineStepUpdate                   `protobuf:"bytes,5,rep,name=updates,proto3" json:"updates,omitempty"`
+	TopicConfig *PipelineTopicConfig                    `protobuf:"bytes,6,opt,name=topicConfig,proto3,oneof" json:"topicConfig,omitempty"` // settings for the pipeline input and output topics
+	// A canary version only processes the messages routed to it. Messages without a version are left to the stable version.
+	Canary bool `protobuf:"varint,7,opt,name=canary,proto3" json:"canary,omitempty"`
 }
 
 func (x *PipelineUpdateMessage) Reset() {
@@ -257,6 +416,100 @@ func (x *PipelineUpdateMessage) GetUpdates() []*PipelineStepUpdate {
 	return nil
 }
 
+func (x *PipelineUpdateMessage) GetTopicConfig() *PipelineTopicConfig {
+	if x != nil {
+		return x.TopicConfig
+	}
+	return nil
+}
+
+func (x *PipelineUpdateMessage) GetCanary() bool {
+	if x != nil {
+		return x.Canary
+	}
+	return false
+}
+
+// Kafka settings for pipeline topics. Unset fields use the global defaults.
+type PipelineTopicConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Partitions        *uint32 `protobuf:"varint,1,opt,name=partitions,proto3,oneof" json:"partitions,omitempty"`
+	ReplicationFactor *uint32 `protobuf:"varint,2,opt,name=replicationFactor,proto3,oneof" json:"replicationFactor,omitempty"` // only applied when topics are created
+	RetentionMs       *int64  `protobuf:"varint,3,opt,name=retentionMs,proto3,oneof" json:"retentionMs,omitempty"`
+	CleanupPolicy     *string `protobuf:"bytes,4,opt,name=cleanupPolicy,proto3,oneof" json:"cleanupPolicy,omitempty"`
+	CompressionType   *string `protobuf:"bytes,5,opt,name=compressionType,proto3,oneof" json:"compressionType,omitempty"`
+}
+
+func (x *PipelineTopicConfig) Reset() {
+	*x = PipelineTopicConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_chainer_chainer_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineTopicConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineTopicConfig) ProtoMessage() {}
+
+func (x *PipelineTopicConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_chainer_chainer_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineTopicConfig.ProtoReflect.Descriptor instead.
+func (*PipelineTopicConfig) Descriptor() ([]byte, []int) {
+	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PipelineTopicConfig) GetPartitions() uint32 {
+	if x != nil && x.Partitions != nil {
+		return *x.Partitions
+	}
+	return 0
+}
+
+func (x *PipelineTopicConfig) GetReplicationFactor() uint32 {
+	if x != nil && x.ReplicationFactor != nil {
+		return *x.ReplicationFactor
+	}
+	return 0
+}
+
+func (x *PipelineTopicConfig) GetRetentionMs() int64 {
+	if x != nil && x.RetentionMs != nil {
+		return *x.RetentionMs
+	}
+	return 0
+}
+
+func (x *PipelineTopicConfig) GetCleanupPolicy() string {
+	if x != nil && x.CleanupPolicy != nil {
+		return *x.CleanupPolicy
+	}
+	return ""
+}
+
+func (x *PipelineTopicConfig) GetCompressionType() string {
+	if x != nil && x.CompressionType != nil {
+		return *x.CompressionType
+	}
+	return ""
+}
+
 type PipelineStepUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -275,12 +528,15 @@ type PipelineStepUpdate struct {
 	JoinWindowMs       *uint32                             `protobuf:"varint,7,opt,name=joinWindowMs,proto3,oneof" json:"joinWindowMs,omitempty"`       // Join window millisecs, some nozero default (TBD)
 	TensorMap          []*PipelineTensorMapping            `protobuf:"bytes,8,rep,name=tensorMap,proto3" json:"tensorMap,omitempty"`                    // optional list of tensor name mappings
 	Batch              *Batch                              `protobuf:"bytes,9,opt,name=batch,proto3" json:"batch,omitempty"`                            // Batch settings
+	Transforms         []*PipelineTransform                `protobuf:"bytes,10,rep,name=transforms,proto3" json:"transforms,omitempty"`                 // optional built-in tensor transforms applied before writing to sink
+	ForEach            *ForEach                            `protobuf:"bytes,11,opt,name=forEach,proto3" json:"forEach,omitempty"`                       // optional fan-out of sink requests, one per element of a tensor
+	KafkaAdapter       *KafkaAdapter                       `protobuf:"bytes,12,opt,name=kafkaAdapter,proto3" json:"kafkaAdapter,omitempty"`             // optional conversion between an external Kafka topic and V2 tensors
 }
 
 func (x *PipelineStepUpdate) Reset() {
 	*x = PipelineStepUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_chainer_chainer_proto_msgTypes[2]
+		mi := &file_mlops_chainer_chainer_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -293,7 +549,7 @@ func (x *PipelineStepUpdate) String() string {
 func (*PipelineStepUpdate) ProtoMessage() {}
 
 func (x *PipelineStepUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_chainer_chainer_proto_msgTypes[2]
+	mi := &file_mlops_chainer_chainer_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -306,7 +562,7 @@ func (x *PipelineStepUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PipelineStepUpdate.ProtoReflect.Descriptor instead.
 func (*PipelineStepUpdate) Descriptor() ([]byte, []int) {
-	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{2}
+	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PipelineStepUpdate) GetSources() []*PipelineTopic {
@@ -372,6 +628,130 @@ func (x *PipelineStepUpdate) GetBatch() *Batch {
 	return nil
 }
 
+func (x *PipelineStepUpdate) GetTransforms() []*PipelineTransform {
+	if x != nil {
+		return x.Transforms
+	}
+	return nil
+}
+
+func (x *PipelineStepUpdate) GetForEach() *ForEach {
+	if x != nil {
+		return x.ForEach
+	}
+	return nil
+}
+
+func (x *PipelineStepUpdate) GetKafkaAdapter() *KafkaAdapter {
+	if x != nil {
+		return x.KafkaAdapter
+	}
+	return nil
+}
+
+type PipelineTransform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     PipelineTransform_TransformType `protobuf:"varint,1,opt,name=type,proto3,enum=seldon.mlops.chainer.PipelineTransform_TransformType" json:"type,omitempty"`
+	Tensors  []string                        `protobuf:"bytes,2,rep,name=tensors,proto3" json:"tensors,omitempty"`
+	Outputs  []string                        `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	Axis     uint32                          `protobuf:"varint,4,opt,name=axis,proto3" json:"axis,omitempty"`
+	Datatype string                          `protobuf:"bytes,5,opt,name=datatype,proto3" json:"datatype,omitempty"`
+	Shape    []int64                         `protobuf:"varint,6,rep,packed,name=shape,proto3" json:"shape,omitempty"`
+	Values   []string                        `protobuf:"bytes,7,rep,name=values,proto3" json:"values,omitempty"`
+	Path     string                          `protobuf:"bytes,8,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *PipelineTransform) Reset() {
+	*x = PipelineTransform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_chainer_chainer_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineTransform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineTransform) ProtoMessage() {}
+
+func (x *PipelineTransform) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_chainer_chainer_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineTransform.ProtoReflect.Descriptor instead.
+func (*PipelineTransform) Descriptor() ([]byte, []int) {
+	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PipelineTransform) GetType() PipelineTransform_TransformType {
+	if x != nil {
+		return x.Type
+	}
+	return PipelineTransform_CONCAT
+}
+
+func (x *PipelineTransform) GetTensors() []string {
+	if x != nil {
+		return x.Tensors
+	}
+	return nil
+}
+
+func (x *PipelineTransform) GetOutputs() []string {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *PipelineTransform) GetAxis() uint32 {
+	if x != nil {
+		return x.Axis
+	}
+	return 0
+}
+
+func (x *PipelineTransform) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+func (x *PipelineTransform) GetShape() []int64 {
+	if x != nil {
+		return x.Shape
+	}
+	return nil
+}
+
+func (x *PipelineTransform) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *PipelineTransform) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
 type PipelineTensorMapping struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -385,7 +765,7 @@ type PipelineTensorMapping struct {
 func (x *PipelineTensorMapping) Reset() {
 	*x = PipelineTensorMapping{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_chainer_chainer_proto_msgTypes[3]
+		mi := &file_mlops_chainer_chainer_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -398,7 +778,7 @@ func (x *PipelineTensorMapping) String() string {
 func (*PipelineTensorMapping) ProtoMessage() {}
 
 func (x *PipelineTensorMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_chainer_chainer_proto_msgTypes[3]
+	mi := &file_mlops_chainer_chainer_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +791,7 @@ func (x *PipelineTensorMapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PipelineTensorMapping.ProtoReflect.Descriptor instead.
 func (*PipelineTensorMapping) Descriptor() ([]byte, []int) {
-	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{3}
+	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PipelineTensorMapping) GetPipelineName() string {
@@ -448,7 +828,7 @@ type PipelineTopic struct {
 func (x *PipelineTopic) Reset() {
 	*x = PipelineTopic{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_chainer_chainer_proto_msgTypes[4]
+		mi := &file_mlops_chainer_chainer_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -461,7 +841,7 @@ func (x *PipelineTopic) String() string {
 func (*PipelineTopic) ProtoMessage() {}
 
 func (x *PipelineTopic) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_chainer_chainer_proto_msgTypes[4]
+	mi := &file_mlops_chainer_chainer_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,7 +854,7 @@ func (x *PipelineTopic) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PipelineTopic.ProtoReflect.Descriptor instead.
 func (*PipelineTopic) Descriptor() ([]byte, []int) {
-	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{4}
+	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PipelineTopic) GetPipelineName() string {
@@ -511,7 +891,7 @@ type Batch struct {
 func (x *Batch) Reset() {
 	*x = Batch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_chainer_chainer_proto_msgTypes[5]
+		mi := &file_mlops_chainer_chainer_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -524,7 +904,7 @@ func (x *Batch) String() string {
 func (*Batch) ProtoMessage() {}
 
 func (x *Batch) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_chainer_chainer_proto_msgTypes[5]
+	mi := &file_mlops_chainer_chainer_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +917,7 @@ func (x *Batch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Batch.ProtoReflect.Descriptor instead.
 func (*Batch) Descriptor() ([]byte, []int) {
-	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{5}
+	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Batch) GetSize() uint32 {
@@ -561,6 +941,213 @@ func (x *Batch) GetRolling() bool {
 	return false
 }
 
+type ForEach struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tensor          string `protobuf:"bytes,1,opt,name=tensor,proto3" json:"tensor,omitempty"`
+	Axis            uint32 `protobuf:"varint,2,opt,name=axis,proto3" json:"axis,omitempty"`
+	Parallelism     uint32 `protobuf:"varint,3,opt,name=parallelism,proto3" json:"parallelism,omitempty"` // max elements in flight per request, 0 for no limit
+	GatherTimeoutMs uint32 `protobuf:"varint,4,opt,name=gatherTimeoutMs,proto3" json:"gatherTimeoutMs,omitempty"`
+	GatherTopic     string `protobuf:"bytes,5,opt,name=gatherTopic,proto3" json:"gatherTopic,omitempty"` // topic the element outputs are read from and the gathered output is written back to
+}
+
+func (x *ForEach) Reset() {
+	*x = ForEach{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_chainer_chainer_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForEach) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForEach) ProtoMessage() {}
+
+func (x *ForEach) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_chainer_chainer_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForEach.ProtoReflect.Descriptor instead.
+func (*ForEach) Descriptor() ([]byte, []int) {
+	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ForEach) GetTensor() string {
+	if x != nil {
+		return x.Tensor
+	}
+	return ""
+}
+
+func (x *ForEach) GetAxis() uint32 {
+	if x != nil {
+		return x.Axis
+	}
+	return 0
+}
+
+func (x *ForEach) GetParallelism() uint32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+func (x *ForEach) GetGatherTimeoutMs() uint32 {
+	if x != nil {
+		return x.GatherTimeoutMs
+	}
+	return 0
+}
+
+func (x *ForEach) GetGatherTopic() string {
+	if x != nil {
+		return x.GatherTopic
+	}
+	return ""
+}
+
+// Converts records of an external Kafka topic to V2 requests for the pipeline, or V2 responses of the
+// pipeline to records of an external topic
+type KafkaAdapter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format    KafkaAdapter_Format    `protobuf:"varint,1,opt,name=format,proto3,enum=seldon.mlops.chainer.KafkaAdapter_Format" json:"format,omitempty"`
+	Direction KafkaAdapter_Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=seldon.mlops.chainer.KafkaAdapter_Direction" json:"direction,omitempty"`
+	Tensors   []*KafkaAdapterTensor  `protobuf:"bytes,3,rep,name=tensors,proto3" json:"tensors,omitempty"` // tensors read from or written to each record, in order
+}
+
+func (x *KafkaAdapter) Reset() {
+	*x = KafkaAdapter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_chainer_chainer_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KafkaAdapter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KafkaAdapter) ProtoMessage() {}
+
+func (x *KafkaAdapter) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_chainer_chainer_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KafkaAdapter.ProtoReflect.Descriptor instead.
+func (*KafkaAdapter) Descriptor() ([]byte, []int) {
+	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *KafkaAdapter) GetFormat() KafkaAdapter_Format {
+	if x != nil {
+		return x.Format
+	}
+	return KafkaAdapter_JSON
+}
+
+func (x *KafkaAdapter) GetDirection() KafkaAdapter_Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return KafkaAdapter_SOURCE
+}
+
+func (x *KafkaAdapter) GetTensors() []*KafkaAdapterTensor {
+	if x != nil {
+		return x.Tensors
+	}
+	return nil
+}
+
+type KafkaAdapterTensor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Datatype string  `protobuf:"bytes,2,opt,name=datatype,proto3" json:"datatype,omitempty"`   // empty to infer the datatype
+	Shape    []int64 `protobuf:"varint,3,rep,packed,name=shape,proto3" json:"shape,omitempty"` // empty to infer the shape
+}
+
+func (x *KafkaAdapterTensor) Reset() {
+	*x = KafkaAdapterTensor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_chainer_chainer_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KafkaAdapterTensor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KafkaAdapterTensor) ProtoMessage() {}
+
+func (x *KafkaAdapterTensor) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_chainer_chainer_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KafkaAdapterTensor.ProtoReflect.Descriptor instead.
+func (*KafkaAdapterTensor) Descriptor() ([]byte, []int) {
+	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *KafkaAdapterTensor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KafkaAdapterTensor) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+func (x *KafkaAdapterTensor) GetShape() []int64 {
+	if x != nil {
+		return x.Shape
+	}
+	return nil
+}
+
 type PipelineUpdateStatusMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -575,7 +1162,7 @@ type PipelineUpdateStatusMessage struct {
 func (x *PipelineUpdateStatusMessage) Reset() {
 	*x = PipelineUpdateStatusMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_chainer_chainer_proto_msgTypes[6]
+		mi := &file_mlops_chainer_chainer_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -588,7 +1175,7 @@ func (x *PipelineUpdateStatusMessage) String() string {
 func (*PipelineUpdateStatusMessage) ProtoMessage() {}
 
 func (x *PipelineUpdateStatusMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_chainer_chainer_proto_msgTypes[6]
+	mi := &file_mlops_chainer_chainer_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -601,7 +1188,7 @@ func (x *PipelineUpdateStatusMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PipelineUpdateStatusMessage.ProtoReflect.Descriptor instead.
 func (*PipelineUpdateStatusMessage) Descriptor() ([]byte, []int) {
-	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{6}
+	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PipelineUpdateStatusMessage) GetUpdate() *PipelineUpdateMessage {
@@ -634,7 +1221,7 @@ type PipelineUpdateStatusResponse struct {
 func (x *PipelineUpdateStatusResponse) Reset() {
 	*x = PipelineUpdateStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_chainer_chainer_proto_msgTypes[7]
+		mi := &file_mlops_chainer_chainer_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -647,7 +1234,7 @@ func (x *PipelineUpdateStatusResponse) String() string {
 func (*PipelineUpdateStatusResponse) ProtoMessage() {}
 
 func (x *PipelineUpdateStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_chainer_chainer_proto_msgTypes[7]
+	mi := &file_mlops_chainer_chainer_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,7 +1247,7 @@ func (x *PipelineUpdateStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PipelineUpdateStatusResponse.ProtoReflect.Descriptor instead.
 func (*PipelineUpdateStatusResponse) Descriptor() ([]byte, []int) {
-	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{7}
+	return file_mlops_chainer_chainer_proto_rawDescGZIP(), []int{12}
 }
 
 var File_mlops_chainer_chainer_proto protoreflect.FileDescriptor
@@ -672,7 +1259,7 @@ var file_mlops_chainer_chainer_proto_rawDesc = []byte{
 	0x6e, 0x65, 0x72, 0x22, 0x31, 0x0a, 0x1b, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53,
 	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xac, 0x02, 0x0a, 0x15, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa6, 0x03, 0x0a, 0x15, 0x50, 0x69, 0x70, 0x65, 0x6c,
 	0x69, 0x6e, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x12, 0x4d, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3d, 0x2e, 0x73,
 	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69,
@@ -687,112 +1274,210 @@ var file_mlops_chainer_chainer_proto_rawDesc = []byte{
 	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
 	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e,
 	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x38, 0x0a, 0x11, 0x50,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x10, 0x02, 0x22, 0xb5, 0x05, 0x0a, 0x12, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x07,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
-	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x74,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
-	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x52, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x37, 0x0a, 0x04,
-	0x73, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x65, 0x6c,
-	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52,
-	0x04, 0x73, 0x69, 0x6e, 0x6b, 0x12, 0x5b, 0x0a, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x4a, 0x6f,
-	0x69, 0x6e, 0x54, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e, 0x73, 0x65, 0x6c,
-	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4a, 0x6f, 0x69,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x4a, 0x6f, 0x69, 0x6e,
-	0x54, 0x79, 0x12, 0x61, 0x0a, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f,
-	0x69, 0x6e, 0x54, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e, 0x73, 0x65, 0x6c,
+	0x74, 0x65, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x0b, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0b, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63,
+	0x61, 0x6e, 0x61, 0x72, 0x79, 0x22, 0x38, 0x0a, 0x11, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x02, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0xc9, 0x02, 0x0a, 0x13, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x11,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x25, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75,
+	0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
+	0x0d, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0f, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42,
+	0x14, 0x0a, 0x12, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75,
+	0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0xff, 0x06, 0x0a, 0x12,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
+	0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x12, 0x3f, 0x0a, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
+	0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x73, 0x12, 0x37, 0x0a, 0x04, 0x73, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x04, 0x73, 0x69, 0x6e, 0x6b, 0x12, 0x5b, 0x0a, 0x0b, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x39, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x12, 0x61, 0x0a, 0x0e, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x39, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x70,
+	0x61, 0x73, 0x73, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x70, 0x61, 0x73, 0x73, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0c, 0x6a,
+	0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x00, 0x52, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61,
+	0x70, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x12,
+	0x31, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x47, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52,
+	0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x66,
+	0x6f, 0x72, 0x45, 0x61, 0x63, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x45, 0x61, 0x63, 0x68, 0x52, 0x07, 0x66, 0x6f, 0x72,
+	0x45, 0x61, 0x63, 0x68, 0x12, 0x46, 0x0a, 0x0c, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x41, 0x64, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x6c,
 	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4a, 0x6f, 0x69,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x4a,
-	0x6f, 0x69, 0x6e, 0x54, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x70, 0x61, 0x73, 0x73, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x12, 0x70, 0x61, 0x73, 0x73, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e,
-	0x64, 0x6f, 0x77, 0x4d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0c, 0x6a,
-	0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x49,
-	0x0a, 0x09, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x18, 0x08, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
-	0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x09,
-	0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x31, 0x0a, 0x05, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0x3e, 0x0a, 0x10,
+	0x72, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x0c,
+	0x6b, 0x61, 0x66, 0x6b, 0x61, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x22, 0x3e, 0x0a, 0x10,
 	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65,
 	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x09, 0x0a,
 	0x05, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x75, 0x74, 0x65,
 	0x72, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x6e, 0x79, 0x10, 0x03, 0x42, 0x0f, 0x0a, 0x0d,
-	0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x22, 0x83, 0x01,
-	0x0a, 0x15, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74,
-	0x6f, 0x70, 0x69, 0x63, 0x41, 0x6e, 0x64, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x41, 0x6e, 0x64, 0x54, 0x65, 0x6e,
-	0x73, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0x79, 0x0a, 0x0d, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x70,
-	0x69, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72,
-	0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x22, 0x71,
-	0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x1f, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x48, 0x01, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x42, 0x07, 0x0a, 0x05, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d,
-	0x73, 0x22, 0x94, 0x01, 0x0a, 0x1b, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x43, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x22, 0xeb, 0x02,
+	0x0a, 0x11, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x12, 0x49, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x35, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
 	0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x1e, 0x0a, 0x1c, 0x50, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x89, 0x02, 0x0a, 0x07, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x12, 0x7e, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
-	0x12, 0x31, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x78, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x61, 0x78, 0x69, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x22, 0x65, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4e, 0x43, 0x41, 0x54, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
+	0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x43, 0x41, 0x53, 0x54,
+	0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x53, 0x48, 0x41, 0x50, 0x45, 0x10, 0x04, 0x12,
+	0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x0c, 0x0a,
+	0x08, 0x4a, 0x53, 0x4f, 0x4e, 0x50, 0x41, 0x54, 0x48, 0x10, 0x06, 0x22, 0x83, 0x01, 0x0a, 0x15,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x41, 0x6e, 0x64, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x41, 0x6e, 0x64, 0x54, 0x65, 0x6e, 0x73, 0x6f,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x79, 0x0a, 0x0d, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x22, 0x71, 0x0a, 0x05,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x01, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x22,
+	0xa3, 0x01, 0x0a, 0x07, 0x46, 0x6f, 0x72, 0x45, 0x61, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x65, 0x6e, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e,
+	0x73, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x78, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x61, 0x78, 0x69, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c,
+	0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x61,
+	0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x67, 0x61, 0x74, 0x68, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x4d, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x61, 0x74, 0x68, 0x65, 0x72, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x22, 0xac, 0x02, 0x0a, 0x0c, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x41,
+	0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x4b, 0x61,
+	0x66, 0x6b, 0x61, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x4a, 0x0a, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x07, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x4b, 0x61,
+	0x66, 0x6b, 0x61, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72,
+	0x52, 0x07, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x22, 0x26, 0x0a, 0x06, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10,
+	0x02, 0x22, 0x21, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a,
+	0x0a, 0x06, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x49,
+	0x4e, 0x4b, 0x10, 0x01, 0x22, 0x5a, 0x0a, 0x12, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x41, 0x64, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68,
+	0x61, 0x70, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65,
+	0x22, 0x94, 0x01, 0x0a, 0x1b, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x43, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
 	0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
-	0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x00, 0x30, 0x01, 0x12, 0x7e, 0x0a, 0x13, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x2e, 0x73, 0x65,
-	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x32,
-	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x42, 0x53, 0x0a, 0x17, 0x69, 0x6f, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5a,
-	0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x6c, 0x64,
-	0x6f, 0x6e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2d, 0x63, 0x6f, 0x72, 0x65,
-	0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x6c, 0x6f, 0x70,
-	0x73, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x1e, 0x0a, 0x1c, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x89, 0x02, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x12, 0x7e, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x31, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
+	0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x7e, 0x0a, 0x13, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x2e, 0x73, 0x65, 0x6c,
+	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x32, 0x2e,
+	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x42, 0x53, 0x0a, 0x17, 0x69, 0x6f, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5a, 0x38,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x6c, 0x64, 0x6f,
+	0x6e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -807,40 +1492,56 @@ func file_mlops_chainer_chainer_proto_rawDescGZIP() []byte {
 	return file_mlops_chainer_chainer_proto_rawDescData
 }
 
-var file_mlops_chainer_chainer_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_mlops_chainer_chainer_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_mlops_chainer_chainer_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_mlops_chainer_chainer_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_mlops_chainer_chainer_proto_goTypes = []interface{}{
 	(PipelineUpdateMessage_PipelineOperation)(0), // 0: seldon.mlops.chainer.PipelineUpdateMessage.PipelineOperation
 	(PipelineStepUpdate_PipelineJoinType)(0),     // 1: seldon.mlops.chainer.PipelineStepUpdate.PipelineJoinType
-	(*PipelineSubscriptionRequest)(nil),          // 2: seldon.mlops.chainer.PipelineSubscriptionRequest
-	(*PipelineUpdateMessage)(nil),                // 3: seldon.mlops.chainer.PipelineUpdateMessage
-	(*PipelineStepUpdate)(nil),                   // 4: seldon.mlops.chainer.PipelineStepUpdate
-	(*PipelineTensorMapping)(nil),                // 5: seldon.mlops.chainer.PipelineTensorMapping
-	(*PipelineTopic)(nil),                        // 6: seldon.mlops.chainer.PipelineTopic
-	(*Batch)(nil),                                // 7: seldon.mlops.chainer.Batch
-	(*PipelineUpdateStatusMessage)(nil),          // 8: seldon.mlops.chainer.PipelineUpdateStatusMessage
-	(*PipelineUpdateStatusResponse)(nil),         // 9: seldon.mlops.chainer.PipelineUpdateStatusResponse
+	(PipelineTransform_TransformType)(0),         // 2: seldon.mlops.chainer.PipelineTransform.TransformType
+	(KafkaAdapter_Format)(0),                     // 3: seldon.mlops.chainer.KafkaAdapter.Format
+	(KafkaAdapter_Direction)(0),                  // 4: seldon.mlops.chainer.KafkaAdapter.Direction
+	(*PipelineSubscriptionRequest)(nil),          // 5: seldon.mlops.chainer.PipelineSubscriptionRequest
+	(*PipelineUpdateMessage)(nil),                // 6: seldon.mlops.chainer.PipelineUpdateMessage
+	(*PipelineTopicConfig)(nil),                  // 7: seldon.mlops.chainer.PipelineTopicConfig
+	(*PipelineStepUpdate)(nil),                   // 8: seldon.mlops.chainer.PipelineStepUpdate
+	(*PipelineTransform)(nil),                    // 9: seldon.mlops.chainer.PipelineTransform
+	(*PipelineTensorMapping)(nil),                // 10: seldon.mlops.chainer.PipelineTensorMapping
+	(*PipelineTopic)(nil),                        // 11: seldon.mlops.chainer.PipelineTopic
+	(*Batch)(nil),                                // 12: seldon.mlops.chainer.Batch
+	(*ForEach)(nil),                              // 13: seldon.mlops.chainer.ForEach
+	(*KafkaAdapter)(nil),                         // 14: seldon.mlops.chainer.KafkaAdapter
+	(*KafkaAdapterTensor)(nil),                   // 15: seldon.mlops.chainer.KafkaAdapterTensor
+	(*PipelineUpdateStatusMessage)(nil),          // 16: seldon.mlops.chainer.PipelineUpdateStatusMessage
+	(*PipelineUpdateStatusResponse)(nil),         // 17: seldon.mlops.chainer.PipelineUpdateStatusResponse
 }
 var file_mlops_chainer_chainer_proto_depIdxs = []int32{
 	0,  // 0: seldon.mlops.chainer.PipelineUpdateMessage.op:type_name -> seldon.mlops.chainer.PipelineUpdateMessage.PipelineOperation
-	4,  // 1: seldon.mlops.chainer.PipelineUpdateMessage.updates:type_name -> seldon.mlops.chainer.PipelineStepUpdate
-	6,  // 2: seldon.mlops.chainer.PipelineStepUpdate.sources:type_name -> seldon.mlops.chainer.PipelineTopic
-	6,  // 3: seldon.mlops.chainer.PipelineStepUpdate.triggers:type_name -> seldon.mlops.chainer.PipelineTopic
-	6,  // 4: seldon.mlops.chainer.PipelineStepUpdate.sink:type_name -> seldon.mlops.chainer.PipelineTopic
-	1,  // 5: seldon.mlops.chainer.PipelineStepUpdate.inputJoinTy:type_name -> seldon.mlops.chainer.PipelineStepUpdate.PipelineJoinType
-	1,  // 6: seldon.mlops.chainer.PipelineStepUpdate.triggersJoinTy:type_name -> seldon.mlops.chainer.PipelineStepUpdate.PipelineJoinType
-	5,  // 7: seldon.mlops.chainer.PipelineStepUpdate.tensorMap:type_name -> seldon.mlops.chainer.PipelineTensorMapping
-	7,  // 8: seldon.mlops.chainer.PipelineStepUpdate.batch:type_name -> seldon.mlops.chainer.Batch
-	3,  // 9: seldon.mlops.chainer.PipelineUpdateStatusMessage.update:type_name -> seldon.mlops.chainer.PipelineUpdateMessage
-	2,  // 10: seldon.mlops.chainer.Chainer.SubscribePipelineUpdates:input_type -> seldon.mlops.chainer.PipelineSubscriptionRequest
-	8,  // 11: seldon.mlops.chainer.Chainer.PipelineUpdateEvent:input_type -> seldon.mlops.chainer.PipelineUpdateStatusMessage
-	3,  // 12: seldon.mlops.chainer.Chainer.SubscribePipelineUpdates:output_type -> seldon.mlops.chainer.PipelineUpdateMessage
-	9,  // 13: seldon.mlops.chainer.Chainer.PipelineUpdateEvent:output_type -> seldon.mlops.chainer.PipelineUpdateStatusResponse
-	12, // [12:14] is the sub-list for method output_type
-	10, // [10:12] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	8,  // 1: seldon.mlops.chainer.PipelineUpdateMessage.updates:type_name -> seldon.mlops.chainer.PipelineStepUpdate
+	7,  // 2: seldon.mlops.chainer.PipelineUpdateMessage.topicConfig:type_name -> seldon.mlops.chainer.PipelineTopicConfig
+	11, // 3: seldon.mlops.chainer.PipelineStepUpdate.sources:type_name -> seldon.mlops.chainer.PipelineTopic
+	11, // 4: seldon.mlops.chainer.PipelineStepUpdate.triggers:type_name -> seldon.mlops.chainer.PipelineTopic
+	11, // 5: seldon.mlops.chainer.PipelineStepUpdate.sink:type_name -> seldon.mlops.chainer.PipelineTopic
+	1,  // 6: seldon.mlops.chainer.PipelineStepUpdate.inputJoinTy:type_name -> seldon.mlops.chainer.PipelineStepUpdate.PipelineJoinType
+	1,  // 7: seldon.mlops.chainer.PipelineStepUpdate.triggersJoinTy:type_name -> seldon.mlops.chainer.PipelineStepUpdate.PipelineJoinType
+	10, // 8: seldon.mlops.chainer.PipelineStepUpdate.tensorMap:type_name -> seldon.mlops.chainer.PipelineTensorMapping
+	12, // 9: seldon.mlops.chainer.PipelineStepUpdate.batch:type_name -> seldon.mlops.chainer.Batch
+	9,  // 10: seldon.mlops.chainer.PipelineStepUpdate.transforms:type_name -> seldon.mlops.chainer.PipelineTransform
+	13, // 11: seldon.mlops.chainer.PipelineStepUpdate.forEach:type_name -> seldon.mlops.chainer.ForEach
+	14, // 12: seldon.mlops.chainer.PipelineStepUpdate.kafkaAdapter:type_name -> seldon.mlops.chainer.KafkaAdapter
+	2,  // 13: seldon.mlops.chainer.PipelineTransform.type:type_name -> seldon.mlops.chainer.PipelineTransform.TransformType
+	3,  // 14: seldon.mlops.chainer.KafkaAdapter.format:type_name -> seldon.mlops.chainer.KafkaAdapter.Format
+	4,  // 15: seldon.mlops.chainer.KafkaAdapter.direction:type_name -> seldon.mlops.chainer.KafkaAdapter.Direction
+	15, // 16: seldon.mlops.chainer.KafkaAdapter.tensors:type_name -> seldon.mlops.chainer.KafkaAdapterTensor
+	6,  // 17: seldon.mlops.chainer.PipelineUpdateStatusMessage.update:type_name -> seldon.mlops.chainer.PipelineUpdateMessage
+	5,  // 18: seldon.mlops.chainer.Chainer.SubscribePipelineUpdates:input_type -> seldon.mlops.chainer.PipelineSubscriptionRequest
+	16, // 19: seldon.mlops.chainer.Chainer.PipelineUpdateEvent:input_type -> seldon.mlops.chainer.PipelineUpdateStatusMessage
+	6,  // 20: seldon.mlops.chainer.Chainer.SubscribePipelineUpdates:output_type -> seldon.mlops.chainer.PipelineUpdateMessage
+	17, // 21: seldon.mlops.chainer.Chainer.PipelineUpdateEvent:output_type -> seldon.mlops.chainer.PipelineUpdateStatusResponse
+	20, // [20:22] is the sub-list for method output_type
+	18, // [18:20] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_mlops_chainer_chainer_proto_init() }
@@ -874,7 +1575,7 @@ func file_mlops_chainer_chainer_proto_init() {
 			}
 		}
 		file_mlops_chainer_chainer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PipelineStepUpdate); i {
+			switch v := v.(*PipelineTopicConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -886,7 +1587,7 @@ func file_mlops_chainer_chainer_proto_init() {
 			}
 		}
 		file_mlops_chainer_chainer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PipelineTensorMapping); i {
+			switch v := v.(*PipelineStepUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -898,7 +1599,7 @@ func file_mlops_chainer_chainer_proto_init() {
 			}
 		}
 		file_mlops_chainer_chainer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PipelineTopic); i {
+			switch v := v.(*PipelineTransform); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -910,7 +1611,7 @@ func file_mlops_chainer_chainer_proto_init() {
 			}
 		}
 		file_mlops_chainer_chainer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Batch); i {
+			switch v := v.(*PipelineTensorMapping); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -922,7 +1623,7 @@ func file_mlops_chainer_chainer_proto_init() {
 			}
 		}
 		file_mlops_chainer_chainer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PipelineUpdateStatusMessage); i {
+			switch v := v.(*PipelineTopic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -934,6 +1635,66 @@ func file_mlops_chainer_chainer_proto_init() {
 			}
 		}
 		file_mlops_chainer_chainer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Batch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_chainer_chainer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForEach); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_chainer_chainer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KafkaAdapter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_chainer_chainer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KafkaAdapterTensor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_chainer_chainer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineUpdateStatusMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_chainer_chainer_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PipelineUpdateStatusResponse); i {
 			case 0:
 				return &v.state
@@ -946,16 +1707,18 @@ func file_mlops_chainer_chainer_proto_init() {
 			}
 		}
 	}
+	file_mlops_chainer_chainer_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	file_mlops_chainer_chainer_proto_msgTypes[2].OneofWrappers = []interface{}{}
-	file_mlops_chainer_chainer_proto_msgTypes[4].OneofWrappers = []interface{}{}
-	file_mlops_chainer_chainer_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_mlops_chainer_chainer_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_mlops_chainer_chainer_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_mlops_chainer_chainer_proto_msgTypes[7].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mlops_chainer_chainer_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   8,
+			NumEnums:      5,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
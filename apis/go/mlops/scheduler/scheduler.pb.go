@@ -77,6 +77,153 @@ func (ResourceType) EnumDescriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{0}
 }
 
+type RolloutAnalysis_FailureAction int32
+
+const (
+	RolloutAnalysis_ROLLBACK RolloutAnalysis_FailureAction = 0 // send all traffic back to the previous version
+	RolloutAnalysis_PAUSE    RolloutAnalysis_FailureAction = 1 // keep the traffic split of the failed step
+)
+
+// Enum value maps for RolloutAnalysis_FailureAction.
+var (
+	RolloutAnalysis_FailureAction_name = map[int32]string{
+		0: "ROLLBACK",
+		1: "PAUSE",
+	}
+	RolloutAnalysis_FailureAction_value = map[string]int32{
+		"ROLLBACK": 0,
+		"PAUSE":    1,
+	}
+)
+
+func (x RolloutAnalysis_FailureAction) Enum() *RolloutAnalysis_FailureAction {
+	p := new(RolloutAnalysis_FailureAction)
+	*p = x
+	return p
+}
+
+func (x RolloutAnalysis_FailureAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RolloutAnalysis_FailureAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_mlops_scheduler_scheduler_proto_enumTypes[1].Descriptor()
+}
+
+func (RolloutAnalysis_FailureAction) Type() protoreflect.EnumType {
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[1]
+}
+
+func (x RolloutAnalysis_FailureAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RolloutAnalysis_FailureAction.Descriptor instead.
+func (RolloutAnalysis_FailureAction) EnumDescriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type RouteRateLimit_Unit int32
+
+const (
+	RouteRateLimit_SECOND RouteRateLimit_Unit = 0
+	RouteRateLimit_MINUTE RouteRateLimit_Unit = 1
+	RouteRateLimit_HOUR   RouteRateLimit_Unit = 2
+)
+
+// Enum value maps for RouteRateLimit_Unit.
+var (
+	RouteRateLimit_Unit_name = map[int32]string{
+		0: "SECOND",
+		1: "MINUTE",
+		2: "HOUR",
+	}
+	RouteRateLimit_Unit_value = map[string]int32{
+		"SECOND": 0,
+		"MINUTE": 1,
+		"HOUR":   2,
+	}
+)
+
+func (x RouteRateLimit_Unit) Enum() *RouteRateLimit_Unit {
+	p := new(RouteRateLimit_Unit)
+	*p = x
+	return p
+}
+
+func (x RouteRateLimit_Unit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RouteRateLimit_Unit) Descriptor() protoreflect.EnumDescriptor {
+	return file_mlops_scheduler_scheduler_proto_enumTypes[2].Descriptor()
+}
+
+func (RouteRateLimit_Unit) Type() protoreflect.EnumType {
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[2]
+}
+
+func (x RouteRateLimit_Unit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RouteRateLimit_Unit.Descriptor instead.
+func (RouteRateLimit_Unit) EnumDescriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type ModelRolloutStatus_RolloutState int32
+
+const (
+	ModelRolloutStatus_RolloutProgressing ModelRolloutStatus_RolloutState = 0
+	ModelRolloutStatus_RolloutPaused      ModelRolloutStatus_RolloutState = 1
+	ModelRolloutStatus_RolloutRolledBack  ModelRolloutStatus_RolloutState = 2
+	ModelRolloutStatus_RolloutSucceeded   ModelRolloutStatus_RolloutState = 3
+)
+
+// Enum value maps for ModelRolloutStatus_RolloutState.
+var (
+	ModelRolloutStatus_RolloutState_name = map[int32]string{
+		0: "RolloutProgressing",
+		1: "RolloutPaused",
+		2: "RolloutRolledBack",
+		3: "RolloutSucceeded",
+	}
+	ModelRolloutStatus_RolloutState_value = map[string]int32{
+		"RolloutProgressing": 0,
+		"RolloutPaused":      1,
+		"RolloutRolledBack":  2,
+		"RolloutSucceeded":   3,
+	}
+)
+
+func (x ModelRolloutStatus_RolloutState) Enum() *ModelRolloutStatus_RolloutState {
+	p := new(ModelRolloutStatus_RolloutState)
+	*p = x
+	return p
+}
+
+func (x ModelRolloutStatus_RolloutState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModelRolloutStatus_RolloutState) Descriptor() protoreflect.EnumDescriptor {
+	return file_mlops_scheduler_scheduler_proto_enumTypes[3].Descriptor()
+}
+
+func (ModelRolloutStatus_RolloutState) Type() protoreflect.EnumType {
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[3]
+}
+
+func (x ModelRolloutStatus_RolloutState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModelRolloutStatus_RolloutState.Descriptor instead.
+func (ModelRolloutStatus_RolloutState) EnumDescriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{27, 0}
+}
+
 type ModelStatus_ModelState int32
 
 const (
@@ -125,11 +272,11 @@ func (x ModelStatus_ModelState) String() string {
 }
 
 func (ModelStatus_ModelState) Descriptor() protoreflect.EnumDescriptor {
-	return file_mlops_scheduler_scheduler_proto_enumTypes[1].Descriptor()
+	return file_mlops_scheduler_scheduler_proto_enumTypes[4].Descriptor()
 }
 
 func (ModelStatus_ModelState) Type() protoreflect.EnumType {
-	return &file_mlops_scheduler_scheduler_proto_enumTypes[1]
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[4]
 }
 
 func (x ModelStatus_ModelState) Number() protoreflect.EnumNumber {
@@ -138,7 +285,7 @@ func (x ModelStatus_ModelState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ModelStatus_ModelState.Descriptor instead.
 func (ModelStatus_ModelState) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{16, 0}
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{29, 0}
 }
 
 type ModelReplicaStatus_ModelReplicaState int32
@@ -204,11 +351,11 @@ func (x ModelReplicaStatus_ModelReplicaState) String() string {
 }
 
 func (ModelReplicaStatus_ModelReplicaState) Descriptor() protoreflect.EnumDescriptor {
-	return file_mlops_scheduler_scheduler_proto_enumTypes[2].Descriptor()
+	return file_mlops_scheduler_scheduler_proto_enumTypes[5].Descriptor()
 }
 
 func (ModelReplicaStatus_ModelReplicaState) Type() protoreflect.EnumType {
-	return &file_mlops_scheduler_scheduler_proto_enumTypes[2]
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[5]
 }
 
 func (x ModelReplicaStatus_ModelReplicaState) Number() protoreflect.EnumNumber {
@@ -217,7 +364,53 @@ func (x ModelReplicaStatus_ModelReplicaState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ModelReplicaStatus_ModelReplicaState.Descriptor instead.
 func (ModelReplicaStatus_ModelReplicaState) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{17, 0}
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{30, 0}
+}
+
+type BanditConfig_Policy int32
+
+const (
+	BanditConfig_EPSILON_GREEDY    BanditConfig_Policy = 0
+	BanditConfig_THOMPSON_SAMPLING BanditConfig_Policy = 1
+)
+
+// Enum value maps for BanditConfig_Policy.
+var (
+	BanditConfig_Policy_name = map[int32]string{
+		0: "EPSILON_GREEDY",
+		1: "THOMPSON_SAMPLING",
+	}
+	BanditConfig_Policy_value = map[string]int32{
+		"EPSILON_GREEDY":    0,
+		"THOMPSON_SAMPLING": 1,
+	}
+)
+
+func (x BanditConfig_Policy) Enum() *BanditConfig_Policy {
+	p := new(BanditConfig_Policy)
+	*p = x
+	return p
+}
+
+func (x BanditConfig_Policy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BanditConfig_Policy) Descriptor() protoreflect.EnumDescriptor {
+	return file_mlops_scheduler_scheduler_proto_enumTypes[6].Descriptor()
+}
+
+func (BanditConfig_Policy) Type() protoreflect.EnumType {
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[6]
+}
+
+func (x BanditConfig_Policy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BanditConfig_Policy.Descriptor instead.
+func (BanditConfig_Policy) EnumDescriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{48, 0}
 }
 
 type PipelineStep_JoinOp int32
@@ -253,11 +446,11 @@ func (x PipelineStep_JoinOp) String() string {
 }
 
 func (PipelineStep_JoinOp) Descriptor() protoreflect.EnumDescriptor {
-	return file_mlops_scheduler_scheduler_proto_enumTypes[3].Descriptor()
+	return file_mlops_scheduler_scheduler_proto_enumTypes[7].Descriptor()
 }
 
 func (PipelineStep_JoinOp) Type() protoreflect.EnumType {
-	return &file_mlops_scheduler_scheduler_proto_enumTypes[3]
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[7]
 }
 
 func (x PipelineStep_JoinOp) Number() protoreflect.EnumNumber {
@@ -266,7 +459,68 @@ func (x PipelineStep_JoinOp) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PipelineStep_JoinOp.Descriptor instead.
 func (PipelineStep_JoinOp) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{39, 0}
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{74, 0}
+}
+
+type PipelineTransform_TransformType int32
+
+const (
+	PipelineTransform_CONCAT   PipelineTransform_TransformType = 0
+	PipelineTransform_SPLIT    PipelineTransform_TransformType = 1
+	PipelineTransform_SELECT   PipelineTransform_TransformType = 2
+	PipelineTransform_CAST     PipelineTransform_TransformType = 3
+	PipelineTransform_RESHAPE  PipelineTransform_TransformType = 4
+	PipelineTransform_CONSTANT PipelineTransform_TransformType = 5
+	PipelineTransform_JSONPATH PipelineTransform_TransformType = 6
+)
+
+// Enum value maps for PipelineTransform_TransformType.
+var (
+	PipelineTransform_TransformType_name = map[int32]string{
+		0: "CONCAT",
+		1: "SPLIT",
+		2: "SELECT",
+		3: "CAST",
+		4: "RESHAPE",
+		5: "CONSTANT",
+		6: "JSONPATH",
+	}
+	PipelineTransform_TransformType_value = map[string]int32{
+		"CONCAT":   0,
+		"SPLIT":    1,
+		"SELECT":   2,
+		"CAST":     3,
+		"RESHAPE":  4,
+		"CONSTANT": 5,
+		"JSONPATH": 6,
+	}
+)
+
+func (x PipelineTransform_TransformType) Enum() *PipelineTransform_TransformType {
+	p := new(PipelineTransform_TransformType)
+	*p = x
+	return p
+}
+
+func (x PipelineTransform_TransformType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PipelineTransform_TransformType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mlops_scheduler_scheduler_proto_enumTypes[8].Descriptor()
+}
+
+func (PipelineTransform_TransformType) Type() protoreflect.EnumType {
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[8]
+}
+
+func (x PipelineTransform_TransformType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PipelineTransform_TransformType.Descriptor instead.
+func (PipelineTransform_TransformType) EnumDescriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{76, 0}
 }
 
 type PipelineInput_JoinOp int32
@@ -302,11 +556,11 @@ func (x PipelineInput_JoinOp) String() string {
 }
 
 func (PipelineInput_JoinOp) Descriptor() protoreflect.EnumDescriptor {
-	return file_mlops_scheduler_scheduler_proto_enumTypes[4].Descriptor()
+	return file_mlops_scheduler_scheduler_proto_enumTypes[9].Descriptor()
 }
 
 func (PipelineInput_JoinOp) Type() protoreflect.EnumType {
-	return &file_mlops_scheduler_scheduler_proto_enumTypes[4]
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[9]
 }
 
 func (x PipelineInput_JoinOp) Number() protoreflect.EnumNumber {
@@ -315,7 +569,7 @@ func (x PipelineInput_JoinOp) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PipelineInput_JoinOp.Descriptor instead.
 func (PipelineInput_JoinOp) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{41, 0}
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{78, 0}
 }
 
 type PipelineOutput_JoinOp int32
@@ -351,11 +605,11 @@ func (x PipelineOutput_JoinOp) String() string {
 }
 
 func (PipelineOutput_JoinOp) Descriptor() protoreflect.EnumDescriptor {
-	return file_mlops_scheduler_scheduler_proto_enumTypes[5].Descriptor()
+	return file_mlops_scheduler_scheduler_proto_enumTypes[10].Descriptor()
 }
 
 func (PipelineOutput_JoinOp) Type() protoreflect.EnumType {
-	return &file_mlops_scheduler_scheduler_proto_enumTypes[5]
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[10]
 }
 
 func (x PipelineOutput_JoinOp) Number() protoreflect.EnumNumber {
@@ -364,7 +618,105 @@ func (x PipelineOutput_JoinOp) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PipelineOutput_JoinOp.Descriptor instead.
 func (PipelineOutput_JoinOp) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{42, 0}
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{79, 0}
+}
+
+type PipelineKafkaTopic_Format int32
+
+const (
+	PipelineKafkaTopic_JSON  PipelineKafkaTopic_Format = 0
+	PipelineKafkaTopic_CSV   PipelineKafkaTopic_Format = 1
+	PipelineKafkaTopic_BYTES PipelineKafkaTopic_Format = 2
+)
+
+// Enum value maps for PipelineKafkaTopic_Format.
+var (
+	PipelineKafkaTopic_Format_name = map[int32]string{
+		0: "JSON",
+		1: "CSV",
+		2: "BYTES",
+	}
+	PipelineKafkaTopic_Format_value = map[string]int32{
+		"JSON":  0,
+		"CSV":   1,
+		"BYTES": 2,
+	}
+)
+
+func (x PipelineKafkaTopic_Format) Enum() *PipelineKafkaTopic_Format {
+	p := new(PipelineKafkaTopic_Format)
+	*p = x
+	return p
+}
+
+func (x PipelineKafkaTopic_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PipelineKafkaTopic_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_mlops_scheduler_scheduler_proto_enumTypes[11].Descriptor()
+}
+
+func (PipelineKafkaTopic_Format) Type() protoreflect.EnumType {
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[11]
+}
+
+func (x PipelineKafkaTopic_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PipelineKafkaTopic_Format.Descriptor instead.
+func (PipelineKafkaTopic_Format) EnumDescriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{80, 0}
+}
+
+type PipelineReplayStatusResponse_ReplayState int32
+
+const (
+	PipelineReplayStatusResponse_REPLAY_RUNNING   PipelineReplayStatusResponse_ReplayState = 0
+	PipelineReplayStatusResponse_REPLAY_SUCCEEDED PipelineReplayStatusResponse_ReplayState = 1
+	PipelineReplayStatusResponse_REPLAY_FAILED    PipelineReplayStatusResponse_ReplayState = 2
+)
+
+// Enum value maps for PipelineReplayStatusResponse_ReplayState.
+var (
+	PipelineReplayStatusResponse_ReplayState_name = map[int32]string{
+		0: "REPLAY_RUNNING",
+		1: "REPLAY_SUCCEEDED",
+		2: "REPLAY_FAILED",
+	}
+	PipelineReplayStatusResponse_ReplayState_value = map[string]int32{
+		"REPLAY_RUNNING":   0,
+		"REPLAY_SUCCEEDED": 1,
+		"REPLAY_FAILED":    2,
+	}
+)
+
+func (x PipelineReplayStatusResponse_ReplayState) Enum() *PipelineReplayStatusResponse_ReplayState {
+	p := new(PipelineReplayStatusResponse_ReplayState)
+	*p = x
+	return p
+}
+
+func (x PipelineReplayStatusResponse_ReplayState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PipelineReplayStatusResponse_ReplayState) Descriptor() protoreflect.EnumDescriptor {
+	return file_mlops_scheduler_scheduler_proto_enumTypes[12].Descriptor()
+}
+
+func (PipelineReplayStatusResponse_ReplayState) Type() protoreflect.EnumType {
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[12]
+}
+
+func (x PipelineReplayStatusResponse_ReplayState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PipelineReplayStatusResponse_ReplayState.Descriptor instead.
+func (PipelineReplayStatusResponse_ReplayState) EnumDescriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{90, 0}
 }
 
 type PipelineVersionState_PipelineStatus int32
@@ -415,11 +767,11 @@ func (x PipelineVersionState_PipelineStatus) String() string {
 }
 
 func (PipelineVersionState_PipelineStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_mlops_scheduler_scheduler_proto_enumTypes[6].Descriptor()
+	return file_mlops_scheduler_scheduler_proto_enumTypes[13].Descriptor()
 }
 
 func (PipelineVersionState_PipelineStatus) Type() protoreflect.EnumType {
-	return &file_mlops_scheduler_scheduler_proto_enumTypes[6]
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[13]
 }
 
 func (x PipelineVersionState_PipelineStatus) Number() protoreflect.EnumNumber {
@@ -428,7 +780,7 @@ func (x PipelineVersionState_PipelineStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PipelineVersionState_PipelineStatus.Descriptor instead.
 func (PipelineVersionState_PipelineStatus) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{50, 0}
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{97, 0}
 }
 
 type LoadModelRequest struct {
@@ -697,14 +1049,17 @@ type ModelSpec struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri             string           `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`                                // storage uri from where to download the artifacts
-	ArtifactVersion *uint32          `protobuf:"varint,2,opt,name=artifactVersion,proto3,oneof" json:"artifactVersion,omitempty"` // Optional v2 version folder to select
-	StorageConfig   *StorageConfig   `protobuf:"bytes,3,opt,name=storageConfig,proto3,oneof" json:"storageConfig,omitempty"`      // Storage auth configuration
-	Requirements    []string         `protobuf:"bytes,4,rep,name=requirements,proto3" json:"requirements,omitempty"`              // list of capabilities the server must satisfy to run this model
-	MemoryBytes     *uint64          `protobuf:"varint,5,opt,name=memoryBytes,proto3,oneof" json:"memoryBytes,omitempty"`         // Requested memory
-	Server          *string          `protobuf:"bytes,6,opt,name=server,proto3,oneof" json:"server,omitempty"`                    // the particular model server to load the model. If unspecified will be chosen.
-	Explainer       *ExplainerSpec   `protobuf:"bytes,7,opt,name=explainer,proto3,oneof" json:"explainer,omitempty"`              // optional black box explainer details
-	Parameters      []*ParameterSpec `protobuf:"bytes,8,rep,name=parameters,proto3" json:"parameters,omitempty"`                  // parameters to load with model
+	Uri             string               `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`                                // storage uri from where to download the artifacts
+	ArtifactVersion *uint32              `protobuf:"varint,2,opt,name=artifactVersion,proto3,oneof" json:"artifactVersion,omitempty"` // Optional v2 version folder to select
+	StorageConfig   *StorageConfig       `protobuf:"bytes,3,opt,name=storageConfig,proto3,oneof" json:"storageConfig,omitempty"`      // Storage auth configuration
+	Requirements    []string             `protobuf:"bytes,4,rep,name=requirements,proto3" json:"requirements,omitempty"`              // list of capabilities the server must satisfy to run this model
+	MemoryBytes     *uint64              `protobuf:"varint,5,opt,name=memoryBytes,proto3,oneof" json:"memoryBytes,omitempty"`         // Requested memory
+	Server          *string              `protobuf:"bytes,6,opt,name=server,proto3,oneof" json:"server,omitempty"`                    // the particular model server to load the model. If unspecified will be chosen.
+	Explainer       *ExplainerSpec       `protobuf:"bytes,7,opt,name=explainer,proto3,oneof" json:"explainer,omitempty"`              // optional black box explainer details
+	Parameters      []*ParameterSpec     `protobuf:"bytes,8,rep,name=parameters,proto3" json:"parameters,omitempty"`                  // parameters to load with model
+	TrafficPolicy   *TrafficPolicy       `protobuf:"bytes,9,opt,name=trafficPolicy,proto3,oneof" json:"trafficPolicy,omitempty"`      // Envoy settings for requests to the model
+	Authorization   *AuthorizationPolicy `protobuf:"bytes,10,opt,name=authorization,proto3,oneof" json:"authorization,omitempty"`     // callers allowed to send requests to the model
+	Rollout         *RolloutStrategy     `protobuf:"bytes,11,opt,name=rollout,proto3,oneof" json:"rollout,omitempty"`                 // gradual shift of traffic to new versions of the model
 }
 
 func (x *ModelSpec) Reset() {
@@ -795,17 +1150,41 @@ func (x *ModelSpec) GetParameters() []*ParameterSpec {
 	return nil
 }
 
-type ParameterSpec struct {
+func (x *ModelSpec) GetTrafficPolicy() *TrafficPolicy {
+	if x != nil {
+		return x.TrafficPolicy
+	}
+	return nil
+}
+
+func (x *ModelSpec) GetAuthorization() *AuthorizationPolicy {
+	if x != nil {
+		return x.Authorization
+	}
+	return nil
+}
+
+func (x *ModelSpec) GetRollout() *RolloutStrategy {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+// Gradual shift of traffic from the last available version of a model to a new version. Each step sends
+// trafficPercent of requests to the new version for holdSeconds once it is available, checking the analysis
+// criteria before moving to the next step. All traffic moves to the new version after the last step.
+type RolloutStrategy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Steps    []*RolloutStep   `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	Analysis *RolloutAnalysis `protobuf:"bytes,2,opt,name=analysis,proto3,oneof" json:"analysis,omitempty"`
 }
 
-func (x *ParameterSpec) Reset() {
-	*x = ParameterSpec{}
+func (x *RolloutStrategy) Reset() {
+	*x = RolloutStrategy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -813,13 +1192,13 @@ func (x *ParameterSpec) Reset() {
 	}
 }
 
-func (x *ParameterSpec) String() string {
+func (x *RolloutStrategy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParameterSpec) ProtoMessage() {}
+func (*RolloutStrategy) ProtoMessage() {}
 
-func (x *ParameterSpec) ProtoReflect() protoreflect.Message {
+func (x *RolloutStrategy) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -831,38 +1210,36 @@ func (x *ParameterSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParameterSpec.ProtoReflect.Descriptor instead.
-func (*ParameterSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use RolloutStrategy.ProtoReflect.Descriptor instead.
+func (*RolloutStrategy) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ParameterSpec) GetName() string {
+func (x *RolloutStrategy) GetSteps() []*RolloutStep {
 	if x != nil {
-		return x.Name
+		return x.Steps
 	}
-	return ""
+	return nil
 }
 
-func (x *ParameterSpec) GetValue() string {
+func (x *RolloutStrategy) GetAnalysis() *RolloutAnalysis {
 	if x != nil {
-		return x.Value
+		return x.Analysis
 	}
-	return ""
+	return nil
 }
 
-type ExplainerSpec struct {
+type RolloutStep struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	// 1 of semantic either model or pipeline reference
-	ModelRef    *string `protobuf:"bytes,2,opt,name=modelRef,proto3,oneof" json:"modelRef,omitempty"`
-	PipelineRef *string `protobuf:"bytes,3,opt,name=pipelineRef,proto3,oneof" json:"pipelineRef,omitempty"`
+	TrafficPercent uint32 `protobuf:"varint,1,opt,name=trafficPercent,proto3" json:"trafficPercent,omitempty"` // percentage of requests sent to the new version, 1-100
+	HoldSeconds    uint32 `protobuf:"varint,2,opt,name=holdSeconds,proto3" json:"holdSeconds,omitempty"`       // time to stay at this step before moving to the next
 }
 
-func (x *ExplainerSpec) Reset() {
-	*x = ExplainerSpec{}
+func (x *RolloutStep) Reset() {
+	*x = RolloutStep{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -870,13 +1247,13 @@ func (x *ExplainerSpec) Reset() {
 	}
 }
 
-func (x *ExplainerSpec) String() string {
+func (x *RolloutStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExplainerSpec) ProtoMessage() {}
+func (*RolloutStep) ProtoMessage() {}
 
-func (x *ExplainerSpec) ProtoReflect() protoreflect.Message {
+func (x *RolloutStep) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -888,43 +1265,39 @@ func (x *ExplainerSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExplainerSpec.ProtoReflect.Descriptor instead.
-func (*ExplainerSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use RolloutStep.ProtoReflect.Descriptor instead.
+func (*RolloutStep) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ExplainerSpec) GetType() string {
+func (x *RolloutStep) GetTrafficPercent() uint32 {
 	if x != nil {
-		return x.Type
+		return x.TrafficPercent
 	}
-	return ""
+	return 0
 }
 
-func (x *ExplainerSpec) GetModelRef() string {
-	if x != nil && x.ModelRef != nil {
-		return *x.ModelRef
+func (x *RolloutStep) GetHoldSeconds() uint32 {
+	if x != nil {
+		return x.HoldSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *ExplainerSpec) GetPipelineRef() string {
-	if x != nil && x.PipelineRef != nil {
-		return *x.PipelineRef
-	}
-	return ""
-}
-
-type KubernetesMeta struct {
+// Success criteria for each rollout step, checked from the agent Prometheus metrics of the new version
+type RolloutAnalysis struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Generation int64  `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
+	MaxErrorRate  *float32                      `protobuf:"fixed32,1,opt,name=maxErrorRate,proto3,oneof" json:"maxErrorRate,omitempty"` // max fraction of requests failing with a non 2xx or OK code
+	MaxLatencyMs  *uint32                       `protobuf:"varint,2,opt,name=maxLatencyMs,proto3,oneof" json:"maxLatencyMs,omitempty"`  // max mean request latency
+	MinRequests   uint32                        `protobuf:"varint,3,opt,name=minRequests,proto3" json:"minRequests,omitempty"`          // requests to the new version needed in a step before the criteria are checked
+	FailureAction RolloutAnalysis_FailureAction `protobuf:"varint,4,opt,name=failureAction,proto3,enum=seldon.mlops.scheduler.RolloutAnalysis_FailureAction" json:"failureAction,omitempty"`
 }
 
-func (x *KubernetesMeta) Reset() {
-	*x = KubernetesMeta{}
+func (x *RolloutAnalysis) Reset() {
+	*x = RolloutAnalysis{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -932,13 +1305,13 @@ func (x *KubernetesMeta) Reset() {
 	}
 }
 
-func (x *KubernetesMeta) String() string {
+func (x *RolloutAnalysis) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KubernetesMeta) ProtoMessage() {}
+func (*RolloutAnalysis) ProtoMessage() {}
 
-func (x *KubernetesMeta) ProtoReflect() protoreflect.Message {
+func (x *RolloutAnalysis) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -950,36 +1323,52 @@ func (x *KubernetesMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KubernetesMeta.ProtoReflect.Descriptor instead.
-func (*KubernetesMeta) Descriptor() ([]byte, []int) {
+// Deprecated: Use RolloutAnalysis.ProtoReflect.Descriptor instead.
+func (*RolloutAnalysis) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *KubernetesMeta) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+func (x *RolloutAnalysis) GetMaxErrorRate() float32 {
+	if x != nil && x.MaxErrorRate != nil {
+		return *x.MaxErrorRate
 	}
-	return ""
+	return 0
 }
 
-func (x *KubernetesMeta) GetGeneration() int64 {
+func (x *RolloutAnalysis) GetMaxLatencyMs() uint32 {
+	if x != nil && x.MaxLatencyMs != nil {
+		return *x.MaxLatencyMs
+	}
+	return 0
+}
+
+func (x *RolloutAnalysis) GetMinRequests() uint32 {
 	if x != nil {
-		return x.Generation
+		return x.MinRequests
 	}
 	return 0
 }
 
-type StreamSpec struct {
+func (x *RolloutAnalysis) GetFailureAction() RolloutAnalysis_FailureAction {
+	if x != nil {
+		return x.FailureAction
+	}
+	return RolloutAnalysis_ROLLBACK
+}
+
+// Callers allowed to send requests to a model or pipeline, matched against the subject and scopes of their JWT
+// or API key. A caller is allowed if it has any of the subjects or any of the scopes.
+type AuthorizationPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InputTopic  string `protobuf:"bytes,2,opt,name=inputTopic,proto3" json:"inputTopic,omitempty"`
-	OutputTopic string `protobuf:"bytes,3,opt,name=outputTopic,proto3" json:"outputTopic,omitempty"`
+	Subjects []string `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	Scopes   []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
 }
 
-func (x *StreamSpec) Reset() {
-	*x = StreamSpec{}
+func (x *AuthorizationPolicy) Reset() {
+	*x = AuthorizationPolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -987,13 +1376,13 @@ func (x *StreamSpec) Reset() {
 	}
 }
 
-func (x *StreamSpec) String() string {
+func (x *AuthorizationPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamSpec) ProtoMessage() {}
+func (*AuthorizationPolicy) ProtoMessage() {}
 
-func (x *StreamSpec) ProtoReflect() protoreflect.Message {
+func (x *AuthorizationPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1005,39 +1394,40 @@ func (x *StreamSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamSpec.ProtoReflect.Descriptor instead.
-func (*StreamSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthorizationPolicy.ProtoReflect.Descriptor instead.
+func (*AuthorizationPolicy) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *StreamSpec) GetInputTopic() string {
+func (x *AuthorizationPolicy) GetSubjects() []string {
 	if x != nil {
-		return x.InputTopic
+		return x.Subjects
 	}
-	return ""
+	return nil
 }
 
-func (x *StreamSpec) GetOutputTopic() string {
+func (x *AuthorizationPolicy) GetScopes() []string {
 	if x != nil {
-		return x.OutputTopic
+		return x.Scopes
 	}
-	return ""
+	return nil
 }
 
-type StorageConfig struct {
+// Envoy settings for requests to a model or pipeline. Unset fields keep the Envoy defaults.
+type TrafficPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Config:
-	//
-	//	*StorageConfig_StorageSecretName
-	//	*StorageConfig_StorageRcloneConfig
-	Config isStorageConfig_Config `protobuf_oneof:"config"`
+	TimeoutMs        *uint32           `protobuf:"varint,1,opt,name=timeoutMs,proto3,oneof" json:"timeoutMs,omitempty"` // request timeout, no timeout if unset or zero
+	Retry            *RetryPolicy      `protobuf:"bytes,2,opt,name=retry,proto3,oneof" json:"retry,omitempty"`
+	CircuitBreaker   *CircuitBreaker   `protobuf:"bytes,3,opt,name=circuitBreaker,proto3,oneof" json:"circuitBreaker,omitempty"`
+	OutlierDetection *OutlierDetection `protobuf:"bytes,4,opt,name=outlierDetection,proto3,oneof" json:"outlierDetection,omitempty"`
+	RateLimit        *RouteRateLimit   `protobuf:"bytes,5,opt,name=rateLimit,proto3,oneof" json:"rateLimit,omitempty"`
 }
 
-func (x *StorageConfig) Reset() {
-	*x = StorageConfig{}
+func (x *TrafficPolicy) Reset() {
+	*x = TrafficPolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1045,13 +1435,13 @@ func (x *StorageConfig) Reset() {
 	}
 }
 
-func (x *StorageConfig) String() string {
+func (x *TrafficPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageConfig) ProtoMessage() {}
+func (*TrafficPolicy) ProtoMessage() {}
 
-func (x *StorageConfig) ProtoReflect() protoreflect.Message {
+func (x *TrafficPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1063,56 +1453,58 @@ func (x *StorageConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageConfig.ProtoReflect.Descriptor instead.
-func (*StorageConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrafficPolicy.ProtoReflect.Descriptor instead.
+func (*TrafficPolicy) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{9}
 }
 
-func (m *StorageConfig) GetConfig() isStorageConfig_Config {
-	if m != nil {
-		return m.Config
+func (x *TrafficPolicy) GetTimeoutMs() uint32 {
+	if x != nil && x.TimeoutMs != nil {
+		return *x.TimeoutMs
 	}
-	return nil
+	return 0
 }
 
-func (x *StorageConfig) GetStorageSecretName() string {
-	if x, ok := x.GetConfig().(*StorageConfig_StorageSecretName); ok {
-		return x.StorageSecretName
+func (x *TrafficPolicy) GetRetry() *RetryPolicy {
+	if x != nil {
+		return x.Retry
 	}
-	return ""
+	return nil
 }
 
-func (x *StorageConfig) GetStorageRcloneConfig() string {
-	if x, ok := x.GetConfig().(*StorageConfig_StorageRcloneConfig); ok {
-		return x.StorageRcloneConfig
+func (x *TrafficPolicy) GetCircuitBreaker() *CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreaker
 	}
-	return ""
-}
-
-type isStorageConfig_Config interface {
-	isStorageConfig_Config()
+	return nil
 }
 
-type StorageConfig_StorageSecretName struct {
-	StorageSecretName string `protobuf:"bytes,1,opt,name=storageSecretName,proto3,oneof"`
+func (x *TrafficPolicy) GetOutlierDetection() *OutlierDetection {
+	if x != nil {
+		return x.OutlierDetection
+	}
+	return nil
 }
 
-type StorageConfig_StorageRcloneConfig struct {
-	StorageRcloneConfig string `protobuf:"bytes,2,opt,name=storageRcloneConfig,proto3,oneof"`
+func (x *TrafficPolicy) GetRateLimit() *RouteRateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
 }
 
-func (*StorageConfig_StorageSecretName) isStorageConfig_Config() {}
-
-func (*StorageConfig_StorageRcloneConfig) isStorageConfig_Config() {}
-
-type LoadModelResponse struct {
+type RetryPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	RetryOn         string  `protobuf:"bytes,1,opt,name=retryOn,proto3" json:"retryOn,omitempty"` // comma separated Envoy retry conditions, e.g. 5xx,reset,connect-failure
+	NumRetries      uint32  `protobuf:"varint,2,opt,name=numRetries,proto3" json:"numRetries,omitempty"`
+	PerTryTimeoutMs *uint32 `protobuf:"varint,3,opt,name=perTryTimeoutMs,proto3,oneof" json:"perTryTimeoutMs,omitempty"`
 }
 
-func (x *LoadModelResponse) Reset() {
-	*x = LoadModelResponse{}
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1120,13 +1512,13 @@ func (x *LoadModelResponse) Reset() {
 	}
 }
 
-func (x *LoadModelResponse) String() string {
+func (x *RetryPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadModelResponse) ProtoMessage() {}
+func (*RetryPolicy) ProtoMessage() {}
 
-func (x *LoadModelResponse) ProtoReflect() protoreflect.Message {
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1138,23 +1530,44 @@ func (x *LoadModelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadModelResponse.ProtoReflect.Descriptor instead.
-func (*LoadModelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{10}
 }
 
-// ModelReference represents a unique model
-type ModelReference struct {
+func (x *RetryPolicy) GetRetryOn() string {
+	if x != nil {
+		return x.RetryOn
+	}
+	return ""
+}
+
+func (x *RetryPolicy) GetNumRetries() uint32 {
+	if x != nil {
+		return x.NumRetries
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetPerTryTimeoutMs() uint32 {
+	if x != nil && x.PerTryTimeoutMs != nil {
+		return *x.PerTryTimeoutMs
+	}
+	return 0
+}
+
+// Limits on the connections and requests to the replicas, requests above the limits fail immediately
+type CircuitBreaker struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version *uint32 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	MaxConnections     *uint32 `protobuf:"varint,1,opt,name=maxConnections,proto3,oneof" json:"maxConnections,omitempty"`
+	MaxPendingRequests *uint32 `protobuf:"varint,2,opt,name=maxPendingRequests,proto3,oneof" json:"maxPendingRequests,omitempty"`
 }
 
-func (x *ModelReference) Reset() {
-	*x = ModelReference{}
+func (x *CircuitBreaker) Reset() {
+	*x = CircuitBreaker{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1162,13 +1575,13 @@ func (x *ModelReference) Reset() {
 	}
 }
 
-func (x *ModelReference) String() string {
+func (x *CircuitBreaker) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelReference) ProtoMessage() {}
+func (*CircuitBreaker) ProtoMessage() {}
 
-func (x *ModelReference) ProtoReflect() protoreflect.Message {
+func (x *CircuitBreaker) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1180,36 +1593,39 @@ func (x *ModelReference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelReference.ProtoReflect.Descriptor instead.
-func (*ModelReference) Descriptor() ([]byte, []int) {
+// Deprecated: Use CircuitBreaker.ProtoReflect.Descriptor instead.
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ModelReference) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *CircuitBreaker) GetMaxConnections() uint32 {
+	if x != nil && x.MaxConnections != nil {
+		return *x.MaxConnections
 	}
-	return ""
+	return 0
 }
 
-func (x *ModelReference) GetVersion() uint32 {
-	if x != nil && x.Version != nil {
-		return *x.Version
+func (x *CircuitBreaker) GetMaxPendingRequests() uint32 {
+	if x != nil && x.MaxPendingRequests != nil {
+		return *x.MaxPendingRequests
 	}
 	return 0
 }
 
-type UnloadModelRequest struct {
+// Ejects replicas returning consecutive errors from the load balancing pool
+type OutlierDetection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Model          *ModelReference `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
-	KubernetesMeta *KubernetesMeta `protobuf:"bytes,2,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
+	ConsecutiveErrors  uint32  `protobuf:"varint,1,opt,name=consecutiveErrors,proto3" json:"consecutiveErrors,omitempty"`
+	IntervalMs         *uint32 `protobuf:"varint,2,opt,name=intervalMs,proto3,oneof" json:"intervalMs,omitempty"`
+	BaseEjectionTimeMs *uint32 `protobuf:"varint,3,opt,name=baseEjectionTimeMs,proto3,oneof" json:"baseEjectionTimeMs,omitempty"`
+	MaxEjectionPercent *uint32 `protobuf:"varint,4,opt,name=maxEjectionPercent,proto3,oneof" json:"maxEjectionPercent,omitempty"`
 }
 
-func (x *UnloadModelRequest) Reset() {
-	*x = UnloadModelRequest{}
+func (x *OutlierDetection) Reset() {
+	*x = OutlierDetection{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1217,13 +1633,13 @@ func (x *UnloadModelRequest) Reset() {
 	}
 }
 
-func (x *UnloadModelRequest) String() string {
+func (x *OutlierDetection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnloadModelRequest) ProtoMessage() {}
+func (*OutlierDetection) ProtoMessage() {}
 
-func (x *UnloadModelRequest) ProtoReflect() protoreflect.Message {
+func (x *OutlierDetection) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1235,33 +1651,55 @@ func (x *UnloadModelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnloadModelRequest.ProtoReflect.Descriptor instead.
-func (*UnloadModelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutlierDetection.ProtoReflect.Descriptor instead.
+func (*OutlierDetection) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UnloadModelRequest) GetModel() *ModelReference {
+func (x *OutlierDetection) GetConsecutiveErrors() uint32 {
 	if x != nil {
-		return x.Model
+		return x.ConsecutiveErrors
 	}
-	return nil
+	return 0
 }
 
-func (x *UnloadModelRequest) GetKubernetesMeta() *KubernetesMeta {
-	if x != nil {
-		return x.KubernetesMeta
+func (x *OutlierDetection) GetIntervalMs() uint32 {
+	if x != nil && x.IntervalMs != nil {
+		return *x.IntervalMs
 	}
-	return nil
+	return 0
 }
 
-type UnloadModelResponse struct {
+func (x *OutlierDetection) GetBaseEjectionTimeMs() uint32 {
+	if x != nil && x.BaseEjectionTimeMs != nil {
+		return *x.BaseEjectionTimeMs
+	}
+	return 0
+}
+
+func (x *OutlierDetection) GetMaxEjectionPercent() uint32 {
+	if x != nil && x.MaxEjectionPercent != nil {
+		return *x.MaxEjectionPercent
+	}
+	return 0
+}
+
+// Limits on requests to a model or pipeline route, requests above the limits get a 429 from Envoy
+type RouteRateLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Unit     RouteRateLimit_Unit `protobuf:"varint,1,opt,name=unit,proto3,enum=seldon.mlops.scheduler.RouteRateLimit_Unit" json:"unit,omitempty"` // period of the route limit and quotas
+	Requests *uint32             `protobuf:"varint,2,opt,name=requests,proto3,oneof" json:"requests,omitempty"`                                   // limit on requests to the route per unit
+	Burst    *uint32             `protobuf:"varint,3,opt,name=burst,proto3,oneof" json:"burst,omitempty"`                                         // defaults to requests
+	Header   *string             `protobuf:"bytes,4,opt,name=header,proto3,oneof" json:"header,omitempty"`                                        // request header identifying the caller, e.g. a tenant or API key
+	Quotas   []*RateLimitQuota   `protobuf:"bytes,5,rep,name=quotas,proto3" json:"quotas,omitempty"`                                              // limits for callers with the given header values
+	Global   bool                `protobuf:"varint,6,opt,name=global,proto3" json:"global,omitempty"`                                             // also check requests with the global rate limit service if configured
 }
 
-func (x *UnloadModelResponse) Reset() {
-	*x = UnloadModelResponse{}
+func (x *RouteRateLimit) Reset() {
+	*x = RouteRateLimit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1269,13 +1707,13 @@ func (x *UnloadModelResponse) Reset() {
 	}
 }
 
-func (x *UnloadModelResponse) String() string {
+func (x *RouteRateLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnloadModelResponse) ProtoMessage() {}
+func (*RouteRateLimit) ProtoMessage() {}
 
-func (x *UnloadModelResponse) ProtoReflect() protoreflect.Message {
+func (x *RouteRateLimit) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1287,24 +1725,65 @@ func (x *UnloadModelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnloadModelResponse.ProtoReflect.Descriptor instead.
-func (*UnloadModelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RouteRateLimit.ProtoReflect.Descriptor instead.
+func (*RouteRateLimit) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{13}
 }
 
-// ModelStatusResponse provides the current assignment of the model onto a server
-type ModelStatusResponse struct {
+func (x *RouteRateLimit) GetUnit() RouteRateLimit_Unit {
+	if x != nil {
+		return x.Unit
+	}
+	return RouteRateLimit_SECOND
+}
+
+func (x *RouteRateLimit) GetRequests() uint32 {
+	if x != nil && x.Requests != nil {
+		return *x.Requests
+	}
+	return 0
+}
+
+func (x *RouteRateLimit) GetBurst() uint32 {
+	if x != nil && x.Burst != nil {
+		return *x.Burst
+	}
+	return 0
+}
+
+func (x *RouteRateLimit) GetHeader() string {
+	if x != nil && x.Header != nil {
+		return *x.Header
+	}
+	return ""
+}
+
+func (x *RouteRateLimit) GetQuotas() []*RateLimitQuota {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+func (x *RouteRateLimit) GetGlobal() bool {
+	if x != nil {
+		return x.Global
+	}
+	return false
+}
+
+type RateLimitQuota struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ModelName string                `protobuf:"bytes,1,opt,name=modelName,proto3" json:"modelName,omitempty"`
-	Versions  []*ModelVersionStatus `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
-	Deleted   bool                  `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Value    string  `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`        // value of the caller header
+	Requests uint32  `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"` // per unit
+	Burst    *uint32 `protobuf:"varint,3,opt,name=burst,proto3,oneof" json:"burst,omitempty"` // defaults to requests
 }
 
-func (x *ModelStatusResponse) Reset() {
-	*x = ModelStatusResponse{}
+func (x *RateLimitQuota) Reset() {
+	*x = RateLimitQuota{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1312,13 +1791,13 @@ func (x *ModelStatusResponse) Reset() {
 	}
 }
 
-func (x *ModelStatusResponse) String() string {
+func (x *RateLimitQuota) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelStatusResponse) ProtoMessage() {}
+func (*RateLimitQuota) ProtoMessage() {}
 
-func (x *ModelStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *RateLimitQuota) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1330,47 +1809,43 @@ func (x *ModelStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelStatusResponse.ProtoReflect.Descriptor instead.
-func (*ModelStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RateLimitQuota.ProtoReflect.Descriptor instead.
+func (*RateLimitQuota) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ModelStatusResponse) GetModelName() string {
+func (x *RateLimitQuota) GetValue() string {
 	if x != nil {
-		return x.ModelName
+		return x.Value
 	}
 	return ""
 }
 
-func (x *ModelStatusResponse) GetVersions() []*ModelVersionStatus {
+func (x *RateLimitQuota) GetRequests() uint32 {
 	if x != nil {
-		return x.Versions
+		return x.Requests
 	}
-	return nil
+	return 0
 }
 
-func (x *ModelStatusResponse) GetDeleted() bool {
-	if x != nil {
-		return x.Deleted
+func (x *RateLimitQuota) GetBurst() uint32 {
+	if x != nil && x.Burst != nil {
+		return *x.Burst
 	}
-	return false
+	return 0
 }
 
-type ModelVersionStatus struct {
+type ParameterSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version           uint32                        `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	ServerName        string                        `protobuf:"bytes,3,opt,name=serverName,proto3" json:"serverName,omitempty"`
-	KubernetesMeta    *KubernetesMeta               `protobuf:"bytes,4,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
-	ModelReplicaState map[int32]*ModelReplicaStatus `protobuf:"bytes,5,rep,name=modelReplicaState,proto3" json:"modelReplicaState,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	State             *ModelStatus                  `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
-	ModelDefn         *Model                        `protobuf:"bytes,7,opt,name=modelDefn,proto3,oneof" json:"modelDefn,omitempty"`
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *ModelVersionStatus) Reset() {
-	*x = ModelVersionStatus{}
+func (x *ParameterSpec) Reset() {
+	*x = ParameterSpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1378,13 +1853,13 @@ func (x *ModelVersionStatus) Reset() {
 	}
 }
 
-func (x *ModelVersionStatus) String() string {
+func (x *ParameterSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelVersionStatus) ProtoMessage() {}
+func (*ParameterSpec) ProtoMessage() {}
 
-func (x *ModelVersionStatus) ProtoReflect() protoreflect.Message {
+func (x *ParameterSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1396,67 +1871,38 @@ func (x *ModelVersionStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelVersionStatus.ProtoReflect.Descriptor instead.
-func (*ModelVersionStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use ParameterSpec.ProtoReflect.Descriptor instead.
+func (*ParameterSpec) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ModelVersionStatus) GetVersion() uint32 {
+func (x *ParameterSpec) GetName() string {
 	if x != nil {
-		return x.Version
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ModelVersionStatus) GetServerName() string {
+func (x *ParameterSpec) GetValue() string {
 	if x != nil {
-		return x.ServerName
+		return x.Value
 	}
 	return ""
 }
 
-func (x *ModelVersionStatus) GetKubernetesMeta() *KubernetesMeta {
-	if x != nil {
-		return x.KubernetesMeta
-	}
-	return nil
-}
-
-func (x *ModelVersionStatus) GetModelReplicaState() map[int32]*ModelReplicaStatus {
-	if x != nil {
-		return x.ModelReplicaState
-	}
-	return nil
-}
-
-func (x *ModelVersionStatus) GetState() *ModelStatus {
-	if x != nil {
-		return x.State
-	}
-	return nil
-}
-
-func (x *ModelVersionStatus) GetModelDefn() *Model {
-	if x != nil {
-		return x.ModelDefn
-	}
-	return nil
-}
-
-type ModelStatus struct {
+type ExplainerSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State               ModelStatus_ModelState `protobuf:"varint,1,opt,name=state,proto3,enum=seldon.mlops.scheduler.ModelStatus_ModelState" json:"state,omitempty"`
-	Reason              string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	AvailableReplicas   uint32                 `protobuf:"varint,3,opt,name=availableReplicas,proto3" json:"availableReplicas,omitempty"`
-	UnavailableReplicas uint32                 `protobuf:"varint,4,opt,name=unavailableReplicas,proto3" json:"unavailableReplicas,omitempty"`
-	LastChangeTimestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=lastChangeTimestamp,proto3" json:"lastChangeTimestamp,omitempty"`
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// 1 of semantic either model or pipeline reference
+	ModelRef    *string `protobuf:"bytes,2,opt,name=modelRef,proto3,oneof" json:"modelRef,omitempty"`
+	PipelineRef *string `protobuf:"bytes,3,opt,name=pipelineRef,proto3,oneof" json:"pipelineRef,omitempty"`
 }
 
-func (x *ModelStatus) Reset() {
-	*x = ModelStatus{}
+func (x *ExplainerSpec) Reset() {
+	*x = ExplainerSpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1464,13 +1910,13 @@ func (x *ModelStatus) Reset() {
 	}
 }
 
-func (x *ModelStatus) String() string {
+func (x *ExplainerSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelStatus) ProtoMessage() {}
+func (*ExplainerSpec) ProtoMessage() {}
 
-func (x *ModelStatus) ProtoReflect() protoreflect.Message {
+func (x *ExplainerSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1482,58 +1928,43 @@ func (x *ModelStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelStatus.ProtoReflect.Descriptor instead.
-func (*ModelStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExplainerSpec.ProtoReflect.Descriptor instead.
+func (*ExplainerSpec) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ModelStatus) GetState() ModelStatus_ModelState {
-	if x != nil {
-		return x.State
-	}
-	return ModelStatus_ModelStateUnknown
-}
-
-func (x *ModelStatus) GetReason() string {
+func (x *ExplainerSpec) GetType() string {
 	if x != nil {
-		return x.Reason
+		return x.Type
 	}
 	return ""
 }
 
-func (x *ModelStatus) GetAvailableReplicas() uint32 {
-	if x != nil {
-		return x.AvailableReplicas
-	}
-	return 0
-}
-
-func (x *ModelStatus) GetUnavailableReplicas() uint32 {
-	if x != nil {
-		return x.UnavailableReplicas
+func (x *ExplainerSpec) GetModelRef() string {
+	if x != nil && x.ModelRef != nil {
+		return *x.ModelRef
 	}
-	return 0
+	return ""
 }
 
-func (x *ModelStatus) GetLastChangeTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastChangeTimestamp
+func (x *ExplainerSpec) GetPipelineRef() string {
+	if x != nil && x.PipelineRef != nil {
+		return *x.PipelineRef
 	}
-	return nil
+	return ""
 }
 
-type ModelReplicaStatus struct {
+type KubernetesMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State               ModelReplicaStatus_ModelReplicaState `protobuf:"varint,1,opt,name=state,proto3,enum=seldon.mlops.scheduler.ModelReplicaStatus_ModelReplicaState" json:"state,omitempty"`
-	Reason              string                               `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	LastChangeTimestamp *timestamppb.Timestamp               `protobuf:"bytes,3,opt,name=lastChangeTimestamp,proto3" json:"lastChangeTimestamp,omitempty"`
+	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Generation int64  `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
 }
 
-func (x *ModelReplicaStatus) Reset() {
-	*x = ModelReplicaStatus{}
+func (x *KubernetesMeta) Reset() {
+	*x = KubernetesMeta{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1541,13 +1972,13 @@ func (x *ModelReplicaStatus) Reset() {
 	}
 }
 
-func (x *ModelReplicaStatus) String() string {
+func (x *KubernetesMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelReplicaStatus) ProtoMessage() {}
+func (*KubernetesMeta) ProtoMessage() {}
 
-func (x *ModelReplicaStatus) ProtoReflect() protoreflect.Message {
+func (x *KubernetesMeta) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1559,43 +1990,38 @@ func (x *ModelReplicaStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelReplicaStatus.ProtoReflect.Descriptor instead.
-func (*ModelReplicaStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use KubernetesMeta.ProtoReflect.Descriptor instead.
+func (*KubernetesMeta) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ModelReplicaStatus) GetState() ModelReplicaStatus_ModelReplicaState {
-	if x != nil {
-		return x.State
-	}
-	return ModelReplicaStatus_ModelReplicaStateUnknown
-}
-
-func (x *ModelReplicaStatus) GetReason() string {
+func (x *KubernetesMeta) GetNamespace() string {
 	if x != nil {
-		return x.Reason
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *ModelReplicaStatus) GetLastChangeTimestamp() *timestamppb.Timestamp {
+func (x *KubernetesMeta) GetGeneration() int64 {
 	if x != nil {
-		return x.LastChangeTimestamp
+		return x.Generation
 	}
-	return nil
+	return 0
 }
 
-type ServerStatusRequest struct {
+type StreamSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriberName string  `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
-	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"` // Leave empty for all servers
+	InputTopic  string            `protobuf:"bytes,2,opt,name=inputTopic,proto3" json:"inputTopic,omitempty"`
+	OutputTopic string            `protobuf:"bytes,3,opt,name=outputTopic,proto3" json:"outputTopic,omitempty"`
+	RateLimit   *StreamRateLimit  `protobuf:"bytes,4,opt,name=rateLimit,proto3,oneof" json:"rateLimit,omitempty"`     // limit on requests consumed by the model gateway
+	TopicConfig *KafkaTopicConfig `protobuf:"bytes,5,opt,name=topicConfig,proto3,oneof" json:"topicConfig,omitempty"` // settings for the model topics
 }
 
-func (x *ServerStatusRequest) Reset() {
-	*x = ServerStatusRequest{}
+func (x *StreamSpec) Reset() {
+	*x = StreamSpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1603,13 +2029,13 @@ func (x *ServerStatusRequest) Reset() {
 	}
 }
 
-func (x *ServerStatusRequest) String() string {
+func (x *StreamSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerStatusRequest) ProtoMessage() {}
+func (*StreamSpec) ProtoMessage() {}
 
-func (x *ServerStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1621,41 +2047,54 @@ func (x *ServerStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerStatusRequest.ProtoReflect.Descriptor instead.
-func (*ServerStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamSpec.ProtoReflect.Descriptor instead.
+func (*StreamSpec) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ServerStatusRequest) GetSubscriberName() string {
+func (x *StreamSpec) GetInputTopic() string {
 	if x != nil {
-		return x.SubscriberName
+		return x.InputTopic
 	}
 	return ""
 }
 
-func (x *ServerStatusRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *StreamSpec) GetOutputTopic() string {
+	if x != nil {
+		return x.OutputTopic
 	}
 	return ""
 }
 
-// ServerStatusResponse provides details of current server status
-type ServerStatusResponse struct {
+func (x *StreamSpec) GetRateLimit() *StreamRateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+func (x *StreamSpec) GetTopicConfig() *KafkaTopicConfig {
+	if x != nil {
+		return x.TopicConfig
+	}
+	return nil
+}
+
+// Kafka settings for the topics of a model or pipeline. Unset fields use the global defaults.
+type KafkaTopicConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServerName             string                    `protobuf:"bytes,1,opt,name=serverName,proto3" json:"serverName,omitempty"`
-	Resources              []*ServerReplicaResources `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`
-	ExpectedReplicas       int32                     `protobuf:"varint,3,opt,name=expectedReplicas,proto3" json:"expectedReplicas,omitempty"`
-	AvailableReplicas      int32                     `protobuf:"varint,4,opt,name=availableReplicas,proto3" json:"availableReplicas,omitempty"`
-	NumLoadedModelReplicas int32                     `protobuf:"varint,5,opt,name=numLoadedModelReplicas,proto3" json:"numLoadedModelReplicas,omitempty"`
-	KubernetesMeta         *KubernetesMeta           `protobuf:"bytes,6,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
+	Partitions        *uint32 `protobuf:"varint,1,opt,name=partitions,proto3,oneof" json:"partitions,omitempty"`
+	ReplicationFactor *uint32 `protobuf:"varint,2,opt,name=replicationFactor,proto3,oneof" json:"replicationFactor,omitempty"` // only applied when topics are created
+	RetentionMs       *int64  `protobuf:"varint,3,opt,name=retentionMs,proto3,oneof" json:"retentionMs,omitempty"`
+	CleanupPolicy     *string `protobuf:"bytes,4,opt,name=cleanupPolicy,proto3,oneof" json:"cleanupPolicy,omitempty"`     // delete, compact or compact,delete
+	CompressionType   *string `protobuf:"bytes,5,opt,name=compressionType,proto3,oneof" json:"compressionType,omitempty"` // uncompressed, producer, gzip, snappy, lz4 or zstd
 }
 
-func (x *ServerStatusResponse) Reset() {
-	*x = ServerStatusResponse{}
+func (x *KafkaTopicConfig) Reset() {
+	*x = KafkaTopicConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1663,13 +2102,13 @@ func (x *ServerStatusResponse) Reset() {
 	}
 }
 
-func (x *ServerStatusResponse) String() string {
+func (x *KafkaTopicConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerStatusResponse) ProtoMessage() {}
+func (*KafkaTopicConfig) ProtoMessage() {}
 
-func (x *ServerStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *KafkaTopicConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1681,67 +2120,58 @@ func (x *ServerStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerStatusResponse.ProtoReflect.Descriptor instead.
-func (*ServerStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KafkaTopicConfig.ProtoReflect.Descriptor instead.
+func (*KafkaTopicConfig) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ServerStatusResponse) GetServerName() string {
-	if x != nil {
-		return x.ServerName
-	}
-	return ""
-}
-
-func (x *ServerStatusResponse) GetResources() []*ServerReplicaResources {
-	if x != nil {
-		return x.Resources
+func (x *KafkaTopicConfig) GetPartitions() uint32 {
+	if x != nil && x.Partitions != nil {
+		return *x.Partitions
 	}
-	return nil
+	return 0
 }
 
-func (x *ServerStatusResponse) GetExpectedReplicas() int32 {
-	if x != nil {
-		return x.ExpectedReplicas
+func (x *KafkaTopicConfig) GetReplicationFactor() uint32 {
+	if x != nil && x.ReplicationFactor != nil {
+		return *x.ReplicationFactor
 	}
 	return 0
 }
 
-func (x *ServerStatusResponse) GetAvailableReplicas() int32 {
-	if x != nil {
-		return x.AvailableReplicas
+func (x *KafkaTopicConfig) GetRetentionMs() int64 {
+	if x != nil && x.RetentionMs != nil {
+		return *x.RetentionMs
 	}
 	return 0
 }
 
-func (x *ServerStatusResponse) GetNumLoadedModelReplicas() int32 {
-	if x != nil {
-		return x.NumLoadedModelReplicas
+func (x *KafkaTopicConfig) GetCleanupPolicy() string {
+	if x != nil && x.CleanupPolicy != nil {
+		return *x.CleanupPolicy
 	}
-	return 0
+	return ""
 }
 
-func (x *ServerStatusResponse) GetKubernetesMeta() *KubernetesMeta {
-	if x != nil {
-		return x.KubernetesMeta
+func (x *KafkaTopicConfig) GetCompressionType() string {
+	if x != nil && x.CompressionType != nil {
+		return *x.CompressionType
 	}
-	return nil
+	return ""
 }
 
-type ServerReplicaResources struct {
+// Token bucket rate limit
+type StreamRateLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReplicaIdx           uint32 `protobuf:"varint,1,opt,name=replicaIdx,proto3" json:"replicaIdx,omitempty"`
-	TotalMemoryBytes     uint64 `protobuf:"varint,2,opt,name=totalMemoryBytes,proto3" json:"totalMemoryBytes,omitempty"`
-	AvailableMemoryBytes uint64 `protobuf:"varint,3,opt,name=availableMemoryBytes,proto3" json:"availableMemoryBytes,omitempty"`
-	NumLoadedModels      int32  `protobuf:"varint,4,opt,name=numLoadedModels,proto3" json:"numLoadedModels,omitempty"`
-	OverCommitPercentage uint32 `protobuf:"varint,5,opt,name=overCommitPercentage,proto3" json:"overCommitPercentage,omitempty"`
+	RequestsPerSecond uint32 `protobuf:"varint,1,opt,name=requestsPerSecond,proto3" json:"requestsPerSecond,omitempty"`
+	Burst             uint32 `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"` // defaults to requestsPerSecond if zero
 }
 
-func (x *ServerReplicaResources) Reset() {
-	*x = ServerReplicaResources{}
+func (x *StreamRateLimit) Reset() {
+	*x = StreamRateLimit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1749,13 +2179,13 @@ func (x *ServerReplicaResources) Reset() {
 	}
 }
 
-func (x *ServerReplicaResources) String() string {
+func (x *StreamRateLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerReplicaResources) ProtoMessage() {}
+func (*StreamRateLimit) ProtoMessage() {}
 
-func (x *ServerReplicaResources) ProtoReflect() protoreflect.Message {
+func (x *StreamRateLimit) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1767,56 +2197,39 @@ func (x *ServerReplicaResources) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerReplicaResources.ProtoReflect.Descriptor instead.
-func (*ServerReplicaResources) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamRateLimit.ProtoReflect.Descriptor instead.
+func (*StreamRateLimit) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ServerReplicaResources) GetReplicaIdx() uint32 {
-	if x != nil {
-		return x.ReplicaIdx
-	}
-	return 0
-}
-
-func (x *ServerReplicaResources) GetTotalMemoryBytes() uint64 {
-	if x != nil {
-		return x.TotalMemoryBytes
-	}
-	return 0
-}
-
-func (x *ServerReplicaResources) GetAvailableMemoryBytes() uint64 {
-	if x != nil {
-		return x.AvailableMemoryBytes
-	}
-	return 0
-}
-
-func (x *ServerReplicaResources) GetNumLoadedModels() int32 {
+func (x *StreamRateLimit) GetRequestsPerSecond() uint32 {
 	if x != nil {
-		return x.NumLoadedModels
+		return x.RequestsPerSecond
 	}
 	return 0
 }
 
-func (x *ServerReplicaResources) GetOverCommitPercentage() uint32 {
+func (x *StreamRateLimit) GetBurst() uint32 {
 	if x != nil {
-		return x.OverCommitPercentage
+		return x.Burst
 	}
 	return 0
 }
 
-type ModelSubscriptionRequest struct {
+type StorageConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"` //Name of the subscription caller
+	// Types that are assignable to Config:
+	//
+	//	*StorageConfig_StorageSecretName
+	//	*StorageConfig_StorageRcloneConfig
+	Config isStorageConfig_Config `protobuf_oneof:"config"`
 }
 
-func (x *ModelSubscriptionRequest) Reset() {
-	*x = ModelSubscriptionRequest{}
+func (x *StorageConfig) Reset() {
+	*x = StorageConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1824,13 +2237,13 @@ func (x *ModelSubscriptionRequest) Reset() {
 	}
 }
 
-func (x *ModelSubscriptionRequest) String() string {
+func (x *StorageConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelSubscriptionRequest) ProtoMessage() {}
+func (*StorageConfig) ProtoMessage() {}
 
-func (x *ModelSubscriptionRequest) ProtoReflect() protoreflect.Message {
+func (x *StorageConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1842,30 +2255,56 @@ func (x *ModelSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelSubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*ModelSubscriptionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StorageConfig.ProtoReflect.Descriptor instead.
+func (*StorageConfig) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ModelSubscriptionRequest) GetSubscriberName() string {
-	if x != nil {
-		return x.SubscriberName
+func (m *StorageConfig) GetConfig() isStorageConfig_Config {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (x *StorageConfig) GetStorageSecretName() string {
+	if x, ok := x.GetConfig().(*StorageConfig_StorageSecretName); ok {
+		return x.StorageSecretName
 	}
 	return ""
 }
 
-type ModelStatusRequest struct {
+func (x *StorageConfig) GetStorageRcloneConfig() string {
+	if x, ok := x.GetConfig().(*StorageConfig_StorageRcloneConfig); ok {
+		return x.StorageRcloneConfig
+	}
+	return ""
+}
+
+type isStorageConfig_Config interface {
+	isStorageConfig_Config()
+}
+
+type StorageConfig_StorageSecretName struct {
+	StorageSecretName string `protobuf:"bytes,1,opt,name=storageSecretName,proto3,oneof"`
+}
+
+type StorageConfig_StorageRcloneConfig struct {
+	StorageRcloneConfig string `protobuf:"bytes,2,opt,name=storageRcloneConfig,proto3,oneof"`
+}
+
+func (*StorageConfig_StorageSecretName) isStorageConfig_Config() {}
+
+func (*StorageConfig_StorageRcloneConfig) isStorageConfig_Config() {}
+
+type LoadModelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	SubscriberName string          `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
-	Model          *ModelReference `protobuf:"bytes,2,opt,name=model,proto3,oneof" json:"model,omitempty"`
-	AllVersions    bool            `protobuf:"varint,3,opt,name=allVersions,proto3" json:"allVersions,omitempty"`
 }
 
-func (x *ModelStatusRequest) Reset() {
-	*x = ModelStatusRequest{}
+func (x *LoadModelResponse) Reset() {
+	*x = LoadModelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1873,13 +2312,13 @@ func (x *ModelStatusRequest) Reset() {
 	}
 }
 
-func (x *ModelStatusRequest) String() string {
+func (x *LoadModelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelStatusRequest) ProtoMessage() {}
+func (*LoadModelResponse) ProtoMessage() {}
 
-func (x *ModelStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *LoadModelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1891,45 +2330,23 @@ func (x *ModelStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelStatusRequest.ProtoReflect.Descriptor instead.
-func (*ModelStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoadModelResponse.ProtoReflect.Descriptor instead.
+func (*LoadModelResponse) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ModelStatusRequest) GetSubscriberName() string {
-	if x != nil {
-		return x.SubscriberName
-	}
-	return ""
-}
-
-func (x *ModelStatusRequest) GetModel() *ModelReference {
-	if x != nil {
-		return x.Model
-	}
-	return nil
-}
-
-func (x *ModelStatusRequest) GetAllVersions() bool {
-	if x != nil {
-		return x.AllVersions
-	}
-	return false
-}
-
-type ServerNotifyRequest struct {
+// ModelReference represents a unique model
+type ModelReference struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name             string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ExpectedReplicas int32           `protobuf:"varint,2,opt,name=expectedReplicas,proto3" json:"expectedReplicas,omitempty"`
-	Shared           bool            `protobuf:"varint,3,opt,name=shared,proto3" json:"shared,omitempty"`
-	KubernetesMeta   *KubernetesMeta `protobuf:"bytes,4,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
+	Name    string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version *uint32 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"`
 }
 
-func (x *ServerNotifyRequest) Reset() {
-	*x = ServerNotifyRequest{}
+func (x *ModelReference) Reset() {
+	*x = ModelReference{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1937,13 +2354,13 @@ func (x *ServerNotifyRequest) Reset() {
 	}
 }
 
-func (x *ServerNotifyRequest) String() string {
+func (x *ModelReference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerNotifyRequest) ProtoMessage() {}
+func (*ModelReference) ProtoMessage() {}
 
-func (x *ServerNotifyRequest) ProtoReflect() protoreflect.Message {
+func (x *ModelReference) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1955,47 +2372,36 @@ func (x *ServerNotifyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerNotifyRequest.ProtoReflect.Descriptor instead.
-func (*ServerNotifyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelReference.ProtoReflect.Descriptor instead.
+func (*ModelReference) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ServerNotifyRequest) GetName() string {
+func (x *ModelReference) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ServerNotifyRequest) GetExpectedReplicas() int32 {
-	if x != nil {
-		return x.ExpectedReplicas
+func (x *ModelReference) GetVersion() uint32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return 0
 }
 
-func (x *ServerNotifyRequest) GetShared() bool {
-	if x != nil {
-		return x.Shared
-	}
-	return false
-}
-
-func (x *ServerNotifyRequest) GetKubernetesMeta() *KubernetesMeta {
-	if x != nil {
-		return x.KubernetesMeta
-	}
-	return nil
-}
-
-type ServerNotifyResponse struct {
+type UnloadModelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Model          *ModelReference `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	KubernetesMeta *KubernetesMeta `protobuf:"bytes,2,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
 }
 
-func (x *ServerNotifyResponse) Reset() {
-	*x = ServerNotifyResponse{}
+func (x *UnloadModelRequest) Reset() {
+	*x = UnloadModelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2003,13 +2409,13 @@ func (x *ServerNotifyResponse) Reset() {
 	}
 }
 
-func (x *ServerNotifyResponse) String() string {
+func (x *UnloadModelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerNotifyResponse) ProtoMessage() {}
+func (*UnloadModelRequest) ProtoMessage() {}
 
-func (x *ServerNotifyResponse) ProtoReflect() protoreflect.Message {
+func (x *UnloadModelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2021,21 +2427,33 @@ func (x *ServerNotifyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerNotifyResponse.ProtoReflect.Descriptor instead.
-func (*ServerNotifyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnloadModelRequest.ProtoReflect.Descriptor instead.
+func (*UnloadModelRequest) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{24}
 }
 
-type ServerSubscriptionRequest struct {
+func (x *UnloadModelRequest) GetModel() *ModelReference {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+func (x *UnloadModelRequest) GetKubernetesMeta() *KubernetesMeta {
+	if x != nil {
+		return x.KubernetesMeta
+	}
+	return nil
+}
+
+type UnloadModelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"` //Name of the subscription caller
 }
 
-func (x *ServerSubscriptionRequest) Reset() {
-	*x = ServerSubscriptionRequest{}
+func (x *UnloadModelResponse) Reset() {
+	*x = UnloadModelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2043,13 +2461,13 @@ func (x *ServerSubscriptionRequest) Reset() {
 	}
 }
 
-func (x *ServerSubscriptionRequest) String() string {
+func (x *UnloadModelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerSubscriptionRequest) ProtoMessage() {}
+func (*UnloadModelResponse) ProtoMessage() {}
 
-func (x *ServerSubscriptionRequest) ProtoReflect() protoreflect.Message {
+func (x *UnloadModelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2061,28 +2479,25 @@ func (x *ServerSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerSubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*ServerSubscriptionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnloadModelResponse.ProtoReflect.Descriptor instead.
+func (*UnloadModelResponse) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ServerSubscriptionRequest) GetSubscriberName() string {
-	if x != nil {
-		return x.SubscriberName
-	}
-	return ""
-}
-
-type StartExperimentRequest struct {
+// ModelStatusResponse provides the current assignment of the model onto a server
+type ModelStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Experiment *Experiment `protobuf:"bytes,1,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	ModelName string                `protobuf:"bytes,1,opt,name=modelName,proto3" json:"modelName,omitempty"`
+	Versions  []*ModelVersionStatus `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	Deleted   bool                  `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Rollout   *ModelRolloutStatus   `protobuf:"bytes,4,opt,name=rollout,proto3,oneof" json:"rollout,omitempty"`
 }
 
-func (x *StartExperimentRequest) Reset() {
-	*x = StartExperimentRequest{}
+func (x *ModelStatusResponse) Reset() {
+	*x = ModelStatusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2090,13 +2505,13 @@ func (x *StartExperimentRequest) Reset() {
 	}
 }
 
-func (x *StartExperimentRequest) String() string {
+func (x *ModelStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartExperimentRequest) ProtoMessage() {}
+func (*ModelStatusResponse) ProtoMessage() {}
 
-func (x *StartExperimentRequest) ProtoReflect() protoreflect.Message {
+func (x *ModelStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2108,34 +2523,55 @@ func (x *StartExperimentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartExperimentRequest.ProtoReflect.Descriptor instead.
-func (*StartExperimentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelStatusResponse.ProtoReflect.Descriptor instead.
+func (*ModelStatusResponse) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *StartExperimentRequest) GetExperiment() *Experiment {
+func (x *ModelStatusResponse) GetModelName() string {
 	if x != nil {
-		return x.Experiment
+		return x.ModelName
+	}
+	return ""
+}
+
+func (x *ModelStatusResponse) GetVersions() []*ModelVersionStatus {
+	if x != nil {
+		return x.Versions
 	}
 	return nil
 }
 
-type Experiment struct {
+func (x *ModelStatusResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *ModelStatusResponse) GetRollout() *ModelRolloutStatus {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+type ModelRolloutStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Default        *string                `protobuf:"bytes,2,opt,name=default,proto3,oneof" json:"default,omitempty"`
-	Candidates     []*ExperimentCandidate `protobuf:"bytes,3,rep,name=candidates,proto3" json:"candidates,omitempty"`
-	Mirror         *ExperimentMirror      `protobuf:"bytes,4,opt,name=mirror,proto3,oneof" json:"mirror,omitempty"`
-	Config         *ExperimentConfig      `protobuf:"bytes,5,opt,name=config,proto3,oneof" json:"config,omitempty"`
-	KubernetesMeta *KubernetesMeta        `protobuf:"bytes,6,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
-	ResourceType   ResourceType           `protobuf:"varint,7,opt,name=resourceType,proto3,enum=seldon.mlops.scheduler.ResourceType" json:"resourceType,omitempty"`
+	State               ModelRolloutStatus_RolloutState `protobuf:"varint,1,opt,name=state,proto3,enum=seldon.mlops.scheduler.ModelRolloutStatus_RolloutState" json:"state,omitempty"`
+	Version             uint32                          `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`               // version being rolled out
+	StableVersion       uint32                          `protobuf:"varint,3,opt,name=stableVersion,proto3" json:"stableVersion,omitempty"`   // version serving the rest of the traffic
+	Step                uint32                          `protobuf:"varint,4,opt,name=step,proto3" json:"step,omitempty"`                     // index of the current step
+	TrafficPercent      uint32                          `protobuf:"varint,5,opt,name=trafficPercent,proto3" json:"trafficPercent,omitempty"` // percentage of requests sent to the new version
+	Reason              string                          `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	LastChangeTimestamp *timestamppb.Timestamp          `protobuf:"bytes,7,opt,name=lastChangeTimestamp,proto3" json:"lastChangeTimestamp,omitempty"`
 }
 
-func (x *Experiment) Reset() {
-	*x = Experiment{}
+func (x *ModelRolloutStatus) Reset() {
+	*x = ModelRolloutStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2143,13 +2579,13 @@ func (x *Experiment) Reset() {
 	}
 }
 
-func (x *Experiment) String() string {
+func (x *ModelRolloutStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Experiment) ProtoMessage() {}
+func (*ModelRolloutStatus) ProtoMessage() {}
 
-func (x *Experiment) ProtoReflect() protoreflect.Message {
+func (x *ModelRolloutStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2161,70 +2597,75 @@ func (x *Experiment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Experiment.ProtoReflect.Descriptor instead.
-func (*Experiment) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelRolloutStatus.ProtoReflect.Descriptor instead.
+func (*ModelRolloutStatus) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *Experiment) GetName() string {
+func (x *ModelRolloutStatus) GetState() ModelRolloutStatus_RolloutState {
 	if x != nil {
-		return x.Name
+		return x.State
 	}
-	return ""
+	return ModelRolloutStatus_RolloutProgressing
 }
 
-func (x *Experiment) GetDefault() string {
-	if x != nil && x.Default != nil {
-		return *x.Default
+func (x *ModelRolloutStatus) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *Experiment) GetCandidates() []*ExperimentCandidate {
+func (x *ModelRolloutStatus) GetStableVersion() uint32 {
 	if x != nil {
-		return x.Candidates
+		return x.StableVersion
 	}
-	return nil
+	return 0
 }
 
-func (x *Experiment) GetMirror() *ExperimentMirror {
+func (x *ModelRolloutStatus) GetStep() uint32 {
 	if x != nil {
-		return x.Mirror
+		return x.Step
 	}
-	return nil
+	return 0
 }
 
-func (x *Experiment) GetConfig() *ExperimentConfig {
+func (x *ModelRolloutStatus) GetTrafficPercent() uint32 {
 	if x != nil {
-		return x.Config
+		return x.TrafficPercent
 	}
-	return nil
+	return 0
 }
 
-func (x *Experiment) GetKubernetesMeta() *KubernetesMeta {
+func (x *ModelRolloutStatus) GetReason() string {
 	if x != nil {
-		return x.KubernetesMeta
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *Experiment) GetResourceType() ResourceType {
+func (x *ModelRolloutStatus) GetLastChangeTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ResourceType
+		return x.LastChangeTimestamp
 	}
-	return ResourceType_MODEL
+	return nil
 }
 
-type ExperimentConfig struct {
+type ModelVersionStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StickySessions bool `protobuf:"varint,1,opt,name=stickySessions,proto3" json:"stickySessions,omitempty"`
+	Version           uint32                        `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	ServerName        string                        `protobuf:"bytes,3,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	KubernetesMeta    *KubernetesMeta               `protobuf:"bytes,4,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
+	ModelReplicaState map[int32]*ModelReplicaStatus `protobuf:"bytes,5,rep,name=modelReplicaState,proto3" json:"modelReplicaState,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	State             *ModelStatus                  `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	ModelDefn         *Model                        `protobuf:"bytes,7,opt,name=modelDefn,proto3,oneof" json:"modelDefn,omitempty"`
 }
 
-func (x *ExperimentConfig) Reset() {
-	*x = ExperimentConfig{}
+func (x *ModelVersionStatus) Reset() {
+	*x = ModelVersionStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2232,13 +2673,13 @@ func (x *ExperimentConfig) Reset() {
 	}
 }
 
-func (x *ExperimentConfig) String() string {
+func (x *ModelVersionStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExperimentConfig) ProtoMessage() {}
+func (*ModelVersionStatus) ProtoMessage() {}
 
-func (x *ExperimentConfig) ProtoReflect() protoreflect.Message {
+func (x *ModelVersionStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2250,29 +2691,67 @@ func (x *ExperimentConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExperimentConfig.ProtoReflect.Descriptor instead.
-func (*ExperimentConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelVersionStatus.ProtoReflect.Descriptor instead.
+func (*ModelVersionStatus) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ExperimentConfig) GetStickySessions() bool {
+func (x *ModelVersionStatus) GetVersion() uint32 {
 	if x != nil {
-		return x.StickySessions
+		return x.Version
 	}
-	return false
+	return 0
 }
 
-type ExperimentCandidate struct {
+func (x *ModelVersionStatus) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *ModelVersionStatus) GetKubernetesMeta() *KubernetesMeta {
+	if x != nil {
+		return x.KubernetesMeta
+	}
+	return nil
+}
+
+func (x *ModelVersionStatus) GetModelReplicaState() map[int32]*ModelReplicaStatus {
+	if x != nil {
+		return x.ModelReplicaState
+	}
+	return nil
+}
+
+func (x *ModelVersionStatus) GetState() *ModelStatus {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *ModelVersionStatus) GetModelDefn() *Model {
+	if x != nil {
+		return x.ModelDefn
+	}
+	return nil
+}
+
+type ModelStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	State               ModelStatus_ModelState `protobuf:"varint,1,opt,name=state,proto3,enum=seldon.mlops.scheduler.ModelStatus_ModelState" json:"state,omitempty"`
+	Reason              string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	AvailableReplicas   uint32                 `protobuf:"varint,3,opt,name=availableReplicas,proto3" json:"availableReplicas,omitempty"`
+	UnavailableReplicas uint32                 `protobuf:"varint,4,opt,name=unavailableReplicas,proto3" json:"unavailableReplicas,omitempty"`
+	LastChangeTimestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=lastChangeTimestamp,proto3" json:"lastChangeTimestamp,omitempty"`
 }
 
-func (x *ExperimentCandidate) Reset() {
-	*x = ExperimentCandidate{}
+func (x *ModelStatus) Reset() {
+	*x = ModelStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2280,13 +2759,13 @@ func (x *ExperimentCandidate) Reset() {
 	}
 }
 
-func (x *ExperimentCandidate) String() string {
+func (x *ModelStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExperimentCandidate) ProtoMessage() {}
+func (*ModelStatus) ProtoMessage() {}
 
-func (x *ExperimentCandidate) ProtoReflect() protoreflect.Message {
+func (x *ModelStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2298,36 +2777,58 @@ func (x *ExperimentCandidate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExperimentCandidate.ProtoReflect.Descriptor instead.
-func (*ExperimentCandidate) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelStatus.ProtoReflect.Descriptor instead.
+func (*ModelStatus) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ExperimentCandidate) GetName() string {
+func (x *ModelStatus) GetState() ModelStatus_ModelState {
 	if x != nil {
-		return x.Name
+		return x.State
+	}
+	return ModelStatus_ModelStateUnknown
+}
+
+func (x *ModelStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *ExperimentCandidate) GetWeight() uint32 {
+func (x *ModelStatus) GetAvailableReplicas() uint32 {
 	if x != nil {
-		return x.Weight
+		return x.AvailableReplicas
 	}
 	return 0
 }
 
-type ExperimentMirror struct {
+func (x *ModelStatus) GetUnavailableReplicas() uint32 {
+	if x != nil {
+		return x.UnavailableReplicas
+	}
+	return 0
+}
+
+func (x *ModelStatus) GetLastChangeTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastChangeTimestamp
+	}
+	return nil
+}
+
+type ModelReplicaStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Percent uint32 `protobuf:"varint,2,opt,name=percent,proto3" json:"percent,omitempty"`
+	State               ModelReplicaStatus_ModelReplicaState `protobuf:"varint,1,opt,name=state,proto3,enum=seldon.mlops.scheduler.ModelReplicaStatus_ModelReplicaState" json:"state,omitempty"`
+	Reason              string                               `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	LastChangeTimestamp *timestamppb.Timestamp               `protobuf:"bytes,3,opt,name=lastChangeTimestamp,proto3" json:"lastChangeTimestamp,omitempty"`
 }
 
-func (x *ExperimentMirror) Reset() {
-	*x = ExperimentMirror{}
+func (x *ModelReplicaStatus) Reset() {
+	*x = ModelReplicaStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2335,13 +2836,13 @@ func (x *ExperimentMirror) Reset() {
 	}
 }
 
-func (x *ExperimentMirror) String() string {
+func (x *ModelReplicaStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExperimentMirror) ProtoMessage() {}
+func (*ModelReplicaStatus) ProtoMessage() {}
 
-func (x *ExperimentMirror) ProtoReflect() protoreflect.Message {
+func (x *ModelReplicaStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2353,33 +2854,43 @@ func (x *ExperimentMirror) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExperimentMirror.ProtoReflect.Descriptor instead.
-func (*ExperimentMirror) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelReplicaStatus.ProtoReflect.Descriptor instead.
+func (*ModelReplicaStatus) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ExperimentMirror) GetName() string {
+func (x *ModelReplicaStatus) GetState() ModelReplicaStatus_ModelReplicaState {
 	if x != nil {
-		return x.Name
+		return x.State
+	}
+	return ModelReplicaStatus_ModelReplicaStateUnknown
+}
+
+func (x *ModelReplicaStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *ExperimentMirror) GetPercent() uint32 {
+func (x *ModelReplicaStatus) GetLastChangeTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Percent
+		return x.LastChangeTimestamp
 	}
-	return 0
+	return nil
 }
 
-type StartExperimentResponse struct {
+type ServerStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SubscriberName string  `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
+	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"` // Leave empty for all servers
 }
 
-func (x *StartExperimentResponse) Reset() {
-	*x = StartExperimentResponse{}
+func (x *ServerStatusRequest) Reset() {
+	*x = ServerStatusRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2387,13 +2898,13 @@ func (x *StartExperimentResponse) Reset() {
 	}
 }
 
-func (x *StartExperimentResponse) String() string {
+func (x *ServerStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartExperimentResponse) ProtoMessage() {}
+func (*ServerStatusRequest) ProtoMessage() {}
 
-func (x *StartExperimentResponse) ProtoReflect() protoreflect.Message {
+func (x *ServerStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2405,21 +2916,41 @@ func (x *StartExperimentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartExperimentResponse.ProtoReflect.Descriptor instead.
-func (*StartExperimentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerStatusRequest.ProtoReflect.Descriptor instead.
+func (*ServerStatusRequest) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{31}
 }
 
-type StopExperimentRequest struct {
+func (x *ServerStatusRequest) GetSubscriberName() string {
+	if x != nil {
+		return x.SubscriberName
+	}
+	return ""
+}
+
+func (x *ServerStatusRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// ServerStatusResponse provides details of current server status
+type ServerStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ServerName             string                    `protobuf:"bytes,1,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	Resources              []*ServerReplicaResources `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`
+	ExpectedReplicas       int32                     `protobuf:"varint,3,opt,name=expectedReplicas,proto3" json:"expectedReplicas,omitempty"`
+	AvailableReplicas      int32                     `protobuf:"varint,4,opt,name=availableReplicas,proto3" json:"availableReplicas,omitempty"`
+	NumLoadedModelReplicas int32                     `protobuf:"varint,5,opt,name=numLoadedModelReplicas,proto3" json:"numLoadedModelReplicas,omitempty"`
+	KubernetesMeta         *KubernetesMeta           `protobuf:"bytes,6,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
 }
 
-func (x *StopExperimentRequest) Reset() {
-	*x = StopExperimentRequest{}
+func (x *ServerStatusResponse) Reset() {
+	*x = ServerStatusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2427,13 +2958,13 @@ func (x *StopExperimentRequest) Reset() {
 	}
 }
 
-func (x *StopExperimentRequest) String() string {
+func (x *ServerStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopExperimentRequest) ProtoMessage() {}
+func (*ServerStatusResponse) ProtoMessage() {}
 
-func (x *StopExperimentRequest) ProtoReflect() protoreflect.Message {
+func (x *ServerStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2445,40 +2976,81 @@ func (x *StopExperimentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopExperimentRequest.ProtoReflect.Descriptor instead.
-func (*StopExperimentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerStatusResponse.ProtoReflect.Descriptor instead.
+func (*ServerStatusResponse) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *StopExperimentRequest) GetName() string {
+func (x *ServerStatusResponse) GetServerName() string {
 	if x != nil {
-		return x.Name
+		return x.ServerName
 	}
 	return ""
 }
 
-type StopExperimentResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *StopExperimentResponse) Reset() {
-	*x = StopExperimentResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[33]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ServerStatusResponse) GetResources() []*ServerReplicaResources {
+	if x != nil {
+		return x.Resources
 	}
+	return nil
 }
 
-func (x *StopExperimentResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ServerStatusResponse) GetExpectedReplicas() int32 {
+	if x != nil {
+		return x.ExpectedReplicas
+	}
+	return 0
 }
 
-func (*StopExperimentResponse) ProtoMessage() {}
-
-func (x *StopExperimentResponse) ProtoReflect() protoreflect.Message {
+func (x *ServerStatusResponse) GetAvailableReplicas() int32 {
+	if x != nil {
+		return x.AvailableReplicas
+	}
+	return 0
+}
+
+func (x *ServerStatusResponse) GetNumLoadedModelReplicas() int32 {
+	if x != nil {
+		return x.NumLoadedModelReplicas
+	}
+	return 0
+}
+
+func (x *ServerStatusResponse) GetKubernetesMeta() *KubernetesMeta {
+	if x != nil {
+		return x.KubernetesMeta
+	}
+	return nil
+}
+
+type ServerReplicaResources struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplicaIdx           uint32 `protobuf:"varint,1,opt,name=replicaIdx,proto3" json:"replicaIdx,omitempty"`
+	TotalMemoryBytes     uint64 `protobuf:"varint,2,opt,name=totalMemoryBytes,proto3" json:"totalMemoryBytes,omitempty"`
+	AvailableMemoryBytes uint64 `protobuf:"varint,3,opt,name=availableMemoryBytes,proto3" json:"availableMemoryBytes,omitempty"`
+	NumLoadedModels      int32  `protobuf:"varint,4,opt,name=numLoadedModels,proto3" json:"numLoadedModels,omitempty"`
+	OverCommitPercentage uint32 `protobuf:"varint,5,opt,name=overCommitPercentage,proto3" json:"overCommitPercentage,omitempty"`
+}
+
+func (x *ServerReplicaResources) Reset() {
+	*x = ServerReplicaResources{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerReplicaResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerReplicaResources) ProtoMessage() {}
+
+func (x *ServerReplicaResources) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2490,12 +3062,47 @@ func (x *StopExperimentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopExperimentResponse.ProtoReflect.Descriptor instead.
-func (*StopExperimentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerReplicaResources.ProtoReflect.Descriptor instead.
+func (*ServerReplicaResources) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{33}
 }
 
-type ExperimentSubscriptionRequest struct {
+func (x *ServerReplicaResources) GetReplicaIdx() uint32 {
+	if x != nil {
+		return x.ReplicaIdx
+	}
+	return 0
+}
+
+func (x *ServerReplicaResources) GetTotalMemoryBytes() uint64 {
+	if x != nil {
+		return x.TotalMemoryBytes
+	}
+	return 0
+}
+
+func (x *ServerReplicaResources) GetAvailableMemoryBytes() uint64 {
+	if x != nil {
+		return x.AvailableMemoryBytes
+	}
+	return 0
+}
+
+func (x *ServerReplicaResources) GetNumLoadedModels() int32 {
+	if x != nil {
+		return x.NumLoadedModels
+	}
+	return 0
+}
+
+func (x *ServerReplicaResources) GetOverCommitPercentage() uint32 {
+	if x != nil {
+		return x.OverCommitPercentage
+	}
+	return 0
+}
+
+type ModelSubscriptionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2503,8 +3110,8 @@ type ExperimentSubscriptionRequest struct {
 	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"` //Name of the subscription caller
 }
 
-func (x *ExperimentSubscriptionRequest) Reset() {
-	*x = ExperimentSubscriptionRequest{}
+func (x *ModelSubscriptionRequest) Reset() {
+	*x = ModelSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2512,13 +3119,13 @@ func (x *ExperimentSubscriptionRequest) Reset() {
 	}
 }
 
-func (x *ExperimentSubscriptionRequest) String() string {
+func (x *ModelSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExperimentSubscriptionRequest) ProtoMessage() {}
+func (*ModelSubscriptionRequest) ProtoMessage() {}
 
-func (x *ExperimentSubscriptionRequest) ProtoReflect() protoreflect.Message {
+func (x *ModelSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2530,33 +3137,30 @@ func (x *ExperimentSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExperimentSubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*ExperimentSubscriptionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ModelSubscriptionRequest) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ExperimentSubscriptionRequest) GetSubscriberName() string {
+func (x *ModelSubscriptionRequest) GetSubscriberName() string {
 	if x != nil {
 		return x.SubscriberName
 	}
 	return ""
 }
 
-type ExperimentStatusResponse struct {
+type ModelStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExperimentName    string          `protobuf:"bytes,1,opt,name=experimentName,proto3" json:"experimentName,omitempty"`
-	Active            bool            `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
-	CandidatesReady   bool            `protobuf:"varint,3,opt,name=candidatesReady,proto3" json:"candidatesReady,omitempty"`
-	MirrorReady       bool            `protobuf:"varint,4,opt,name=mirrorReady,proto3" json:"mirrorReady,omitempty"`
-	StatusDescription string          `protobuf:"bytes,5,opt,name=statusDescription,proto3" json:"statusDescription,omitempty"`
-	KubernetesMeta    *KubernetesMeta `protobuf:"bytes,6,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
+	SubscriberName string          `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
+	Model          *ModelReference `protobuf:"bytes,2,opt,name=model,proto3,oneof" json:"model,omitempty"`
+	AllVersions    bool            `protobuf:"varint,3,opt,name=allVersions,proto3" json:"allVersions,omitempty"`
 }
 
-func (x *ExperimentStatusResponse) Reset() {
-	*x = ExperimentStatusResponse{}
+func (x *ModelStatusRequest) Reset() {
+	*x = ModelStatusRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2564,13 +3168,13 @@ func (x *ExperimentStatusResponse) Reset() {
 	}
 }
 
-func (x *ExperimentStatusResponse) String() string {
+func (x *ModelStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExperimentStatusResponse) ProtoMessage() {}
+func (*ModelStatusRequest) ProtoMessage() {}
 
-func (x *ExperimentStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *ModelStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2582,63 +3186,45 @@ func (x *ExperimentStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExperimentStatusResponse.ProtoReflect.Descriptor instead.
-func (*ExperimentStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelStatusRequest.ProtoReflect.Descriptor instead.
+func (*ModelStatusRequest) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ExperimentStatusResponse) GetExperimentName() string {
+func (x *ModelStatusRequest) GetSubscriberName() string {
 	if x != nil {
-		return x.ExperimentName
+		return x.SubscriberName
 	}
 	return ""
 }
 
-func (x *ExperimentStatusResponse) GetActive() bool {
-	if x != nil {
-		return x.Active
-	}
-	return false
-}
-
-func (x *ExperimentStatusResponse) GetCandidatesReady() bool {
+func (x *ModelStatusRequest) GetModel() *ModelReference {
 	if x != nil {
-		return x.CandidatesReady
+		return x.Model
 	}
-	return false
+	return nil
 }
 
-func (x *ExperimentStatusResponse) GetMirrorReady() bool {
+func (x *ModelStatusRequest) GetAllVersions() bool {
 	if x != nil {
-		return x.MirrorReady
+		return x.AllVersions
 	}
 	return false
 }
 
-func (x *ExperimentStatusResponse) GetStatusDescription() string {
-	if x != nil {
-		return x.StatusDescription
-	}
-	return ""
-}
-
-func (x *ExperimentStatusResponse) GetKubernetesMeta() *KubernetesMeta {
-	if x != nil {
-		return x.KubernetesMeta
-	}
-	return nil
-}
-
-type LoadPipelineRequest struct {
+type ServerNotifyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Name             string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExpectedReplicas int32           `protobuf:"varint,2,opt,name=expectedReplicas,proto3" json:"expectedReplicas,omitempty"`
+	Shared           bool            `protobuf:"varint,3,opt,name=shared,proto3" json:"shared,omitempty"`
+	KubernetesMeta   *KubernetesMeta `protobuf:"bytes,4,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
 }
 
-func (x *LoadPipelineRequest) Reset() {
-	*x = LoadPipelineRequest{}
+func (x *ServerNotifyRequest) Reset() {
+	*x = ServerNotifyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2646,13 +3232,13 @@ func (x *LoadPipelineRequest) Reset() {
 	}
 }
 
-func (x *LoadPipelineRequest) String() string {
+func (x *ServerNotifyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadPipelineRequest) ProtoMessage() {}
+func (*ServerNotifyRequest) ProtoMessage() {}
 
-func (x *LoadPipelineRequest) ProtoReflect() protoreflect.Message {
+func (x *ServerNotifyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2664,29 +3250,47 @@ func (x *LoadPipelineRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadPipelineRequest.ProtoReflect.Descriptor instead.
-func (*LoadPipelineRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerNotifyRequest.ProtoReflect.Descriptor instead.
+func (*ServerNotifyRequest) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *LoadPipelineRequest) GetPipeline() *Pipeline {
+func (x *ServerNotifyRequest) GetName() string {
 	if x != nil {
-		return x.Pipeline
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServerNotifyRequest) GetExpectedReplicas() int32 {
+	if x != nil {
+		return x.ExpectedReplicas
+	}
+	return 0
+}
+
+func (x *ServerNotifyRequest) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+func (x *ServerNotifyRequest) GetKubernetesMeta() *KubernetesMeta {
+	if x != nil {
+		return x.KubernetesMeta
 	}
 	return nil
 }
 
-type ExperimentStatusRequest struct {
+type ServerNotifyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	SubscriberName string  `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
-	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"` // Leave empty for all experiments
 }
 
-func (x *ExperimentStatusRequest) Reset() {
-	*x = ExperimentStatusRequest{}
+func (x *ServerNotifyResponse) Reset() {
+	*x = ServerNotifyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2694,13 +3298,13 @@ func (x *ExperimentStatusRequest) Reset() {
 	}
 }
 
-func (x *ExperimentStatusRequest) String() string {
+func (x *ServerNotifyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExperimentStatusRequest) ProtoMessage() {}
+func (*ServerNotifyResponse) ProtoMessage() {}
 
-func (x *ExperimentStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *ServerNotifyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2712,41 +3316,21 @@ func (x *ExperimentStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExperimentStatusRequest.ProtoReflect.Descriptor instead.
-func (*ExperimentStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerNotifyResponse.ProtoReflect.Descriptor instead.
+func (*ServerNotifyResponse) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ExperimentStatusRequest) GetSubscriberName() string {
-	if x != nil {
-		return x.SubscriberName
-	}
-	return ""
-}
-
-func (x *ExperimentStatusRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-type Pipeline struct {
+type ServerSubscriptionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name           string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Uid            string          `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	Version        uint32          `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	Steps          []*PipelineStep `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
-	Output         *PipelineOutput `protobuf:"bytes,5,opt,name=output,proto3,oneof" json:"output,omitempty"`
-	KubernetesMeta *KubernetesMeta `protobuf:"bytes,6,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
-	Input          *PipelineInput  `protobuf:"bytes,7,opt,name=input,proto3,oneof" json:"input,omitempty"`
+	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"` //Name of the subscription caller
 }
 
-func (x *Pipeline) Reset() {
-	*x = Pipeline{}
+func (x *ServerSubscriptionRequest) Reset() {
+	*x = ServerSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2754,13 +3338,13 @@ func (x *Pipeline) Reset() {
 	}
 }
 
-func (x *Pipeline) String() string {
+func (x *ServerSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pipeline) ProtoMessage() {}
+func (*ServerSubscriptionRequest) ProtoMessage() {}
 
-func (x *Pipeline) ProtoReflect() protoreflect.Message {
+func (x *ServerSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2772,92 +3356,97 @@ func (x *Pipeline) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pipeline.ProtoReflect.Descriptor instead.
-func (*Pipeline) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ServerSubscriptionRequest) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *Pipeline) GetName() string {
+func (x *ServerSubscriptionRequest) GetSubscriberName() string {
 	if x != nil {
-		return x.Name
+		return x.SubscriberName
 	}
 	return ""
 }
 
-func (x *Pipeline) GetUid() string {
-	if x != nil {
-		return x.Uid
-	}
-	return ""
+type StartExperimentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Experiment *Experiment `protobuf:"bytes,1,opt,name=experiment,proto3" json:"experiment,omitempty"`
 }
 
-func (x *Pipeline) GetVersion() uint32 {
-	if x != nil {
-		return x.Version
+func (x *StartExperimentRequest) Reset() {
+	*x = StartExperimentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Pipeline) GetSteps() []*PipelineStep {
-	if x != nil {
-		return x.Steps
-	}
-	return nil
+func (x *StartExperimentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Pipeline) GetOutput() *PipelineOutput {
-	if x != nil {
-		return x.Output
+func (*StartExperimentRequest) ProtoMessage() {}
+
+func (x *StartExperimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Pipeline) GetKubernetesMeta() *KubernetesMeta {
-	if x != nil {
-		return x.KubernetesMeta
-	}
-	return nil
+// Deprecated: Use StartExperimentRequest.ProtoReflect.Descriptor instead.
+func (*StartExperimentRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *Pipeline) GetInput() *PipelineInput {
+func (x *StartExperimentRequest) GetExperiment() *Experiment {
 	if x != nil {
-		return x.Input
+		return x.Experiment
 	}
 	return nil
 }
 
-type PipelineStep struct {
+type Experiment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Inputs       []string            `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	JoinWindowMs *uint32             `protobuf:"varint,3,opt,name=joinWindowMs,proto3,oneof" json:"joinWindowMs,omitempty"`                                                                            // Join window millisecs, some nonzero default (TBD)
-	TensorMap    map[string]string   `protobuf:"bytes,4,rep,name=tensorMap,proto3" json:"tensorMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // optional map of tensor name mappings
-	InputsJoin   PipelineStep_JoinOp `protobuf:"varint,5,opt,name=inputsJoin,proto3,enum=seldon.mlops.scheduler.PipelineStep_JoinOp" json:"inputsJoin,omitempty"`
-	Triggers     []string            `protobuf:"bytes,6,rep,name=triggers,proto3" json:"triggers,omitempty"`
-	TriggersJoin PipelineStep_JoinOp `protobuf:"varint,7,opt,name=triggersJoin,proto3,enum=seldon.mlops.scheduler.PipelineStep_JoinOp" json:"triggersJoin,omitempty"`
-	Batch        *Batch              `protobuf:"bytes,8,opt,name=batch,proto3" json:"batch,omitempty"`
+	Name           string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Default        *string                  `protobuf:"bytes,2,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	Candidates     []*ExperimentCandidate   `protobuf:"bytes,3,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Mirror         *ExperimentMirror        `protobuf:"bytes,4,opt,name=mirror,proto3,oneof" json:"mirror,omitempty"`
+	Config         *ExperimentConfig        `protobuf:"bytes,5,opt,name=config,proto3,oneof" json:"config,omitempty"`
+	KubernetesMeta *KubernetesMeta          `protobuf:"bytes,6,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
+	ResourceType   ResourceType             `protobuf:"varint,7,opt,name=resourceType,proto3,enum=seldon.mlops.scheduler.ResourceType" json:"resourceType,omitempty"`
+	ScheduleState  *ExperimentScheduleState `protobuf:"bytes,8,opt,name=scheduleState,proto3,oneof" json:"scheduleState,omitempty"` // progress of the schedule kept by the scheduler, not set in requests
 }
 
-func (x *PipelineStep) Reset() {
-	*x = PipelineStep{}
+func (x *Experiment) Reset() {
+	*x = Experiment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[39]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PipelineStep) String() string {
+func (x *Experiment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineStep) ProtoMessage() {}
+func (*Experiment) ProtoMessage() {}
 
-func (x *PipelineStep) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[39]
+func (x *Experiment) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2868,93 +3457,95 @@ func (x *PipelineStep) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineStep.ProtoReflect.Descriptor instead.
-func (*PipelineStep) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use Experiment.ProtoReflect.Descriptor instead.
+func (*Experiment) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *PipelineStep) GetName() string {
+func (x *Experiment) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *PipelineStep) GetInputs() []string {
-	if x != nil {
-		return x.Inputs
+func (x *Experiment) GetDefault() string {
+	if x != nil && x.Default != nil {
+		return *x.Default
 	}
-	return nil
+	return ""
 }
 
-func (x *PipelineStep) GetJoinWindowMs() uint32 {
-	if x != nil && x.JoinWindowMs != nil {
-		return *x.JoinWindowMs
+func (x *Experiment) GetCandidates() []*ExperimentCandidate {
+	if x != nil {
+		return x.Candidates
 	}
-	return 0
+	return nil
 }
 
-func (x *PipelineStep) GetTensorMap() map[string]string {
+func (x *Experiment) GetMirror() *ExperimentMirror {
 	if x != nil {
-		return x.TensorMap
+		return x.Mirror
 	}
 	return nil
 }
 
-func (x *PipelineStep) GetInputsJoin() PipelineStep_JoinOp {
+func (x *Experiment) GetConfig() *ExperimentConfig {
 	if x != nil {
-		return x.InputsJoin
+		return x.Config
 	}
-	return PipelineStep_INNER
+	return nil
 }
 
-func (x *PipelineStep) GetTriggers() []string {
+func (x *Experiment) GetKubernetesMeta() *KubernetesMeta {
 	if x != nil {
-		return x.Triggers
+		return x.KubernetesMeta
 	}
 	return nil
 }
 
-func (x *PipelineStep) GetTriggersJoin() PipelineStep_JoinOp {
+func (x *Experiment) GetResourceType() ResourceType {
 	if x != nil {
-		return x.TriggersJoin
+		return x.ResourceType
 	}
-	return PipelineStep_INNER
+	return ResourceType_MODEL
 }
 
-func (x *PipelineStep) GetBatch() *Batch {
+func (x *Experiment) GetScheduleState() *ExperimentScheduleState {
 	if x != nil {
-		return x.Batch
+		return x.ScheduleState
 	}
 	return nil
 }
 
-type Batch struct {
+type ExperimentConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Size     *uint32 `protobuf:"varint,1,opt,name=size,proto3,oneof" json:"size,omitempty"`
-	WindowMs *uint32 `protobuf:"varint,2,opt,name=windowMs,proto3,oneof" json:"windowMs,omitempty"`
+	StickySessions bool                `protobuf:"varint,1,opt,name=stickySessions,proto3" json:"stickySessions,omitempty"`
+	Bandit         *BanditConfig       `protobuf:"bytes,2,opt,name=bandit,proto3,oneof" json:"bandit,omitempty"`
+	Rules          []*ExperimentRule   `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"` // checked in order before the weighted split of the candidates
+	Schedule       *ExperimentSchedule `protobuf:"bytes,4,opt,name=schedule,proto3,oneof" json:"schedule,omitempty"`
 }
 
-func (x *Batch) Reset() {
-	*x = Batch{}
+func (x *ExperimentConfig) Reset() {
+	*x = ExperimentConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[40]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Batch) String() string {
+func (x *ExperimentConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Batch) ProtoMessage() {}
+func (*ExperimentConfig) ProtoMessage() {}
 
-func (x *Batch) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[40]
+func (x *ExperimentConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2965,55 +3556,68 @@ func (x *Batch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Batch.ProtoReflect.Descriptor instead.
-func (*Batch) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ExperimentConfig.ProtoReflect.Descriptor instead.
+func (*ExperimentConfig) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *Batch) GetSize() uint32 {
-	if x != nil && x.Size != nil {
-		return *x.Size
+func (x *ExperimentConfig) GetStickySessions() bool {
+	if x != nil {
+		return x.StickySessions
 	}
-	return 0
+	return false
 }
 
-func (x *Batch) GetWindowMs() uint32 {
-	if x != nil && x.WindowMs != nil {
-		return *x.WindowMs
+func (x *ExperimentConfig) GetBandit() *BanditConfig {
+	if x != nil {
+		return x.Bandit
 	}
-	return 0
+	return nil
 }
 
-type PipelineInput struct {
+func (x *ExperimentConfig) GetRules() []*ExperimentRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ExperimentConfig) GetSchedule() *ExperimentSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// Starts the experiment at startTime and concludes it at endTime or after maxDurationSeconds, whichever is first
+type ExperimentSchedule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExternalInputs   []string             `protobuf:"bytes,1,rep,name=externalInputs,proto3" json:"externalInputs,omitempty"`
-	ExternalTriggers []string             `protobuf:"bytes,2,rep,name=externalTriggers,proto3" json:"externalTriggers,omitempty"`
-	JoinWindowMs     *uint32              `protobuf:"varint,3,opt,name=joinWindowMs,proto3,oneof" json:"joinWindowMs,omitempty"` // Join window millisecs for output, default 0
-	JoinType         PipelineInput_JoinOp `protobuf:"varint,4,opt,name=joinType,proto3,enum=seldon.mlops.scheduler.PipelineInput_JoinOp" json:"joinType,omitempty"`
-	TriggersJoin     PipelineInput_JoinOp `protobuf:"varint,5,opt,name=triggersJoin,proto3,enum=seldon.mlops.scheduler.PipelineInput_JoinOp" json:"triggersJoin,omitempty"`
-	TensorMap        map[string]string    `protobuf:"bytes,6,rep,name=tensorMap,proto3" json:"tensorMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // optional map of tensor name mappings
+	StartTime          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=startTime,proto3,oneof" json:"startTime,omitempty"` // defaults to when the experiment is started
+	EndTime            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=endTime,proto3,oneof" json:"endTime,omitempty"`
+	MaxDurationSeconds *uint32                `protobuf:"varint,3,opt,name=maxDurationSeconds,proto3,oneof" json:"maxDurationSeconds,omitempty"`
+	Promotion          *ExperimentPromotion   `protobuf:"bytes,4,opt,name=promotion,proto3,oneof" json:"promotion,omitempty"` // promote the winning candidate when concluded, else stop routing
 }
 
-func (x *PipelineInput) Reset() {
-	*x = PipelineInput{}
+func (x *ExperimentSchedule) Reset() {
+	*x = ExperimentSchedule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[41]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PipelineInput) String() string {
+func (x *ExperimentSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineInput) ProtoMessage() {}
+func (*ExperimentSchedule) ProtoMessage() {}
 
-func (x *PipelineInput) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[41]
+func (x *ExperimentSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3024,81 +3628,67 @@ func (x *PipelineInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineInput.ProtoReflect.Descriptor instead.
-func (*PipelineInput) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ExperimentSchedule.ProtoReflect.Descriptor instead.
+func (*ExperimentSchedule) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *PipelineInput) GetExternalInputs() []string {
+func (x *ExperimentSchedule) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ExternalInputs
+		return x.StartTime
 	}
 	return nil
 }
 
-func (x *PipelineInput) GetExternalTriggers() []string {
+func (x *ExperimentSchedule) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ExternalTriggers
+		return x.EndTime
 	}
 	return nil
 }
 
-func (x *PipelineInput) GetJoinWindowMs() uint32 {
-	if x != nil && x.JoinWindowMs != nil {
-		return *x.JoinWindowMs
+func (x *ExperimentSchedule) GetMaxDurationSeconds() uint32 {
+	if x != nil && x.MaxDurationSeconds != nil {
+		return *x.MaxDurationSeconds
 	}
 	return 0
 }
 
-func (x *PipelineInput) GetJoinType() PipelineInput_JoinOp {
-	if x != nil {
-		return x.JoinType
-	}
-	return PipelineInput_INNER
-}
-
-func (x *PipelineInput) GetTriggersJoin() PipelineInput_JoinOp {
+func (x *ExperimentSchedule) GetPromotion() *ExperimentPromotion {
 	if x != nil {
-		return x.TriggersJoin
-	}
-	return PipelineInput_INNER
-}
-
-func (x *PipelineInput) GetTensorMap() map[string]string {
-	if x != nil {
-		return x.TensorMap
+		return x.Promotion
 	}
 	return nil
 }
 
-type PipelineOutput struct {
+// PromQL query returning the metric of a candidate over the experiment, where {{.Candidate}} and {{.Interval}} are
+// replaced by the candidate name and the time the experiment ran for, e.g. 3600s
+type ExperimentPromotion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Steps        []string              `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
-	JoinWindowMs uint32                `protobuf:"varint,2,opt,name=joinWindowMs,proto3" json:"joinWindowMs,omitempty"` // Join window millisecs for output, default 0
-	StepsJoin    PipelineOutput_JoinOp `protobuf:"varint,3,opt,name=stepsJoin,proto3,enum=seldon.mlops.scheduler.PipelineOutput_JoinOp" json:"stepsJoin,omitempty"`
-	TensorMap    map[string]string     `protobuf:"bytes,4,rep,name=tensorMap,proto3" json:"tensorMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // optional map of tensor name mappings
+	Query         string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	LowerIsBetter bool   `protobuf:"varint,2,opt,name=lowerIsBetter,proto3" json:"lowerIsBetter,omitempty"`
 }
 
-func (x *PipelineOutput) Reset() {
-	*x = PipelineOutput{}
+func (x *ExperimentPromotion) Reset() {
+	*x = ExperimentPromotion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[42]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PipelineOutput) String() string {
+func (x *ExperimentPromotion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineOutput) ProtoMessage() {}
+func (*ExperimentPromotion) ProtoMessage() {}
 
-func (x *PipelineOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[42]
+func (x *ExperimentPromotion) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3109,62 +3699,53 @@ func (x *PipelineOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineOutput.ProtoReflect.Descriptor instead.
-func (*PipelineOutput) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *PipelineOutput) GetSteps() []string {
-	if x != nil {
-		return x.Steps
-	}
-	return nil
-}
-
-func (x *PipelineOutput) GetJoinWindowMs() uint32 {
-	if x != nil {
-		return x.JoinWindowMs
-	}
-	return 0
+// Deprecated: Use ExperimentPromotion.ProtoReflect.Descriptor instead.
+func (*ExperimentPromotion) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *PipelineOutput) GetStepsJoin() PipelineOutput_JoinOp {
+func (x *ExperimentPromotion) GetQuery() string {
 	if x != nil {
-		return x.StepsJoin
+		return x.Query
 	}
-	return PipelineOutput_INNER
+	return ""
 }
 
-func (x *PipelineOutput) GetTensorMap() map[string]string {
+func (x *ExperimentPromotion) GetLowerIsBetter() bool {
 	if x != nil {
-		return x.TensorMap
+		return x.LowerIsBetter
 	}
-	return nil
+	return false
 }
 
-type LoadPipelineResponse struct {
+type ExperimentScheduleState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	StartedAt         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=startedAt,proto3,oneof" json:"startedAt,omitempty"`
+	ConcludedAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=concludedAt,proto3,oneof" json:"concludedAt,omitempty"`
+	PromotedCandidate *string                `protobuf:"bytes,3,opt,name=promotedCandidate,proto3,oneof" json:"promotedCandidate,omitempty"` // sent all traffic of the experiment since it concluded
+	EndsAt            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=endsAt,proto3,oneof" json:"endsAt,omitempty"`                       // only set in status responses
 }
 
-func (x *LoadPipelineResponse) Reset() {
-	*x = LoadPipelineResponse{}
+func (x *ExperimentScheduleState) Reset() {
+	*x = ExperimentScheduleState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[43]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoadPipelineResponse) String() string {
+func (x *ExperimentScheduleState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadPipelineResponse) ProtoMessage() {}
+func (*ExperimentScheduleState) ProtoMessage() {}
 
-func (x *LoadPipelineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[43]
+func (x *ExperimentScheduleState) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3175,66 +3756,51 @@ func (x *LoadPipelineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadPipelineResponse.ProtoReflect.Descriptor instead.
-func (*LoadPipelineResponse) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{43}
-}
-
-type UnloadPipelineRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+// Deprecated: Use ExperimentScheduleState.ProtoReflect.Descriptor instead.
+func (*ExperimentScheduleState) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *UnloadPipelineRequest) Reset() {
-	*x = UnloadPipelineRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[44]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExperimentScheduleState) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
 	}
+	return nil
 }
 
-func (x *UnloadPipelineRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UnloadPipelineRequest) ProtoMessage() {}
-
-func (x *UnloadPipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[44]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ExperimentScheduleState) GetConcludedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConcludedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use UnloadPipelineRequest.ProtoReflect.Descriptor instead.
-func (*UnloadPipelineRequest) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{44}
+func (x *ExperimentScheduleState) GetPromotedCandidate() string {
+	if x != nil && x.PromotedCandidate != nil {
+		return *x.PromotedCandidate
+	}
+	return ""
 }
 
-func (x *UnloadPipelineRequest) GetName() string {
+func (x *ExperimentScheduleState) GetEndsAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Name
+		return x.EndsAt
 	}
-	return ""
+	return nil
 }
 
-type UnloadPipelineResponse struct {
+// Sends requests whose headers all match to a candidate instead of the weighted split
+type ExperimentRule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Candidate string                   `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Headers   []*ExperimentHeaderMatch `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
 }
 
-func (x *UnloadPipelineResponse) Reset() {
-	*x = UnloadPipelineResponse{}
+func (x *ExperimentRule) Reset() {
+	*x = ExperimentRule{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3242,13 +3808,13 @@ func (x *UnloadPipelineResponse) Reset() {
 	}
 }
 
-func (x *UnloadPipelineResponse) String() string {
+func (x *ExperimentRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnloadPipelineResponse) ProtoMessage() {}
+func (*ExperimentRule) ProtoMessage() {}
 
-func (x *UnloadPipelineResponse) ProtoReflect() protoreflect.Message {
+func (x *ExperimentRule) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3260,23 +3826,40 @@ func (x *UnloadPipelineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnloadPipelineResponse.ProtoReflect.Descriptor instead.
-func (*UnloadPipelineResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExperimentRule.ProtoReflect.Descriptor instead.
+func (*ExperimentRule) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{45}
 }
 
-type PipelineStatusRequest struct {
+func (x *ExperimentRule) GetCandidate() string {
+	if x != nil {
+		return x.Candidate
+	}
+	return ""
+}
+
+func (x *ExperimentRule) GetHeaders() []*ExperimentHeaderMatch {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// Matches a request header by exactly one of exact, prefix, regex or hash. Requests without the header never match.
+type ExperimentHeaderMatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriberName string  `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
-	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"` // Leave empty for all pipelines
-	AllVersions    bool    `protobuf:"varint,3,opt,name=allVersions,proto3" json:"allVersions,omitempty"`
+	Name   string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Exact  *string              `protobuf:"bytes,2,opt,name=exact,proto3,oneof" json:"exact,omitempty"`
+	Prefix *string              `protobuf:"bytes,3,opt,name=prefix,proto3,oneof" json:"prefix,omitempty"`
+	Regex  *string              `protobuf:"bytes,4,opt,name=regex,proto3,oneof" json:"regex,omitempty"` // RE2 syntax, matching the whole value
+	Hash   *ExperimentHashRange `protobuf:"bytes,5,opt,name=hash,proto3,oneof" json:"hash,omitempty"`
 }
 
-func (x *PipelineStatusRequest) Reset() {
-	*x = PipelineStatusRequest{}
+func (x *ExperimentHeaderMatch) Reset() {
+	*x = ExperimentHeaderMatch{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3284,13 +3867,13 @@ func (x *PipelineStatusRequest) Reset() {
 	}
 }
 
-func (x *PipelineStatusRequest) String() string {
+func (x *ExperimentHeaderMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineStatusRequest) ProtoMessage() {}
+func (*ExperimentHeaderMatch) ProtoMessage() {}
 
-func (x *PipelineStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *ExperimentHeaderMatch) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3302,42 +3885,58 @@ func (x *PipelineStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineStatusRequest.ProtoReflect.Descriptor instead.
-func (*PipelineStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExperimentHeaderMatch.ProtoReflect.Descriptor instead.
+func (*ExperimentHeaderMatch) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *PipelineStatusRequest) GetSubscriberName() string {
+func (x *ExperimentHeaderMatch) GetName() string {
 	if x != nil {
-		return x.SubscriberName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *PipelineStatusRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *ExperimentHeaderMatch) GetExact() string {
+	if x != nil && x.Exact != nil {
+		return *x.Exact
 	}
 	return ""
 }
 
-func (x *PipelineStatusRequest) GetAllVersions() bool {
+func (x *ExperimentHeaderMatch) GetPrefix() string {
+	if x != nil && x.Prefix != nil {
+		return *x.Prefix
+	}
+	return ""
+}
+
+func (x *ExperimentHeaderMatch) GetRegex() string {
+	if x != nil && x.Regex != nil {
+		return *x.Regex
+	}
+	return ""
+}
+
+func (x *ExperimentHeaderMatch) GetHash() *ExperimentHashRange {
 	if x != nil {
-		return x.AllVersions
+		return x.Hash
 	}
-	return false
+	return nil
 }
 
-type PipelineSubscriptionRequest struct {
+// Matches header values whose hash falls in buckets [start, end) out of 100, assigning cohorts deterministically
+type ExperimentHashRange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"` //Name of the subscription caller
+	Start uint32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   uint32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-func (x *PipelineSubscriptionRequest) Reset() {
-	*x = PipelineSubscriptionRequest{}
+func (x *ExperimentHashRange) Reset() {
+	*x = ExperimentHashRange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3345,13 +3944,13 @@ func (x *PipelineSubscriptionRequest) Reset() {
 	}
 }
 
-func (x *PipelineSubscriptionRequest) String() string {
+func (x *ExperimentHashRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineSubscriptionRequest) ProtoMessage() {}
+func (*ExperimentHashRange) ProtoMessage() {}
 
-func (x *PipelineSubscriptionRequest) ProtoReflect() protoreflect.Message {
+func (x *ExperimentHashRange) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3363,29 +3962,41 @@ func (x *PipelineSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineSubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*PipelineSubscriptionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExperimentHashRange.ProtoReflect.Descriptor instead.
+func (*ExperimentHashRange) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *PipelineSubscriptionRequest) GetSubscriberName() string {
+func (x *ExperimentHashRange) GetStart() uint32 {
 	if x != nil {
-		return x.SubscriberName
+		return x.Start
 	}
-	return ""
+	return 0
 }
 
-type PipelineStatusResponse struct {
+func (x *ExperimentHashRange) GetEnd() uint32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// Periodically updates the candidate weights from the rewards of each candidate
+type BanditConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PipelineName string               `protobuf:"bytes,1,opt,name=pipelineName,proto3" json:"pipelineName,omitempty"`
-	Versions     []*PipelineWithState `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	Policy                BanditConfig_Policy     `protobuf:"varint,1,opt,name=policy,proto3,enum=seldon.mlops.scheduler.BanditConfig_Policy" json:"policy,omitempty"`
+	Epsilon               *float32                `protobuf:"fixed32,2,opt,name=epsilon,proto3,oneof" json:"epsilon,omitempty"` // share of traffic used to explore for epsilon greedy, defaults to 0.1
+	MinWeight             uint32                  `protobuf:"varint,3,opt,name=minWeight,proto3" json:"minWeight,omitempty"`    // out of a total weight of 100
+	MaxWeight             *uint32                 `protobuf:"varint,4,opt,name=maxWeight,proto3,oneof" json:"maxWeight,omitempty"`
+	UpdateIntervalSeconds *uint32                 `protobuf:"varint,5,opt,name=updateIntervalSeconds,proto3,oneof" json:"updateIntervalSeconds,omitempty"` // defaults to 60
+	PrometheusReward      *BanditPrometheusReward `protobuf:"bytes,6,opt,name=prometheusReward,proto3,oneof" json:"prometheusReward,omitempty"`            // added to the rewards sent through ExperimentFeedback
 }
 
-func (x *PipelineStatusResponse) Reset() {
-	*x = PipelineStatusResponse{}
+func (x *BanditConfig) Reset() {
+	*x = BanditConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3393,13 +4004,13 @@ func (x *PipelineStatusResponse) Reset() {
 	}
 }
 
-func (x *PipelineStatusResponse) String() string {
+func (x *BanditConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineStatusResponse) ProtoMessage() {}
+func (*BanditConfig) ProtoMessage() {}
 
-func (x *PipelineStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *BanditConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3411,36 +4022,66 @@ func (x *PipelineStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineStatusResponse.ProtoReflect.Descriptor instead.
-func (*PipelineStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BanditConfig.ProtoReflect.Descriptor instead.
+func (*BanditConfig) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *PipelineStatusResponse) GetPipelineName() string {
+func (x *BanditConfig) GetPolicy() BanditConfig_Policy {
 	if x != nil {
-		return x.PipelineName
+		return x.Policy
 	}
-	return ""
+	return BanditConfig_EPSILON_GREEDY
 }
 
-func (x *PipelineStatusResponse) GetVersions() []*PipelineWithState {
+func (x *BanditConfig) GetEpsilon() float32 {
+	if x != nil && x.Epsilon != nil {
+		return *x.Epsilon
+	}
+	return 0
+}
+
+func (x *BanditConfig) GetMinWeight() uint32 {
 	if x != nil {
-		return x.Versions
+		return x.MinWeight
+	}
+	return 0
+}
+
+func (x *BanditConfig) GetMaxWeight() uint32 {
+	if x != nil && x.MaxWeight != nil {
+		return *x.MaxWeight
+	}
+	return 0
+}
+
+func (x *BanditConfig) GetUpdateIntervalSeconds() uint32 {
+	if x != nil && x.UpdateIntervalSeconds != nil {
+		return *x.UpdateIntervalSeconds
+	}
+	return 0
+}
+
+func (x *BanditConfig) GetPrometheusReward() *BanditPrometheusReward {
+	if x != nil {
+		return x.PrometheusReward
 	}
 	return nil
 }
 
-type PipelineWithState struct {
+// PromQL queries returning the sum and number of rewards in [0,1] of a candidate over the update interval.
+// {{.Candidate}} and {{.Interval}} are replaced by the candidate name and the interval, e.g. 60s.
+type BanditPrometheusReward struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pipeline *Pipeline             `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	State    *PipelineVersionState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	RewardQuery string `protobuf:"bytes,1,opt,name=rewardQuery,proto3" json:"rewardQuery,omitempty"`
+	CountQuery  string `protobuf:"bytes,2,opt,name=countQuery,proto3" json:"countQuery,omitempty"`
 }
 
-func (x *PipelineWithState) Reset() {
-	*x = PipelineWithState{}
+func (x *BanditPrometheusReward) Reset() {
+	*x = BanditPrometheusReward{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3448,13 +4089,13 @@ func (x *PipelineWithState) Reset() {
 	}
 }
 
-func (x *PipelineWithState) String() string {
+func (x *BanditPrometheusReward) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineWithState) ProtoMessage() {}
+func (*BanditPrometheusReward) ProtoMessage() {}
 
-func (x *PipelineWithState) ProtoReflect() protoreflect.Message {
+func (x *BanditPrometheusReward) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3466,39 +4107,36 @@ func (x *PipelineWithState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineWithState.ProtoReflect.Descriptor instead.
-func (*PipelineWithState) Descriptor() ([]byte, []int) {
+// Deprecated: Use BanditPrometheusReward.ProtoReflect.Descriptor instead.
+func (*BanditPrometheusReward) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *PipelineWithState) GetPipeline() *Pipeline {
+func (x *BanditPrometheusReward) GetRewardQuery() string {
 	if x != nil {
-		return x.Pipeline
+		return x.RewardQuery
 	}
-	return nil
+	return ""
 }
 
-func (x *PipelineWithState) GetState() *PipelineVersionState {
+func (x *BanditPrometheusReward) GetCountQuery() string {
 	if x != nil {
-		return x.State
+		return x.CountQuery
 	}
-	return nil
+	return ""
 }
 
-type PipelineVersionState struct {
+type ExperimentCandidate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PipelineVersion     uint32                              `protobuf:"varint,1,opt,name=pipelineVersion,proto3" json:"pipelineVersion,omitempty"`
-	Status              PipelineVersionState_PipelineStatus `protobuf:"varint,2,opt,name=status,proto3,enum=seldon.mlops.scheduler.PipelineVersionState_PipelineStatus" json:"status,omitempty"`
-	Reason              string                              `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	LastChangeTimestamp *timestamppb.Timestamp              `protobuf:"bytes,4,opt,name=lastChangeTimestamp,proto3" json:"lastChangeTimestamp,omitempty"`
-	ModelsReady         bool                                `protobuf:"varint,5,opt,name=modelsReady,proto3" json:"modelsReady,omitempty"`
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
-func (x *PipelineVersionState) Reset() {
-	*x = PipelineVersionState{}
+func (x *ExperimentCandidate) Reset() {
+	*x = ExperimentCandidate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3506,13 +4144,13 @@ func (x *PipelineVersionState) Reset() {
 	}
 }
 
-func (x *PipelineVersionState) String() string {
+func (x *ExperimentCandidate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineVersionState) ProtoMessage() {}
+func (*ExperimentCandidate) ProtoMessage() {}
 
-func (x *PipelineVersionState) ProtoReflect() protoreflect.Message {
+func (x *ExperimentCandidate) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3524,56 +4162,37 @@ func (x *PipelineVersionState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineVersionState.ProtoReflect.Descriptor instead.
-func (*PipelineVersionState) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExperimentCandidate.ProtoReflect.Descriptor instead.
+func (*ExperimentCandidate) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *PipelineVersionState) GetPipelineVersion() uint32 {
-	if x != nil {
-		return x.PipelineVersion
-	}
-	return 0
-}
-
-func (x *PipelineVersionState) GetStatus() PipelineVersionState_PipelineStatus {
-	if x != nil {
-		return x.Status
-	}
-	return PipelineVersionState_PipelineStatusUnknown
-}
-
-func (x *PipelineVersionState) GetReason() string {
+func (x *ExperimentCandidate) GetName() string {
 	if x != nil {
-		return x.Reason
+		return x.Name
 	}
 	return ""
 }
 
-func (x *PipelineVersionState) GetLastChangeTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastChangeTimestamp
-	}
-	return nil
-}
-
-func (x *PipelineVersionState) GetModelsReady() bool {
+func (x *ExperimentCandidate) GetWeight() uint32 {
 	if x != nil {
-		return x.ModelsReady
+		return x.Weight
 	}
-	return false
+	return 0
 }
 
-type SchedulerStatusRequest struct {
+type ExperimentMirror struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
+	Name       string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Percent    uint32            `protobuf:"varint,2,opt,name=percent,proto3" json:"percent,omitempty"`
+	Comparison *ShadowComparison `protobuf:"bytes,3,opt,name=comparison,proto3,oneof" json:"comparison,omitempty"` // compare the responses of the mirror with those of the default model
 }
 
-func (x *SchedulerStatusRequest) Reset() {
-	*x = SchedulerStatusRequest{}
+func (x *ExperimentMirror) Reset() {
+	*x = ExperimentMirror{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3581,13 +4200,13 @@ func (x *SchedulerStatusRequest) Reset() {
 	}
 }
 
-func (x *SchedulerStatusRequest) String() string {
+func (x *ExperimentMirror) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchedulerStatusRequest) ProtoMessage() {}
+func (*ExperimentMirror) ProtoMessage() {}
 
-func (x *SchedulerStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *ExperimentMirror) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3599,28 +4218,43 @@ func (x *SchedulerStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchedulerStatusRequest.ProtoReflect.Descriptor instead.
-func (*SchedulerStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExperimentMirror.ProtoReflect.Descriptor instead.
+func (*ExperimentMirror) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *SchedulerStatusRequest) GetSubscriberName() string {
+func (x *ExperimentMirror) GetName() string {
 	if x != nil {
-		return x.SubscriberName
+		return x.Name
 	}
 	return ""
 }
 
-type SchedulerStatusResponse struct {
+func (x *ExperimentMirror) GetPercent() uint32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *ExperimentMirror) GetComparison() *ShadowComparison {
+	if x != nil {
+		return x.Comparison
+	}
+	return nil
+}
+
+type ShadowComparison struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ApplicationVersion string `protobuf:"bytes,1,opt,name=applicationVersion,proto3" json:"applicationVersion,omitempty"`
+	Outputs   []string `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs,omitempty"`       // output tensors compared, all if empty
+	Tolerance float64  `protobuf:"fixed64,2,opt,name=tolerance,proto3" json:"tolerance,omitempty"` // largest absolute difference of an output element counted as a match
 }
 
-func (x *SchedulerStatusResponse) Reset() {
-	*x = SchedulerStatusResponse{}
+func (x *ShadowComparison) Reset() {
+	*x = ShadowComparison{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3628,13 +4262,13 @@ func (x *SchedulerStatusResponse) Reset() {
 	}
 }
 
-func (x *SchedulerStatusResponse) String() string {
+func (x *ShadowComparison) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchedulerStatusResponse) ProtoMessage() {}
+func (*ShadowComparison) ProtoMessage() {}
 
-func (x *SchedulerStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *ShadowComparison) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3646,926 +4280,5671 @@ func (x *SchedulerStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchedulerStatusResponse.ProtoReflect.Descriptor instead.
-func (*SchedulerStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShadowComparison.ProtoReflect.Descriptor instead.
+func (*ShadowComparison) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *SchedulerStatusResponse) GetApplicationVersion() string {
+func (x *ShadowComparison) GetOutputs() []string {
 	if x != nil {
-		return x.ApplicationVersion
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *ShadowComparison) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+type StartExperimentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StartExperimentResponse) Reset() {
+	*x = StartExperimentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartExperimentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartExperimentResponse) ProtoMessage() {}
+
+func (x *StartExperimentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartExperimentResponse.ProtoReflect.Descriptor instead.
+func (*StartExperimentResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{53}
+}
+
+type StopExperimentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *StopExperimentRequest) Reset() {
+	*x = StopExperimentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopExperimentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopExperimentRequest) ProtoMessage() {}
+
+func (x *StopExperimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopExperimentRequest.ProtoReflect.Descriptor instead.
+func (*StopExperimentRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *StopExperimentRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-var File_mlops_scheduler_scheduler_proto protoreflect.FileDescriptor
+type StopExperimentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
 
-var file_mlops_scheduler_scheduler_proto_rawDesc = []byte{
-	0x0a, 0x1f, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x16, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x47, 0x0a, 0x10, 0x4c, 0x6f,
-	0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33,
-	0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x05, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x22, 0x92, 0x02, 0x0a, 0x05, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x34, 0x0a,
-	0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65,
-	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x6d,
-	0x65, 0x74, 0x61, 0x12, 0x3f, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x63,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
-	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x53, 0x70, 0x65, 0x63, 0x12, 0x4e, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73,
-	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x53, 0x70, 0x65, 0x63, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x53, 0x70, 0x65, 0x63, 0x12, 0x42, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x70,
-	0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x22, 0xd3, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x74,
-	0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x88,
-	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01,
-	0x01, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d,
-	0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74,
-	0x61, 0x48, 0x02, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d,
-	0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
-	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x92,
-	0x01, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65,
-	0x63, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20, 0x0a,
-	0x0b, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12,
-	0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x73, 0x22, 0xe6, 0x03, 0x0a, 0x09, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x70, 0x65,
-	0x63, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x69, 0x12, 0x2d, 0x0a, 0x0f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0f,
-	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88,
-	0x01, 0x01, 0x12, 0x50, 0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x6c, 0x64,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x48, 0x01, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52,
-	0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12,
-	0x1b, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x03, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x09,
-	0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x48, 0x04, 0x52, 0x09, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
-	0x74, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x6c,
-	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65,
-	0x63, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x42, 0x12, 0x0a,
-	0x10, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x0c,
-	0x0a, 0x0a, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x39, 0x0a, 0x0d,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6c,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a,
-	0x08, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x12, 0x25,
-	0x0a, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x66, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
-	0x65, 0x66, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
-	0x65, 0x66, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
-	0x65, 0x66, 0x22, 0x4e, 0x0a, 0x0e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
-	0x4d, 0x65, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x70, 0x65, 0x63,
-	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63,
-	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x22, 0x7d, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x63,
-	0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x63, 0x6c, 0x6f, 0x6e,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xba, 0x01, 0x0a, 0x12, 0x55, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c,
-	0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
-	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x53, 0x0a, 0x0e,
-	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
-	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75,
-	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e,
-	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01,
-	0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
-	0x4d, 0x65, 0x74, 0x61, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x13,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x46, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
-	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x22, 0xa4, 0x04, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
-	0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73,
-	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
-	0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
-	0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x6f, 0x0a, 0x11, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
-	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x65, 0x6c, 0x64,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x44, 0x65,
-	0x66, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x48, 0x01, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x44, 0x65, 0x66, 0x6e, 0x88, 0x01, 0x01, 0x1a, 0x70, 0x0a, 0x16, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
-	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75,
-	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a,
-	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x6e, 0x22, 0xd3, 0x03, 0x0a, 0x0b, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x13, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x4c, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xb7, 0x01, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67,
-	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x46,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x04, 0x12, 0x13, 0x0a,
-	0x0f, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64,
-	0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x69,
-	0x6e, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x07,
-	0x22, 0xd0, 0x03, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x52, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
-	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x6c, 0x61,
-	0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x22, 0xff, 0x01, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x6b, 0x6e,
-	0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x6f, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x10,
-	0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10,
-	0x04, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x65, 0x64, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65,
-	0x64, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x69,
-	0x6c, 0x65, 0x64, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x10, 0x09, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x55, 0x6e,
-	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x0a, 0x12, 0x18, 0x0a, 0x14, 0x55,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x65, 0x64, 0x10, 0x0b, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e,
-	0x67, 0x10, 0x0c, 0x22, 0x5f, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0xfe, 0x02, 0x0a, 0x14, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a,
-	0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x65,
-	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52,
-	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x6e, 0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x64,
-	0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x6e, 0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x53, 0x0a,
-	0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
-	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b,
-	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52,
-	0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x88,
-	0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
-	0x73, 0x4d, 0x65, 0x74, 0x61, 0x22, 0xf6, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x78, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x78,
-	0x12, 0x2a, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x14,
-	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6e, 0x75, 0x6d, 0x4c, 0x6f,
-	0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x6f, 0x76,
-	0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
-	0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x42,
-	0x0a, 0x18, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x41, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x22, 0xd5, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10,
-	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65,
-	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61,
-	0x48, 0x00, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65,
-	0x74, 0x61, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e,
-	0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x43, 0x0a, 0x19, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
-	0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x42, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
-	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
-	0x65, 0x6e, 0x74, 0x22, 0xee, 0x03, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6e,
-	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
-	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65,
-	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x01, 0x52, 0x06,
-	0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x6c, 0x64,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x48, 0x02, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88, 0x01, 0x01,
-	0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65,
-	0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61,
-	0x48, 0x03, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65,
-	0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x73, 0x65,
-	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
-	0x4d, 0x65, 0x74, 0x61, 0x22, 0x3a, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
-	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x74, 0x69, 0x63,
-	0x6b, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0e, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x41, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x61,
-	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x22, 0x40, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
-	0x74, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x2b, 0x0a, 0x15, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x18, 0x0a,
-	0x16, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x1d, 0x45, 0x78, 0x70, 0x65, 0x72,
-	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0xbc, 0x02, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
-	0x0e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x28, 0x0a,
-	0x0f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x72, 0x72, 0x6f,
-	0x72, 0x52, 0x65, 0x61, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x69,
-	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72,
-	0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
-	0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72,
-	0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f,
-	0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x22,
-	0x53, 0x0a, 0x13, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x22, 0x63, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
-	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
-	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x8a, 0x03, 0x0a, 0x08, 0x50, 0x69,
-	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
-	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65,
-	0x70, 0x73, 0x12, 0x43, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
-	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72,
-	0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
-	0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x01, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72,
-	0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x05,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65,
-	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x48, 0x02, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x88, 0x01, 0x01, 0x42, 0x09,
-	0x0a, 0x07, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75,
-	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0x0a, 0x06,
-	0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x9d, 0x04, 0x0a, 0x0c, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69,
-	0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6e, 0x70,
-	0x75, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0c, 0x6a, 0x6f, 0x69,
-	0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x09,
-	0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x33, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x12,
-	0x4b, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
-	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70,
-	0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
-	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x4f, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b,
-	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x53, 0x74, 0x65, 0x70, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x52, 0x0c, 0x74, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x33, 0x0a, 0x05, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x3c,
-	0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x27, 0x0a, 0x06,
-	0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10,
-	0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03,
-	0x41, 0x4e, 0x59, 0x10, 0x02, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x22, 0x57, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
-	0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52,
-	0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x08, 0x77, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x22,
-	0xf4, 0x03, 0x0a, 0x0d, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e,
-	0x64, 0x6f, 0x77, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0c, 0x6a,
-	0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x48,
-	0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x52, 0x08,
-	0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x50, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c,
-	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x52, 0x0c, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x52, 0x0a, 0x09, 0x74, 0x65,
-	0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e,
-	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x2e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x1a, 0x3c,
-	0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x27, 0x0a, 0x06,
-	0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10,
-	0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03,
-	0x41, 0x4e, 0x59, 0x10, 0x02, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x22, 0xd3, 0x02, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x65,
-	0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12,
-	0x22, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x4d, 0x73, 0x12, 0x4b, 0x0a, 0x09, 0x73, 0x74, 0x65, 0x70, 0x73, 0x4a, 0x6f, 0x69, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
-	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x4a,
-	0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x52, 0x09, 0x73, 0x74, 0x65, 0x70, 0x73, 0x4a, 0x6f, 0x69, 0x6e,
-	0x12, 0x53, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
-	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x54, 0x65, 0x6e, 0x73,
-	0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x65, 0x6e, 0x73,
-	0x6f, 0x72, 0x4d, 0x61, 0x70, 0x1a, 0x3c, 0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d,
-	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x27, 0x0a, 0x06, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x12, 0x09, 0x0a,
-	0x05, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45,
-	0x52, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x02, 0x22, 0x16, 0x0a, 0x14,
-	0x4c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69,
-	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x15,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x45, 0x0a, 0x1b, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x16, 0x50, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x6c, 0x64,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x95,
-	0x01, 0x0a, 0x11, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
-	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
-	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xe4, 0x03, 0x0a, 0x14, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x28, 0x0a, 0x0f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x73, 0x65, 0x6c, 0x64,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65,
-	0x61, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x22, 0xc4, 0x01, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
-	0x77, 0x6e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x11,
-	0x0a, 0x0d, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x61, 0x64, 0x79, 0x10,
-	0x03, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x46, 0x61, 0x69,
-	0x6c, 0x65, 0x64, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x10, 0x07, 0x22, 0x40, 0x0a,
-	0x16, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x49, 0x0a, 0x17, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2a, 0x27, 0x0a, 0x0c, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x4f,
-	0x44, 0x45, 0x4c, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e,
-	0x45, 0x10, 0x01, 0x32, 0xd9, 0x0e, 0x0a, 0x09, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x12, 0x6b, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x12, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
-	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
-	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62,
-	0x0a, 0x09, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x28, 0x2e, 0x73, 0x65,
-	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
-	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c,
-	0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x68, 0x0a, 0x0b, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x12, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
-	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
-	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0c,
-	0x4c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2b, 0x2e, 0x73,
-	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x0e, 0x55, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2d, 0x2e, 0x73, 0x65,
-	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x65, 0x6c,
-	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x0f,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2f, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x71, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
-	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74,
-	0x6f, 0x70, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
-	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f,
-	0x70, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
-	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
-	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x30, 0x01, 0x12, 0x6a, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
-	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
-	0x12, 0x73, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x2d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
-	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
-	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x79, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
-	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x2e, 0x73, 0x65, 0x6c, 0x64,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x65, 0x6c,
-	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
-	0x12, 0x74, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
-	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
-	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x31, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
-	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x30, 0x01, 0x12, 0x79, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x2e, 0x73,
-	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
-	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
-	0x88, 0x01, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x2e,
-	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
-	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
-	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x82, 0x01, 0x0a, 0x17, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
-	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x65,
-	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42,
-	0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65,
-	0x6c, 0x64, 0x6f, 0x6e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2d, 0x63, 0x6f,
-	0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x6c,
-	0x6f, 0x70, 0x73, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *StopExperimentResponse) Reset() {
+	*x = StopExperimentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var (
-	file_mlops_scheduler_scheduler_proto_rawDescOnce sync.Once
-	file_mlops_scheduler_scheduler_proto_rawDescData = file_mlops_scheduler_scheduler_proto_rawDesc
-)
+func (x *StopExperimentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_mlops_scheduler_scheduler_proto_rawDescGZIP() []byte {
-	file_mlops_scheduler_scheduler_proto_rawDescOnce.Do(func() {
-		file_mlops_scheduler_scheduler_proto_rawDescData = protoimpl.X.CompressGZIP(file_mlops_scheduler_scheduler_proto_rawDescData)
-	})
-	return file_mlops_scheduler_scheduler_proto_rawDescData
+func (*StopExperimentResponse) ProtoMessage() {}
+
+func (x *StopExperimentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_mlops_scheduler_scheduler_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_mlops_scheduler_scheduler_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
-var file_mlops_scheduler_scheduler_proto_goTypes = []interface{}{
-	(ResourceType)(0),                         // 0: seldon.mlops.scheduler.ResourceType
-	(ModelStatus_ModelState)(0),               // 1: seldon.mlops.scheduler.ModelStatus.ModelState
-	(ModelReplicaStatus_ModelReplicaState)(0), // 2: seldon.mlops.scheduler.ModelReplicaStatus.ModelReplicaState
-	(PipelineStep_JoinOp)(0),                  // 3: seldon.mlops.scheduler.PipelineStep.JoinOp
-	(PipelineInput_JoinOp)(0),                 // 4: seldon.mlops.scheduler.PipelineInput.JoinOp
-	(PipelineOutput_JoinOp)(0),                // 5: seldon.mlops.scheduler.PipelineOutput.JoinOp
-	(PipelineVersionState_PipelineStatus)(0),  // 6: seldon.mlops.scheduler.PipelineVersionState.PipelineStatus
-	(*LoadModelRequest)(nil),                  // 7: seldon.mlops.scheduler.LoadModelRequest
-	(*Model)(nil),                             // 8: seldon.mlops.scheduler.Model
-	(*MetaData)(nil),                          // 9: seldon.mlops.scheduler.MetaData
-	(*DeploymentSpec)(nil),                    // 10: seldon.mlops.scheduler.DeploymentSpec
-	(*ModelSpec)(nil),                         // 11: seldon.mlops.scheduler.ModelSpec
-	(*ParameterSpec)(nil),                     // 12: seldon.mlops.scheduler.ParameterSpec
-	(*ExplainerSpec)(nil),                     // 13: seldon.mlops.scheduler.ExplainerSpec
-	(*KubernetesMeta)(nil),                    // 14: seldon.mlops.scheduler.KubernetesMeta
-	(*StreamSpec)(nil),                        // 15: seldon.mlops.scheduler.StreamSpec
-	(*StorageConfig)(nil),                     // 16: seldon.mlops.scheduler.StorageConfig
-	(*LoadModelResponse)(nil),                 // 17: seldon.mlops.scheduler.LoadModelResponse
-	(*ModelReference)(nil),                    // 18: seldon.mlops.scheduler.ModelReference
-	(*UnloadModelRequest)(nil),                // 19: seldon.mlops.scheduler.UnloadModelRequest
-	(*UnloadModelResponse)(nil),               // 20: seldon.mlops.scheduler.UnloadModelResponse
-	(*ModelStatusResponse)(nil),               // 21: seldon.mlops.scheduler.ModelStatusResponse
-	(*ModelVersionStatus)(nil),                // 22: seldon.mlops.scheduler.ModelVersionStatus
-	(*ModelStatus)(nil),                       // 23: seldon.mlops.scheduler.ModelStatus
-	(*ModelReplicaStatus)(nil),                // 24: seldon.mlops.scheduler.ModelReplicaStatus
-	(*ServerStatusRequest)(nil),               // 25: seldon.mlops.scheduler.ServerStatusRequest
-	(*ServerStatusResponse)(nil),              // 26: seldon.mlops.scheduler.ServerStatusResponse
-	(*ServerReplicaResources)(nil),            // 27: seldon.mlops.scheduler.ServerReplicaResources
-	(*ModelSubscriptionRequest)(nil),          // 28: seldon.mlops.scheduler.ModelSubscriptionRequest
-	(*ModelStatusRequest)(nil),                // 29: seldon.mlops.scheduler.ModelStatusRequest
-	(*ServerNotifyRequest)(nil),               // 30: seldon.mlops.scheduler.ServerNotifyRequest
-	(*ServerNotifyResponse)(nil),              // 31: seldon.mlops.scheduler.ServerNotifyResponse
-	(*ServerSubscriptionRequest)(nil),         // 32: seldon.mlops.scheduler.ServerSubscriptionRequest
-	(*StartExperimentRequest)(nil),            // 33: seldon.mlops.scheduler.StartExperimentRequest
-	(*Experiment)(nil),                        // 34: seldon.mlops.scheduler.Experiment
-	(*ExperimentConfig)(nil),                  // 35: seldon.mlops.scheduler.ExperimentConfig
-	(*ExperimentCandidate)(nil),               // 36: seldon.mlops.scheduler.ExperimentCandidate
-	(*ExperimentMirror)(nil),                  // 37: seldon.mlops.scheduler.ExperimentMirror
-	(*StartExperimentResponse)(nil),           // 38: seldon.mlops.scheduler.StartExperimentResponse
-	(*StopExperimentRequest)(nil),             // 39: seldon.mlops.scheduler.StopExperimentRequest
-	(*StopExperimentResponse)(nil),            // 40: seldon.mlops.scheduler.StopExperimentResponse
-	(*ExperimentSubscriptionRequest)(nil),     // 41: seldon.mlops.scheduler.ExperimentSubscriptionRequest
-	(*ExperimentStatusResponse)(nil),          // 42: seldon.mlops.scheduler.ExperimentStatusResponse
-	(*LoadPipelineRequest)(nil),               // 43: seldon.mlops.scheduler.LoadPipelineRequest
-	(*ExperimentStatusRequest)(nil),           // 44: seldon.mlops.scheduler.ExperimentStatusRequest
-	(*Pipeline)(nil),                          // 45: seldon.mlops.scheduler.Pipeline
-	(*PipelineStep)(nil),                      // 46: seldon.mlops.scheduler.PipelineStep
-	(*Batch)(nil),                             // 47: seldon.mlops.scheduler.Batch
-	(*PipelineInput)(nil),                     // 48: seldon.mlops.scheduler.PipelineInput
-	(*PipelineOutput)(nil),                    // 49: seldon.mlops.scheduler.PipelineOutput
-	(*LoadPipelineResponse)(nil),              // 50: seldon.mlops.scheduler.LoadPipelineResponse
-	(*UnloadPipelineRequest)(nil),             // 51: seldon.mlops.scheduler.UnloadPipelineRequest
-	(*UnloadPipelineResponse)(nil),            // 52: seldon.mlops.scheduler.UnloadPipelineResponse
-	(*PipelineStatusRequest)(nil),             // 53: seldon.mlops.scheduler.PipelineStatusRequest
-	(*PipelineSubscriptionRequest)(nil),       // 54: seldon.mlops.scheduler.PipelineSubscriptionRequest
-	(*PipelineStatusResponse)(nil),            // 55: seldon.mlops.scheduler.PipelineStatusResponse
-	(*PipelineWithState)(nil),                 // 56: seldon.mlops.scheduler.PipelineWithState
-	(*PipelineVersionState)(nil),              // 57: seldon.mlops.scheduler.PipelineVersionState
-	(*SchedulerStatusRequest)(nil),            // 58: seldon.mlops.scheduler.SchedulerStatusRequest
-	(*SchedulerStatusResponse)(nil),           // 59: seldon.mlops.scheduler.SchedulerStatusResponse
-	nil,                                       // 60: seldon.mlops.scheduler.ModelVersionStatus.ModelReplicaStateEntry
-	nil,                                       // 61: seldon.mlops.scheduler.PipelineStep.TensorMapEntry
-	nil,                                       // 62: seldon.mlops.scheduler.PipelineInput.TensorMapEntry
-	nil,                                       // 63: seldon.mlops.scheduler.PipelineOutput.TensorMapEntry
-	(*timestamppb.Timestamp)(nil),             // 64: google.protobuf.Timestamp
+// Deprecated: Use StopExperimentResponse.ProtoReflect.Descriptor instead.
+func (*StopExperimentResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{55}
 }
-var file_mlops_scheduler_scheduler_proto_depIdxs = []int32{
-	8,  // 0: seldon.mlops.scheduler.LoadModelRequest.model:type_name -> seldon.mlops.scheduler.Model
-	9,  // 1: seldon.mlops.scheduler.Model.meta:type_name -> seldon.mlops.scheduler.MetaData
-	11, // 2: seldon.mlops.scheduler.Model.modelSpec:type_name -> seldon.mlops.scheduler.ModelSpec
-	10, // 3: seldon.mlops.scheduler.Model.deploymentSpec:type_name -> seldon.mlops.scheduler.DeploymentSpec
-	15, // 4: seldon.mlops.scheduler.Model.streamSpec:type_name -> seldon.mlops.scheduler.StreamSpec
-	14, // 5: seldon.mlops.scheduler.MetaData.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
-	16, // 6: seldon.mlops.scheduler.ModelSpec.storageConfig:type_name -> seldon.mlops.scheduler.StorageConfig
-	13, // 7: seldon.mlops.scheduler.ModelSpec.explainer:type_name -> seldon.mlops.scheduler.ExplainerSpec
-	12, // 8: seldon.mlops.scheduler.ModelSpec.parameters:type_name -> seldon.mlops.scheduler.ParameterSpec
-	18, // 9: seldon.mlops.scheduler.UnloadModelRequest.model:type_name -> seldon.mlops.scheduler.ModelReference
-	14, // 10: seldon.mlops.scheduler.UnloadModelRequest.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
-	22, // 11: seldon.mlops.scheduler.ModelStatusResponse.versions:type_name -> seldon.mlops.scheduler.ModelVersionStatus
-	14, // 12: seldon.mlops.scheduler.ModelVersionStatus.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
-	60, // 13: seldon.mlops.scheduler.ModelVersionStatus.modelReplicaState:type_name -> seldon.mlops.scheduler.ModelVersionStatus.ModelReplicaStateEntry
-	23, // 14: seldon.mlops.scheduler.ModelVersionStatus.state:type_name -> seldon.mlops.scheduler.ModelStatus
-	8,  // 15: seldon.mlops.scheduler.ModelVersionStatus.modelDefn:type_name -> seldon.mlops.scheduler.Model
-	1,  // 16: seldon.mlops.scheduler.ModelStatus.state:type_name -> seldon.mlops.scheduler.ModelStatus.ModelState
-	64, // 17: seldon.mlops.scheduler.ModelStatus.lastChangeTimestamp:type_name -> google.protobuf.Timestamp
-	2,  // 18: seldon.mlops.scheduler.ModelReplicaStatus.state:type_name -> seldon.mlops.scheduler.ModelReplicaStatus.ModelReplicaState
-	64, // 19: seldon.mlops.scheduler.ModelReplicaStatus.lastChangeTimestamp:type_name -> google.protobuf.Timestamp
-	27, // 20: seldon.mlops.scheduler.ServerStatusResponse.resources:type_name -> seldon.mlops.scheduler.ServerReplicaResources
-	14, // 21: seldon.mlops.scheduler.ServerStatusResponse.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
-	18, // 22: seldon.mlops.scheduler.ModelStatusRequest.model:type_name -> seldon.mlops.scheduler.ModelReference
-	14, // 23: seldon.mlops.scheduler.ServerNotifyRequest.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
-	34, // 24: seldon.mlops.scheduler.StartExperimentRequest.experiment:type_name -> seldon.mlops.scheduler.Experiment
-	36, // 25: seldon.mlops.scheduler.Experiment.candidates:type_name -> seldon.mlops.scheduler.ExperimentCandidate
-	37, // 26: seldon.mlops.scheduler.Experiment.mirror:type_name -> seldon.mlops.scheduler.ExperimentMirror
-	35, // 27: seldon.mlops.scheduler.Experiment.config:type_name -> seldon.mlops.scheduler.ExperimentConfig
-	14, // 28: seldon.mlops.scheduler.Experiment.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
-	0,  // 29: seldon.mlops.scheduler.Experiment.resourceType:type_name -> seldon.mlops.scheduler.ResourceType
-	14, // 30: seldon.mlops.scheduler.ExperimentStatusResponse.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
-	45, // 31: seldon.mlops.scheduler.LoadPipelineRequest.pipeline:type_name -> seldon.mlops.scheduler.Pipeline
-	46, // 32: seldon.mlops.scheduler.Pipeline.steps:type_name -> seldon.mlops.scheduler.PipelineStep
-	49, // 33: seldon.mlops.scheduler.Pipeline.output:type_name -> seldon.mlops.scheduler.PipelineOutput
-	14, // 34: seldon.mlops.scheduler.Pipeline.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
-	48, // 35: seldon.mlops.scheduler.Pipeline.input:type_name -> seldon.mlops.scheduler.PipelineInput
-	61, // 36: seldon.mlops.scheduler.PipelineStep.tensorMap:type_name -> seldon.mlops.scheduler.PipelineStep.TensorMapEntry
-	3,  // 37: seldon.mlops.scheduler.PipelineStep.inputsJoin:type_name -> seldon.mlops.scheduler.PipelineStep.JoinOp
-	3,  // 38: seldon.mlops.scheduler.PipelineStep.triggersJoin:type_name -> seldon.mlops.scheduler.PipelineStep.JoinOp
-	47, // 39: seldon.mlops.scheduler.PipelineStep.batch:type_name -> seldon.mlops.scheduler.Batch
-	4,  // 40: seldon.mlops.scheduler.PipelineInput.joinType:type_name -> seldon.mlops.scheduler.PipelineInput.JoinOp
-	4,  // 41: seldon.mlops.scheduler.PipelineInput.triggersJoin:type_name -> seldon.mlops.scheduler.PipelineInput.JoinOp
-	62, // 42: seldon.mlops.scheduler.PipelineInput.tensorMap:type_name -> seldon.mlops.scheduler.PipelineInput.TensorMapEntry
-	5,  // 43: seldon.mlops.scheduler.PipelineOutput.stepsJoin:type_name -> seldon.mlops.scheduler.PipelineOutput.JoinOp
-	63, // 44: seldon.mlops.scheduler.PipelineOutput.tensorMap:type_name -> seldon.mlops.scheduler.PipelineOutput.TensorMapEntry
-	56, // 45: seldon.mlops.scheduler.PipelineStatusResponse.versions:type_name -> seldon.mlops.scheduler.PipelineWithState
-	45, // 46: seldon.mlops.scheduler.PipelineWithState.pipeline:type_name -> seldon.mlops.scheduler.Pipeline
-	57, // 47: seldon.mlops.scheduler.PipelineWithState.state:type_name -> seldon.mlops.scheduler.PipelineVersionState
-	6,  // 48: seldon.mlops.scheduler.PipelineVersionState.status:type_name -> seldon.mlops.scheduler.PipelineVersionState.PipelineStatus
-	64, // 49: seldon.mlops.scheduler.PipelineVersionState.lastChangeTimestamp:type_name -> google.protobuf.Timestamp
-	24, // 50: seldon.mlops.scheduler.ModelVersionStatus.ModelReplicaStateEntry.value:type_name -> seldon.mlops.scheduler.ModelReplicaStatus
-	30, // 51: seldon.mlops.scheduler.Scheduler.ServerNotify:input_type -> seldon.mlops.scheduler.ServerNotifyRequest
-	7,  // 52: seldon.mlops.scheduler.Scheduler.LoadModel:input_type -> seldon.mlops.scheduler.LoadModelRequest
-	19, // 53: seldon.mlops.scheduler.Scheduler.UnloadModel:input_type -> seldon.mlops.scheduler.UnloadModelRequest
-	43, // 54: seldon.mlops.scheduler.Scheduler.LoadPipeline:input_type -> seldon.mlops.scheduler.LoadPipelineRequest
-	51, // 55: seldon.mlops.scheduler.Scheduler.UnloadPipeline:input_type -> seldon.mlops.scheduler.UnloadPipelineRequest
-	33, // 56: seldon.mlops.scheduler.Scheduler.StartExperiment:input_type -> seldon.mlops.scheduler.StartExperimentRequest
-	39, // 57: seldon.mlops.scheduler.Scheduler.StopExperiment:input_type -> seldon.mlops.scheduler.StopExperimentRequest
-	25, // 58: seldon.mlops.scheduler.Scheduler.ServerStatus:input_type -> seldon.mlops.scheduler.ServerStatusRequest
-	29, // 59: seldon.mlops.scheduler.Scheduler.ModelStatus:input_type -> seldon.mlops.scheduler.ModelStatusRequest
-	53, // 60: seldon.mlops.scheduler.Scheduler.PipelineStatus:input_type -> seldon.mlops.scheduler.PipelineStatusRequest
-	44, // 61: seldon.mlops.scheduler.Scheduler.ExperimentStatus:input_type -> seldon.mlops.scheduler.ExperimentStatusRequest
-	58, // 62: seldon.mlops.scheduler.Scheduler.SchedulerStatus:input_type -> seldon.mlops.scheduler.SchedulerStatusRequest
-	32, // 63: seldon.mlops.scheduler.Scheduler.SubscribeServerStatus:input_type -> seldon.mlops.scheduler.ServerSubscriptionRequest
-	28, // 64: seldon.mlops.scheduler.Scheduler.SubscribeModelStatus:input_type -> seldon.mlops.scheduler.ModelSubscriptionRequest
-	41, // 65: seldon.mlops.scheduler.Scheduler.SubscribeExperimentStatus:input_type -> seldon.mlops.scheduler.ExperimentSubscriptionRequest
-	54, // 66: seldon.mlops.scheduler.Scheduler.SubscribePipelineStatus:input_type -> seldon.mlops.scheduler.PipelineSubscriptionRequest
-	31, // 67: seldon.mlops.scheduler.Scheduler.ServerNotify:output_type -> seldon.mlops.scheduler.ServerNotifyResponse
-	17, // 68: seldon.mlops.scheduler.Scheduler.LoadModel:output_type -> seldon.mlops.scheduler.LoadModelResponse
-	20, // 69: seldon.mlops.scheduler.Scheduler.UnloadModel:output_type -> seldon.mlops.scheduler.UnloadModelResponse
-	50, // 70: seldon.mlops.scheduler.Scheduler.LoadPipeline:output_type -> seldon.mlops.scheduler.LoadPipelineResponse
-	52, // 71: seldon.mlops.scheduler.Scheduler.UnloadPipeline:output_type -> seldon.mlops.scheduler.UnloadPipelineResponse
-	38, // 72: seldon.mlops.scheduler.Scheduler.StartExperiment:output_type -> seldon.mlops.scheduler.StartExperimentResponse
-	40, // 73: seldon.mlops.scheduler.Scheduler.StopExperiment:output_type -> seldon.mlops.scheduler.StopExperimentResponse
-	26, // 74: seldon.mlops.scheduler.Scheduler.ServerStatus:output_type -> seldon.mlops.scheduler.ServerStatusResponse
-	21, // 75: seldon.mlops.scheduler.Scheduler.ModelStatus:output_type -> seldon.mlops.scheduler.ModelStatusResponse
-	55, // 76: seldon.mlops.scheduler.Scheduler.PipelineStatus:output_type -> seldon.mlops.scheduler.PipelineStatusResponse
-	42, // 77: seldon.mlops.scheduler.Scheduler.ExperimentStatus:output_type -> seldon.mlops.scheduler.ExperimentStatusResponse
-	59, // 78: seldon.mlops.scheduler.Scheduler.SchedulerStatus:output_type -> seldon.mlops.scheduler.SchedulerStatusResponse
-	26, // 79: seldon.mlops.scheduler.Scheduler.SubscribeServerStatus:output_type -> seldon.mlops.scheduler.ServerStatusResponse
-	21, // 80: seldon.mlops.scheduler.Scheduler.SubscribeModelStatus:output_type -> seldon.mlops.scheduler.ModelStatusResponse
-	42, // 81: seldon.mlops.scheduler.Scheduler.SubscribeExperimentStatus:output_type -> seldon.mlops.scheduler.ExperimentStatusResponse
-	55, // 82: seldon.mlops.scheduler.Scheduler.SubscribePipelineStatus:output_type -> seldon.mlops.scheduler.PipelineStatusResponse
-	67, // [67:83] is the sub-list for method output_type
-	51, // [51:67] is the sub-list for method input_type
-	51, // [51:51] is the sub-list for extension type_name
-	51, // [51:51] is the sub-list for extension extendee
-	0,  // [0:51] is the sub-list for field type_name
+
+type ExperimentSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"` //Name of the subscription caller
 }
 
-func init() { file_mlops_scheduler_scheduler_proto_init() }
-func file_mlops_scheduler_scheduler_proto_init() {
-	if File_mlops_scheduler_scheduler_proto != nil {
-		return
+func (x *ExperimentSubscriptionRequest) Reset() {
+	*x = ExperimentSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_mlops_scheduler_scheduler_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadModelRequest); i {
+}
+
+func (x *ExperimentSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentSubscriptionRequest) ProtoMessage() {}
+
+func (x *ExperimentSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ExperimentSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ExperimentSubscriptionRequest) GetSubscriberName() string {
+	if x != nil {
+		return x.SubscriberName
+	}
+	return ""
+}
+
+type ExperimentStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExperimentName    string                   `protobuf:"bytes,1,opt,name=experimentName,proto3" json:"experimentName,omitempty"`
+	Active            bool                     `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	CandidatesReady   bool                     `protobuf:"varint,3,opt,name=candidatesReady,proto3" json:"candidatesReady,omitempty"`
+	MirrorReady       bool                     `protobuf:"varint,4,opt,name=mirrorReady,proto3" json:"mirrorReady,omitempty"`
+	StatusDescription string                   `protobuf:"bytes,5,opt,name=statusDescription,proto3" json:"statusDescription,omitempty"`
+	KubernetesMeta    *KubernetesMeta          `protobuf:"bytes,6,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
+	Candidates        []*ExperimentCandidate   `protobuf:"bytes,7,rep,name=candidates,proto3" json:"candidates,omitempty"`                   // current weights, which change for bandit experiments
+	ShadowComparison  *ShadowComparisonReport  `protobuf:"bytes,8,opt,name=shadowComparison,proto3,oneof" json:"shadowComparison,omitempty"` // comparison of the mirror responses if enabled
+	Schedule          *ExperimentScheduleState `protobuf:"bytes,9,opt,name=schedule,proto3,oneof" json:"schedule,omitempty"`                 // progress of scheduled experiments
+}
+
+func (x *ExperimentStatusResponse) Reset() {
+	*x = ExperimentStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExperimentStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentStatusResponse) ProtoMessage() {}
+
+func (x *ExperimentStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentStatusResponse.ProtoReflect.Descriptor instead.
+func (*ExperimentStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ExperimentStatusResponse) GetExperimentName() string {
+	if x != nil {
+		return x.ExperimentName
+	}
+	return ""
+}
+
+func (x *ExperimentStatusResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *ExperimentStatusResponse) GetCandidatesReady() bool {
+	if x != nil {
+		return x.CandidatesReady
+	}
+	return false
+}
+
+func (x *ExperimentStatusResponse) GetMirrorReady() bool {
+	if x != nil {
+		return x.MirrorReady
+	}
+	return false
+}
+
+func (x *ExperimentStatusResponse) GetStatusDescription() string {
+	if x != nil {
+		return x.StatusDescription
+	}
+	return ""
+}
+
+func (x *ExperimentStatusResponse) GetKubernetesMeta() *KubernetesMeta {
+	if x != nil {
+		return x.KubernetesMeta
+	}
+	return nil
+}
+
+func (x *ExperimentStatusResponse) GetCandidates() []*ExperimentCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *ExperimentStatusResponse) GetShadowComparison() *ShadowComparisonReport {
+	if x != nil {
+		return x.ShadowComparison
+	}
+	return nil
+}
+
+func (x *ExperimentStatusResponse) GetSchedule() *ExperimentScheduleState {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type ShadowComparisonReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ComparedRequests   uint64                `protobuf:"varint,1,opt,name=comparedRequests,proto3" json:"comparedRequests,omitempty"`      // requests with responses of both the default model and the mirror
+	UnmatchedRequests  uint64                `protobuf:"varint,2,opt,name=unmatchedRequests,proto3" json:"unmatchedRequests,omitempty"`    // mirror responses whose default model response did not arrive in time
+	PrimaryErrors      uint64                `protobuf:"varint,3,opt,name=primaryErrors,proto3" json:"primaryErrors,omitempty"`            // compared requests that failed on the default model
+	MirrorErrors       uint64                `protobuf:"varint,4,opt,name=mirrorErrors,proto3" json:"mirrorErrors,omitempty"`              // compared requests that failed on the mirror
+	MismatchedRequests uint64                `protobuf:"varint,5,opt,name=mismatchedRequests,proto3" json:"mismatchedRequests,omitempty"`  // compared requests with an output differing beyond the tolerance
+	MeanLatencyDeltaMs float64               `protobuf:"fixed64,6,opt,name=meanLatencyDeltaMs,proto3" json:"meanLatencyDeltaMs,omitempty"` // mean of the mirror latency minus the default model latency
+	Outputs            []*ShadowOutputReport `protobuf:"bytes,7,rep,name=outputs,proto3" json:"outputs,omitempty"`
+}
+
+func (x *ShadowComparisonReport) Reset() {
+	*x = ShadowComparisonReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShadowComparisonReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShadowComparisonReport) ProtoMessage() {}
+
+func (x *ShadowComparisonReport) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShadowComparisonReport.ProtoReflect.Descriptor instead.
+func (*ShadowComparisonReport) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ShadowComparisonReport) GetComparedRequests() uint64 {
+	if x != nil {
+		return x.ComparedRequests
+	}
+	return 0
+}
+
+func (x *ShadowComparisonReport) GetUnmatchedRequests() uint64 {
+	if x != nil {
+		return x.UnmatchedRequests
+	}
+	return 0
+}
+
+func (x *ShadowComparisonReport) GetPrimaryErrors() uint64 {
+	if x != nil {
+		return x.PrimaryErrors
+	}
+	return 0
+}
+
+func (x *ShadowComparisonReport) GetMirrorErrors() uint64 {
+	if x != nil {
+		return x.MirrorErrors
+	}
+	return 0
+}
+
+func (x *ShadowComparisonReport) GetMismatchedRequests() uint64 {
+	if x != nil {
+		return x.MismatchedRequests
+	}
+	return 0
+}
+
+func (x *ShadowComparisonReport) GetMeanLatencyDeltaMs() float64 {
+	if x != nil {
+		return x.MeanLatencyDeltaMs
+	}
+	return 0
+}
+
+func (x *ShadowComparisonReport) GetOutputs() []*ShadowOutputReport {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type ShadowOutputReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Compared    uint64  `protobuf:"varint,2,opt,name=compared,proto3" json:"compared,omitempty"` // requests where both responses had the output
+	Mismatched  uint64  `protobuf:"varint,3,opt,name=mismatched,proto3" json:"mismatched,omitempty"`
+	MaxAbsDiff  float64 `protobuf:"fixed64,4,opt,name=maxAbsDiff,proto3" json:"maxAbsDiff,omitempty"`   // largest absolute difference of any element
+	MeanAbsDiff float64 `protobuf:"fixed64,5,opt,name=meanAbsDiff,proto3" json:"meanAbsDiff,omitempty"` // mean over requests of the largest absolute difference of an element
+}
+
+func (x *ShadowOutputReport) Reset() {
+	*x = ShadowOutputReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShadowOutputReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShadowOutputReport) ProtoMessage() {}
+
+func (x *ShadowOutputReport) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShadowOutputReport.ProtoReflect.Descriptor instead.
+func (*ShadowOutputReport) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ShadowOutputReport) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShadowOutputReport) GetCompared() uint64 {
+	if x != nil {
+		return x.Compared
+	}
+	return 0
+}
+
+func (x *ShadowOutputReport) GetMismatched() uint64 {
+	if x != nil {
+		return x.Mismatched
+	}
+	return 0
+}
+
+func (x *ShadowOutputReport) GetMaxAbsDiff() float64 {
+	if x != nil {
+		return x.MaxAbsDiff
+	}
+	return 0
+}
+
+func (x *ShadowOutputReport) GetMeanAbsDiff() float64 {
+	if x != nil {
+		return x.MeanAbsDiff
+	}
+	return 0
+}
+
+type ExperimentFeedbackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExperimentName string  `protobuf:"bytes,1,opt,name=experimentName,proto3" json:"experimentName,omitempty"`
+	Candidate      string  `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"` // candidate name or x-seldon-route response header of the request
+	RequestId      string  `protobuf:"bytes,3,opt,name=requestId,proto3" json:"requestId,omitempty"` // feedback is only counted once per request
+	Reward         float64 `protobuf:"fixed64,4,opt,name=reward,proto3" json:"reward,omitempty"`     // in [0,1]
+}
+
+func (x *ExperimentFeedbackRequest) Reset() {
+	*x = ExperimentFeedbackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExperimentFeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentFeedbackRequest) ProtoMessage() {}
+
+func (x *ExperimentFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*ExperimentFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ExperimentFeedbackRequest) GetExperimentName() string {
+	if x != nil {
+		return x.ExperimentName
+	}
+	return ""
+}
+
+func (x *ExperimentFeedbackRequest) GetCandidate() string {
+	if x != nil {
+		return x.Candidate
+	}
+	return ""
+}
+
+func (x *ExperimentFeedbackRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ExperimentFeedbackRequest) GetReward() float64 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+type ExperimentFeedbackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExperimentFeedbackResponse) Reset() {
+	*x = ExperimentFeedbackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExperimentFeedbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentFeedbackResponse) ProtoMessage() {}
+
+func (x *ExperimentFeedbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentFeedbackResponse.ProtoReflect.Descriptor instead.
+func (*ExperimentFeedbackResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{61}
+}
+
+type ExperimentStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExperimentName  string   `protobuf:"bytes,1,opt,name=experimentName,proto3" json:"experimentName,omitempty"`
+	WindowSeconds   *uint32  `protobuf:"varint,2,opt,name=windowSeconds,proto3,oneof" json:"windowSeconds,omitempty"`      // defaults to since a scheduled experiment started, else the last hour
+	ConfidenceLevel *float64 `protobuf:"fixed64,3,opt,name=confidenceLevel,proto3,oneof" json:"confidenceLevel,omitempty"` // of the confidence intervals, defaults to 0.95
+}
+
+func (x *ExperimentStatsRequest) Reset() {
+	*x = ExperimentStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExperimentStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentStatsRequest) ProtoMessage() {}
+
+func (x *ExperimentStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentStatsRequest.ProtoReflect.Descriptor instead.
+func (*ExperimentStatsRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ExperimentStatsRequest) GetExperimentName() string {
+	if x != nil {
+		return x.ExperimentName
+	}
+	return ""
+}
+
+func (x *ExperimentStatsRequest) GetWindowSeconds() uint32 {
+	if x != nil && x.WindowSeconds != nil {
+		return *x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *ExperimentStatsRequest) GetConfidenceLevel() float64 {
+	if x != nil && x.ConfidenceLevel != nil {
+		return *x.ConfidenceLevel
+	}
+	return 0
+}
+
+type ExperimentStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExperimentName  string                 `protobuf:"bytes,1,opt,name=experimentName,proto3" json:"experimentName,omitempty"`
+	WindowSeconds   uint32                 `protobuf:"varint,2,opt,name=windowSeconds,proto3" json:"windowSeconds,omitempty"`
+	ConfidenceLevel float64                `protobuf:"fixed64,3,opt,name=confidenceLevel,proto3" json:"confidenceLevel,omitempty"`
+	Baseline        string                 `protobuf:"bytes,4,opt,name=baseline,proto3" json:"baseline,omitempty"` // candidate the others are compared with, the default if it is a candidate else the first
+	Candidates      []*CandidateStats      `protobuf:"bytes,5,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Comparisons     []*CandidateComparison `protobuf:"bytes,6,rep,name=comparisons,proto3" json:"comparisons,omitempty"`
+}
+
+func (x *ExperimentStatsResponse) Reset() {
+	*x = ExperimentStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExperimentStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentStatsResponse) ProtoMessage() {}
+
+func (x *ExperimentStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentStatsResponse.ProtoReflect.Descriptor instead.
+func (*ExperimentStatsResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ExperimentStatsResponse) GetExperimentName() string {
+	if x != nil {
+		return x.ExperimentName
+	}
+	return ""
+}
+
+func (x *ExperimentStatsResponse) GetWindowSeconds() uint32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *ExperimentStatsResponse) GetConfidenceLevel() float64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *ExperimentStatsResponse) GetBaseline() string {
+	if x != nil {
+		return x.Baseline
+	}
+	return ""
+}
+
+func (x *ExperimentStatsResponse) GetCandidates() []*CandidateStats {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *ExperimentStatsResponse) GetComparisons() []*CandidateComparison {
+	if x != nil {
+		return x.Comparisons
+	}
+	return nil
+}
+
+// Interval containing the true value at the confidence level of the response
+type ConfidenceInterval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lower float64 `protobuf:"fixed64,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper float64 `protobuf:"fixed64,2,opt,name=upper,proto3" json:"upper,omitempty"`
+}
+
+func (x *ConfidenceInterval) Reset() {
+	*x = ConfidenceInterval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfidenceInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfidenceInterval) ProtoMessage() {}
+
+func (x *ConfidenceInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfidenceInterval.ProtoReflect.Descriptor instead.
+func (*ConfidenceInterval) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ConfidenceInterval) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *ConfidenceInterval) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+type CandidateStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name               string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Requests           float64             `protobuf:"fixed64,2,opt,name=requests,proto3" json:"requests,omitempty"`
+	Errors             float64             `protobuf:"fixed64,3,opt,name=errors,proto3" json:"errors,omitempty"` // requests without a 2xx or OK code
+	ErrorRate          float64             `protobuf:"fixed64,4,opt,name=errorRate,proto3" json:"errorRate,omitempty"`
+	ErrorRateInterval  *ConfidenceInterval `protobuf:"bytes,5,opt,name=errorRateInterval,proto3" json:"errorRateInterval,omitempty"`
+	MeanLatencySeconds float64             `protobuf:"fixed64,6,opt,name=meanLatencySeconds,proto3" json:"meanLatencySeconds,omitempty"`
+	LatencyQuantiles   []*LatencyQuantile  `protobuf:"bytes,7,rep,name=latencyQuantiles,proto3" json:"latencyQuantiles,omitempty"`
+	FeedbackCount      float64             `protobuf:"fixed64,8,opt,name=feedbackCount,proto3" json:"feedbackCount,omitempty"` // rewards of bandit experiments
+	MeanReward         float64             `protobuf:"fixed64,9,opt,name=meanReward,proto3" json:"meanReward,omitempty"`
+	MeanRewardInterval *ConfidenceInterval `protobuf:"bytes,10,opt,name=meanRewardInterval,proto3,oneof" json:"meanRewardInterval,omitempty"`
+}
+
+func (x *CandidateStats) Reset() {
+	*x = CandidateStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CandidateStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CandidateStats) ProtoMessage() {}
+
+func (x *CandidateStats) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CandidateStats.ProtoReflect.Descriptor instead.
+func (*CandidateStats) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CandidateStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CandidateStats) GetRequests() float64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *CandidateStats) GetErrors() float64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *CandidateStats) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *CandidateStats) GetErrorRateInterval() *ConfidenceInterval {
+	if x != nil {
+		return x.ErrorRateInterval
+	}
+	return nil
+}
+
+func (x *CandidateStats) GetMeanLatencySeconds() float64 {
+	if x != nil {
+		return x.MeanLatencySeconds
+	}
+	return 0
+}
+
+func (x *CandidateStats) GetLatencyQuantiles() []*LatencyQuantile {
+	if x != nil {
+		return x.LatencyQuantiles
+	}
+	return nil
+}
+
+func (x *CandidateStats) GetFeedbackCount() float64 {
+	if x != nil {
+		return x.FeedbackCount
+	}
+	return 0
+}
+
+func (x *CandidateStats) GetMeanReward() float64 {
+	if x != nil {
+		return x.MeanReward
+	}
+	return 0
+}
+
+func (x *CandidateStats) GetMeanRewardInterval() *ConfidenceInterval {
+	if x != nil {
+		return x.MeanRewardInterval
+	}
+	return nil
+}
+
+type LatencyQuantile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quantile float64 `protobuf:"fixed64,1,opt,name=quantile,proto3" json:"quantile,omitempty"`
+	Seconds  float64 `protobuf:"fixed64,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+}
+
+func (x *LatencyQuantile) Reset() {
+	*x = LatencyQuantile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatencyQuantile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatencyQuantile) ProtoMessage() {}
+
+func (x *LatencyQuantile) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatencyQuantile.ProtoReflect.Descriptor instead.
+func (*LatencyQuantile) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *LatencyQuantile) GetQuantile() float64 {
+	if x != nil {
+		return x.Quantile
+	}
+	return 0
+}
+
+func (x *LatencyQuantile) GetSeconds() float64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+// Difference of a candidate from the baseline with the p-value of a two sided test of there being no difference
+type CandidateComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Candidate              string              `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	ErrorRateDiff          float64             `protobuf:"fixed64,2,opt,name=errorRateDiff,proto3" json:"errorRateDiff,omitempty"`
+	ErrorRateDiffInterval  *ConfidenceInterval `protobuf:"bytes,3,opt,name=errorRateDiffInterval,proto3" json:"errorRateDiffInterval,omitempty"`
+	ErrorRatePValue        float64             `protobuf:"fixed64,4,opt,name=errorRatePValue,proto3" json:"errorRatePValue,omitempty"`
+	MeanLatencyDiffSeconds float64             `protobuf:"fixed64,5,opt,name=meanLatencyDiffSeconds,proto3" json:"meanLatencyDiffSeconds,omitempty"`
+	MeanRewardDiff         *float64            `protobuf:"fixed64,6,opt,name=meanRewardDiff,proto3,oneof" json:"meanRewardDiff,omitempty"`
+	MeanRewardDiffInterval *ConfidenceInterval `protobuf:"bytes,7,opt,name=meanRewardDiffInterval,proto3,oneof" json:"meanRewardDiffInterval,omitempty"`
+	MeanRewardPValue       *float64            `protobuf:"fixed64,8,opt,name=meanRewardPValue,proto3,oneof" json:"meanRewardPValue,omitempty"`
+	// Latency comparisons from the latency histogram buckets, only if both have observations
+	MeanLatencyDiffInterval *ConfidenceInterval `protobuf:"bytes,9,opt,name=meanLatencyDiffInterval,proto3,oneof" json:"meanLatencyDiffInterval,omitempty"`
+	LatencyPValue           *float64            `protobuf:"fixed64,10,opt,name=latencyPValue,proto3,oneof" json:"latencyPValue,omitempty"` // Mann-Whitney U test of the latency distributions
+}
+
+func (x *CandidateComparison) Reset() {
+	*x = CandidateComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CandidateComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CandidateComparison) ProtoMessage() {}
+
+func (x *CandidateComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CandidateComparison.ProtoReflect.Descriptor instead.
+func (*CandidateComparison) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CandidateComparison) GetCandidate() string {
+	if x != nil {
+		return x.Candidate
+	}
+	return ""
+}
+
+func (x *CandidateComparison) GetErrorRateDiff() float64 {
+	if x != nil {
+		return x.ErrorRateDiff
+	}
+	return 0
+}
+
+func (x *CandidateComparison) GetErrorRateDiffInterval() *ConfidenceInterval {
+	if x != nil {
+		return x.ErrorRateDiffInterval
+	}
+	return nil
+}
+
+func (x *CandidateComparison) GetErrorRatePValue() float64 {
+	if x != nil {
+		return x.ErrorRatePValue
+	}
+	return 0
+}
+
+func (x *CandidateComparison) GetMeanLatencyDiffSeconds() float64 {
+	if x != nil {
+		return x.MeanLatencyDiffSeconds
+	}
+	return 0
+}
+
+func (x *CandidateComparison) GetMeanRewardDiff() float64 {
+	if x != nil && x.MeanRewardDiff != nil {
+		return *x.MeanRewardDiff
+	}
+	return 0
+}
+
+func (x *CandidateComparison) GetMeanRewardDiffInterval() *ConfidenceInterval {
+	if x != nil {
+		return x.MeanRewardDiffInterval
+	}
+	return nil
+}
+
+func (x *CandidateComparison) GetMeanRewardPValue() float64 {
+	if x != nil && x.MeanRewardPValue != nil {
+		return *x.MeanRewardPValue
+	}
+	return 0
+}
+
+func (x *CandidateComparison) GetMeanLatencyDiffInterval() *ConfidenceInterval {
+	if x != nil {
+		return x.MeanLatencyDiffInterval
+	}
+	return nil
+}
+
+func (x *CandidateComparison) GetLatencyPValue() float64 {
+	if x != nil && x.LatencyPValue != nil {
+		return *x.LatencyPValue
+	}
+	return 0
+}
+
+type LoadPipelineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+}
+
+func (x *LoadPipelineRequest) Reset() {
+	*x = LoadPipelineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadPipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadPipelineRequest) ProtoMessage() {}
+
+func (x *LoadPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadPipelineRequest.ProtoReflect.Descriptor instead.
+func (*LoadPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *LoadPipelineRequest) GetPipeline() *Pipeline {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+type ExperimentStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriberName string  `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
+	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"` // Leave empty for all experiments
+}
+
+func (x *ExperimentStatusRequest) Reset() {
+	*x = ExperimentStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExperimentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentStatusRequest) ProtoMessage() {}
+
+func (x *ExperimentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentStatusRequest.ProtoReflect.Descriptor instead.
+func (*ExperimentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ExperimentStatusRequest) GetSubscriberName() string {
+	if x != nil {
+		return x.SubscriberName
+	}
+	return ""
+}
+
+func (x *ExperimentStatusRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type Pipeline struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uid            string               `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Version        uint32               `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Steps          []*PipelineStep      `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
+	Output         *PipelineOutput      `protobuf:"bytes,5,opt,name=output,proto3,oneof" json:"output,omitempty"`
+	KubernetesMeta *KubernetesMeta      `protobuf:"bytes,6,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
+	Input          *PipelineInput       `protobuf:"bytes,7,opt,name=input,proto3,oneof" json:"input,omitempty"`
+	Canary         *PipelineCanary      `protobuf:"bytes,8,opt,name=canary,proto3,oneof" json:"canary,omitempty"`                // optional canary rollout of this version alongside the current ready version
+	Schema         *PipelineSchema      `protobuf:"bytes,9,opt,name=schema,proto3,oneof" json:"schema,omitempty"`                // optional schema of the pipeline requests and responses checked by the pipeline gateway
+	TopicConfig    *KafkaTopicConfig    `protobuf:"bytes,10,opt,name=topicConfig,proto3,oneof" json:"topicConfig,omitempty"`     // settings for the pipeline input and output topics
+	TrafficPolicy  *TrafficPolicy       `protobuf:"bytes,11,opt,name=trafficPolicy,proto3,oneof" json:"trafficPolicy,omitempty"` // Envoy settings for requests to the pipeline
+	Authorization  *AuthorizationPolicy `protobuf:"bytes,12,opt,name=authorization,proto3,oneof" json:"authorization,omitempty"` // callers allowed to send requests to the pipeline
+}
+
+func (x *Pipeline) Reset() {
+	*x = Pipeline{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pipeline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pipeline) ProtoMessage() {}
+
+func (x *Pipeline) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pipeline.ProtoReflect.Descriptor instead.
+func (*Pipeline) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Pipeline) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pipeline) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Pipeline) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Pipeline) GetSteps() []*PipelineStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *Pipeline) GetOutput() *PipelineOutput {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *Pipeline) GetKubernetesMeta() *KubernetesMeta {
+	if x != nil {
+		return x.KubernetesMeta
+	}
+	return nil
+}
+
+func (x *Pipeline) GetInput() *PipelineInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *Pipeline) GetCanary() *PipelineCanary {
+	if x != nil {
+		return x.Canary
+	}
+	return nil
+}
+
+func (x *Pipeline) GetSchema() *PipelineSchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+func (x *Pipeline) GetTopicConfig() *KafkaTopicConfig {
+	if x != nil {
+		return x.TopicConfig
+	}
+	return nil
+}
+
+func (x *Pipeline) GetTrafficPolicy() *TrafficPolicy {
+	if x != nil {
+		return x.TrafficPolicy
+	}
+	return nil
+}
+
+func (x *Pipeline) GetAuthorization() *AuthorizationPolicy {
+	if x != nil {
+		return x.Authorization
+	}
+	return nil
+}
+
+type PipelineSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Inputs  []*TensorSchema `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Outputs []*TensorSchema `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+}
+
+func (x *PipelineSchema) Reset() {
+	*x = PipelineSchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineSchema) ProtoMessage() {}
+
+func (x *PipelineSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineSchema.ProtoReflect.Descriptor instead.
+func (*PipelineSchema) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *PipelineSchema) GetInputs() []*TensorSchema {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *PipelineSchema) GetOutputs() []*TensorSchema {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type TensorSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Datatype string  `protobuf:"bytes,2,opt,name=datatype,proto3" json:"datatype,omitempty"`   // empty for any datatype
+	Shape    []int64 `protobuf:"varint,3,rep,packed,name=shape,proto3" json:"shape,omitempty"` // -1 for a dimension of any size, empty for any shape
+}
+
+func (x *TensorSchema) Reset() {
+	*x = TensorSchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TensorSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TensorSchema) ProtoMessage() {}
+
+func (x *TensorSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TensorSchema.ProtoReflect.Descriptor instead.
+func (*TensorSchema) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *TensorSchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TensorSchema) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+func (x *TensorSchema) GetShape() []int64 {
+	if x != nil {
+		return x.Shape
+	}
+	return nil
+}
+
+type PipelineCanary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrafficPercent uint32 `protobuf:"varint,1,opt,name=trafficPercent,proto3" json:"trafficPercent,omitempty"` // percentage of traffic sent to the new version, the rest stays on the previous version
+}
+
+func (x *PipelineCanary) Reset() {
+	*x = PipelineCanary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineCanary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineCanary) ProtoMessage() {}
+
+func (x *PipelineCanary) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineCanary.ProtoReflect.Descriptor instead.
+func (*PipelineCanary) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *PipelineCanary) GetTrafficPercent() uint32 {
+	if x != nil {
+		return x.TrafficPercent
+	}
+	return 0
+}
+
+type PipelineStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Inputs       []string             `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	JoinWindowMs *uint32              `protobuf:"varint,3,opt,name=joinWindowMs,proto3,oneof" json:"joinWindowMs,omitempty"`                                                                            // Join window millisecs, some nonzero default (TBD)
+	TensorMap    map[string]string    `protobuf:"bytes,4,rep,name=tensorMap,proto3" json:"tensorMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // optional map of tensor name mappings
+	InputsJoin   PipelineStep_JoinOp  `protobuf:"varint,5,opt,name=inputsJoin,proto3,enum=seldon.mlops.scheduler.PipelineStep_JoinOp" json:"inputsJoin,omitempty"`
+	Triggers     []string             `protobuf:"bytes,6,rep,name=triggers,proto3" json:"triggers,omitempty"`
+	TriggersJoin PipelineStep_JoinOp  `protobuf:"varint,7,opt,name=triggersJoin,proto3,enum=seldon.mlops.scheduler.PipelineStep_JoinOp" json:"triggersJoin,omitempty"`
+	Batch        *Batch               `protobuf:"bytes,8,opt,name=batch,proto3" json:"batch,omitempty"`
+	Transforms   []*PipelineTransform `protobuf:"bytes,9,rep,name=transforms,proto3" json:"transforms,omitempty"`  // optional built-in tensor transforms run by the dataflow engine in place of a model
+	ForEach      *ForEach             `protobuf:"bytes,10,opt,name=forEach,proto3,oneof" json:"forEach,omitempty"` // optional fan-out calling the step once per element of a tensor
+}
+
+func (x *PipelineStep) Reset() {
+	*x = PipelineStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineStep) ProtoMessage() {}
+
+func (x *PipelineStep) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineStep.ProtoReflect.Descriptor instead.
+func (*PipelineStep) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *PipelineStep) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PipelineStep) GetInputs() []string {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetJoinWindowMs() uint32 {
+	if x != nil && x.JoinWindowMs != nil {
+		return *x.JoinWindowMs
+	}
+	return 0
+}
+
+func (x *PipelineStep) GetTensorMap() map[string]string {
+	if x != nil {
+		return x.TensorMap
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetInputsJoin() PipelineStep_JoinOp {
+	if x != nil {
+		return x.InputsJoin
+	}
+	return PipelineStep_INNER
+}
+
+func (x *PipelineStep) GetTriggers() []string {
+	if x != nil {
+		return x.Triggers
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetTriggersJoin() PipelineStep_JoinOp {
+	if x != nil {
+		return x.TriggersJoin
+	}
+	return PipelineStep_INNER
+}
+
+func (x *PipelineStep) GetBatch() *Batch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetTransforms() []*PipelineTransform {
+	if x != nil {
+		return x.Transforms
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetForEach() *ForEach {
+	if x != nil {
+		return x.ForEach
+	}
+	return nil
+}
+
+type ForEach struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tensor          string  `protobuf:"bytes,1,opt,name=tensor,proto3" json:"tensor,omitempty"`                          // input tensor to split into elements
+	Axis            uint32  `protobuf:"varint,2,opt,name=axis,proto3" json:"axis,omitempty"`                             // dimension to split along, default 0
+	Parallelism     *uint32 `protobuf:"varint,3,opt,name=parallelism,proto3,oneof" json:"parallelism,omitempty"`         // max elements in flight per request, default no limit
+	GatherTimeoutMs *uint32 `protobuf:"varint,4,opt,name=gatherTimeoutMs,proto3,oneof" json:"gatherTimeoutMs,omitempty"` // time to wait for all element outputs before dropping the request
+}
+
+func (x *ForEach) Reset() {
+	*x = ForEach{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForEach) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForEach) ProtoMessage() {}
+
+func (x *ForEach) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForEach.ProtoReflect.Descriptor instead.
+func (*ForEach) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ForEach) GetTensor() string {
+	if x != nil {
+		return x.Tensor
+	}
+	return ""
+}
+
+func (x *ForEach) GetAxis() uint32 {
+	if x != nil {
+		return x.Axis
+	}
+	return 0
+}
+
+func (x *ForEach) GetParallelism() uint32 {
+	if x != nil && x.Parallelism != nil {
+		return *x.Parallelism
+	}
+	return 0
+}
+
+func (x *ForEach) GetGatherTimeoutMs() uint32 {
+	if x != nil && x.GatherTimeoutMs != nil {
+		return *x.GatherTimeoutMs
+	}
+	return 0
+}
+
+type PipelineTransform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     PipelineTransform_TransformType `protobuf:"varint,1,opt,name=type,proto3,enum=seldon.mlops.scheduler.PipelineTransform_TransformType" json:"type,omitempty"`
+	Tensors  []string                        `protobuf:"bytes,2,rep,name=tensors,proto3" json:"tensors,omitempty"`     // tensors the transform reads
+	Outputs  []string                        `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs,omitempty"`     // tensors the transform creates
+	Axis     uint32                          `protobuf:"varint,4,opt,name=axis,proto3" json:"axis,omitempty"`          // axis for concat and split
+	Datatype string                          `protobuf:"bytes,5,opt,name=datatype,proto3" json:"datatype,omitempty"`   // target datatype for cast, constant and jsonPath
+	Shape    []int64                         `protobuf:"varint,6,rep,packed,name=shape,proto3" json:"shape,omitempty"` // target shape for reshape and constant
+	Values   []string                        `protobuf:"bytes,7,rep,name=values,proto3" json:"values,omitempty"`       // flattened values for constant
+	Path     string                          `protobuf:"bytes,8,opt,name=path,proto3" json:"path,omitempty"`           // JSON path for jsonPath, e.g. $.a.b[0]
+}
+
+func (x *PipelineTransform) Reset() {
+	*x = PipelineTransform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineTransform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineTransform) ProtoMessage() {}
+
+func (x *PipelineTransform) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineTransform.ProtoReflect.Descriptor instead.
+func (*PipelineTransform) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PipelineTransform) GetType() PipelineTransform_TransformType {
+	if x != nil {
+		return x.Type
+	}
+	return PipelineTransform_CONCAT
+}
+
+func (x *PipelineTransform) GetTensors() []string {
+	if x != nil {
+		return x.Tensors
+	}
+	return nil
+}
+
+func (x *PipelineTransform) GetOutputs() []string {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *PipelineTransform) GetAxis() uint32 {
+	if x != nil {
+		return x.Axis
+	}
+	return 0
+}
+
+func (x *PipelineTransform) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+func (x *PipelineTransform) GetShape() []int64 {
+	if x != nil {
+		return x.Shape
+	}
+	return nil
+}
+
+func (x *PipelineTransform) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *PipelineTransform) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type Batch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Size     *uint32 `protobuf:"varint,1,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	WindowMs *uint32 `protobuf:"varint,2,opt,name=windowMs,proto3,oneof" json:"windowMs,omitempty"`
+}
+
+func (x *Batch) Reset() {
+	*x = Batch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Batch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Batch) ProtoMessage() {}
+
+func (x *Batch) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Batch.ProtoReflect.Descriptor instead.
+func (*Batch) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Batch) GetSize() uint32 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *Batch) GetWindowMs() uint32 {
+	if x != nil && x.WindowMs != nil {
+		return *x.WindowMs
+	}
+	return 0
+}
+
+type PipelineInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExternalInputs   []string              `protobuf:"bytes,1,rep,name=externalInputs,proto3" json:"externalInputs,omitempty"`
+	ExternalTriggers []string              `protobuf:"bytes,2,rep,name=externalTriggers,proto3" json:"externalTriggers,omitempty"`
+	JoinWindowMs     *uint32               `protobuf:"varint,3,opt,name=joinWindowMs,proto3,oneof" json:"joinWindowMs,omitempty"` // Join window millisecs for output, default 0
+	JoinType         PipelineInput_JoinOp  `protobuf:"varint,4,opt,name=joinType,proto3,enum=seldon.mlops.scheduler.PipelineInput_JoinOp" json:"joinType,omitempty"`
+	TriggersJoin     PipelineInput_JoinOp  `protobuf:"varint,5,opt,name=triggersJoin,proto3,enum=seldon.mlops.scheduler.PipelineInput_JoinOp" json:"triggersJoin,omitempty"`
+	TensorMap        map[string]string     `protobuf:"bytes,6,rep,name=tensorMap,proto3" json:"tensorMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // optional map of tensor name mappings
+	KafkaSources     []*PipelineKafkaTopic `protobuf:"bytes,7,rep,name=kafkaSources,proto3" json:"kafkaSources,omitempty"`                                                                                   // external topics whose records are sent to the pipeline
+}
+
+func (x *PipelineInput) Reset() {
+	*x = PipelineInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineInput) ProtoMessage() {}
+
+func (x *PipelineInput) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineInput.ProtoReflect.Descriptor instead.
+func (*PipelineInput) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *PipelineInput) GetExternalInputs() []string {
+	if x != nil {
+		return x.ExternalInputs
+	}
+	return nil
+}
+
+func (x *PipelineInput) GetExternalTriggers() []string {
+	if x != nil {
+		return x.ExternalTriggers
+	}
+	return nil
+}
+
+func (x *PipelineInput) GetJoinWindowMs() uint32 {
+	if x != nil && x.JoinWindowMs != nil {
+		return *x.JoinWindowMs
+	}
+	return 0
+}
+
+func (x *PipelineInput) GetJoinType() PipelineInput_JoinOp {
+	if x != nil {
+		return x.JoinType
+	}
+	return PipelineInput_INNER
+}
+
+func (x *PipelineInput) GetTriggersJoin() PipelineInput_JoinOp {
+	if x != nil {
+		return x.TriggersJoin
+	}
+	return PipelineInput_INNER
+}
+
+func (x *PipelineInput) GetTensorMap() map[string]string {
+	if x != nil {
+		return x.TensorMap
+	}
+	return nil
+}
+
+func (x *PipelineInput) GetKafkaSources() []*PipelineKafkaTopic {
+	if x != nil {
+		return x.KafkaSources
+	}
+	return nil
+}
+
+type PipelineOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Steps        []string              `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	JoinWindowMs uint32                `protobuf:"varint,2,opt,name=joinWindowMs,proto3" json:"joinWindowMs,omitempty"` // Join window millisecs for output, default 0
+	StepsJoin    PipelineOutput_JoinOp `protobuf:"varint,3,opt,name=stepsJoin,proto3,enum=seldon.mlops.scheduler.PipelineOutput_JoinOp" json:"stepsJoin,omitempty"`
+	TensorMap    map[string]string     `protobuf:"bytes,4,rep,name=tensorMap,proto3" json:"tensorMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // optional map of tensor name mappings
+	KafkaSinks   []*PipelineKafkaTopic `protobuf:"bytes,5,rep,name=kafkaSinks,proto3" json:"kafkaSinks,omitempty"`                                                                                       // external topics the pipeline outputs are written to
+}
+
+func (x *PipelineOutput) Reset() {
+	*x = PipelineOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineOutput) ProtoMessage() {}
+
+func (x *PipelineOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineOutput.ProtoReflect.Descriptor instead.
+func (*PipelineOutput) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *PipelineOutput) GetSteps() []string {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *PipelineOutput) GetJoinWindowMs() uint32 {
+	if x != nil {
+		return x.JoinWindowMs
+	}
+	return 0
+}
+
+func (x *PipelineOutput) GetStepsJoin() PipelineOutput_JoinOp {
+	if x != nil {
+		return x.StepsJoin
+	}
+	return PipelineOutput_INNER
+}
+
+func (x *PipelineOutput) GetTensorMap() map[string]string {
+	if x != nil {
+		return x.TensorMap
+	}
+	return nil
+}
+
+func (x *PipelineOutput) GetKafkaSinks() []*PipelineKafkaTopic {
+	if x != nil {
+		return x.KafkaSinks
+	}
+	return nil
+}
+
+// An existing Kafka topic outside Seldon, with the format of its records
+type PipelineKafkaTopic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic   string                    `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"` // full topic name, the Seldon topic prefix is not added
+	Format  PipelineKafkaTopic_Format `protobuf:"varint,2,opt,name=format,proto3,enum=seldon.mlops.scheduler.PipelineKafkaTopic_Format" json:"format,omitempty"`
+	Tensors []*TensorSchema           `protobuf:"bytes,3,rep,name=tensors,proto3" json:"tensors,omitempty"` // tensors read from or written to each record, in order
+}
+
+func (x *PipelineKafkaTopic) Reset() {
+	*x = PipelineKafkaTopic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineKafkaTopic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineKafkaTopic) ProtoMessage() {}
+
+func (x *PipelineKafkaTopic) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineKafkaTopic.ProtoReflect.Descriptor instead.
+func (*PipelineKafkaTopic) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *PipelineKafkaTopic) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *PipelineKafkaTopic) GetFormat() PipelineKafkaTopic_Format {
+	if x != nil {
+		return x.Format
+	}
+	return PipelineKafkaTopic_JSON
+}
+
+func (x *PipelineKafkaTopic) GetTensors() []*TensorSchema {
+	if x != nil {
+		return x.Tensors
+	}
+	return nil
+}
+
+type LoadPipelineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LoadPipelineResponse) Reset() {
+	*x = LoadPipelineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadPipelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadPipelineResponse) ProtoMessage() {}
+
+func (x *LoadPipelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadPipelineResponse.ProtoReflect.Descriptor instead.
+func (*LoadPipelineResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{81}
+}
+
+type UpdatePipelineCanaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TrafficPercent uint32 `protobuf:"varint,2,opt,name=trafficPercent,proto3" json:"trafficPercent,omitempty"` // 100 promotes the canary version, 0 rolls it back
+}
+
+func (x *UpdatePipelineCanaryRequest) Reset() {
+	*x = UpdatePipelineCanaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePipelineCanaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePipelineCanaryRequest) ProtoMessage() {}
+
+func (x *UpdatePipelineCanaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePipelineCanaryRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePipelineCanaryRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UpdatePipelineCanaryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePipelineCanaryRequest) GetTrafficPercent() uint32 {
+	if x != nil {
+		return x.TrafficPercent
+	}
+	return 0
+}
+
+type UpdatePipelineCanaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdatePipelineCanaryResponse) Reset() {
+	*x = UpdatePipelineCanaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePipelineCanaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePipelineCanaryResponse) ProtoMessage() {}
+
+func (x *UpdatePipelineCanaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePipelineCanaryResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePipelineCanaryResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{83}
+}
+
+type KafkaGarbageCollectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun             bool    `protobuf:"varint,1,opt,name=dryRun,proto3" json:"dryRun,omitempty"`                               // list what would be deleted without deleting it
+	GracePeriodSeconds *uint32 `protobuf:"varint,2,opt,name=gracePeriodSeconds,proto3,oneof" json:"gracePeriodSeconds,omitempty"` // overrides the scheduler grace period
+}
+
+func (x *KafkaGarbageCollectRequest) Reset() {
+	*x = KafkaGarbageCollectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KafkaGarbageCollectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KafkaGarbageCollectRequest) ProtoMessage() {}
+
+func (x *KafkaGarbageCollectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KafkaGarbageCollectRequest.ProtoReflect.Descriptor instead.
+func (*KafkaGarbageCollectRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *KafkaGarbageCollectRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *KafkaGarbageCollectRequest) GetGracePeriodSeconds() uint32 {
+	if x != nil && x.GracePeriodSeconds != nil {
+		return *x.GracePeriodSeconds
+	}
+	return 0
+}
+
+type KafkaGarbageCollectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topics         []*KafkaOrphanedResource `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	ConsumerGroups []*KafkaOrphanedResource `protobuf:"bytes,2,rep,name=consumerGroups,proto3" json:"consumerGroups,omitempty"`
+}
+
+func (x *KafkaGarbageCollectResponse) Reset() {
+	*x = KafkaGarbageCollectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KafkaGarbageCollectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KafkaGarbageCollectResponse) ProtoMessage() {}
+
+func (x *KafkaGarbageCollectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KafkaGarbageCollectResponse.ProtoReflect.Descriptor instead.
+func (*KafkaGarbageCollectResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *KafkaGarbageCollectResponse) GetTopics() []*KafkaOrphanedResource {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *KafkaGarbageCollectResponse) GetConsumerGroups() []*KafkaOrphanedResource {
+	if x != nil {
+		return x.ConsumerGroups
+	}
+	return nil
+}
+
+// A Kafka topic or consumer group left behind by a deleted model or pipeline
+type KafkaOrphanedResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner           string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`                      // the model or pipeline (version) the resource belonged to
+	OrphanedSeconds uint64 `protobuf:"varint,3,opt,name=orphanedSeconds,proto3" json:"orphanedSeconds,omitempty"` // how long the owner has been known to be deleted
+	Deleted         bool   `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`                 // false for dry runs and resources still within the grace period
+}
+
+func (x *KafkaOrphanedResource) Reset() {
+	*x = KafkaOrphanedResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KafkaOrphanedResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KafkaOrphanedResource) ProtoMessage() {}
+
+func (x *KafkaOrphanedResource) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KafkaOrphanedResource.ProtoReflect.Descriptor instead.
+func (*KafkaOrphanedResource) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *KafkaOrphanedResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KafkaOrphanedResource) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *KafkaOrphanedResource) GetOrphanedSeconds() uint64 {
+	if x != nil {
+		return x.OrphanedSeconds
+	}
+	return 0
+}
+
+func (x *KafkaOrphanedResource) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+// Replays a range of a pipeline inputs topic through a pipeline. The outputs of the replayed requests are written
+// to a sink topic rather than the pipeline outputs topic.
+type ReplayPipelineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SourcePipeline *string                `protobuf:"bytes,2,opt,name=sourcePipeline,proto3,oneof" json:"sourcePipeline,omitempty"` // pipeline whose inputs topic is replayed, defaults to name
+	Version        uint32                 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`                    // only run the replayed requests through this pipeline version, 0 for any running version
+	SinkTopic      *string                `protobuf:"bytes,4,opt,name=sinkTopic,proto3,oneof" json:"sinkTopic,omitempty"`           // defaults to the replay topic of the pipeline
+	FromOffset     *int64                 `protobuf:"varint,5,opt,name=fromOffset,proto3,oneof" json:"fromOffset,omitempty"`        // offsets apply to every partition, unset bounds are the start and current end
+	ToOffset       *int64                 `protobuf:"varint,6,opt,name=toOffset,proto3,oneof" json:"toOffset,omitempty"`            // inclusive
+	FromTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=fromTime,proto3,oneof" json:"fromTime,omitempty"`
+	ToTime         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=toTime,proto3,oneof" json:"toTime,omitempty"` // exclusive
+}
+
+func (x *ReplayPipelineRequest) Reset() {
+	*x = ReplayPipelineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayPipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayPipelineRequest) ProtoMessage() {}
+
+func (x *ReplayPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayPipelineRequest.ProtoReflect.Descriptor instead.
+func (*ReplayPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ReplayPipelineRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReplayPipelineRequest) GetSourcePipeline() string {
+	if x != nil && x.SourcePipeline != nil {
+		return *x.SourcePipeline
+	}
+	return ""
+}
+
+func (x *ReplayPipelineRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ReplayPipelineRequest) GetSinkTopic() string {
+	if x != nil && x.SinkTopic != nil {
+		return *x.SinkTopic
+	}
+	return ""
+}
+
+func (x *ReplayPipelineRequest) GetFromOffset() int64 {
+	if x != nil && x.FromOffset != nil {
+		return *x.FromOffset
+	}
+	return 0
+}
+
+func (x *ReplayPipelineRequest) GetToOffset() int64 {
+	if x != nil && x.ToOffset != nil {
+		return *x.ToOffset
+	}
+	return 0
+}
+
+func (x *ReplayPipelineRequest) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromTime
+	}
+	return nil
+}
+
+func (x *ReplayPipelineRequest) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToTime
+	}
+	return nil
+}
+
+type ReplayPipelineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplayId  string `protobuf:"bytes,1,opt,name=replayId,proto3" json:"replayId,omitempty"`
+	SinkTopic string `protobuf:"bytes,2,opt,name=sinkTopic,proto3" json:"sinkTopic,omitempty"`
+}
+
+func (x *ReplayPipelineResponse) Reset() {
+	*x = ReplayPipelineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayPipelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayPipelineResponse) ProtoMessage() {}
+
+func (x *ReplayPipelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayPipelineResponse.ProtoReflect.Descriptor instead.
+func (*ReplayPipelineResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ReplayPipelineResponse) GetReplayId() string {
+	if x != nil {
+		return x.ReplayId
+	}
+	return ""
+}
+
+func (x *ReplayPipelineResponse) GetSinkTopic() string {
+	if x != nil {
+		return x.SinkTopic
+	}
+	return ""
+}
+
+type PipelineReplayStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplayId string `protobuf:"bytes,1,opt,name=replayId,proto3" json:"replayId,omitempty"`
+}
+
+func (x *PipelineReplayStatusRequest) Reset() {
+	*x = PipelineReplayStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineReplayStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineReplayStatusRequest) ProtoMessage() {}
+
+func (x *PipelineReplayStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineReplayStatusRequest.ProtoReflect.Descriptor instead.
+func (*PipelineReplayStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PipelineReplayStatusRequest) GetReplayId() string {
+	if x != nil {
+		return x.ReplayId
+	}
+	return ""
+}
+
+type PipelineReplayStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplayId  string                                   `protobuf:"bytes,1,opt,name=replayId,proto3" json:"replayId,omitempty"`
+	Name      string                                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SinkTopic string                                   `protobuf:"bytes,3,opt,name=sinkTopic,proto3" json:"sinkTopic,omitempty"`
+	State     PipelineReplayStatusResponse_ReplayState `protobuf:"varint,4,opt,name=state,proto3,enum=seldon.mlops.scheduler.PipelineReplayStatusResponse_ReplayState" json:"state,omitempty"`
+	Replayed  uint64                                   `protobuf:"varint,5,opt,name=replayed,proto3" json:"replayed,omitempty"` // requests sent to the pipeline so far
+	Reason    string                                   `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *PipelineReplayStatusResponse) Reset() {
+	*x = PipelineReplayStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineReplayStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineReplayStatusResponse) ProtoMessage() {}
+
+func (x *PipelineReplayStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineReplayStatusResponse.ProtoReflect.Descriptor instead.
+func (*PipelineReplayStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *PipelineReplayStatusResponse) GetReplayId() string {
+	if x != nil {
+		return x.ReplayId
+	}
+	return ""
+}
+
+func (x *PipelineReplayStatusResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PipelineReplayStatusResponse) GetSinkTopic() string {
+	if x != nil {
+		return x.SinkTopic
+	}
+	return ""
+}
+
+func (x *PipelineReplayStatusResponse) GetState() PipelineReplayStatusResponse_ReplayState {
+	if x != nil {
+		return x.State
+	}
+	return PipelineReplayStatusResponse_REPLAY_RUNNING
+}
+
+func (x *PipelineReplayStatusResponse) GetReplayed() uint64 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+func (x *PipelineReplayStatusResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnloadPipelineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *UnloadPipelineRequest) Reset() {
+	*x = UnloadPipelineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnloadPipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnloadPipelineRequest) ProtoMessage() {}
+
+func (x *UnloadPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnloadPipelineRequest.ProtoReflect.Descriptor instead.
+func (*UnloadPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *UnloadPipelineRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UnloadPipelineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnloadPipelineResponse) Reset() {
+	*x = UnloadPipelineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnloadPipelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnloadPipelineResponse) ProtoMessage() {}
+
+func (x *UnloadPipelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnloadPipelineResponse.ProtoReflect.Descriptor instead.
+func (*UnloadPipelineResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{92}
+}
+
+type PipelineStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriberName string  `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
+	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"` // Leave empty for all pipelines
+	AllVersions    bool    `protobuf:"varint,3,opt,name=allVersions,proto3" json:"allVersions,omitempty"`
+}
+
+func (x *PipelineStatusRequest) Reset() {
+	*x = PipelineStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineStatusRequest) ProtoMessage() {}
+
+func (x *PipelineStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineStatusRequest.ProtoReflect.Descriptor instead.
+func (*PipelineStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *PipelineStatusRequest) GetSubscriberName() string {
+	if x != nil {
+		return x.SubscriberName
+	}
+	return ""
+}
+
+func (x *PipelineStatusRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *PipelineStatusRequest) GetAllVersions() bool {
+	if x != nil {
+		return x.AllVersions
+	}
+	return false
+}
+
+type PipelineSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"` //Name of the subscription caller
+}
+
+func (x *PipelineSubscriptionRequest) Reset() {
+	*x = PipelineSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineSubscriptionRequest) ProtoMessage() {}
+
+func (x *PipelineSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*PipelineSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *PipelineSubscriptionRequest) GetSubscriberName() string {
+	if x != nil {
+		return x.SubscriberName
+	}
+	return ""
+}
+
+type PipelineStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PipelineName string               `protobuf:"bytes,1,opt,name=pipelineName,proto3" json:"pipelineName,omitempty"`
+	Versions     []*PipelineWithState `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *PipelineStatusResponse) Reset() {
+	*x = PipelineStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineStatusResponse) ProtoMessage() {}
+
+func (x *PipelineStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineStatusResponse.ProtoReflect.Descriptor instead.
+func (*PipelineStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *PipelineStatusResponse) GetPipelineName() string {
+	if x != nil {
+		return x.PipelineName
+	}
+	return ""
+}
+
+func (x *PipelineStatusResponse) GetVersions() []*PipelineWithState {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type PipelineWithState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline *Pipeline             `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	State    *PipelineVersionState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *PipelineWithState) Reset() {
+	*x = PipelineWithState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineWithState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineWithState) ProtoMessage() {}
+
+func (x *PipelineWithState) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineWithState.ProtoReflect.Descriptor instead.
+func (*PipelineWithState) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *PipelineWithState) GetPipeline() *Pipeline {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+func (x *PipelineWithState) GetState() *PipelineVersionState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type PipelineVersionState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PipelineVersion     uint32                              `protobuf:"varint,1,opt,name=pipelineVersion,proto3" json:"pipelineVersion,omitempty"`
+	Status              PipelineVersionState_PipelineStatus `protobuf:"varint,2,opt,name=status,proto3,enum=seldon.mlops.scheduler.PipelineVersionState_PipelineStatus" json:"status,omitempty"`
+	Reason              string                              `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	LastChangeTimestamp *timestamppb.Timestamp              `protobuf:"bytes,4,opt,name=lastChangeTimestamp,proto3" json:"lastChangeTimestamp,omitempty"`
+	ModelsReady         bool                                `protobuf:"varint,5,opt,name=modelsReady,proto3" json:"modelsReady,omitempty"`
+}
+
+func (x *PipelineVersionState) Reset() {
+	*x = PipelineVersionState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineVersionState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineVersionState) ProtoMessage() {}
+
+func (x *PipelineVersionState) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineVersionState.ProtoReflect.Descriptor instead.
+func (*PipelineVersionState) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *PipelineVersionState) GetPipelineVersion() uint32 {
+	if x != nil {
+		return x.PipelineVersion
+	}
+	return 0
+}
+
+func (x *PipelineVersionState) GetStatus() PipelineVersionState_PipelineStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PipelineVersionState_PipelineStatusUnknown
+}
+
+func (x *PipelineVersionState) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PipelineVersionState) GetLastChangeTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastChangeTimestamp
+	}
+	return nil
+}
+
+func (x *PipelineVersionState) GetModelsReady() bool {
+	if x != nil {
+		return x.ModelsReady
+	}
+	return false
+}
+
+type SchedulerStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
+}
+
+func (x *SchedulerStatusRequest) Reset() {
+	*x = SchedulerStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerStatusRequest) ProtoMessage() {}
+
+func (x *SchedulerStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerStatusRequest.ProtoReflect.Descriptor instead.
+func (*SchedulerStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SchedulerStatusRequest) GetSubscriberName() string {
+	if x != nil {
+		return x.SubscriberName
+	}
+	return ""
+}
+
+type SchedulerStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationVersion string `protobuf:"bytes,1,opt,name=applicationVersion,proto3" json:"applicationVersion,omitempty"`
+}
+
+func (x *SchedulerStatusResponse) Reset() {
+	*x = SchedulerStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerStatusResponse) ProtoMessage() {}
+
+func (x *SchedulerStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerStatusResponse.ProtoReflect.Descriptor instead.
+func (*SchedulerStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SchedulerStatusResponse) GetApplicationVersion() string {
+	if x != nil {
+		return x.ApplicationVersion
+	}
+	return ""
+}
+
+// Summary of the models available in the region of a scheduler, polled by the schedulers of other regions
+type FederationSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"` // region of the calling scheduler
+}
+
+func (x *FederationSummaryRequest) Reset() {
+	*x = FederationSummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederationSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederationSummaryRequest) ProtoMessage() {}
+
+func (x *FederationSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederationSummaryRequest.ProtoReflect.Descriptor instead.
+func (*FederationSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *FederationSummaryRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type FederationSummaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Region        string            `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	InferenceHost string            `protobuf:"bytes,2,opt,name=inferenceHost,proto3" json:"inferenceHost,omitempty"` // host of the Envoy of the region for requests from other regions
+	InferencePort uint32            `protobuf:"varint,3,opt,name=inferencePort,proto3" json:"inferencePort,omitempty"`
+	Models        []*FederatedModel `protobuf:"bytes,4,rep,name=models,proto3" json:"models,omitempty"` // models with available replicas in the region
+}
+
+func (x *FederationSummaryResponse) Reset() {
+	*x = FederationSummaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederationSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederationSummaryResponse) ProtoMessage() {}
+
+func (x *FederationSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederationSummaryResponse.ProtoReflect.Descriptor instead.
+func (*FederationSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *FederationSummaryResponse) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *FederationSummaryResponse) GetInferenceHost() string {
+	if x != nil {
+		return x.InferenceHost
+	}
+	return ""
+}
+
+func (x *FederationSummaryResponse) GetInferencePort() uint32 {
+	if x != nil {
+		return x.InferencePort
+	}
+	return 0
+}
+
+func (x *FederationSummaryResponse) GetModels() []*FederatedModel {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+type FederatedModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name              string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AvailableReplicas uint32 `protobuf:"varint,2,opt,name=availableReplicas,proto3" json:"availableReplicas,omitempty"`
+	SpecHash          string `protobuf:"bytes,3,opt,name=specHash,proto3" json:"specHash,omitempty"` // hash of the artifacts and parameters of the latest version, matched by other regions
+}
+
+func (x *FederatedModel) Reset() {
+	*x = FederatedModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederatedModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederatedModel) ProtoMessage() {}
+
+func (x *FederatedModel) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederatedModel.ProtoReflect.Descriptor instead.
+func (*FederatedModel) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *FederatedModel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FederatedModel) GetAvailableReplicas() uint32 {
+	if x != nil {
+		return x.AvailableReplicas
+	}
+	return 0
+}
+
+func (x *FederatedModel) GetSpecHash() string {
+	if x != nil {
+		return x.SpecHash
+	}
+	return ""
+}
+
+var File_mlops_scheduler_scheduler_proto protoreflect.FileDescriptor
+
+var file_mlops_scheduler_scheduler_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x16, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x47, 0x0a, 0x10, 0x4c, 0x6f,
+	0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33,
+	0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x05, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x22, 0x92, 0x02, 0x0a, 0x05, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x34, 0x0a,
+	0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x12, 0x3f, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x4e, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x42, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x70,
+	0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x22, 0xd3, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x74,
+	0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d,
+	0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74,
+	0x61, 0x48, 0x02, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d,
+	0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x92,
+	0x01, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x73, 0x22, 0x88, 0x06, 0x0a, 0x09, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x2d, 0x0a, 0x0f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0f,
+	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x50, 0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x6c, 0x64,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x48, 0x01, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52,
+	0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x09,
+	0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x48, 0x04, 0x52, 0x09, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x6c,
+	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x50, 0x0a,
+	0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x05, 0x52, 0x0d, 0x74,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12,
+	0x56, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x48, 0x06, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x75, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x48, 0x07, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x42,
+	0x12, 0x0a, 0x10, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x22, 0xa3,
+	0x01, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x12, 0x39, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x75, 0x74, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x48, 0x0a,
+	0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x48, 0x00, 0x52, 0x08, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x73, 0x69, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x73, 0x69, 0x73, 0x22, 0x57, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x53,
+	0x74, 0x65, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x68,
+	0x6f, 0x6c, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xae, 0x02,
+	0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
+	0x73, 0x12, 0x27, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x6d, 0x61,
+	0x78, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x01, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x5b, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x28, 0x0a, 0x0d, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x01, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x6d, 0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x22, 0x49,
+	0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0xbb, 0x03, 0x0a, 0x0d, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3e,
+	0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x48, 0x01, 0x52, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x53,
+	0x0a, 0x0e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x48, 0x02,
+	0x52, 0x0e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x59, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x03, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x6c, 0x69,
+	0x65, 0x72, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x49,
+	0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x04, 0x52, 0x09, 0x72, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72,
+	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x79,
+	0x4f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x2d, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x54, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x65,
+	0x72, 0x54, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x88, 0x01, 0x01,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x65, 0x72, 0x54, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x4d, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x00, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x01, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d, 0x61,
+	0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x15, 0x0a, 0x13,
+	0x5f, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x22, 0x8c, 0x02, 0x0a, 0x10, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x12, 0x62,
+	0x61, 0x73, 0x65, 0x45, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x12, 0x62, 0x61, 0x73, 0x65, 0x45,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x33, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x45, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x12,
+	0x6d, 0x61, 0x78, 0x45, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x4d, 0x73, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x42, 0x15, 0x0a, 0x13, 0x5f,
+	0x6d, 0x61, 0x78, 0x45, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x22, 0xce, 0x02, 0x0a, 0x0e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3f, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
+	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x55, 0x6e, 0x69, 0x74,
+	0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x3e, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x22, 0x28, 0x0a, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d,
+	0x49, 0x4e, 0x55, 0x54, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x55, 0x52, 0x10,
+	0x02, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x22, 0x67, 0x0a, 0x0e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x88,
+	0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x0d,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6c,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a,
+	0x08, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x12, 0x25,
+	0x0a, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x66, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x66, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
+	0x65, 0x66, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x66, 0x22, 0x4e, 0x0a, 0x0e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
+	0x4d, 0x65, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x89, 0x02, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x12, 0x4a, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48,
+	0x00, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x4f, 0x0a, 0x0b, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x61,
+	0x66, 0x6b, 0x61, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01,
+	0x52, 0x0b, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88, 0x01, 0x01,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xc6,
+	0x02, 0x0a, 0x10, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x72,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x02, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0d, 0x63, 0x6c, 0x65,
+	0x61, 0x6e, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x55, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50,
+	0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x22, 0x7d,
+	0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x2e, 0x0a, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x11, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x32, 0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x63, 0x6c, 0x6f, 0x6e, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x13,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x13, 0x0a,
+	0x11, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x4f, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0xba, 0x01, 0x0a, 0x12, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61,
+	0x22, 0x15, 0x0a, 0x13, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xec, 0x01, 0x0a, 0x13, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a,
+	0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
+	0x49, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x07,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x22, 0xad, 0x03, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4d, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x74, 0x65, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70,
+	0x12, 0x26, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x4c, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x66,
+	0x0a, 0x0c, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x12, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75,
+	0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x10, 0x02,
+	0x12, 0x14, 0x0a, 0x10, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x65, 0x64, 0x65, 0x64, 0x10, 0x03, 0x22, 0xa4, 0x04, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
+	0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x6f, 0x0a, 0x11,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x44, 0x65, 0x66, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x48, 0x01, 0x52, 0x09, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x6e, 0x88, 0x01, 0x01, 0x1a, 0x70, 0x0a, 0x16, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x6e, 0x22, 0xd3, 0x03,
+	0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x75, 0x6e, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x4c, 0x0a, 0x13, 0x6c,
+	0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xb7, 0x01, 0x0a, 0x0a, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12,
+	0x14, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x04,
+	0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x64, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x06, 0x12,
+	0x12, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x10, 0x07, 0x22, 0xd0, 0x03, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x52, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x73, 0x65, 0x6c, 0x64,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0xff, 0x01, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x55,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4c,
+	0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x6f, 0x61, 0x64,
+	0x65, 0x64, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x65, 0x64, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x09, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x55, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x0a, 0x12, 0x18,
+	0x0a, 0x14, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x10, 0x0b, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x72, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x10, 0x0c, 0x22, 0x5f, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xfe, 0x02, 0x0a, 0x14, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x4c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
+	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x2a,
+	0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x6e, 0x75, 0x6d, 0x4c,
+	0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x6e, 0x75, 0x6d, 0x4c, 0x6f, 0x61,
+	0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73,
+	0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65,
+	0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61,
+	0x48, 0x00, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65,
+	0x74, 0x61, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x22, 0xf6, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x49, 0x64, 0x78, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x32, 0x0a, 0x14, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6e, 0x75,
+	0x6d, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x32, 0x0a,
+	0x14, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6f, 0x76, 0x65,
+	0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x22, 0x42, 0x0a, 0x18, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
+	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c,
+	0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x22, 0xd5, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x2a, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d,
+	0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x16, 0x0a, 0x14, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x19, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x42, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xdc, 0x04, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x48,
+	0x01, 0x52, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x02, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x88, 0x01, 0x01, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d,
+	0x65, 0x74, 0x61, 0x48, 0x03, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
+	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x5a, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x65, 0x6c, 0x64,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x04, 0x52, 0x0d, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d,
+	0x69, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d,
+	0x65, 0x74, 0x61, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0xa0, 0x02, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x74,
+	0x69, 0x63, 0x6b, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x41, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x64, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
+	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x64,
+	0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x64,
+	0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x48, 0x01, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x69, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0xd2, 0x02, 0x0a, 0x12, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x3d, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39,
+	0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x12, 0x6d, 0x61, 0x78,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x4e,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x03,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x6d, 0x61, 0x78,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a,
+	0x13, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x49, 0x73, 0x42, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x73, 0x42, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x22, 0xc6, 0x02, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x31,
+	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x11, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x65, 0x64, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x03, 0x52,
+	0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x41, 0x74, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x65, 0x64, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x22, 0x77, 0x0a, 0x0e, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x65, 0x6c,
+	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x19, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65,
+	0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78,
+	0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x03,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x78,
+	0x61, 0x63, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x22, 0x3d, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x65, 0x6e, 0x64,
+	0x22, 0xcd, 0x03, 0x0a, 0x0c, 0x42, 0x61, 0x6e, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x43, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x64, 0x69,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x07, 0x65, 0x70, 0x73, 0x69, 0x6c,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x15, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x15, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x5f, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x65,
+	0x74, 0x68, 0x65, 0x75, 0x73, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x03, 0x52, 0x10, 0x70,
+	0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x88,
+	0x01, 0x01, 0x22, 0x33, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x0e,
+	0x45, 0x50, 0x53, 0x49, 0x4c, 0x4f, 0x4e, 0x5f, 0x47, 0x52, 0x45, 0x45, 0x44, 0x59, 0x10, 0x00,
+	0x12, 0x15, 0x0a, 0x11, 0x54, 0x48, 0x4f, 0x4d, 0x50, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x41, 0x4d,
+	0x50, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x70, 0x73, 0x69,
+	0x6c, 0x6f, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f,
+	0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x22, 0x5a, 0x0a, 0x16, 0x42, 0x61, 0x6e, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x65, 0x74,
+	0x68, 0x65, 0x75, 0x73, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x22, 0x41, 0x0a, 0x13,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0x9e, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x69,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e,
+	0x22, 0x4a, 0x0a, 0x10, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x69, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x19, 0x0a, 0x17,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x53, 0x74, 0x6f, 0x70, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47,
+	0x0a, 0x1d, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xde, 0x04, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x20,
+	0x0a, 0x0b, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x64, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x64, 0x79,
+	0x12, 0x2c, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53,
+	0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00,
+	0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61,
+	0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x5f, 0x0a, 0x10, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x69, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x65, 0x6c,
+	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x69, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x01, 0x52, 0x10, 0x73, 0x68,
+	0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x50, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
+	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x48, 0x02, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x68, 0x61, 0x64, 0x6f,
+	0x77, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0xe2, 0x02, 0x0a, 0x16, 0x53, 0x68, 0x61,
+	0x64, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12,
+	0x2c, 0x0a, 0x11, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x75, 0x6e, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x69, 0x72, 0x72, 0x6f,
+	0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x69, 0x73, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x65, 0x61, 0x6e, 0x4c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x4d, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x12, 0x6d, 0x65, 0x61, 0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x44, 0x65, 0x6c, 0x74, 0x61, 0x4d, 0x73, 0x12, 0x44, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0xa6, 0x01,
+	0x0a, 0x12, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x41, 0x62, 0x73, 0x44, 0x69,
+	0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x41, 0x62, 0x73,
+	0x44, 0x69, 0x66, 0x66, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x6e, 0x41, 0x62, 0x73, 0x44,
+	0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x6e, 0x41,
+	0x62, 0x73, 0x44, 0x69, 0x66, 0x66, 0x22, 0x97, 0x01, 0x0a, 0x19, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x22, 0x1c, 0x0a, 0x1a, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc0,
+	0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x29, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0d, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x22, 0xc4, 0x02, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x46, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0a, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x0b, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x40, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x22, 0x93, 0x04, 0x0a, 0x0e, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x11, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2e, 0x0a,
+	0x12, 0x6d, 0x65, 0x61, 0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6d, 0x65, 0x61, 0x6e, 0x4c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x53, 0x0a,
+	0x10, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65,
+	0x52, 0x10, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x64, 0x62,
+	0x61, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x61, 0x6e,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x65,
+	0x61, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x5f, 0x0a, 0x12, 0x6d, 0x65, 0x61, 0x6e,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x48, 0x00, 0x52, 0x12, 0x6d, 0x65, 0x61, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x6d, 0x65,
+	0x61, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x22, 0x47, 0x0a, 0x0f, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xeb, 0x05, 0x0a, 0x13, 0x43, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x24, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74,
+	0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x60, 0x0a, 0x15, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61,
+	0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x52, 0x15, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x61, 0x74, 0x65, 0x50, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x50, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x36, 0x0a, 0x16, 0x6d, 0x65, 0x61, 0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x44, 0x69, 0x66, 0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x16, 0x6d, 0x65, 0x61, 0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69,
+	0x66, 0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x0e, 0x6d, 0x65, 0x61,
+	0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x66, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x65, 0x61, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44,
+	0x69, 0x66, 0x66, 0x88, 0x01, 0x01, 0x12, 0x67, 0x0a, 0x16, 0x6d, 0x65, 0x61, 0x6e, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x48, 0x01, 0x52, 0x16, 0x6d, 0x65, 0x61, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x44, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x2f, 0x0a, 0x10, 0x6d, 0x65, 0x61, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x10, 0x6d, 0x65, 0x61,
+	0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x69, 0x0a, 0x17, 0x6d, 0x65, 0x61, 0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x44,
+	0x69, 0x66, 0x66, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x48, 0x03, 0x52,
+	0x17, 0x6d, 0x65, 0x61, 0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x66, 0x66,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x6c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x04, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x66, 0x66, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x6d, 0x65,
+	0x61, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x50, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x6d, 0x65,
+	0x61, 0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x50, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x53, 0x0a, 0x13, 0x4c, 0x6f, 0x61, 0x64, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c,
+	0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x63, 0x0a, 0x17,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0xd9, 0x06, 0x0a, 0x08, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a,
+	0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53,
+	0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x43, 0x0a, 0x06, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c,
+	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74,
+	0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x48,
+	0x01, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74,
+	0x61, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
+	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x02, 0x52, 0x05, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x48, 0x03,
+	0x52, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x06, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x48, 0x04, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x88, 0x01, 0x01,
+	0x12, 0x4f, 0x0a, 0x0b, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b,
+	0x61, 0x66, 0x6b, 0x61, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x05, 0x52, 0x0b, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88, 0x01,
+	0x01, 0x12, 0x50, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48,
+	0x06, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x56, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c,
+	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x07, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8e, 0x01,
+	0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x12, 0x3c, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x3e,
+	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0x54,
+	0x0a, 0x0c, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x73,
+	0x68, 0x61, 0x70, 0x65, 0x22, 0x38, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xb4,
+	0x05, 0x0a, 0x0c, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0c, 0x6a,
+	0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x00, 0x52, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61,
+	0x70, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x54, 0x65,
+	0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x65,
+	0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x4b, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x65,
+	0x70, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73,
+	0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73,
+	0x12, 0x4f, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x4a, 0x6f, 0x69,
+	0x6e, 0x4f, 0x70, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69,
+	0x6e, 0x12, 0x33, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x49, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x6c,
+	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x73, 0x12, 0x3e, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x45, 0x61, 0x63, 0x68, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
+	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x45,
+	0x61, 0x63, 0x68, 0x48, 0x01, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x45, 0x61, 0x63, 0x68, 0x88, 0x01,
+	0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x27, 0x0a, 0x06, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x4e,
+	0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12,
+	0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x02, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6a, 0x6f, 0x69,
+	0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x6f,
+	0x72, 0x45, 0x61, 0x63, 0x68, 0x22, 0xaf, 0x01, 0x0a, 0x07, 0x46, 0x6f, 0x72, 0x45, 0x61, 0x63,
+	0x68, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x78, 0x69,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x61, 0x78, 0x69, 0x73, 0x12, 0x25, 0x0a,
+	0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73,
+	0x6d, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x68, 0x65, 0x72, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52,
+	0x0f, 0x67, 0x61, 0x74, 0x68, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73,
+	0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c,
+	0x69, 0x73, 0x6d, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x67, 0x61, 0x74, 0x68, 0x65, 0x72, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0xed, 0x02, 0x0a, 0x11, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x4b, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x65,
+	0x6e, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x65, 0x6e,
+	0x73, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x78, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x61, 0x78,
+	0x69, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x73,
+	0x68, 0x61, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x22, 0x65, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4e, 0x43, 0x41, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a,
+	0x05, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x4c, 0x45,
+	0x43, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x43, 0x41, 0x53, 0x54, 0x10, 0x03, 0x12, 0x0b,
+	0x0a, 0x07, 0x52, 0x45, 0x53, 0x48, 0x41, 0x50, 0x45, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x43,
+	0x4f, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x53, 0x4f,
+	0x4e, 0x50, 0x41, 0x54, 0x48, 0x10, 0x06, 0x22, 0x57, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x08, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73,
+	0x22, 0xc4, 0x04, 0x0a, 0x0d, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0c,
+	0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x48, 0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x52,
+	0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x50, 0x0a, 0x0c, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x52, 0x0c, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x52, 0x0a, 0x09, 0x74,
+	0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34,
+	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x12,
+	0x4e, 0x0a, 0x0c, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x52, 0x0c, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x1a,
+	0x3c, 0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x27, 0x0a,
+	0x06, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x4e, 0x45, 0x52,
+	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x07, 0x0a,
+	0x03, 0x41, 0x4e, 0x59, 0x10, 0x02, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x22, 0x9f, 0x03, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x65, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x4d, 0x73, 0x12, 0x4b, 0x0a, 0x09, 0x73, 0x74, 0x65, 0x70, 0x73, 0x4a, 0x6f, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e,
+	0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x52, 0x09, 0x73, 0x74, 0x65, 0x70, 0x73, 0x4a, 0x6f, 0x69,
+	0x6e, 0x12, 0x53, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x54, 0x65, 0x6e,
+	0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x65, 0x6e,
+	0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x4a, 0x0a, 0x0a, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x53,
+	0x69, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c,
+	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4b, 0x61, 0x66, 0x6b,
+	0x61, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x0a, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x53, 0x69, 0x6e,
+	0x6b, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x27, 0x0a, 0x06, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e,
+	0x4e, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x01,
+	0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x02, 0x22, 0xdd, 0x01, 0x0a, 0x12, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x49, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x3e, 0x0a, 0x07, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
+	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x6e, 0x73,
+	0x6f, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x07, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72,
+	0x73, 0x22, 0x26, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x4a,
+	0x53, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x09,
+	0x0a, 0x05, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x02, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x6f, 0x61,
+	0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x59, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x1e, 0x0a, 0x1c,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x61,
+	0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x80, 0x01, 0x0a,
+	0x1a, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x12, 0x33, 0x0a, 0x12, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x00, 0x52, 0x12, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x67, 0x72, 0x61,
+	0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22,
+	0xbb, 0x01, 0x0a, 0x1b, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x45, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x4f, 0x72,
+	0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x55, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x4f, 0x72, 0x70,
+	0x68, 0x61, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0e, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x85, 0x01,
+	0x0a, 0x15, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x72, 0x70, 0x68,
+	0x61, 0x6e, 0x65, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xa6, 0x03, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x09, 0x73, 0x69,
+	0x6e, 0x6b, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x09, 0x73, 0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a,
+	0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x02, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x74, 0x6f, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x08, 0x74, 0x6f, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x48, 0x04, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x37, 0x0a, 0x06, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x05, 0x52, 0x06,
+	0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x73, 0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x6f,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x54,
+	0x69, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x52,
+	0x0a, 0x16, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x22, 0x39, 0x0a, 0x1b, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x64, 0x22, 0xc4, 0x02,
+	0x0a, 0x1c, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x56, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x40, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x50, 0x4c, 0x41,
+	0x59, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x52,
+	0x45, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x02, 0x22, 0x2b, 0x0a, 0x15, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x15,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x45, 0x0a, 0x1b, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x16, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x6c, 0x64,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x95,
+	0x01, 0x0a, 0x11, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xe4, 0x03, 0x0a, 0x14, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x73, 0x65, 0x6c, 0x64,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x61, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x22, 0xc4, 0x01, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x11,
+	0x0a, 0x0d, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x61, 0x64, 0x79, 0x10,
+	0x03, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x10, 0x07, 0x22, 0x40, 0x0a,
+	0x16, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x49, 0x0a, 0x17, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x32, 0x0a, 0x18, 0x46, 0x65,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0xbf,
+	0x01, 0x0a, 0x19, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x3e, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x22, 0x6e, 0x0a, 0x0e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x63, 0x48, 0x61, 0x73, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x70, 0x65, 0x63, 0x48, 0x61, 0x73, 0x68,
+	0x2a, 0x27, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x09, 0x0a, 0x05, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x50,
+	0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x32, 0xcc, 0x15, 0x0a, 0x09, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x6b, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x09, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x12, 0x28, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0b, 0x55, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
+	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0c, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
+	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x71, 0x0a, 0x0e, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x2d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x33, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x34, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x4b, 0x61, 0x66,
+	0x6b, 0x61, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x12, 0x32, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x47,
+	0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x61,
+	0x66, 0x6b, 0x61, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x0e, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2d, 0x2e,
+	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x83,
+	0x01, 0x0a, 0x14, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x0e, 0x53, 0x74,
+	0x6f, 0x70, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7d, 0x0a,
+	0x12, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62,
+	0x61, 0x63, 0x6b, 0x12, 0x31, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
+	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x0f,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x6d, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
+	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x6a, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x73, 0x0a,
+	0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x79, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x74, 0x0a,
+	0x0f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2f, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x11, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x30, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x65, 0x6c,
+	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x7c, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x79, 0x0a,
+	0x14, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x88, 0x01, 0x0a, 0x19, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x82, 0x01, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x33, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x69, 0x6f, 0x2f,
+	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x73,
+	0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2f, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mlops_scheduler_scheduler_proto_rawDescOnce sync.Once
+	file_mlops_scheduler_scheduler_proto_rawDescData = file_mlops_scheduler_scheduler_proto_rawDesc
+)
+
+func file_mlops_scheduler_scheduler_proto_rawDescGZIP() []byte {
+	file_mlops_scheduler_scheduler_proto_rawDescOnce.Do(func() {
+		file_mlops_scheduler_scheduler_proto_rawDescData = protoimpl.X.CompressGZIP(file_mlops_scheduler_scheduler_proto_rawDescData)
+	})
+	return file_mlops_scheduler_scheduler_proto_rawDescData
+}
+
+var file_mlops_scheduler_scheduler_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_mlops_scheduler_scheduler_proto_msgTypes = make([]protoimpl.MessageInfo, 107)
+var file_mlops_scheduler_scheduler_proto_goTypes = []interface{}{
+	(ResourceType)(0),                             // 0: seldon.mlops.scheduler.ResourceType
+	(RolloutAnalysis_FailureAction)(0),            // 1: seldon.mlops.scheduler.RolloutAnalysis.FailureAction
+	(RouteRateLimit_Unit)(0),                      // 2: seldon.mlops.scheduler.RouteRateLimit.Unit
+	(ModelRolloutStatus_RolloutState)(0),          // 3: seldon.mlops.scheduler.ModelRolloutStatus.RolloutState
+	(ModelStatus_ModelState)(0),                   // 4: seldon.mlops.scheduler.ModelStatus.ModelState
+	(ModelReplicaStatus_ModelReplicaState)(0),     // 5: seldon.mlops.scheduler.ModelReplicaStatus.ModelReplicaState
+	(BanditConfig_Policy)(0),                      // 6: seldon.mlops.scheduler.BanditConfig.Policy
+	(PipelineStep_JoinOp)(0),                      // 7: seldon.mlops.scheduler.PipelineStep.JoinOp
+	(PipelineTransform_TransformType)(0),          // 8: seldon.mlops.scheduler.PipelineTransform.TransformType
+	(PipelineInput_JoinOp)(0),                     // 9: seldon.mlops.scheduler.PipelineInput.JoinOp
+	(PipelineOutput_JoinOp)(0),                    // 10: seldon.mlops.scheduler.PipelineOutput.JoinOp
+	(PipelineKafkaTopic_Format)(0),                // 11: seldon.mlops.scheduler.PipelineKafkaTopic.Format
+	(PipelineReplayStatusResponse_ReplayState)(0), // 12: seldon.mlops.scheduler.PipelineReplayStatusResponse.ReplayState
+	(PipelineVersionState_PipelineStatus)(0),      // 13: seldon.mlops.scheduler.PipelineVersionState.PipelineStatus
+	(*LoadModelRequest)(nil),                      // 14: seldon.mlops.scheduler.LoadModelRequest
+	(*Model)(nil),                                 // 15: seldon.mlops.scheduler.Model
+	(*MetaData)(nil),                              // 16: seldon.mlops.scheduler.MetaData
+	(*DeploymentSpec)(nil),                        // 17: seldon.mlops.scheduler.DeploymentSpec
+	(*ModelSpec)(nil),                             // 18: seldon.mlops.scheduler.ModelSpec
+	(*RolloutStrategy)(nil),                       // 19: seldon.mlops.scheduler.RolloutStrategy
+	(*RolloutStep)(nil),                           // 20: seldon.mlops.scheduler.RolloutStep
+	(*RolloutAnalysis)(nil),                       // 21: seldon.mlops.scheduler.RolloutAnalysis
+	(*AuthorizationPolicy)(nil),                   // 22: seldon.mlops.scheduler.AuthorizationPolicy
+	(*TrafficPolicy)(nil),                         // 23: seldon.mlops.scheduler.TrafficPolicy
+	(*RetryPolicy)(nil),                           // 24: seldon.mlops.scheduler.RetryPolicy
+	(*CircuitBreaker)(nil),                        // 25: seldon.mlops.scheduler.CircuitBreaker
+	(*OutlierDetection)(nil),                      // 26: seldon.mlops.scheduler.OutlierDetection
+	(*RouteRateLimit)(nil),                        // 27: seldon.mlops.scheduler.RouteRateLimit
+	(*RateLimitQuota)(nil),                        // 28: seldon.mlops.scheduler.RateLimitQuota
+	(*ParameterSpec)(nil),                         // 29: seldon.mlops.scheduler.ParameterSpec
+	(*ExplainerSpec)(nil),                         // 30: seldon.mlops.scheduler.ExplainerSpec
+	(*KubernetesMeta)(nil),                        // 31: seldon.mlops.scheduler.KubernetesMeta
+	(*StreamSpec)(nil),                            // 32: seldon.mlops.scheduler.StreamSpec
+	(*KafkaTopicConfig)(nil),                      // 33: seldon.mlops.scheduler.KafkaTopicConfig
+	(*StreamRateLimit)(nil),                       // 34: seldon.mlops.scheduler.StreamRateLimit
+	(*StorageConfig)(nil),                         // 35: seldon.mlops.scheduler.StorageConfig
+	(*LoadModelResponse)(nil),                     // 36: seldon.mlops.scheduler.LoadModelResponse
+	(*ModelReference)(nil),                        // 37: seldon.mlops.scheduler.ModelReference
+	(*UnloadModelRequest)(nil),                    // 38: seldon.mlops.scheduler.UnloadModelRequest
+	(*UnloadModelResponse)(nil),                   // 39: seldon.mlops.scheduler.UnloadModelResponse
+	(*ModelStatusResponse)(nil),                   // 40: seldon.mlops.scheduler.ModelStatusResponse
+	(*ModelRolloutStatus)(nil),                    // 41: seldon.mlops.scheduler.ModelRolloutStatus
+	(*ModelVersionStatus)(nil),                    // 42: seldon.mlops.scheduler.ModelVersionStatus
+	(*ModelStatus)(nil),                           // 43: seldon.mlops.scheduler.ModelStatus
+	(*ModelReplicaStatus)(nil),                    // 44: seldon.mlops.scheduler.ModelReplicaStatus
+	(*ServerStatusRequest)(nil),                   // 45: seldon.mlops.scheduler.ServerStatusRequest
+	(*ServerStatusResponse)(nil),                  // 46: seldon.mlops.scheduler.ServerStatusResponse
+	(*ServerReplicaResources)(nil),                // 47: seldon.mlops.scheduler.ServerReplicaResources
+	(*ModelSubscriptionRequest)(nil),              // 48: seldon.mlops.scheduler.ModelSubscriptionRequest
+	(*ModelStatusRequest)(nil),                    // 49: seldon.mlops.scheduler.ModelStatusRequest
+	(*ServerNotifyRequest)(nil),                   // 50: seldon.mlops.scheduler.ServerNotifyRequest
+	(*ServerNotifyResponse)(nil),                  // 51: seldon.mlops.scheduler.ServerNotifyResponse
+	(*ServerSubscriptionRequest)(nil),             // 52: seldon.mlops.scheduler.ServerSubscriptionRequest
+	(*StartExperimentRequest)(nil),                // 53: seldon.mlops.scheduler.StartExperimentRequest
+	(*Experiment)(nil),                            // 54: seldon.mlops.scheduler.Experiment
+	(*ExperimentConfig)(nil),                      // 55: seldon.mlops.scheduler.ExperimentConfig
+	(*ExperimentSchedule)(nil),                    // 56: seldon.mlops.scheduler.ExperimentSchedule
+	(*ExperimentPromotion)(nil),                   // 57: seldon.mlops.scheduler.ExperimentPromotion
+	(*ExperimentScheduleState)(nil),               // 58: seldon.mlops.scheduler.ExperimentScheduleState
+	(*ExperimentRule)(nil),                        // 59: seldon.mlops.scheduler.ExperimentRule
+	(*ExperimentHeaderMatch)(nil),                 // 60: seldon.mlops.scheduler.ExperimentHeaderMatch
+	(*ExperimentHashRange)(nil),                   // 61: seldon.mlops.scheduler.ExperimentHashRange
+	(*BanditConfig)(nil),                          // 62: seldon.mlops.scheduler.BanditConfig
+	(*BanditPrometheusReward)(nil),                // 63: seldon.mlops.scheduler.BanditPrometheusReward
+	(*ExperimentCandidate)(nil),                   // 64: seldon.mlops.scheduler.ExperimentCandidate
+	(*ExperimentMirror)(nil),                      // 65: seldon.mlops.scheduler.ExperimentMirror
+	(*ShadowComparison)(nil),                      // 66: seldon.mlops.scheduler.ShadowComparison
+	(*StartExperimentResponse)(nil),               // 67: seldon.mlops.scheduler.StartExperimentResponse
+	(*StopExperimentRequest)(nil),                 // 68: seldon.mlops.scheduler.StopExperimentRequest
+	(*StopExperimentResponse)(nil),                // 69: seldon.mlops.scheduler.StopExperimentResponse
+	(*ExperimentSubscriptionRequest)(nil),         // 70: seldon.mlops.scheduler.ExperimentSubscriptionRequest
+	(*ExperimentStatusResponse)(nil),              // 71: seldon.mlops.scheduler.ExperimentStatusResponse
+	(*ShadowComparisonReport)(nil),                // 72: seldon.mlops.scheduler.ShadowComparisonReport
+	(*ShadowOutputReport)(nil),                    // 73: seldon.mlops.scheduler.ShadowOutputReport
+	(*ExperimentFeedbackRequest)(nil),             // 74: seldon.mlops.scheduler.ExperimentFeedbackRequest
+	(*ExperimentFeedbackResponse)(nil),            // 75: seldon.mlops.scheduler.ExperimentFeedbackResponse
+	(*ExperimentStatsRequest)(nil),                // 76: seldon.mlops.scheduler.ExperimentStatsRequest
+	(*ExperimentStatsResponse)(nil),               // 77: seldon.mlops.scheduler.ExperimentStatsResponse
+	(*ConfidenceInterval)(nil),                    // 78: seldon.mlops.scheduler.ConfidenceInterval
+	(*CandidateStats)(nil),                        // 79: seldon.mlops.scheduler.CandidateStats
+	(*LatencyQuantile)(nil),                       // 80: seldon.mlops.scheduler.LatencyQuantile
+	(*CandidateComparison)(nil),                   // 81: seldon.mlops.scheduler.CandidateComparison
+	(*LoadPipelineRequest)(nil),                   // 82: seldon.mlops.scheduler.LoadPipelineRequest
+	(*ExperimentStatusRequest)(nil),               // 83: seldon.mlops.scheduler.ExperimentStatusRequest
+	(*Pipeline)(nil),                              // 84: seldon.mlops.scheduler.Pipeline
+	(*PipelineSchema)(nil),                        // 85: seldon.mlops.scheduler.PipelineSchema
+	(*TensorSchema)(nil),                          // 86: seldon.mlops.scheduler.TensorSchema
+	(*PipelineCanary)(nil),                        // 87: seldon.mlops.scheduler.PipelineCanary
+	(*PipelineStep)(nil),                          // 88: seldon.mlops.scheduler.PipelineStep
+	(*ForEach)(nil),                               // 89: seldon.mlops.scheduler.ForEach
+	(*PipelineTransform)(nil),                     // 90: seldon.mlops.scheduler.PipelineTransform
+	(*Batch)(nil),                                 // 91: seldon.mlops.scheduler.Batch
+	(*PipelineInput)(nil),                         // 92: seldon.mlops.scheduler.PipelineInput
+	(*PipelineOutput)(nil),                        // 93: seldon.mlops.scheduler.PipelineOutput
+	(*PipelineKafkaTopic)(nil),                    // 94: seldon.mlops.scheduler.PipelineKafkaTopic
+	(*LoadPipelineResponse)(nil),                  // 95: seldon.mlops.scheduler.LoadPipelineResponse
+	(*UpdatePipelineCanaryRequest)(nil),           // 96: seldon.mlops.scheduler.UpdatePipelineCanaryRequest
+	(*UpdatePipelineCanaryResponse)(nil),          // 97: seldon.mlops.scheduler.UpdatePipelineCanaryResponse
+	(*KafkaGarbageCollectRequest)(nil),            // 98: seldon.mlops.scheduler.KafkaGarbageCollectRequest
+	(*KafkaGarbageCollectResponse)(nil),           // 99: seldon.mlops.scheduler.KafkaGarbageCollectResponse
+	(*KafkaOrphanedResource)(nil),                 // 100: seldon.mlops.scheduler.KafkaOrphanedResource
+	(*ReplayPipelineRequest)(nil),                 // 101: seldon.mlops.scheduler.ReplayPipelineRequest
+	(*ReplayPipelineResponse)(nil),                // 102: seldon.mlops.scheduler.ReplayPipelineResponse
+	(*PipelineReplayStatusRequest)(nil),           // 103: seldon.mlops.scheduler.PipelineReplayStatusRequest
+	(*PipelineReplayStatusResponse)(nil),          // 104: seldon.mlops.scheduler.PipelineReplayStatusResponse
+	(*UnloadPipelineRequest)(nil),                 // 105: seldon.mlops.scheduler.UnloadPipelineRequest
+	(*UnloadPipelineResponse)(nil),                // 106: seldon.mlops.scheduler.UnloadPipelineResponse
+	(*PipelineStatusRequest)(nil),                 // 107: seldon.mlops.scheduler.PipelineStatusRequest
+	(*PipelineSubscriptionRequest)(nil),           // 108: seldon.mlops.scheduler.PipelineSubscriptionRequest
+	(*PipelineStatusResponse)(nil),                // 109: seldon.mlops.scheduler.PipelineStatusResponse
+	(*PipelineWithState)(nil),                     // 110: seldon.mlops.scheduler.PipelineWithState
+	(*PipelineVersionState)(nil),                  // 111: seldon.mlops.scheduler.PipelineVersionState
+	(*SchedulerStatusRequest)(nil),                // 112: seldon.mlops.scheduler.SchedulerStatusRequest
+	(*SchedulerStatusResponse)(nil),               // 113: seldon.mlops.scheduler.SchedulerStatusResponse
+	(*FederationSummaryRequest)(nil),              // 114: seldon.mlops.scheduler.FederationSummaryRequest
+	(*FederationSummaryResponse)(nil),             // 115: seldon.mlops.scheduler.FederationSummaryResponse
+	(*FederatedModel)(nil),                        // 116: seldon.mlops.scheduler.FederatedModel
+	nil,                                           // 117: seldon.mlops.scheduler.ModelVersionStatus.ModelReplicaStateEntry
+	nil,                                           // 118: seldon.mlops.scheduler.PipelineStep.TensorMapEntry
+	nil,                                           // 119: seldon.mlops.scheduler.PipelineInput.TensorMapEntry
+	nil,                                           // 120: seldon.mlops.scheduler.PipelineOutput.TensorMapEntry
+	(*timestamppb.Timestamp)(nil),                 // 121: google.protobuf.Timestamp
+}
+var file_mlops_scheduler_scheduler_proto_depIdxs = []int32{
+	15,  // 0: seldon.mlops.scheduler.LoadModelRequest.model:type_name -> seldon.mlops.scheduler.Model
+	16,  // 1: seldon.mlops.scheduler.Model.meta:type_name -> seldon.mlops.scheduler.MetaData
+	18,  // 2: seldon.mlops.scheduler.Model.modelSpec:type_name -> seldon.mlops.scheduler.ModelSpec
+	17,  // 3: seldon.mlops.scheduler.Model.deploymentSpec:type_name -> seldon.mlops.scheduler.DeploymentSpec
+	32,  // 4: seldon.mlops.scheduler.Model.streamSpec:type_name -> seldon.mlops.scheduler.StreamSpec
+	31,  // 5: seldon.mlops.scheduler.MetaData.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
+	35,  // 6: seldon.mlops.scheduler.ModelSpec.storageConfig:type_name -> seldon.mlops.scheduler.StorageConfig
+	30,  // 7: seldon.mlops.scheduler.ModelSpec.explainer:type_name -> seldon.mlops.scheduler.ExplainerSpec
+	29,  // 8: seldon.mlops.scheduler.ModelSpec.parameters:type_name -> seldon.mlops.scheduler.ParameterSpec
+	23,  // 9: seldon.mlops.scheduler.ModelSpec.trafficPolicy:type_name -> seldon.mlops.scheduler.TrafficPolicy
+	22,  // 10: seldon.mlops.scheduler.ModelSpec.authorization:type_name -> seldon.mlops.scheduler.AuthorizationPolicy
+	19,  // 11: seldon.mlops.scheduler.ModelSpec.rollout:type_name -> seldon.mlops.scheduler.RolloutStrategy
+	20,  // 12: seldon.mlops.scheduler.RolloutStrategy.steps:type_name -> seldon.mlops.scheduler.RolloutStep
+	21,  // 13: seldon.mlops.scheduler.RolloutStrategy.analysis:type_name -> seldon.mlops.scheduler.RolloutAnalysis
+	1,   // 14: seldon.mlops.scheduler.RolloutAnalysis.failureAction:type_name -> seldon.mlops.scheduler.RolloutAnalysis.FailureAction
+	24,  // 15: seldon.mlops.scheduler.TrafficPolicy.retry:type_name -> seldon.mlops.scheduler.RetryPolicy
+	25,  // 16: seldon.mlops.scheduler.TrafficPolicy.circuitBreaker:type_name -> seldon.mlops.scheduler.CircuitBreaker
+	26,  // 17: seldon.mlops.scheduler.TrafficPolicy.outlierDetection:type_name -> seldon.mlops.scheduler.OutlierDetection
+	27,  // 18: seldon.mlops.scheduler.TrafficPolicy.rateLimit:type_name -> seldon.mlops.scheduler.RouteRateLimit
+	2,   // 19: seldon.mlops.scheduler.RouteRateLimit.unit:type_name -> seldon.mlops.scheduler.RouteRateLimit.Unit
+	28,  // 20: seldon.mlops.scheduler.RouteRateLimit.quotas:type_name -> seldon.mlops.scheduler.RateLimitQuota
+	34,  // 21: seldon.mlops.scheduler.StreamSpec.rateLimit:type_name -> seldon.mlops.scheduler.StreamRateLimit
+	33,  // 22: seldon.mlops.scheduler.StreamSpec.topicConfig:type_name -> seldon.mlops.scheduler.KafkaTopicConfig
+	37,  // 23: seldon.mlops.scheduler.UnloadModelRequest.model:type_name -> seldon.mlops.scheduler.ModelReference
+	31,  // 24: seldon.mlops.scheduler.UnloadModelRequest.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
+	42,  // 25: seldon.mlops.scheduler.ModelStatusResponse.versions:type_name -> seldon.mlops.scheduler.ModelVersionStatus
+	41,  // 26: seldon.mlops.scheduler.ModelStatusResponse.rollout:type_name -> seldon.mlops.scheduler.ModelRolloutStatus
+	3,   // 27: seldon.mlops.scheduler.ModelRolloutStatus.state:type_name -> seldon.mlops.scheduler.ModelRolloutStatus.RolloutState
+	121, // 28: seldon.mlops.scheduler.ModelRolloutStatus.lastChangeTimestamp:type_name -> google.protobuf.Timestamp
+	31,  // 29: seldon.mlops.scheduler.ModelVersionStatus.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
+	117, // 30: seldon.mlops.scheduler.ModelVersionStatus.modelReplicaState:type_name -> seldon.mlops.scheduler.ModelVersionStatus.ModelReplicaStateEntry
+	43,  // 31: seldon.mlops.scheduler.ModelVersionStatus.state:type_name -> seldon.mlops.scheduler.ModelStatus
+	15,  // 32: seldon.mlops.scheduler.ModelVersionStatus.modelDefn:type_name -> seldon.mlops.scheduler.Model
+	4,   // 33: seldon.mlops.scheduler.ModelStatus.state:type_name -> seldon.mlops.scheduler.ModelStatus.ModelState
+	121, // 34: seldon.mlops.scheduler.ModelStatus.lastChangeTimestamp:type_name -> google.protobuf.Timestamp
+	5,   // 35: seldon.mlops.scheduler.ModelReplicaStatus.state:type_name -> seldon.mlops.scheduler.ModelReplicaStatus.ModelReplicaState
+	121, // 36: seldon.mlops.scheduler.ModelReplicaStatus.lastChangeTimestamp:type_name -> google.protobuf.Timestamp
+	47,  // 37: seldon.mlops.scheduler.ServerStatusResponse.resources:type_name -> seldon.mlops.scheduler.ServerReplicaResources
+	31,  // 38: seldon.mlops.scheduler.ServerStatusResponse.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
+	37,  // 39: seldon.mlops.scheduler.ModelStatusRequest.model:type_name -> seldon.mlops.scheduler.ModelReference
+	31,  // 40: seldon.mlops.scheduler.ServerNotifyRequest.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
+	54,  // 41: seldon.mlops.scheduler.StartExperimentRequest.experiment:type_name -> seldon.mlops.scheduler.Experiment
+	64,  // 42: seldon.mlops.scheduler.Experiment.candidates:type_name -> seldon.mlops.scheduler.ExperimentCandidate
+	65,  // 43: seldon.mlops.scheduler.Experiment.mirror:type_name -> seldon.mlops.scheduler.ExperimentMirror
+	55,  // 44: seldon.mlops.scheduler.Experiment.config:type_name -> seldon.mlops.scheduler.ExperimentConfig
+	31,  // 45: seldon.mlops.scheduler.Experiment.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
+	0,   // 46: seldon.mlops.scheduler.Experiment.resourceType:type_name -> seldon.mlops.scheduler.ResourceType
+	58,  // 47: seldon.mlops.scheduler.Experiment.scheduleState:type_name -> seldon.mlops.scheduler.ExperimentScheduleState
+	62,  // 48: seldon.mlops.scheduler.ExperimentConfig.bandit:type_name -> seldon.mlops.scheduler.BanditConfig
+	59,  // 49: seldon.mlops.scheduler.ExperimentConfig.rules:type_name -> seldon.mlops.scheduler.ExperimentRule
+	56,  // 50: seldon.mlops.scheduler.ExperimentConfig.schedule:type_name -> seldon.mlops.scheduler.ExperimentSchedule
+	121, // 51: seldon.mlops.scheduler.ExperimentSchedule.startTime:type_name -> google.protobuf.Timestamp
+	121, // 52: seldon.mlops.scheduler.ExperimentSchedule.endTime:type_name -> google.protobuf.Timestamp
+	57,  // 53: seldon.mlops.scheduler.ExperimentSchedule.promotion:type_name -> seldon.mlops.scheduler.ExperimentPromotion
+	121, // 54: seldon.mlops.scheduler.ExperimentScheduleState.startedAt:type_name -> google.protobuf.Timestamp
+	121, // 55: seldon.mlops.scheduler.ExperimentScheduleState.concludedAt:type_name -> google.protobuf.Timestamp
+	121, // 56: seldon.mlops.scheduler.ExperimentScheduleState.endsAt:type_name -> google.protobuf.Timestamp
+	60,  // 57: seldon.mlops.scheduler.ExperimentRule.headers:type_name -> seldon.mlops.scheduler.ExperimentHeaderMatch
+	61,  // 58: seldon.mlops.scheduler.ExperimentHeaderMatch.hash:type_name -> seldon.mlops.scheduler.ExperimentHashRange
+	6,   // 59: seldon.mlops.scheduler.BanditConfig.policy:type_name -> seldon.mlops.scheduler.BanditConfig.Policy
+	63,  // 60: seldon.mlops.scheduler.BanditConfig.prometheusReward:type_name -> seldon.mlops.scheduler.BanditPrometheusReward
+	66,  // 61: seldon.mlops.scheduler.ExperimentMirror.comparison:type_name -> seldon.mlops.scheduler.ShadowComparison
+	31,  // 62: seldon.mlops.scheduler.ExperimentStatusResponse.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
+	64,  // 63: seldon.mlops.scheduler.ExperimentStatusResponse.candidates:type_name -> seldon.mlops.scheduler.ExperimentCandidate
+	72,  // 64: seldon.mlops.scheduler.ExperimentStatusResponse.shadowComparison:type_name -> seldon.mlops.scheduler.ShadowComparisonReport
+	58,  // 65: seldon.mlops.scheduler.ExperimentStatusResponse.schedule:type_name -> seldon.mlops.scheduler.ExperimentScheduleState
+	73,  // 66: seldon.mlops.scheduler.ShadowComparisonReport.outputs:type_name -> seldon.mlops.scheduler.ShadowOutputReport
+	79,  // 67: seldon.mlops.scheduler.ExperimentStatsResponse.candidates:type_name -> seldon.mlops.scheduler.CandidateStats
+	81,  // 68: seldon.mlops.scheduler.ExperimentStatsResponse.comparisons:type_name -> seldon.mlops.scheduler.CandidateComparison
+	78,  // 69: seldon.mlops.scheduler.CandidateStats.errorRateInterval:type_name -> seldon.mlops.scheduler.ConfidenceInterval
+	80,  // 70: seldon.mlops.scheduler.CandidateStats.latencyQuantiles:type_name -> seldon.mlops.scheduler.LatencyQuantile
+	78,  // 71: seldon.mlops.scheduler.CandidateStats.meanRewardInterval:type_name -> seldon.mlops.scheduler.ConfidenceInterval
+	78,  // 72: seldon.mlops.scheduler.CandidateComparison.errorRateDiffInterval:type_name -> seldon.mlops.scheduler.ConfidenceInterval
+	78,  // 73: seldon.mlops.scheduler.CandidateComparison.meanRewardDiffInterval:type_name -> seldon.mlops.scheduler.ConfidenceInterval
+	78,  // 74: seldon.mlops.scheduler.CandidateComparison.meanLatencyDiffInterval:type_name -> seldon.mlops.scheduler.ConfidenceInterval
+	84,  // 75: seldon.mlops.scheduler.LoadPipelineRequest.pipeline:type_name -> seldon.mlops.scheduler.Pipeline
+	88,  // 76: seldon.mlops.scheduler.Pipeline.steps:type_name -> seldon.mlops.scheduler.PipelineStep
+	93,  // 77: seldon.mlops.scheduler.Pipeline.output:type_name -> seldon.mlops.scheduler.PipelineOutput
+	31,  // 78: seldon.mlops.scheduler.Pipeline.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
+	92,  // 79: seldon.mlops.scheduler.Pipeline.input:type_name -> seldon.mlops.scheduler.PipelineInput
+	87,  // 80: seldon.mlops.scheduler.Pipeline.canary:type_name -> seldon.mlops.scheduler.PipelineCanary
+	85,  // 81: seldon.mlops.scheduler.Pipeline.schema:type_name -> seldon.mlops.scheduler.PipelineSchema
+	33,  // 82: seldon.mlops.scheduler.Pipeline.topicConfig:type_name -> seldon.mlops.scheduler.KafkaTopicConfig
+	23,  // 83: seldon.mlops.scheduler.Pipeline.trafficPolicy:type_name -> seldon.mlops.scheduler.TrafficPolicy
+	22,  // 84: seldon.mlops.scheduler.Pipeline.authorization:type_name -> seldon.mlops.scheduler.AuthorizationPolicy
+	86,  // 85: seldon.mlops.scheduler.PipelineSchema.inputs:type_name -> seldon.mlops.scheduler.TensorSchema
+	86,  // 86: seldon.mlops.scheduler.PipelineSchema.outputs:type_name -> seldon.mlops.scheduler.TensorSchema
+	118, // 87: seldon.mlops.scheduler.PipelineStep.tensorMap:type_name -> seldon.mlops.scheduler.PipelineStep.TensorMapEntry
+	7,   // 88: seldon.mlops.scheduler.PipelineStep.inputsJoin:type_name -> seldon.mlops.scheduler.PipelineStep.JoinOp
+	7,   // 89: seldon.mlops.scheduler.PipelineStep.triggersJoin:type_name -> seldon.mlops.scheduler.PipelineStep.JoinOp
+	91,  // 90: seldon.mlops.scheduler.PipelineStep.batch:type_name -> seldon.mlops.scheduler.Batch
+	90,  // 91: seldon.mlops.scheduler.PipelineStep.transforms:type_name -> seldon.mlops.scheduler.PipelineTransform
+	89,  // 92: seldon.mlops.scheduler.PipelineStep.forEach:type_name -> seldon.mlops.scheduler.ForEach
+	8,   // 93: seldon.mlops.scheduler.PipelineTransform.type:type_name -> seldon.mlops.scheduler.PipelineTransform.TransformType
+	9,   // 94: seldon.mlops.scheduler.PipelineInput.joinType:type_name -> seldon.mlops.scheduler.PipelineInput.JoinOp
+	9,   // 95: seldon.mlops.scheduler.PipelineInput.triggersJoin:type_name -> seldon.mlops.scheduler.PipelineInput.JoinOp
+	119, // 96: seldon.mlops.scheduler.PipelineInput.tensorMap:type_name -> seldon.mlops.scheduler.PipelineInput.TensorMapEntry
+	94,  // 97: seldon.mlops.scheduler.PipelineInput.kafkaSources:type_name -> seldon.mlops.scheduler.PipelineKafkaTopic
+	10,  // 98: seldon.mlops.scheduler.PipelineOutput.stepsJoin:type_name -> seldon.mlops.scheduler.PipelineOutput.JoinOp
+	120, // 99: seldon.mlops.scheduler.PipelineOutput.tensorMap:type_name -> seldon.mlops.scheduler.PipelineOutput.TensorMapEntry
+	94,  // 100: seldon.mlops.scheduler.PipelineOutput.kafkaSinks:type_name -> seldon.mlops.scheduler.PipelineKafkaTopic
+	11,  // 101: seldon.mlops.scheduler.PipelineKafkaTopic.format:type_name -> seldon.mlops.scheduler.PipelineKafkaTopic.Format
+	86,  // 102: seldon.mlops.scheduler.PipelineKafkaTopic.tensors:type_name -> seldon.mlops.scheduler.TensorSchema
+	100, // 103: seldon.mlops.scheduler.KafkaGarbageCollectResponse.topics:type_name -> seldon.mlops.scheduler.KafkaOrphanedResource
+	100, // 104: seldon.mlops.scheduler.KafkaGarbageCollectResponse.consumerGroups:type_name -> seldon.mlops.scheduler.KafkaOrphanedResource
+	121, // 105: seldon.mlops.scheduler.ReplayPipelineRequest.fromTime:type_name -> google.protobuf.Timestamp
+	121, // 106: seldon.mlops.scheduler.ReplayPipelineRequest.toTime:type_name -> google.protobuf.Timestamp
+	12,  // 107: seldon.mlops.scheduler.PipelineReplayStatusResponse.state:type_name -> seldon.mlops.scheduler.PipelineReplayStatusResponse.ReplayState
+	110, // 108: seldon.mlops.scheduler.PipelineStatusResponse.versions:type_name -> seldon.mlops.scheduler.PipelineWithState
+	84,  // 109: seldon.mlops.scheduler.PipelineWithState.pipeline:type_name -> seldon.mlops.scheduler.Pipeline
+	111, // 110: seldon.mlops.scheduler.PipelineWithState.state:type_name -> seldon.mlops.scheduler.PipelineVersionState
+	13,  // 111: seldon.mlops.scheduler.PipelineVersionState.status:type_name -> seldon.mlops.scheduler.PipelineVersionState.PipelineStatus
+	121, // 112: seldon.mlops.scheduler.PipelineVersionState.lastChangeTimestamp:type_name -> google.protobuf.Timestamp
+	116, // 113: seldon.mlops.scheduler.FederationSummaryResponse.models:type_name -> seldon.mlops.scheduler.FederatedModel
+	44,  // 114: seldon.mlops.scheduler.ModelVersionStatus.ModelReplicaStateEntry.value:type_name -> seldon.mlops.scheduler.ModelReplicaStatus
+	50,  // 115: seldon.mlops.scheduler.Scheduler.ServerNotify:input_type -> seldon.mlops.scheduler.ServerNotifyRequest
+	14,  // 116: seldon.mlops.scheduler.Scheduler.LoadModel:input_type -> seldon.mlops.scheduler.LoadModelRequest
+	38,  // 117: seldon.mlops.scheduler.Scheduler.UnloadModel:input_type -> seldon.mlops.scheduler.UnloadModelRequest
+	82,  // 118: seldon.mlops.scheduler.Scheduler.LoadPipeline:input_type -> seldon.mlops.scheduler.LoadPipelineRequest
+	105, // 119: seldon.mlops.scheduler.Scheduler.UnloadPipeline:input_type -> seldon.mlops.scheduler.UnloadPipelineRequest
+	96,  // 120: seldon.mlops.scheduler.Scheduler.UpdatePipelineCanary:input_type -> seldon.mlops.scheduler.UpdatePipelineCanaryRequest
+	98,  // 121: seldon.mlops.scheduler.Scheduler.KafkaGarbageCollect:input_type -> seldon.mlops.scheduler.KafkaGarbageCollectRequest
+	101, // 122: seldon.mlops.scheduler.Scheduler.ReplayPipeline:input_type -> seldon.mlops.scheduler.ReplayPipelineRequest
+	103, // 123: seldon.mlops.scheduler.Scheduler.PipelineReplayStatus:input_type -> seldon.mlops.scheduler.PipelineReplayStatusRequest
+	53,  // 124: seldon.mlops.scheduler.Scheduler.StartExperiment:input_type -> seldon.mlops.scheduler.StartExperimentRequest
+	68,  // 125: seldon.mlops.scheduler.Scheduler.StopExperiment:input_type -> seldon.mlops.scheduler.StopExperimentRequest
+	74,  // 126: seldon.mlops.scheduler.Scheduler.ExperimentFeedback:input_type -> seldon.mlops.scheduler.ExperimentFeedbackRequest
+	76,  // 127: seldon.mlops.scheduler.Scheduler.ExperimentStats:input_type -> seldon.mlops.scheduler.ExperimentStatsRequest
+	45,  // 128: seldon.mlops.scheduler.Scheduler.ServerStatus:input_type -> seldon.mlops.scheduler.ServerStatusRequest
+	49,  // 129: seldon.mlops.scheduler.Scheduler.ModelStatus:input_type -> seldon.mlops.scheduler.ModelStatusRequest
+	107, // 130: seldon.mlops.scheduler.Scheduler.PipelineStatus:input_type -> seldon.mlops.scheduler.PipelineStatusRequest
+	83,  // 131: seldon.mlops.scheduler.Scheduler.ExperimentStatus:input_type -> seldon.mlops.scheduler.ExperimentStatusRequest
+	112, // 132: seldon.mlops.scheduler.Scheduler.SchedulerStatus:input_type -> seldon.mlops.scheduler.SchedulerStatusRequest
+	114, // 133: seldon.mlops.scheduler.Scheduler.FederationSummary:input_type -> seldon.mlops.scheduler.FederationSummaryRequest
+	52,  // 134: seldon.mlops.scheduler.Scheduler.SubscribeServerStatus:input_type -> seldon.mlops.scheduler.ServerSubscriptionRequest
+	48,  // 135: seldon.mlops.scheduler.Scheduler.SubscribeModelStatus:input_type -> seldon.mlops.scheduler.ModelSubscriptionRequest
+	70,  // 136: seldon.mlops.scheduler.Scheduler.SubscribeExperimentStatus:input_type -> seldon.mlops.scheduler.ExperimentSubscriptionRequest
+	108, // 137: seldon.mlops.scheduler.Scheduler.SubscribePipelineStatus:input_type -> seldon.mlops.scheduler.PipelineSubscriptionRequest
+	51,  // 138: seldon.mlops.scheduler.Scheduler.ServerNotify:output_type -> seldon.mlops.scheduler.ServerNotifyResponse
+	36,  // 139: seldon.mlops.scheduler.Scheduler.LoadModel:output_type -> seldon.mlops.scheduler.LoadModelResponse
+	39,  // 140: seldon.mlops.scheduler.Scheduler.UnloadModel:output_type -> seldon.mlops.scheduler.UnloadModelResponse
+	95,  // 141: seldon.mlops.scheduler.Scheduler.LoadPipeline:output_type -> seldon.mlops.scheduler.LoadPipelineResponse
+	106, // 142: seldon.mlops.scheduler.Scheduler.UnloadPipeline:output_type -> seldon.mlops.scheduler.UnloadPipelineResponse
+	97,  // 143: seldon.mlops.scheduler.Scheduler.UpdatePipelineCanary:output_type -> seldon.mlops.scheduler.UpdatePipelineCanaryResponse
+	99,  // 144: seldon.mlops.scheduler.Scheduler.KafkaGarbageCollect:output_type -> seldon.mlops.scheduler.KafkaGarbageCollectResponse
+	102, // 145: seldon.mlops.scheduler.Scheduler.ReplayPipeline:output_type -> seldon.mlops.scheduler.ReplayPipelineResponse
+	104, // 146: seldon.mlops.scheduler.Scheduler.PipelineReplayStatus:output_type -> seldon.mlops.scheduler.PipelineReplayStatusResponse
+	67,  // 147: seldon.mlops.scheduler.Scheduler.StartExperiment:output_type -> seldon.mlops.scheduler.StartExperimentResponse
+	69,  // 148: seldon.mlops.scheduler.Scheduler.StopExperiment:output_type -> seldon.mlops.scheduler.StopExperimentResponse
+	75,  // 149: seldon.mlops.scheduler.Scheduler.ExperimentFeedback:output_type -> seldon.mlops.scheduler.ExperimentFeedbackResponse
+	77,  // 150: seldon.mlops.scheduler.Scheduler.ExperimentStats:output_type -> seldon.mlops.scheduler.ExperimentStatsResponse
+	46,  // 151: seldon.mlops.scheduler.Scheduler.ServerStatus:output_type -> seldon.mlops.scheduler.ServerStatusResponse
+	40,  // 152: seldon.mlops.scheduler.Scheduler.ModelStatus:output_type -> seldon.mlops.scheduler.ModelStatusResponse
+	109, // 153: seldon.mlops.scheduler.Scheduler.PipelineStatus:output_type -> seldon.mlops.scheduler.PipelineStatusResponse
+	71,  // 154: seldon.mlops.scheduler.Scheduler.ExperimentStatus:output_type -> seldon.mlops.scheduler.ExperimentStatusResponse
+	113, // 155: seldon.mlops.scheduler.Scheduler.SchedulerStatus:output_type -> seldon.mlops.scheduler.SchedulerStatusResponse
+	115, // 156: seldon.mlops.scheduler.Scheduler.FederationSummary:output_type -> seldon.mlops.scheduler.FederationSummaryResponse
+	46,  // 157: seldon.mlops.scheduler.Scheduler.SubscribeServerStatus:output_type -> seldon.mlops.scheduler.ServerStatusResponse
+	40,  // 158: seldon.mlops.scheduler.Scheduler.SubscribeModelStatus:output_type -> seldon.mlops.scheduler.ModelStatusResponse
+	71,  // 159: seldon.mlops.scheduler.Scheduler.SubscribeExperimentStatus:output_type -> seldon.mlops.scheduler.ExperimentStatusResponse
+	109, // 160: seldon.mlops.scheduler.Scheduler.SubscribePipelineStatus:output_type -> seldon.mlops.scheduler.PipelineStatusResponse
+	138, // [138:161] is the sub-list for method output_type
+	115, // [115:138] is the sub-list for method input_type
+	115, // [115:115] is the sub-list for extension type_name
+	115, // [115:115] is the sub-list for extension extendee
+	0,   // [0:115] is the sub-list for field type_name
+}
+
+func init() { file_mlops_scheduler_scheduler_proto_init() }
+func file_mlops_scheduler_scheduler_proto_init() {
+	if File_mlops_scheduler_scheduler_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mlops_scheduler_scheduler_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadModelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Model); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetaData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeploymentSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutStrategy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutAnalysis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircuitBreaker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutlierDetection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteRateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimitQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParameterSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainerSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KubernetesMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KafkaTopicConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamRateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadModelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnloadModelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnloadModelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelRolloutStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelVersionStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelReplicaStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerReplicaResources); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerNotifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerNotifyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4576,8 +9955,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Model); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartExperimentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4588,8 +9967,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetaData); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Experiment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4600,8 +9979,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeploymentSpec); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4612,8 +9991,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModelSpec); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentSchedule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4624,8 +10003,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ParameterSpec); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentPromotion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4636,8 +10015,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExplainerSpec); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentScheduleState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4648,8 +10027,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KubernetesMeta); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4660,8 +10039,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamSpec); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentHeaderMatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4672,8 +10051,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageConfig); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentHashRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4684,8 +10063,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadModelResponse); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanditConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4696,8 +10075,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModelReference); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanditPrometheusReward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4708,8 +10087,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnloadModelRequest); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentCandidate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4720,8 +10099,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnloadModelResponse); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentMirror); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4732,8 +10111,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModelStatusResponse); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShadowComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4744,8 +10123,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModelVersionStatus); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartExperimentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4756,8 +10135,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModelStatus); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopExperimentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4768,8 +10147,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModelReplicaStatus); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopExperimentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4780,8 +10159,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerStatusRequest); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentSubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4792,8 +10171,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerStatusResponse); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4804,8 +10183,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerReplicaResources); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShadowComparisonReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4816,8 +10195,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModelSubscriptionRequest); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShadowOutputReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4828,8 +10207,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModelStatusRequest); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentFeedbackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4840,8 +10219,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerNotifyRequest); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentFeedbackResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4852,8 +10231,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerNotifyResponse); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4864,8 +10243,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerSubscriptionRequest); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4876,8 +10255,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartExperimentRequest); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfidenceInterval); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4888,8 +10267,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Experiment); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CandidateStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4900,8 +10279,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExperimentConfig); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LatencyQuantile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4912,8 +10291,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExperimentCandidate); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CandidateComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4924,8 +10303,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExperimentMirror); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadPipelineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4936,8 +10315,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartExperimentResponse); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4948,8 +10327,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopExperimentRequest); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pipeline); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4960,8 +10339,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopExperimentResponse); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineSchema); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4972,8 +10351,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExperimentSubscriptionRequest); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TensorSchema); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4984,8 +10363,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExperimentStatusResponse); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineCanary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4996,8 +10375,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadPipelineRequest); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineStep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5008,8 +10387,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExperimentStatusRequest); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForEach); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5020,8 +10399,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pipeline); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineTransform); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5032,8 +10411,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PipelineStep); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Batch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5044,8 +10423,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Batch); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5056,8 +10435,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PipelineInput); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5068,8 +10447,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PipelineOutput); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineKafkaTopic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5080,7 +10459,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_mlops_scheduler_scheduler_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoadPipelineResponse); i {
 			case 0:
 				return &v.state
@@ -5092,7 +10471,115 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_mlops_scheduler_scheduler_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePipelineCanaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePipelineCanaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KafkaGarbageCollectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KafkaGarbageCollectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KafkaOrphanedResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayPipelineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayPipelineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineReplayStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineReplayStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnloadPipelineRequest); i {
 			case 0:
 				return &v.state
@@ -5104,7 +10591,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_mlops_scheduler_scheduler_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnloadPipelineResponse); i {
 			case 0:
 				return &v.state
@@ -5116,7 +10603,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_mlops_scheduler_scheduler_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PipelineStatusRequest); i {
 			case 0:
 				return &v.state
@@ -5128,7 +10615,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_mlops_scheduler_scheduler_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PipelineSubscriptionRequest); i {
 			case 0:
 				return &v.state
@@ -5140,7 +10627,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_mlops_scheduler_scheduler_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PipelineStatusResponse); i {
 			case 0:
 				return &v.state
@@ -5152,7 +10639,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_mlops_scheduler_scheduler_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PipelineWithState); i {
 			case 0:
 				return &v.state
@@ -5164,7 +10651,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_mlops_scheduler_scheduler_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PipelineVersionState); i {
 			case 0:
 				return &v.state
@@ -5176,7 +10663,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_mlops_scheduler_scheduler_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SchedulerStatusRequest); i {
 			case 0:
 				return &v.state
@@ -5188,7 +10675,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_mlops_scheduler_scheduler_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SchedulerStatusResponse); i {
 			case 0:
 				return &v.state
@@ -5200,36 +10687,95 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FederationSummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FederationSummaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FederatedModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_mlops_scheduler_scheduler_proto_msgTypes[2].OneofWrappers = []interface{}{}
 	file_mlops_scheduler_scheduler_proto_msgTypes[4].OneofWrappers = []interface{}{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[6].OneofWrappers = []interface{}{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[9].OneofWrappers = []interface{}{
-		(*StorageConfig_StorageSecretName)(nil),
-		(*StorageConfig_StorageRcloneConfig)(nil),
-	}
+	file_mlops_scheduler_scheduler_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[10].OneofWrappers = []interface{}{}
 	file_mlops_scheduler_scheduler_proto_msgTypes[11].OneofWrappers = []interface{}{}
 	file_mlops_scheduler_scheduler_proto_msgTypes[12].OneofWrappers = []interface{}{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[16].OneofWrappers = []interface{}{}
 	file_mlops_scheduler_scheduler_proto_msgTypes[18].OneofWrappers = []interface{}{}
 	file_mlops_scheduler_scheduler_proto_msgTypes[19].OneofWrappers = []interface{}{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*StorageConfig_StorageSecretName)(nil),
+		(*StorageConfig_StorageRcloneConfig)(nil),
+	}
 	file_mlops_scheduler_scheduler_proto_msgTypes[23].OneofWrappers = []interface{}{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[32].OneofWrappers = []interface{}{}
 	file_mlops_scheduler_scheduler_proto_msgTypes[35].OneofWrappers = []interface{}{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[37].OneofWrappers = []interface{}{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[38].OneofWrappers = []interface{}{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[39].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[36].OneofWrappers = []interface{}{}
 	file_mlops_scheduler_scheduler_proto_msgTypes[40].OneofWrappers = []interface{}{}
 	file_mlops_scheduler_scheduler_proto_msgTypes[41].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[42].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[44].OneofWrappers = []interface{}{}
 	file_mlops_scheduler_scheduler_proto_msgTypes[46].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[48].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[51].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[57].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[62].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[65].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[67].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[69].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[70].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[74].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[75].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[77].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[78].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[84].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[87].OneofWrappers = []interface{}{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[93].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mlops_scheduler_scheduler_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   57,
+			NumEnums:      14,
+			NumMessages:   107,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -37,13 +37,20 @@ type SchedulerClient interface {
 	UnloadModel(ctx context.Context, in *UnloadModelRequest, opts ...grpc.CallOption) (*UnloadModelResponse, error)
 	LoadPipeline(ctx context.Context, in *LoadPipelineRequest, opts ...grpc.CallOption) (*LoadPipelineResponse, error)
 	UnloadPipeline(ctx context.Context, in *UnloadPipelineRequest, opts ...grpc.CallOption) (*UnloadPipelineResponse, error)
+	UpdatePipelineCanary(ctx context.Context, in *UpdatePipelineCanaryRequest, opts ...grpc.CallOption) (*UpdatePipelineCanaryResponse, error)
+	KafkaGarbageCollect(ctx context.Context, in *KafkaGarbageCollectRequest, opts ...grpc.CallOption) (*KafkaGarbageCollectResponse, error)
+	ReplayPipeline(ctx context.Context, in *ReplayPipelineRequest, opts ...grpc.CallOption) (*ReplayPipelineResponse, error)
+	PipelineReplayStatus(ctx context.Context, in *PipelineReplayStatusRequest, opts ...grpc.CallOption) (*PipelineReplayStatusResponse, error)
 	StartExperiment(ctx context.Context, in *StartExperimentRequest, opts ...grpc.CallOption) (*StartExperimentResponse, error)
 	StopExperiment(ctx context.Context, in *StopExperimentRequest, opts ...grpc.CallOption) (*StopExperimentResponse, error)
+	ExperimentFeedback(ctx context.Context, in *ExperimentFeedbackRequest, opts ...grpc.CallOption) (*ExperimentFeedbackResponse, error)
+	ExperimentStats(ctx context.Context, in *ExperimentStatsRequest, opts ...grpc.CallOption) (*ExperimentStatsResponse, error)
 	ServerStatus(ctx context.Context, in *ServerStatusRequest, opts ...grpc.CallOption) (Scheduler_ServerStatusClient, error)
 	ModelStatus(ctx context.Context, in *ModelStatusRequest, opts ...grpc.CallOption) (Scheduler_ModelStatusClient, error)
 	PipelineStatus(ctx context.Context, in *PipelineStatusRequest, opts ...grpc.CallOption) (Scheduler_PipelineStatusClient, error)
 	ExperimentStatus(ctx context.Context, in *ExperimentStatusRequest, opts ...grpc.CallOption) (Scheduler_ExperimentStatusClient, error)
 	SchedulerStatus(ctx context.Context, in *SchedulerStatusRequest, opts ...grpc.CallOption) (*SchedulerStatusResponse, error)
+	FederationSummary(ctx context.Context, in *FederationSummaryRequest, opts ...grpc.CallOption) (*FederationSummaryResponse, error)
 	SubscribeServerStatus(ctx context.Context, in *ServerSubscriptionRequest, opts ...grpc.CallOption) (Scheduler_SubscribeServerStatusClient, error)
 	SubscribeModelStatus(ctx context.Context, in *ModelSubscriptionRequest, opts ...grpc.CallOption) (Scheduler_SubscribeModelStatusClient, error)
 	SubscribeExperimentStatus(ctx context.Context, in *ExperimentSubscriptionRequest, opts ...grpc.CallOption) (Scheduler_SubscribeExperimentStatusClient, error)
@@ -103,6 +110,42 @@ func (c *schedulerClient) UnloadPipeline(ctx context.Context, in *UnloadPipeline
 	return out, nil
 }
 
+func (c *schedulerClient) UpdatePipelineCanary(ctx context.Context, in *UpdatePipelineCanaryRequest, opts ...grpc.CallOption) (*UpdatePipelineCanaryResponse, error) {
+	out := new(UpdatePipelineCanaryResponse)
+	err := c.cc.Invoke(ctx, "/seldon.mlops.scheduler.Scheduler/UpdatePipelineCanary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) KafkaGarbageCollect(ctx context.Context, in *KafkaGarbageCollectRequest, opts ...grpc.CallOption) (*KafkaGarbageCollectResponse, error) {
+	out := new(KafkaGarbageCollectResponse)
+	err := c.cc.Invoke(ctx, "/seldon.mlops.scheduler.Scheduler/KafkaGarbageCollect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) ReplayPipeline(ctx context.Context, in *ReplayPipelineRequest, opts ...grpc.CallOption) (*ReplayPipelineResponse, error) {
+	out := new(ReplayPipelineResponse)
+	err := c.cc.Invoke(ctx, "/seldon.mlops.scheduler.Scheduler/ReplayPipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) PipelineReplayStatus(ctx context.Context, in *PipelineReplayStatusRequest, opts ...grpc.CallOption) (*PipelineReplayStatusResponse, error) {
+	out := new(PipelineReplayStatusResponse)
+	err := c.cc.Invoke(ctx, "/seldon.mlops.scheduler.Scheduler/PipelineReplayStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *schedulerClient) StartExperiment(ctx context.Context, in *StartExperimentRequest, opts ...grpc.CallOption) (*StartExperimentResponse, error) {
 	out := new(StartExperimentResponse)
 	err := c.cc.Invoke(ctx, "/seldon.mlops.scheduler.Scheduler/StartExperiment", in, out, opts...)
@@ -121,6 +164,24 @@ func (c *schedulerClient) StopExperiment(ctx context.Context, in *StopExperiment
 	return out, nil
 }
 
+func (c *schedulerClient) ExperimentFeedback(ctx context.Context, in *ExperimentFeedbackRequest, opts ...grpc.CallOption) (*ExperimentFeedbackResponse, error) {
+	out := new(ExperimentFeedbackResponse)
+	err := c.cc.Invoke(ctx, "/seldon.mlops.scheduler.Scheduler/ExperimentFeedback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) ExperimentStats(ctx context.Context, in *ExperimentStatsRequest, opts ...grpc.CallOption) (*ExperimentStatsResponse, error) {
+	out := new(ExperimentStatsResponse)
+	err := c.cc.Invoke(ctx, "/seldon.mlops.scheduler.Scheduler/ExperimentStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *schedulerClient) ServerStatus(ctx context.Context, in *ServerStatusRequest, opts ...grpc.CallOption) (Scheduler_ServerStatusClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Scheduler_ServiceDesc.Streams[0], "/seldon.mlops.scheduler.Scheduler/ServerStatus", opts...)
 	if err != nil {
@@ -258,6 +319,15 @@ func (c *schedulerClient) SchedulerStatus(ctx context.Context, in *SchedulerStat
 	return out, nil
 }
 
+func (c *schedulerClient) FederationSummary(ctx context.Context, in *FederationSummaryRequest, opts ...grpc.CallOption) (*FederationSummaryResponse, error) {
+	out := new(FederationSummaryResponse)
+	err := c.cc.Invoke(ctx, "/seldon.mlops.scheduler.Scheduler/FederationSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *schedulerClient) SubscribeServerStatus(ctx context.Context, in *ServerSubscriptionRequest, opts ...grpc.CallOption) (Scheduler_SubscribeServerStatusClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Scheduler_ServiceDesc.Streams[4], "/seldon.mlops.scheduler.Scheduler/SubscribeServerStatus", opts...)
 	if err != nil {
@@ -395,13 +465,20 @@ type SchedulerServer interface {
 	UnloadModel(context.Context, *UnloadModelRequest) (*UnloadModelResponse, error)
 	LoadPipeline(context.Context, *LoadPipelineRequest) (*LoadPipelineResponse, error)
 	UnloadPipeline(context.Context, *UnloadPipelineRequest) (*UnloadPipelineResponse, error)
+	UpdatePipelineCanary(context.Context, *UpdatePipelineCanaryRequest) (*UpdatePipelineCanaryResponse, error)
+	KafkaGarbageCollect(context.Context, *KafkaGarbageCollectRequest) (*KafkaGarbageCollectResponse, error)
+	ReplayPipeline(context.Context, *ReplayPipelineRequest) (*ReplayPipelineResponse, error)
+	PipelineReplayStatus(context.Context, *PipelineReplayStatusRequest) (*PipelineReplayStatusResponse, error)
 	StartExperiment(context.Context, *StartExperimentRequest) (*StartExperimentResponse, error)
 	StopExperiment(context.Context, *StopExperimentRequest) (*StopExperimentResponse, error)
+	ExperimentFeedback(context.Context, *ExperimentFeedbackRequest) (*ExperimentFeedbackResponse, error)
+	ExperimentStats(context.Context, *ExperimentStatsRequest) (*ExperimentStatsResponse, error)
 	ServerStatus(*ServerStatusRequest, Scheduler_ServerStatusServer) error
 	ModelStatus(*ModelStatusRequest, Scheduler_ModelStatusServer) error
 	PipelineStatus(*PipelineStatusRequest, Scheduler_PipelineStatusServer) error
 	ExperimentStatus(*ExperimentStatusRequest, Scheduler_ExperimentStatusServer) error
 	SchedulerStatus(context.Context, *SchedulerStatusRequest) (*SchedulerStatusResponse, error)
+	FederationSummary(context.Context, *FederationSummaryRequest) (*FederationSummaryResponse, error)
 	SubscribeServerStatus(*ServerSubscriptionRequest, Scheduler_SubscribeServerStatusServer) error
 	SubscribeModelStatus(*ModelSubscriptionRequest, Scheduler_SubscribeModelStatusServer) error
 	SubscribeExperimentStatus(*ExperimentSubscriptionRequest, Scheduler_SubscribeExperimentStatusServer) error
@@ -428,12 +505,30 @@ func (UnimplementedSchedulerServer) LoadPipeline(context.Context, *LoadPipelineR
 func (UnimplementedSchedulerServer) UnloadPipeline(context.Context, *UnloadPipelineRequest) (*UnloadPipelineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnloadPipeline not implemented")
 }
+func (UnimplementedSchedulerServer) UpdatePipelineCanary(context.Context, *UpdatePipelineCanaryRequest) (*UpdatePipelineCanaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePipelineCanary not implemented")
+}
+func (UnimplementedSchedulerServer) KafkaGarbageCollect(context.Context, *KafkaGarbageCollectRequest) (*KafkaGarbageCollectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KafkaGarbageCollect not implemented")
+}
+func (UnimplementedSchedulerServer) ReplayPipeline(context.Context, *ReplayPipelineRequest) (*ReplayPipelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayPipeline not implemented")
+}
+func (UnimplementedSchedulerServer) PipelineReplayStatus(context.Context, *PipelineReplayStatusRequest) (*PipelineReplayStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PipelineReplayStatus not implemented")
+}
 func (UnimplementedSchedulerServer) StartExperiment(context.Context, *StartExperimentRequest) (*StartExperimentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartExperiment not implemented")
 }
 func (UnimplementedSchedulerServer) StopExperiment(context.Context, *StopExperimentRequest) (*StopExperimentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopExperiment not implemented")
 }
+func (UnimplementedSchedulerServer) ExperimentFeedback(context.Context, *ExperimentFeedbackRequest) (*ExperimentFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExperimentFeedback not implemented")
+}
+func (UnimplementedSchedulerServer) ExperimentStats(context.Context, *ExperimentStatsRequest) (*ExperimentStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExperimentStats not implemented")
+}
 func (UnimplementedSchedulerServer) ServerStatus(*ServerStatusRequest, Scheduler_ServerStatusServer) error {
 	return status.Errorf(codes.Unimplemented, "method ServerStatus not implemented")
 }
@@ -449,6 +544,9 @@ func (UnimplementedSchedulerServer) ExperimentStatus(*ExperimentStatusRequest, S
 func (UnimplementedSchedulerServer) SchedulerStatus(context.Context, *SchedulerStatusRequest) (*SchedulerStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SchedulerStatus not implemented")
 }
+func (UnimplementedSchedulerServer) FederationSummary(context.Context, *FederationSummaryRequest) (*FederationSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FederationSummary not implemented")
+}
 func (UnimplementedSchedulerServer) SubscribeServerStatus(*ServerSubscriptionRequest, Scheduler_SubscribeServerStatusServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeServerStatus not implemented")
 }
@@ -564,6 +662,78 @@ func _Scheduler_UnloadPipeline_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Scheduler_UpdatePipelineCanary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePipelineCanaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).UpdatePipelineCanary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seldon.mlops.scheduler.Scheduler/UpdatePipelineCanary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).UpdatePipelineCanary(ctx, req.(*UpdatePipelineCanaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_KafkaGarbageCollect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KafkaGarbageCollectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).KafkaGarbageCollect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seldon.mlops.scheduler.Scheduler/KafkaGarbageCollect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).KafkaGarbageCollect(ctx, req.(*KafkaGarbageCollectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_ReplayPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ReplayPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seldon.mlops.scheduler.Scheduler/ReplayPipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ReplayPipeline(ctx, req.(*ReplayPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_PipelineReplayStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PipelineReplayStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).PipelineReplayStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seldon.mlops.scheduler.Scheduler/PipelineReplayStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).PipelineReplayStatus(ctx, req.(*PipelineReplayStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Scheduler_StartExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartExperimentRequest)
 	if err := dec(in); err != nil {
@@ -600,6 +770,42 @@ func _Scheduler_StopExperiment_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Scheduler_ExperimentFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExperimentFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ExperimentFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seldon.mlops.scheduler.Scheduler/ExperimentFeedback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ExperimentFeedback(ctx, req.(*ExperimentFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_ExperimentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExperimentStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ExperimentStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seldon.mlops.scheduler.Scheduler/ExperimentStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ExperimentStats(ctx, req.(*ExperimentStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Scheduler_ServerStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ServerStatusRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -702,6 +908,24 @@ func _Scheduler_SchedulerStatus_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Scheduler_FederationSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FederationSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).FederationSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seldon.mlops.scheduler.Scheduler/FederationSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).FederationSummary(ctx, req.(*FederationSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Scheduler_SubscribeServerStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ServerSubscriptionRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -813,6 +1037,22 @@ var Scheduler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnloadPipeline",
 			Handler:    _Scheduler_UnloadPipeline_Handler,
 		},
+		{
+			MethodName: "UpdatePipelineCanary",
+			Handler:    _Scheduler_UpdatePipelineCanary_Handler,
+		},
+		{
+			MethodName: "KafkaGarbageCollect",
+			Handler:    _Scheduler_KafkaGarbageCollect_Handler,
+		},
+		{
+			MethodName: "ReplayPipeline",
+			Handler:    _Scheduler_ReplayPipeline_Handler,
+		},
+		{
+			MethodName: "PipelineReplayStatus",
+			Handler:    _Scheduler_PipelineReplayStatus_Handler,
+		},
 		{
 			MethodName: "StartExperiment",
 			Handler:    _Scheduler_StartExperiment_Handler,
@@ -821,10 +1061,22 @@ var Scheduler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopExperiment",
 			Handler:    _Scheduler_StopExperiment_Handler,
 		},
+		{
+			MethodName: "ExperimentFeedback",
+			Handler:    _Scheduler_ExperimentFeedback_Handler,
+		},
+		{
+			MethodName: "ExperimentStats",
+			Handler:    _Scheduler_ExperimentStats_Handler,
+		},
 		{
 			MethodName: "SchedulerStatus",
 			Handler:    _Scheduler_SchedulerStatus_Handler,
 		},
+		{
+			MethodName: "FederationSummary",
+			Handler:    _Scheduler_FederationSummary_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -39,6 +39,9 @@ type PipelineSnapshot struct {
 	LastVersion uint32               `protobuf:"varint,2,opt,name=lastVersion,proto3" json:"lastVersion,omitempty"`
 	Versions    []*PipelineWithState `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty"`
 	Deleted     bool                 `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Canary      *PipelineCanaryState `protobuf:"bytes,5,opt,name=canary,proto3,oneof" json:"canary,omitempty"`
+	// version serving traffic in place of the last version after its canary was rolled back, 0 otherwise
+	StableVersion uint32 `protobuf:"varint,6,opt,name=stableVersion,proto3" json:"stableVersion,omitempty"`
 }
 
 func (x *PipelineSnapshot) Reset() {
@@ -101,6 +104,75 @@ func (x *PipelineSnapshot) GetDeleted() bool {
 	return false
 }
 
+func (x *PipelineSnapshot) GetCanary() *PipelineCanaryState {
+	if x != nil {
+		return x.Canary
+	}
+	return nil
+}
+
+func (x *PipelineSnapshot) GetStableVersion() uint32 {
+	if x != nil {
+		return x.StableVersion
+	}
+	return 0
+}
+
+type PipelineCanaryState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StableVersion  uint32 `protobuf:"varint,1,opt,name=stableVersion,proto3" json:"stableVersion,omitempty"`
+	TrafficPercent uint32 `protobuf:"varint,2,opt,name=trafficPercent,proto3" json:"trafficPercent,omitempty"`
+}
+
+func (x *PipelineCanaryState) Reset() {
+	*x = PipelineCanaryState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_storage_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineCanaryState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineCanaryState) ProtoMessage() {}
+
+func (x *PipelineCanaryState) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_storage_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineCanaryState.ProtoReflect.Descriptor instead.
+func (*PipelineCanaryState) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_storage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PipelineCanaryState) GetStableVersion() uint32 {
+	if x != nil {
+		return x.StableVersion
+	}
+	return 0
+}
+
+func (x *PipelineCanaryState) GetTrafficPercent() uint32 {
+	if x != nil {
+		return x.TrafficPercent
+	}
+	return 0
+}
+
 var File_mlops_scheduler_storage_proto protoreflect.FileDescriptor
 
 var file_mlops_scheduler_storage_proto_rawDesc = []byte{
@@ -109,7 +181,7 @@ var file_mlops_scheduler_storage_proto_rawDesc = []byte{
 	0x16, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
 	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x1a, 0x1f, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2f, 0x73,
 	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa9, 0x01, 0x0a, 0x10, 0x50, 0x69, 0x70,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x02, 0x0a, 0x10, 0x50, 0x69, 0x70,
 	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
@@ -120,7 +192,21 @@ var file_mlops_scheduler_storage_proto_rawDesc = []byte{
 	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65,
 	0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
 	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x65, 0x74, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x24,
+	0x0a, 0x0d, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x22,
+	0x63, 0x0a, 0x13, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x61, 0x6e, 0x61, 0x72,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e,
+	0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
 	0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x6c, 0x64,
 	0x6f, 0x6e, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x6f, 0x2f,
 	0x76, 0x32, 0x2f, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
@@ -139,18 +225,20 @@ func file_mlops_scheduler_storage_proto_rawDescGZIP() []byte {
 	return file_mlops_scheduler_storage_proto_rawDescData
 }
 
-var file_mlops_scheduler_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_mlops_scheduler_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_mlops_scheduler_storage_proto_goTypes = []interface{}{
-	(*PipelineSnapshot)(nil),  // 0: seldon.mlops.scheduler.PipelineSnapshot
-	(*PipelineWithState)(nil), // 1: seldon.mlops.scheduler.PipelineWithState
+	(*PipelineSnapshot)(nil),    // 0: seldon.mlops.scheduler.PipelineSnapshot
+	(*PipelineCanaryState)(nil), // 1: seldon.mlops.scheduler.PipelineCanaryState
+	(*PipelineWithState)(nil),   // 2: seldon.mlops.scheduler.PipelineWithState
 }
 var file_mlops_scheduler_storage_proto_depIdxs = []int32{
-	1, // 0: seldon.mlops.scheduler.PipelineSnapshot.versions:type_name -> seldon.mlops.scheduler.PipelineWithState
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: seldon.mlops.scheduler.PipelineSnapshot.versions:type_name -> seldon.mlops.scheduler.PipelineWithState
+	1, // 1: seldon.mlops.scheduler.PipelineSnapshot.canary:type_name -> seldon.mlops.scheduler.PipelineCanaryState
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_mlops_scheduler_storage_proto_init() }
@@ -172,14 +260,27 @@ func file_mlops_scheduler_storage_proto_init() {
 				return nil
 			}
 		}
+		file_mlops_scheduler_storage_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineCanaryState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_mlops_scheduler_storage_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mlops_scheduler_storage_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
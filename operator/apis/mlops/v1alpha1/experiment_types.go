@@ -10,7 +10,9 @@ the Change License after the Change Date as each is defined in accordance with t
 package v1alpha1
 
 import (
+	"google.golang.org/protobuf/types/known/timestamppb"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -34,6 +36,121 @@ type ExperimentSpec struct {
 	Candidates   []ExperimentCandidate `json:"candidates"`
 	Mirror       *ExperimentMirror     `json:"mirror,omitempty"`
 	ResourceType ResourceType          `json:"resourceType,omitempty"`
+	// Update the candidate weights from the rewards of each candidate, starting from the given weights
+	// +optional
+	Bandit *BanditSpec `json:"bandit,omitempty"`
+	// Send requests with matching headers to a candidate ahead of the weighted split, checking the rules in order
+	// +optional
+	Rules []ExperimentRule `json:"rules,omitempty"`
+	// Start the experiment later and conclude it at an end time or after a max duration
+	// +optional
+	Schedule *ExperimentSchedule `json:"schedule,omitempty"`
+}
+
+// ExperimentSchedule starts an experiment at startTime and concludes it at endTime or after maxDuration, whichever is
+// first. A concluded experiment sends all its traffic to the promoted candidate, or stops routing if there is none.
+type ExperimentSchedule struct {
+	// Defaults to when the experiment is created
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+	// Longest time the experiment runs for from its start, e.g. 24h
+	// +optional
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+	// Promote the candidate with the best metric when the experiment concludes
+	// +optional
+	Promotion *ExperimentPromotion `json:"promotion,omitempty"`
+}
+
+// ExperimentPromotion has a PromQL query for the metric of a candidate over the experiment, where {{.Candidate}} and
+// {{.Interval}} are replaced by the candidate name and the time the experiment ran for
+type ExperimentPromotion struct {
+	// +kubebuilder:validation:MinLength=1
+	Query string `json:"query"`
+	// Promote the candidate with the lowest metric, such as a latency or error rate, rather than the highest
+	// +optional
+	LowerIsBetter bool `json:"lowerIsBetter,omitempty"`
+}
+
+// ExperimentRule sends requests matching all its headers to a candidate
+type ExperimentRule struct {
+	Candidate string `json:"candidate"`
+	// +kubebuilder:validation:MinItems=1
+	Headers []ExperimentHeaderMatch `json:"headers"`
+}
+
+// ExperimentHeaderMatch matches a request header by exactly one of exact, prefix, regex or hash.
+// Requests without the header do not match.
+type ExperimentHeaderMatch struct {
+	// Lower case header name
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// +optional
+	Exact *string `json:"exact,omitempty"`
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+	// RE2 regular expression matching the whole value
+	// +optional
+	Regex *string `json:"regex,omitempty"`
+	// Match values whose hash falls in a range of 100 buckets, assigning cohorts deterministically
+	// +optional
+	Hash *ExperimentHashRange `json:"hash,omitempty"`
+}
+
+// ExperimentHashRange is the range of buckets [start, end) out of 100
+type ExperimentHashRange struct {
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=99
+	Start int32 `json:"start"`
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	End int32 `json:"end"`
+}
+
+// +kubebuilder:validation:Enum=epsilonGreedy;thompsonSampling
+type BanditPolicy string
+
+const (
+	EpsilonGreedyBanditPolicy    BanditPolicy = "epsilonGreedy"
+	ThompsonSamplingBanditPolicy BanditPolicy = "thompsonSampling"
+)
+
+type BanditSpec struct {
+	// How traffic is shared from the rewards, defaults to epsilonGreedy
+	// +optional
+	Policy *BanditPolicy `json:"policy,omitempty"`
+	// Percentage of traffic shared evenly between candidates for epsilonGreedy, defaults to 10
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	ExplorationPercent *int32 `json:"explorationPercent,omitempty"`
+	// Minimum weight of each candidate out of a total of 100
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MinWeight *int32 `json:"minWeight,omitempty"`
+	// Maximum weight of each candidate out of a total of 100
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxWeight *int32 `json:"maxWeight,omitempty"`
+	// Seconds between weight updates, defaults to 60
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	UpdateIntervalSeconds *int32 `json:"updateIntervalSeconds,omitempty"`
+	// Prometheus queries for rewards, in addition to rewards sent as feedback through the scheduler
+	// +optional
+	PrometheusReward *BanditPrometheusReward `json:"prometheusReward,omitempty"`
+}
+
+// BanditPrometheusReward has PromQL queries for the sum and number of rewards in [0,1] of a candidate, where
+// {{.Candidate}} and {{.Interval}} are replaced by the candidate name and the update interval
+type BanditPrometheusReward struct {
+	// +kubebuilder:validation:MinLength=1
+	RewardQuery string `json:"rewardQuery"`
+	// +kubebuilder:validation:MinLength=1
+	CountQuery string `json:"countQuery"`
 }
 
 type ExperimentCandidate struct {
@@ -44,6 +161,19 @@ type ExperimentCandidate struct {
 type ExperimentMirror struct {
 	Name    string `json:"name"`
 	Percent uint32 `json:"percent"`
+	// Compare the responses of the mirror with those of the default model, reported in the experiment status
+	// +optional
+	Comparison *ShadowComparison `json:"comparison,omitempty"`
+}
+
+// ShadowComparison compares the outputs of the mirror of a model experiment with those of the default model
+type ShadowComparison struct {
+	// Outputs compared, all outputs if empty
+	// +optional
+	Outputs []string `json:"outputs,omitempty"`
+	// Largest absolute difference of numeric output elements counted as a match, e.g. 0.001, defaults to 0
+	// +optional
+	Tolerance *resource.Quantity `json:"tolerance,omitempty"`
 }
 
 // ExperimentStatus defines the observed state of Experiment
@@ -84,6 +214,92 @@ func asSchedulerCandidate(candidate *ExperimentCandidate) *scheduler.ExperimentC
 	}
 }
 
+func (b *BanditSpec) asSchedulerBandit() *scheduler.BanditConfig {
+	if b == nil {
+		return nil
+	}
+	bandit := &scheduler.BanditConfig{
+		MaxWeight:             asUint32Ptr(b.MaxWeight),
+		UpdateIntervalSeconds: asUint32Ptr(b.UpdateIntervalSeconds),
+	}
+	if b.Policy != nil && *b.Policy == ThompsonSamplingBanditPolicy {
+		bandit.Policy = scheduler.BanditConfig_THOMPSON_SAMPLING
+	}
+	if b.ExplorationPercent != nil {
+		epsilon := float32(*b.ExplorationPercent) / 100
+		bandit.Epsilon = &epsilon
+	}
+	if b.MinWeight != nil {
+		bandit.MinWeight = uint32(*b.MinWeight)
+	}
+	if b.PrometheusReward != nil {
+		bandit.PrometheusReward = &scheduler.BanditPrometheusReward{
+			RewardQuery: b.PrometheusReward.RewardQuery,
+			CountQuery:  b.PrometheusReward.CountQuery,
+		}
+	}
+	return bandit
+}
+
+func (c *ShadowComparison) asSchedulerShadowComparison() *scheduler.ShadowComparison {
+	if c == nil {
+		return nil
+	}
+	comparison := &scheduler.ShadowComparison{Outputs: c.Outputs}
+	if c.Tolerance != nil {
+		comparison.Tolerance = c.Tolerance.AsApproximateFloat64()
+	}
+	return comparison
+}
+
+func (s *ExperimentSchedule) asSchedulerSchedule() *scheduler.ExperimentSchedule {
+	if s == nil {
+		return nil
+	}
+	schedule := &scheduler.ExperimentSchedule{}
+	if s.StartTime != nil {
+		schedule.StartTime = timestamppb.New(s.StartTime.Time)
+	}
+	if s.EndTime != nil {
+		schedule.EndTime = timestamppb.New(s.EndTime.Time)
+	}
+	if s.MaxDuration != nil {
+		maxDurationSeconds := uint32(s.MaxDuration.Seconds())
+		schedule.MaxDurationSeconds = &maxDurationSeconds
+	}
+	if s.Promotion != nil {
+		schedule.Promotion = &scheduler.ExperimentPromotion{
+			Query:         s.Promotion.Query,
+			LowerIsBetter: s.Promotion.LowerIsBetter,
+		}
+	}
+	return schedule
+}
+
+func asSchedulerRules(rules []ExperimentRule) []*scheduler.ExperimentRule {
+	var schedulerRules []*scheduler.ExperimentRule
+	for _, rule := range rules {
+		schedulerRule := &scheduler.ExperimentRule{Candidate: rule.Candidate}
+		for _, header := range rule.Headers {
+			schedulerHeader := &scheduler.ExperimentHeaderMatch{
+				Name:   header.Name,
+				Exact:  header.Exact,
+				Prefix: header.Prefix,
+				Regex:  header.Regex,
+			}
+			if header.Hash != nil {
+				schedulerHeader.Hash = &scheduler.ExperimentHashRange{
+					Start: uint32(header.Hash.Start),
+					End:   uint32(header.Hash.End),
+				}
+			}
+			schedulerRule.Headers = append(schedulerRule.Headers, schedulerHeader)
+		}
+		schedulerRules = append(schedulerRules, schedulerRule)
+	}
+	return schedulerRules
+}
+
 func (e *Experiment) AsSchedulerExperimentRequest() *scheduler.Experiment {
 	var candidates []*scheduler.ExperimentCandidate
 	var mirror *scheduler.ExperimentMirror
@@ -92,8 +308,17 @@ func (e *Experiment) AsSchedulerExperimentRequest() *scheduler.Experiment {
 	}
 	if e.Spec.Mirror != nil {
 		mirror = &scheduler.ExperimentMirror{
-			Name:    e.Spec.Mirror.Name,
-			Percent: e.Spec.Mirror.Percent,
+			Name:       e.Spec.Mirror.Name,
+			Percent:    e.Spec.Mirror.Percent,
+			Comparison: e.Spec.Mirror.Comparison.asSchedulerShadowComparison(),
+		}
+	}
+	var config *scheduler.ExperimentConfig
+	if e.Spec.Bandit != nil || len(e.Spec.Rules) > 0 || e.Spec.Schedule != nil {
+		config = &scheduler.ExperimentConfig{
+			Bandit:   e.Spec.Bandit.asSchedulerBandit(),
+			Rules:    asSchedulerRules(e.Spec.Rules),
+			Schedule: e.Spec.Schedule.asSchedulerSchedule(),
 		}
 	}
 	var resourceType scheduler.ResourceType
@@ -108,6 +333,7 @@ func (e *Experiment) AsSchedulerExperimentRequest() *scheduler.Experiment {
 		Default:    e.Spec.Default,
 		Candidates: candidates,
 		Mirror:     mirror,
+		Config:     config,
 		KubernetesMeta: &scheduler.KubernetesMeta{
 			Namespace:  e.Namespace,
 			Generation: e.Generation,
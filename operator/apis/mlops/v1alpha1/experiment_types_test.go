@@ -11,8 +11,11 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
@@ -27,6 +30,13 @@ func TestAsSchedulerExperimentRequest(t *testing.T) {
 	}
 
 	getStrPtr := func(val string) *string { return &val }
+	getInt32Ptr := func(val int32) *int32 { return &val }
+	getUint32Ptr := func(val uint32) *uint32 { return &val }
+	getFloat32Ptr := func(val float32) *float32 { return &val }
+	thompsonSampling := ThompsonSamplingBanditPolicy
+	tolerance := resource.MustParse("0.001")
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
 	tests := []test{
 		{
 			name: "model",
@@ -129,6 +139,244 @@ func TestAsSchedulerExperimentRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "bandit",
+			experiment: &Experiment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: ExperimentSpec{
+					Default: getStrPtr("model1"),
+					Candidates: []ExperimentCandidate{
+						{
+							Name:   "model1",
+							Weight: 50,
+						},
+						{
+							Name:   "model2",
+							Weight: 50,
+						},
+					},
+					Bandit: &BanditSpec{
+						Policy:                &thompsonSampling,
+						ExplorationPercent:    getInt32Ptr(20),
+						MinWeight:             getInt32Ptr(10),
+						MaxWeight:             getInt32Ptr(90),
+						UpdateIntervalSeconds: getInt32Ptr(30),
+						PrometheusReward: &BanditPrometheusReward{
+							RewardQuery: "sum(reward)",
+							CountQuery:  "sum(count)",
+						},
+					},
+				},
+			},
+			proto: &scheduler.Experiment{
+				Name:         "foo",
+				Default:      getStrPtr("model1"),
+				ResourceType: scheduler.ResourceType_MODEL,
+				Candidates: []*scheduler.ExperimentCandidate{
+					{
+						Name:   "model1",
+						Weight: 50,
+					},
+					{
+						Name:   "model2",
+						Weight: 50,
+					},
+				},
+				Config: &scheduler.ExperimentConfig{
+					Bandit: &scheduler.BanditConfig{
+						Policy:                scheduler.BanditConfig_THOMPSON_SAMPLING,
+						Epsilon:               getFloat32Ptr(0.2),
+						MinWeight:             10,
+						MaxWeight:             getUint32Ptr(90),
+						UpdateIntervalSeconds: getUint32Ptr(30),
+						PrometheusReward: &scheduler.BanditPrometheusReward{
+							RewardQuery: "sum(reward)",
+							CountQuery:  "sum(count)",
+						},
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 1,
+				},
+			},
+		},
+		{
+			name: "rules",
+			experiment: &Experiment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: ExperimentSpec{
+					Default: getStrPtr("model1"),
+					Candidates: []ExperimentCandidate{
+						{
+							Name:   "model1",
+							Weight: 100,
+						},
+						{
+							Name: "model2",
+						},
+					},
+					Rules: []ExperimentRule{
+						{
+							Candidate: "model2",
+							Headers: []ExperimentHeaderMatch{
+								{Name: "x-tenant", Exact: getStrPtr("internal")},
+								{Name: "x-user", Hash: &ExperimentHashRange{Start: 0, End: 10}},
+							},
+						},
+					},
+				},
+			},
+			proto: &scheduler.Experiment{
+				Name:         "foo",
+				Default:      getStrPtr("model1"),
+				ResourceType: scheduler.ResourceType_MODEL,
+				Candidates: []*scheduler.ExperimentCandidate{
+					{
+						Name:   "model1",
+						Weight: 100,
+					},
+					{
+						Name: "model2",
+					},
+				},
+				Config: &scheduler.ExperimentConfig{
+					Rules: []*scheduler.ExperimentRule{
+						{
+							Candidate: "model2",
+							Headers: []*scheduler.ExperimentHeaderMatch{
+								{Name: "x-tenant", Exact: getStrPtr("internal")},
+								{Name: "x-user", Hash: &scheduler.ExperimentHashRange{Start: 0, End: 10}},
+							},
+						},
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 1,
+				},
+			},
+		},
+		{
+			name: "shadow comparison",
+			experiment: &Experiment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: ExperimentSpec{
+					Default: getStrPtr("model1"),
+					Candidates: []ExperimentCandidate{
+						{
+							Name:   "model1",
+							Weight: 100,
+						},
+					},
+					Mirror: &ExperimentMirror{
+						Name:    "model2",
+						Percent: 10,
+						Comparison: &ShadowComparison{
+							Outputs:   []string{"predict"},
+							Tolerance: &tolerance,
+						},
+					},
+				},
+			},
+			proto: &scheduler.Experiment{
+				Name:         "foo",
+				Default:      getStrPtr("model1"),
+				ResourceType: scheduler.ResourceType_MODEL,
+				Candidates: []*scheduler.ExperimentCandidate{
+					{
+						Name:   "model1",
+						Weight: 100,
+					},
+				},
+				Mirror: &scheduler.ExperimentMirror{
+					Name:    "model2",
+					Percent: 10,
+					Comparison: &scheduler.ShadowComparison{
+						Outputs:   []string{"predict"},
+						Tolerance: 0.001,
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 1,
+				},
+			},
+		},
+		{
+			name: "schedule",
+			experiment: &Experiment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: ExperimentSpec{
+					Default: getStrPtr("model1"),
+					Candidates: []ExperimentCandidate{
+						{
+							Name:   "model1",
+							Weight: 50,
+						},
+						{
+							Name:   "model2",
+							Weight: 50,
+						},
+					},
+					Schedule: &ExperimentSchedule{
+						StartTime:   &metav1.Time{Time: start},
+						EndTime:     &metav1.Time{Time: end},
+						MaxDuration: &metav1.Duration{Duration: 12 * time.Hour},
+						Promotion: &ExperimentPromotion{
+							Query:         `latency{model="{{.Candidate}}"}`,
+							LowerIsBetter: true,
+						},
+					},
+				},
+			},
+			proto: &scheduler.Experiment{
+				Name:         "foo",
+				Default:      getStrPtr("model1"),
+				ResourceType: scheduler.ResourceType_MODEL,
+				Candidates: []*scheduler.ExperimentCandidate{
+					{
+						Name:   "model1",
+						Weight: 50,
+					},
+					{
+						Name:   "model2",
+						Weight: 50,
+					},
+				},
+				Config: &scheduler.ExperimentConfig{
+					Schedule: &scheduler.ExperimentSchedule{
+						StartTime:          timestamppb.New(start),
+						EndTime:            timestamppb.New(end),
+						MaxDurationSeconds: getUint32Ptr(43200),
+						Promotion: &scheduler.ExperimentPromotion{
+							Query:         `latency{model="{{.Candidate}}"}`,
+							LowerIsBetter: true,
+						},
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 1,
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -44,6 +44,364 @@ type ModelSpec struct {
 	Explainer *ExplainerSpec `json:"explainer,omitempty"`
 	// Parameters to load with model
 	Parameters []ParameterSpec `json:"parameters,omitempty"`
+	// Rate limit on requests consumed from Kafka by the model gateway
+	// +optional
+	RateLimit *RateLimitSpec `json:"rateLimit,omitempty"`
+	// Kafka settings for the model input and output topics
+	// +optional
+	TopicConfig *KafkaTopicConfig `json:"topicConfig,omitempty"`
+	// Timeouts, retries and circuit breaking applied by Envoy to requests to the model
+	// +optional
+	TrafficPolicy *TrafficPolicy `json:"trafficPolicy,omitempty"`
+	// Callers allowed to send inference requests to the model when Envoy authentication is enabled
+	// +optional
+	Authorization *AuthorizationSpec `json:"authorization,omitempty"`
+	// Gradual shift of traffic to new versions of the model, by default traffic moves as soon as replicas are ready
+	// +optional
+	Rollout *RolloutSpec `json:"rollout,omitempty"`
+}
+
+type RateLimitSpec struct {
+	// Sustained requests per second
+	// +kubebuilder:validation:Minimum=1
+	RequestsPerSecond int32 `json:"requestsPerSecond"`
+	// Maximum requests allowed at once, defaults to requestsPerSecond
+	// +optional
+	Burst *int32 `json:"burst,omitempty"`
+}
+
+// KafkaTopicConfig overrides the global Kafka topic defaults. Partitions and settings are reconciled on update
+// but partitions are never reduced and the replication factor only applies to new topics.
+type KafkaTopicConfig struct {
+	// Number of partitions, increasing it for existing topics changes the partition of existing keys
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Partitions *int32 `json:"partitions,omitempty"`
+	// Replication factor used when the topics are created
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ReplicationFactor *int32 `json:"replicationFactor,omitempty"`
+	// Time in milliseconds messages are kept, -1 for no limit
+	// +kubebuilder:validation:Minimum=-1
+	// +optional
+	RetentionMs *int64 `json:"retentionMs,omitempty"`
+	// Kafka cleanup policy
+	// +kubebuilder:validation:Enum=delete;compact;"compact,delete"
+	// +optional
+	CleanupPolicy *string `json:"cleanupPolicy,omitempty"`
+	// Compression applied by the brokers, producer keeps the compression set by the producer
+	// +kubebuilder:validation:Enum=uncompressed;producer;gzip;snappy;lz4;zstd
+	// +optional
+	CompressionType *string `json:"compressionType,omitempty"`
+}
+
+func (tc *KafkaTopicConfig) AsSchedulerTopicConfig() *scheduler.KafkaTopicConfig {
+	if tc == nil {
+		return nil
+	}
+	topicConfig := &scheduler.KafkaTopicConfig{
+		RetentionMs:     tc.RetentionMs,
+		CleanupPolicy:   tc.CleanupPolicy,
+		CompressionType: tc.CompressionType,
+	}
+	if tc.Partitions != nil {
+		partitions := uint32(*tc.Partitions)
+		topicConfig.Partitions = &partitions
+	}
+	if tc.ReplicationFactor != nil {
+		replicationFactor := uint32(*tc.ReplicationFactor)
+		topicConfig.ReplicationFactor = &replicationFactor
+	}
+	return topicConfig
+}
+
+// TrafficPolicy overrides the Envoy defaults for requests to a model or pipeline
+type TrafficPolicy struct {
+	// Request timeout in milliseconds, no timeout by default
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutMs *int32 `json:"timeoutMs,omitempty"`
+	// Retries of failed requests, none by default
+	// +optional
+	Retry *RetryPolicy `json:"retry,omitempty"`
+	// Limits on requests to the replicas
+	// +optional
+	CircuitBreaker *CircuitBreaker `json:"circuitBreaker,omitempty"`
+	// Ejection of replicas which return consecutive errors
+	// +optional
+	OutlierDetection *OutlierDetection `json:"outlierDetection,omitempty"`
+	// Limits on requests per route and per caller, requests above the limits get a 429
+	// +optional
+	RateLimit *RouteRateLimit `json:"rateLimit,omitempty"`
+}
+
+type RetryPolicy struct {
+	// Envoy retry conditions, e.g. 5xx,reset for HTTP or unavailable,resource-exhausted for gRPC
+	// +kubebuilder:validation:MinLength=1
+	RetryOn string `json:"retryOn"`
+	// Number of retries, defaults to 1
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	NumRetries *int32 `json:"numRetries,omitempty"`
+	// Timeout in milliseconds for each try, defaults to the request timeout
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	PerTryTimeoutMs *int32 `json:"perTryTimeoutMs,omitempty"`
+}
+
+type CircuitBreaker struct {
+	// Maximum connections to the replicas
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConnections *int32 `json:"maxConnections,omitempty"`
+	// Maximum requests waiting for a connection
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxPendingRequests *int32 `json:"maxPendingRequests,omitempty"`
+}
+
+type OutlierDetection struct {
+	// Consecutive errors before a replica is ejected
+	// +kubebuilder:validation:Minimum=1
+	ConsecutiveErrors int32 `json:"consecutiveErrors"`
+	// Time in milliseconds between ejection sweeps
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	IntervalMs *int32 `json:"intervalMs,omitempty"`
+	// Minimum time in milliseconds a replica is ejected for
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BaseEjectionTimeMs *int32 `json:"baseEjectionTimeMs,omitempty"`
+	// Maximum percentage of replicas which can be ejected
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxEjectionPercent *int32 `json:"maxEjectionPercent,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=second;minute;hour
+type RateLimitUnit string
+
+const (
+	RateLimitUnitSecond RateLimitUnit = "second"
+	RateLimitUnitMinute RateLimitUnit = "minute"
+	RateLimitUnitHour   RateLimitUnit = "hour"
+)
+
+type RouteRateLimit struct {
+	// Period of the requests limit and the quotas, defaults to second
+	// +optional
+	Unit *RateLimitUnit `json:"unit,omitempty"`
+	// Limit on all requests to the model or pipeline per unit
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Requests *int32 `json:"requests,omitempty"`
+	// Maximum requests allowed at once, defaults to requests
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Burst *int32 `json:"burst,omitempty"`
+	// Request header identifying the caller, e.g. a tenant header or x-api-key
+	// +optional
+	Header string `json:"header,omitempty"`
+	// Limits for callers with the given header values, which need the header to be set
+	// +optional
+	Quotas []RateLimitQuota `json:"quotas,omitempty"`
+	// Also check requests with the global rate limit service configured in the scheduler
+	// +optional
+	Global bool `json:"global,omitempty"`
+}
+
+type RateLimitQuota struct {
+	// Value of the caller header
+	Value string `json:"value"`
+	// Limit on requests from the caller per unit
+	// +kubebuilder:validation:Minimum=1
+	Requests int32 `json:"requests"`
+	// Maximum requests allowed at once, defaults to requests
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Burst *int32 `json:"burst,omitempty"`
+}
+
+func (u *RateLimitUnit) asSchedulerUnit() scheduler.RouteRateLimit_Unit {
+	if u == nil {
+		return scheduler.RouteRateLimit_SECOND
+	}
+	switch *u {
+	case RateLimitUnitMinute:
+		return scheduler.RouteRateLimit_MINUTE
+	case RateLimitUnitHour:
+		return scheduler.RouteRateLimit_HOUR
+	default:
+		return scheduler.RouteRateLimit_SECOND
+	}
+}
+
+func (rl *RouteRateLimit) asSchedulerRateLimit() *scheduler.RouteRateLimit {
+	if rl == nil {
+		return nil
+	}
+	rateLimit := &scheduler.RouteRateLimit{
+		Unit:     rl.Unit.asSchedulerUnit(),
+		Requests: asUint32Ptr(rl.Requests),
+		Burst:    asUint32Ptr(rl.Burst),
+		Global:   rl.Global,
+	}
+	if rl.Header != "" {
+		header := rl.Header
+		rateLimit.Header = &header
+	}
+	for _, quota := range rl.Quotas {
+		rateLimit.Quotas = append(rateLimit.Quotas, &scheduler.RateLimitQuota{
+			Value:    quota.Value,
+			Requests: uint32(quota.Requests),
+			Burst:    asUint32Ptr(quota.Burst),
+		})
+	}
+	return rateLimit
+}
+
+func asUint32Ptr(val *int32) *uint32 {
+	if val == nil {
+		return nil
+	}
+	u := uint32(*val)
+	return &u
+}
+
+func (tp *TrafficPolicy) AsSchedulerTrafficPolicy() *scheduler.TrafficPolicy {
+	if tp == nil {
+		return nil
+	}
+	trafficPolicy := &scheduler.TrafficPolicy{
+		TimeoutMs: asUint32Ptr(tp.TimeoutMs),
+		RateLimit: tp.RateLimit.asSchedulerRateLimit(),
+	}
+	if tp.Retry != nil {
+		trafficPolicy.Retry = &scheduler.RetryPolicy{
+			RetryOn:         tp.Retry.RetryOn,
+			NumRetries:      1,
+			PerTryTimeoutMs: asUint32Ptr(tp.Retry.PerTryTimeoutMs),
+		}
+		if tp.Retry.NumRetries != nil {
+			trafficPolicy.Retry.NumRetries = uint32(*tp.Retry.NumRetries)
+		}
+	}
+	if tp.CircuitBreaker != nil {
+		trafficPolicy.CircuitBreaker = &scheduler.CircuitBreaker{
+			MaxConnections:     asUint32Ptr(tp.CircuitBreaker.MaxConnections),
+			MaxPendingRequests: asUint32Ptr(tp.CircuitBreaker.MaxPendingRequests),
+		}
+	}
+	if tp.OutlierDetection != nil {
+		trafficPolicy.OutlierDetection = &scheduler.OutlierDetection{
+			ConsecutiveErrors:  uint32(tp.OutlierDetection.ConsecutiveErrors),
+			IntervalMs:         asUint32Ptr(tp.OutlierDetection.IntervalMs),
+			BaseEjectionTimeMs: asUint32Ptr(tp.OutlierDetection.BaseEjectionTimeMs),
+			MaxEjectionPercent: asUint32Ptr(tp.OutlierDetection.MaxEjectionPercent),
+		}
+	}
+	return trafficPolicy
+}
+
+// AuthorizationSpec lists the callers allowed to send requests to a model or pipeline.
+// A caller is allowed if its subject or any of its scopes is listed.
+type AuthorizationSpec struct {
+	// Allowed JWT subjects or API key subjects
+	// +optional
+	Subjects []string `json:"subjects,omitempty"`
+	// Allowed JWT scopes or API key scopes
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+func (as *AuthorizationSpec) AsSchedulerAuthorizationPolicy() *scheduler.AuthorizationPolicy {
+	if as == nil {
+		return nil
+	}
+	return &scheduler.AuthorizationPolicy{
+		Subjects: as.Subjects,
+		Scopes:   as.Scopes,
+	}
+}
+
+// RolloutSpec moves traffic from the last available version of a model to a new version in steps. Each step
+// starts once the new version is available and holds while the analysis criteria are checked, all traffic
+// moves to the new version after the last step.
+type RolloutSpec struct {
+	// Steps of the rollout in order
+	// +kubebuilder:validation:MinItems=1
+	Steps []RolloutStep `json:"steps"`
+	// Success criteria for each step from the agent metrics in Prometheus, steps only hold by default
+	// +optional
+	Analysis *RolloutAnalysis `json:"analysis,omitempty"`
+}
+
+type RolloutStep struct {
+	// Percentage of requests sent to the new version
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	TrafficPercent int32 `json:"trafficPercent"`
+	// Seconds to stay at this step before moving to the next
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	HoldSeconds int32 `json:"holdSeconds,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=rollback;pause
+type RolloutFailureAction string
+
+const (
+	RollbackRolloutFailureAction RolloutFailureAction = "rollback"
+	PauseRolloutFailureAction    RolloutFailureAction = "pause"
+)
+
+type RolloutAnalysis struct {
+	// Maximum percentage of requests to the new version failing with a non 2xx or OK code
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxErrorPercent *int32 `json:"maxErrorPercent,omitempty"`
+	// Maximum mean latency in milliseconds of requests to the new version
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxLatencyMs *int32 `json:"maxLatencyMs,omitempty"`
+	// Requests to the new version needed in a step before the criteria are checked
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinRequests *int32 `json:"minRequests,omitempty"`
+	// What happens when a step fails the criteria, defaults to rollback which sends all traffic to the previous version
+	// +optional
+	FailureAction *RolloutFailureAction `json:"failureAction,omitempty"`
+}
+
+func (rs *RolloutSpec) AsSchedulerRolloutStrategy() *scheduler.RolloutStrategy {
+	if rs == nil {
+		return nil
+	}
+	strategy := &scheduler.RolloutStrategy{}
+	for _, step := range rs.Steps {
+		strategy.Steps = append(strategy.Steps, &scheduler.RolloutStep{
+			TrafficPercent: uint32(step.TrafficPercent),
+			HoldSeconds:    uint32(step.HoldSeconds),
+		})
+	}
+	if rs.Analysis != nil {
+		strategy.Analysis = &scheduler.RolloutAnalysis{
+			MaxLatencyMs: asUint32Ptr(rs.Analysis.MaxLatencyMs),
+		}
+		if rs.Analysis.MaxErrorPercent != nil {
+			maxErrorRate := float32(*rs.Analysis.MaxErrorPercent) / 100
+			strategy.Analysis.MaxErrorRate = &maxErrorRate
+		}
+		if rs.Analysis.MinRequests != nil {
+			strategy.Analysis.MinRequests = uint32(*rs.Analysis.MinRequests)
+		}
+		if rs.Analysis.FailureAction != nil && *rs.Analysis.FailureAction == PauseRolloutFailureAction {
+			strategy.Analysis.FailureAction = scheduler.RolloutAnalysis_PAUSE
+		}
+	}
+	return strategy
 }
 
 type ParameterSpec struct {
@@ -216,6 +574,22 @@ func (m Model) AsSchedulerModel() (*scheduler.Model, error) {
 		md.DeploymentSpec.MaxReplicas = 0
 	}
 
+	if m.Spec.RateLimit != nil || m.Spec.TopicConfig != nil {
+		md.StreamSpec = &scheduler.StreamSpec{TopicConfig: m.Spec.TopicConfig.AsSchedulerTopicConfig()}
+		if m.Spec.RateLimit != nil {
+			md.StreamSpec.RateLimit = &scheduler.StreamRateLimit{
+				RequestsPerSecond: uint32(m.Spec.RateLimit.RequestsPerSecond),
+			}
+			if m.Spec.RateLimit.Burst != nil {
+				md.StreamSpec.RateLimit.Burst = uint32(*m.Spec.RateLimit.Burst)
+			}
+		}
+	}
+
+	md.ModelSpec.TrafficPolicy = m.Spec.TrafficPolicy.AsSchedulerTrafficPolicy()
+	md.ModelSpec.Authorization = m.Spec.Authorization.AsSchedulerAuthorizationPolicy()
+	md.ModelSpec.Rollout = m.Spec.Rollout.AsSchedulerRolloutStrategy()
+
 	// Set memory bytes
 	if m.Spec.Memory != nil {
 		if i64, ok := m.Spec.Memory.AsInt64(); ok {
@@ -34,6 +34,26 @@ func TestAsModelDetails(t *testing.T) {
 	server := "server"
 	m1 := resource.MustParse("1M")
 	m1bytes := uint64(1_000_000)
+	burst := int32(20)
+	partitions := int32(4)
+	partitionsProto := uint32(4)
+	timeoutMs := int32(2000)
+	timeoutMsProto := uint32(2000)
+	maxEjectionPercent := int32(50)
+	maxEjectionPercentProto := uint32(50)
+	rateLimitUnit := RateLimitUnitMinute
+	rateLimitRequests := int32(600)
+	rateLimitRequestsProto := uint32(600)
+	rateLimitHeader := "x-tenant"
+	retentionMs := int64(3600000)
+	cleanupPolicy := "compact"
+	compressionType := "zstd"
+	maxErrorPercent := int32(5)
+	maxErrorRate := float32(0.05)
+	maxLatencyMs := int32(200)
+	maxLatencyMsProto := uint32(200)
+	minRequests := int32(100)
+	pauseFailureAction := PauseRolloutFailureAction
 	incomeModel := "income"
 	tests := []test{
 		{
@@ -173,6 +193,250 @@ func TestAsModelDetails(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "rate limit",
+			model: &Model{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: ModelSpec{
+					InferenceArtifactSpec: InferenceArtifactSpec{
+						StorageURI: "gs://test",
+					},
+					RateLimit: &RateLimitSpec{
+						RequestsPerSecond: 10,
+						Burst:             &burst,
+					},
+				},
+			},
+			modelpb: &scheduler.Model{
+				Meta: &scheduler.MetaData{
+					Name: "foo",
+					KubernetesMeta: &scheduler.KubernetesMeta{
+						Namespace:  "default",
+						Generation: 1,
+					},
+				},
+				ModelSpec: &scheduler.ModelSpec{
+					Uri: "gs://test",
+				},
+				DeploymentSpec: &scheduler.DeploymentSpec{
+					Replicas:    1,
+					MinReplicas: 0,
+					MaxReplicas: 0,
+				},
+				StreamSpec: &scheduler.StreamSpec{
+					RateLimit: &scheduler.StreamRateLimit{
+						RequestsPerSecond: 10,
+						Burst:             20,
+					},
+				},
+			},
+		},
+		{
+			name: "topic config",
+			model: &Model{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: ModelSpec{
+					InferenceArtifactSpec: InferenceArtifactSpec{
+						StorageURI: "gs://test",
+					},
+					TopicConfig: &KafkaTopicConfig{
+						Partitions:      &partitions,
+						RetentionMs:     &retentionMs,
+						CleanupPolicy:   &cleanupPolicy,
+						CompressionType: &compressionType,
+					},
+				},
+			},
+			modelpb: &scheduler.Model{
+				Meta: &scheduler.MetaData{
+					Name: "foo",
+					KubernetesMeta: &scheduler.KubernetesMeta{
+						Namespace:  "default",
+						Generation: 1,
+					},
+				},
+				ModelSpec: &scheduler.ModelSpec{
+					Uri: "gs://test",
+				},
+				DeploymentSpec: &scheduler.DeploymentSpec{
+					Replicas:    1,
+					MinReplicas: 0,
+					MaxReplicas: 0,
+				},
+				StreamSpec: &scheduler.StreamSpec{
+					TopicConfig: &scheduler.KafkaTopicConfig{
+						Partitions:      &partitionsProto,
+						RetentionMs:     &retentionMs,
+						CleanupPolicy:   &cleanupPolicy,
+						CompressionType: &compressionType,
+					},
+				},
+			},
+		},
+		{
+			name: "traffic policy",
+			model: &Model{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: ModelSpec{
+					InferenceArtifactSpec: InferenceArtifactSpec{
+						StorageURI: "gs://test",
+					},
+					TrafficPolicy: &TrafficPolicy{
+						TimeoutMs: &timeoutMs,
+						Retry:     &RetryPolicy{RetryOn: "5xx"},
+						OutlierDetection: &OutlierDetection{
+							ConsecutiveErrors:  5,
+							MaxEjectionPercent: &maxEjectionPercent,
+						},
+						RateLimit: &RouteRateLimit{
+							Unit:     &rateLimitUnit,
+							Requests: &rateLimitRequests,
+							Header:   "x-tenant",
+							Quotas:   []RateLimitQuota{{Value: "tenant-a", Requests: 10}},
+						},
+					},
+				},
+			},
+			modelpb: &scheduler.Model{
+				Meta: &scheduler.MetaData{
+					Name: "foo",
+					KubernetesMeta: &scheduler.KubernetesMeta{
+						Namespace:  "default",
+						Generation: 1,
+					},
+				},
+				ModelSpec: &scheduler.ModelSpec{
+					Uri: "gs://test",
+					TrafficPolicy: &scheduler.TrafficPolicy{
+						TimeoutMs: &timeoutMsProto,
+						Retry:     &scheduler.RetryPolicy{RetryOn: "5xx", NumRetries: 1},
+						OutlierDetection: &scheduler.OutlierDetection{
+							ConsecutiveErrors:  5,
+							MaxEjectionPercent: &maxEjectionPercentProto,
+						},
+						RateLimit: &scheduler.RouteRateLimit{
+							Unit:     scheduler.RouteRateLimit_MINUTE,
+							Requests: &rateLimitRequestsProto,
+							Header:   &rateLimitHeader,
+							Quotas:   []*scheduler.RateLimitQuota{{Value: "tenant-a", Requests: 10}},
+						},
+					},
+				},
+				DeploymentSpec: &scheduler.DeploymentSpec{
+					Replicas:    1,
+					MinReplicas: 0,
+					MaxReplicas: 0,
+				},
+			},
+		},
+		{
+			name: "authorization",
+			model: &Model{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: ModelSpec{
+					InferenceArtifactSpec: InferenceArtifactSpec{
+						StorageURI: "gs://test",
+					},
+					Authorization: &AuthorizationSpec{
+						Subjects: []string{"team-a"},
+						Scopes:   []string{"predict"},
+					},
+				},
+			},
+			modelpb: &scheduler.Model{
+				Meta: &scheduler.MetaData{
+					Name: "foo",
+					KubernetesMeta: &scheduler.KubernetesMeta{
+						Namespace:  "default",
+						Generation: 1,
+					},
+				},
+				ModelSpec: &scheduler.ModelSpec{
+					Uri: "gs://test",
+					Authorization: &scheduler.AuthorizationPolicy{
+						Subjects: []string{"team-a"},
+						Scopes:   []string{"predict"},
+					},
+				},
+				DeploymentSpec: &scheduler.DeploymentSpec{
+					Replicas:    1,
+					MinReplicas: 0,
+					MaxReplicas: 0,
+				},
+			},
+		},
+		{
+			name: "rollout",
+			model: &Model{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: ModelSpec{
+					InferenceArtifactSpec: InferenceArtifactSpec{
+						StorageURI: "gs://test",
+					},
+					Rollout: &RolloutSpec{
+						Steps: []RolloutStep{
+							{TrafficPercent: 5, HoldSeconds: 300},
+							{TrafficPercent: 25, HoldSeconds: 600},
+						},
+						Analysis: &RolloutAnalysis{
+							MaxErrorPercent: &maxErrorPercent,
+							MaxLatencyMs:    &maxLatencyMs,
+							MinRequests:     &minRequests,
+							FailureAction:   &pauseFailureAction,
+						},
+					},
+				},
+			},
+			modelpb: &scheduler.Model{
+				Meta: &scheduler.MetaData{
+					Name: "foo",
+					KubernetesMeta: &scheduler.KubernetesMeta{
+						Namespace:  "default",
+						Generation: 1,
+					},
+				},
+				ModelSpec: &scheduler.ModelSpec{
+					Uri: "gs://test",
+					Rollout: &scheduler.RolloutStrategy{
+						Steps: []*scheduler.RolloutStep{
+							{TrafficPercent: 5, HoldSeconds: 300},
+							{TrafficPercent: 25, HoldSeconds: 600},
+						},
+						Analysis: &scheduler.RolloutAnalysis{
+							MaxErrorRate:  &maxErrorRate,
+							MaxLatencyMs:  &maxLatencyMsProto,
+							MinRequests:   100,
+							FailureAction: scheduler.RolloutAnalysis_PAUSE,
+						},
+					},
+				},
+				DeploymentSpec: &scheduler.DeploymentSpec{
+					Replicas:    1,
+					MinReplicas: 0,
+					MaxReplicas: 0,
+				},
+			},
+		},
 		{
 			name: "simple min replica",
 			model: &Model{
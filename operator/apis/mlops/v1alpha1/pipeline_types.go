@@ -51,6 +51,47 @@ type PipelineSpec struct {
 
 	// Synchronous output from this pipeline, optional
 	Output *PipelineOutput `json:"output,omitempty"`
+
+	// Roll out this version as a canary alongside the current ready version, optional
+	Canary *PipelineCanary `json:"canary,omitempty"`
+
+	// Tensors expected in the pipeline requests and responses, checked by the pipeline gateway, optional
+	Schema *PipelineSchema `json:"schema,omitempty"`
+
+	// Kafka settings for the pipeline input and output topics, optional
+	TopicConfig *KafkaTopicConfig `json:"topicConfig,omitempty"`
+
+	// Timeouts, retries and circuit breaking applied by Envoy to requests to the pipeline, optional
+	TrafficPolicy *TrafficPolicy `json:"trafficPolicy,omitempty"`
+
+	// Callers allowed to send inference requests to the pipeline when Envoy authentication is enabled, optional
+	Authorization *AuthorizationSpec `json:"authorization,omitempty"`
+}
+
+type PipelineCanary struct {
+	// Percentage of traffic sent to the new version, the rest stays on the current version
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	TrafficPercent uint32 `json:"trafficPercent"`
+}
+
+type PipelineSchema struct {
+	// Tensors that must be present in pipeline requests
+	// +optional
+	Inputs []TensorSchema `json:"inputs,omitempty"`
+	// Tensors that must be present in pipeline responses
+	// +optional
+	Outputs []TensorSchema `json:"outputs,omitempty"`
+}
+
+type TensorSchema struct {
+	Name string `json:"name"`
+	// V2 datatype of the tensor, any datatype if not specified
+	// +optional
+	Datatype string `json:"datatype,omitempty"`
+	// Shape of the tensor with -1 for a dimension of any size, any shape if not specified
+	// +optional
+	Shape []int64 `json:"shape,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=inner;outer;any
@@ -88,6 +129,70 @@ type PipelineStep struct {
 
 	// Batch size of request required before data will be sent to this step
 	Batch *PipelineBatch `json:"batch,omitempty"`
+
+	// Built-in tensor transforms applied in order by the dataflow engine.
+	// If set the step does not call a model.
+	Transforms []PipelineTransform `json:"transforms,omitempty"`
+
+	// Call this step once per element of an input tensor and gather the outputs in order
+	ForEach *PipelineForEach `json:"forEach,omitempty"`
+}
+
+type PipelineForEach struct {
+	// Input tensor to split into elements
+	Tensor string `json:"tensor"`
+	// Dimension to split along, default 0
+	Axis uint32 `json:"axis,omitempty"`
+	// Max number of elements in flight for a request, default no limit
+	Parallelism *uint32 `json:"parallelism,omitempty"`
+	// Time to wait for all element outputs before dropping the request, default 60s
+	GatherTimeoutMs *uint32 `json:"gatherTimeoutMs,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=concat;split;select;cast;reshape;constant;jsonPath
+type TransformType string
+
+const (
+	// concatenate tensors along an axis into a single output tensor
+	TransformTypeConcat TransformType = "concat"
+	// split a tensor evenly along an axis into several output tensors
+	TransformTypeSplit TransformType = "split"
+	// keep only the listed tensors
+	TransformTypeSelect TransformType = "select"
+	// convert tensors to another datatype
+	TransformTypeCast TransformType = "cast"
+	// change the shape of a tensor
+	TransformTypeReshape TransformType = "reshape"
+	// add a tensor with constant values
+	TransformTypeConstant TransformType = "constant"
+	// extract a value from JSON held in a BYTES tensor
+	TransformTypeJsonPath TransformType = "jsonPath"
+)
+
+type PipelineTransform struct {
+	// Type of transform
+	Type TransformType `json:"type"`
+
+	// Tensors the transform reads
+	Tensors []string `json:"tensors,omitempty"`
+
+	// Tensors the transform creates
+	Outputs []string `json:"outputs,omitempty"`
+
+	// Axis for concat and split
+	Axis uint32 `json:"axis,omitempty"`
+
+	// Target datatype for cast, constant and jsonPath
+	Datatype string `json:"datatype,omitempty"`
+
+	// Target shape for reshape and constant
+	Shape []int64 `json:"shape,omitempty"`
+
+	// Flattened values for constant
+	Values []string `json:"values,omitempty"`
+
+	// JSON path for jsonPath e.g. $.a.b[0]
+	Path string `json:"path,omitempty"`
 }
 
 type PipelineBatch struct {
@@ -114,6 +219,9 @@ type PipelineInput struct {
 
 	// Map of tensor name conversions to use e.g. output1 -> input1
 	TensorMap map[string]string `json:"tensorMap,omitempty"`
+
+	// Existing Kafka topics whose records are converted to pipeline requests
+	KafkaSources []PipelineKafkaTopic `json:"kafkaSources,omitempty"`
 }
 
 type PipelineOutput struct {
@@ -128,6 +236,34 @@ type PipelineOutput struct {
 
 	// Map of tensor name conversions to use e.g. output1 -> input1
 	TensorMap map[string]string `json:"tensorMap,omitempty"`
+
+	// Existing Kafka topics the pipeline outputs are converted and written to
+	KafkaSinks []PipelineKafkaTopic `json:"kafkaSinks,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=json;csv;bytes
+type KafkaRecordFormat string
+
+const (
+	// a JSON object with a field per tensor
+	KafkaRecordFormatJson KafkaRecordFormat = "json"
+	// a line of comma separated values, filling the tensors in order
+	KafkaRecordFormatCsv KafkaRecordFormat = "csv"
+	// the raw record value as a single BYTES tensor
+	KafkaRecordFormatBytes KafkaRecordFormat = "bytes"
+)
+
+type PipelineKafkaTopic struct {
+	// Full name of the topic, the Seldon topic prefix is not added
+	Topic string `json:"topic"`
+
+	// Format of the records on the topic
+	// +kubebuilder:default=json
+	Format KafkaRecordFormat `json:"format,omitempty"`
+
+	// Tensors read from or written to each record, in order.
+	// Datatype and shape are only used for sources.
+	Tensors []TensorSchema `json:"tensors,omitempty"`
 }
 
 // PipelineStatus defines the observed state of Pipeline
@@ -149,6 +285,7 @@ func (p Pipeline) AsSchedulerPipeline() *scheduler.Pipeline {
 			ExternalTriggers: p.Spec.Input.ExternalTriggers,
 			JoinWindowMs:     p.Spec.Input.JoinWindowMs,
 			TensorMap:        p.Spec.Input.TensorMap,
+			KafkaSources:     asSchedulerKafkaTopics(p.Spec.Input.KafkaSources),
 		}
 		if p.Spec.Input.JoinType != nil {
 			switch *p.Spec.Input.JoinType {
@@ -213,6 +350,17 @@ func (p Pipeline) AsSchedulerPipeline() *scheduler.Pipeline {
 				WindowMs: step.Batch.WindowMs,
 			}
 		}
+		for _, transform := range step.Transforms {
+			pipelineStep.Transforms = append(pipelineStep.Transforms, transform.AsSchedulerTransform())
+		}
+		if step.ForEach != nil {
+			pipelineStep.ForEach = &scheduler.ForEach{
+				Tensor:          step.ForEach.Tensor,
+				Axis:            step.ForEach.Axis,
+				Parallelism:     step.ForEach.Parallelism,
+				GatherTimeoutMs: step.ForEach.GatherTimeoutMs,
+			}
+		}
 		steps = append(steps, pipelineStep)
 	}
 	if p.Spec.Output != nil {
@@ -220,6 +368,7 @@ func (p Pipeline) AsSchedulerPipeline() *scheduler.Pipeline {
 			Steps:        p.Spec.Output.Steps,
 			JoinWindowMs: p.Spec.Output.JoinWindowMs,
 			TensorMap:    p.Spec.Output.TensorMap,
+			KafkaSinks:   asSchedulerKafkaTopics(p.Spec.Output.KafkaSinks),
 		}
 		if p.Spec.Output.StepsJoin != nil {
 			switch *p.Spec.Output.StepsJoin {
@@ -234,6 +383,19 @@ func (p Pipeline) AsSchedulerPipeline() *scheduler.Pipeline {
 			}
 		}
 	}
+	var canary *scheduler.PipelineCanary
+	if p.Spec.Canary != nil {
+		canary = &scheduler.PipelineCanary{
+			TrafficPercent: p.Spec.Canary.TrafficPercent,
+		}
+	}
+	var schema *scheduler.PipelineSchema
+	if p.Spec.Schema != nil {
+		schema = &scheduler.PipelineSchema{
+			Inputs:  asSchedulerTensorSchemas(p.Spec.Schema.Inputs),
+			Outputs: asSchedulerTensorSchemas(p.Spec.Schema.Outputs),
+		}
+	}
 	return &scheduler.Pipeline{
 		Name:           p.GetName(),
 		Uid:            "", // ID Will be set on scheduler side. IDs don't change on k8s when updates are made so can't use it for each version
@@ -241,7 +403,73 @@ func (p Pipeline) AsSchedulerPipeline() *scheduler.Pipeline {
 		Steps:          steps,
 		Output:         output,
 		KubernetesMeta: &scheduler.KubernetesMeta{Namespace: p.Namespace, Generation: p.Generation},
+		Canary:         canary,
+		Schema:         schema,
+		TopicConfig:    p.Spec.TopicConfig.AsSchedulerTopicConfig(),
+		TrafficPolicy:  p.Spec.TrafficPolicy.AsSchedulerTrafficPolicy(),
+		Authorization:  p.Spec.Authorization.AsSchedulerAuthorizationPolicy(),
+	}
+}
+
+func asSchedulerTensorSchemas(tensors []TensorSchema) []*scheduler.TensorSchema {
+	var schedulerTensors []*scheduler.TensorSchema
+	for _, tensor := range tensors {
+		schedulerTensors = append(schedulerTensors, &scheduler.TensorSchema{
+			Name:     tensor.Name,
+			Datatype: tensor.Datatype,
+			Shape:    tensor.Shape,
+		})
+	}
+	return schedulerTensors
+}
+
+func asSchedulerKafkaTopics(topics []PipelineKafkaTopic) []*scheduler.PipelineKafkaTopic {
+	var schedulerTopics []*scheduler.PipelineKafkaTopic
+	for _, topic := range topics {
+		schedulerTopic := &scheduler.PipelineKafkaTopic{
+			Topic:   topic.Topic,
+			Tensors: asSchedulerTensorSchemas(topic.Tensors),
+		}
+		switch topic.Format {
+		case KafkaRecordFormatCsv:
+			schedulerTopic.Format = scheduler.PipelineKafkaTopic_CSV
+		case KafkaRecordFormatBytes:
+			schedulerTopic.Format = scheduler.PipelineKafkaTopic_BYTES
+		default:
+			schedulerTopic.Format = scheduler.PipelineKafkaTopic_JSON
+		}
+		schedulerTopics = append(schedulerTopics, schedulerTopic)
+	}
+	return schedulerTopics
+}
+
+func (t PipelineTransform) AsSchedulerTransform() *scheduler.PipelineTransform {
+	transform := &scheduler.PipelineTransform{
+		Tensors:  t.Tensors,
+		Outputs:  t.Outputs,
+		Axis:     t.Axis,
+		Datatype: t.Datatype,
+		Shape:    t.Shape,
+		Values:   t.Values,
+		Path:     t.Path,
+	}
+	switch t.Type {
+	case TransformTypeConcat:
+		transform.Type = scheduler.PipelineTransform_CONCAT
+	case TransformTypeSplit:
+		transform.Type = scheduler.PipelineTransform_SPLIT
+	case TransformTypeSelect:
+		transform.Type = scheduler.PipelineTransform_SELECT
+	case TransformTypeCast:
+		transform.Type = scheduler.PipelineTransform_CAST
+	case TransformTypeReshape:
+		transform.Type = scheduler.PipelineTransform_RESHAPE
+	case TransformTypeConstant:
+		transform.Type = scheduler.PipelineTransform_CONSTANT
+	case TransformTypeJsonPath:
+		transform.Type = scheduler.PipelineTransform_JSONPATH
 	}
+	return transform
 }
 
 const (
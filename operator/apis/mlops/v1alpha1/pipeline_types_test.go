@@ -28,6 +28,11 @@ func TestAsPipelineDetails(t *testing.T) {
 
 	getUintPtr := func(val uint32) *uint32 { return &val }
 	getJoinPtr := func(val JoinType) *JoinType { return &val }
+	partitions := int32(6)
+	numRetries := int32(3)
+	maxPendingRequests := int32(20)
+	replicationFactor := int32(3)
+	cleanupPolicy := "delete"
 	tests := []test{
 		{
 			name: "basic",
@@ -45,6 +50,10 @@ func TestAsPipelineDetails(t *testing.T) {
 						{
 							Name:   "b",
 							Inputs: []string{"a"},
+							ForEach: &PipelineForEach{
+								Tensor:      "boxes",
+								Parallelism: getUintPtr(4),
+							},
 						},
 						{
 							Name:           "c",
@@ -74,6 +83,10 @@ func TestAsPipelineDetails(t *testing.T) {
 					{
 						Name:   "b",
 						Inputs: []string{"a"},
+						ForEach: &scheduler.ForEach{
+							Tensor:      "boxes",
+							Parallelism: getUintPtr(4),
+						},
 					},
 					{
 						Name:         "c",
@@ -97,6 +110,258 @@ func TestAsPipelineDetails(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "transforms",
+			pipeline: &Pipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: PipelineSpec{
+					Steps: []PipelineStep{
+						{
+							Name: "a",
+						},
+						{
+							Name:   "b",
+							Inputs: []string{"a"},
+							Transforms: []PipelineTransform{
+								{Type: TransformTypeConcat, Tensors: []string{"t1", "t2"}, Outputs: []string{"t3"}, Axis: 1},
+								{Type: TransformTypeReshape, Tensors: []string{"t3"}, Shape: []int64{-1, 4}},
+								{Type: TransformTypeJsonPath, Tensors: []string{"t4"}, Outputs: []string{"t5"}, Path: "$.a", Datatype: "FP32"},
+							},
+						},
+					},
+				},
+			},
+			proto: &scheduler.Pipeline{
+				Name: "foo",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "a",
+					},
+					{
+						Name:   "b",
+						Inputs: []string{"a"},
+						Transforms: []*scheduler.PipelineTransform{
+							{Type: scheduler.PipelineTransform_CONCAT, Tensors: []string{"t1", "t2"}, Outputs: []string{"t3"}, Axis: 1},
+							{Type: scheduler.PipelineTransform_RESHAPE, Tensors: []string{"t3"}, Shape: []int64{-1, 4}},
+							{Type: scheduler.PipelineTransform_JSONPATH, Tensors: []string{"t4"}, Outputs: []string{"t5"}, Path: "$.a", Datatype: "FP32"},
+						},
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 1,
+				},
+			},
+		},
+		{
+			name: "canary",
+			pipeline: &Pipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 2,
+				},
+				Spec: PipelineSpec{
+					Steps: []PipelineStep{
+						{
+							Name: "a",
+						},
+					},
+					Canary: &PipelineCanary{TrafficPercent: 10},
+				},
+			},
+			proto: &scheduler.Pipeline{
+				Name: "foo",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "a",
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 2,
+				},
+				Canary: &scheduler.PipelineCanary{TrafficPercent: 10},
+			},
+		},
+		{
+			name: "topic config",
+			pipeline: &Pipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: PipelineSpec{
+					Steps: []PipelineStep{
+						{
+							Name: "a",
+						},
+					},
+					TopicConfig: &KafkaTopicConfig{
+						Partitions:        &partitions,
+						ReplicationFactor: &replicationFactor,
+						CleanupPolicy:     &cleanupPolicy,
+					},
+				},
+			},
+			proto: &scheduler.Pipeline{
+				Name: "foo",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "a",
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 1,
+				},
+				TopicConfig: &scheduler.KafkaTopicConfig{
+					Partitions:        getUintPtr(6),
+					ReplicationFactor: getUintPtr(3),
+					CleanupPolicy:     &cleanupPolicy,
+				},
+			},
+		},
+		{
+			name: "traffic policy",
+			pipeline: &Pipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: PipelineSpec{
+					Steps: []PipelineStep{
+						{
+							Name: "a",
+						},
+					},
+					TrafficPolicy: &TrafficPolicy{
+						Retry: &RetryPolicy{RetryOn: "unavailable", NumRetries: &numRetries},
+						CircuitBreaker: &CircuitBreaker{
+							MaxPendingRequests: &maxPendingRequests,
+						},
+					},
+				},
+			},
+			proto: &scheduler.Pipeline{
+				Name: "foo",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "a",
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 1,
+				},
+				TrafficPolicy: &scheduler.TrafficPolicy{
+					Retry: &scheduler.RetryPolicy{RetryOn: "unavailable", NumRetries: 3},
+					CircuitBreaker: &scheduler.CircuitBreaker{
+						MaxPendingRequests: getUintPtr(20),
+					},
+				},
+			},
+		},
+		{
+			name: "authorization",
+			pipeline: &Pipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: PipelineSpec{
+					Steps: []PipelineStep{
+						{
+							Name: "a",
+						},
+					},
+					Authorization: &AuthorizationSpec{
+						Subjects: []string{"team-a"},
+					},
+				},
+			},
+			proto: &scheduler.Pipeline{
+				Name: "foo",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "a",
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Authorization: &scheduler.AuthorizationPolicy{
+					Subjects: []string{"team-a"},
+				},
+			},
+		},
+		{
+			name: "kafka sources and sinks",
+			pipeline: &Pipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: PipelineSpec{
+					Input: &PipelineInput{
+						KafkaSources: []PipelineKafkaTopic{
+							{
+								Topic:   "events",
+								Format:  KafkaRecordFormatCsv,
+								Tensors: []TensorSchema{{Name: "x", Datatype: "FP32", Shape: []int64{4}}},
+							},
+						},
+					},
+					Steps: []PipelineStep{
+						{
+							Name: "a",
+						},
+					},
+					Output: &PipelineOutput{
+						Steps: []string{"a"},
+						KafkaSinks: []PipelineKafkaTopic{
+							{Topic: "predictions"},
+						},
+					},
+				},
+			},
+			proto: &scheduler.Pipeline{
+				Name: "foo",
+				Input: &scheduler.PipelineInput{
+					KafkaSources: []*scheduler.PipelineKafkaTopic{
+						{
+							Topic:   "events",
+							Format:  scheduler.PipelineKafkaTopic_CSV,
+							Tensors: []*scheduler.TensorSchema{{Name: "x", Datatype: "FP32", Shape: []int64{4}}},
+						},
+					},
+				},
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "a",
+					},
+				},
+				Output: &scheduler.PipelineOutput{
+					Steps: []string{"a"},
+					KafkaSinks: []*scheduler.PipelineKafkaTopic{
+						{Topic: "predictions", Format: scheduler.PipelineKafkaTopic_JSON},
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 1,
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
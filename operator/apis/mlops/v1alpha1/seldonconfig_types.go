@@ -60,6 +60,42 @@ type KafkaConfig struct {
 	Producer              map[string]intstr.IntOrString `json:"producer,omitempty"`
 	Streams               map[string]intstr.IntOrString `json:"streams,omitempty"`
 	TopicPrefix           string                        `json:"topicPrefix,omitempty"`
+	SchemaRegistry        *KafkaSchemaRegistryConfig    `json:"schemaRegistry,omitempty"`
+	ClaimCheck            *KafkaClaimCheckConfig        `json:"claimCheck,omitempty"`
+	// Transport used by the scheduler and gateways, kafka by default. inprocess keeps messages in the memory of each
+	// process so it does not pass messages between components, and is meant for development and tests.
+	// +kubebuilder:validation:Enum=kafka;inprocess
+	// +optional
+	Transport string `json:"transport,omitempty"`
+}
+
+type KafkaSchemaRegistryConfig struct {
+	// Url of the schema registry, payloads on model and pipeline topics are written in its wire format
+	Url string `json:"url"`
+	// Optional view topics to write with an Avro and/or JSON schema: avro, json
+	Views []string `json:"views,omitempty"`
+}
+
+// KafkaClaimCheckConfig stores payloads above the threshold in an object store rather than sending them inline.
+// S3 is used if set, otherwise Path.
+type KafkaClaimCheckConfig struct {
+	// Payload size in bytes above which payloads are stored, defaults to 900KiB
+	// +optional
+	ThresholdBytes int `json:"thresholdBytes,omitempty"`
+	// Directory shared by the model gateway, pipeline gateway and dataflow engine
+	// +optional
+	Path string `json:"path,omitempty"`
+	// +optional
+	S3 *KafkaClaimCheckS3Config `json:"s3,omitempty"`
+}
+
+type KafkaClaimCheckS3Config struct {
+	// Endpoint of an S3 compatible store, defaults to AWS S3
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+	Bucket   string `json:"bucket"`
+	// +optional
+	Region string `json:"region,omitempty"`
 }
 
 type AgentConfiguration struct {
@@ -170,6 +206,15 @@ func (k *KafkaConfig) addDefaults(defaults KafkaConfig) {
 	if k.TopicPrefix == "" {
 		k.TopicPrefix = defaults.TopicPrefix
 	}
+	if k.SchemaRegistry == nil {
+		k.SchemaRegistry = defaults.SchemaRegistry
+	}
+	if k.ClaimCheck == nil {
+		k.ClaimCheck = defaults.ClaimCheck
+	}
+	if k.Transport == "" {
+		k.Transport = defaults.Transport
+	}
 }
 
 func (a *AgentConfiguration) addDefaults(defaults AgentConfiguration) {
@@ -15,7 +15,8 @@ the Change License after the Change Date as each is defined in accordance with t
 package v1alpha1
 
 import (
-	"k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -36,6 +37,116 @@ func (in *AgentConfiguration) DeepCopy() *AgentConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationSpec) DeepCopyInto(out *AuthorizationSpec) {
+	*out = *in
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationSpec.
+func (in *AuthorizationSpec) DeepCopy() *AuthorizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BanditPrometheusReward) DeepCopyInto(out *BanditPrometheusReward) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BanditPrometheusReward.
+func (in *BanditPrometheusReward) DeepCopy() *BanditPrometheusReward {
+	if in == nil {
+		return nil
+	}
+	out := new(BanditPrometheusReward)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BanditSpec) DeepCopyInto(out *BanditSpec) {
+	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(BanditPolicy)
+		**out = **in
+	}
+	if in.ExplorationPercent != nil {
+		in, out := &in.ExplorationPercent, &out.ExplorationPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinWeight != nil {
+		in, out := &in.MinWeight, &out.MinWeight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxWeight != nil {
+		in, out := &in.MaxWeight, &out.MaxWeight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UpdateIntervalSeconds != nil {
+		in, out := &in.UpdateIntervalSeconds, &out.UpdateIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PrometheusReward != nil {
+		in, out := &in.PrometheusReward, &out.PrometheusReward
+		*out = new(BanditPrometheusReward)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BanditSpec.
+func (in *BanditSpec) DeepCopy() *BanditSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BanditSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircuitBreaker) DeepCopyInto(out *CircuitBreaker) {
+	*out = *in
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxPendingRequests != nil {
+		in, out := &in.MaxPendingRequests, &out.MaxPendingRequests
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreaker.
+func (in *CircuitBreaker) DeepCopy() *CircuitBreaker {
+	if in == nil {
+		return nil
+	}
+	out := new(CircuitBreaker)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentDefn) DeepCopyInto(out *ComponentDefn) {
 	*out = *in
@@ -46,7 +157,7 @@ func (in *ComponentDefn) DeepCopyInto(out *ComponentDefn) {
 	}
 	if in.PodSpec != nil {
 		in, out := &in.PodSpec, &out.PodSpec
-		*out = new(v1.PodSpec)
+		*out = new(corev1.PodSpec)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.VolumeClaimTemplates != nil {
@@ -73,12 +184,12 @@ func (in *ContainerOverrideSpec) DeepCopyInto(out *ContainerOverrideSpec) {
 	*out = *in
 	if in.Agent != nil {
 		in, out := &in.Agent, &out.Agent
-		*out = new(v1.Container)
+		*out = new(corev1.Container)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.RClone != nil {
 		in, out := &in.RClone, &out.RClone
-		*out = new(v1.Container)
+		*out = new(corev1.Container)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -135,6 +246,56 @@ func (in *ExperimentCandidate) DeepCopy() *ExperimentCandidate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentHashRange) DeepCopyInto(out *ExperimentHashRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentHashRange.
+func (in *ExperimentHashRange) DeepCopy() *ExperimentHashRange {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentHashRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentHeaderMatch) DeepCopyInto(out *ExperimentHeaderMatch) {
+	*out = *in
+	if in.Exact != nil {
+		in, out := &in.Exact, &out.Exact
+		*out = new(string)
+		**out = **in
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Regex != nil {
+		in, out := &in.Regex, &out.Regex
+		*out = new(string)
+		**out = **in
+	}
+	if in.Hash != nil {
+		in, out := &in.Hash, &out.Hash
+		*out = new(ExperimentHashRange)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentHeaderMatch.
+func (in *ExperimentHeaderMatch) DeepCopy() *ExperimentHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentHeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExperimentList) DeepCopyInto(out *ExperimentList) {
 	*out = *in
@@ -170,6 +331,11 @@ func (in *ExperimentList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExperimentMirror) DeepCopyInto(out *ExperimentMirror) {
 	*out = *in
+	if in.Comparison != nil {
+		in, out := &in.Comparison, &out.Comparison
+		*out = new(ShadowComparison)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentMirror.
@@ -182,6 +348,76 @@ func (in *ExperimentMirror) DeepCopy() *ExperimentMirror {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentPromotion) DeepCopyInto(out *ExperimentPromotion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentPromotion.
+func (in *ExperimentPromotion) DeepCopy() *ExperimentPromotion {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentPromotion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentRule) DeepCopyInto(out *ExperimentRule) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]ExperimentHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentRule.
+func (in *ExperimentRule) DeepCopy() *ExperimentRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentSchedule) DeepCopyInto(out *ExperimentSchedule) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MaxDuration != nil {
+		in, out := &in.MaxDuration, &out.MaxDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Promotion != nil {
+		in, out := &in.Promotion, &out.Promotion
+		*out = new(ExperimentPromotion)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentSchedule.
+func (in *ExperimentSchedule) DeepCopy() *ExperimentSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
 	*out = *in
@@ -198,7 +434,24 @@ func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
 	if in.Mirror != nil {
 		in, out := &in.Mirror, &out.Mirror
 		*out = new(ExperimentMirror)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bandit != nil {
+		in, out := &in.Bandit, &out.Bandit
+		*out = new(BanditSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ExperimentRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(ExperimentSchedule)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -288,6 +541,41 @@ func (in *InferenceArtifactSpec) DeepCopy() *InferenceArtifactSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaClaimCheckConfig) DeepCopyInto(out *KafkaClaimCheckConfig) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(KafkaClaimCheckS3Config)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaClaimCheckConfig.
+func (in *KafkaClaimCheckConfig) DeepCopy() *KafkaClaimCheckConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaClaimCheckConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaClaimCheckS3Config) DeepCopyInto(out *KafkaClaimCheckS3Config) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaClaimCheckS3Config.
+func (in *KafkaClaimCheckS3Config) DeepCopy() *KafkaClaimCheckS3Config {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaClaimCheckS3Config)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KafkaConfig) DeepCopyInto(out *KafkaConfig) {
 	*out = *in
@@ -312,6 +600,16 @@ func (in *KafkaConfig) DeepCopyInto(out *KafkaConfig) {
 			(*out)[key] = val
 		}
 	}
+	if in.SchemaRegistry != nil {
+		in, out := &in.SchemaRegistry, &out.SchemaRegistry
+		*out = new(KafkaSchemaRegistryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClaimCheck != nil {
+		in, out := &in.ClaimCheck, &out.ClaimCheck
+		*out = new(KafkaClaimCheckConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConfig.
@@ -324,6 +622,66 @@ func (in *KafkaConfig) DeepCopy() *KafkaConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchemaRegistryConfig) DeepCopyInto(out *KafkaSchemaRegistryConfig) {
+	*out = *in
+	if in.Views != nil {
+		in, out := &in.Views, &out.Views
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaRegistryConfig.
+func (in *KafkaSchemaRegistryConfig) DeepCopy() *KafkaSchemaRegistryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchemaRegistryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicConfig) DeepCopyInto(out *KafkaTopicConfig) {
+	*out = *in
+	if in.Partitions != nil {
+		in, out := &in.Partitions, &out.Partitions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReplicationFactor != nil {
+		in, out := &in.ReplicationFactor, &out.ReplicationFactor
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetentionMs != nil {
+		in, out := &in.RetentionMs, &out.RetentionMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CleanupPolicy != nil {
+		in, out := &in.CleanupPolicy, &out.CleanupPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.CompressionType != nil {
+		in, out := &in.CompressionType, &out.CompressionType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicConfig.
+func (in *KafkaTopicConfig) DeepCopy() *KafkaTopicConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoggingSpec) DeepCopyInto(out *LoggingSpec) {
 	*out = *in
@@ -438,6 +796,31 @@ func (in *ModelSpec) DeepCopyInto(out *ModelSpec) {
 		*out = make([]ParameterSpec, len(*in))
 		copy(*out, *in)
 	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RateLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopicConfig != nil {
+		in, out := &in.TopicConfig, &out.TopicConfig
+		*out = new(KafkaTopicConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrafficPolicy != nil {
+		in, out := &in.TrafficPolicy, &out.TrafficPolicy
+		*out = new(TrafficPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(AuthorizationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSpec.
@@ -466,6 +849,36 @@ func (in *ModelStatus) DeepCopy() *ModelStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutlierDetection) DeepCopyInto(out *OutlierDetection) {
+	*out = *in
+	if in.IntervalMs != nil {
+		in, out := &in.IntervalMs, &out.IntervalMs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BaseEjectionTimeMs != nil {
+		in, out := &in.BaseEjectionTimeMs, &out.BaseEjectionTimeMs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxEjectionPercent != nil {
+		in, out := &in.MaxEjectionPercent, &out.MaxEjectionPercent
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutlierDetection.
+func (in *OutlierDetection) DeepCopy() *OutlierDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(OutlierDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OverrideSpec) DeepCopyInto(out *OverrideSpec) {
 	*out = *in
@@ -564,12 +977,52 @@ func (in *PipelineBatch) DeepCopyInto(out *PipelineBatch) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineBatch.
-func (in *PipelineBatch) DeepCopy() *PipelineBatch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineBatch.
+func (in *PipelineBatch) DeepCopy() *PipelineBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineCanary) DeepCopyInto(out *PipelineCanary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineCanary.
+func (in *PipelineCanary) DeepCopy() *PipelineCanary {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineCanary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineForEach) DeepCopyInto(out *PipelineForEach) {
+	*out = *in
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.GatherTimeoutMs != nil {
+		in, out := &in.GatherTimeoutMs, &out.GatherTimeoutMs
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineForEach.
+func (in *PipelineForEach) DeepCopy() *PipelineForEach {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineBatch)
+	out := new(PipelineForEach)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -609,6 +1062,13 @@ func (in *PipelineInput) DeepCopyInto(out *PipelineInput) {
 			(*out)[key] = val
 		}
 	}
+	if in.KafkaSources != nil {
+		in, out := &in.KafkaSources, &out.KafkaSources
+		*out = make([]PipelineKafkaTopic, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineInput.
@@ -621,6 +1081,28 @@ func (in *PipelineInput) DeepCopy() *PipelineInput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineKafkaTopic) DeepCopyInto(out *PipelineKafkaTopic) {
+	*out = *in
+	if in.Tensors != nil {
+		in, out := &in.Tensors, &out.Tensors
+		*out = make([]TensorSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineKafkaTopic.
+func (in *PipelineKafkaTopic) DeepCopy() *PipelineKafkaTopic {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineKafkaTopic)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PipelineList) DeepCopyInto(out *PipelineList) {
 	*out = *in
@@ -673,6 +1155,13 @@ func (in *PipelineOutput) DeepCopyInto(out *PipelineOutput) {
 			(*out)[key] = val
 		}
 	}
+	if in.KafkaSinks != nil {
+		in, out := &in.KafkaSinks, &out.KafkaSinks
+		*out = make([]PipelineKafkaTopic, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineOutput.
@@ -685,6 +1174,35 @@ func (in *PipelineOutput) DeepCopy() *PipelineOutput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineSchema) DeepCopyInto(out *PipelineSchema) {
+	*out = *in
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]TensorSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]TensorSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineSchema.
+func (in *PipelineSchema) DeepCopy() *PipelineSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 	*out = *in
@@ -705,6 +1223,31 @@ func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 		*out = new(PipelineOutput)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(PipelineCanary)
+		**out = **in
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(PipelineSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopicConfig != nil {
+		in, out := &in.TopicConfig, &out.TopicConfig
+		*out = new(KafkaTopicConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrafficPolicy != nil {
+		in, out := &in.TrafficPolicy, &out.TrafficPolicy
+		*out = new(TrafficPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(AuthorizationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineSpec.
@@ -773,6 +1316,18 @@ func (in *PipelineStep) DeepCopyInto(out *PipelineStep) {
 		*out = new(PipelineBatch)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Transforms != nil {
+		in, out := &in.Transforms, &out.Transforms
+		*out = make([]PipelineTransform, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ForEach != nil {
+		in, out := &in.ForEach, &out.ForEach
+		*out = new(PipelineForEach)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineStep.
@@ -785,26 +1340,61 @@ func (in *PipelineStep) DeepCopy() *PipelineStep {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineTransform) DeepCopyInto(out *PipelineTransform) {
+	*out = *in
+	if in.Tensors != nil {
+		in, out := &in.Tensors, &out.Tensors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Shape != nil {
+		in, out := &in.Shape, &out.Shape
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineTransform.
+func (in *PipelineTransform) DeepCopy() *PipelineTransform {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineTransform)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSpec) DeepCopyInto(out *PodSpec) {
 	*out = *in
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
-		*out = make([]v1.Volume, len(*in))
+		*out = make([]corev1.Volume, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.InitContainers != nil {
 		in, out := &in.InitContainers, &out.InitContainers
-		*out = make([]v1.Container, len(*in))
+		*out = make([]corev1.Container, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.Containers != nil {
 		in, out := &in.Containers, &out.Containers
-		*out = make([]v1.Container, len(*in))
+		*out = make([]corev1.Container, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -838,29 +1428,29 @@ func (in *PodSpec) DeepCopyInto(out *PodSpec) {
 	}
 	if in.SecurityContext != nil {
 		in, out := &in.SecurityContext, &out.SecurityContext
-		*out = new(v1.PodSecurityContext)
+		*out = new(corev1.PodSecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ImagePullSecrets != nil {
 		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
-		*out = make([]v1.LocalObjectReference, len(*in))
+		*out = make([]corev1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+		*out = new(corev1.Affinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.HostAliases != nil {
 		in, out := &in.HostAliases, &out.HostAliases
-		*out = make([]v1.HostAlias, len(*in))
+		*out = make([]corev1.HostAlias, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -872,12 +1462,12 @@ func (in *PodSpec) DeepCopyInto(out *PodSpec) {
 	}
 	if in.DNSConfig != nil {
 		in, out := &in.DNSConfig, &out.DNSConfig
-		*out = new(v1.PodDNSConfig)
+		*out = new(corev1.PodDNSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ReadinessGates != nil {
 		in, out := &in.ReadinessGates, &out.ReadinessGates
-		*out = make([]v1.PodReadinessGate, len(*in))
+		*out = make([]corev1.PodReadinessGate, len(*in))
 		copy(*out, *in)
 	}
 	if in.RuntimeClassName != nil {
@@ -892,19 +1482,19 @@ func (in *PodSpec) DeepCopyInto(out *PodSpec) {
 	}
 	if in.PreemptionPolicy != nil {
 		in, out := &in.PreemptionPolicy, &out.PreemptionPolicy
-		*out = new(v1.PreemptionPolicy)
+		*out = new(corev1.PreemptionPolicy)
 		**out = **in
 	}
 	if in.Overhead != nil {
 		in, out := &in.Overhead, &out.Overhead
-		*out = make(v1.ResourceList, len(*in))
+		*out = make(corev1.ResourceList, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
 	if in.TopologySpreadConstraints != nil {
 		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
-		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -926,6 +1516,46 @@ func (in *PodSpec) DeepCopy() *PodSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitQuota) DeepCopyInto(out *RateLimitQuota) {
+	*out = *in
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitQuota.
+func (in *RateLimitQuota) DeepCopy() *RateLimitQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitSpec) DeepCopyInto(out *RateLimitSpec) {
+	*out = *in
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitSpec.
+func (in *RateLimitSpec) DeepCopy() *RateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RcloneConfiguration) DeepCopyInto(out *RcloneConfiguration) {
 	*out = *in
@@ -951,6 +1581,143 @@ func (in *RcloneConfiguration) DeepCopy() *RcloneConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	if in.NumRetries != nil {
+		in, out := &in.NumRetries, &out.NumRetries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PerTryTimeoutMs != nil {
+		in, out := &in.PerTryTimeoutMs, &out.PerTryTimeoutMs
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutAnalysis) DeepCopyInto(out *RolloutAnalysis) {
+	*out = *in
+	if in.MaxErrorPercent != nil {
+		in, out := &in.MaxErrorPercent, &out.MaxErrorPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxLatencyMs != nil {
+		in, out := &in.MaxLatencyMs, &out.MaxLatencyMs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinRequests != nil {
+		in, out := &in.MinRequests, &out.MinRequests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureAction != nil {
+		in, out := &in.FailureAction, &out.FailureAction
+		*out = new(RolloutFailureAction)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutAnalysis.
+func (in *RolloutAnalysis) DeepCopy() *RolloutAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutSpec) DeepCopyInto(out *RolloutSpec) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]RolloutStep, len(*in))
+		copy(*out, *in)
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(RolloutAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutSpec.
+func (in *RolloutSpec) DeepCopy() *RolloutSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStep) DeepCopyInto(out *RolloutStep) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStep.
+func (in *RolloutStep) DeepCopy() *RolloutStep {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteRateLimit) DeepCopyInto(out *RouteRateLimit) {
+	*out = *in
+	if in.Unit != nil {
+		in, out := &in.Unit, &out.Unit
+		*out = new(RateLimitUnit)
+		**out = **in
+	}
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = make([]RateLimitQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteRateLimit.
+func (in *RouteRateLimit) DeepCopy() *RouteRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalingSpec) DeepCopyInto(out *ScalingSpec) {
 	*out = *in
@@ -1441,6 +2208,51 @@ func (in *ServiceConfig) DeepCopy() *ServiceConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShadowComparison) DeepCopyInto(out *ShadowComparison) {
+	*out = *in
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tolerance != nil {
+		in, out := &in.Tolerance, &out.Tolerance
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShadowComparison.
+func (in *ShadowComparison) DeepCopy() *ShadowComparison {
+	if in == nil {
+		return nil
+	}
+	out := new(ShadowComparison)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TensorSchema) DeepCopyInto(out *TensorSchema) {
+	*out = *in
+	if in.Shape != nil {
+		in, out := &in.Shape, &out.Shape
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TensorSchema.
+func (in *TensorSchema) DeepCopy() *TensorSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(TensorSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracingConfig) DeepCopyInto(out *TracingConfig) {
 	*out = *in
@@ -1455,3 +2267,43 @@ func (in *TracingConfig) DeepCopy() *TracingConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficPolicy) DeepCopyInto(out *TrafficPolicy) {
+	*out = *in
+	if in.TimeoutMs != nil {
+		in, out := &in.TimeoutMs, &out.TimeoutMs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(CircuitBreaker)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutlierDetection != nil {
+		in, out := &in.OutlierDetection, &out.OutlierDetection
+		*out = new(OutlierDetection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RouteRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficPolicy.
+func (in *TrafficPolicy) DeepCopy() *TrafficPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package cli
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/utils/env"
+
+	"github.com/seldonio/seldon-core/operator/v2/pkg/cli"
+)
+
+const (
+	flagFeedbackCandidate = "candidate"
+	flagFeedbackRequestId = "request-id"
+	flagFeedbackReward    = "reward"
+)
+
+func createExperimentFeedback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feedback <experimentName>",
+		Short: "send the reward of a request to a bandit experiment",
+		Long:  `send the reward in [0,1] of a request to a bandit experiment, which uses the rewards of each candidate to update their weights`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+
+			schedulerHostIsSet := flags.Changed(flagSchedulerHost)
+			schedulerHost, err := flags.GetString(flagSchedulerHost)
+			if err != nil {
+				return err
+			}
+			authority, err := flags.GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+			verbose, err := flags.GetBool(flagVerbose)
+			if err != nil {
+				return err
+			}
+			candidate, err := flags.GetString(flagFeedbackCandidate)
+			if err != nil {
+				return err
+			}
+			requestId, err := flags.GetString(flagFeedbackRequestId)
+			if err != nil {
+				return err
+			}
+			reward, err := flags.GetFloat64(flagFeedbackReward)
+			if err != nil {
+				return err
+			}
+
+			schedulerClient, err := cli.NewSchedulerClient(schedulerHost, schedulerHostIsSet, authority, verbose)
+			if err != nil {
+				return err
+			}
+			res, err := schedulerClient.ExperimentFeedback(args[0], candidate, requestId, reward)
+			if err == nil && verbose {
+				cli.PrintProto(res)
+			}
+			return err
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolP(flagVerbose, "v", false, "verbose output")
+	flags.String(flagSchedulerHost, env.GetString(envScheduler, defaultSchedulerHost), helpSchedulerHost)
+	flags.String(flagAuthority, "", helpAuthority)
+	flags.String(flagFeedbackCandidate, "", "candidate the request was sent to, or the x-seldon-route header of the response")
+	flags.String(flagFeedbackRequestId, "", "id of the request, feedback is counted once per request")
+	flags.Float64(flagFeedbackReward, 0, "reward of the request in [0,1]")
+	for _, flag := range []string{flagFeedbackCandidate, flagFeedbackRequestId, flagFeedbackReward} {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			os.Exit(-1)
+		}
+	}
+
+	return cmd
+}
@@ -10,6 +10,7 @@ the Change License after the Change Date as each is defined in accordance with t
 package cli
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -19,7 +20,10 @@ import (
 )
 
 const (
-	flagExperimentWait = "wait"
+	flagExperimentWait            = "wait"
+	flagExperimentStats           = "stats"
+	flagExperimentStatsWindow     = "window"
+	flagExperimentStatsConfidence = "confidence-level"
 )
 
 func createExperimentStatus() *cobra.Command {
@@ -52,6 +56,10 @@ func createExperimentStatus() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			stats, err := flags.GetBool(flagExperimentStats)
+			if err != nil {
+				return err
+			}
 			experimentName := args[0]
 
 			schedulerClient, err := cli.NewSchedulerClient(schedulerHost, schedulerHostIsSet, authority, verbose)
@@ -59,6 +67,34 @@ func createExperimentStatus() *cobra.Command {
 				return err
 			}
 
+			if stats {
+				var windowSeconds *uint32
+				if flags.Changed(flagExperimentStatsWindow) {
+					window, err := flags.GetDuration(flagExperimentStatsWindow)
+					if err != nil {
+						return err
+					}
+					if window < time.Second {
+						return fmt.Errorf("statistics window %s must be at least a second", window)
+					}
+					seconds := uint32(window.Seconds())
+					windowSeconds = &seconds
+				}
+				var confidenceLevel *float64
+				if flags.Changed(flagExperimentStatsConfidence) {
+					level, err := flags.GetFloat64(flagExperimentStatsConfidence)
+					if err != nil {
+						return err
+					}
+					confidenceLevel = &level
+				}
+				res, err := schedulerClient.ExperimentStats(experimentName, windowSeconds, confidenceLevel)
+				if err == nil {
+					cli.PrintProto(res)
+				}
+				return err
+			}
+
 			res, err := schedulerClient.ExperimentStatus(experimentName, wait, time.Duration(timeout*int64(time.Second)))
 			if err == nil {
 				cli.PrintProto(res)
@@ -73,6 +109,9 @@ func createExperimentStatus() *cobra.Command {
 	flags.String(flagSchedulerHost, env.GetString(envScheduler, defaultSchedulerHost), helpSchedulerHost)
 	flags.String(flagAuthority, "", helpAuthority)
 	flags.BoolP(flagExperimentWait, "w", false, "wait for experiment to be active")
+	flags.Bool(flagExperimentStats, false, "show request, error, latency and feedback statistics of the candidates with their comparison with the baseline")
+	flags.Duration(flagExperimentStatsWindow, 0, "window of the statistics, defaults to since a scheduled experiment started or else the last hour")
+	flags.Float64(flagExperimentStatsConfidence, 0.95, "confidence level of the intervals of the statistics")
 
 	return cmd
 }
@@ -21,6 +21,7 @@ const (
 	flagInferenceSecs       = "seconds"
 	flagInferenceMode       = "inference-mode"
 	flagKafkaBroker         = "kafka-broker"
+	flagPriority            = "priority"
 	flagSchedulerHost       = "scheduler-host"
 	flagShowHeaders         = "show-headers"
 	flagShowRequest         = "show-request"
@@ -54,6 +55,7 @@ const (
 	helpInferenceIterations = "how many times to run inference"
 	helpInferenceSecs       = "number of secs to run inference"
 	helpInferenceMode       = "inference mode (rest or grpc)"
+	helpPriority            = "request priority (high or low), low priority requests through Kafka are processed after high priority requests"
 	helpSchedulerHost       = "seldon scheduler host"
 	helpShowHeaders         = "show request and response headers"
 	helpStickySession       = "use sticky session from last inference (only works with experiments)"
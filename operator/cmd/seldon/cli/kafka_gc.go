@@ -0,0 +1,80 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package cli
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/utils/env"
+
+	"github.com/seldonio/seldon-core/operator/v2/pkg/cli"
+)
+
+const (
+	flagDryRun      = "dry-run"
+	helpDryRun      = "list orphaned topics and consumer groups without deleting them"
+	flagGracePeriod = "grace-period"
+	helpGracePeriod = "only delete resources orphaned for longer than this, defaults to the scheduler grace period"
+)
+
+func createKafkaGC() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "delete orphaned kafka topics and consumer groups",
+		Long:  `delete the kafka topics and consumer groups of models and pipelines deleted for longer than the grace period`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+
+			schedulerHostIsSet := flags.Changed(flagSchedulerHost)
+			schedulerHost, err := flags.GetString(flagSchedulerHost)
+			if err != nil {
+				return err
+			}
+			authority, err := flags.GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+			verbose, err := flags.GetBool(flagVerbose)
+			if err != nil {
+				return err
+			}
+			dryRun, err := flags.GetBool(flagDryRun)
+			if err != nil {
+				return err
+			}
+			var gracePeriod *time.Duration
+			if flags.Changed(flagGracePeriod) {
+				gp, err := flags.GetDuration(flagGracePeriod)
+				if err != nil {
+					return err
+				}
+				gracePeriod = &gp
+			}
+
+			schedulerClient, err := cli.NewSchedulerClient(schedulerHost, schedulerHostIsSet, authority, verbose)
+			if err != nil {
+				return err
+			}
+
+			return schedulerClient.KafkaGarbageCollect(dryRun, gracePeriod)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolP(flagVerbose, "v", false, "verbose output")
+	flags.String(flagSchedulerHost, env.GetString(envScheduler, defaultSchedulerHost), helpSchedulerHost)
+	flags.String(flagAuthority, "", helpAuthority)
+	flags.Bool(flagDryRun, false, helpDryRun)
+	flags.Duration(flagGracePeriod, 0, helpGracePeriod)
+
+	return cmd
+}
@@ -68,7 +68,7 @@ func createModelStatus() *cobra.Command {
 	flags.BoolP(flagVerbose, "v", false, "verbose output")
 	flags.String(flagSchedulerHost, env.GetString(envScheduler, defaultSchedulerHost), helpSchedulerHost)
 	flags.String(flagAuthority, "", helpAuthority)
-	flags.StringP(flagWaitCondition, "w", "", "model wait condition")
+	flags.StringP(flagWaitCondition, "w", "", "model or rollout state to wait for")
 
 	return cmd
 }
@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/utils/env"
+
+	"github.com/seldonio/seldon-core/operator/v2/pkg/cli"
+)
+
+const (
+	flagCanaryTraffic     = "traffic"
+	helpCanaryTraffic     = "percentage of traffic sent to the canary version (1-99)"
+	defaultCanaryTraffic  = 10
+	canaryPromoteTraffic  = 100
+	canaryRollbackTraffic = 0
+)
+
+func createPipelineCanary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "canary <pipelineName>",
+		Short: "set the traffic sent to a canary pipeline version",
+		Long:  `set the traffic sent to the canary version of a pipeline, the rest stays on the stable version`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			traffic, err := cmd.Flags().GetUint32(flagCanaryTraffic)
+			if err != nil {
+				return err
+			}
+			return updatePipelineCanary(cmd, args[0], traffic)
+		},
+	}
+
+	addPipelineCanaryFlags(cmd)
+	cmd.Flags().Uint32(flagCanaryTraffic, defaultCanaryTraffic, helpCanaryTraffic)
+
+	return cmd
+}
+
+func createPipelinePromote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "promote <pipelineName>",
+		Short: "promote a canary pipeline version",
+		Long:  `send all traffic to the canary version of a pipeline and terminate the stable version`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updatePipelineCanary(cmd, args[0], canaryPromoteTraffic)
+		},
+	}
+
+	addPipelineCanaryFlags(cmd)
+
+	return cmd
+}
+
+func createPipelineRollback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback <pipelineName>",
+		Short: "roll back a canary pipeline version",
+		Long:  `send all traffic back to the stable version of a pipeline and terminate the canary version`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updatePipelineCanary(cmd, args[0], canaryRollbackTraffic)
+		},
+	}
+
+	addPipelineCanaryFlags(cmd)
+
+	return cmd
+}
+
+func addPipelineCanaryFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.BoolP(flagVerbose, "v", false, "verbose output")
+	flags.String(flagSchedulerHost, env.GetString(envScheduler, defaultSchedulerHost), helpSchedulerHost)
+	flags.String(flagAuthority, "", helpAuthority)
+}
+
+func updatePipelineCanary(cmd *cobra.Command, pipelineName string, traffic uint32) error {
+	flags := cmd.Flags()
+
+	schedulerHostIsSet := flags.Changed(flagSchedulerHost)
+	schedulerHost, err := flags.GetString(flagSchedulerHost)
+	if err != nil {
+		return err
+	}
+	authority, err := flags.GetString(flagAuthority)
+	if err != nil {
+		return err
+	}
+	verbose, err := flags.GetBool(flagVerbose)
+	if err != nil {
+		return err
+	}
+
+	schedulerClient, err := cli.NewSchedulerClient(schedulerHost, schedulerHostIsSet, authority, verbose)
+	if err != nil {
+		return err
+	}
+
+	res, err := schedulerClient.UpdatePipelineCanary(pipelineName, traffic)
+	if err == nil && verbose {
+		cli.PrintProto(res)
+	}
+	return err
+}
@@ -72,6 +72,17 @@ func createPipelineInfer() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			priority, err := flags.GetString(flagPriority)
+			if err != nil {
+				return err
+			}
+			if priority != "" {
+				priorityHeader, err := cli.GetPriorityHeader(priority)
+				if err != nil {
+					return err
+				}
+				headers = append(headers, priorityHeader)
+			}
 			pipelineName := args[0]
 
 			// Get inference data
@@ -124,6 +135,7 @@ func createPipelineInfer() *cobra.Command {
 	flags.Bool(flagShowHeaders, false, helpShowHeaders)
 	flags.StringArray(flagAddHeader, []string{}, helpAddHeader)
 	flags.String(flagAuthority, "", helpAuthority)
+	flags.String(flagPriority, "", helpPriority)
 
 	return cmd
 }
@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/utils/env"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/operator/v2/pkg/cli"
+)
+
+const (
+	flagSourcePipeline = "source-pipeline"
+	flagFromOffset     = "from-offset"
+	flagToOffset       = "to-offset"
+	flagFromTime       = "from-time"
+	flagToTime         = "to-time"
+	flagSinkTopic      = "sink-topic"
+	flagPipelineVer    = "version"
+)
+
+func createPipelineReplay() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay <pipelineName>",
+		Short: "replay historical requests through a pipeline",
+		Long:  `replay the requests of a pipeline inputs topic offset or time range, or a JSONL file of V2 inference requests, through a pipeline. The pipeline outputs of replayed requests are written to a separate sink topic. Topic ranges are replayed by the scheduler, files are sent to Kafka directly.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+
+			schedulerHostIsSet := flags.Changed(flagSchedulerHost)
+			schedulerHost, err := flags.GetString(flagSchedulerHost)
+			if err != nil {
+				return err
+			}
+			kafkaBrokerIsSet := flags.Changed(flagKafkaBroker)
+			kafkaBroker, err := flags.GetString(flagKafkaBroker)
+			if err != nil {
+				return err
+			}
+			sourcePipeline, err := flags.GetString(flagSourcePipeline)
+			if err != nil {
+				return err
+			}
+			sinkTopic, err := flags.GetString(flagSinkTopic)
+			if err != nil {
+				return err
+			}
+			version, err := flags.GetUint32(flagPipelineVer)
+			if err != nil {
+				return err
+			}
+			filename, err := flags.GetString(flagFile)
+			if err != nil {
+				return err
+			}
+			namespace, err := flags.GetString(flagNamespace)
+			if err != nil {
+				return err
+			}
+			replayReq, err := getReplayRange(flags)
+			if err != nil {
+				return err
+			}
+			if filename != "" {
+				if sourcePipeline != "" || hasReplayRange(replayReq) {
+					return fmt.Errorf("%s can not be used with a source pipeline or offset and time ranges", flagFile)
+				}
+				kc, err := cli.NewKafkaClient(kafkaBroker, kafkaBrokerIsSet, schedulerHost, schedulerHostIsSet)
+				if err != nil {
+					return err
+				}
+				return kc.ReplayPipeline(&cli.ReplayOptions{
+					Pipeline:  args[0],
+					Version:   version,
+					SinkTopic: sinkTopic,
+					Filename:  filename,
+					Namespace: namespace,
+				})
+			}
+
+			authority, err := flags.GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+			verbose, err := flags.GetBool(flagVerbose)
+			if err != nil {
+				return err
+			}
+			schedulerClient, err := cli.NewSchedulerClient(schedulerHost, schedulerHostIsSet, authority, verbose)
+			if err != nil {
+				return err
+			}
+			replayReq.Name = args[0]
+			replayReq.Version = version
+			if sourcePipeline != "" {
+				replayReq.SourcePipeline = &sourcePipeline
+			}
+			if sinkTopic != "" {
+				replayReq.SinkTopic = &sinkTopic
+			}
+			return schedulerClient.ReplayPipeline(replayReq)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.String(flagKafkaBroker, env.GetString(envKafka, defaultKafkaHost), "kafka broker")
+	flags.String(flagSchedulerHost, env.GetString(envScheduler, defaultSchedulerHost), helpSchedulerHost)
+	flags.String(flagAuthority, "", helpAuthority)
+	flags.BoolP(flagVerbose, "v", false, "verbose output")
+	flags.String(flagSourcePipeline, "", "pipeline whose inputs are replayed, if not specified will be the replayed pipeline")
+	flags.Int64(flagFromOffset, 0, "first offset to replay in each partition, if not specified will be the start of the topic")
+	flags.Int64(flagToOffset, 0, "last offset to replay in each partition, if not specified will be the current end of the topic")
+	flags.String(flagFromTime, "", "replay messages at or after this time (RFC3339)")
+	flags.String(flagToTime, "", "replay messages before this time (RFC3339)")
+	flags.String(flagSinkTopic, "", "topic for the pipeline outputs of replayed requests, if not specified will be the pipeline replay topic")
+	flags.Uint32(flagPipelineVer, 0, "only run the replayed requests through this pipeline version, e.g. a canary version")
+	flags.StringP(flagFile, "f", "", "JSONL file of V2 inference requests to replay instead of the source pipeline inputs")
+	flags.String(flagNamespace, "", fmt.Sprintf("Kubernetes namespace. Default %s", cli.DefaultNamespace))
+	return cmd
+}
+
+func getReplayRange(flags *pflag.FlagSet) (*scheduler.ReplayPipelineRequest, error) {
+	req := &scheduler.ReplayPipelineRequest{}
+	for flag, offset := range map[string]**int64{flagFromOffset: &req.FromOffset, flagToOffset: &req.ToOffset} {
+		if flags.Changed(flag) {
+			val, err := flags.GetInt64(flag)
+			if err != nil {
+				return nil, err
+			}
+			*offset = &val
+		}
+	}
+	for flag, t := range map[string]**timestamppb.Timestamp{flagFromTime: &req.FromTime, flagToTime: &req.ToTime} {
+		if flags.Changed(flag) {
+			val, err := flags.GetString(flag)
+			if err != nil {
+				return nil, err
+			}
+			parsed, err := time.Parse(time.RFC3339, val)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", flag, err)
+			}
+			*t = timestamppb.New(parsed)
+		}
+	}
+	return req, nil
+}
+
+func hasReplayRange(req *scheduler.ReplayPipelineRequest) bool {
+	return req.FromOffset != nil || req.ToOffset != nil || req.FromTime != nil || req.ToTime != nil
+}
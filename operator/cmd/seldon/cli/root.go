@@ -66,6 +66,16 @@ func GetCmd() *cobra.Command {
 		},
 	}
 
+	cmdKafka := &cobra.Command{
+		Use:   "kafka <subcomand>",
+		Short: "manage kafka resources",
+		Long:  `Manage the Kafka topics and consumer groups used by Seldon`,
+		Args:  cobra.MinimumNArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return fmt.Errorf("kafka subcommand required")
+		},
+	}
+
 	// Model commands
 	cmdModelLoad := createModelLoad()
 	cmdModelUnload := createModelUnload()
@@ -83,6 +93,7 @@ func GetCmd() *cobra.Command {
 	cmdExperimentStop := createExperimentStop()
 	cmdExperimentStatus := createExperimentStatus()
 	cmdExperimentList := createExperimentList()
+	cmdExperimentFeedback := createExperimentFeedback()
 
 	// pipeline commands
 	cmdPipelineLoad := createPipelineLoad()
@@ -91,6 +102,10 @@ func GetCmd() *cobra.Command {
 	cmdPipelineInfer := createPipelineInfer()
 	cmdPipelineList := createPipelineList()
 	cmdPipelineInspect := createPipelineInspect()
+	cmdPipelineCanary := createPipelineCanary()
+	cmdPipelinePromote := createPipelinePromote()
+	cmdPipelineRollback := createPipelineRollback()
+	cmdPipelineReplay := createPipelineReplay()
 
 	// config commands
 	cmdConfigActivate := createConfigActivate()
@@ -99,6 +114,9 @@ func GetCmd() *cobra.Command {
 	cmdConfigRemove := createConfigRemove()
 	cmdConfigList := createConfigList()
 
+	// kafka commands
+	cmdKafkaGC := createKafkaGC()
+
 	// Generic commands
 	cmdLoad := createLoad()
 	cmdUnload := createUnload()
@@ -108,12 +126,13 @@ func GetCmd() *cobra.Command {
 
 	rootCmd.DisableAutoGenTag = true
 
-	rootCmd.AddCommand(cmdModel, cmdServer, cmdExperiment, cmdPipeline, cmdConfig, cmdLoad, cmdUnload, cmdStatus)
+	rootCmd.AddCommand(cmdModel, cmdServer, cmdExperiment, cmdPipeline, cmdConfig, cmdKafka, cmdLoad, cmdUnload, cmdStatus)
 	cmdModel.AddCommand(cmdModelLoad, cmdModelUnload, cmdModelStatus, cmdModelInfer, cmdModelMeta, cmdModelList)
 	cmdServer.AddCommand(cmdServerStatus, cmdServerList)
-	cmdExperiment.AddCommand(cmdExperimentStart, cmdExperimentStop, cmdExperimentStatus, cmdExperimentList)
-	cmdPipeline.AddCommand(cmdPipelineLoad, cmdPipelineUnload, cmdPipelineStatus, cmdPipelineInfer, cmdPipelineList, cmdPipelineInspect)
+	cmdExperiment.AddCommand(cmdExperimentStart, cmdExperimentStop, cmdExperimentStatus, cmdExperimentList, cmdExperimentFeedback)
+	cmdPipeline.AddCommand(cmdPipelineLoad, cmdPipelineUnload, cmdPipelineStatus, cmdPipelineInfer, cmdPipelineList, cmdPipelineInspect, cmdPipelineCanary, cmdPipelinePromote, cmdPipelineRollback, cmdPipelineReplay)
 	cmdConfig.AddCommand(cmdConfigActivate, cmdConfigAdd, cmdConfigDeactivate, cmdConfigList, cmdConfigRemove)
+	cmdKafka.AddCommand(cmdKafkaGC)
 
 	return rootCmd
 }
@@ -40,7 +40,10 @@ const (
 	SeldonModelHeader    = "seldon-model"
 	SeldonRouteHeader    = "x-seldon-route"
 	SeldonPipelineHeader = "pipeline"
+	SeldonPriorityHeader = "x-seldon-priority"
 	HeaderSeparator      = "="
+	PriorityHigh         = "high"
+	PriorityLow          = "low"
 )
 
 const (
@@ -765,6 +768,16 @@ func (ic *InferenceClient) inferGrpc(
 	return resJson, nil
 }
 
+// GetPriorityHeader returns the header selecting the priority lane of a pipeline request
+func GetPriorityHeader(priority string) (string, error) {
+	switch priority {
+	case PriorityHigh, PriorityLow:
+		return SeldonPriorityHeader + HeaderSeparator + priority, nil
+	default:
+		return "", fmt.Errorf("invalid priority %s, must be %s or %s", priority, PriorityHigh, PriorityLow)
+	}
+}
+
 func validateHeaders(headers []string) (map[string]string, error) {
 	hs := make(map[string]string, len(headers))
 
@@ -11,6 +11,7 @@ package cli
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"math/rand"
@@ -33,10 +34,14 @@ const (
 	ModelSpecifier           = "model"
 	kafkaTimeoutSeconds      = 2
 	DefaultNamespace         = "default"
+	// Confluent schema registry wire format: magic byte followed by a 4 byte schema id
+	wireMagicByte    = 0
+	wireHeaderLength = 5
 )
 
 type KafkaClient struct {
 	consumer        *kafka.Consumer
+	producerConfig  kafka.ConfigMap
 	schedulerClient *SchedulerClient
 	namespace       string
 	topicPrefix     string
@@ -116,6 +121,13 @@ func NewKafkaClient(kafkaBroker string, kafkaBrokerIsSet bool, schedulerHost str
 	if err != nil {
 		return nil, err
 	}
+	// Producer shares the broker and security settings of the consumer
+	producerConfig := kafka.ConfigMap{}
+	for k, v := range consumerConfig {
+		if k != "group.id" && k != "auto.offset.reset" {
+			producerConfig[k] = v
+		}
+	}
 
 	scheduler, err := NewSchedulerClient(schedulerHost, schedulerHostIsSet, "", false)
 	if err != nil {
@@ -123,6 +135,7 @@ func NewKafkaClient(kafkaBroker string, kafkaBrokerIsSet bool, schedulerHost str
 	}
 	kc := &KafkaClient{
 		consumer:        consumer,
+		producerConfig:  producerConfig,
 		schedulerClient: scheduler,
 		namespace:       namespace,
 		topicPrefix:     topicPrefix,
@@ -170,7 +183,10 @@ func createPipelineInspectTopics(pipelineSpec string, response *scheduler.Pipeli
 	case 1: //Just pipeline - show all steps and pipeline itself
 		var topics []string
 		for _, step := range response.Versions[len(response.Versions)-1].Pipeline.Steps {
-			topics = append(topics, fmt.Sprintf("%s.%s.%s.%s.%s", topicPrefix, namespace, ModelSpecifier, step.Name, InputsSpecifier))
+			// transform steps are run by the dataflow engine so only have an outputs topic
+			if len(step.Transforms) == 0 {
+				topics = append(topics, fmt.Sprintf("%s.%s.%s.%s.%s", topicPrefix, namespace, ModelSpecifier, step.Name, InputsSpecifier))
+			}
 			topics = append(topics, fmt.Sprintf("%s.%s.%s.%s.%s", topicPrefix, namespace, ModelSpecifier, step.Name, OutputsSpecifier))
 		}
 		topics = append(topics, fmt.Sprintf("%s.%s.%s.%s.%s", topicPrefix, namespace, PipelineSpecifier, parts[0], InputsSpecifier))
@@ -390,6 +406,28 @@ func protoTojson(msg proto.Message) (json.RawMessage, error) {
 	return b, nil
 }
 
+// unwrapWireFormat strips the schema registry header and message indexes from a payload written in the
+// Confluent wire format, other payloads are returned unchanged
+func unwrapWireFormat(data []byte) []byte {
+	if len(data) <= wireHeaderLength || data[0] != wireMagicByte {
+		return data
+	}
+	rest := data[wireHeaderLength:]
+	count, n := binary.Varint(rest)
+	if n <= 0 || count < 0 {
+		return data
+	}
+	rest = rest[n:]
+	for i := int64(0); i < count; i++ {
+		_, n = binary.Varint(rest)
+		if n <= 0 {
+			return data
+		}
+		rest = rest[n:]
+	}
+	return rest
+}
+
 func clearTensorContents(c *v2_dataplane.InferTensorContents) {
 	c.IntContents = nil
 	c.Uint64Contents = nil
@@ -403,7 +441,7 @@ func clearTensorContents(c *v2_dataplane.InferTensorContents) {
 
 func addInspectKafkaOutputMsg(e *kafka.Message, tensor string, kitm *KafkaInspectTopicMessage, truncateData bool) error {
 	res := &v2_dataplane.ModelInferResponse{}
-	err := proto.Unmarshal(e.Value, res)
+	err := proto.Unmarshal(unwrapWireFormat(e.Value), res)
 	if err != nil {
 		kitm.Value = e.Value
 		return nil
@@ -439,7 +477,7 @@ func addInspectKafkaOutputMsg(e *kafka.Message, tensor string, kitm *KafkaInspec
 
 func addInspectKafkaInputMsg(e *kafka.Message, tensor string, kitm *KafkaInspectTopicMessage, truncateData bool) error {
 	req := &v2_dataplane.ModelInferRequest{}
-	err := proto.Unmarshal(e.Value, req)
+	err := proto.Unmarshal(unwrapWireFormat(e.Value), req)
 	if err != nil {
 		kitm.Value = json.RawMessage(e.Value)
 		return nil
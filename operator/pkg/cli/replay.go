@@ -0,0 +1,179 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+)
+
+const (
+	ReplaySpecifier             = "replay"
+	SeldonPipelineVersionHeader = "x-seldon-pipeline-version"
+	SeldonReplaySinkHeader      = "x-seldon-replay-sink"
+	replayFlushTimeoutMs        = 30000
+	replayMaxLineBytes          = 100 * 1024 * 1024
+)
+
+// ReplayOptions replays a file of requests. Replays of a pipeline inputs topic are run by the scheduler.
+type ReplayOptions struct {
+	// Pipeline to run the replayed requests through
+	Pipeline string
+	// Only run the requests through this version of the pipeline, e.g. a canary version. 0 for any running version.
+	Version uint32
+	// Topic the pipeline outputs are written to, defaults to a replay topic for the pipeline
+	SinkTopic string
+	// JSONL file of V2 inference requests in protobuf JSON format
+	Filename  string
+	Namespace string
+}
+
+func (kc *KafkaClient) getPipelineTopic(namespace string, pipeline string, specifier string) string {
+	return fmt.Sprintf("%s.%s.%s.%s.%s", kc.topicPrefix, namespace, PipelineSpecifier, pipeline, specifier)
+}
+
+// ReplayPipeline re-sends a file of requests to a pipeline. The pipeline outputs of replayed requests are
+// written to a separate sink topic by the dataflow engine so consumers of the pipeline outputs are not affected.
+func (kc *KafkaClient) ReplayPipeline(opts *ReplayOptions) error {
+	namespace := opts.Namespace
+	if namespace == "" {
+		namespace = kc.namespace
+	}
+	sinkTopic := opts.SinkTopic
+	if sinkTopic == "" {
+		sinkTopic = kc.getPipelineTopic(namespace, opts.Pipeline, ReplaySpecifier)
+	}
+	if _, err := kc.getPipelineStatus(opts.Pipeline); err != nil {
+		return err
+	}
+
+	producer, err := kafka.NewProducer(&kc.producerConfig)
+	if err != nil {
+		return err
+	}
+	defer producer.Close()
+	deliveryErrs := make(chan error, 1)
+	go handleReplayDeliveries(producer, deliveryErrs)
+
+	headers := []kafka.Header{
+		{Key: PipelineSpecifier, Value: []byte(opts.Pipeline)},
+		{Key: SeldonReplaySinkHeader, Value: []byte(sinkTopic)},
+	}
+	if opts.Version > 0 {
+		headers = append(headers, kafka.Header{Key: SeldonPipelineVersionHeader, Value: []byte(strconv.FormatUint(uint64(opts.Version), 10))})
+	}
+	targetTopic := kc.getPipelineTopic(namespace, opts.Pipeline, InputsSpecifier)
+
+	if err := createReplaySinkTopic(producer, sinkTopic, 1); err != nil {
+		return err
+	}
+	count, err := replayFile(producer, opts.Filename, targetTopic, headers)
+	if err != nil {
+		return err
+	}
+
+	if remaining := producer.Flush(replayFlushTimeoutMs); remaining > 0 {
+		return fmt.Errorf("timed out waiting for %d replayed requests to be delivered", remaining)
+	}
+	select {
+	case err := <-deliveryErrs:
+		return err
+	default:
+	}
+	fmt.Printf("replayed %d requests to pipeline %s, outputs will be written to topic %s\n", count, opts.Pipeline, sinkTopic)
+	return nil
+}
+
+func handleReplayDeliveries(producer *kafka.Producer, errs chan<- error) {
+	for e := range producer.Events() {
+		if m, ok := e.(*kafka.Message); ok && m.TopicPartition.Error != nil {
+			select {
+			case errs <- m.TopicPartition.Error:
+			default:
+			}
+		}
+	}
+}
+
+func createReplaySinkTopic(producer *kafka.Producer, topic string, partitions int) error {
+	admin, err := kafka.NewAdminClientFromProducer(producer)
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+	results, err := admin.CreateTopics(
+		context.Background(),
+		[]kafka.TopicSpecification{{Topic: topic, NumPartitions: partitions, ReplicationFactor: -1}},
+		kafka.SetAdminOperationTimeout(time.Duration(kafkaTimeoutSeconds)*time.Second),
+	)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if result.Error.Code() != kafka.ErrNoError && result.Error.Code() != kafka.ErrTopicAlreadyExists {
+			return result.Error
+		}
+	}
+	return nil
+}
+
+func replayFile(producer *kafka.Producer, filename string, topic string, headers []kafka.Header) (int, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	prefix := fmt.Sprintf("replay-%d", time.Now().UnixNano())
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), replayMaxLineBytes)
+	count := 0
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := strings.TrimSpace(scanner.Text())
+		if data == "" {
+			continue
+		}
+		req := &v2_dataplane.ModelInferRequest{}
+		if err := protojson.Unmarshal([]byte(data), req); err != nil {
+			return count, fmt.Errorf("failed to parse request on line %d of %s: %w", line, filename, err)
+		}
+		if req.Id == "" {
+			req.Id = fmt.Sprintf("%s-%d", prefix, line)
+		}
+		value, err := proto.Marshal(req)
+		if err != nil {
+			return count, err
+		}
+		err = producer.Produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+			Key:            []byte(req.Id),
+			Value:          value,
+			Headers:        headers,
+		}, nil)
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, scanner.Err()
+}
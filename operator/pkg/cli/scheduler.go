@@ -265,6 +265,10 @@ func (sc *SchedulerClient) ModelStatus(modelName string, waitCondition string, t
 					break
 				}
 			}
+			// Rollout states can also be waited on, e.g. RolloutSucceeded
+			if res.Rollout != nil && res.Rollout.GetState().String() == waitCondition {
+				break
+			}
 			time.Sleep(1 * time.Second)
 			if time.Now().Unix()-secsStart > (timeout.Milliseconds() / 1000) {
 				return nil, fmt.Errorf("Model wait status timeout")
@@ -644,6 +648,156 @@ func (sc *SchedulerClient) UnloadPipeline(pipelineName string, pipelineBytes []b
 	return res, nil
 }
 
+func (sc *SchedulerClient) ExperimentFeedback(experimentName string, candidate string, requestId string, reward float64) (*scheduler.ExperimentFeedbackResponse, error) {
+	req := &scheduler.ExperimentFeedbackRequest{
+		ExperimentName: experimentName,
+		Candidate:      candidate,
+		RequestId:      requestId,
+		Reward:         reward,
+	}
+	if sc.verbose {
+		printProto(req)
+	}
+	conn, err := sc.newConnection()
+	if err != nil {
+		return nil, err
+	}
+	grpcClient := scheduler.NewSchedulerClient(conn)
+	res, err := grpcClient.ExperimentFeedback(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (sc *SchedulerClient) ExperimentStats(experimentName string, windowSeconds *uint32, confidenceLevel *float64) (*scheduler.ExperimentStatsResponse, error) {
+	req := &scheduler.ExperimentStatsRequest{
+		ExperimentName:  experimentName,
+		WindowSeconds:   windowSeconds,
+		ConfidenceLevel: confidenceLevel,
+	}
+	if sc.verbose {
+		printProto(req)
+	}
+	conn, err := sc.newConnection()
+	if err != nil {
+		return nil, err
+	}
+	grpcClient := scheduler.NewSchedulerClient(conn)
+	res, err := grpcClient.ExperimentStats(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (sc *SchedulerClient) UpdatePipelineCanary(pipelineName string, trafficPercent uint32) (*scheduler.UpdatePipelineCanaryResponse, error) {
+	req := &scheduler.UpdatePipelineCanaryRequest{
+		Name:           pipelineName,
+		TrafficPercent: trafficPercent,
+	}
+	if sc.verbose {
+		printProto(req)
+	}
+	conn, err := sc.newConnection()
+	if err != nil {
+		return nil, err
+	}
+	grpcClient := scheduler.NewSchedulerClient(conn)
+	res, err := grpcClient.UpdatePipelineCanary(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (sc *SchedulerClient) KafkaGarbageCollect(dryRun bool, gracePeriod *time.Duration) error {
+	req := &scheduler.KafkaGarbageCollectRequest{
+		DryRun: dryRun,
+	}
+	if gracePeriod != nil {
+		gracePeriodSeconds := uint32(gracePeriod.Seconds())
+		req.GracePeriodSeconds = &gracePeriodSeconds
+	}
+	if sc.verbose {
+		printProto(req)
+	}
+	conn, err := sc.newConnection()
+	if err != nil {
+		return err
+	}
+	grpcClient := scheduler.NewSchedulerClient(conn)
+	res, err := grpcClient.KafkaGarbageCollect(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	if sc.verbose {
+		printProto(res)
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', tabwriter.AlignRight)
+	_, err = fmt.Fprintln(writer, "kind\tname\towner\torphaned\tdeleted")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(writer, "----\t----\t-----\t--------\t-------")
+	if err != nil {
+		return err
+	}
+	printOrphans := func(kind string, orphans []*scheduler.KafkaOrphanedResource) error {
+		for _, orphan := range orphans {
+			orphaned := time.Duration(orphan.OrphanedSeconds) * time.Second
+			_, err := fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%t\n", kind, orphan.Name, orphan.Owner, orphaned.String(), orphan.Deleted)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	err = printOrphans("topic", res.Topics)
+	if err != nil {
+		return err
+	}
+	err = printOrphans("consumer group", res.ConsumerGroups)
+	if err != nil {
+		return err
+	}
+	return writer.Flush()
+}
+
+// ReplayPipeline starts a replay of a pipeline inputs topic on the scheduler and waits for it to finish
+func (sc *SchedulerClient) ReplayPipeline(req *scheduler.ReplayPipelineRequest) error {
+	if sc.verbose {
+		printProto(req)
+	}
+	conn, err := sc.newConnection()
+	if err != nil {
+		return err
+	}
+	grpcClient := scheduler.NewSchedulerClient(conn)
+	res, err := grpcClient.ReplayPipeline(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	if sc.verbose {
+		printProto(res)
+	}
+	for {
+		status, err := grpcClient.PipelineReplayStatus(context.Background(), &scheduler.PipelineReplayStatusRequest{ReplayId: res.ReplayId})
+		if err != nil {
+			return err
+		}
+		switch status.State {
+		case scheduler.PipelineReplayStatusResponse_REPLAY_SUCCEEDED:
+			fmt.Printf("replayed %d requests to pipeline %s, outputs will be written to topic %s\n", status.Replayed, status.Name, status.SinkTopic)
+			return nil
+		case scheduler.PipelineReplayStatusResponse_REPLAY_FAILED:
+			return fmt.Errorf("replay %s failed after %d requests: %s", status.ReplayId, status.Replayed, status.Reason)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
 func (sc *SchedulerClient) PipelineStatus(pipelineName string, waitCondition string, timeout time.Duration) (*scheduler.PipelineStatusResponse, error) {
 	req := &scheduler.PipelineStatusRequest{
 		SubscriberName: subscriberName,
@@ -10,17 +10,24 @@ the Change License after the Change Date as each is defined in accordance with t
 package main
 
 import (
+	"errors"
 	"flag"
+	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
 	"syscall"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/claimcheck"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/gateway"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/serde"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/tracing"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
 
 const (
@@ -30,9 +37,11 @@ const (
 	flagEnvoyHost                = "envoy-host"
 	flagEnvoyPort                = "envoy-port"
 	flagLogLevel                 = "log-level"
+	flagMetricsPort              = "metrics-port"
 	defaultSchedulerPlaintxtPort = 9004
 	defaultSchedulerTLSPort      = 9044
 	defaultEnvoyPort             = 9000
+	defaultMetricsPort           = 9006
 	kubernetesNamespacePath      = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
 )
 
@@ -46,6 +55,7 @@ var (
 	namespace             string
 	logLevel              string
 	tracingConfigPath     string
+	metricsPort           int
 )
 
 func init() {
@@ -55,6 +65,7 @@ func init() {
 	flag.IntVar(&schedulerTlsPort, flagSchedulerTlsPort, defaultSchedulerTLSPort, "Scheduler TLS port")
 	flag.StringVar(&envoyHost, flagEnvoyHost, "0.0.0.0", "Envoy host")
 	flag.IntVar(&envoyPort, flagEnvoyPort, defaultEnvoyPort, "Envoy port")
+	flag.IntVar(&metricsPort, flagMetricsPort, defaultMetricsPort, "Metrics port")
 	flag.StringVar(&namespace, "namespace", "", "Namespace")
 	flag.StringVar(
 		&kafkaConfigPath,
@@ -130,10 +141,39 @@ func main() {
 		logger.WithError(err).Fatal("Failed to load Kafka config")
 	}
 
+	encoder, err := serde.NewEncoderFromConfig(kafkaConfigMap)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create schema registry encoder")
+	}
+	claimChecker, err := claimcheck.NewClaimCheckerFromConfig(kafkaConfigMap)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create claim check store")
+	}
+
+	idempotent, err := util.GetBoolEnvar(gateway.EnvVarIdempotent, false)
+	if err != nil {
+		logger.WithError(err).Fatalf("Failed to parse %s", gateway.EnvVarIdempotent)
+	}
+
+	promMetrics, err := metrics.NewPrometheusModelGatewayMetrics(logger)
+	if err != nil {
+		logger.WithError(err).Fatalf("Can't create prometheus metrics")
+	}
+	go func() {
+		err := promMetrics.Start(metricsPort)
+		if errors.Is(err, http.ErrServerClosed) {
+			return
+		}
+		logger.WithError(err).Error("Can't start metrics server")
+		close(done)
+	}()
+	defer func() { _ = promMetrics.Stop() }()
+
 	inferServerConfig := &gateway.InferenceServerConfig{
 		Host:     envoyHost,
 		HttpPort: envoyPort,
 		GrpcPort: envoyPort,
+		APIKey:   os.Getenv(util.EnvVarEnvoyAPIKey),
 	}
 	consumerConfig := gateway.ManagerConfig{
 		SeldonKafkaConfig:     kafkaConfigMap,
@@ -141,6 +181,14 @@ func main() {
 		InferenceServerConfig: inferServerConfig,
 		TraceProvider:         tracer,
 		NumWorkers:            getEnVar(logger, gateway.EnvVarNumWorkers, gateway.DefaultNumWorkers),
+		Idempotent:            idempotent,
+		IdempotencyCacheSize:  getEnVar(logger, gateway.EnvVarIdempotencyCacheSize, gateway.DefaultIdempotencyCacheSize),
+		MaxInFlight:           getEnVar(logger, gateway.EnvVarMaxInFlight, gateway.DefaultMaxInFlight),
+		TargetLatency:         time.Duration(getEnVar(logger, gateway.EnvVarTargetLatencyMs, 0)) * time.Millisecond,
+		LowPriorityPercent:    getEnVar(logger, gateway.EnvVarLowPriorityPercent, gateway.DefaultLowPriorityPercent),
+		Metrics:               promMetrics,
+		Encoder:               encoder,
+		ClaimChecker:          claimChecker,
 	}
 	kafkaConsumer, err := gateway.NewConsumerManager(logger, &consumerConfig,
 		getEnVar(logger, gateway.EnvMaxNumConsumers, gateway.DefaultMaxNumConsumers))
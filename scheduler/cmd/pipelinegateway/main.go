@@ -10,6 +10,7 @@ the Change License after the Change Date as each is defined in accordance with t
 package main
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"net/http"
@@ -17,12 +18,17 @@ import (
 	"os/signal"
 	"strconv"
 	"syscall"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/claimcheck"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline/status"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/serde"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/tracing"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
@@ -49,6 +55,11 @@ const (
 	defaultSchedulerTLSPort      = 9044
 	defaultEnvoyPort             = 9000
 	serviceTag                   = "seldon-pipelinegateway"
+	envDefaultReplicationFactor  = "KAFKA_DEFAULT_REPLICATION_FACTOR"
+	envDefaultNumPartitions      = "KAFKA_DEFAULT_NUM_PARTITIONS"
+	defaultReplicationFactor     = 1
+	defaultNumPartitions         = 1
+	shadowTopicCreateTimeout     = 30 * time.Second
 )
 
 var (
@@ -124,6 +135,52 @@ func getEnVar(logger *log.Logger, key string, defaultValue int) int {
 	return defaultValue
 }
 
+func createViewWriter(
+	logger *log.Logger,
+	kafkaConfig *config.KafkaConfig,
+	kafkaTransport transport.Transport,
+	claimChecker *claimcheck.ClaimChecker,
+) *serde.ViewWriter {
+	registry, err := serde.NewSchemaRegistryClient(kafkaConfig.SchemaRegistry)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create schema registry client")
+	}
+	topicNamer, err := kafka.NewTopicNamer(namespace, kafkaConfig.TopicPrefix)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create topic namer")
+	}
+	viewEncoder, err := serde.NewViewEncoder(registry, topicNamer, kafkaConfig.SchemaRegistry.Views)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create schema registry view encoder")
+	}
+	numPartitions := getEnVar(logger, envDefaultNumPartitions, defaultNumPartitions)
+	replicationFactor := getEnVar(logger, envDefaultReplicationFactor, defaultReplicationFactor)
+	return serde.NewViewWriter(
+		logger, kafkaTransport, topicNamer, viewEncoder, claimChecker, namespace, kafkaConfig.ConsumerGroupIdPrefix, numPartitions, replicationFactor)
+}
+
+// createShadowTopic creates the topic the responses captured for shadow experiments are written to
+func createShadowTopic(logger *log.Logger, kafkaConfig *config.KafkaConfig, kafkaTransport transport.Transport) error {
+	topicNamer, err := kafka.NewTopicNamer(namespace, kafkaConfig.TopicPrefix)
+	if err != nil {
+		return err
+	}
+	admin, err := kafkaTransport.NewAdmin()
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), shadowTopicCreateTimeout)
+	defer cancel()
+	return admin.CreateTopics(ctx, []transport.TopicSpec{
+		{
+			Name:              topicNamer.GetShadowTopic(),
+			NumPartitions:     getEnVar(logger, envDefaultNumPartitions, defaultNumPartitions),
+			ReplicationFactor: getEnVar(logger, envDefaultReplicationFactor, defaultReplicationFactor),
+		},
+	})
+}
+
 func main() {
 	logger := log.New()
 	flag.Parse()
@@ -154,17 +211,39 @@ func main() {
 
 	maxNumTopicsPerConsumer := getEnVar(logger, pipeline.EnvMaxNumTopicPerConsumer, pipeline.DefaultMaxNumTopicsPerConsumer)
 	maxNumConsumers := getEnVar(logger, pipeline.EnvMaxNumConsumers, pipeline.DefaultMaxNumConsumers)
+	kafkaTransport, err := transport.NewTransportFromConfig(logger, kafkaConfigMap)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create kafka transport")
+	}
+	encoder, err := serde.NewEncoderFromConfig(kafkaConfigMap)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create schema registry encoder")
+	}
+	claimChecker, err := claimcheck.NewClaimCheckerFromConfig(kafkaConfigMap)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create claim check store")
+	}
+	promMetrics, err := metrics.NewPrometheusPipelineMetrics(logger)
+	if err != nil {
+		logger.WithError(err).Fatalf("Can't create prometheus metrics")
+	}
 	km, err := pipeline.NewKafkaManager(
-		logger, namespace, kafkaConfigMap, tracer, maxNumConsumers, maxNumTopicsPerConsumer)
+		logger, namespace, kafkaConfigMap, kafkaTransport, encoder, claimChecker, promMetrics, tracer, maxNumConsumers, maxNumTopicsPerConsumer)
 	if err != nil {
 		logger.WithError(err).Fatal("Failed to create kafka manager")
 	}
 	defer km.Stop()
 
-	promMetrics, err := metrics.NewPrometheusPipelineMetrics(logger)
-	if err != nil {
-		logger.WithError(err).Fatalf("Can't create prometheus metrics")
+	if kafkaConfigMap.SchemaRegistry != nil && len(kafkaConfigMap.SchemaRegistry.Views) > 0 {
+		viewWriter := createViewWriter(logger, kafkaConfigMap, kafkaTransport, claimChecker)
+		go func() {
+			if err := viewWriter.Start(); err != nil {
+				logger.WithError(err).Error("Schema registry view writer failed")
+			}
+		}()
+		defer viewWriter.Stop()
 	}
+
 	go func() {
 		err := promMetrics.Start(metricsPort)
 		if errors.Is(err, http.ErrServerClosed) {
@@ -191,12 +270,17 @@ func main() {
 		close(done)
 	}()
 
-	restModelChecker, err := status.NewModelRestStatusCaller(logger, envoyHost, envoyPort)
+	restModelChecker, err := status.NewModelRestStatusCaller(logger, envoyHost, envoyPort, os.Getenv(util.EnvVarEnvoyAPIKey))
 	if err != nil {
 		logger.WithError(err).Fatalf("Failed to create REST modelchecker")
 	}
 	pipelineReadyChecker := status.NewSimpleReadyChecker(statusManager, restModelChecker)
-	httpServer := pipeline.NewGatewayHttpServer(httpPort, logger, km, promMetrics, &tlsOptions, pipelineReadyChecker)
+	inferer := pipeline.NewSchemaValidatingInferer(km, statusManager)
+	httpServer := pipeline.NewGatewayHttpServer(httpPort, logger, inferer, promMetrics, &tlsOptions, pipelineReadyChecker)
+	if err := createShadowTopic(logger, kafkaConfigMap, kafkaTransport); err != nil {
+		logger.WithError(err).Warn("Failed to create shadow topic, responses of shadow experiments may not be compared")
+	}
+	httpServer.SetShadowCapturer(km, os.Getenv(util.EnvVarEnvoyAPIKey))
 	go func() {
 		if err := httpServer.Start(); err != nil {
 			if !errors.Is(err, http.ErrServerClosed) {
@@ -206,7 +290,7 @@ func main() {
 		}
 	}()
 
-	grpcServer := pipeline.NewGatewayGrpcServer(grpcPort, logger, km, promMetrics, &tlsOptions, pipelineReadyChecker)
+	grpcServer := pipeline.NewGatewayGrpcServer(grpcPort, logger, inferer, promMetrics, &tlsOptions, pipelineReadyChecker)
 	go func() {
 		if err := grpcServer.Start(); err != nil {
 			logger.WithError(err).Error("Failed to start grpc server")
@@ -11,10 +11,13 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"math/rand"
+	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -25,12 +28,21 @@ import (
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/coordinator"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/processor"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
 	envoyServer "github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/server"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/xdscache"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/federation"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/dataflow"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/gc"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/replay"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/shadow"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/cleaner"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/rollout"
 	schedulerServer "github.com/seldonio/seldon-core/scheduler/v2/pkg/server"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/experiment"
@@ -46,6 +58,7 @@ var (
 	schedulerPort           uint
 	schedulerMtlsPort       uint
 	chainerPort             uint
+	metricsPort             int
 	namespace               string
 	pipelineGatewayHost     string
 	pipelineGatewayHttpPort int
@@ -57,6 +70,24 @@ var (
 	allowPlaintxt           bool //scheduler server
 	autoscalingDisabled     bool
 	kafkaConfigPath         string
+	kafkaGCInterval         time.Duration
+	kafkaGCGracePeriod      time.Duration
+	authJwtIssuer           string
+	authJwtAudiences        string
+	authJwksFile            string
+	authJwksUri             string
+	authAPIKeysPath         string
+	rateLimitServiceHost    string
+	rateLimitServicePort    uint
+	rateLimitDomain         string
+	prometheusUrl           string
+	shadowMatchTimeout      time.Duration
+	federationRegion        string
+	federationPeers         string
+	federationInferenceHost string
+	federationInferencePort uint
+	federationPollInterval  time.Duration
+	federationTokenPath     string
 )
 
 func init() {
@@ -80,6 +111,9 @@ func init() {
 	// The dataflow port to listen for data flow agents
 	flag.UintVar(&chainerPort, "dataflow-port", 9008, "dataflow server port")
 
+	// The port to serve the metrics of experiments comparing their mirror
+	flag.IntVar(&metricsPort, "metrics-port", 9006, "metrics server port")
+
 	// Tell Envoy to use this Node ID
 	flag.StringVar(&nodeID, "nodeID", "test-id", "Node ID")
 
@@ -104,6 +138,34 @@ func init() {
 		"/mnt/config/kafka.json",
 		"Path to kafka configuration file",
 	)
+	flag.DurationVar(&kafkaGCInterval, "kafka-gc-interval", 0, "Interval to delete Kafka topics and consumer groups of deleted models and pipelines, 0 to disable")
+	flag.DurationVar(&kafkaGCGracePeriod, "kafka-gc-grace-period", gc.DefaultGracePeriod, "Time models and pipelines must be deleted before their Kafka topics and consumer groups are")
+
+	// Authentication of inference requests at Envoy, off unless a JWT issuer or API keys are set
+	flag.StringVar(&authJwtIssuer, "envoy-auth-jwt-issuer", "", "Issuer of JWTs accepted by Envoy")
+	flag.StringVar(&authJwtAudiences, "envoy-auth-jwt-audiences", "", "Comma separated audiences of JWTs accepted by Envoy, any if empty")
+	flag.StringVar(&authJwksFile, "envoy-auth-jwks-file", "", "Path of the JWKS file in the Envoy container")
+	flag.StringVar(&authJwksUri, "envoy-auth-jwks-uri", "", "URI Envoy fetches the JWKS from")
+	flag.StringVar(&authAPIKeysPath, "envoy-auth-api-keys-path", "", "Path to a YAML file of API keys accepted by Envoy")
+
+	// Global rate limiting of inference requests at Envoy, off unless a rate limit service is set
+	flag.StringVar(&rateLimitServiceHost, "envoy-ratelimit-service-host", "", "Host of the gRPC rate limit service checked by Envoy for global rate limits")
+	flag.UintVar(&rateLimitServicePort, "envoy-ratelimit-service-port", 8081, "Port of the gRPC rate limit service")
+	flag.StringVar(&rateLimitDomain, "envoy-ratelimit-domain", resources.DefaultRateLimitDomain, "Domain of the rate limit service configuration")
+
+	// Prometheus queried for the rewards of bandit experiments, the promotion of scheduled experiments and the analysis of model rollouts
+	flag.StringVar(&prometheusUrl, "prometheus-url", "", "URL of the Prometheus server queried for experiment rewards and promotions and model rollout analysis")
+
+	// Shadow experiments comparing the responses of their mirror with the default model
+	flag.DurationVar(&shadowMatchTimeout, "shadow-match-timeout", shadow.DefaultMatchTimeout, "Time a captured response of a shadow experiment waits for the other response to the same request")
+
+	// Federation with the schedulers of other regions, off unless a region is set
+	flag.StringVar(&federationRegion, "federation-region", "", "Region of this scheduler in a federation of schedulers in other regions")
+	flag.StringVar(&federationPeers, "federation-peers", "", "Comma separated host:port of the schedulers of the other regions")
+	flag.StringVar(&federationInferenceHost, "federation-inference-host", "", "Host of the Envoy of this region for requests from other regions")
+	flag.UintVar(&federationInferencePort, "federation-inference-port", 9000, "Port of the Envoy of this region for requests from other regions")
+	flag.DurationVar(&federationPollInterval, "federation-poll-interval", federation.DefaultPollInterval, "Interval to poll the schedulers of other regions for their available models")
+	flag.StringVar(&federationTokenPath, "federation-token-path", "", "File with the token the schedulers of all regions use to authenticate each other, needed unless the control plane uses mTLS")
 }
 
 func getNamespace() string {
@@ -117,6 +179,67 @@ func getNamespace() string {
 	return ns
 }
 
+func createAuthConfig() (*resources.AuthConfig, error) {
+	if authJwtIssuer == "" && authAPIKeysPath == "" {
+		return nil, nil
+	}
+	authConfig := &resources.AuthConfig{}
+	if authJwtIssuer != "" {
+		authConfig.JWT = &resources.JWTConfig{
+			Issuer:   authJwtIssuer,
+			JwksFile: authJwksFile,
+			JwksUri:  authJwksUri,
+		}
+		if authJwtAudiences != "" {
+			authConfig.JWT.Audiences = strings.Split(authJwtAudiences, ",")
+		}
+	}
+	if authAPIKeysPath != "" {
+		apiKeys, err := resources.LoadAPIKeys(authAPIKeysPath)
+		if err != nil {
+			return nil, err
+		}
+		authConfig.APIKeys = apiKeys
+	}
+	if err := authConfig.Validate(); err != nil {
+		return nil, err
+	}
+	return authConfig, nil
+}
+
+func createGlobalRateLimitConfig() *resources.GlobalRateLimitConfig {
+	if rateLimitServiceHost == "" {
+		return nil
+	}
+	return &resources.GlobalRateLimitConfig{
+		Host:   rateLimitServiceHost,
+		Port:   uint32(rateLimitServicePort),
+		Domain: rateLimitDomain,
+	}
+}
+
+func createFederation(logger *log.Logger, modelStore store.ModelStore, eventHub *coordinator.EventHub) (*federation.Federation, error) {
+	if federationRegion == "" {
+		return nil, nil
+	}
+	config := federation.Config{
+		Region:        federationRegion,
+		InferenceHost: federationInferenceHost,
+		InferencePort: uint32(federationInferencePort),
+	}
+	if federationPeers != "" {
+		config.Peers = strings.Split(federationPeers, ",")
+	}
+	if federationTokenPath != "" {
+		token, err := os.ReadFile(federationTokenPath)
+		if err != nil {
+			return nil, err
+		}
+		config.Token = strings.TrimSpace(string(token))
+	}
+	return federation.NewFederation(config, modelStore, eventHub, logger)
+}
+
 func makeSignalHandler(logger *log.Logger, done chan<- bool) {
 	exit := make(chan os.Signal, 1)
 	signal.Notify(exit, os.Interrupt, syscall.SIGTERM)
@@ -127,6 +250,56 @@ func makeSignalHandler(logger *log.Logger, done chan<- bool) {
 	close(done)
 }
 
+func createKafkaGarbageCollector(
+	logger *log.Logger,
+	kafkaConfigMap *config.KafkaConfig,
+	streamTransport transport.Transport,
+	modelStore store.ModelStore,
+	pipelineHandler pipeline.PipelineHandler,
+) *gc.KafkaGarbageCollector {
+	admin, err := streamTransport.NewAdmin()
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create Kafka admin client")
+	}
+	topicNamer, err := kafka.NewTopicNamer(namespace, kafkaConfigMap.TopicPrefix)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create topic namer")
+	}
+	return gc.NewKafkaGarbageCollector(
+		logger, admin, topicNamer, modelStore, pipelineHandler, namespace, kafkaConfigMap.ConsumerGroupIdPrefix, kafkaGCGracePeriod)
+}
+
+func createShadowComparator(
+	logger *log.Logger,
+	kafkaConfigMap *config.KafkaConfig,
+	streamTransport transport.Transport,
+	experimentServer experiment.ExperimentServer,
+	shadowMetrics metrics.ShadowMetricsHandler,
+) *shadow.ShadowComparator {
+	topicNamer, err := kafka.NewTopicNamer(namespace, kafkaConfigMap.TopicPrefix)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create topic namer")
+	}
+	return shadow.NewShadowComparator(
+		logger, streamTransport, topicNamer, namespace, kafkaConfigMap.ConsumerGroupIdPrefix, experimentServer, shadowMetrics, shadowMatchTimeout)
+}
+
+func createPipelineReplayer(
+	logger *log.Logger,
+	kafkaConfigMap *config.KafkaConfig,
+	streamTransport transport.Transport,
+) *replay.PipelineReplayer {
+	topicNamer, err := kafka.NewTopicNamer(namespace, kafkaConfigMap.TopicPrefix)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create topic namer")
+	}
+	replayer, err := replay.NewPipelineReplayer(logger, kafkaConfigMap, streamTransport, topicNamer)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create pipeline replayer")
+	}
+	return replayer
+}
+
 func main() {
 	logger := log.New()
 	flag.Parse()
@@ -170,7 +343,19 @@ func main() {
 	ss := store.NewMemoryStore(logger, store.NewLocalSchedulerStore(), eventHub)
 	ps := pipeline.NewPipelineStore(logger, eventHub, ss)
 	es := experiment.NewExperimentServer(logger, eventHub, ss, ps)
+	var metricsQuerier rollout.MetricsQuerier
+	if prometheusUrl != "" {
+		prometheusQuerier, err := metrics.NewPrometheusQuerier(prometheusUrl, logger)
+		if err != nil {
+			logger.WithError(err).Fatalf("Failed to create Prometheus client for %s", prometheusUrl)
+		}
+		es.SetMetricsQuerier(prometheusQuerier)
+		metricsQuerier = prometheusQuerier
+	}
 	cleaner := cleaner.NewVersionCleaner(ss, logger)
+	rolloutController := rollout.NewRolloutController(ss, metricsQuerier, logger)
+	go rolloutController.Start(rollout.DefaultAnalysisInterval)
+	defer rolloutController.Stop()
 
 	pipelineGatewayDetails := xdscache.PipelineGatewayDetails{
 		Host:     pipelineGatewayHost,
@@ -178,7 +363,21 @@ func main() {
 		GrpcPort: pipelineGatewayGrpcPort,
 	}
 
-	_, err = processor.NewIncrementalProcessor(xdsCache, nodeID, logger, ss, es, ps, eventHub, &pipelineGatewayDetails, cleaner)
+	authConfig, err := createAuthConfig()
+	if err != nil {
+		log.WithError(err).Fatalf("Failed to configure Envoy authentication")
+	}
+
+	fed, err := createFederation(logger, ss, eventHub)
+	if err != nil {
+		log.WithError(err).Fatalf("Failed to configure federation")
+	}
+	var remoteModels federation.RemoteModels
+	if fed != nil {
+		remoteModels = fed
+	}
+
+	_, err = processor.NewIncrementalProcessor(xdsCache, nodeID, logger, ss, es, ps, eventHub, &pipelineGatewayDetails, cleaner, authConfig, createGlobalRateLimitConfig(), remoteModels)
 	if err != nil {
 		log.WithError(err).Fatalf("Failed to create incremental processor")
 	}
@@ -196,6 +395,11 @@ func main() {
 	if err != nil {
 		logger.WithError(err).Fatal("Failed to load Kafka config")
 	}
+	topicNamer, err := kafka.NewTopicNamer(namespace, kafkaConfigMap.TopicPrefix)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create topic namer")
+	}
+	ps.SetInternalTopicPrefix(topicNamer.GetInternalTopicPrefix())
 	cs, err := dataflow.NewChainerServer(logger, eventHub, ps, namespace, dataFlowLoadBalancer, kafkaConfigMap)
 	if err != nil {
 		logger.WithError(err).Fatal("Failed to start data engine chainer server")
@@ -224,6 +428,47 @@ func main() {
 	}
 
 	s := schedulerServer.NewSchedulerServer(logger, ss, es, ps, sched, eventHub)
+	if fed != nil {
+		s.SetFederation(fed)
+		logger.Infof("Federating region %s with schedulers %s every %s", federationRegion, federationPeers, federationPollInterval)
+		go fed.Start(federationPollInterval)
+		defer fed.Stop()
+	}
+	if kafkaConfigMap.HasKafkaBootstrapServer() || kafkaConfigMap.IsInProcessTransport() {
+		streamTransport, err := transport.NewTransportFromConfig(logger, kafkaConfigMap)
+		if err != nil {
+			logger.WithError(err).Fatal("Failed to create Kafka transport")
+		}
+		kgc := createKafkaGarbageCollector(logger, kafkaConfigMap, streamTransport, ss, ps)
+		defer kgc.Stop()
+		s.SetKafkaGarbageCollector(kgc)
+		if kafkaGCInterval > 0 {
+			logger.Infof("Collecting Kafka garbage every %s with grace period %s", kafkaGCInterval, kafkaGCGracePeriod)
+			go kgc.Start(kafkaGCInterval)
+		}
+		s.SetPipelineReplayer(createPipelineReplayer(logger, kafkaConfigMap, streamTransport))
+
+		shadowMetrics, err := metrics.NewPrometheusShadowMetrics(logger)
+		if err != nil {
+			logger.WithError(err).Fatal("Failed to create shadow metrics")
+		}
+		go func() {
+			err := shadowMetrics.Start(metricsPort)
+			if errors.Is(err, http.ErrServerClosed) {
+				return
+			}
+			logger.WithError(err).Error("Can't start metrics server")
+			close(done)
+		}()
+		defer func() { _ = shadowMetrics.Stop() }()
+		sc := createShadowComparator(logger, kafkaConfigMap, streamTransport, es, shadowMetrics)
+		go func() {
+			if err := sc.Start(); err != nil {
+				logger.WithError(err).Error("Failed to start shadow comparator")
+			}
+		}()
+		defer sc.Stop()
+	}
 	err = s.StartGrpcServers(allowPlaintxt, schedulerPort, schedulerMtlsPort)
 	if err != nil {
 		log.WithError(err).Fatalf("Failed to start server gRPC servers")
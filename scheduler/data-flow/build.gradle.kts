@@ -40,6 +40,8 @@ dependencies {
     implementation("com.google.protobuf:protobuf-kotlin:3.25.2")
     implementation("org.jetbrains.kotlinx:kotlinx-coroutines-core:1.7.3")
     implementation("com.michael-bull.kotlin-retry:kotlin-retry:1.0.9")
+    implementation("com.google.code.gson:gson:2.10.1")
+    implementation("org.apache.commons:commons-csv:1.10.0")
 
     // k8s
     implementation("io.kubernetes:client-java:19.0.0")
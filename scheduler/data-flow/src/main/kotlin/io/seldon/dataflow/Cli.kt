@@ -59,6 +59,17 @@ object Cli {
     val saslPasswordPath = Key("kafka.sasl.password.path", stringType)
     val saslMechanism = Key("kafka.sasl.mechanism", enumType(KafkaSaslMechanisms.byName))
 
+    // Kafka schema registry
+    val schemaRegistryUrl = Key("kafka.schema.registry.url", stringType)
+    val schemaRegistryBasicAuthUserInfo = Key("kafka.schema.registry.basic.auth.user.info", stringType)
+
+    // Kafka claim check
+    val claimCheckThresholdBytes = Key("kafka.claim.check.threshold.bytes", intType)
+    val claimCheckPath = Key("kafka.claim.check.path", stringType)
+    val claimCheckS3Endpoint = Key("kafka.claim.check.s3.endpoint", stringType)
+    val claimCheckS3Bucket = Key("kafka.claim.check.s3.bucket", stringType)
+    val claimCheckS3Region = Key("kafka.claim.check.s3.region", stringType)
+
     fun args(): List<Key<Any>> {
         return listOf(
             logLevelApplication,
@@ -89,6 +100,13 @@ object Cli {
             saslSecret,
             saslPasswordPath,
             saslMechanism,
+            schemaRegistryUrl,
+            schemaRegistryBasicAuthUserInfo,
+            claimCheckThresholdBytes,
+            claimCheckPath,
+            claimCheckS3Endpoint,
+            claimCheckS3Bucket,
+            claimCheckS3Region,
         )
     }
 
@@ -29,7 +29,12 @@ object Main {
             kafkaLevel = config[Cli.logLevelKafka],
         )
 
-        val effectiveArgs = Cli.args().map { arg -> arg.name to config[arg] }
+        val effectiveArgs = Cli.args().map { arg ->
+            when (arg) {
+                Cli.schemaRegistryBasicAuthUserInfo -> arg.name to "<redacted>"
+                else -> arg.name to config[arg]
+            }
+        }
         logger.info { "initialised with config $effectiveArgs" }
 
         val tlsCertConfig = CertificateConfig(
@@ -75,6 +80,22 @@ object Main {
             maxMessageSizeBytes = config[Cli.kafkaMaxMessageSizeBytes],
             security = kafkaSecurityParams,
         )
+        val schemaRegistryUrl = config[Cli.schemaRegistryUrl]
+        if (schemaRegistryUrl.isNotEmpty()) {
+            val schemaRegistryParams = SchemaRegistryParams(
+                url = schemaRegistryUrl,
+                basicAuthUserInfo = config[Cli.schemaRegistryBasicAuthUserInfo],
+            )
+            WireFormat.configure(SchemaRegistryClient(schemaRegistryParams))
+        }
+        val claimCheckParams = ClaimCheckParams(
+            thresholdBytes = config[Cli.claimCheckThresholdBytes],
+            path = config[Cli.claimCheckPath],
+            s3Endpoint = config[Cli.claimCheckS3Endpoint],
+            s3Bucket = config[Cli.claimCheckS3Bucket],
+            s3Region = config[Cli.claimCheckS3Region],
+        )
+        ClaimCheck.configure(ClaimCheck.storeFor(claimCheckParams), claimCheckParams.thresholdBytes)
         val kafkaProperties = getKafkaProperties(kafkaStreamsParams)
         val kafkaAdminProperties = getKafkaAdminProperties(kafkaStreamsParams)
         val kafkaDomainParams = KafkaDomainParams(
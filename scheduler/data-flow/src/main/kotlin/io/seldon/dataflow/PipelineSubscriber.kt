@@ -83,7 +83,14 @@ class PipelineSubscriber(
                 )
 
                 when (update.op) {
-                    PipelineOperation.Create -> handleCreate(metadata, update.updatesList, kafkaConsumerGroupIdPrefix, namespace)
+                    PipelineOperation.Create -> handleCreate(
+                        metadata,
+                        update.updatesList,
+                        if (update.hasTopicConfig()) update.topicConfig else null,
+                        update.canary,
+                        kafkaConsumerGroupIdPrefix,
+                        namespace,
+                    )
                     PipelineOperation.Delete -> handleDelete(metadata)
                     else -> logger.warn("unrecognised pipeline operation (${update.op})")
                 }
@@ -118,15 +125,20 @@ class PipelineSubscriber(
     private suspend fun handleCreate(
         metadata: PipelineMetadata,
         steps: List<PipelineStepUpdate>,
+        topicConfig: PipelineTopicConfig?,
+        canary: Boolean,
         kafkaConsumerGroupIdPrefix: String,
         namespace: String,
     ) {
         logger.info(
-            "Create pipeline {pipelineName}  version: {pipelineVersion} id: {pipelineId}",
+            "Create pipeline {pipelineName}  version: {pipelineVersion} id: {pipelineId} canary: {canary}",
             metadata.name,
             metadata.version,
-            metadata.id
+            metadata.id,
+            canary,
         )
+        // Also applied to an existing pipeline, as a canary is sent again when it is promoted
+        PipelineCanaries.set(metadata.name, metadata.version, canary)
         val (pipeline, err) = Pipeline.forSteps(
             metadata,
             steps,
@@ -166,7 +178,7 @@ class PipelineSubscriber(
         val previous = pipelines.putIfAbsent(metadata.id, pipeline)
         var pipelineStatus: PipelineStatus
         if (previous == null) {
-            val err = kafkaAdmin.ensureTopicsExist(steps)
+            val err = kafkaAdmin.ensureTopicsExist(steps, metadata.name, topicConfig)
             if (err == null) {
                 pipelineStatus = pipeline.start()
             } else {
@@ -207,6 +219,7 @@ class PipelineSubscriber(
 
     private suspend fun handleDelete(metadata: PipelineMetadata) {
         logger.info("Delete pipeline {pipelineName} version: {pipelineVersion} id: {pipelineId}", metadata.name, metadata.version, metadata.id )
+        PipelineCanaries.set(metadata.name, metadata.version, false)
         pipelines
             .remove(metadata.id)
             ?.also { pipeline ->
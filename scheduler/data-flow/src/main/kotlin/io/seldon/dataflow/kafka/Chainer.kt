@@ -11,7 +11,9 @@ package io.seldon.dataflow.kafka
 
 import io.klogging.noCoLogger
 import io.seldon.mlops.chainer.ChainerOuterClass
+import io.seldon.mlops.chainer.ChainerOuterClass.ForEach
 import io.seldon.mlops.chainer.ChainerOuterClass.PipelineTensorMapping
+import io.seldon.mlops.chainer.ChainerOuterClass.PipelineTransform
 import org.apache.kafka.streams.StreamsBuilder
 
 /**
@@ -29,7 +31,12 @@ class Chainer(
     internal val inputTriggerTopics: Set<TopicForPipeline>,
     internal val triggerJoinType: ChainerOuterClass.PipelineStepUpdate.PipelineJoinType,
     internal val triggerTensorsByTopic: Map<TopicForPipeline, Set<TensorName>>?,
+    internal val transforms: List<PipelineTransform> = emptyList(),
+    internal val forEach: ForEach? = null,
+    internal val pipelineVersion: Int? = null,
 ) : PipelineStep {
+    private val forEachFanOut = forEach?.let { ForEachFanOut(builder, pipelineName, it, outputTopic.topicName, pipelineVersion) }
+
     init {
         builder.apply {
             if (batchProperties.size > 0) {
@@ -52,7 +59,7 @@ class Chainer(
     private fun buildPassThroughStream(builder: StreamsBuilder) {
         val s1 = builder
             .stream(inputTopic.topicName, consumerSerde)
-            .filterForPipeline(inputTopic.pipelineName)
+            .filterForPipeline(inputTopic.pipelineName, inputTopic.versionFilter(pipelineName, pipelineVersion))
         addTriggerTopology(
             kafkaDomainParams,
             builder,
@@ -64,18 +71,19 @@ class Chainer(
         )
             .headerRemover()
             .headerSetter(pipelineName)
-            .to(outputTopic.topicName, producerSerde)
+            .toSink(outputTopic)
     }
 
     private fun buildInputOutputStream(builder: StreamsBuilder) {
         val s1 = builder
             .stream(inputTopic.topicName, consumerSerde)
-            .filterForPipeline(inputTopic.pipelineName)
+            .filterForPipeline(inputTopic.pipelineName, inputTopic.versionFilter(pipelineName, pipelineVersion))
             .unmarshallInferenceV2Request()
             .convertToResponse(inputTopic.pipelineName, inputTopic.topicName, tensors, tensorRenaming)
             // handle cases where there are no tensors we want
             .filter { _, value -> value.outputsList.size != 0 }
             .marshallInferenceV2Response()
+            .applyTransforms(transforms)
         addTriggerTopology(
             kafkaDomainParams,
             builder,
@@ -87,18 +95,19 @@ class Chainer(
         )
             .headerRemover()
             .headerSetter(pipelineName)
-            .to(outputTopic.topicName, producerSerde)
+            .toSink(outputTopic)
     }
 
     private fun buildOutputOutputStream(builder: StreamsBuilder) {
         val s1 = builder
             .stream(inputTopic.topicName, consumerSerde)
-            .filterForPipeline(inputTopic.pipelineName)
+            .filterForPipeline(inputTopic.pipelineName, inputTopic.versionFilter(pipelineName, pipelineVersion))
             .unmarshallInferenceV2Response()
             .filterResponses(inputTopic.pipelineName, inputTopic.topicName, tensors, tensorRenaming)
             // handle cases where there are no tensors we want
             .filter { _, value -> value.outputsList.size != 0 }
             .marshallInferenceV2Response()
+            .applyTransforms(transforms)
         addTriggerTopology(
             kafkaDomainParams,
             builder,
@@ -110,13 +119,13 @@ class Chainer(
         )
             .headerRemover()
             .headerSetter(pipelineName)
-            .to(outputTopic.topicName, producerSerde)
+            .toSink(outputTopic)
     }
 
     private fun buildOutputInputStream(builder: StreamsBuilder) {
         val s1 = builder
             .stream(inputTopic.topicName, consumerSerde)
-            .filterForPipeline(inputTopic.pipelineName)
+            .filterForPipeline(inputTopic.pipelineName, inputTopic.versionFilter(pipelineName, pipelineVersion))
             .unmarshallInferenceV2Response()
             .convertToRequest(inputTopic.pipelineName, inputTopic.topicName, tensors, tensorRenaming)
             // handle cases where there are no tensors we want
@@ -134,13 +143,14 @@ class Chainer(
         )
             .headerRemover()
             .headerSetter(pipelineName)
-            .to(outputTopic.topicName, producerSerde)
+            .splitForEach(forEachFanOut)
+            .toSink(outputTopic)
     }
 
     private fun buildInputInputStream(builder: StreamsBuilder) {
         val s1 = builder
             .stream(inputTopic.topicName, consumerSerde)
-            .filterForPipeline(inputTopic.pipelineName)
+            .filterForPipeline(inputTopic.pipelineName, inputTopic.versionFilter(pipelineName, pipelineVersion))
             .unmarshallInferenceV2Request()
             .filterRequests(inputTopic.pipelineName, inputTopic.topicName, tensors, tensorRenaming)
             // handle cases where there are no tensors we want
@@ -158,7 +168,8 @@ class Chainer(
         )
             .headerRemover()
             .headerSetter(pipelineName)
-            .to(outputTopic.topicName, producerSerde)
+            .splitForEach(forEachFanOut)
+            .toSink(outputTopic)
     }
 
     companion object {
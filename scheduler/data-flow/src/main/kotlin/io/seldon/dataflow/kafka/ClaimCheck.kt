@@ -0,0 +1,259 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package io.seldon.dataflow.kafka
+
+import io.klogging.noCoLogger
+import io.seldon.dataflow.kafka.headers.SeldonHeaders
+import org.apache.kafka.common.header.Headers
+import java.io.File
+import java.net.URI
+import java.net.http.HttpClient
+import java.net.http.HttpRequest
+import java.net.http.HttpResponse
+import java.security.MessageDigest
+import java.time.Duration
+import java.time.ZoneOffset
+import java.time.ZonedDateTime
+import java.time.format.DateTimeFormatter
+import java.util.*
+import javax.crypto.Mac
+import javax.crypto.spec.SecretKeySpec
+
+data class ClaimCheckParams(
+    val thresholdBytes: Int,
+    val path: String,
+    val s3Endpoint: String,
+    val s3Bucket: String,
+    val s3Region: String,
+)
+
+interface ObjectStore {
+    fun put(key: String, data: ByteArray)
+    fun get(key: String): ByteArray
+}
+
+/**
+ * Keeps payloads under a directory, which must be shared with the model and pipeline gateways.
+ */
+class FileObjectStore(dir: String) : ObjectStore {
+    private val root = File(dir).canonicalFile
+
+    init {
+        root.mkdirs()
+    }
+
+    override fun put(key: String, data: ByteArray) {
+        val file = resolve(key)
+        file.parentFile.mkdirs()
+        // Write to a temporary file first so readers never see a partial payload
+        val tmp = File(file.path + ".tmp")
+        tmp.writeBytes(data)
+        if (!tmp.renameTo(file)) {
+            throw IllegalStateException("failed to store payload $key")
+        }
+    }
+
+    override fun get(key: String): ByteArray {
+        return resolve(key).readBytes()
+    }
+
+    // Keys are read from message headers so must not resolve outside of the store directory
+    private fun resolve(key: String): File {
+        val file = File(root, key).canonicalFile
+        if (!file.path.startsWith(root.path + File.separator)) {
+            throw IllegalArgumentException("invalid claim check key $key")
+        }
+        return file
+    }
+}
+
+data class S3Credentials(
+    val accessKeyId: String,
+    val secretAccessKey: String,
+    val sessionToken: String,
+)
+
+/**
+ * Keeps payloads in an S3 compatible bucket using path style requests.
+ * Requests are signed with AWS signature version 4 if credentials are given.
+ */
+class S3ObjectStore(
+    endpoint: String,
+    private val bucket: String,
+    private val region: String,
+    private val credentials: S3Credentials,
+) : ObjectStore {
+    private val endpoint = endpoint.ifEmpty { DEFAULT_ENDPOINT }.trimEnd('/')
+    private val client = HttpClient.newBuilder().connectTimeout(Duration.ofSeconds(30)).build()
+    internal var now: () -> ZonedDateTime = { ZonedDateTime.now(ZoneOffset.UTC) }
+
+    override fun put(key: String, data: ByteArray) {
+        send("PUT", key, data)
+    }
+
+    override fun get(key: String): ByteArray {
+        return send("GET", key, ByteArray(0))
+    }
+
+    private fun send(method: String, key: String, body: ByteArray): ByteArray {
+        val uri = URI.create("$endpoint/$bucket/$key")
+        val publisher = if (method == "PUT") {
+            HttpRequest.BodyPublishers.ofByteArray(body)
+        } else {
+            HttpRequest.BodyPublishers.noBody()
+        }
+        val request = HttpRequest.newBuilder()
+            .uri(uri)
+            .timeout(Duration.ofSeconds(30))
+            .method(method, publisher)
+            .apply {
+                signedHeaders(method, uri, body).forEach { (name, value) -> header(name, value) }
+            }
+            .build()
+        val response = client.send(request, HttpResponse.BodyHandlers.ofByteArray())
+        if (response.statusCode() != 200) {
+            throw IllegalStateException(
+                "s3 $method of $key failed with status ${response.statusCode()}: ${response.body().decodeToString()}",
+            )
+        }
+        return response.body()
+    }
+
+    // See https://docs.aws.amazon.com/IAM/latest/UserGuide/create-signed-request.html
+    internal fun signedHeaders(method: String, uri: URI, body: ByteArray): Map<String, String> {
+        if (credentials.accessKeyId.isEmpty()) {
+            return emptyMap()
+        }
+        val time = now()
+        val amzDate = time.format(AMZ_DATE_FORMAT)
+        val shortDate = time.format(AMZ_SHORT_FORMAT)
+        val payloadHash = hashHex(body)
+
+        val headers = sortedMapOf(
+            "host" to uri.rawAuthority,
+            "x-amz-content-sha256" to payloadHash,
+            "x-amz-date" to amzDate,
+        )
+        if (credentials.sessionToken.isNotEmpty()) {
+            headers["x-amz-security-token"] = credentials.sessionToken
+        }
+        val signedHeaders = headers.keys.joinToString(";")
+        val canonicalRequest = listOf(
+            method,
+            uri.rawPath,
+            uri.rawQuery ?: "",
+            headers.entries.joinToString("") { "${it.key}:${it.value.trim()}\n" },
+            signedHeaders,
+            payloadHash,
+        ).joinToString("\n")
+
+        val scope = "$shortDate/$region/s3/aws4_request"
+        val stringToSign = listOf(ALGORITHM, amzDate, scope, hashHex(canonicalRequest.toByteArray())).joinToString("\n")
+        var signingKey = hmac("AWS4${credentials.secretAccessKey}".toByteArray(), shortDate)
+        signingKey = hmac(signingKey, region)
+        signingKey = hmac(signingKey, "s3")
+        signingKey = hmac(signingKey, "aws4_request")
+        val signature = hmac(signingKey, stringToSign).toHex()
+
+        // The HTTP client sets the host header itself
+        return headers.filterKeys { it != "host" } + mapOf(
+            "Authorization" to
+                "$ALGORITHM Credential=${credentials.accessKeyId}/$scope, SignedHeaders=$signedHeaders, Signature=$signature",
+        )
+    }
+
+    private fun hashHex(data: ByteArray): String {
+        return MessageDigest.getInstance("SHA-256").digest(data).toHex()
+    }
+
+    private fun hmac(key: ByteArray, data: String): ByteArray {
+        val mac = Mac.getInstance("HmacSHA256")
+        mac.init(SecretKeySpec(key, "HmacSHA256"))
+        return mac.doFinal(data.toByteArray())
+    }
+
+    private fun ByteArray.toHex(): String = joinToString("") { "%02x".format(it) }
+
+    companion object {
+        private const val DEFAULT_ENDPOINT = "https://s3.amazonaws.com"
+        private const val ALGORITHM = "AWS4-HMAC-SHA256"
+        private val AMZ_DATE_FORMAT = DateTimeFormatter.ofPattern("yyyyMMdd'T'HHmmss'Z'")
+        private val AMZ_SHORT_FORMAT = DateTimeFormatter.ofPattern("yyyyMMdd")
+    }
+}
+
+/**
+ * Payloads above the threshold are written to an object store and replaced by an empty payload with a
+ * reference header, as done by the model and pipeline gateways. Payloads with a reference are always loaded
+ * when a store is configured.
+ */
+object ClaimCheck {
+    const val DEFAULT_THRESHOLD_BYTES = 900 * 1024
+
+    private val logger = noCoLogger(ClaimCheck::class)
+
+    @Volatile
+    private var store: ObjectStore? = null
+
+    @Volatile
+    private var thresholdBytes = DEFAULT_THRESHOLD_BYTES
+
+    fun configure(store: ObjectStore?, thresholdBytes: Int = DEFAULT_THRESHOLD_BYTES) {
+        this.store = store
+        this.thresholdBytes = if (thresholdBytes > 0) thresholdBytes else DEFAULT_THRESHOLD_BYTES
+    }
+
+    /**
+     * Any reference carried over from the record being processed is removed,
+     * so only payloads stored for this record are referenced.
+     */
+    fun offload(topic: TopicName, headers: Headers?, data: TRecord): TRecord {
+        headers?.remove(SeldonHeaders.claimCheck)
+        val store = this.store ?: return data
+        if (headers == null || data.size <= thresholdBytes) {
+            return data
+        }
+        val key = "$topic/${UUID.randomUUID()}"
+        return try {
+            store.put(key, data)
+            headers.add(SeldonHeaders.claimCheck, key.toByteArray())
+            ByteArray(0)
+        } catch (e: Exception) {
+            logger.warn("failed to store payload for topic $topic, sending inline: ${e.message}")
+            data
+        }
+    }
+
+    fun rehydrate(headers: Headers?, data: TRecord): TRecord {
+        val key = headers?.lastHeader(SeldonHeaders.claimCheck)?.value()?.decodeToString() ?: return data
+        val store = this.store
+            ?: throw IllegalStateException("payload $key is held in an object store but no claim check store is configured")
+        val payload = store.get(key)
+        headers.remove(SeldonHeaders.claimCheck)
+        return payload
+    }
+
+    fun storeFor(params: ClaimCheckParams): ObjectStore? {
+        return when {
+            params.s3Bucket.isNotEmpty() -> S3ObjectStore(
+                params.s3Endpoint,
+                params.s3Bucket,
+                params.s3Region.ifEmpty { "us-east-1" },
+                S3Credentials(
+                    accessKeyId = System.getenv("AWS_ACCESS_KEY_ID") ?: "",
+                    secretAccessKey = System.getenv("AWS_SECRET_ACCESS_KEY") ?: "",
+                    sessionToken = System.getenv("AWS_SESSION_TOKEN") ?: "",
+                ),
+            )
+            params.path.isNotEmpty() -> FileObjectStore(params.path)
+            else -> null
+        }
+    }
+}
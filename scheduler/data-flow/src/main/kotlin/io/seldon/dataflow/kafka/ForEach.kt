@@ -0,0 +1,352 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package io.seldon.dataflow.kafka
+
+import io.klogging.noCoLogger
+import io.seldon.dataflow.kafka.headers.PriorityLaneExtractor
+import io.seldon.dataflow.kafka.headers.SeldonHeaders
+import io.seldon.mlops.chainer.ChainerOuterClass.ForEach
+import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferRequest
+import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferResponse
+import org.apache.kafka.common.header.Headers
+import org.apache.kafka.common.header.internals.RecordHeaders
+import org.apache.kafka.common.serialization.Serdes
+import org.apache.kafka.streams.StreamsBuilder
+import org.apache.kafka.streams.kstream.Branched
+import org.apache.kafka.streams.kstream.KStream
+import org.apache.kafka.streams.kstream.Named
+import org.apache.kafka.streams.processor.PunctuationType
+import org.apache.kafka.streams.processor.api.Processor
+import org.apache.kafka.streams.processor.api.ProcessorContext
+import org.apache.kafka.streams.processor.api.ProcessorSupplier
+import org.apache.kafka.streams.processor.api.Record
+import org.apache.kafka.streams.state.KeyValueStore
+import org.apache.kafka.streams.state.StoreBuilder
+import org.apache.kafka.streams.state.Stores
+import java.io.ByteArrayInputStream
+import java.io.ByteArrayOutputStream
+import java.io.DataInputStream
+import java.io.DataOutputStream
+import java.nio.ByteBuffer
+import java.time.Duration
+
+typealias TForEachStore = KeyValueStore<String, ByteArray>
+
+/**
+ * Fans out the requests for a step into one message per element of a tensor and gathers the
+ * element outputs back, in order, into a single message on the step outputs topic.
+ *
+ * The splitter and the gatherer share a state store so they are placed in the same sub-topology,
+ * which relies on the step inputs and outputs topics having the same number of partitions.
+ */
+class ForEachFanOut(
+    builder: StreamsBuilder,
+    private val pipelineName: String,
+    private val forEach: ForEach,
+    private val inputTopic: TopicName,
+    private val pipelineVersion: Int? = null,
+) {
+    private val storeName = "foreach-${forEach.gatherTopic}"
+
+    init {
+        builder.addStateStore(stateStoreBuilder(storeName))
+        buildGatherStream(builder)
+    }
+
+    fun split(stream: KStream<RequestId, TRecord>): KStream<RequestId, TRecord> {
+        return stream
+            .process(ProcessorSupplier { ForEachSplitter(forEach, storeName) }, storeName)
+    }
+
+    private fun buildGatherStream(builder: StreamsBuilder) {
+        builder
+            .stream(forEach.gatherTopic, consumerSerde)
+            .filterForPipelineElements(pipelineName, pipelineVersion)
+            .process(ProcessorSupplier { ForEachGatherer(forEach, storeName) }, storeName)
+            .split(Named.`as`("foreach-"))
+            .branch(
+                { _, value -> value.release },
+                Branched.withConsumer { s: KStream<RequestId, ForEachRecord> ->
+                    s.mapValues { value -> value.value }.to(PriorityLaneExtractor(inputTopic), producerSerde)
+                },
+            )
+            .defaultBranch(
+                Branched.withConsumer { s: KStream<RequestId, ForEachRecord> ->
+                    s.mapValues { value -> value.value }.to(forEach.gatherTopic, producerSerde)
+                },
+            )
+    }
+
+    companion object {
+        // The store is backed by a changelog topic, so requests in progress are restored when their partition
+        // moves to another replica or the engine restarts, rather than their gathered outputs being lost.
+        private fun stateStoreBuilder(name: String): StoreBuilder<TForEachStore> = Stores
+            .keyValueStoreBuilder(
+                Stores.inMemoryKeyValueStore(name),
+                Serdes.String(),
+                Serdes.ByteArray(),
+            )
+            .withLoggingEnabled(emptyMap())
+            .withCachingDisabled()
+    }
+}
+
+fun KStream<RequestId, TRecord>.splitForEach(fanOut: ForEachFanOut?): KStream<RequestId, TRecord> {
+    return fanOut?.split(this) ?: this
+}
+
+/**
+ * The output of the gatherer: either an element request released to the step inputs topic
+ * as capacity frees up, or the gathered response for the step outputs topic.
+ */
+data class ForEachRecord(val release: Boolean, val value: TRecord)
+
+/**
+ * The progress of a single request through a forEach step.
+ * Pending elements are those held back by the parallelism limit.
+ */
+internal data class ForEachState(
+    val count: Int,
+    val startedMs: Long,
+    val pending: MutableList<Pair<Int, ByteArray>>,
+    val gathered: MutableMap<Int, ByteArray>,
+) {
+    fun toBytes(): ByteArray {
+        val bytes = ByteArrayOutputStream()
+        DataOutputStream(bytes).use { out ->
+            out.writeInt(count)
+            out.writeLong(startedMs)
+            out.writeInt(pending.size)
+            pending.forEach { (index, value) ->
+                out.writeInt(index)
+                out.writeInt(value.size)
+                out.write(value)
+            }
+            out.writeInt(gathered.size)
+            gathered.forEach { (index, value) ->
+                out.writeInt(index)
+                out.writeInt(value.size)
+                out.write(value)
+            }
+        }
+        return bytes.toByteArray()
+    }
+
+    companion object {
+        fun fromBytes(bytes: ByteArray): ForEachState {
+            DataInputStream(ByteArrayInputStream(bytes)).use { input ->
+                val count = input.readInt()
+                val startedMs = input.readLong()
+                val pending = MutableList(input.readInt()) {
+                    val index = input.readInt()
+                    index to ByteArray(input.readInt()).also { input.readFully(it) }
+                }
+                val gathered = sortedMapOf<Int, ByteArray>()
+                repeat(input.readInt()) {
+                    val index = input.readInt()
+                    gathered[index] = ByteArray(input.readInt()).also { input.readFully(it) }
+                }
+                return ForEachState(count, startedMs, pending, gathered)
+            }
+        }
+
+        // Read the start time without decoding the stored elements
+        fun startedMs(bytes: ByteArray): Long = ByteBuffer.wrap(bytes, Int.SIZE_BYTES, Long.SIZE_BYTES).getLong()
+    }
+}
+
+class ForEachSplitter(
+    private val forEach: ForEach,
+    private val storeName: String,
+) : Processor<RequestId, TRecord, RequestId, TRecord> {
+    private lateinit var context: ProcessorContext<RequestId, TRecord>
+    private lateinit var store: TForEachStore
+
+    override fun init(context: ProcessorContext<RequestId, TRecord>) {
+        this.context = context
+        this.store = context.getStateStore(storeName)
+    }
+
+    override fun process(record: Record<RequestId, TRecord>) {
+        val elements = try {
+            splitRequest(ModelInferRequest.parseFrom(record.value()), forEach.tensor, forEach.axis.toInt())
+        } catch (e: Exception) {
+            logger.warn("failed to split request ${record.key()} for forEach: ${e.message}")
+            return
+        }
+        if (elements.isEmpty()) {
+            return
+        }
+
+        val inFlight = if (forEach.parallelism > 0) minOf(forEach.parallelism, elements.size) else elements.size
+        val state = ForEachState(
+            count = elements.size,
+            startedMs = System.currentTimeMillis(),
+            pending = elements
+                .drop(inFlight)
+                .mapIndexed { idx, element -> (idx + inFlight) to element.toByteArray() }
+                .toMutableList(),
+            gathered = sortedMapOf(),
+        )
+        store.put(record.key(), state.toBytes())
+
+        elements.take(inFlight).forEachIndexed { idx, element ->
+            context.forward(
+                record
+                    .withValue(element.toByteArray())
+                    .withHeaders(elementHeaders(record.headers(), idx, elements.size))
+            )
+        }
+    }
+
+    companion object {
+        private val logger = noCoLogger(ForEachSplitter::class)
+    }
+}
+
+class ForEachGatherer(
+    private val forEach: ForEach,
+    private val storeName: String,
+) : Processor<RequestId, TRecord, RequestId, ForEachRecord> {
+    private lateinit var context: ProcessorContext<RequestId, ForEachRecord>
+    private lateinit var store: TForEachStore
+
+    override fun init(context: ProcessorContext<RequestId, ForEachRecord>) {
+        this.context = context
+        this.store = context.getStateStore(storeName)
+        val interval = Duration.ofMillis(minOf(forEach.gatherTimeoutMs.toLong(), 1000L).coerceAtLeast(1L))
+        context.schedule(interval, PunctuationType.WALL_CLOCK_TIME) { now -> expire(now) }
+    }
+
+    override fun process(record: Record<RequestId, TRecord>) {
+        val index = record.headers().lastHeader(SeldonHeaders.forEachIndex)?.value()?.decodeToString()?.toIntOrNull()
+        val stored = store.get(record.key())
+        if (index == null || stored == null) {
+            // the request has already completed or timed out
+            return
+        }
+
+        val state = ForEachState.fromBytes(stored)
+        state.gathered[index] = record.value()
+        if (state.pending.isNotEmpty()) {
+            val (nextIndex, next) = state.pending.removeAt(0)
+            context.forward(
+                record
+                    .withValue(ForEachRecord(true, next))
+                    .withHeaders(elementHeaders(record.headers(), nextIndex, state.count))
+            )
+        }
+
+        if (state.gathered.size < state.count) {
+            store.put(record.key(), state.toBytes())
+            return
+        }
+
+        store.delete(record.key())
+        val gathered = try {
+            gatherResponses(state.gathered.values.map { ModelInferResponse.parseFrom(it) }, forEach.axis.toInt())
+        } catch (e: Exception) {
+            logger.warn("failed to gather outputs for request ${record.key()} for forEach: ${e.message}")
+            return
+        }
+        val headers = RecordHeaders(record.headers().toArray())
+        headers.remove(SeldonHeaders.forEachIndex)
+        headers.remove(SeldonHeaders.forEachCount)
+        context.forward(
+            record
+                .withValue(ForEachRecord(false, gathered.toByteArray()))
+                .withHeaders(headers)
+        )
+    }
+
+    private fun expire(now: Long) {
+        val expired = mutableListOf<String>()
+        store.all().use { iter ->
+            iter.forEach {
+                if (now - ForEachState.startedMs(it.value) > forEach.gatherTimeoutMs.toLong()) {
+                    expired.add(it.key)
+                }
+            }
+        }
+        expired.forEach {
+            logger.warn("dropping request $it as forEach outputs were not gathered within ${forEach.gatherTimeoutMs}ms")
+            store.delete(it)
+        }
+    }
+
+    companion object {
+        private val logger = noCoLogger(ForEachGatherer::class)
+    }
+}
+
+private fun elementHeaders(headers: Headers, index: Int, count: Int): Headers {
+    return RecordHeaders(headers.toArray()).apply {
+        remove(SeldonHeaders.forEachIndex)
+        remove(SeldonHeaders.forEachCount)
+        add(SeldonHeaders.forEachIndex, index.toString().toByteArray())
+        add(SeldonHeaders.forEachCount, count.toString().toByteArray())
+    }
+}
+
+/**
+ * Split a request into one request per element of a tensor along an axis.
+ * The axis is kept with size one and all other tensors are copied to every element.
+ */
+internal fun splitRequest(request: ModelInferRequest, tensorName: TensorName, axis: Int): List<ModelInferRequest> {
+    val tensors = decodeTensors(request)
+    val tensor = tensors.find { it.name == tensorName }
+        ?: throw TransformException("tensor $tensorName not found")
+    if (axis >= tensor.shape.size) {
+        throw TransformException("cannot split tensor $tensorName with shape ${tensor.shape} on axis $axis")
+    }
+    val numElements = tensor.shape[axis].toInt()
+    if (numElements == 0) {
+        return emptyList()
+    }
+
+    return split(tensor, axis, List(numElements) { tensorName }).map { element ->
+        ModelInferRequest
+            .newBuilder()
+            .setId(request.id)
+            .setModelName(request.modelName)
+            .setModelVersion(request.modelVersion)
+            .putAllParameters(request.parametersMap)
+            .addAllOutputs(request.outputsList)
+            .apply {
+                tensors.forEach { addInputs(encodeInputTensor(if (it.name == tensorName) element else it)) }
+            }
+            .build()
+    }
+}
+
+/**
+ * Gather the element responses of a forEach step, ordered by element, into a single response
+ * by concatenating each output tensor along the axis the request was split on.
+ */
+internal fun gatherResponses(responses: List<ModelInferResponse>, axis: Int): ModelInferResponse {
+    val first = responses.first()
+    val tensorsByResponse = responses.map { decodeTensors(it) }
+    return ModelInferResponse
+        .newBuilder()
+        .setId(first.id)
+        .setModelName(first.modelName)
+        .setModelVersion(first.modelVersion)
+        .putAllParameters(first.parametersMap)
+        .apply {
+            tensorsByResponse.first().forEach { tensor ->
+                val elements = tensorsByResponse.map { tensors ->
+                    tensors.find { it.name == tensor.name }
+                        ?: throw TransformException("tensor ${tensor.name} missing from an element output")
+                }
+                addOutputs(encodeTensor(concat(elements, axis, tensor.name)))
+            }
+        }
+        .build()
+}
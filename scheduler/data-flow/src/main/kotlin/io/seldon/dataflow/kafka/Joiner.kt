@@ -10,8 +10,10 @@ the Change License after the Change Date as each is defined in accordance with t
 package io.seldon.dataflow.kafka
 
 import io.klogging.noCoLogger
+import io.seldon.mlops.chainer.ChainerOuterClass.ForEach
 import io.seldon.mlops.chainer.ChainerOuterClass.PipelineStepUpdate.PipelineJoinType
 import io.seldon.mlops.chainer.ChainerOuterClass.PipelineTensorMapping
+import io.seldon.mlops.chainer.ChainerOuterClass.PipelineTransform
 import io.seldon.mlops.inference.v2.V2Dataplane
 import org.apache.kafka.streams.StreamsBuilder
 import org.apache.kafka.streams.kstream.JoinWindows
@@ -33,9 +35,15 @@ class Joiner(
     internal val inputTriggerTopics: Set<TopicForPipeline>,
     internal val triggerJoinType: PipelineJoinType,
     internal val triggerTensorsByTopic: Map<TopicForPipeline, Set<TensorName>>?,
+    internal val transforms: List<PipelineTransform> = emptyList(),
+    internal val forEach: ForEach? = null,
+    internal val pipelineVersion: Int? = null,
 ) : PipelineStep {
+    private val forEachFanOut = forEach?.let { ForEachFanOut(builder, pipelineName, it, outputTopic.topicName, pipelineVersion) }
+
     init {
         val dataStream = buildTopology(builder, inputTopics)
+            .applyTransforms(transforms)
         addTriggerTopology(
             kafkaDomainParams,
             builder,
@@ -47,7 +55,8 @@ class Joiner(
         )
             .headerRemover()
             .headerSetter(pipelineName)
-            .to(outputTopic.topicName, producerSerde)
+            .splitForEach(forEachFanOut)
+            .toSink(outputTopic)
     }
 
     private fun buildTopology(
@@ -133,13 +142,13 @@ class Joiner(
     private fun buildPassThroughStream(topic: TopicForPipeline, builder: StreamsBuilder): KStream<RequestId, TRecord> {
         return builder
             .stream(topic.topicName, consumerSerde)
-            .filterForPipeline(topic.pipelineName)
+            .filterForPipeline(topic.pipelineName, topic.versionFilter(pipelineName, pipelineVersion))
     }
 
     private fun buildInputOutputStream(topic: TopicForPipeline, builder: StreamsBuilder): KStream<RequestId, TRecord> {
         return builder
             .stream(topic.topicName, consumerSerde)
-            .filterForPipeline(topic.pipelineName)
+            .filterForPipeline(topic.pipelineName, topic.versionFilter(pipelineName, pipelineVersion))
             .unmarshallInferenceV2Request()
             .convertToResponse(topic.pipelineName, topic.topicName, tensorsByTopic?.get(topic), tensorRenaming)
             // handle cases where there are no tensors we want
@@ -150,7 +159,7 @@ class Joiner(
     private fun buildOutputOutputStream(topic: TopicForPipeline, builder: StreamsBuilder): KStream<RequestId, TRecord> {
         return builder
             .stream(topic.topicName, consumerSerde)
-            .filterForPipeline(topic.pipelineName)
+            .filterForPipeline(topic.pipelineName, topic.versionFilter(pipelineName, pipelineVersion))
             .unmarshallInferenceV2Response()
             .filterResponses(topic.pipelineName, topic.topicName, tensorsByTopic?.get(topic), tensorRenaming)
             // handle cases where there are no tensors we want
@@ -161,7 +170,7 @@ class Joiner(
     private fun buildOutputInputStream(topic: TopicForPipeline, builder: StreamsBuilder): KStream<RequestId, TRecord> {
         return builder
             .stream(topic.topicName, consumerSerde)
-            .filterForPipeline(topic.pipelineName)
+            .filterForPipeline(topic.pipelineName, topic.versionFilter(pipelineName, pipelineVersion))
             .unmarshallInferenceV2Response()
             .convertToRequest(topic.pipelineName, topic.topicName, tensorsByTopic?.get(topic), tensorRenaming)
             // handle cases where there are no tensors we want
@@ -172,7 +181,7 @@ class Joiner(
     private fun buildInputInputStream(topic: TopicForPipeline, builder: StreamsBuilder): KStream<RequestId, TRecord> {
         return builder
             .stream(topic.topicName, consumerSerde)
-            .filterForPipeline(topic.pipelineName)
+            .filterForPipeline(topic.pipelineName, topic.versionFilter(pipelineName, pipelineVersion))
             .unmarshallInferenceV2Request()
             .filterRequests(topic.pipelineName,topic.topicName, tensorsByTopic?.get(topic), tensorRenaming)
             // handle cases where there are no tensors we want
@@ -0,0 +1,249 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package io.seldon.dataflow.kafka
+
+import com.google.gson.JsonArray
+import com.google.gson.JsonElement
+import com.google.gson.JsonObject
+import com.google.gson.JsonParser
+import com.google.gson.JsonPrimitive
+import com.google.protobuf.ByteString
+import io.klogging.noCoLogger
+import io.seldon.dataflow.kafka.headers.PipelineErrorFilter
+import io.seldon.mlops.chainer.ChainerOuterClass.KafkaAdapter
+import io.seldon.mlops.chainer.ChainerOuterClass.KafkaAdapterTensor
+import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferRequest
+import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferResponse
+import org.apache.commons.csv.CSVFormat
+import org.apache.commons.csv.CSVParser
+import org.apache.kafka.common.serialization.Serdes
+import org.apache.kafka.streams.StreamsBuilder
+import org.apache.kafka.streams.kstream.Consumed
+import org.apache.kafka.streams.kstream.Produced
+import org.apache.kafka.streams.kstream.ValueTransformerSupplier
+import java.util.*
+
+// External topics hold records in the user's own format, so are never framed for the schema registry
+private val externalConsumerSerde: Consumed<String?, TRecord> = Consumed.with(Serdes.String(), Serdes.ByteArray())
+private val externalProducerSerde: Produced<RequestId, TRecord> = Produced.with(Serdes.String(), Serdes.ByteArray())
+
+private const val defaultBytesTensor = "input"
+
+private val csvRecordFormat: CSVFormat = CSVFormat.DEFAULT
+    .builder()
+    .setIgnoreSurroundingSpaces(true)
+    .setIgnoreEmptyLines(true)
+    .build()
+
+/**
+ * A *step* connecting an external Kafka topic to a pipeline.
+ * A source adapter converts records on the external topic to inference requests on the pipeline inputs topic.
+ * A sink adapter converts inference responses on the pipeline outputs topic to records on the external topic.
+ * Records that cannot be converted are logged and dropped.
+ */
+class KafkaAdapterStep(
+    builder: StreamsBuilder,
+    internal val inputTopic: TopicForPipeline,
+    internal val outputTopic: TopicForPipeline,
+    internal val pipelineName: String,
+    internal val adapter: KafkaAdapter,
+    internal val pipelineVersion: Int? = null,
+) : PipelineStep {
+    init {
+        when (adapter.direction) {
+            KafkaAdapter.Direction.SINK -> buildSinkStream(builder)
+            else -> buildSourceStream(builder)
+        }
+    }
+
+    private fun buildSourceStream(builder: StreamsBuilder) {
+        builder
+            .stream(inputTopic.topicName, externalConsumerSerde)
+            .flatMapValues { value ->
+                try {
+                    listOf(decodeExternalRecord(value, adapter.format, adapter.tensorsList).toByteArray())
+                } catch (e: Exception) {
+                    logger.warn("failed to convert record from ${inputTopic.topicName}: ${e.message}")
+                    emptyList()
+                }
+            }
+            // The key of a pipeline request is its request id, which external keys can not be as they may repeat
+            .selectKey { _, _ -> UUID.randomUUID().toString() }
+            .headerSetter(pipelineName)
+            .to(outputTopic.topicName, producerSerde)
+    }
+
+    private fun buildSinkStream(builder: StreamsBuilder) {
+        builder
+            .stream(inputTopic.topicName, consumerSerde)
+            .filterForPipeline(inputTopic.pipelineName, inputTopic.versionFilter(pipelineName, pipelineVersion))
+            .transformValues(ValueTransformerSupplier { PipelineErrorFilter() })
+            .filterNot { _, value -> value == null }
+            .flatMapValues { value ->
+                try {
+                    val response = ModelInferResponse.parseFrom(value)
+                    listOf(encodeExternalRecord(response, adapter.format, adapter.tensorsList))
+                } catch (e: Exception) {
+                    logger.warn("failed to convert record for ${outputTopic.topicName}: ${e.message}")
+                    emptyList()
+                }
+            }
+            .to(outputTopic.topicName, externalProducerSerde)
+    }
+
+    companion object {
+        private val logger = noCoLogger(KafkaAdapterStep::class)
+    }
+}
+
+/**
+ * Convert a record from an external topic to an inference request.
+ * JSON records are objects whose fields are tensors, CSV records are a single row of values
+ * and any other record is passed as a single BYTES tensor.
+ */
+internal fun decodeExternalRecord(
+    value: TRecord,
+    format: KafkaAdapter.Format,
+    tensors: List<KafkaAdapterTensor>,
+): ModelInferRequest {
+    val decoded = when (format) {
+        KafkaAdapter.Format.JSON -> decodeJsonRecord(value, tensors)
+        KafkaAdapter.Format.CSV -> decodeCsvRecord(value, tensors)
+        else -> {
+            val name = tensors.firstOrNull()?.name ?: defaultBytesTensor
+            listOf(TransformTensor(name, DataType.BYTES, listOf(1L), listOf(ByteString.copyFrom(value))))
+        }
+    }
+    return ModelInferRequest
+        .newBuilder()
+        .apply {
+            decoded.forEach { addInputs(encodeInputTensor(it)) }
+        }
+        .build()
+}
+
+private fun decodeJsonRecord(value: TRecord, tensors: List<KafkaAdapterTensor>): List<TransformTensor> {
+    val json = JsonParser.parseString(value.decodeToString())
+    if (!json.isJsonObject) {
+        throw TransformException("json records must be objects")
+    }
+    val fields = json.asJsonObject
+    if (tensors.isEmpty()) {
+        return fields.keySet().map { jsonTensor(it, fields[it], "") }
+    }
+    return tensors.map {
+        val field = fields[it.name] ?: throw TransformException("field ${it.name} not found")
+        val tensor = jsonTensor(it.name, field, it.datatype)
+        if (it.shapeCount > 0) reshape(tensor, it.name, it.shapeList) else tensor
+    }
+}
+
+private fun decodeCsvRecord(value: TRecord, tensors: List<KafkaAdapterTensor>): List<TransformTensor> {
+    val records = CSVParser.parse(value.decodeToString(), csvRecordFormat).use { it.records }
+    if (records.size != 1) {
+        throw TransformException("csv records must be a single row but found ${records.size}")
+    }
+    val columns = records.first().toList()
+    val specs = tensors.ifEmpty { listOf(KafkaAdapterTensor.newBuilder().setName(defaultBytesTensor).build()) }
+    // Without declared tensors the whole row is a single tensor
+    val sizes = if (tensors.isEmpty()) {
+        listOf(columns.size.toLong())
+    } else {
+        specs.map { spec -> spec.shapeList.fold(1L) { acc, dim -> acc * dim } }
+    }
+    if (sizes.sum() != columns.size.toLong()) {
+        throw TransformException("csv record has ${columns.size} columns but ${sizes.sum()} are expected")
+    }
+
+    var offset = 0
+    return specs.mapIndexed { idx, spec ->
+        val size = sizes[idx].toInt()
+        val datatype = if (spec.datatype.isEmpty()) DataType.FP64 else DataType.valueOf(spec.datatype)
+        val values = columns.subList(offset, offset + size).map { castValue(ByteString.copyFromUtf8(it), datatype) }
+        offset += size
+        val shape = if (spec.shapeCount > 0) spec.shapeList else listOf(size.toLong())
+        TransformTensor(spec.name, datatype, shape, values)
+    }
+}
+
+/**
+ * Convert an inference response to a record for an external topic, using the declared tensors
+ * or all of the outputs if none are declared.
+ */
+internal fun encodeExternalRecord(
+    response: ModelInferResponse,
+    format: KafkaAdapter.Format,
+    tensors: List<KafkaAdapterTensor>,
+): TRecord {
+    val outputs = decodeTensors(response)
+    val byName = outputs.associateBy { it.name }
+    val selected = if (tensors.isEmpty()) {
+        outputs
+    } else {
+        tensors.map { byName[it.name] ?: throw TransformException("tensor ${it.name} not found") }
+    }
+
+    return when (format) {
+        KafkaAdapter.Format.JSON -> JsonObject()
+            .apply {
+                selected.forEach { add(it.name, tensorToJson(it)) }
+            }
+            .toString()
+            .toByteArray()
+        KafkaAdapter.Format.CSV -> csvRecordFormat
+            .format(*selected.flatMap { it.values }.map { csvValue(it) }.toTypedArray())
+            .toByteArray()
+        else -> {
+            val tensor = selected.firstOrNull { it.datatype == DataType.BYTES }
+                ?: throw TransformException("no BYTES tensor found for bytes record")
+            (tensor.values.firstOrNull() as ByteString?)?.toByteArray()
+                ?: throw TransformException("tensor ${tensor.name} is empty")
+        }
+    }
+}
+
+private fun tensorToJson(tensor: TransformTensor): JsonElement {
+    val values = tensor.values.map { jsonValue(it) }
+    if (tensor.shape == listOf(1L) && values.size == 1) {
+        return values.first()
+    }
+    if (tensor.shape.isEmpty() || tensor.shape.fold(1L) { acc, dim -> acc * dim } != values.size.toLong()) {
+        return JsonArray().apply { values.forEach { add(it) } }
+    }
+    return nestJson(values, tensor.shape)
+}
+
+private fun nestJson(values: List<JsonElement>, shape: List<Long>): JsonArray {
+    return JsonArray().apply {
+        if (shape.size == 1) {
+            values.forEach { add(it) }
+        } else {
+            val chunk = values.size / shape.first().toInt()
+            values.chunked(chunk).forEach { add(nestJson(it, shape.drop(1))) }
+        }
+    }
+}
+
+private fun jsonValue(value: Any): JsonElement {
+    return when (value) {
+        is Boolean -> JsonPrimitive(value)
+        is Long -> JsonPrimitive(value)
+        is Double -> JsonPrimitive(value)
+        is ByteString -> JsonPrimitive(value.toStringUtf8())
+        else -> JsonPrimitive(value.toString())
+    }
+}
+
+private fun csvValue(value: Any): String {
+    return when (value) {
+        is ByteString -> value.toStringUtf8()
+        else -> value.toString()
+    }
+}
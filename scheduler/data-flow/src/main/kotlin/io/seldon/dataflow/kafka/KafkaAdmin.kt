@@ -16,10 +16,16 @@ import com.github.michaelbull.retry.policy.limitAttempts
 import com.github.michaelbull.retry.policy.plus
 import com.github.michaelbull.retry.retry
 import io.seldon.mlops.chainer.ChainerOuterClass.PipelineStepUpdate
+import io.seldon.mlops.chainer.ChainerOuterClass.PipelineTopicConfig
 import org.apache.kafka.clients.admin.Admin
+import org.apache.kafka.clients.admin.AlterConfigOp
+import org.apache.kafka.clients.admin.ConfigEntry
 import org.apache.kafka.clients.admin.CreateTopicsOptions
+import org.apache.kafka.clients.admin.NewPartitions
 import org.apache.kafka.clients.admin.NewTopic
 import org.apache.kafka.common.KafkaFuture
+import org.apache.kafka.common.config.ConfigResource
+import org.apache.kafka.common.config.TopicConfig
 import org.apache.kafka.common.errors.TimeoutException
 import org.apache.kafka.common.errors.TopicExistsException
 import org.apache.kafka.common.errors.UnknownTopicOrPartitionException
@@ -34,9 +40,18 @@ class KafkaAdmin(
 ) {
     private val adminClient = Admin.create(adminConfig)
 
+    /**
+     * Creates the topics of the steps. The pipeline topic config, if any, applies to the pipeline's own input and
+     * output topics and is also reconciled for those topics if they already exist.
+     */
     suspend fun ensureTopicsExist(
         steps: List<PipelineStepUpdate>,
+        pipelineName: String,
+        topicConfig: PipelineTopicConfig?,
     ) : Exception? {
+        val isPipelineTopic = { topicName: String ->
+            topicName.endsWith(".pipeline.$pipelineName.inputs") || topicName.endsWith(".pipeline.$pipelineName.outputs")
+        }
         val missingTopicRetryPolicy: RetryPolicy<Throwable> = {
             when (reason.cause) {
                 is TimeoutException,
@@ -52,19 +67,24 @@ class KafkaAdmin(
             steps
                 .flatMap { step -> step.sourcesList + step.sink + step.triggersList }
                 .map { topicName -> parseSource(topicName).first }
+                // low priority requests may be sent to models, so their low priority input topics must exist too
+                .flatMap { topicName ->
+                    if (isModelInputsTopic(topicName)) listOf(topicName, lowPriorityTopic(topicName)) else listOf(topicName)
+                }
                 .toSet()
                 .also {
                     logger.info("Topics found are $it")
                 }
                 .map { topicName ->
+                    val config = if (isPipelineTopic(topicName)) topicConfig else null
                     NewTopic(
                         topicName,
-                        streamsConfig.numPartitions,
-                        streamsConfig.replicationFactor.toShort(),
+                        config?.takeIf { it.hasPartitions() }?.partitions ?: streamsConfig.numPartitions,
+                        (config?.takeIf { it.hasReplicationFactor() }?.replicationFactor ?: streamsConfig.replicationFactor).toShort(),
                     ).configs(
                         kafkaTopicConfig(
                             streamsConfig.maxMessageSizeBytes,
-                        ),
+                        ) + pipelineTopicSettings(config),
                     )
                 }
                 .run {
@@ -91,6 +111,11 @@ class KafkaAdmin(
                             .get(topicWaitRetryParams.describeTimeoutMillis, TimeUnit.MILLISECONDS)
                     }
                 }
+                .also { topicCreations ->
+                    if (topicConfig != null) {
+                        updateTopics(topicCreations.keys.filter(isPipelineTopic).toSet(), topicConfig)
+                    }
+                }
         } catch (e: Exception) {
             // we catch all exceptions here and return them instead, because we want to handle
             // errors as part of programming logic, instead of them bubbling up to the scheduler
@@ -103,6 +128,51 @@ class KafkaAdmin(
         return null
     }
 
+    /**
+     * Topics may exist from an earlier version of the pipeline, so increase their partitions and set their config to
+     * match. Partitions are never reduced and the replication factor is left unchanged.
+     */
+    private suspend fun updateTopics(topicNames: Set<String>, topicConfig: PipelineTopicConfig) {
+        if (topicNames.isEmpty()) {
+            return
+        }
+        val timeoutMillis = topicWaitRetryParams.createTimeoutMillis.toLong()
+        if (topicConfig.hasPartitions()) {
+            val increases = adminClient.describeTopics(topicNames).allTopicNames()
+                .get(topicWaitRetryParams.describeTimeoutMillis, TimeUnit.MILLISECONDS)
+                .filterValues { description -> description.partitions().size < topicConfig.partitions }
+                .mapValues { NewPartitions.increaseTo(topicConfig.partitions) }
+            if (increases.isNotEmpty()) {
+                adminClient.createPartitions(increases).all().get(timeoutMillis, TimeUnit.MILLISECONDS)
+                increases.keys.forEach { topicName ->
+                    logger.warn("Increased partitions of topic $topicName to ${topicConfig.partitions}, messages with the same key may now be read out of order")
+                }
+            }
+        }
+        val settings = pipelineTopicSettings(topicConfig)
+        if (settings.isNotEmpty()) {
+            val ops = settings.map { (name, value) -> AlterConfigOp(ConfigEntry(name, value), AlterConfigOp.OpType.SET) }
+            adminClient
+                .incrementalAlterConfigs(topicNames.associate { ConfigResource(ConfigResource.Type.TOPIC, it) to ops })
+                .all()
+                .get(timeoutMillis, TimeUnit.MILLISECONDS)
+        }
+    }
+
+    private fun pipelineTopicSettings(topicConfig: PipelineTopicConfig?): Map<String, String> {
+        val settings = mutableMapOf<String, String>()
+        if (topicConfig?.hasRetentionMs() == true) {
+            settings[TopicConfig.RETENTION_MS_CONFIG] = topicConfig.retentionMs.toString()
+        }
+        if (topicConfig?.hasCleanupPolicy() == true) {
+            settings[TopicConfig.CLEANUP_POLICY_CONFIG] = topicConfig.cleanupPolicy
+        }
+        if (topicConfig?.hasCompressionType() == true) {
+            settings[TopicConfig.COMPRESSION_TYPE_CONFIG] = topicConfig.compressionType
+        }
+        return settings
+    }
+
     companion object {
         private val logger = coLogger(KafkaAdmin::class)
     }
@@ -185,6 +185,10 @@ class Pipeline(
                         it.triggersJoinTy,
                         it.batch,
                         kafkaDomainParams,
+                        it.transformsList,
+                        if (it.hasForEach()) it.forEach else null,
+                        metadata.version,
+                        if (it.hasKafkaAdapter()) it.kafkaAdapter else null,
                     )
                 }
             val topology = builder.build()
@@ -0,0 +1,32 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package io.seldon.dataflow.kafka
+
+import java.util.concurrent.ConcurrentHashMap
+
+/**
+ * The pipeline versions running as canaries, as told by the scheduler. While a canary runs alongside the stable
+ * version, messages without a version header are only processed by the stable version so they are not duplicated.
+ */
+object PipelineCanaries {
+    private val canaries = ConcurrentHashMap.newKeySet<Pair<String, Int>>()
+
+    fun set(pipelineName: String, pipelineVersion: Int, canary: Boolean) {
+        if (canary) {
+            canaries.add(pipelineName to pipelineVersion)
+        } else {
+            canaries.remove(pipelineName to pipelineVersion)
+        }
+    }
+
+    fun isCanary(pipelineName: String, pipelineVersion: Int): Boolean {
+        return canaries.contains(pipelineName to pipelineVersion)
+    }
+}
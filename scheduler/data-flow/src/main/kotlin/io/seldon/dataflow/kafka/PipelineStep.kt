@@ -9,10 +9,14 @@ the Change License after the Change Date as each is defined in accordance with t
 
 package io.seldon.dataflow.kafka
 
+import io.seldon.dataflow.kafka.headers.SeldonHeaders
 import io.seldon.mlops.chainer.ChainerOuterClass.Batch
+import io.seldon.mlops.chainer.ChainerOuterClass.ForEach
+import io.seldon.mlops.chainer.ChainerOuterClass.KafkaAdapter
 import io.seldon.mlops.chainer.ChainerOuterClass.PipelineStepUpdate.PipelineJoinType
 import io.seldon.mlops.chainer.ChainerOuterClass.PipelineTensorMapping
 import io.seldon.mlops.chainer.ChainerOuterClass.PipelineTopic
+import io.seldon.mlops.chainer.ChainerOuterClass.PipelineTransform
 import org.apache.kafka.streams.StreamsBuilder
 
 interface PipelineStep
@@ -27,6 +31,30 @@ data class TopicForPipeline(
     val pipelineName: String,
 )
 
+fun TopicForPipeline.isPipelineOutputs(): Boolean {
+    return topicName.endsWith(".pipeline.$pipelineName.outputs")
+}
+
+fun isModelInputsTopic(topicName: TopicName): Boolean {
+    return ".model." in topicName && topicName.endsWith(".inputs")
+}
+
+/**
+ * Low priority requests for a model are read by the model gateway from a separate topic, so bulk traffic does not
+ * delay real-time traffic.
+ */
+fun lowPriorityTopic(topicName: TopicName): TopicName {
+    return "$topicName.${SeldonHeaders.priorityLow}"
+}
+
+/**
+ * The version of the step's own pipeline to filter on when reading this topic.
+ * Topics of other pipelines are not filtered by version.
+ */
+fun TopicForPipeline.versionFilter(pipelineName: String, pipelineVersion: Int?): Int? {
+    return if (this.pipelineName == pipelineName) pipelineVersion else null
+}
+
 fun stepFor(
     builder: StreamsBuilder,
     pipelineName: String,
@@ -38,7 +66,22 @@ fun stepFor(
     triggerJoinType: PipelineJoinType,
     batchProperties: Batch,
     kafkaDomainParams: KafkaDomainParams,
+    transforms: List<PipelineTransform> = emptyList(),
+    forEach: ForEach? = null,
+    pipelineVersion: Int? = null,
+    kafkaAdapter: KafkaAdapter? = null,
 ): PipelineStep? {
+    if (kafkaAdapter != null) {
+        val source = sources.firstOrNull() ?: return null
+        return KafkaAdapterStep(
+            builder,
+            TopicForPipeline(topicName = source.topicName, pipelineName = source.pipelineName),
+            TopicForPipeline(topicName = sink.topicName, pipelineName = sink.pipelineName),
+            pipelineName,
+            kafkaAdapter,
+            pipelineVersion,
+        )
+    }
     val triggerTopicsToTensors = parseTriggers(triggerSources)
     return when (val result = parseSources(sources)) {
         is SourceProjection.Empty -> null
@@ -53,7 +96,10 @@ fun stepFor(
             kafkaDomainParams,
             triggerTopicsToTensors.keys,
             triggerJoinType,
-            triggerTopicsToTensors
+            triggerTopicsToTensors,
+            transforms,
+            forEach,
+            pipelineVersion,
         )
         is SourceProjection.SingleSubset -> Chainer(
             builder,
@@ -66,7 +112,10 @@ fun stepFor(
             kafkaDomainParams,
             triggerTopicsToTensors.keys,
             triggerJoinType,
-            triggerTopicsToTensors
+            triggerTopicsToTensors,
+            transforms,
+            forEach,
+            pipelineVersion,
         )
         is SourceProjection.Many -> Joiner(
             builder,
@@ -79,7 +128,10 @@ fun stepFor(
             joinType,
             triggerTopicsToTensors.keys,
             triggerJoinType,
-            triggerTopicsToTensors
+            triggerTopicsToTensors,
+            transforms,
+            forEach,
+            pipelineVersion,
         )
         is SourceProjection.ManySubsets -> Joiner(
             builder,
@@ -92,7 +144,10 @@ fun stepFor(
             joinType,
             triggerTopicsToTensors.keys,
             triggerJoinType,
-            triggerTopicsToTensors
+            triggerTopicsToTensors,
+            transforms,
+            forEach,
+            pipelineVersion,
         )
     }
 }
@@ -11,7 +11,10 @@ package io.seldon.dataflow.kafka
 
 import io.seldon.dataflow.kafka.headers.PipelineNameFilter
 import io.seldon.dataflow.kafka.headers.AlibiDetectRemover
+import io.seldon.dataflow.kafka.headers.ForEachElementFilter
 import io.seldon.dataflow.kafka.headers.PipelineHeaderSetter
+import io.seldon.dataflow.kafka.headers.PriorityLaneExtractor
+import io.seldon.dataflow.kafka.headers.ReplaySinkExtractor
 import io.seldon.mlops.chainer.ChainerOuterClass.PipelineTensorMapping
 import io.seldon.mlops.chainer.ChainerOuterClass.Batch
 import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferRequest
@@ -19,9 +22,20 @@ import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferResponse
 import org.apache.kafka.streams.kstream.KStream
 import org.apache.kafka.streams.kstream.ValueTransformerSupplier
 
-fun <T> KStream<T, TRecord>.filterForPipeline(pipelineName: String): KStream<T, TRecord> {
+fun <T> KStream<T, TRecord>.filterForPipeline(pipelineName: String, pipelineVersion: Int? = null): KStream<T, TRecord> {
     return this
-        .transformValues(ValueTransformerSupplier { PipelineNameFilter(pipelineName) })
+        .transformValues(ValueTransformerSupplier { PipelineNameFilter(pipelineName, pipelineVersion) })
+        .filterNot { _, value -> value == null }
+        // per-element messages of a forEach step are only consumed by its gather stream
+        .transformValues(ValueTransformerSupplier { ForEachElementFilter(false) })
+        .filterNot { _, value -> value == null }
+}
+
+fun <T> KStream<T, TRecord>.filterForPipelineElements(pipelineName: String, pipelineVersion: Int? = null): KStream<T, TRecord> {
+    return this
+        .transformValues(ValueTransformerSupplier { PipelineNameFilter(pipelineName, pipelineVersion) })
+        .filterNot { _, value -> value == null }
+        .transformValues(ValueTransformerSupplier { ForEachElementFilter(true) })
         .filterNot { _, value -> value == null }
 }
 
@@ -35,6 +49,20 @@ fun <T> KStream<T, TRecord>.headerSetter(pipelineName: String): KStream<T, TReco
         .transformValues(ValueTransformerSupplier { PipelineHeaderSetter(pipelineName) })
 }
 
+/**
+ * Writes a step to its sink topic. Pipeline outputs of replayed requests are written to their replay sink instead
+ * and low priority requests for models to the low priority input topic of the model.
+ */
+fun KStream<RequestId, TRecord>.toSink(sink: TopicForPipeline) {
+    if (sink.isPipelineOutputs()) {
+        this.to(ReplaySinkExtractor(sink.topicName), producerSerde)
+    } else if (isModelInputsTopic(sink.topicName)) {
+        this.to(PriorityLaneExtractor(sink.topicName), producerSerde)
+    } else {
+        this.to(sink.topicName, producerSerde)
+    }
+}
+
 fun <T> KStream<T, ByteArray>.unmarshallInferenceV2Response(): KStream<T, ModelInferResponse> {
     return this
         .mapValues { bytes -> ModelInferResponse.parseFrom(bytes) }
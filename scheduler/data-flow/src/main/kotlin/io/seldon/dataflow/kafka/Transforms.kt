@@ -0,0 +1,391 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package io.seldon.dataflow.kafka
+
+import com.google.gson.JsonArray
+import com.google.gson.JsonElement
+import com.google.gson.JsonParser
+import com.google.protobuf.ByteString
+import io.klogging.noCoLogger
+import io.seldon.mlops.chainer.ChainerOuterClass.PipelineTransform
+import io.seldon.mlops.chainer.ChainerOuterClass.PipelineTransform.TransformType
+import io.seldon.mlops.inference.v2.V2Dataplane.InferTensorContents
+import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferRequest
+import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferResponse
+import org.apache.kafka.streams.kstream.KStream
+import java.nio.ByteBuffer
+import java.nio.ByteOrder
+
+private val logger = noCoLogger(TransformException::class)
+
+private val transformByteOrder = ByteOrder.LITTLE_ENDIAN
+
+/**
+ * A tensor with decoded values, used while applying built-in transforms.
+ * Values are held as Boolean for BOOL, Long for integer types, Double for floating point types
+ * and ByteString for BYTES.
+ */
+internal data class TransformTensor(
+    val name: TensorName,
+    val datatype: DataType,
+    val shape: List<Long>,
+    val values: List<Any>,
+)
+
+class TransformException(message: String) : Exception(message)
+
+/**
+ * Apply the built-in transforms for a step, in order, to a stream of marshalled inference responses.
+ * Records for which a transform fails are logged and dropped.
+ */
+fun <T> KStream<T, TRecord>.applyTransforms(transforms: List<PipelineTransform>): KStream<T, TRecord> {
+    if (transforms.isEmpty()) {
+        return this
+    }
+    return this
+        .flatMapValues { value ->
+            try {
+                val response = ModelInferResponse.parseFrom(value)
+                listOf(applyTransforms(response, transforms).toByteArray())
+            } catch (e: Exception) {
+                logger.warn("failed to apply transforms: ${e.message}")
+                emptyList()
+            }
+        }
+}
+
+internal fun applyTransforms(response: ModelInferResponse, transforms: List<PipelineTransform>): ModelInferResponse {
+    var tensors = decodeTensors(response)
+    transforms.forEach { tensors = applyTransform(tensors, it) }
+
+    return ModelInferResponse
+        .newBuilder()
+        .setId(response.id)
+        .setModelName(response.modelName)
+        .setModelVersion(response.modelVersion)
+        .putAllParameters(response.parametersMap)
+        .apply {
+            tensors.forEach { addOutputs(encodeTensor(it)) }
+        }
+        .build()
+}
+
+private fun applyTransform(tensors: List<TransformTensor>, transform: PipelineTransform): List<TransformTensor> {
+    val byName = tensors.associateBy { it.name }
+    val inputs = transform.tensorsList.map {
+        byName[it] ?: throw TransformException("tensor $it not found for ${transform.type} transform")
+    }
+
+    return when (transform.type) {
+        TransformType.CONCAT -> tensors + concat(inputs, transform.axis.toInt(), transform.getOutputs(0))
+        TransformType.SPLIT -> tensors + split(inputs.first(), transform.axis.toInt(), transform.outputsList)
+        TransformType.SELECT -> inputs
+        TransformType.CAST -> {
+            val cast = inputs.mapIndexed { idx, tensor ->
+                val name = if (transform.outputsCount > 0) transform.getOutputs(idx) else tensor.name
+                cast(tensor, name, DataType.valueOf(transform.datatype))
+            }
+            replaceOrAppend(tensors, cast)
+        }
+        TransformType.RESHAPE -> {
+            val name = if (transform.outputsCount > 0) transform.getOutputs(0) else inputs.first().name
+            replaceOrAppend(tensors, listOf(reshape(inputs.first(), name, transform.shapeList)))
+        }
+        TransformType.CONSTANT -> {
+            val datatype = DataType.valueOf(transform.datatype)
+            val values = transform.valuesList.map { castValue(ByteString.copyFromUtf8(it), datatype) }
+            replaceOrAppend(tensors, listOf(TransformTensor(transform.getOutputs(0), datatype, transform.shapeList, values)))
+        }
+        TransformType.JSONPATH ->
+            replaceOrAppend(tensors, listOf(jsonPath(inputs.first(), transform.getOutputs(0), transform.path, transform.datatype)))
+        else -> throw TransformException("unknown transform type ${transform.type}")
+    }
+}
+
+private fun replaceOrAppend(tensors: List<TransformTensor>, updated: List<TransformTensor>): List<TransformTensor> {
+    val updatedByName = updated.associateBy { it.name }
+    val replaced = tensors.map { updatedByName[it.name] ?: it }
+    val existing = tensors.map { it.name }.toSet()
+    return replaced + updated.filter { it.name !in existing }
+}
+
+internal fun concat(tensors: List<TransformTensor>, axis: Int, output: TensorName): TransformTensor {
+    val first = tensors.first()
+    tensors.forEach {
+        if (it.datatype != first.datatype) {
+            throw TransformException("cannot concat tensors with datatypes ${first.datatype} and ${it.datatype}")
+        }
+        if (it.shape.size != first.shape.size || axis >= it.shape.size) {
+            throw TransformException("cannot concat tensor ${it.name} with shape ${it.shape} on axis $axis")
+        }
+        if (it.shape.filterIndexed { idx, _ -> idx != axis } != first.shape.filterIndexed { idx, _ -> idx != axis }) {
+            throw TransformException("cannot concat tensor ${it.name} with shape ${it.shape} to shape ${first.shape}")
+        }
+    }
+
+    val outer = first.shape.take(axis).fold(1L) { acc, dim -> acc * dim }
+    val values = mutableListOf<Any>()
+    for (i in 0 until outer) {
+        tensors.forEach {
+            val chunk = (it.values.size / outer).toInt()
+            values.addAll(it.values.subList((i * chunk).toInt(), ((i + 1) * chunk).toInt()))
+        }
+    }
+    val shape = first.shape.toMutableList()
+    shape[axis] = tensors.sumOf { it.shape[axis] }
+    return TransformTensor(output, first.datatype, shape, values)
+}
+
+internal fun split(tensor: TransformTensor, axis: Int, outputs: List<TensorName>): List<TransformTensor> {
+    if (axis >= tensor.shape.size || tensor.shape[axis] % outputs.size != 0L) {
+        throw TransformException("cannot split tensor ${tensor.name} with shape ${tensor.shape} into ${outputs.size} on axis $axis")
+    }
+
+    val outer = tensor.shape.take(axis).fold(1L) { acc, dim -> acc * dim }.toInt()
+    val chunk = tensor.values.size / outer
+    val part = chunk / outputs.size
+    val shape = tensor.shape.toMutableList()
+    shape[axis] = tensor.shape[axis] / outputs.size
+    return outputs.mapIndexed { idx, name ->
+        val values = (0 until outer).flatMap { i ->
+            tensor.values.subList(i * chunk + idx * part, i * chunk + (idx + 1) * part)
+        }
+        TransformTensor(name, tensor.datatype, shape.toList(), values)
+    }
+}
+
+internal fun reshape(tensor: TransformTensor, name: TensorName, shape: List<Long>): TransformTensor {
+    val known = shape.filter { it != -1L }.fold(1L) { acc, dim -> acc * dim }
+    val size = tensor.values.size.toLong()
+    val newShape = shape.map { if (it == -1L && known > 0) size / known else it }
+    if (newShape.fold(1L) { acc, dim -> acc * dim } != size) {
+        throw TransformException("cannot reshape tensor ${tensor.name} with shape ${tensor.shape} to $shape")
+    }
+    return TransformTensor(name, tensor.datatype, newShape, tensor.values)
+}
+
+private fun cast(tensor: TransformTensor, name: TensorName, datatype: DataType): TransformTensor {
+    return TransformTensor(name, datatype, tensor.shape, tensor.values.map { castValue(it, datatype) })
+}
+
+internal fun castValue(value: Any, datatype: DataType): Any {
+    return when (datatype) {
+        DataType.BOOL -> when (value) {
+            is Boolean -> value
+            is Long -> value != 0L
+            is Double -> value != 0.0
+            is ByteString -> value.toStringUtf8().trim().toBooleanStrict()
+            else -> throw TransformException("cannot cast $value to $datatype")
+        }
+        DataType.UINT8, DataType.UINT16, DataType.UINT32, DataType.UINT64,
+        DataType.INT8, DataType.INT16, DataType.INT32, DataType.INT64 -> when (value) {
+            is Boolean -> if (value) 1L else 0L
+            is Long -> value
+            is Double -> value.toLong()
+            is ByteString -> value.toStringUtf8().trim().toLong()
+            else -> throw TransformException("cannot cast $value to $datatype")
+        }
+        DataType.FP32, DataType.FP64 -> when (value) {
+            is Boolean -> if (value) 1.0 else 0.0
+            is Long -> value.toDouble()
+            is Double -> value
+            is ByteString -> value.toStringUtf8().trim().toDouble()
+            else -> throw TransformException("cannot cast $value to $datatype")
+        }
+        DataType.BYTES -> when (value) {
+            is ByteString -> value
+            else -> ByteString.copyFromUtf8(value.toString())
+        }
+        DataType.FP16 -> throw TransformException("cannot cast to FP16")
+    }
+}
+
+/**
+ * Extract a value from the JSON held in the first element of a BYTES tensor.
+ * Supports paths of object keys and array indices, e.g. $.a.b[0] or $['a'].
+ * If no datatype is given it is inferred from the extracted value.
+ */
+private fun jsonPath(tensor: TransformTensor, name: TensorName, path: String, datatype: String): TransformTensor {
+    val json = when (val first = tensor.values.firstOrNull()) {
+        is ByteString -> JsonParser.parseString(first.toStringUtf8())
+        else -> throw TransformException("jsonPath requires a BYTES tensor but ${tensor.name} is ${tensor.datatype}")
+    }
+
+    var element: JsonElement = json
+    parseJsonPath(path).forEach { segment ->
+        element = when {
+            segment is Int && element.isJsonArray && segment < element.asJsonArray.size() -> element.asJsonArray[segment]
+            segment is String && element.isJsonObject && element.asJsonObject.has(segment) -> element.asJsonObject[segment]
+            else -> throw TransformException("path $path not found in tensor ${tensor.name}")
+        }
+    }
+
+    return jsonTensor(name, element, datatype)
+}
+
+/**
+ * Convert a JSON value to a tensor, taking the shape from any nested arrays.
+ * If no datatype is given it is inferred from the leaf values.
+ */
+internal fun jsonTensor(name: TensorName, element: JsonElement, datatype: String): TransformTensor {
+    val shape = mutableListOf<Long>()
+    val leaves = flattenJson(element, shape, 0)
+    val targetType = when {
+        datatype.isNotEmpty() -> DataType.valueOf(datatype)
+        leaves.all { it.isJsonPrimitive && it.asJsonPrimitive.isBoolean } -> DataType.BOOL
+        leaves.all { it.isJsonPrimitive && it.asJsonPrimitive.isNumber } -> DataType.FP64
+        else -> DataType.BYTES
+    }
+    val values = leaves.map {
+        val value: Any = when {
+            it.isJsonPrimitive && it.asJsonPrimitive.isBoolean -> it.asBoolean
+            it.isJsonPrimitive && it.asJsonPrimitive.isNumber -> it.asDouble
+            it.isJsonPrimitive -> ByteString.copyFromUtf8(it.asString)
+            else -> ByteString.copyFromUtf8(it.toString())
+        }
+        castValue(value, targetType)
+    }
+    return TransformTensor(name, targetType, if (shape.isEmpty()) listOf(1L) else shape, values)
+}
+
+private fun parseJsonPath(path: String): List<Any> {
+    val segments = mutableListOf<Any>()
+    val pattern = Regex("""\.([^.\[\]]+)|\[(\d+)]|\['([^']*)']""")
+    var idx = 1
+    while (idx < path.length) {
+        val match = pattern.matchAt(path, idx) ?: throw TransformException("invalid json path $path")
+        val (key, index, quoted) = match.destructured
+        segments.add(
+            when {
+                index.isNotEmpty() -> index.toInt()
+                quoted.isNotEmpty() -> quoted
+                else -> key
+            }
+        )
+        idx = match.range.last + 1
+    }
+    return segments
+}
+
+private fun flattenJson(element: JsonElement, shape: MutableList<Long>, depth: Int): List<JsonElement> {
+    if (!element.isJsonArray) {
+        return listOf(element)
+    }
+    val array: JsonArray = element.asJsonArray
+    if (shape.size == depth) {
+        shape.add(array.size().toLong())
+    } else if (shape[depth] != array.size().toLong()) {
+        throw TransformException("json arrays must be rectangular")
+    }
+    return array.flatMap { flattenJson(it, shape, depth + 1) }
+}
+
+internal fun decodeTensors(response: ModelInferResponse): List<TransformTensor> {
+    return response.outputsList.mapIndexed { idx, output ->
+        val datatype = DataType.valueOf(output.datatype)
+        val values = if (idx < response.rawOutputContentsCount) {
+            decodeRawContents(response.getRawOutputContents(idx), datatype)
+        } else {
+            decodeContents(output.contents, datatype)
+        }
+        TransformTensor(output.name, datatype, output.shapeList, values)
+    }
+}
+
+internal fun decodeTensors(request: ModelInferRequest): List<TransformTensor> {
+    return request.inputsList.mapIndexed { idx, input ->
+        val datatype = DataType.valueOf(input.datatype)
+        val values = if (idx < request.rawInputContentsCount) {
+            decodeRawContents(request.getRawInputContents(idx), datatype)
+        } else {
+            decodeContents(input.contents, datatype)
+        }
+        TransformTensor(input.name, datatype, input.shapeList, values)
+    }
+}
+
+private fun decodeContents(contents: InferTensorContents, datatype: DataType): List<Any> {
+    return when (datatype) {
+        DataType.BOOL -> contents.boolContentsList
+        DataType.UINT8, DataType.UINT16, DataType.UINT32 -> contents.uintContentsList.map { it.toUInt().toLong() }
+        DataType.UINT64 -> contents.uint64ContentsList
+        DataType.INT8, DataType.INT16, DataType.INT32 -> contents.intContentsList.map { it.toLong() }
+        DataType.INT64 -> contents.int64ContentsList
+        DataType.FP32 -> contents.fp32ContentsList.map { it.toDouble() }
+        DataType.FP64 -> contents.fp64ContentsList
+        DataType.BYTES -> contents.bytesContentsList
+        DataType.FP16 -> throw TransformException("FP16 tensors are not supported by transforms")
+    }
+}
+
+private fun decodeRawContents(raw: ByteString, datatype: DataType): List<Any> {
+    val buffer = ByteBuffer.wrap(raw.toByteArray()).order(transformByteOrder)
+    val values = mutableListOf<Any>()
+    while (buffer.hasRemaining()) {
+        values.add(
+            when (datatype) {
+                DataType.BOOL -> buffer.get() != 0.toByte()
+                DataType.UINT8 -> buffer.get().toUByte().toLong()
+                DataType.UINT16 -> buffer.getShort().toUShort().toLong()
+                DataType.UINT32 -> buffer.getInt().toUInt().toLong()
+                DataType.UINT64 -> buffer.getLong()
+                DataType.INT8 -> buffer.get().toLong()
+                DataType.INT16 -> buffer.getShort().toLong()
+                DataType.INT32 -> buffer.getInt().toLong()
+                DataType.INT64 -> buffer.getLong()
+                DataType.FP32 -> buffer.getFloat().toDouble()
+                DataType.FP64 -> buffer.getDouble()
+                DataType.BYTES -> {
+                    val bytes = ByteArray(buffer.getInt())
+                    buffer.get(bytes)
+                    ByteString.copyFrom(bytes)
+                }
+                DataType.FP16 -> throw TransformException("FP16 tensors are not supported by transforms")
+            }
+        )
+    }
+    return values
+}
+
+internal fun encodeTensor(tensor: TransformTensor): ModelInferResponse.InferOutputTensor {
+    return ModelInferResponse.InferOutputTensor
+        .newBuilder()
+        .setName(tensor.name)
+        .setDatatype(tensor.datatype.name)
+        .addAllShape(tensor.shape)
+        .setContents(encodeContents(tensor))
+        .build()
+}
+
+internal fun encodeInputTensor(tensor: TransformTensor): ModelInferRequest.InferInputTensor {
+    return ModelInferRequest.InferInputTensor
+        .newBuilder()
+        .setName(tensor.name)
+        .setDatatype(tensor.datatype.name)
+        .addAllShape(tensor.shape)
+        .setContents(encodeContents(tensor))
+        .build()
+}
+
+private fun encodeContents(tensor: TransformTensor): InferTensorContents {
+    return InferTensorContents.newBuilder().apply {
+        when (tensor.datatype) {
+            DataType.BOOL -> addAllBoolContents(tensor.values.map { it as Boolean })
+            DataType.UINT8, DataType.UINT16, DataType.UINT32 -> addAllUintContents(tensor.values.map { (it as Long).toInt() })
+            DataType.UINT64 -> addAllUint64Contents(tensor.values.map { it as Long })
+            DataType.INT8, DataType.INT16, DataType.INT32 -> addAllIntContents(tensor.values.map { (it as Long).toInt() })
+            DataType.INT64 -> addAllInt64Contents(tensor.values.map { it as Long })
+            DataType.FP32 -> addAllFp32Contents(tensor.values.map { (it as Double).toFloat() })
+            DataType.FP64 -> addAllFp64Contents(tensor.values.map { it as Double })
+            DataType.BYTES -> addAllBytesContents(tensor.values.map { it as ByteString })
+            DataType.FP16 -> throw TransformException("FP16 tensors are not supported by transforms")
+        }
+    }.build()
+}
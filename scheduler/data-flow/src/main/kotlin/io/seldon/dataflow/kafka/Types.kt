@@ -9,6 +9,7 @@ the Change License after the Change Date as each is defined in accordance with t
 
 package io.seldon.dataflow.kafka
 
+import org.apache.kafka.common.serialization.Serde
 import org.apache.kafka.common.serialization.Serdes
 import org.apache.kafka.streams.kstream.Consumed
 import org.apache.kafka.streams.kstream.Produced
@@ -23,8 +24,9 @@ typealias RequestId = String
 typealias TRecord = ByteArray
 typealias TopicsAndTensors = Pair<Set<TopicName>, Set<TensorName>>
 
-val consumerSerde: Consumed<RequestId, TRecord> = Consumed.with(Serdes.String(), Serdes.ByteArray())
-val producerSerde: Produced<RequestId, TRecord> = Produced.with(Serdes.String(), Serdes.ByteArray())
+val wireFormatSerde: Serde<TRecord> = Serdes.serdeFrom(WireFormatSerializer(), WireFormatDeserializer())
+val consumerSerde: Consumed<RequestId, TRecord> = Consumed.with(Serdes.String(), wireFormatSerde)
+val producerSerde: Produced<RequestId, TRecord> = Produced.with(Serdes.String(), wireFormatSerde)
 val joinSerde: StreamJoined<RequestId, TRecord, TRecord> =
     StreamJoined.with(Serdes.String(), Serdes.ByteArray(), Serdes.ByteArray())
 
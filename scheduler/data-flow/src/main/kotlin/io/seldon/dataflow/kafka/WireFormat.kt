@@ -0,0 +1,202 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package io.seldon.dataflow.kafka
+
+import com.google.gson.JsonObject
+import com.google.gson.JsonParser
+import com.google.protobuf.Descriptors
+import io.klogging.noCoLogger
+import io.seldon.dataflow.kafka.headers.SeldonHeaders
+import io.seldon.mlops.inference.v2.V2Dataplane
+import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferRequest
+import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferResponse
+import org.apache.kafka.common.header.Headers
+import org.apache.kafka.common.serialization.Deserializer
+import org.apache.kafka.common.serialization.Serializer
+import java.io.ByteArrayOutputStream
+import java.net.URI
+import java.net.http.HttpClient
+import java.net.http.HttpRequest
+import java.net.http.HttpResponse
+import java.nio.ByteBuffer
+import java.util.*
+import java.util.concurrent.ConcurrentHashMap
+
+data class SchemaRegistryParams(
+    val url: String,
+    val basicAuthUserInfo: String,
+)
+
+fun interface SchemaIdProvider {
+    fun getSchemaId(subject: String): Int
+}
+
+/**
+ * Registers the V2 dataplane protobuf schema under a subject, returning the id of the schema.
+ * Registering an existing schema is idempotent in the registry and returns the existing id.
+ */
+class SchemaRegistryClient(private val params: SchemaRegistryParams) : SchemaIdProvider {
+    private val client = HttpClient.newHttpClient()
+
+    override fun getSchemaId(subject: String): Int {
+        val body = JsonObject().apply {
+            addProperty("schemaType", "PROTOBUF")
+            addProperty("schema", protobufSchema)
+        }
+        val request = HttpRequest.newBuilder()
+            .uri(URI.create("${params.url.trimEnd('/')}/subjects/$subject/versions"))
+            .header("Content-Type", "application/vnd.schemaregistry.v1+json")
+            .apply {
+                if (params.basicAuthUserInfo.isNotEmpty()) {
+                    val credentials = Base64.getEncoder().encodeToString(params.basicAuthUserInfo.toByteArray())
+                    header("Authorization", "Basic $credentials")
+                }
+            }
+            .POST(HttpRequest.BodyPublishers.ofString(body.toString()))
+            .build()
+        val response = client.send(request, HttpResponse.BodyHandlers.ofString())
+        if (response.statusCode() != 200) {
+            throw IllegalStateException("failed to register schema for subject $subject: ${response.body()}")
+        }
+        return JsonParser.parseString(response.body()).asJsonObject["id"].asInt
+    }
+
+    companion object {
+        private val protobufSchema: String =
+            Base64.getEncoder().encodeToString(V2Dataplane.getDescriptor().toProto().toByteArray())
+    }
+}
+
+/**
+ * Payloads on model and pipeline topics in the Confluent schema registry wire format: a zero magic byte,
+ * the 4 byte schema id, the message indexes and then the protobuf message.
+ * Payloads are only framed when a schema registry is configured, while framed payloads are always accepted.
+ */
+object WireFormat {
+    private const val MAGIC_BYTE: Byte = 0
+    private const val HEADER_LENGTH = 5
+    private const val SUBJECT_SUFFIX = "-value"
+
+    private val logger = noCoLogger(WireFormat::class)
+
+    @Volatile
+    private var registry: SchemaIdProvider? = null
+    private val headers = ConcurrentHashMap<TopicName, ByteArray>()
+
+    fun configure(registry: SchemaIdProvider?) {
+        this.registry = registry
+        headers.clear()
+    }
+
+    fun wrap(topic: TopicName, data: TRecord): TRecord {
+        val registry = this.registry ?: return data
+        val descriptor = getDescriptor(topic) ?: return data
+        return try {
+            val header = headers.getOrPut(topic) {
+                createHeader(registry.getSchemaId(topic + SUBJECT_SUFFIX), descriptor)
+            }
+            header + data
+        } catch (e: Exception) {
+            logger.warn(e, "failed to encode payload for topic {topic}, writing raw protobuf", topic)
+            data
+        }
+    }
+
+    fun unwrap(data: TRecord): TRecord {
+        if (data.size <= HEADER_LENGTH || data[0] != MAGIC_BYTE) {
+            return data
+        }
+        val buffer = ByteBuffer.wrap(data, HEADER_LENGTH, data.size - HEADER_LENGTH)
+        val count = readVarint(buffer) ?: return data
+        if (count < 0) {
+            return data
+        }
+        repeat(count.toInt()) {
+            readVarint(buffer) ?: return data
+        }
+        return data.copyOfRange(buffer.position(), data.size)
+    }
+
+    private fun getDescriptor(topic: TopicName): Descriptors.Descriptor? {
+        return when (topic.substringAfterLast(".")) {
+            "inputs" -> ModelInferRequest.getDescriptor()
+            "outputs" -> ModelInferResponse.getDescriptor()
+            else -> null
+        }
+    }
+
+    internal fun createHeader(schemaId: Int, descriptor: Descriptors.Descriptor): ByteArray {
+        val out = ByteArrayOutputStream()
+        out.write(MAGIC_BYTE.toInt())
+        out.write(ByteBuffer.allocate(4).putInt(schemaId).array())
+        val indexes = generateSequence(descriptor) { it.containingType }.map { it.index }.toList().reversed()
+        if (indexes == listOf(0)) {
+            out.write(0)
+        } else {
+            writeVarint(out, indexes.size.toLong())
+            indexes.forEach { writeVarint(out, it.toLong()) }
+        }
+        return out.toByteArray()
+    }
+
+    // Zigzag encoded varints, as written by the Confluent serializers
+    private fun writeVarint(out: ByteArrayOutputStream, value: Long) {
+        var v = (value shl 1) xor (value shr 63)
+        while (v and 0x7FL.inv() != 0L) {
+            out.write(((v and 0x7FL) or 0x80L).toInt())
+            v = v ushr 7
+        }
+        out.write(v.toInt())
+    }
+
+    private fun readVarint(buffer: ByteBuffer): Long? {
+        var result = 0L
+        var shift = 0
+        while (buffer.hasRemaining() && shift < 64) {
+            val b = buffer.get().toLong()
+            result = result or ((b and 0x7FL) shl shift)
+            if (b and 0x80L == 0L) {
+                return (result ushr 1) xor -(result and 1L)
+            }
+            shift += 7
+        }
+        return null
+    }
+}
+
+class WireFormatSerializer : Serializer<TRecord> {
+    override fun serialize(topic: String?, data: TRecord?): TRecord? {
+        if (topic == null || data == null) {
+            return data
+        }
+        return WireFormat.wrap(topic, data)
+    }
+
+    override fun serialize(topic: String?, headers: Headers?, data: TRecord?): TRecord? {
+        if (topic == null || data == null) {
+            return data
+        }
+        headers?.apply {
+            remove(SeldonHeaders.produceTime)
+            add(SeldonHeaders.produceTime, System.currentTimeMillis().toString().toByteArray())
+        }
+        return ClaimCheck.offload(topic, headers, WireFormat.wrap(topic, data))
+    }
+}
+
+class WireFormatDeserializer : Deserializer<TRecord> {
+    override fun deserialize(topic: String?, data: TRecord?): TRecord? {
+        return data?.let { WireFormat.unwrap(it) }
+    }
+
+    override fun deserialize(topic: String?, headers: Headers?, data: TRecord?): TRecord? {
+        return data?.let { WireFormat.unwrap(ClaimCheck.rehydrate(headers, it)) }
+    }
+}
@@ -22,6 +22,11 @@ class PipelineHeaderSetter(private val pipelineName: String) : ValueTransformer<
     }
 
     override fun transform(value: TRecord?): TRecord? {
+        // A version header routed to another pipeline does not apply to this one
+        val previousPipeline = this.context?.headers()?.lastHeader(SeldonHeaders.pipelineName)?.value()?.decodeToString()
+        if (previousPipeline != pipelineName) {
+            this.context?.headers()?.remove(SeldonHeaders.pipelineVersion)
+        }
         this.context?.headers()?.remove(SeldonHeaders.pipelineName)
         this.context?.headers()?.add(SeldonHeaders.pipelineName, pipelineName.toByteArray())
         return value
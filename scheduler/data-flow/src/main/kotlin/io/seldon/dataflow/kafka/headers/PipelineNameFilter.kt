@@ -9,11 +9,19 @@ the Change License after the Change Date as each is defined in accordance with t
 
 package io.seldon.dataflow.kafka.headers
 
+import io.seldon.dataflow.kafka.PipelineCanaries
 import io.seldon.dataflow.kafka.TRecord
 import org.apache.kafka.streams.kstream.ValueTransformer
 import org.apache.kafka.streams.processor.ProcessorContext
 
-class PipelineNameFilter(private val pipelineName: String) : ValueTransformer<TRecord, TRecord> {
+/**
+ * Keeps the messages of a pipeline. When a version is given, messages routed to another version of the
+ * pipeline are dropped, while messages without a version header are only processed by a version that is not a canary.
+ */
+class PipelineNameFilter(
+    private val pipelineName: String,
+    private val pipelineVersion: Int? = null,
+) : ValueTransformer<TRecord, TRecord> {
     var context: ProcessorContext? = null
 
     override fun init(context: ProcessorContext?) {
@@ -26,7 +34,16 @@ class PipelineNameFilter(private val pipelineName: String) : ValueTransformer<TR
             ?.headers(SeldonHeaders.pipelineName)
             ?.any { it.value().decodeToString() == pipelineName }
             ?: false
-        return if (shouldProcess) value else null
+        return if (shouldProcess && isForVersion()) value else null
+    }
+
+    private fun isForVersion(): Boolean {
+        if (pipelineVersion == null) return true
+        val version = context
+            ?.headers()
+            ?.lastHeader(SeldonHeaders.pipelineVersion)
+            ?: return !PipelineCanaries.isCanary(pipelineName, pipelineVersion)
+        return version.value().decodeToString() == pipelineVersion.toString()
     }
 
     override fun close() {}
@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package io.seldon.dataflow.kafka.headers
+
+import io.seldon.dataflow.kafka.RequestId
+import io.seldon.dataflow.kafka.TRecord
+import io.seldon.dataflow.kafka.TopicName
+import io.seldon.dataflow.kafka.isModelInputsTopic
+import io.seldon.dataflow.kafka.lowPriorityTopic
+import org.apache.kafka.streams.processor.RecordContext
+import org.apache.kafka.streams.processor.TopicNameExtractor
+
+/**
+ * Sends low priority requests to the low priority input topic of the model and all other requests to the default topic.
+ */
+class PriorityLaneExtractor(private val defaultTopic: TopicName) : TopicNameExtractor<RequestId, TRecord> {
+    private val lowPriorityTopic = if (isModelInputsTopic(defaultTopic)) lowPriorityTopic(defaultTopic) else defaultTopic
+
+    override fun extract(key: RequestId?, value: TRecord?, recordContext: RecordContext?): String {
+        val priority = recordContext
+            ?.headers()
+            ?.lastHeader(SeldonHeaders.priority)
+            ?.value()
+            ?.decodeToString()
+        return if (priority == SeldonHeaders.priorityLow) lowPriorityTopic else defaultTopic
+    }
+}
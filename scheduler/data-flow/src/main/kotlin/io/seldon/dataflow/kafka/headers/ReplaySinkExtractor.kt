@@ -0,0 +1,31 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package io.seldon.dataflow.kafka.headers
+
+import io.seldon.dataflow.kafka.RequestId
+import io.seldon.dataflow.kafka.TRecord
+import io.seldon.dataflow.kafka.TopicName
+import org.apache.kafka.streams.processor.RecordContext
+import org.apache.kafka.streams.processor.TopicNameExtractor
+
+/**
+ * Sends replayed requests to the sink topic named in their replay header and all other requests to the default topic.
+ */
+class ReplaySinkExtractor(private val defaultTopic: TopicName) : TopicNameExtractor<RequestId, TRecord> {
+    override fun extract(key: RequestId?, value: TRecord?, recordContext: RecordContext?): String {
+        return recordContext
+            ?.headers()
+            ?.lastHeader(SeldonHeaders.replaySink)
+            ?.value()
+            ?.decodeToString()
+            ?.takeIf { it.isNotBlank() }
+            ?: defaultTopic
+    }
+}
@@ -12,6 +12,30 @@ package io.seldon.dataflow.kafka.headers
 object SeldonHeaders {
     const val pipelineName = "pipeline"
 
+    // Set by Envoy when traffic is split between a stable and a canary version of a pipeline.
+    const val pipelineVersion = "x-seldon-pipeline-version"
+
+    // Set on replayed requests so the pipeline outputs go to a separate topic rather than the pipeline outputs topic.
+    const val replaySink = "x-seldon-replay-sink"
+
+    // Set on the per-element messages of a forEach step and removed once the element outputs are gathered.
+    const val forEachIndex = "x-seldon-foreach-index"
+    const val forEachCount = "x-seldon-foreach-count"
+
+    // Set by the model gateway on pipeline outputs carrying a model error rather than an inference response.
+    const val pipelineErrors = "seldon-pipeline-errors"
+
+    // Set on low priority requests, which are sent to the low priority input topics of models.
+    const val priority = "x-seldon-priority"
+    const val priorityLow = "low"
+
+    // Set in place of payloads above the claim check threshold, holding the object store key of the payload.
+    const val claimCheck = "seldon-claim-check"
+
+    // Set on every record produced, in unix milliseconds, as Kafka Streams keeps the timestamp of the input record.
+    // The model and pipeline gateways use it to measure how long records wait on each topic.
+    const val produceTime = "seldon-produce-time"
+
     // Remove headers we do not want transferred between topics.
     // These headers are set in MLServer Alibi-Detect and Alibi-Explain runtimes: https://github.com/SeldonIO/mlserver.
     val alibiDiscards = arrayOf(
@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package io.seldon.dataflow.kafka
+
+import io.seldon.dataflow.kafka.headers.SeldonHeaders
+import org.apache.kafka.common.header.internals.RecordHeaders
+import org.junit.jupiter.api.AfterEach
+import org.junit.jupiter.api.Test
+import org.junit.jupiter.api.assertThrows
+import org.junit.jupiter.api.io.TempDir
+import strikt.api.expectThat
+import strikt.assertions.*
+import java.io.File
+
+internal class ClaimCheckTest {
+    @TempDir
+    lateinit var dir: File
+
+    @AfterEach
+    fun reset() {
+        ClaimCheck.configure(null)
+    }
+
+    @Test
+    fun `should store large payloads and load them back`() {
+        ClaimCheck.configure(FileObjectStore(dir.path), 10)
+        val headers = RecordHeaders()
+        val data = "a".repeat(11).toByteArray()
+
+        val offloaded = ClaimCheck.offload("seldon.default.model.iris.outputs", headers, data)
+
+        expectThat(offloaded).isEmpty()
+        val key = headers.lastHeader(SeldonHeaders.claimCheck).value().decodeToString()
+        expectThat(key).startsWith("seldon.default.model.iris.outputs/")
+        expectThat(ClaimCheck.rehydrate(headers, offloaded)).contentEquals(data)
+        expectThat(headers.lastHeader(SeldonHeaders.claimCheck)).isNull()
+    }
+
+    @Test
+    fun `should leave small payloads inline`() {
+        ClaimCheck.configure(FileObjectStore(dir.path), 10)
+        val headers = RecordHeaders()
+        val data = "small".toByteArray()
+
+        expectThat(ClaimCheck.offload("seldon.default.model.iris.outputs", headers, data)).contentEquals(data)
+        expectThat(headers.lastHeader(SeldonHeaders.claimCheck)).isNull()
+    }
+
+    @Test
+    fun `should remove references copied from the input record`() {
+        val headers = RecordHeaders().add(SeldonHeaders.claimCheck, "seldon.default.model.iris.inputs/1".toByteArray())
+        val data = "small".toByteArray()
+
+        expectThat(ClaimCheck.offload("seldon.default.model.iris.outputs", headers, data)).contentEquals(data)
+        expectThat(headers.lastHeader(SeldonHeaders.claimCheck)).isNull()
+    }
+
+    @Test
+    fun `should fail to load references without a store`() {
+        val headers = RecordHeaders().add(SeldonHeaders.claimCheck, "seldon.default.model.iris.inputs/1".toByteArray())
+
+        assertThrows<IllegalStateException> { ClaimCheck.rehydrate(headers, ByteArray(0)) }
+    }
+
+    @Test
+    fun `should reject keys outside of the store directory`() {
+        val store = FileObjectStore(dir.path)
+
+        assertThrows<IllegalArgumentException> { store.get("../../etc/passwd") }
+    }
+}
@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package io.seldon.dataflow.kafka
+
+import io.seldon.mlops.inference.v2.V2Dataplane.InferTensorContents
+import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferRequest
+import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferRequest.InferInputTensor
+import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferResponse
+import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferResponse.InferOutputTensor
+import org.junit.jupiter.api.Test
+import strikt.api.expectThat
+import strikt.assertions.*
+
+internal class ForEachTest {
+    @Test
+    fun `should split request into elements and copy other tensors`() {
+        val request = ModelInferRequest.newBuilder()
+            .setId("1")
+            .addInputs(makeInput("boxes", listOf(3L, 2L), listOf(1F, 2F, 3F, 4F, 5F, 6F)))
+            .addInputs(makeInput("image", listOf(1L), listOf(9F)))
+            .build()
+
+        val elements = splitRequest(request, "boxes", 0)
+
+        expectThat(elements).hasSize(3)
+        expectThat(elements.map { it.getInputs(0).contents.fp32ContentsList })
+            .isEqualTo(listOf(listOf(1F, 2F), listOf(3F, 4F), listOf(5F, 6F)))
+        expectThat(elements.map { it.getInputs(0).shapeList }.distinct()).isEqualTo(listOf(listOf(1L, 2L)))
+        expectThat(elements.map { it.getInputs(1).contents.fp32ContentsList }.distinct()).isEqualTo(listOf(listOf(9F)))
+    }
+
+    @Test
+    fun `should gather element responses in order`() {
+        val responses = listOf(
+            makeResponse(listOf(1F, 2F)),
+            makeResponse(listOf(3F, 4F)),
+        )
+
+        val gathered = gatherResponses(responses, 0)
+
+        expectThat(gathered.id).isEqualTo("1")
+        expectThat(gathered.getOutputs(0).shapeList).isEqualTo(listOf(2L, 2L))
+        expectThat(gathered.getOutputs(0).contents.fp32ContentsList).isEqualTo(listOf(1F, 2F, 3F, 4F))
+    }
+
+    @Test
+    fun `should round trip state`() {
+        val state = ForEachState(
+            count = 3,
+            startedMs = 1234L,
+            pending = mutableListOf(2 to byteArrayOf(1, 2)),
+            gathered = sortedMapOf(0 to byteArrayOf(3), 1 to byteArrayOf()),
+        )
+
+        val bytes = state.toBytes()
+        val decoded = ForEachState.fromBytes(bytes)
+
+        expectThat(ForEachState.startedMs(bytes)).isEqualTo(1234L)
+        expectThat(decoded.count).isEqualTo(3)
+        expectThat(decoded.pending.map { it.first to it.second.toList() }).isEqualTo(listOf(2 to listOf<Byte>(1, 2)))
+        expectThat(decoded.gathered.mapValues { it.value.toList() })
+            .isEqualTo(mapOf(0 to listOf<Byte>(3), 1 to emptyList<Byte>()))
+    }
+
+    companion object {
+        private fun makeInput(name: String, shape: List<Long>, values: List<Float>): InferInputTensor {
+            return InferInputTensor.newBuilder()
+                .setName(name)
+                .setDatatype("FP32")
+                .addAllShape(shape)
+                .setContents(InferTensorContents.newBuilder().addAllFp32Contents(values))
+                .build()
+        }
+
+        private fun makeResponse(values: List<Float>): ModelInferResponse {
+            return ModelInferResponse.newBuilder()
+                .setId("1")
+                .addOutputs(
+                    InferOutputTensor.newBuilder()
+                        .setName("scores")
+                        .setDatatype("FP32")
+                        .addAllShape(listOf(1L, values.size.toLong()))
+                        .setContents(InferTensorContents.newBuilder().addAllFp32Contents(values))
+                )
+                .build()
+        }
+    }
+}
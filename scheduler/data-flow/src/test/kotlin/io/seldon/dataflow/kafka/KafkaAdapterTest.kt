@@ -0,0 +1,195 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package io.seldon.dataflow.kafka
+
+import com.google.protobuf.ByteString
+import io.seldon.mlops.chainer.ChainerOuterClass.KafkaAdapter.Format
+import io.seldon.mlops.chainer.ChainerOuterClass.KafkaAdapterTensor
+import io.seldon.mlops.inference.v2.V2Dataplane.InferTensorContents
+import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferResponse
+import org.junit.jupiter.api.Test
+import org.junit.jupiter.api.assertThrows
+import strikt.api.expectThat
+import strikt.assertions.*
+
+internal class KafkaAdapterTest {
+    @Test
+    fun `should decode json records with inferred tensors`() {
+        val request = decodeExternalRecord(
+            """{"a": [[1, 2], [3, 4]], "b": "hello", "c": true}""".toByteArray(),
+            Format.JSON,
+            emptyList(),
+        )
+
+        val tensors = decodeTensors(request).associateBy { it.name }
+        expectThat(tensors["a"]).isEqualTo(TransformTensor("a", DataType.FP64, listOf(2L, 2L), listOf(1.0, 2.0, 3.0, 4.0)))
+        expectThat(tensors["b"]).isEqualTo(TransformTensor("b", DataType.BYTES, listOf(1L), listOf(ByteString.copyFromUtf8("hello"))))
+        expectThat(tensors["c"]).isEqualTo(TransformTensor("c", DataType.BOOL, listOf(1L), listOf(true)))
+    }
+
+    @Test
+    fun `should decode json records with declared tensors`() {
+        val request = decodeExternalRecord(
+            """{"a": [1, 2, 3, 4], "ignored": 1}""".toByteArray(),
+            Format.JSON,
+            listOf(tensor("a", "INT64", 2, 2)),
+        )
+
+        expectThat(decodeTensors(request)).containsExactly(
+            TransformTensor("a", DataType.INT64, listOf(2L, 2L), listOf(1L, 2L, 3L, 4L)),
+        )
+    }
+
+    @Test
+    fun `should fail json records missing a declared tensor`() {
+        assertThrows<TransformException> {
+            decodeExternalRecord("""{"b": 1}""".toByteArray(), Format.JSON, listOf(tensor("a", "FP64")))
+        }
+    }
+
+    @Test
+    fun `should decode csv records into declared tensors`() {
+        val request = decodeExternalRecord(
+            "1.5, 2, 3,4\n".toByteArray(),
+            Format.CSV,
+            listOf(tensor("x", "FP32", 1, 3), tensor("y", "INT32")),
+        )
+
+        expectThat(decodeTensors(request)).containsExactly(
+            TransformTensor("x", DataType.FP32, listOf(1L, 3L), listOf(1.5, 2.0, 3.0)),
+            TransformTensor("y", DataType.INT32, listOf(1L), listOf(4L)),
+        )
+    }
+
+    @Test
+    fun `should decode csv records as a single tensor by default`() {
+        val request = decodeExternalRecord("1,2,3".toByteArray(), Format.CSV, emptyList())
+
+        expectThat(decodeTensors(request)).containsExactly(
+            TransformTensor("input", DataType.FP64, listOf(3L), listOf(1.0, 2.0, 3.0)),
+        )
+    }
+
+    @Test
+    fun `should fail csv records with the wrong number of columns`() {
+        assertThrows<TransformException> {
+            decodeExternalRecord("1,2".toByteArray(), Format.CSV, listOf(tensor("x", "FP64", 3)))
+        }
+    }
+
+    @Test
+    fun `should decode csv records with quoted values`() {
+        val request = decodeExternalRecord(
+            "\"Smith, \"\"J\"\"\",2".toByteArray(),
+            Format.CSV,
+            listOf(tensor("name", "BYTES"), tensor("x", "FP64")),
+        )
+
+        expectThat(decodeTensors(request)).containsExactly(
+            TransformTensor("name", DataType.BYTES, listOf(1L), listOf(ByteString.copyFromUtf8("Smith, \"J\""))),
+            TransformTensor("x", DataType.FP64, listOf(1L), listOf(2.0)),
+        )
+    }
+
+    @Test
+    fun `should fail csv records with many rows`() {
+        assertThrows<TransformException> {
+            decodeExternalRecord("1,2\n3,4".toByteArray(), Format.CSV, listOf(tensor("x", "FP64", 2)))
+        }
+    }
+
+    @Test
+    fun `should decode bytes records`() {
+        val request = decodeExternalRecord(byteArrayOf(1, 2, 3), Format.BYTES, listOf(tensor("image", "BYTES")))
+
+        expectThat(decodeTensors(request)).containsExactly(
+            TransformTensor("image", DataType.BYTES, listOf(1L), listOf(ByteString.copyFrom(byteArrayOf(1, 2, 3)))),
+        )
+    }
+
+    @Test
+    fun `should encode json records`() {
+        val record = encodeExternalRecord(response(), Format.JSON, emptyList())
+
+        expectThat(record.decodeToString()).isEqualTo("""{"scores":[[0.5,1.5]],"label":"cat"}""")
+    }
+
+    @Test
+    fun `should encode csv records for declared tensors`() {
+        val record = encodeExternalRecord(response(), Format.CSV, listOf(tensor("label", ""), tensor("scores", "")))
+
+        expectThat(record.decodeToString()).isEqualTo("cat,0.5,1.5")
+    }
+
+    @Test
+    fun `should quote csv values`() {
+        val response = ModelInferResponse
+            .newBuilder()
+            .addOutputs(
+                ModelInferResponse.InferOutputTensor
+                    .newBuilder()
+                    .setName("label")
+                    .setDatatype("BYTES")
+                    .addShape(1)
+                    .setContents(InferTensorContents.newBuilder().addBytesContents(ByteString.copyFromUtf8("a, \"b\""))),
+            )
+            .build()
+
+        val record = encodeExternalRecord(response, Format.CSV, emptyList())
+
+        expectThat(record.decodeToString()).isEqualTo("\"a, \"\"b\"\"\"")
+    }
+
+    @Test
+    fun `should encode bytes records`() {
+        val record = encodeExternalRecord(response(), Format.BYTES, emptyList())
+
+        expectThat(record).contentEquals("cat".toByteArray())
+    }
+
+    @Test
+    fun `should fail to encode a missing tensor`() {
+        assertThrows<TransformException> {
+            encodeExternalRecord(response(), Format.JSON, listOf(tensor("missing", "")))
+        }
+    }
+
+    private fun tensor(name: String, datatype: String, vararg shape: Long): KafkaAdapterTensor {
+        return KafkaAdapterTensor
+            .newBuilder()
+            .setName(name)
+            .setDatatype(datatype)
+            .addAllShape(shape.toList())
+            .build()
+    }
+
+    private fun response(): ModelInferResponse {
+        return ModelInferResponse
+            .newBuilder()
+            .setModelName("classifier")
+            .addOutputs(
+                ModelInferResponse.InferOutputTensor
+                    .newBuilder()
+                    .setName("scores")
+                    .setDatatype("FP64")
+                    .addAllShape(listOf(1L, 2L))
+                    .setContents(InferTensorContents.newBuilder().addAllFp64Contents(listOf(0.5, 1.5)))
+            )
+            .addOutputs(
+                ModelInferResponse.InferOutputTensor
+                    .newBuilder()
+                    .setName("label")
+                    .setDatatype("BYTES")
+                    .addAllShape(listOf(1L))
+                    .setContents(InferTensorContents.newBuilder().addBytesContents(ByteString.copyFromUtf8("cat")))
+            )
+            .build()
+    }
+}
@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package io.seldon.dataflow.kafka
+
+import com.google.protobuf.ByteString
+import io.seldon.mlops.chainer.ChainerOuterClass.PipelineTransform
+import io.seldon.mlops.chainer.ChainerOuterClass.PipelineTransform.TransformType
+import io.seldon.mlops.inference.v2.V2Dataplane.InferTensorContents
+import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferResponse
+import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferResponse.InferOutputTensor
+import org.junit.jupiter.api.Test
+import strikt.api.expectThat
+import strikt.api.expectThrows
+import strikt.assertions.*
+
+internal class TransformsTest {
+    @Test
+    fun `should concat tensors on axis`() {
+        val response = makeResponse(
+            makeFp32Tensor("a", listOf(2L, 1L), listOf(1F, 2F)),
+            makeFp32Tensor("b", listOf(2L, 1L), listOf(3F, 4F)),
+        )
+        val transform = PipelineTransform.newBuilder()
+            .setType(TransformType.CONCAT)
+            .addAllTensors(listOf("a", "b"))
+            .addOutputs("c")
+            .setAxis(1)
+            .build()
+
+        val transformed = applyTransforms(response, listOf(transform))
+
+        expectThat(transformed.outputsList.map { it.name }).isEqualTo(listOf("a", "b", "c"))
+        expectThat(transformed.getOutputs(2).shapeList).isEqualTo(listOf(2L, 2L))
+        expectThat(transformed.getOutputs(2).contents.fp32ContentsList).isEqualTo(listOf(1F, 3F, 2F, 4F))
+    }
+
+    @Test
+    fun `should split, select and reshape tensors`() {
+        val response = makeResponse(makeFp32Tensor("a", listOf(1L, 4L), listOf(1F, 2F, 3F, 4F)))
+        val transforms = listOf(
+            PipelineTransform.newBuilder()
+                .setType(TransformType.SPLIT)
+                .addTensors("a")
+                .addAllOutputs(listOf("b", "c"))
+                .setAxis(1)
+                .build(),
+            PipelineTransform.newBuilder()
+                .setType(TransformType.SELECT)
+                .addTensors("c")
+                .build(),
+            PipelineTransform.newBuilder()
+                .setType(TransformType.RESHAPE)
+                .addTensors("c")
+                .addAllShape(listOf(-1L))
+                .build(),
+        )
+
+        val transformed = applyTransforms(response, transforms)
+
+        expectThat(transformed.outputsList.map { it.name }).isEqualTo(listOf("c"))
+        expectThat(transformed.getOutputs(0).shapeList).isEqualTo(listOf(2L))
+        expectThat(transformed.getOutputs(0).contents.fp32ContentsList).isEqualTo(listOf(3F, 4F))
+    }
+
+    @Test
+    fun `should cast raw tensor contents`() {
+        val response = makeResponse(makeFp32Tensor("a", listOf(2L), listOf(1.5F, 2.5F))).withBinaryContents()
+        val transform = PipelineTransform.newBuilder()
+            .setType(TransformType.CAST)
+            .addTensors("a")
+            .setDatatype("INT64")
+            .build()
+
+        val transformed = applyTransforms(response, listOf(transform))
+
+        expectThat(transformed.rawOutputContentsCount).isEqualTo(0)
+        expectThat(transformed.getOutputs(0).datatype).isEqualTo("INT64")
+        expectThat(transformed.getOutputs(0).contents.int64ContentsList).isEqualTo(listOf(1L, 2L))
+    }
+
+    @Test
+    fun `should add constant and extract json path`() {
+        val json = InferOutputTensor.newBuilder()
+            .setName("json")
+            .setDatatype("BYTES")
+            .addShape(1)
+            .setContents(
+                InferTensorContents.newBuilder()
+                    .addBytesContents(ByteString.copyFromUtf8("""{"a": {"b": [[1, 2], [3, 4]]}}"""))
+            )
+            .build()
+        val transforms = listOf(
+            PipelineTransform.newBuilder()
+                .setType(TransformType.CONSTANT)
+                .addOutputs("k")
+                .setDatatype("BOOL")
+                .addShape(1)
+                .addValues("true")
+                .build(),
+            PipelineTransform.newBuilder()
+                .setType(TransformType.JSONPATH)
+                .addTensors("json")
+                .addOutputs("b")
+                .setPath("$.a['b'][1]")
+                .setDatatype("INT32")
+                .build(),
+        )
+
+        val transformed = applyTransforms(makeResponse(json), transforms)
+
+        expectThat(transformed.outputsList.map { it.name }).isEqualTo(listOf("json", "k", "b"))
+        expectThat(transformed.getOutputs(1).contents.boolContentsList).isEqualTo(listOf(true))
+        expectThat(transformed.getOutputs(2).shapeList).isEqualTo(listOf(2L))
+        expectThat(transformed.getOutputs(2).contents.intContentsList).isEqualTo(listOf(3, 4))
+    }
+
+    @Test
+    fun `should fail for missing tensor`() {
+        val response = makeResponse(makeFp32Tensor("a", listOf(1L), listOf(1F)))
+        val transform = PipelineTransform.newBuilder()
+            .setType(TransformType.SELECT)
+            .addTensors("missing")
+            .build()
+
+        expectThrows<TransformException> { applyTransforms(response, listOf(transform)) }
+    }
+
+    companion object {
+        private fun makeResponse(vararg tensors: InferOutputTensor): ModelInferResponse {
+            return ModelInferResponse.newBuilder()
+                .setId("1")
+                .addAllOutputs(tensors.toList())
+                .build()
+        }
+
+        private fun makeFp32Tensor(name: String, shape: List<Long>, values: List<Float>): InferOutputTensor {
+            return InferOutputTensor.newBuilder()
+                .setName(name)
+                .setDatatype("FP32")
+                .addAllShape(shape)
+                .setContents(InferTensorContents.newBuilder().addAllFp32Contents(values))
+                .build()
+        }
+    }
+}
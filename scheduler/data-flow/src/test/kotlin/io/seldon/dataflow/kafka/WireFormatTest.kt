@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package io.seldon.dataflow.kafka
+
+import io.seldon.dataflow.kafka.headers.SeldonHeaders
+import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferRequest
+import io.seldon.mlops.inference.v2.V2Dataplane.ModelInferResponse
+import org.apache.kafka.common.header.internals.RecordHeaders
+import org.junit.jupiter.api.AfterEach
+import org.junit.jupiter.api.Test
+import strikt.api.expectThat
+import strikt.assertions.*
+
+internal class WireFormatTest {
+    @AfterEach
+    fun reset() {
+        WireFormat.configure(null)
+    }
+
+    @Test
+    fun `should leave payloads raw without a schema registry`() {
+        val data = request().toByteArray()
+
+        expectThat(WireFormat.wrap("seldon.default.model.iris.inputs", data)).contentEquals(data)
+    }
+
+    @Test
+    fun `should round trip payloads on model and pipeline topics`() {
+        val subjects = mutableListOf<String>()
+        WireFormat.configure { subject -> subjects.add(subject); 7 }
+        val data = request().toByteArray()
+
+        val wrapped = WireFormat.wrap("seldon.default.model.iris.inputs", data)
+
+        expectThat(wrapped.size).isGreaterThan(data.size)
+        expectThat(wrapped[0]).isEqualTo(0.toByte())
+        expectThat(wrapped.copyOfRange(1, 5)).contentEquals(byteArrayOf(0, 0, 0, 7))
+        expectThat(ModelInferRequest.parseFrom(WireFormat.unwrap(wrapped))).isEqualTo(request())
+        expectThat(subjects).containsExactly("seldon.default.model.iris.inputs-value")
+    }
+
+    @Test
+    fun `should cache schema ids per topic`() {
+        val subjects = mutableListOf<String>()
+        WireFormat.configure { subject -> subjects.add(subject); 1 }
+        val data = ModelInferResponse.newBuilder().setModelName("iris").build().toByteArray()
+
+        WireFormat.wrap("seldon.default.pipeline.p1.outputs", data)
+        WireFormat.wrap("seldon.default.pipeline.p1.outputs", data)
+
+        expectThat(subjects).containsExactly("seldon.default.pipeline.p1.outputs-value")
+    }
+
+    @Test
+    fun `should leave other topics raw`() {
+        WireFormat.configure { 1 }
+        val data = "state".toByteArray()
+
+        expectThat(WireFormat.wrap("seldon.default.errors.errors", data)).contentEquals(data)
+    }
+
+    @Test
+    fun `should write raw payloads if the schema registry fails`() {
+        WireFormat.configure { throw IllegalStateException("unavailable") }
+        val data = request().toByteArray()
+
+        expectThat(WireFormat.wrap("seldon.default.model.iris.inputs", data)).contentEquals(data)
+    }
+
+    @Test
+    fun `should unwrap only framed payloads`() {
+        val data = request().toByteArray()
+        val framed = byteArrayOf(0, 0, 0, 0, 3, 4, 2, 2) + data
+
+        expectThat(WireFormat.unwrap(data)).contentEquals(data)
+        expectThat(WireFormat.unwrap(framed)).contentEquals(data)
+    }
+
+    @Test
+    fun `should replace the produce time copied from the input record`() {
+        val headers = RecordHeaders().add(SeldonHeaders.produceTime, "1".toByteArray())
+        val before = System.currentTimeMillis()
+
+        WireFormatSerializer().serialize("seldon.default.model.iris.outputs", headers, request().toByteArray())
+
+        val produceTimes = headers.headers(SeldonHeaders.produceTime).map { it.value().decodeToString().toLong() }
+        expectThat(produceTimes).hasSize(1)
+        expectThat(produceTimes.first()).isGreaterThanOrEqualTo(before)
+    }
+
+    private fun request(): ModelInferRequest {
+        return ModelInferRequest.newBuilder().setModelName("iris").setId("1").build()
+    }
+}
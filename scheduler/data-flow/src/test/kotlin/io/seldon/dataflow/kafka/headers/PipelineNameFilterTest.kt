@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package io.seldon.dataflow.kafka.headers
+
+import io.seldon.dataflow.kafka.PipelineCanaries
+import org.apache.kafka.common.header.internals.RecordHeaders
+import org.apache.kafka.streams.processor.MockProcessorContext
+import org.junit.jupiter.api.Test
+import org.junit.jupiter.params.ParameterizedTest
+import org.junit.jupiter.params.provider.Arguments
+import org.junit.jupiter.params.provider.Arguments.arguments
+import org.junit.jupiter.params.provider.MethodSource
+import strikt.api.expectThat
+import strikt.assertions.isEqualTo
+import java.util.stream.Stream
+
+internal class PipelineNameFilterTest {
+
+    @ParameterizedTest(name = "{0}")
+    @MethodSource
+    fun filter(
+        testName: String,
+        headers: Map<String, String>,
+        pipelineVersion: Int?,
+        expected: Boolean,
+    ) {
+        val recordHeaders = RecordHeaders()
+        headers.forEach { (key, value) -> recordHeaders.add(key, value.toByteArray()) }
+        val context = MockProcessorContext()
+        context.setHeaders(recordHeaders)
+        val filter = PipelineNameFilter(pipelineName, pipelineVersion)
+        filter.init(context)
+
+        val result = filter.transform(byteArrayOf(1))
+
+        expectThat(result != null).isEqualTo(expected)
+    }
+
+    @Test
+    fun `messages without a version are only processed by the stable version`() {
+        PipelineCanaries.set(pipelineName, 2, true)
+        try {
+            val processed = listOf(1, 2).map { version ->
+                val context = MockProcessorContext()
+                context.setHeaders(RecordHeaders().add(SeldonHeaders.pipelineName, pipelineName.toByteArray()))
+                val filter = PipelineNameFilter(pipelineName, version)
+                filter.init(context)
+                filter.transform(byteArrayOf(1)) != null
+            }
+
+            expectThat(processed).isEqualTo(listOf(true, false))
+        } finally {
+            PipelineCanaries.set(pipelineName, 2, false)
+        }
+    }
+
+    companion object {
+        private const val pipelineName = "some-pipeline"
+
+        @JvmStatic
+        fun filter(): Stream<Arguments> =
+            Stream.of(
+                arguments("matching pipeline", mapOf(SeldonHeaders.pipelineName to pipelineName), null, true),
+                arguments("other pipeline", mapOf(SeldonHeaders.pipelineName to "other"), null, false),
+                arguments(
+                    "no version header",
+                    mapOf(SeldonHeaders.pipelineName to pipelineName),
+                    2,
+                    true,
+                ),
+                arguments(
+                    "matching version",
+                    mapOf(SeldonHeaders.pipelineName to pipelineName, SeldonHeaders.pipelineVersion to "2"),
+                    2,
+                    true,
+                ),
+                arguments(
+                    "other version",
+                    mapOf(SeldonHeaders.pipelineName to pipelineName, SeldonHeaders.pipelineVersion to "1"),
+                    2,
+                    false,
+                ),
+                arguments(
+                    "version not filtered",
+                    mapOf(SeldonHeaders.pipelineName to pipelineName, SeldonHeaders.pipelineVersion to "1"),
+                    null,
+                    true,
+                ),
+            )
+    }
+}
@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package io.seldon.dataflow.kafka.headers
+
+import org.apache.kafka.common.header.internals.RecordHeaders
+import org.apache.kafka.streams.processor.internals.ProcessorRecordContext
+import org.junit.jupiter.api.Test
+import strikt.api.expectThat
+import strikt.assertions.isEqualTo
+
+internal class PriorityLaneExtractorTest {
+    @Test
+    fun `should use low priority topic when header set`() {
+        val headers = RecordHeaders()
+        headers.add(SeldonHeaders.priority, "low".toByteArray())
+        val context = ProcessorRecordContext(0L, 0L, 0, "topic", headers)
+
+        val topic = PriorityLaneExtractor("seldon.default.model.m.inputs").extract("1", byteArrayOf(), context)
+
+        expectThat(topic).isEqualTo("seldon.default.model.m.inputs.low")
+    }
+
+    @Test
+    fun `should use default topic for high priority requests`() {
+        val headers = RecordHeaders()
+        headers.add(SeldonHeaders.priority, "high".toByteArray())
+        val context = ProcessorRecordContext(0L, 0L, 0, "topic", headers)
+
+        val topic = PriorityLaneExtractor("seldon.default.model.m.inputs").extract("1", byteArrayOf(), context)
+
+        expectThat(topic).isEqualTo("seldon.default.model.m.inputs")
+    }
+
+    @Test
+    fun `should use default topic without header`() {
+        val context = ProcessorRecordContext(0L, 0L, 0, "topic", RecordHeaders())
+
+        val topic = PriorityLaneExtractor("seldon.default.model.m.inputs").extract("1", byteArrayOf(), context)
+
+        expectThat(topic).isEqualTo("seldon.default.model.m.inputs")
+    }
+
+    @Test
+    fun `should only redirect model inputs`() {
+        val headers = RecordHeaders()
+        headers.add(SeldonHeaders.priority, "low".toByteArray())
+        val context = ProcessorRecordContext(0L, 0L, 0, "topic", headers)
+
+        val topic = PriorityLaneExtractor("seldon.default.pipeline.p.outputs").extract("1", byteArrayOf(), context)
+
+        expectThat(topic).isEqualTo("seldon.default.pipeline.p.outputs")
+    }
+}
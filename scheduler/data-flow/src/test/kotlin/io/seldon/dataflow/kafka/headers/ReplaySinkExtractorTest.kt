@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package io.seldon.dataflow.kafka.headers
+
+import io.seldon.dataflow.kafka.TopicForPipeline
+import io.seldon.dataflow.kafka.isPipelineOutputs
+import org.apache.kafka.common.header.internals.RecordHeaders
+import org.apache.kafka.streams.processor.internals.ProcessorRecordContext
+import org.junit.jupiter.api.Test
+import strikt.api.expectThat
+import strikt.assertions.isEqualTo
+import strikt.assertions.isFalse
+import strikt.assertions.isTrue
+
+internal class ReplaySinkExtractorTest {
+    @Test
+    fun `should use replay sink when header set`() {
+        val headers = RecordHeaders()
+        headers.add(SeldonHeaders.replaySink, "replay".toByteArray())
+        val context = ProcessorRecordContext(0L, 0L, 0, "topic", headers)
+
+        val topic = ReplaySinkExtractor("outputs").extract("1", byteArrayOf(), context)
+
+        expectThat(topic).isEqualTo("replay")
+    }
+
+    @Test
+    fun `should use default topic without header`() {
+        val context = ProcessorRecordContext(0L, 0L, 0, "topic", RecordHeaders())
+
+        val topic = ReplaySinkExtractor("outputs").extract("1", byteArrayOf(), context)
+
+        expectThat(topic).isEqualTo("outputs")
+    }
+
+    @Test
+    fun `should only redirect pipeline outputs`() {
+        expectThat(TopicForPipeline("seldon.default.pipeline.p.outputs", "p").isPipelineOutputs()).isTrue()
+        expectThat(TopicForPipeline("seldon.default.model.m.outputs", "p").isPipelineOutputs()).isFalse()
+        expectThat(TopicForPipeline("seldon.default.pipeline.p.inputs", "p").isPipelineOutputs()).isFalse()
+    }
+}
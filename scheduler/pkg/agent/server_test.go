@@ -80,6 +80,10 @@ func (m *mockStore) UnloadVersionModels(modelKey string, version uint32) (bool,
 	panic("implement me")
 }
 
+func (m *mockStore) SetModelRollout(modelKey string, rollout *store.ModelRollout) error {
+	return nil
+}
+
 func (m *mockStore) UpdateModelState(modelKey string, version uint32, serverKey string, replicaIdx int, availableMemory *uint64, expectedState, desiredState store.ModelReplicaState, reason string) error {
 	model := m.models[modelKey]
 	for _, mv := range model.Versions {
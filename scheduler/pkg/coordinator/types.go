@@ -49,7 +49,9 @@ type PipelineEventMsg struct {
 	UID               string
 	ExperimentUpdate  bool
 	ModelStatusChange bool
-	Source            string
+	// CanaryUpdate is set when the version stops being a canary, so the dataflow engine is told it is now stable
+	CanaryUpdate bool
+	Source       string
 }
 
 func (p PipelineEventMsg) String() string {
@@ -23,9 +23,12 @@ import (
 	rsrc "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	"github.com/sirupsen/logrus"
 
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/coordinator"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/xdscache"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/federation"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/cleaner"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/experiment"
@@ -57,6 +60,7 @@ type IncrementalProcessor struct {
 	pendingModelVersions []*pendingModelVersion
 	versionCleaner       cleaner.ModelVersionCleaner
 	batchTriggerManual   *time.Time
+	remoteModels         federation.RemoteModels // nil unless federated with schedulers in other regions
 }
 
 type pendingModelVersion struct {
@@ -74,6 +78,9 @@ func NewIncrementalProcessor(
 	hub *coordinator.EventHub,
 	pipelineGatewayDetails *xdscache.PipelineGatewayDetails,
 	versionCleaner cleaner.ModelVersionCleaner,
+	authConfig *resources.AuthConfig,
+	globalRateLimit *resources.GlobalRateLimitConfig,
+	remoteModels federation.RemoteModels,
 ) (*IncrementalProcessor, error) {
 	ip := &IncrementalProcessor{
 		cache:                cache,
@@ -89,7 +96,10 @@ func NewIncrementalProcessor(
 		batchWait:            util.EnvoyUpdateDefaultBatchWait,
 		versionCleaner:       versionCleaner,
 		batchTriggerManual:   nil,
+		remoteModels:         remoteModels,
 	}
+	ip.xdsCache.AuthConfig = authConfig
+	ip.xdsCache.GlobalRateLimit = globalRateLimit
 
 	err := ip.setListeners()
 	if err != nil {
@@ -152,12 +162,27 @@ func (p *IncrementalProcessor) handleExperimentEvents(event coordinator.Experime
 					logger.WithError(err).Errorf("Failed to get experiment %s", event.ExperimentName)
 				}
 			} else {
-				if event.UpdatedExperiment {
+				if event.UpdatedExperiment && !exp.RoutesTraffic() {
+					// pending or concluded without promotion so the default routes as usual
+					err := p.experimentRemoveTraffic(exp)
+					var err2 error
+					if err != nil {
+						logger.WithError(err).Errorf("Failed to process sync for experiment %s", event.String())
+						err2 = p.experimentServer.SetStatus(event.ExperimentName, false, err.Error())
+					} else {
+						err2 = p.experimentServer.SetStatus(event.ExperimentName, false, exp.ScheduleDescription())
+					}
+					if err2 != nil {
+						logger.WithError(err2).Errorf("Failed to set experiment activation")
+					}
+				} else if event.UpdatedExperiment {
 					err := p.experimentUpdate(exp)
 					var err2 error
 					if err != nil {
 						logger.WithError(err).Errorf("Failed to process sync for experiment %s", event.String())
 						err2 = p.experimentServer.SetStatus(event.ExperimentName, false, err.Error())
+					} else if description := exp.ScheduleDescription(); description != "" {
+						err2 = p.experimentServer.SetStatus(event.ExperimentName, true, description)
 					} else {
 						err2 = p.experimentServer.SetStatus(event.ExperimentName, true, "experiment active")
 					}
@@ -256,11 +281,56 @@ func (p *IncrementalProcessor) updateEnvoyForModelVersion(modelRouteName string,
 		return
 	}
 
-	clusterNameBase := server.Name + "_" + computeHashKeyForList(assignment)
+	// Models with their own circuit breaker or outlier detection settings get their own clusters, which are not shared
+	// with other models with the same settings so each model has its own limits
+	policy := modelVersion.GetModel().GetModelSpec().GetTrafficPolicy()
+	authz := modelVersion.GetModel().GetModelSpec().GetAuthorization()
+	// Models available in other regions fail over to them, so only share clusters with models available in the same
+	// regions
+	remoteEndpoints := p.getRemoteEndpoints(modelRouteName, modelVersion.GetModel(), isMirror)
+	localClusterNameBase := server.Name + "_" + computeHashKeyForList(assignment)
+	if key := resources.GetClusterPolicyKey(policy); key != "" {
+		localClusterNameBase += "_" + modelVersion.GetModel().GetMeta().GetName() + "_" + key
+	}
+	clusterNameBase := localClusterNameBase
+	if key := resources.GetRemoteEndpointsKey(remoteEndpoints); key != "" {
+		clusterNameBase += "_" + key
+	}
+	httpClusterName, grpcClusterName := p.addModelVersionClusters(clusterNameBase, modelRouteName, modelVersion, server, remoteEndpoints)
+
+	logPayloads := false
+	if modelVersion.GetDeploymentSpec() != nil {
+		logPayloads = modelVersion.GetDeploymentSpec().LogPayloads
+	} else {
+		logger.Warnf("model %s has not deployment spec", modelVersion.GetModel().GetMeta().GetName())
+	}
+
+	p.xdsCache.AddRouteClusterTraffic(modelRouteName, modelVersion.GetModel().GetMeta().GetName(), modelVersion.GetVersion(), trafficPercent, httpClusterName, grpcClusterName, logPayloads, isMirror, policy, authz)
+
+	// Requests forwarded from other regions go to the clusters of the local replicas, shared with models not failing
+	// over to other regions
+	if len(remoteEndpoints) > 0 {
+		localHttpClusterName, localGrpcClusterName := p.addModelVersionClusters(localClusterNameBase, modelRouteName, modelVersion, server, nil)
+		p.xdsCache.AddRouteLocalClusterTraffic(modelRouteName, modelVersion.GetModel().GetMeta().GetName(), modelVersion.GetVersion(), trafficPercent, localHttpClusterName, localGrpcClusterName)
+		p.xdsCache.SetRouteFederationRegion(modelRouteName, p.remoteModels.GetRegion())
+	}
+}
+
+// addModelVersionClusters adds the http and grpc clusters of the replicas of a model version and any other regions it
+// fails over to, returning their names
+func (p *IncrementalProcessor) addModelVersionClusters(
+	clusterNameBase string,
+	modelRouteName string,
+	modelVersion *store.ModelVersion,
+	server *store.ServerSnapshot,
+	remoteEndpoints []resources.Endpoint,
+) (string, string) {
+	logger := p.logger.WithField("func", "addModelVersionClusters")
+	policy := modelVersion.GetModel().GetModelSpec().GetTrafficPolicy()
 	httpClusterName := clusterNameBase + "_http"
 	grpcClusterName := clusterNameBase + "_grpc"
-	p.xdsCache.AddCluster(httpClusterName, modelRouteName, modelVersion.GetModel().GetMeta().GetName(), modelVersion.GetVersion(), false)
-	for _, replicaIdx := range assignment {
+	p.xdsCache.AddCluster(httpClusterName, modelRouteName, modelVersion.GetModel().GetMeta().GetName(), modelVersion.GetVersion(), false, policy)
+	for _, replicaIdx := range modelVersion.GetAssignment() {
 		replica, ok := server.Replicas[replicaIdx]
 		if !ok {
 			logger.Warnf("Invalid replica index %d for server %s", replicaIdx, server.Name)
@@ -268,8 +338,9 @@ func (p *IncrementalProcessor) updateEnvoyForModelVersion(modelRouteName string,
 			p.xdsCache.AddEndpoint(httpClusterName, replica.GetInferenceSvc(), uint32(replica.GetInferenceHttpPort()))
 		}
 	}
-	p.xdsCache.AddCluster(grpcClusterName, modelRouteName, modelVersion.GetModel().GetMeta().GetName(), modelVersion.GetVersion(), true)
-	for _, replicaIdx := range assignment {
+	p.addRemoteEndpoints(httpClusterName, remoteEndpoints)
+	p.xdsCache.AddCluster(grpcClusterName, modelRouteName, modelVersion.GetModel().GetMeta().GetName(), modelVersion.GetVersion(), true, policy)
+	for _, replicaIdx := range modelVersion.GetAssignment() {
 		replica, ok := server.Replicas[replicaIdx]
 		if !ok {
 			logger.Warnf("Invalid replica index %d for server %s", replicaIdx, server.Name)
@@ -277,15 +348,69 @@ func (p *IncrementalProcessor) updateEnvoyForModelVersion(modelRouteName string,
 			p.xdsCache.AddEndpoint(grpcClusterName, replica.GetInferenceSvc(), uint32(replica.GetInferenceGrpcPort()))
 		}
 	}
+	p.addRemoteEndpoints(grpcClusterName, remoteEndpoints)
+	return httpClusterName, grpcClusterName
+}
 
-	logPayloads := false
-	if modelVersion.GetDeploymentSpec() != nil {
-		logPayloads = modelVersion.GetDeploymentSpec().LogPayloads
-	} else {
-		logger.Warnf("model %s has not deployment spec", modelVersion.GetModel().GetMeta().GetName())
+// getRemoteEndpoints returns the Envoys of the other regions a model is available in. Only the route of the model
+// itself fails over, as the other regions route requests by the model header to their own replicas of it.
+func (p *IncrementalProcessor) getRemoteEndpoints(routeName string, model *scheduler.Model, isMirror bool) []resources.Endpoint {
+	if p.remoteModels == nil || isMirror || routeName != model.GetMeta().GetName() {
+		return nil
+	}
+	var endpoints []resources.Endpoint
+	for _, remoteModel := range p.remoteModels.GetRemoteModels(model) {
+		endpoints = append(endpoints, resources.Endpoint{
+			UpstreamHost: remoteModel.Host,
+			UpstreamPort: remoteModel.Port,
+			Region:       remoteModel.Region,
+			Weight:       remoteModel.Replicas,
+		})
+	}
+	return endpoints
+}
+
+func (p *IncrementalProcessor) addRemoteEndpoints(clusterName string, endpoints []resources.Endpoint) {
+	for _, e := range endpoints {
+		p.xdsCache.AddRemoteEndpoint(clusterName, e.Region, e.UpstreamHost, e.UpstreamPort, e.Weight)
+	}
+}
+
+// addRemoteModelTraffic sends the traffic of a model with no local replicas to the other regions it is available in,
+// returning false if there are none
+func (p *IncrementalProcessor) addRemoteModelTraffic(model *store.ModelSnapshot) bool {
+	logger := p.logger.WithField("func", "addRemoteModelTraffic")
+	latestModel := model.GetLatest()
+	if latestModel == nil {
+		return false
+	}
+	remoteEndpoints := p.getRemoteEndpoints(model.Name, latestModel.GetModel(), false)
+	if len(remoteEndpoints) == 0 {
+		return false
+	}
+
+	policy := latestModel.GetModel().GetModelSpec().GetTrafficPolicy()
+	authz := latestModel.GetModel().GetModelSpec().GetAuthorization()
+	clusterNameBase := resources.FederationClusterPrefix + "_" + resources.GetRemoteEndpointsKey(remoteEndpoints)
+	if key := resources.GetClusterPolicyKey(policy); key != "" {
+		clusterNameBase += "_" + model.Name + "_" + key
 	}
+	httpClusterName := clusterNameBase + "_http"
+	grpcClusterName := clusterNameBase + "_grpc"
+	p.xdsCache.AddCluster(httpClusterName, model.Name, model.Name, latestModel.GetVersion(), false, policy)
+	p.addRemoteEndpoints(httpClusterName, remoteEndpoints)
+	p.xdsCache.AddCluster(grpcClusterName, model.Name, model.Name, latestModel.GetVersion(), true, policy)
+	p.addRemoteEndpoints(grpcClusterName, remoteEndpoints)
 
-	p.xdsCache.AddRouteClusterTraffic(modelRouteName, modelVersion.GetModel().GetMeta().GetName(), modelVersion.GetVersion(), trafficPercent, httpClusterName, grpcClusterName, logPayloads, isMirror)
+	logPayloads := false
+	if latestModel.GetDeploymentSpec() != nil {
+		logPayloads = latestModel.GetDeploymentSpec().LogPayloads
+	}
+	logger.Debugf("No local replica for model %s so sending its traffic to %d other regions", model.Name, len(remoteEndpoints))
+	p.xdsCache.AddRouteClusterTraffic(model.Name, model.Name, latestModel.GetVersion(), 100, httpClusterName, grpcClusterName, logPayloads, false, policy, authz)
+	// Requests forwarded from other regions are refused rather than sent on
+	p.xdsCache.SetRouteFederationRegion(model.Name, p.remoteModels.GetRegion())
+	return true
 }
 
 func getTrafficShare(latestModel *store.ModelVersion, lastAvailableModelVersion *store.ModelVersion, weight uint32) (uint32, uint32) {
@@ -314,6 +439,13 @@ func (p *IncrementalProcessor) addModelTraffic(routeName string, model *store.Mo
 		return err
 	}
 
+	if rollout := model.Rollout; rollout != nil && rollout.Version == latestModel.GetVersion() {
+		stableModel := model.GetVersion(rollout.StableVersion)
+		if stableModel != nil && stableModel.HasLiveReplicas() {
+			return p.addModelRolloutTraffic(routeName, model.Name, rollout, latestModel, server, stableModel, weight, isMirror)
+		}
+	}
+
 	lastAvailableModelVersion := model.GetLastAvailableModel()
 	if lastAvailableModelVersion != nil && latestModel.GetVersion() != lastAvailableModelVersion.GetVersion() {
 		trafficLatestModel, trafficLastAvailableModel := getTrafficShare(latestModel, lastAvailableModelVersion, weight)
@@ -337,6 +469,51 @@ func (p *IncrementalProcessor) addModelTraffic(routeName string, model *store.Mo
 	return nil
 }
 
+func getRolloutTrafficShare(rollout *store.ModelRollout, latestModel *store.ModelVersion, weight uint32) (uint32, uint32) {
+	trafficLatestModel := uint32(0)
+	if latestModel.HasLiveReplicas() {
+		trafficLatestModel = weight * rollout.TrafficPercent(latestModel.GetModel().GetModelSpec().GetRollout()) / 100
+	}
+	return trafficLatestModel, weight - trafficLatestModel
+}
+
+// addModelRolloutTraffic splits traffic between the stable and latest versions by the current rollout step,
+// leaving out any version with no traffic so the route has a single cluster once the rollout has finished
+func (p *IncrementalProcessor) addModelRolloutTraffic(
+	routeName string,
+	modelName string,
+	rollout *store.ModelRollout,
+	latestModel *store.ModelVersion,
+	server *store.ServerSnapshot,
+	stableModel *store.ModelVersion,
+	weight uint32,
+	isMirror bool,
+) error {
+	logger := p.logger.WithField("func", "addModelRolloutTraffic")
+
+	trafficLatestModel, trafficStableModel := getRolloutTrafficShare(rollout, latestModel, weight)
+	logger.Debugf("Rollout %s splitting traffic between latest %s:%d %d percent and stable %s:%d %d percent",
+		rollout.State.String(),
+		modelName,
+		latestModel.GetVersion(),
+		trafficLatestModel,
+		modelName,
+		stableModel.GetVersion(),
+		trafficStableModel)
+	if trafficStableModel > 0 {
+		stableServer, err := p.modelStore.GetServer(stableModel.Server(), false, false)
+		if err != nil {
+			logger.WithError(err).Errorf("Failed to find server %s for stable model %s", stableModel.Server(), modelName)
+			return err
+		}
+		p.updateEnvoyForModelVersion(routeName, stableModel, stableServer, trafficStableModel, isMirror)
+	}
+	if trafficLatestModel > 0 {
+		p.updateEnvoyForModelVersion(routeName, latestModel, server, trafficLatestModel, isMirror)
+	}
+	return nil
+}
+
 func (p *IncrementalProcessor) addExperimentModelBaselineTraffic(model *store.ModelSnapshot, exp *experiment.Experiment) error {
 	logger := p.logger.WithField("func", "addExperimentModelBaselineTraffic")
 	logger.Infof("Trying to setup experiment for %s", model.Name)
@@ -369,7 +546,13 @@ func (p *IncrementalProcessor) addExperimentModelBaselineTraffic(model *store.Mo
 		if err != nil {
 			return err
 		}
+		if exp.GetShadowComparison() != nil {
+			p.xdsCache.SetRouteShadowExperiment(model.Name, exp.Name)
+		}
 	}
+	policy, authz := p.getModelRoutePolicies(exp)
+	p.xdsCache.SetRoutePolicies(model.Name, policy, authz)
+	p.xdsCache.SetRouteRules(model.Name, experiment.CreateRulesProto(exp.GetRules()))
 	return nil
 }
 
@@ -402,6 +585,9 @@ func (p *IncrementalProcessor) addTrafficForExperiment(routeName string, exp *ex
 		if exp.Mirror != nil {
 			p.xdsCache.AddPipelineRoute(routeName, exp.Mirror.Name, exp.Mirror.Percent, true)
 		}
+		policy, authz := p.getPipelineRoutePolicies(exp.Default)
+		p.xdsCache.SetPipelineRoutePolicies(routeName, policy, authz)
+		p.xdsCache.SetPipelineRouteRules(routeName, experiment.CreateRulesProto(exp.GetRules()))
 	case experiment.ModelResourceType:
 		for _, candidate := range exp.Candidates {
 			candidateModel, err := p.modelStore.GetModel(candidate.Name)
@@ -422,7 +608,13 @@ func (p *IncrementalProcessor) addTrafficForExperiment(routeName string, exp *ex
 			if err != nil {
 				return err
 			}
+			if exp.GetShadowComparison() != nil {
+				p.xdsCache.SetRouteShadowExperiment(routeName, exp.Name)
+			}
 		}
+		policy, authz := p.getModelRoutePolicies(exp)
+		p.xdsCache.SetRoutePolicies(routeName, policy, authz)
+		p.xdsCache.SetRouteRules(routeName, experiment.CreateRulesProto(exp.GetRules()))
 	default:
 		return fmt.Errorf("Unknown resource type %v", exp.ResourceType)
 	}
@@ -435,6 +627,16 @@ func (p *IncrementalProcessor) addExperiment(exp *experiment.Experiment) error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 	routeName := fmt.Sprintf("%s.experiment", exp.Name)
+	// Start from an empty route as the weights of the candidates may have changed
+	switch exp.ResourceType {
+	case experiment.PipelineResourceType:
+		p.xdsCache.RemovePipelineRoute(routeName)
+	case experiment.ModelResourceType:
+		if err := p.removeRouteForServerInEnvoyCache(routeName); err != nil {
+			logger.WithError(err).Errorf("Failed to remove traffic for experiment %s", routeName)
+			return err
+		}
+	}
 	if err := p.addTrafficForExperiment(routeName, exp); err != nil {
 		logger.WithError(err).Errorf("Failed to add traffic for experiment %s", routeName)
 		return err
@@ -455,6 +657,47 @@ func (p *IncrementalProcessor) removeExperiment(exp *experiment.Experiment) erro
 	return p.updateEnvoy()
 }
 
+// getModelRoutePolicies returns the traffic and authorization policies of the latest version of the default model of an
+// experiment, or of its first candidate if it has no default, so they don't depend on which candidates are available
+func (p *IncrementalProcessor) getModelRoutePolicies(exp *experiment.Experiment) (*scheduler.TrafficPolicy, *scheduler.AuthorizationPolicy) {
+	var modelName string
+	switch {
+	case exp.Default != nil:
+		modelName = *exp.Default
+	case len(exp.Candidates) > 0:
+		modelName = exp.Candidates[0].Name
+	default:
+		return nil, nil
+	}
+	model, err := p.modelStore.GetModel(modelName)
+	if err != nil || model == nil || model.GetLatest() == nil {
+		return nil, nil
+	}
+	modelSpec := model.GetLatest().GetModel().GetModelSpec()
+	return modelSpec.GetTrafficPolicy(), modelSpec.GetAuthorization()
+}
+
+// getPipelineRoutePolicies returns the traffic and authorization policies of the default pipeline of an experiment if
+// there is one
+func (p *IncrementalProcessor) getPipelineRoutePolicies(pipelineName *string) (*scheduler.TrafficPolicy, *scheduler.AuthorizationPolicy) {
+	if pipelineName == nil {
+		return nil, nil
+	}
+	pip, err := p.pipelineHandler.GetPipeline(*pipelineName)
+	if err != nil {
+		return nil, nil
+	}
+	return getRoutePoliciesForPipeline(pip)
+}
+
+func getRoutePoliciesForPipeline(pip *pipeline.Pipeline) (*scheduler.TrafficPolicy, *scheduler.AuthorizationPolicy) {
+	pv := pip.GetLatestPipelineVersion()
+	if pv == nil {
+		return nil, nil
+	}
+	return pipeline.CreateProtoTrafficPolicy(pv.TrafficPolicy), pipeline.CreateProtoAuthorizationPolicy(pv.Authorization)
+}
+
 func getPipelineRouteName(pipelineName string) string {
 	return fmt.Sprintf("%s.%s", pipelineName, resources.SeldonPipelineHeaderSuffix)
 }
@@ -496,10 +739,25 @@ func (p *IncrementalProcessor) addPipeline(pipelineName string) error {
 			logger.Infof("Adding pipeline experiment mirror %s %s %d", routeName, exp.Mirror.Name, exp.Mirror.Percent)
 			p.xdsCache.AddPipelineRoute(routeName, exp.Mirror.Name, exp.Mirror.Percent, true)
 		}
+		p.xdsCache.SetPipelineRouteRules(routeName, experiment.CreateRulesProto(exp.GetRules()))
+	} else if stable := pip.GetStablePipelineVersion(); stable != nil {
+		// Split traffic between the stable and canary versions, the canary only takes traffic once ready
+		canary := pip.GetLatestPipelineVersion()
+		canaryWeight := pip.Canary.TrafficPercent
+		if canary.State.Status != pipeline.PipelineReady {
+			canaryWeight = 0
+		}
+		logger.Infof("Adding canary pipeline route %s version %d %d version %d %d", routeName, stable.Version, 100-canaryWeight, canary.Version, canaryWeight)
+		p.xdsCache.AddPipelineVersionRoute(routeName, pip.Name, stable.Version, 100-canaryWeight)
+		if canaryWeight > 0 {
+			p.xdsCache.AddPipelineVersionRoute(routeName, pip.Name, canary.Version, canaryWeight)
+		}
 	} else {
 		logger.Infof("Adding normal pipeline route %s", routeName)
 		p.xdsCache.AddPipelineRoute(routeName, pip.Name, 100, false)
 	}
+	policy, authz := getRoutePoliciesForPipeline(pip)
+	p.xdsCache.SetPipelineRoutePolicies(routeName, policy, authz)
 
 	return p.updateEnvoy()
 }
@@ -510,6 +768,21 @@ func (p *IncrementalProcessor) removePipeline(pip *pipeline.Pipeline) error {
 }
 
 func (p *IncrementalProcessor) experimentUpdate(exp *experiment.Experiment) error {
+	if err := p.experimentDefaultUpdate(exp); err != nil {
+		return err
+	}
+	return p.addExperiment(exp)
+}
+
+// experimentRemoveTraffic removes the route of an experiment which is not routing traffic and updates its default
+func (p *IncrementalProcessor) experimentRemoveTraffic(exp *experiment.Experiment) error {
+	if err := p.experimentDefaultUpdate(exp); err != nil {
+		return err
+	}
+	return p.removeExperiment(exp)
+}
+
+func (p *IncrementalProcessor) experimentDefaultUpdate(exp *experiment.Experiment) error {
 	logger := p.logger.WithField("func", "experimentSync")
 	if exp.Default != nil {
 		switch exp.ResourceType {
@@ -529,7 +802,7 @@ func (p *IncrementalProcessor) experimentUpdate(exp *experiment.Experiment) erro
 			return fmt.Errorf("Unknown resource type %v", exp.ResourceType)
 		}
 	}
-	return p.addExperiment(exp)
+	return nil
 }
 
 func (p *IncrementalProcessor) modelUpdate(modelName string) error {
@@ -579,6 +852,7 @@ func (p *IncrementalProcessor) modelUpdate(modelName string) error {
 			p.modelStore.UnlockModel(modelName)
 			return err
 		}
+		p.addRemoteModelTraffic(model)
 		modelRemoved = true
 	}
 
@@ -590,6 +864,7 @@ func (p *IncrementalProcessor) modelUpdate(modelName string) error {
 				logger.WithError(err).Errorf("Failed to remove model route from envoy %s", modelName)
 				return err
 			}
+			p.addRemoteModelTraffic(model)
 			modelRemoved = true
 		}
 	}
@@ -138,6 +138,9 @@ func benchmarkModelUpdate(
 				GrpcPort: 2,
 			},
 			nil,
+			nil,
+			nil,
+			nil,
 		)
 		require.NoError(b, err)
 
@@ -11,16 +11,21 @@ package processor
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	. "github.com/onsi/gomega"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 
 	pba "github.com/seldonio/seldon-core/apis/go/v2/mlops/agent"
 	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
 
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/xdscache"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/federation"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/experiment"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/pipeline"
@@ -270,6 +275,101 @@ func TestUpdateEnvoyForModelVersion(t *testing.T) {
 			expectedRoutes:   2,
 			expectedClusters: 4,
 		},
+		{
+			name: "TwoRoutesSameReplicasDifferentClusterPolicies",
+			modelVersions: []*store.ModelVersion{
+				store.NewModelVersion(
+					&scheduler.Model{
+						Meta:           &scheduler.MetaData{Name: "foo"},
+						DeploymentSpec: &scheduler.DeploymentSpec{LogPayloads: false},
+					},
+					1,
+					"server",
+					map[int]store.ReplicaStatus{
+						1: {State: store.Loaded},
+					},
+					false,
+					store.ModelAvailable,
+				),
+				store.NewModelVersion(
+					&scheduler.Model{
+						Meta: &scheduler.MetaData{Name: "bar"},
+						ModelSpec: &scheduler.ModelSpec{
+							TrafficPolicy: &scheduler.TrafficPolicy{
+								OutlierDetection: &scheduler.OutlierDetection{ConsecutiveErrors: 5},
+							},
+						},
+						DeploymentSpec: &scheduler.DeploymentSpec{LogPayloads: false},
+					},
+					1,
+					"server",
+					map[int]store.ReplicaStatus{
+						1: {State: store.Loaded},
+					},
+					false,
+					store.ModelAvailable,
+				),
+			},
+			server: &store.ServerSnapshot{
+				Name: "server",
+				Replicas: map[int]*store.ServerReplica{
+					1: store.NewServerReplica("host1", 8080, 5000, 1, store.NewServer("server", false), nil, 100, 100, 0, nil, 100),
+				},
+			},
+			traffic:          100,
+			expectedRoutes:   2,
+			expectedClusters: 4,
+		},
+		{
+			name: "TwoRoutesSameReplicasSameClusterPolicies",
+			modelVersions: []*store.ModelVersion{
+				store.NewModelVersion(
+					&scheduler.Model{
+						Meta: &scheduler.MetaData{Name: "foo"},
+						ModelSpec: &scheduler.ModelSpec{
+							TrafficPolicy: &scheduler.TrafficPolicy{
+								OutlierDetection: &scheduler.OutlierDetection{ConsecutiveErrors: 5},
+							},
+						},
+						DeploymentSpec: &scheduler.DeploymentSpec{LogPayloads: false},
+					},
+					1,
+					"server",
+					map[int]store.ReplicaStatus{
+						1: {State: store.Loaded},
+					},
+					false,
+					store.ModelAvailable,
+				),
+				store.NewModelVersion(
+					&scheduler.Model{
+						Meta: &scheduler.MetaData{Name: "bar"},
+						ModelSpec: &scheduler.ModelSpec{
+							TrafficPolicy: &scheduler.TrafficPolicy{
+								OutlierDetection: &scheduler.OutlierDetection{ConsecutiveErrors: 5},
+							},
+						},
+						DeploymentSpec: &scheduler.DeploymentSpec{LogPayloads: false},
+					},
+					1,
+					"server",
+					map[int]store.ReplicaStatus{
+						1: {State: store.Loaded},
+					},
+					false,
+					store.ModelAvailable,
+				),
+			},
+			server: &store.ServerSnapshot{
+				Name: "server",
+				Replicas: map[int]*store.ServerReplica{
+					1: store.NewServerReplica("host1", 8080, 5000, 1, store.NewServer("server", false), nil, 100, 100, 0, nil, 100),
+				},
+			},
+			traffic:          100,
+			expectedRoutes:   2,
+			expectedClusters: 4,
+		},
 	}
 
 	for _, test := range tests {
@@ -288,6 +388,52 @@ func TestUpdateEnvoyForModelVersion(t *testing.T) {
 	}
 }
 
+func TestUpdateEnvoyForModelVersionTrafficPolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+	getUintPtr := func(val uint32) *uint32 { return &val }
+	policy := &scheduler.TrafficPolicy{
+		TimeoutMs: getUintPtr(2000),
+		Retry:     &scheduler.RetryPolicy{RetryOn: "5xx", NumRetries: 2},
+		CircuitBreaker: &scheduler.CircuitBreaker{
+			MaxConnections: getUintPtr(10),
+		},
+	}
+	mv := store.NewModelVersion(
+		&scheduler.Model{
+			Meta:           &scheduler.MetaData{Name: "foo"},
+			ModelSpec:      &scheduler.ModelSpec{TrafficPolicy: policy},
+			DeploymentSpec: &scheduler.DeploymentSpec{LogPayloads: false},
+		},
+		1,
+		"server",
+		map[int]store.ReplicaStatus{
+			1: {State: store.Loaded},
+		},
+		false,
+		store.ModelAvailable,
+	)
+	server := &store.ServerSnapshot{
+		Name: "server",
+		Replicas: map[int]*store.ServerReplica{
+			1: store.NewServerReplica("host1", 8080, 5000, 1, store.NewServer("server", false), nil, 100, 100, 0, nil, 100),
+		},
+	}
+	inc := IncrementalProcessor{
+		logger:   log.New(),
+		xdsCache: xdscache.NewSeldonXDSCache(log.New(), &xdscache.PipelineGatewayDetails{}),
+	}
+
+	inc.updateEnvoyForModelVersion("foo", mv, server, 100, false)
+
+	g.Expect(proto.Equal(inc.xdsCache.Routes["foo"].TrafficPolicy, policy)).To(BeTrue())
+	key := resources.GetClusterPolicyKey(policy)
+	g.Expect(key).ToNot(BeEmpty())
+	for clusterName, cluster := range inc.xdsCache.Clusters {
+		g.Expect(clusterName).To(ContainSubstring(key))
+		g.Expect(proto.Equal(cluster.TrafficPolicy, policy)).To(BeTrue())
+	}
+}
+
 func createTestServer(serverName string, numReplicas uint32) func(inc *IncrementalProcessor, g *WithT) {
 	f := func(inc *IncrementalProcessor, g *WithT) {
 		for i := uint32(0); i < numReplicas; i++ {
@@ -311,13 +457,24 @@ func createTestModel(modelName string,
 	replicas []int,
 	version uint32,
 	replicaStates []store.ModelReplicaState) func(inc *IncrementalProcessor, g *WithT) {
+	return createTestRolloutModel(modelName, serverName, desiredReplicas, replicas, version, replicaStates, nil)
+}
+
+func createTestRolloutModel(modelName string,
+	serverName string,
+	desiredReplicas uint32,
+	replicas []int,
+	version uint32,
+	replicaStates []store.ModelReplicaState,
+	rollout *scheduler.RolloutStrategy) func(inc *IncrementalProcessor, g *WithT) {
 	f := func(inc *IncrementalProcessor, g *WithT) {
 		model := &scheduler.Model{
 			Meta: &scheduler.MetaData{
 				Name: modelName,
 			},
 			ModelSpec: &scheduler.ModelSpec{
-				Uri: "gs://" + util.CreateRequestId(), // Create a random uri
+				Uri:     "gs://" + util.CreateRequestId(), // Create a random uri
+				Rollout: rollout,
 			},
 			DeploymentSpec: &scheduler.DeploymentSpec{
 				Replicas: desiredReplicas,
@@ -357,6 +514,21 @@ func createTestModel(modelName string,
 }
 
 func createTestExperiment(experimentName string, modelNames []string, defaultModel *string, mirrorName *string) func(inc *IncrementalProcessor, g *WithT) {
+	return createTestRulesExperiment(experimentName, modelNames, defaultModel, mirrorName, nil)
+}
+
+func createTestRulesExperiment(experimentName string, modelNames []string, defaultModel *string, mirrorName *string, rules []*experiment.Rule) func(inc *IncrementalProcessor, g *WithT) {
+	return createTestExperimentWithComparison(experimentName, modelNames, defaultModel, mirrorName, rules, nil)
+}
+
+func createTestExperimentWithComparison(
+	experimentName string,
+	modelNames []string,
+	defaultModel *string,
+	mirrorName *string,
+	rules []*experiment.Rule,
+	comparison *experiment.ShadowComparison,
+) func(inc *IncrementalProcessor, g *WithT) {
 	f := func(inc *IncrementalProcessor, g *WithT) {
 		var candidates []*experiment.Candidate
 		var mirror *experiment.Mirror
@@ -365,8 +537,9 @@ func createTestExperiment(experimentName string, modelNames []string, defaultMod
 		}
 		if mirrorName != nil {
 			mirror = &experiment.Mirror{
-				Name:    *mirrorName,
-				Percent: 100,
+				Name:       *mirrorName,
+				Percent:    100,
+				Comparison: comparison,
 			}
 		}
 		exp := &experiment.Experiment{
@@ -375,6 +548,9 @@ func createTestExperiment(experimentName string, modelNames []string, defaultMod
 			Candidates: candidates,
 			Mirror:     mirror,
 		}
+		if rules != nil {
+			exp.Config = &experiment.Config{Rules: rules}
+		}
 		err := inc.experimentServer.StartExperiment(exp)
 		g.Expect(err).To(BeNil())
 		err = inc.experimentUpdate(exp)
@@ -383,6 +559,39 @@ func createTestExperiment(experimentName string, modelNames []string, defaultMod
 	return f
 }
 
+// createTestScheduledExperiment starts a scheduled experiment and routes it as its experiment event would
+func createTestScheduledExperiment(
+	experimentName string,
+	modelNames []string,
+	defaultModel *string,
+	schedule *experiment.Schedule,
+	state *experiment.ScheduleState,
+) func(inc *IncrementalProcessor, g *WithT) {
+	f := func(inc *IncrementalProcessor, g *WithT) {
+		var candidates []*experiment.Candidate
+		for _, modelName := range modelNames {
+			candidates = append(candidates, &experiment.Candidate{Name: modelName, Weight: 1})
+		}
+		err := inc.experimentServer.StartExperiment(&experiment.Experiment{
+			Name:          experimentName,
+			Default:       defaultModel,
+			Candidates:    candidates,
+			Config:        &experiment.Config{Schedule: schedule},
+			ScheduleState: state,
+		})
+		g.Expect(err).To(BeNil())
+		exp, err := inc.experimentServer.GetExperiment(experimentName)
+		g.Expect(err).To(BeNil())
+		if exp.RoutesTraffic() {
+			err = inc.experimentUpdate(exp)
+		} else {
+			err = inc.experimentRemoveTraffic(exp)
+		}
+		g.Expect(err).To(BeNil())
+	}
+	return f
+}
+
 func deleteTestExperiment(experimentName string) func(inc *IncrementalProcessor, g *WithT) {
 	f := func(inc *IncrementalProcessor, g *WithT) {
 		err := inc.experimentServer.StopExperiment(experimentName)
@@ -402,9 +611,17 @@ func TestExperiments(t *testing.T) {
 		ops                 []func(proc *IncrementalProcessor, g *WithT)
 		numExpectedClusters int
 		numExpectedRoutes   int
+		numTrafficSplits    map[string]int
+		numRules            map[string]int
+		shadowExperiments   map[string]string
 	}
 
 	getStrPtr := func(t string) *string { return &t }
+	rules := []*experiment.Rule{
+		{Candidate: "model2", Headers: []*experiment.HeaderMatch{{Name: "x-tenant", Exact: getStrPtr("internal")}}},
+	}
+	later := time.Now().Add(time.Hour)
+	earlier := time.Now().Add(-time.Hour)
 	tests := []test{
 		{
 			name: "One model",
@@ -446,6 +663,20 @@ func TestExperiments(t *testing.T) {
 			},
 			numExpectedClusters: 4,
 			numExpectedRoutes:   3,
+			numTrafficSplits:    map[string]int{"model1": 2, "exp.experiment": 2},
+		},
+		{
+			name: "updated experiment",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 2),
+				createTestModel("model1", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.Available}),
+				createTestModel("model2", "server", 1, []int{1}, 1, []store.ModelReplicaState{store.Available}),
+				createTestExperiment("exp", []string{"model1", "model2"}, getStrPtr("model1"), nil),
+				createTestExperiment("exp", []string{"model1", "model2"}, getStrPtr("model1"), nil),
+			},
+			numExpectedClusters: 4,
+			numExpectedRoutes:   3,
+			numTrafficSplits:    map[string]int{"model1": 2, "exp.experiment": 2},
 		},
 		{
 			name: "delete experiment",
@@ -470,6 +701,109 @@ func TestExperiments(t *testing.T) {
 			numExpectedClusters: 4,
 			numExpectedRoutes:   3,
 		},
+		{
+			name: "rules",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 2),
+				createTestModel("model1", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.Available}),
+				createTestModel("model2", "server", 1, []int{1}, 1, []store.ModelReplicaState{store.Available}),
+				createTestRulesExperiment("exp", []string{"model1", "model2"}, getStrPtr("model1"), nil, rules),
+			},
+			numExpectedClusters: 4,
+			numExpectedRoutes:   3,
+			numTrafficSplits:    map[string]int{"model1": 2, "exp.experiment": 2},
+			numRules:            map[string]int{"model1": 1, "exp.experiment": 1, "model2": 0},
+		},
+		{
+			name: "mirror comparison",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 2),
+				createTestModel("model1", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.Available}),
+				createTestModel("model2", "server", 1, []int{1}, 1, []store.ModelReplicaState{store.Available}),
+				createTestExperimentWithComparison("exp", []string{"model1"}, getStrPtr("model1"), getStrPtr("model2"), nil, &experiment.ShadowComparison{}),
+			},
+			numExpectedClusters: 4,
+			numExpectedRoutes:   3,
+			shadowExperiments:   map[string]string{"model1": "exp", "exp.experiment": "exp", "model2": ""},
+		},
+		{
+			name: "mirror comparison removed",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 2),
+				createTestModel("model1", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.Available}),
+				createTestModel("model2", "server", 1, []int{1}, 1, []store.ModelReplicaState{store.Available}),
+				createTestExperimentWithComparison("exp", []string{"model1"}, getStrPtr("model1"), getStrPtr("model2"), nil, &experiment.ShadowComparison{}),
+				createTestExperiment("exp", []string{"model1"}, getStrPtr("model1"), getStrPtr("model2")),
+			},
+			numExpectedClusters: 4,
+			numExpectedRoutes:   3,
+			shadowExperiments:   map[string]string{"model1": "", "exp.experiment": ""},
+		},
+		{
+			name: "pending scheduled experiment",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 2),
+				createTestModel("model1", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.Available}),
+				createTestModel("model2", "server", 1, []int{1}, 1, []store.ModelReplicaState{store.Available}),
+				createTestScheduledExperiment("exp", []string{"model1", "model2"}, getStrPtr("model1"), &experiment.Schedule{StartTime: &later}, nil),
+			},
+			numExpectedClusters: 4,
+			numExpectedRoutes:   2,
+			numTrafficSplits:    map[string]int{"model1": 1},
+		},
+		{
+			name: "running experiment rescheduled",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 2),
+				createTestModel("model1", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.Available}),
+				createTestModel("model2", "server", 1, []int{1}, 1, []store.ModelReplicaState{store.Available}),
+				createTestExperiment("exp", []string{"model1", "model2"}, getStrPtr("model1"), nil),
+				createTestScheduledExperiment("exp", []string{"model1", "model2"}, getStrPtr("model1"), &experiment.Schedule{StartTime: &later}, nil),
+			},
+			numExpectedClusters: 4,
+			numExpectedRoutes:   2,
+			numTrafficSplits:    map[string]int{"model1": 1},
+		},
+		{
+			name: "running scheduled experiment",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 2),
+				createTestModel("model1", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.Available}),
+				createTestModel("model2", "server", 1, []int{1}, 1, []store.ModelReplicaState{store.Available}),
+				createTestScheduledExperiment("exp", []string{"model1", "model2"}, getStrPtr("model1"), &experiment.Schedule{StartTime: &earlier, EndTime: &later}, nil),
+			},
+			numExpectedClusters: 4,
+			numExpectedRoutes:   3,
+			numTrafficSplits:    map[string]int{"model1": 2, "exp.experiment": 2},
+		},
+		{
+			name: "concluded experiment",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 2),
+				createTestModel("model1", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.Available}),
+				createTestModel("model2", "server", 1, []int{1}, 1, []store.ModelReplicaState{store.Available}),
+				createTestScheduledExperiment("exp", []string{"model1", "model2"}, getStrPtr("model1"),
+					&experiment.Schedule{EndTime: &earlier},
+					&experiment.ScheduleState{StartedAt: &earlier, ConcludedAt: &earlier}),
+			},
+			numExpectedClusters: 4,
+			numExpectedRoutes:   2,
+			numTrafficSplits:    map[string]int{"model1": 1},
+		},
+		{
+			name: "concluded experiment with promotion",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 2),
+				createTestModel("model1", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.Available}),
+				createTestModel("model2", "server", 1, []int{1}, 1, []store.ModelReplicaState{store.Available}),
+				createTestScheduledExperiment("exp", []string{"model1", "model2"}, getStrPtr("model1"),
+					&experiment.Schedule{EndTime: &earlier},
+					&experiment.ScheduleState{StartedAt: &earlier, ConcludedAt: &earlier, PromotedCandidate: getStrPtr("model2")}),
+			},
+			numExpectedClusters: 4,
+			numExpectedRoutes:   3,
+			numTrafficSplits:    map[string]int{"model1": 2, "exp.experiment": 2},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -488,6 +822,15 @@ func TestExperiments(t *testing.T) {
 			}
 			g.Expect(len(inc.xdsCache.Clusters)).To(Equal(test.numExpectedClusters))
 			g.Expect(len(inc.xdsCache.Routes)).To(Equal(test.numExpectedRoutes))
+			for routeName, trafficSplits := range test.numTrafficSplits {
+				g.Expect(len(inc.xdsCache.Routes[routeName].Clusters)).To(Equal(trafficSplits))
+			}
+			for routeName, rules := range test.numRules {
+				g.Expect(len(inc.xdsCache.Routes[routeName].Rules)).To(Equal(rules))
+			}
+			for routeName, experimentName := range test.shadowExperiments {
+				g.Expect(inc.xdsCache.Routes[routeName].ShadowExperiment).To(Equal(experimentName))
+			}
 		})
 	}
 
@@ -552,6 +895,117 @@ func TestRollingUpdate(t *testing.T) {
 	}
 }
 
+func updateTestRollout(modelName string, update func(rollout *store.ModelRollout)) func(inc *IncrementalProcessor, g *WithT) {
+	f := func(inc *IncrementalProcessor, g *WithT) {
+		model, err := inc.modelStore.GetModel(modelName)
+		g.Expect(err).To(BeNil())
+		update(model.Rollout)
+		err = inc.modelStore.SetModelRollout(modelName, model.Rollout)
+		g.Expect(err).To(BeNil())
+		err = inc.modelUpdate(modelName)
+		g.Expect(err).To(BeNil())
+	}
+	return f
+}
+
+func TestModelRollout(t *testing.T) {
+	g := NewGomegaWithT(t)
+	type test struct {
+		name            string
+		ops             []func(proc *IncrementalProcessor, g *WithT)
+		expectedTraffic map[uint32]uint32
+	}
+
+	rollout := &scheduler.RolloutStrategy{
+		Steps: []*scheduler.RolloutStep{
+			{TrafficPercent: 10, HoldSeconds: 60},
+			{TrafficPercent: 50, HoldSeconds: 60},
+		},
+	}
+	tests := []test{
+		{
+			name: "first step",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 2),
+				createTestModel("model", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.Available}),
+				createTestRolloutModel("model", "server", 1, []int{1}, 2, []store.ModelReplicaState{store.Available}, rollout),
+			},
+			expectedTraffic: map[uint32]uint32{1: 90, 2: 10},
+		},
+		{
+			name: "new version not loaded",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 2),
+				createTestModel("model", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.Available}),
+				createTestRolloutModel("model", "server", 1, []int{1}, 2, []store.ModelReplicaState{store.Loading}, rollout),
+			},
+			expectedTraffic: map[uint32]uint32{1: 100},
+		},
+		{
+			name: "next step",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 2),
+				createTestModel("model", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.Available}),
+				createTestRolloutModel("model", "server", 1, []int{1}, 2, []store.ModelReplicaState{store.Available}, rollout),
+				updateTestRollout("model", func(rollout *store.ModelRollout) { rollout.Step = 1 }),
+			},
+			expectedTraffic: map[uint32]uint32{1: 50, 2: 50},
+		},
+		{
+			name: "paused",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 2),
+				createTestModel("model", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.Available}),
+				createTestRolloutModel("model", "server", 1, []int{1}, 2, []store.ModelReplicaState{store.Available}, rollout),
+				updateTestRollout("model", func(rollout *store.ModelRollout) { rollout.State = store.RolloutPaused }),
+			},
+			expectedTraffic: map[uint32]uint32{1: 90, 2: 10},
+		},
+		{
+			name: "rolled back",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 2),
+				createTestModel("model", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.Available}),
+				createTestRolloutModel("model", "server", 1, []int{1}, 2, []store.ModelReplicaState{store.Available}, rollout),
+				updateTestRollout("model", func(rollout *store.ModelRollout) { rollout.State = store.RolloutRolledBack }),
+			},
+			expectedTraffic: map[uint32]uint32{1: 100},
+		},
+		{
+			name: "succeeded",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 2),
+				createTestModel("model", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.Available}),
+				createTestRolloutModel("model", "server", 1, []int{1}, 2, []store.ModelReplicaState{store.Available}, rollout),
+				updateTestRollout("model", func(rollout *store.ModelRollout) { rollout.State = store.RolloutSucceeded }),
+			},
+			expectedTraffic: map[uint32]uint32{2: 100},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			modelStore := store.NewMemoryStore(log.New(), store.NewLocalSchedulerStore(), nil)
+			inc := &IncrementalProcessor{
+				cache:            cache.NewSnapshotCache(false, cache.IDHash{}, log.New()),
+				logger:           log.New(),
+				xdsCache:         xdscache.NewSeldonXDSCache(log.New(), &xdscache.PipelineGatewayDetails{Host: "pipeline", GrpcPort: 1, HttpPort: 2}),
+				modelStore:       modelStore,
+				experimentServer: experiment.NewExperimentServer(log.New(), nil, nil, nil),
+				pipelineHandler:  pipeline.NewPipelineStore(log.New(), nil, modelStore),
+			}
+			inc.xdsCache.AddListeners()
+			for _, op := range test.ops {
+				op(inc, g)
+			}
+			traffic := make(map[uint32]uint32)
+			for _, split := range inc.xdsCache.Routes["model"].Clusters {
+				traffic[split.ModelVersion] = split.TrafficWeight
+			}
+			g.Expect(traffic).To(Equal(test.expectedTraffic))
+		})
+	}
+}
+
 func TestDraining(t *testing.T) {
 	g := NewGomegaWithT(t)
 	type test struct {
@@ -776,3 +1230,153 @@ func TestModelSync(t *testing.T) {
 		})
 	}
 }
+
+type fakeRemoteModels struct {
+	region string
+	models map[string][]federation.RemoteModel
+}
+
+func (f *fakeRemoteModels) GetRegion() string {
+	return f.region
+}
+
+func (f *fakeRemoteModels) GetRemoteModels(model *scheduler.Model) []federation.RemoteModel {
+	return f.models[model.GetMeta().GetName()]
+}
+
+func TestFederation(t *testing.T) {
+	g := NewGomegaWithT(t)
+	type test struct {
+		name               string
+		ops                []func(proc *IncrementalProcessor, g *WithT)
+		routeName          string
+		expectedRoute      bool
+		expectedLocal      int
+		expectedRegions    []string
+		expectedFederation bool // cluster with only remote endpoints
+		expectedForwarded  int  // local replicas of requests forwarded from other regions
+	}
+
+	remoteModels := &fakeRemoteModels{region: "ap", models: map[string][]federation.RemoteModel{
+		"model": {
+			{Region: "eu", Host: "eu.seldon", Port: 80, Replicas: 2},
+			{Region: "us", Host: "us.seldon", Port: 80, Replicas: 1},
+		},
+	}}
+	tests := []test{
+		{
+			name: "local replicas fail over to other regions",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 1),
+				createTestModel("model", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.Available}),
+			},
+			routeName:         "model",
+			expectedRoute:     true,
+			expectedLocal:     1,
+			expectedRegions:   []string{"eu", "us"},
+			expectedForwarded: 1,
+		},
+		{
+			name: "no local replica sends traffic to other regions",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 1),
+				createTestModel("model", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.LoadFailed}),
+			},
+			routeName:          "model",
+			expectedRoute:      true,
+			expectedRegions:    []string{"eu", "us"},
+			expectedFederation: true,
+		},
+		{
+			name: "not available in other regions",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 1),
+				createTestModel("model2", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.LoadFailed}),
+			},
+			routeName: "model2",
+		},
+		{
+			name: "experiment candidates stay local",
+			ops: []func(inc *IncrementalProcessor, g *WithT){
+				createTestServer("server", 2),
+				createTestModel("model", "server", 1, []int{0}, 1, []store.ModelReplicaState{store.Available}),
+				createTestModel("model2", "server", 1, []int{1}, 1, []store.ModelReplicaState{store.Available}),
+				createTestExperiment("exp", []string{"model", "model2"}, nil, nil),
+			},
+			routeName:     "exp.experiment",
+			expectedRoute: true,
+			expectedLocal: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			memoryStore := store.NewMemoryStore(log.New(), store.NewLocalSchedulerStore(), nil)
+			inc := &IncrementalProcessor{
+				cache:            cache.NewSnapshotCache(false, cache.IDHash{}, log.New()),
+				logger:           log.New(),
+				xdsCache:         xdscache.NewSeldonXDSCache(log.New(), &xdscache.PipelineGatewayDetails{Host: "pipeline", GrpcPort: 1, HttpPort: 2}),
+				modelStore:       memoryStore,
+				experimentServer: experiment.NewExperimentServer(log.New(), nil, nil, nil),
+				pipelineHandler:  pipeline.NewPipelineStore(log.New(), nil, memoryStore),
+				remoteModels:     remoteModels,
+			}
+			inc.xdsCache.AddListeners()
+			for _, op := range test.ops {
+				op(inc, g)
+			}
+			route, ok := inc.xdsCache.Routes[test.routeName]
+			g.Expect(ok).To(Equal(test.expectedRoute))
+			if !test.expectedRoute {
+				return
+			}
+			if len(test.expectedRegions) > 0 {
+				g.Expect(route.FederationRegion).To(Equal("ap"))
+			} else {
+				g.Expect(route.FederationRegion).To(BeEmpty())
+			}
+			var forwarded int
+			for _, split := range route.LocalClusters {
+				for _, clusterName := range []string{split.HttpCluster, split.GrpcCluster} {
+					cluster, ok := inc.xdsCache.Clusters[clusterName]
+					g.Expect(ok).To(BeTrue())
+					for _, endpoint := range cluster.Endpoints {
+						g.Expect(endpoint.Region).To(BeEmpty())
+						forwarded++
+					}
+				}
+			}
+			g.Expect(forwarded).To(Equal(test.expectedForwarded * 2))
+			var local int
+			regions := make(map[string]bool)
+			for _, split := range route.Clusters {
+				for _, clusterName := range []string{split.HttpCluster, split.GrpcCluster} {
+					cluster, ok := inc.xdsCache.Clusters[clusterName]
+					g.Expect(ok).To(BeTrue())
+					g.Expect(strings.HasPrefix(clusterName, resources.FederationClusterPrefix)).To(Equal(test.expectedFederation))
+					for _, endpoint := range cluster.Endpoints {
+						if endpoint.Region == "" {
+							local++
+						} else {
+							regions[endpoint.Region] = true
+						}
+					}
+				}
+			}
+			g.Expect(local).To(Equal(test.expectedLocal * 2)) // http and grpc clusters
+			g.Expect(regions).To(HaveLen(len(test.expectedRegions)))
+			for _, region := range test.expectedRegions {
+				g.Expect(regions).To(HaveKey(region))
+			}
+			g.Expect(inc.updateEnvoy()).To(BeNil())
+			// Removing the route releases the clusters of forwarded requests too
+			g.Expect(inc.xdsCache.RemoveRoute(test.routeName)).To(BeNil())
+			for _, split := range append(route.Clusters, route.LocalClusters...) {
+				for _, clusterName := range []string{split.HttpCluster, split.GrpcCluster} {
+					for key := range inc.xdsCache.Clusters[clusterName].Routes {
+						g.Expect(key.RouteName).ToNot(Equal(test.routeName))
+					}
+				}
+			}
+		})
+	}
+}
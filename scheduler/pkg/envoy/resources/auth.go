@@ -0,0 +1,471 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	rbacconfig "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	jwtauthn "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gopkg.in/yaml.v2"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+const (
+	APIKeyHeader = "x-api-key"
+	// JWTs are read from the default header of the JWT authentication filter
+	authorizationHeader = "authorization"
+	// InternalScope is allowed on every route so the model and pipeline gateways can call models and pipelines
+	// with authorization rules through Envoy
+	InternalScope = "seldon:internal"
+	// JwksClusterName is the cluster used to fetch a remote JWKS
+	JwksClusterName = "jwks"
+
+	jwtAuthnFilterName      = "envoy.filters.http.jwt_authn"
+	jwtProviderName         = "seldon"
+	jwtPayloadMetadataKey   = "jwt_payload"
+	jwtSubjectClaim         = "sub"
+	jwtScopeClaim           = "scope"
+	authenticatedPolicyName = "authenticated"
+	authorizedPolicyName    = "authorized"
+	jwksCacheDuration       = 5 * time.Minute
+	jwksFetchTimeout        = 5 * time.Second
+	jwksTrustedCAFile       = "/etc/ssl/certs/ca-certificates.crt"
+	defaultHttpsPort        = 443
+	defaultHttpPort         = 80
+	jwksUriSchemeHttps      = "https"
+)
+
+// AuthConfig turns on authentication of requests on the seldon-mesh listener. Requests must have a valid JWT or
+// API key, and authorization rules of models and pipelines are checked against the subject and scopes of the caller.
+type AuthConfig struct {
+	JWT     *JWTConfig
+	APIKeys []APIKey
+}
+
+type JWTConfig struct {
+	Issuer    string
+	Audiences []string
+	// One of the JWKS file or URI is needed
+	JwksFile string
+	JwksUri  string
+}
+
+type APIKey struct {
+	Key     string   `yaml:"key"`
+	Subject string   `yaml:"subject"`
+	Scopes  []string `yaml:"scopes,omitempty"`
+}
+
+type apiKeysFile struct {
+	Keys []APIKey `yaml:"keys"`
+}
+
+// LoadAPIKeys reads API keys from a YAML file with a list of keys, each with a subject and optional scopes
+func LoadAPIKeys(path string) ([]APIKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keysFile := apiKeysFile{}
+	if err := yaml.Unmarshal(data, &keysFile); err != nil {
+		return nil, err
+	}
+	if len(keysFile.Keys) == 0 {
+		return nil, fmt.Errorf("%s: API key file has no keys", path)
+	}
+	for idx, apiKey := range keysFile.Keys {
+		if apiKey.Key == "" || apiKey.Subject == "" {
+			return nil, fmt.Errorf("%s: API key %d needs a key and a subject", path, idx)
+		}
+	}
+	return keysFile.Keys, nil
+}
+
+// InternalAPIKey returns the first API key with the internal scope, which Envoy uses to authenticate its own calls to
+// the gateways
+func (a *AuthConfig) InternalAPIKey() string {
+	if a == nil {
+		return ""
+	}
+	for _, apiKey := range a.APIKeys {
+		for _, scope := range apiKey.Scopes {
+			if scope == InternalScope {
+				return apiKey.Key
+			}
+		}
+	}
+	return ""
+}
+
+func (a *AuthConfig) Validate() error {
+	if a.JWT == nil && len(a.APIKeys) == 0 {
+		return fmt.Errorf("authentication needs a JWT issuer or API keys")
+	}
+	// The model and pipeline gateways call models and pipelines through Envoy with an API key, even with JWTs
+	if a.InternalAPIKey() == "" {
+		return fmt.Errorf("authentication needs an API key with the %s scope for the model and pipeline gateways", InternalScope)
+	}
+	if a.JWT != nil {
+		if a.JWT.Issuer == "" {
+			return fmt.Errorf("JWT authentication needs an issuer")
+		}
+		if (a.JWT.JwksFile == "") == (a.JWT.JwksUri == "") {
+			return fmt.Errorf("JWT authentication needs one of a JWKS file or URI")
+		}
+		if a.JWT.JwksUri != "" {
+			if _, _, _, err := parseJwksUri(a.JWT.JwksUri); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func parseJwksUri(jwksUri string) (string, uint32, bool, error) {
+	u, err := url.Parse(jwksUri)
+	if err != nil {
+		return "", 0, false, err
+	}
+	if u.Hostname() == "" {
+		return "", 0, false, fmt.Errorf("JWKS URI %s has no host", jwksUri)
+	}
+	isTLS := u.Scheme == jwksUriSchemeHttps
+	port := defaultHttpPort
+	if isTLS {
+		port = defaultHttpsPort
+	}
+	if u.Port() != "" {
+		port, err = strconv.Atoi(u.Port())
+		if err != nil {
+			return "", 0, false, err
+		}
+	}
+	return u.Hostname(), uint32(port), isTLS, nil
+}
+
+// MakeJwksCluster creates the cluster for fetching a remote JWKS. The certificate of HTTPS servers is checked
+// against the CA bundle of the Envoy image.
+func MakeJwksCluster(jwksUri string) (*cluster.Cluster, error) {
+	host, port, isTLS, err := parseJwksUri(jwksUri)
+	if err != nil {
+		return nil, err
+	}
+	c := &cluster.Cluster{
+		Name:                 JwksClusterName,
+		ConnectTimeout:       durationpb.New(5 * time.Second),
+		ClusterDiscoveryType: &cluster.Cluster_Type{Type: cluster.Cluster_LOGICAL_DNS},
+		LoadAssignment: MakeEndpoint(JwksClusterName, []Endpoint{
+			{UpstreamHost: host, UpstreamPort: port},
+		}),
+		DnsLookupFamily: cluster.Cluster_V4_ONLY,
+	}
+	if isTLS {
+		tlsCtx := &tlsv3.UpstreamTlsContext{
+			Sni: host,
+			CommonTlsContext: &tlsv3.CommonTlsContext{
+				ValidationContextType: &tlsv3.CommonTlsContext_ValidationContext{
+					ValidationContext: &tlsv3.CertificateValidationContext{
+						TrustedCa: &core.DataSource{
+							Specifier: &core.DataSource_Filename{Filename: jwksTrustedCAFile},
+						},
+					},
+				},
+			},
+		}
+		tlsCtxPb, err := anypb.New(tlsCtx)
+		if err != nil {
+			return nil, err
+		}
+		c.TransportSocket = &core.TransportSocket{
+			Name:       wellknown.TransportSocketTls,
+			ConfigType: &core.TransportSocket_TypedConfig{TypedConfig: tlsCtxPb},
+		}
+	}
+	return c, nil
+}
+
+func createJwtProvider(jwtConfig *JWTConfig) *jwtauthn.JwtProvider {
+	provider := &jwtauthn.JwtProvider{
+		Issuer:            jwtConfig.Issuer,
+		Audiences:         jwtConfig.Audiences,
+		PayloadInMetadata: jwtPayloadMetadataKey,
+		// Kept for requests forwarded to other regions, routes to model servers remove it
+		Forward: true,
+		// OAuth scopes are a space separated string, which is split so scopes can be matched one by one
+		NormalizePayloadInMetadata: &jwtauthn.JwtProvider_NormalizePayload{
+			SpaceDelimitedClaims: []string{jwtScopeClaim},
+		},
+	}
+	if jwtConfig.JwksFile != "" {
+		provider.JwksSourceSpecifier = &jwtauthn.JwtProvider_LocalJwks{
+			LocalJwks: &core.DataSource{
+				Specifier: &core.DataSource_Filename{Filename: jwtConfig.JwksFile},
+			},
+		}
+	} else {
+		provider.JwksSourceSpecifier = &jwtauthn.JwtProvider_RemoteJwks{
+			RemoteJwks: &jwtauthn.RemoteJwks{
+				HttpUri: &core.HttpUri{
+					Uri:              jwtConfig.JwksUri,
+					HttpUpstreamType: &core.HttpUri_Cluster{Cluster: JwksClusterName},
+					Timeout:          durationpb.New(jwksFetchTimeout),
+				},
+				CacheDuration: durationpb.New(jwksCacheDuration),
+				AsyncFetch:    &jwtauthn.JwksAsyncFetch{},
+			},
+		}
+	}
+	return provider
+}
+
+// createJwtAuthnFilter rejects requests with invalid tokens. Requests without a token are let through when API keys
+// are also accepted, and are then rejected by the RBAC filter if they have no valid API key either.
+func createJwtAuthnFilter(auth *AuthConfig) *anypb.Any {
+	requirement := &jwtauthn.JwtRequirement{
+		RequiresType: &jwtauthn.JwtRequirement_ProviderName{ProviderName: jwtProviderName},
+	}
+	if len(auth.APIKeys) > 0 {
+		requirement = &jwtauthn.JwtRequirement{
+			RequiresType: &jwtauthn.JwtRequirement_RequiresAny{
+				RequiresAny: &jwtauthn.JwtRequirementOrList{
+					Requirements: []*jwtauthn.JwtRequirement{
+						requirement,
+						{RequiresType: &jwtauthn.JwtRequirement_AllowMissing{AllowMissing: &emptypb.Empty{}}},
+					},
+				},
+			},
+		}
+	}
+	jwtFilter := &jwtauthn.JwtAuthentication{
+		Providers: map[string]*jwtauthn.JwtProvider{
+			jwtProviderName: createJwtProvider(auth.JWT),
+		},
+		Rules: []*jwtauthn.RequirementRule{
+			{
+				Match:           &route.RouteMatch{PathSpecifier: &route.RouteMatch_Prefix{Prefix: "/"}},
+				RequirementType: &jwtauthn.RequirementRule_Requires{Requires: requirement},
+			},
+		},
+	}
+	jwtAny, err := anypb.New(jwtFilter)
+	if err != nil {
+		panic(err)
+	}
+	return jwtAny
+}
+
+func jwtClaimMatcher(claim string, value *matcherv3.ValueMatcher) *rbacconfig.Principal {
+	return &rbacconfig.Principal{
+		Identifier: &rbacconfig.Principal_Metadata{
+			Metadata: &matcherv3.MetadataMatcher{
+				Filter: jwtAuthnFilterName,
+				Path: []*matcherv3.MetadataMatcher_PathSegment{
+					{Segment: &matcherv3.MetadataMatcher_PathSegment_Key{Key: jwtPayloadMetadataKey}},
+					{Segment: &matcherv3.MetadataMatcher_PathSegment_Key{Key: claim}},
+				},
+				Value: value,
+			},
+		},
+	}
+}
+
+func exactValue(value string) *matcherv3.ValueMatcher {
+	return &matcherv3.ValueMatcher{
+		MatchPattern: &matcherv3.ValueMatcher_StringMatch{
+			StringMatch: &matcherv3.StringMatcher{
+				MatchPattern: &matcherv3.StringMatcher_Exact{Exact: value},
+			},
+		},
+	}
+}
+
+func apiKeyPrincipal(apiKey APIKey) *rbacconfig.Principal {
+	return &rbacconfig.Principal{
+		Identifier: &rbacconfig.Principal_Header{
+			Header: &route.HeaderMatcher{
+				Name: APIKeyHeader,
+				HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+					StringMatch: &matcherv3.StringMatcher{
+						MatchPattern: &matcherv3.StringMatcher_Exact{Exact: apiKey.Key},
+					},
+				},
+			},
+		},
+	}
+}
+
+// createAuthenticatedPrincipals matches callers with a valid JWT or a known API key
+func createAuthenticatedPrincipals(auth *AuthConfig) []*rbacconfig.Principal {
+	var principals []*rbacconfig.Principal
+	if auth.JWT != nil {
+		principals = append(principals, jwtClaimMatcher(jwtSubjectClaim, &matcherv3.ValueMatcher{
+			MatchPattern: &matcherv3.ValueMatcher_PresentMatch{PresentMatch: true},
+		}))
+	}
+	for _, apiKey := range auth.APIKeys {
+		principals = append(principals, apiKeyPrincipal(apiKey))
+	}
+	return principals
+}
+
+// createAuthorizedPrincipals matches callers with one of the subjects or scopes of the policy, or the internal scope
+func createAuthorizedPrincipals(auth *AuthConfig, authz *scheduler.AuthorizationPolicy) []*rbacconfig.Principal {
+	subjects := map[string]bool{}
+	for _, subject := range authz.GetSubjects() {
+		subjects[subject] = true
+	}
+	scopes := map[string]bool{InternalScope: true}
+	for _, scope := range authz.GetScopes() {
+		scopes[scope] = true
+	}
+
+	var principals []*rbacconfig.Principal
+	if auth.JWT != nil {
+		for _, subject := range sortedKeys(subjects) {
+			principals = append(principals, jwtClaimMatcher(jwtSubjectClaim, exactValue(subject)))
+		}
+		for _, scope := range sortedKeys(scopes) {
+			principals = append(principals, jwtClaimMatcher(jwtScopeClaim, &matcherv3.ValueMatcher{
+				MatchPattern: &matcherv3.ValueMatcher_ListMatch{
+					ListMatch: &matcherv3.ListMatcher{
+						MatchPattern: &matcherv3.ListMatcher_OneOf{OneOf: exactValue(scope)},
+					},
+				},
+			}))
+		}
+	}
+	for _, apiKey := range auth.APIKeys {
+		allowed := subjects[apiKey.Subject]
+		for _, scope := range apiKey.Scopes {
+			allowed = allowed || scopes[scope]
+		}
+		if allowed {
+			principals = append(principals, apiKeyPrincipal(apiKey))
+		}
+	}
+	return principals
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func createRbacRules(policyName string, principals []*rbacconfig.Principal) *rbacconfig.RBAC {
+	rules := &rbacconfig.RBAC{
+		Action:   rbacconfig.RBAC_ALLOW,
+		Policies: map[string]*rbacconfig.Policy{},
+	}
+	// An allow rule without policies denies all requests
+	if len(principals) > 0 {
+		rules.Policies[policyName] = &rbacconfig.Policy{
+			Permissions: []*rbacconfig.Permission{
+				{Rule: &rbacconfig.Permission_Any{Any: true}},
+			},
+			Principals: principals,
+		}
+	}
+	return rules
+}
+
+func createRbacFilter(auth *AuthConfig) *anypb.Any {
+	rbacAny, err := anypb.New(&rbac.RBAC{
+		Rules: createRbacRules(authenticatedPolicyName, createAuthenticatedPrincipals(auth)),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return rbacAny
+}
+
+// createRbacPerRoute replaces the listener rules with the authorization policy of the model or pipeline. It returns
+// nil if authentication is off or the route has no policy, leaving the listener rules in place.
+func createRbacPerRoute(auth *AuthConfig, authz *scheduler.AuthorizationPolicy) *anypb.Any {
+	if auth == nil || authz == nil {
+		return nil
+	}
+	rbacAny, err := anypb.New(&rbac.RBACPerRoute{
+		Rbac: &rbac.RBAC{
+			Rules: createRbacRules(authorizedPolicyName, createAuthorizedPrincipals(auth, authz)),
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return rbacAny
+}
+
+// getCredentialHeaders returns the request headers holding the API key or JWT of the caller
+func getCredentialHeaders(auth *AuthConfig) []string {
+	if auth == nil {
+		return nil
+	}
+	var headers []string
+	if len(auth.APIKeys) > 0 {
+		headers = append(headers, APIKeyHeader)
+	}
+	if auth.JWT != nil {
+		headers = append(headers, authorizationHeader)
+	}
+	return headers
+}
+
+// removeRouteCredentials stops the credentials of the caller reaching the upstream of the route
+func removeRouteCredentials(rt *route.Route, credentialHeaders []string) {
+	rt.RequestHeadersToRemove = append(rt.RequestHeadersToRemove, credentialHeaders...)
+}
+
+func setRouteAuthorization(rt *route.Route, rbacPerRoute *anypb.Any) {
+	if rbacPerRoute != nil {
+		setTypedPerFilterConfig(rt, wellknown.HTTPRoleBasedAccessControl, rbacPerRoute)
+	}
+}
+
+// createAuthFilters returns the filters to check the JWT or API key of requests, which must come after the filter
+// setting the model header so the RBAC filter sees the route of the model
+func createAuthFilters(auth *AuthConfig) []*hcm.HttpFilter {
+	if auth == nil {
+		return nil
+	}
+	var filters []*hcm.HttpFilter
+	if auth.JWT != nil {
+		filters = append(filters, &hcm.HttpFilter{
+			Name:       jwtAuthnFilterName,
+			ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: createJwtAuthnFilter(auth)},
+		})
+	}
+	filters = append(filters, &hcm.HttpFilter{
+		Name:       wellknown.HTTPRoleBasedAccessControl,
+		ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: createRbacFilter(auth)},
+	})
+	return filters
+}
@@ -0,0 +1,454 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package resources
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	. "github.com/onsi/gomega"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+func TestLoadAPIKeys(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name     string
+		contents string
+		expected []APIKey
+		err      bool
+	}
+
+	tests := []test{
+		{
+			name: "keys",
+			contents: `keys:
+- key: abc
+  subject: team-a
+- key: def
+  subject: modelgateway
+  scopes:
+  - seldon:internal
+`,
+			expected: []APIKey{
+				{Key: "abc", Subject: "team-a"},
+				{Key: "def", Subject: "modelgateway", Scopes: []string{InternalScope}},
+			},
+		},
+		{
+			name:     "no keys",
+			contents: "keys: []\n",
+			err:      true,
+		},
+		{
+			name: "missing subject",
+			contents: `keys:
+- key: abc
+`,
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "keys.yaml")
+			g.Expect(os.WriteFile(path, []byte(test.contents), 0600)).To(BeNil())
+			apiKeys, err := LoadAPIKeys(path)
+			if test.err {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(apiKeys).To(Equal(test.expected))
+			}
+		})
+	}
+}
+
+func TestAuthConfigValidate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name string
+		auth *AuthConfig
+		err  bool
+	}
+
+	gatewayKeys := []APIKey{{Key: "gw", Subject: "gateway", Scopes: []string{InternalScope}}}
+	tests := []test{
+		{
+			name: "api keys",
+			auth: &AuthConfig{APIKeys: []APIKey{{Key: "abc", Subject: "a"}, gatewayKeys[0]}},
+		},
+		{
+			name: "jwks file",
+			auth: &AuthConfig{JWT: &JWTConfig{Issuer: "https://issuer", JwksFile: "/etc/jwks.json"}, APIKeys: gatewayKeys},
+		},
+		{
+			name: "jwks uri",
+			auth: &AuthConfig{JWT: &JWTConfig{Issuer: "https://issuer", JwksUri: "https://issuer/.well-known/jwks.json"}, APIKeys: gatewayKeys},
+		},
+		{
+			name: "nothing set",
+			auth: &AuthConfig{},
+			err:  true,
+		},
+		{
+			name: "api keys without internal scope",
+			auth: &AuthConfig{APIKeys: []APIKey{{Key: "abc", Subject: "a"}}},
+			err:  true,
+		},
+		{
+			name: "jwt only",
+			auth: &AuthConfig{JWT: &JWTConfig{Issuer: "https://issuer", JwksFile: "/etc/jwks.json"}},
+			err:  true,
+		},
+		{
+			name: "no issuer",
+			auth: &AuthConfig{JWT: &JWTConfig{JwksFile: "/etc/jwks.json"}},
+			err:  true,
+		},
+		{
+			name: "jwks file and uri",
+			auth: &AuthConfig{JWT: &JWTConfig{Issuer: "https://issuer", JwksFile: "/etc/jwks.json", JwksUri: "https://issuer/jwks"}},
+			err:  true,
+		},
+		{
+			name: "no jwks",
+			auth: &AuthConfig{JWT: &JWTConfig{Issuer: "https://issuer"}},
+			err:  true,
+		},
+		{
+			name: "jwks uri without host",
+			auth: &AuthConfig{JWT: &JWTConfig{Issuer: "https://issuer", JwksUri: "/jwks"}},
+			err:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.auth.Validate()
+			if test.err {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestInternalAPIKey(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name     string
+		auth     *AuthConfig
+		expected string
+	}
+
+	tests := []test{
+		{
+			name:     "no auth",
+			expected: "",
+		},
+		{
+			name:     "no internal key",
+			auth:     &AuthConfig{APIKeys: []APIKey{{Key: "k1", Subject: "user", Scopes: []string{"read"}}}},
+			expected: "",
+		},
+		{
+			name: "first internal key",
+			auth: &AuthConfig{APIKeys: []APIKey{
+				{Key: "k1", Subject: "user"},
+				{Key: "k2", Subject: "gateway", Scopes: []string{InternalScope}},
+				{Key: "k3", Subject: "gateway2", Scopes: []string{InternalScope}},
+			}},
+			expected: "k2",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(test.auth.InternalAPIKey()).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestMakeJwksCluster(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name         string
+		uri          string
+		expectedHost string
+		expectedPort uint32
+		expectedTLS  bool
+	}
+
+	tests := []test{
+		{
+			name:         "https",
+			uri:          "https://issuer.example.com/.well-known/jwks.json",
+			expectedHost: "issuer.example.com",
+			expectedPort: 443,
+			expectedTLS:  true,
+		},
+		{
+			name:         "http with port",
+			uri:          "http://keycloak:8080/realms/seldon/protocol/openid-connect/certs",
+			expectedHost: "keycloak",
+			expectedPort: 8080,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, err := MakeJwksCluster(test.uri)
+			g.Expect(err).To(BeNil())
+			g.Expect(c.Name).To(Equal(JwksClusterName))
+			address := c.LoadAssignment.Endpoints[0].LbEndpoints[0].GetEndpoint().Address.GetSocketAddress()
+			g.Expect(address.Address).To(Equal(test.expectedHost))
+			g.Expect(address.GetPortValue()).To(Equal(test.expectedPort))
+			g.Expect(c.TransportSocket != nil).To(Equal(test.expectedTLS))
+		})
+	}
+}
+
+func getHttpFilterNames(g *WithT, auth *AuthConfig, globalRateLimit *GlobalRateLimitConfig) []string {
+	l := MakeHTTPListener("l", "0.0.0.0", 9000, DefaultRouteConfigurationName, nil, auth, globalRateLimit, nil, "")
+	manager := &hcm.HttpConnectionManager{}
+	g.Expect(l.FilterChains[0].Filters[0].GetTypedConfig().UnmarshalTo(manager)).To(BeNil())
+	var names []string
+	for _, filter := range manager.HttpFilters {
+		names = append(names, filter.Name)
+	}
+	return names
+}
+
+func TestMakeHTTPListenerAuth(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name            string
+		auth            *AuthConfig
+		expectedFilters []string
+	}
+
+	tests := []test{
+		{
+			name:            "no auth",
+			expectedFilters: []string{"envoy.filters.http.tap", "envoy.filters.http.lua", localRateLimitFilterName, wellknown.Router},
+		},
+		{
+			name: "api keys",
+			auth: &AuthConfig{APIKeys: []APIKey{{Key: "abc", Subject: "a"}}},
+			expectedFilters: []string{
+				"envoy.filters.http.tap", "envoy.filters.http.lua", wellknown.HTTPRoleBasedAccessControl, localRateLimitFilterName, wellknown.Router,
+			},
+		},
+		{
+			name: "jwt",
+			auth: &AuthConfig{JWT: &JWTConfig{Issuer: "https://issuer", JwksFile: "/etc/jwks.json"}},
+			expectedFilters: []string{
+				"envoy.filters.http.tap", "envoy.filters.http.lua", jwtAuthnFilterName, wellknown.HTTPRoleBasedAccessControl, localRateLimitFilterName, wellknown.Router,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(getHttpFilterNames(g, test.auth, nil)).To(Equal(test.expectedFilters))
+		})
+	}
+}
+
+func TestCreateRbacPerRoute(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	apiKeys := []APIKey{
+		{Key: "k1", Subject: "team-a"},
+		{Key: "k2", Subject: "team-b", Scopes: []string{"predict"}},
+		{Key: "k3", Subject: "modelgateway", Scopes: []string{InternalScope}},
+	}
+	jwtConfig := &JWTConfig{Issuer: "https://issuer", JwksFile: "/etc/jwks.json"}
+
+	type test struct {
+		name               string
+		auth               *AuthConfig
+		authz              *scheduler.AuthorizationPolicy
+		expectedNil        bool
+		expectedPrincipals int
+	}
+
+	tests := []test{
+		{
+			name:        "no authentication",
+			authz:       &scheduler.AuthorizationPolicy{Subjects: []string{"team-a"}},
+			expectedNil: true,
+		},
+		{
+			name:        "no authorization",
+			auth:        &AuthConfig{APIKeys: apiKeys},
+			expectedNil: true,
+		},
+		{
+			name:  "api key subjects",
+			auth:  &AuthConfig{APIKeys: apiKeys},
+			authz: &scheduler.AuthorizationPolicy{Subjects: []string{"team-a"}},
+			// team-a and the internal key
+			expectedPrincipals: 2,
+		},
+		{
+			name:               "api key scopes",
+			auth:               &AuthConfig{APIKeys: apiKeys},
+			authz:              &scheduler.AuthorizationPolicy{Scopes: []string{"predict"}},
+			expectedPrincipals: 2,
+		},
+		{
+			name:  "jwt",
+			auth:  &AuthConfig{JWT: jwtConfig},
+			authz: &scheduler.AuthorizationPolicy{Subjects: []string{"team-a"}, Scopes: []string{"predict"}},
+			// subject, scope and internal scope
+			expectedPrincipals: 3,
+		},
+		{
+			name:               "jwt and api keys",
+			auth:               &AuthConfig{JWT: jwtConfig, APIKeys: apiKeys},
+			authz:              &scheduler.AuthorizationPolicy{Subjects: []string{"team-a"}},
+			expectedPrincipals: 4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rbacAny := createRbacPerRoute(test.auth, test.authz)
+			if test.expectedNil {
+				g.Expect(rbacAny).To(BeNil())
+				return
+			}
+			perRoute := &rbac.RBACPerRoute{}
+			g.Expect(rbacAny.UnmarshalTo(perRoute)).To(BeNil())
+			policy := perRoute.Rbac.Rules.Policies[authorizedPolicyName]
+			g.Expect(policy.Principals).To(HaveLen(test.expectedPrincipals))
+		})
+	}
+}
+
+func TestMakeRouteAuthorization(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	auth := &AuthConfig{APIKeys: []APIKey{{Key: "k1", Subject: "team-a"}}}
+	modelRoutes := []*Route{
+		{
+			RouteName:     "m1",
+			Clusters:      []TrafficSplits{{ModelName: "m1", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "h1", GrpcCluster: "g1"}},
+			Mirrors:       []TrafficSplits{{ModelName: "m2", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "h2", GrpcCluster: "g2"}},
+			Authorization: &scheduler.AuthorizationPolicy{Subjects: []string{"team-a"}},
+		},
+		{
+			RouteName: "m3",
+			Clusters:  []TrafficSplits{{ModelName: "m3", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "h3", GrpcCluster: "g3"}},
+		},
+	}
+	pipelineRoutes := []*PipelineRoute{
+		{
+			RouteName:     "p1.pipeline",
+			Clusters:      []PipelineTrafficSplits{{PipelineName: "p1", TrafficWeight: 100}},
+			Authorization: &scheduler.AuthorizationPolicy{Scopes: []string{"predict"}},
+		},
+	}
+
+	rcDef, rcMirror := MakeRoute(modelRoutes, pipelineRoutes, auth, nil)
+	withRbac := 0
+	for _, rt := range rcDef.VirtualHosts[0].Routes {
+		if _, ok := rt.TypedPerFilterConfig[wellknown.HTTPRoleBasedAccessControl]; ok {
+			withRbac++
+		}
+	}
+	// http and grpc routes of m1 and p1
+	g.Expect(withRbac).To(Equal(4))
+	// mirrors are internal traffic
+	for _, rt := range rcMirror.VirtualHosts[0].Routes {
+		g.Expect(rt.TypedPerFilterConfig).To(BeNil())
+	}
+
+	rcDef, _ = MakeRoute(modelRoutes, pipelineRoutes, nil, nil)
+	for _, rt := range rcDef.VirtualHosts[0].Routes {
+		g.Expect(rt.TypedPerFilterConfig).To(BeNil())
+	}
+}
+
+func TestMakeRouteRemovesCredentials(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name             string
+		auth             *AuthConfig
+		federationRegion string
+		expected         map[string][]string
+	}
+
+	apiKeys := []APIKey{{Key: "gw", Subject: "gateway", Scopes: []string{InternalScope}}}
+	jwt := &JWTConfig{Issuer: "https://issuer", JwksFile: "/etc/jwks.json"}
+	tests := []test{
+		{
+			name:     "no auth",
+			expected: map[string][]string{"m1_http": nil, "m1_grpc": nil},
+		},
+		{
+			name:     "api keys",
+			auth:     &AuthConfig{APIKeys: apiKeys},
+			expected: map[string][]string{"m1_http": {APIKeyHeader}, "m1_grpc": {APIKeyHeader}},
+		},
+		{
+			name:     "api keys and jwt",
+			auth:     &AuthConfig{APIKeys: apiKeys, JWT: jwt},
+			expected: map[string][]string{"m1_http": {APIKeyHeader, authorizationHeader}, "m1_grpc": {APIKeyHeader, authorizationHeader}},
+		},
+		{
+			name:             "federated routes keep credentials for other regions",
+			auth:             &AuthConfig{APIKeys: apiKeys},
+			federationRegion: "eu",
+			expected: map[string][]string{
+				"m1_http_forwarded": {APIKeyHeader},
+				"m1_grpc_forwarded": {APIKeyHeader},
+				"m1_http":           nil,
+				"m1_grpc":           nil,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			modelRoutes := []*Route{
+				{
+					RouteName:        "m1",
+					Clusters:         []TrafficSplits{{ModelName: "m1", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "h1", GrpcCluster: "g1"}},
+					Mirrors:          []TrafficSplits{{ModelName: "m2", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "h2", GrpcCluster: "g2"}},
+					FederationRegion: test.federationRegion,
+				},
+			}
+			rcDef, rcMirror := MakeRoute(modelRoutes, nil, test.auth, nil)
+			removed := map[string][]string{}
+			for _, rt := range rcDef.VirtualHosts[0].Routes {
+				removed[rt.Name] = rt.RequestHeadersToRemove
+			}
+			g.Expect(removed).To(Equal(test.expected))
+			for _, rt := range rcMirror.VirtualHosts[0].Routes {
+				g.Expect(rt.RequestHeadersToRemove).To(Equal(getCredentialHeaders(test.auth)))
+			}
+		})
+	}
+}
@@ -9,7 +9,10 @@ the Change License after the Change Date as each is defined in accordance with t
 
 package resources
 
-import "github.com/seldonio/seldon-core/components/tls/v2/pkg/tls"
+import (
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+	"github.com/seldonio/seldon-core/components/tls/v2/pkg/tls"
+)
 
 const (
 	PipelineGatewayHttpClusterName = "pipelinegateway_http"
@@ -23,14 +26,24 @@ type Listener struct {
 	Address                string
 	Port                   uint32
 	RouteConfigurationName string
+	Authenticate           bool // check the JWT or API key of requests if authentication is configured
 	//RouteNames []string
 }
 
 type Route struct {
-	RouteName   string
-	LogPayloads bool
-	Clusters    []TrafficSplits
-	Mirrors     []TrafficSplits
+	RouteName     string
+	LogPayloads   bool
+	Clusters      []TrafficSplits
+	Mirrors       []TrafficSplits
+	TrafficPolicy *scheduler.TrafficPolicy       // timeout and retries of the route
+	Authorization *scheduler.AuthorizationPolicy // callers allowed on the route
+	Rules         []*scheduler.ExperimentRule    // header rules of an experiment checked before the weighted split
+	// experiment comparing the responses of the mirrors with those of the clusters, empty if not compared
+	ShadowExperiment string
+	// region of this scheduler if the clusters fail over to other regions, which marks the requests sent there
+	FederationRegion string
+	// local replicas for requests forwarded from other regions, which are not forwarded again
+	LocalClusters []TrafficSplits
 }
 
 type TrafficSplits struct {
@@ -48,26 +61,33 @@ type RouteVersionKey struct {
 }
 
 type Cluster struct {
-	Name      string
-	Grpc      bool
-	Endpoints map[string]Endpoint
-	Routes    map[RouteVersionKey]bool
+	Name          string
+	Grpc          bool
+	Endpoints     map[string]Endpoint
+	Routes        map[RouteVersionKey]bool
+	TrafficPolicy *scheduler.TrafficPolicy // circuit breakers and outlier detection of the cluster
 }
 
 type Endpoint struct {
 	UpstreamHost string
 	UpstreamPort uint32
+	Region       string // region of a remote endpoint, empty for local replicas
+	Weight       uint32 // load balancing weight of the region of a remote endpoint
 }
 
 type PipelineRoute struct {
-	RouteName string
-	Clusters  []PipelineTrafficSplits
-	Mirrors   []PipelineTrafficSplits
+	RouteName     string
+	Clusters      []PipelineTrafficSplits
+	Mirrors       []PipelineTrafficSplits
+	TrafficPolicy *scheduler.TrafficPolicy
+	Authorization *scheduler.AuthorizationPolicy
+	Rules         []*scheduler.ExperimentRule
 }
 
 type PipelineTrafficSplits struct {
-	PipelineName  string
-	TrafficWeight uint32
+	PipelineName    string
+	PipelineVersion uint32 // set when traffic is split between versions of the same pipeline
+	TrafficWeight   uint32
 }
 
 type Secret struct {
@@ -0,0 +1,242 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package resources
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+const (
+	FederationClusterPrefix = "federation"
+	federationKeyLength     = 12
+	localityWeightLocal     = 1
+	// Endpoints of clusters failing over to other regions are checked, as traffic only moves to the next priority
+	// once the endpoints of the current one are unhealthy
+	federationHealthCheckInterval      = 5 * time.Second
+	federationHealthCheckTimeout       = 2 * time.Second
+	federationUnhealthyThreshold       = 2
+	federationHealthyThreshold         = 1
+	federationConsecutiveGatewayErrors = 5
+	federationBaseEjectionTime         = 30 * time.Second
+	federationMaxEjectionPercent       = 100
+	forwardedUnavailableMessage        = "model is not available in this region"
+)
+
+func hasRemoteEndpoints(eps []Endpoint) bool {
+	for _, e := range eps {
+		if e.Region != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// makeLocalityEndpoints groups the endpoints of a cluster by region. Local replicas take all traffic while any are
+// available, failing over to the regions of the remote endpoints at the next priority, weighted by the replicas they
+// have available.
+func makeLocalityEndpoints(eps []Endpoint) []*endpoint.LocalityLbEndpoints {
+	if !hasRemoteEndpoints(eps) {
+		var lbEndpoints []*endpoint.LbEndpoint
+		for _, e := range eps {
+			lbEndpoints = append(lbEndpoints, makeLbEndpoint(e))
+		}
+		return []*endpoint.LocalityLbEndpoints{{
+			LbEndpoints: lbEndpoints,
+		}}
+	}
+
+	local := &endpoint.LocalityLbEndpoints{
+		LoadBalancingWeight: &wrappers.UInt32Value{Value: localityWeightLocal},
+	}
+	remotes := make(map[string]*endpoint.LocalityLbEndpoints)
+	var regions []string
+	for _, e := range eps {
+		if e.Region == "" {
+			local.LbEndpoints = append(local.LbEndpoints, makeLbEndpoint(e))
+			continue
+		}
+		remote, ok := remotes[e.Region]
+		if !ok {
+			weight := e.Weight
+			if weight == 0 {
+				weight = 1
+			}
+			remote = &endpoint.LocalityLbEndpoints{
+				Locality:            &core.Locality{Region: e.Region},
+				LoadBalancingWeight: &wrappers.UInt32Value{Value: weight},
+			}
+			remotes[e.Region] = remote
+			regions = append(regions, e.Region)
+		}
+		remote.LbEndpoints = append(remote.LbEndpoints, makeLbEndpoint(e))
+	}
+
+	// Priorities start at 0, so remote regions take it if there are no local replicas
+	var localities []*endpoint.LocalityLbEndpoints
+	remotePriority := uint32(0)
+	if len(local.LbEndpoints) > 0 {
+		localities = append(localities, local)
+		remotePriority = 1
+	}
+	sort.Strings(regions)
+	for _, region := range regions {
+		remote := remotes[region]
+		remote.Priority = remotePriority
+		localities = append(localities, remote)
+	}
+	return localities
+}
+
+// createCommonLbConfig spreads the traffic of clusters with remote endpoints over regions by their weights
+func createCommonLbConfig(eps []Endpoint) *cluster.Cluster_CommonLbConfig {
+	if !hasRemoteEndpoints(eps) {
+		return nil
+	}
+	return &cluster.Cluster_CommonLbConfig{
+		LocalityConfigSpecifier: &cluster.Cluster_CommonLbConfig_LocalityWeightedLbConfig_{
+			LocalityWeightedLbConfig: &cluster.Cluster_CommonLbConfig_LocalityWeightedLbConfig{},
+		},
+	}
+}
+
+// createFederationHealthChecks connects to the endpoints of clusters with remote endpoints, so failed local replicas
+// and regions are taken out of the load balancing even without traffic
+func createFederationHealthChecks(eps []Endpoint) []*core.HealthCheck {
+	if !hasRemoteEndpoints(eps) {
+		return nil
+	}
+	return []*core.HealthCheck{{
+		Timeout:            durationpb.New(federationHealthCheckTimeout),
+		Interval:           durationpb.New(federationHealthCheckInterval),
+		UnhealthyThreshold: &wrappers.UInt32Value{Value: federationUnhealthyThreshold},
+		HealthyThreshold:   &wrappers.UInt32Value{Value: federationHealthyThreshold},
+		// Connect only, as the Envoys of other regions have no route for a request without a model header
+		HealthChecker: &core.HealthCheck_TcpHealthCheck_{TcpHealthCheck: &core.HealthCheck_TcpHealthCheck{}},
+	}}
+}
+
+// createFederationOutlierDetection ejects endpoints of clusters with remote endpoints that fail to respond, even
+// without outlier detection in the traffic policy. Errors returned by the model do not eject its replicas unless the
+// traffic policy asks for it. All local replicas can be ejected so traffic moves to other regions.
+func createFederationOutlierDetection(eps []Endpoint, policy *scheduler.TrafficPolicy) *cluster.OutlierDetection {
+	detection := createOutlierDetection(policy)
+	if !hasRemoteEndpoints(eps) {
+		return detection
+	}
+	if detection == nil {
+		detection = &cluster.OutlierDetection{
+			EnforcingConsecutive_5Xx:           &wrappers.UInt32Value{Value: 0},
+			ConsecutiveGatewayFailure:          &wrappers.UInt32Value{Value: federationConsecutiveGatewayErrors},
+			EnforcingConsecutiveGatewayFailure: &wrappers.UInt32Value{Value: 100},
+			BaseEjectionTime:                   durationpb.New(federationBaseEjectionTime),
+		}
+	}
+	if detection.MaxEjectionPercent == nil {
+		detection.MaxEjectionPercent = &wrappers.UInt32Value{Value: federationMaxEjectionPercent}
+	}
+	return detection
+}
+
+func calcNumberOfModelForwardedRoutesNeeded(modelRoutes []*Route) int {
+	count := 0
+	for _, r := range modelRoutes {
+		if r.FederationRegion != "" {
+			count += 2 // http and grpc
+		}
+	}
+	return count
+}
+
+// makeModelForwardedRoute sends requests forwarded from another region only to the local replicas of the model, so
+// a request is never forwarded twice even if the regions disagree on where the model is available
+func makeModelForwardedRoute(r *Route, rt *route.Route, isGrpc bool) {
+	rt.Name = getRouteName(r.RouteName, false, isGrpc, false) + "_forwarded"
+	rt.Match.PathSpecifier = modelRouteMatchPathHttp
+	if isGrpc {
+		rt.Match.PathSpecifier = modelRouteMatchPathGrpc
+	}
+	rt.Match.Headers[0] = &route.HeaderMatcher{
+		Name: SeldonModelHeader,
+		HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+			StringMatch: &matcherv3.StringMatcher{
+				MatchPattern: &matcherv3.StringMatcher_Exact{
+					Exact: r.RouteName,
+				},
+			},
+		},
+	}
+	rt.Match.Headers[1] = &route.HeaderMatcher{
+		Name: SeldonFederatedFromHeader,
+		HeaderMatchSpecifier: &route.HeaderMatcher_PresentMatch{
+			PresentMatch: true,
+		},
+	}
+	if len(r.LocalClusters) == 0 {
+		rt.Action = &route.Route_DirectResponse{
+			DirectResponse: &route.DirectResponseAction{
+				Status: http.StatusServiceUnavailable,
+				Body: &core.DataSource{
+					Specifier: &core.DataSource_InlineString{InlineString: forwardedUnavailableMessage},
+				},
+			},
+		}
+		return
+	}
+	rt.Action = createWeightedModelClusterAction(r.LocalClusters, []TrafficSplits{}, !isGrpc, r.TrafficPolicy)
+	if r.LogPayloads {
+		rt.ResponseHeadersToAdd = modelRouteHeaders
+	}
+}
+
+// setRouteFederationRegion marks requests of a route that can be sent to other regions with the region they come from
+func setRouteFederationRegion(rt *route.Route, region string) {
+	if region == "" {
+		return
+	}
+	rt.RequestHeadersToAdd = append(rt.RequestHeadersToAdd, &core.HeaderValueOption{
+		Header: &core.HeaderValue{
+			Key:   SeldonFederatedFromHeader,
+			Value: region,
+		},
+	})
+}
+
+// GetRemoteEndpointsKey returns a hash of the regions and weights of remote endpoints, or an empty string if there are
+// none. Models only share clusters when they are available in the same regions.
+func GetRemoteEndpointsKey(eps []Endpoint) string {
+	var keys []string
+	for _, e := range eps {
+		if e.Region != "" {
+			keys = append(keys, fmt.Sprintf("%s=%s:%d/%d", e.Region, e.UpstreamHost, e.UpstreamPort, e.Weight))
+		}
+	}
+	if len(keys) == 0 {
+		return ""
+	}
+	sort.Strings(keys)
+	h := sha256.Sum256([]byte(strings.Join(keys, ",")))
+	return hex.EncodeToString(h[:])[:federationKeyLength]
+}
@@ -0,0 +1,236 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package resources
+
+import (
+	"net/http"
+	"testing"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	. "github.com/onsi/gomega"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+func TestMakeLocalityEndpoints(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type locality struct {
+		region    string
+		priority  uint32
+		weight    uint32 // 0 if not set
+		endpoints int
+	}
+	type test struct {
+		name               string
+		eps                []Endpoint
+		expected           []locality
+		expectedWeightedLb bool
+	}
+
+	tests := []test{
+		{
+			name: "local only",
+			eps: []Endpoint{
+				{UpstreamHost: "server.0", UpstreamPort: 9000},
+				{UpstreamHost: "server.1", UpstreamPort: 9000},
+			},
+			expected: []locality{{endpoints: 2}},
+		},
+		{
+			name: "local with remote failover",
+			eps: []Endpoint{
+				{UpstreamHost: "server.0", UpstreamPort: 9000},
+				{UpstreamHost: "us.seldon", UpstreamPort: 80, Region: "us", Weight: 1},
+				{UpstreamHost: "eu.seldon", UpstreamPort: 80, Region: "eu", Weight: 3},
+			},
+			expected: []locality{
+				{priority: 0, weight: 1, endpoints: 1},
+				{region: "eu", priority: 1, weight: 3, endpoints: 1},
+				{region: "us", priority: 1, weight: 1, endpoints: 1},
+			},
+			expectedWeightedLb: true,
+		},
+		{
+			name: "remote only",
+			eps: []Endpoint{
+				{UpstreamHost: "us.seldon", UpstreamPort: 80, Region: "us", Weight: 2},
+				{UpstreamHost: "eu.seldon", UpstreamPort: 80, Region: "eu"},
+			},
+			expected: []locality{
+				{region: "eu", priority: 0, weight: 1, endpoints: 1},
+				{region: "us", priority: 0, weight: 2, endpoints: 1},
+			},
+			expectedWeightedLb: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			localities := makeLocalityEndpoints(test.eps)
+			g.Expect(localities).To(HaveLen(len(test.expected)))
+			for idx, expected := range test.expected {
+				g.Expect(localities[idx].GetLocality().GetRegion()).To(Equal(expected.region))
+				g.Expect(localities[idx].GetPriority()).To(Equal(expected.priority))
+				g.Expect(localities[idx].GetLoadBalancingWeight().GetValue()).To(Equal(expected.weight))
+				g.Expect(localities[idx].GetLbEndpoints()).To(HaveLen(expected.endpoints))
+			}
+			lbConfig := createCommonLbConfig(test.eps)
+			g.Expect(lbConfig.GetLocalityWeightedLbConfig() != nil).To(Equal(test.expectedWeightedLb))
+			c := MakeCluster("c", test.eps, true, nil, nil)
+			g.Expect(c.GetLoadAssignment().GetEndpoints()).To(HaveLen(len(test.expected)))
+			g.Expect(c.GetCommonLbConfig().GetLocalityWeightedLbConfig() != nil).To(Equal(test.expectedWeightedLb))
+			// Clusters failing over to other regions check their endpoints
+			g.Expect(c.GetHealthChecks() != nil).To(Equal(test.expectedWeightedLb))
+			g.Expect(c.GetOutlierDetection() != nil).To(Equal(test.expectedWeightedLb))
+			g.Expect(c.Validate()).To(BeNil())
+		})
+	}
+}
+
+func TestGetRemoteEndpointsKey(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	local := Endpoint{UpstreamHost: "server.0", UpstreamPort: 9000}
+	eu := Endpoint{UpstreamHost: "eu.seldon", UpstreamPort: 80, Region: "eu", Weight: 1}
+	us := Endpoint{UpstreamHost: "us.seldon", UpstreamPort: 80, Region: "us", Weight: 1}
+	euScaled := Endpoint{UpstreamHost: "eu.seldon", UpstreamPort: 80, Region: "eu", Weight: 2}
+
+	g.Expect(GetRemoteEndpointsKey(nil)).To(BeEmpty())
+	g.Expect(GetRemoteEndpointsKey([]Endpoint{local})).To(BeEmpty())
+	g.Expect(GetRemoteEndpointsKey([]Endpoint{local, eu, us})).To(Equal(GetRemoteEndpointsKey([]Endpoint{us, eu})))
+	g.Expect(GetRemoteEndpointsKey([]Endpoint{eu})).ToNot(Equal(GetRemoteEndpointsKey([]Endpoint{eu, us})))
+	g.Expect(GetRemoteEndpointsKey([]Endpoint{eu})).ToNot(Equal(GetRemoteEndpointsKey([]Endpoint{euScaled})))
+}
+
+func TestCreateFederationOutlierDetection(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	getUintPtr := func(val uint32) *uint32 { return &val }
+	local := []Endpoint{{UpstreamHost: "server.0", UpstreamPort: 9000}}
+	federated := []Endpoint{
+		{UpstreamHost: "server.0", UpstreamPort: 9000},
+		{UpstreamHost: "us.seldon", UpstreamPort: 80, Region: "us", Weight: 1},
+	}
+	type test struct {
+		name                       string
+		eps                        []Endpoint
+		policy                     *scheduler.TrafficPolicy
+		expected                   bool
+		expectedConsecutive5xx     uint32
+		expectedEnforcing5xx       bool
+		expectedMaxEjectionPercent uint32
+	}
+
+	tests := []test{
+		{
+			name: "local only",
+			eps:  local,
+		},
+		{
+			name:                       "failing over ejects on gateway errors",
+			eps:                        federated,
+			expected:                   true,
+			expectedMaxEjectionPercent: 100,
+		},
+		{
+			name: "failing over keeps traffic policy",
+			eps:  federated,
+			policy: &scheduler.TrafficPolicy{
+				OutlierDetection: &scheduler.OutlierDetection{ConsecutiveErrors: 3, MaxEjectionPercent: getUintPtr(50)},
+			},
+			expected:                   true,
+			expectedConsecutive5xx:     3,
+			expectedEnforcing5xx:       true,
+			expectedMaxEjectionPercent: 50,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			detection := createFederationOutlierDetection(test.eps, test.policy)
+			if !test.expected {
+				g.Expect(detection).To(BeNil())
+				return
+			}
+			g.Expect(detection.GetConsecutive_5Xx().GetValue()).To(Equal(test.expectedConsecutive5xx))
+			g.Expect(detection.GetEnforcingConsecutive_5Xx() == nil).To(Equal(test.expectedEnforcing5xx))
+			g.Expect(detection.GetMaxEjectionPercent().GetValue()).To(Equal(test.expectedMaxEjectionPercent))
+			if !test.expectedEnforcing5xx {
+				g.Expect(detection.GetConsecutiveGatewayFailure().GetValue()).To(Equal(uint32(federationConsecutiveGatewayErrors)))
+				g.Expect(detection.GetEnforcingConsecutiveGatewayFailure().GetValue()).To(Equal(uint32(100)))
+			}
+		})
+	}
+}
+
+func TestMakeRouteFederation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	local := TrafficSplits{ModelName: "model", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "local_http", GrpcCluster: "local_grpc"}
+	failover := TrafficSplits{ModelName: "model", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "failover_http", GrpcCluster: "failover_grpc"}
+	type test struct {
+		name                   string
+		route                  *Route
+		expectedForwarded      bool
+		expectedForwardCluster string // empty if forwarded requests are refused
+	}
+
+	tests := []test{
+		{
+			name:  "not federated",
+			route: &Route{RouteName: "model", Clusters: []TrafficSplits{local}},
+		},
+		{
+			name:                   "local replicas failing over",
+			route:                  &Route{RouteName: "model", Clusters: []TrafficSplits{failover}, LocalClusters: []TrafficSplits{local}, FederationRegion: "eu"},
+			expectedForwarded:      true,
+			expectedForwardCluster: "local_http",
+		},
+		{
+			name:              "only other regions",
+			route:             &Route{RouteName: "model", Clusters: []TrafficSplits{failover}, FederationRegion: "eu"},
+			expectedForwarded: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rc, _ := MakeRoute([]*Route{test.route}, nil, nil, nil)
+			g.Expect(rc.Validate()).To(BeNil())
+			routes := rc.GetVirtualHosts()[0].GetRoutes()
+			if !test.expectedForwarded {
+				g.Expect(routes).To(HaveLen(2))
+				for _, rt := range routes {
+					g.Expect(rt.GetRequestHeadersToAdd()).To(BeEmpty())
+				}
+				return
+			}
+			g.Expect(routes).To(HaveLen(4))
+			for _, rt := range routes[:2] {
+				g.Expect(rt.GetMatch().GetHeaders()[1].GetName()).To(Equal(SeldonFederatedFromHeader))
+				g.Expect(rt.GetMatch().GetHeaders()[1].GetPresentMatch()).To(BeTrue())
+				g.Expect(rt.GetRequestHeadersToAdd()).To(BeEmpty())
+			}
+			if test.expectedForwardCluster != "" {
+				g.Expect(routes[0].GetRoute().GetWeightedClusters().GetClusters()[0].GetName()).To(Equal(test.expectedForwardCluster))
+			} else {
+				g.Expect(routes[0].GetAction()).To(BeAssignableToTypeOf(&route.Route_DirectResponse{}))
+				g.Expect(routes[0].GetDirectResponse().GetStatus()).To(Equal(uint32(http.StatusServiceUnavailable)))
+			}
+			for _, rt := range routes[2:] {
+				g.Expect(rt.GetRoute().GetWeightedClusters().GetClusters()[0].GetName()).To(HavePrefix("failover"))
+				g.Expect(rt.GetRequestHeadersToAdd()).To(HaveLen(1))
+				g.Expect(rt.GetRequestHeadersToAdd()[0].GetHeader().GetKey()).To(Equal(SeldonFederatedFromHeader))
+				g.Expect(rt.GetRequestHeadersToAdd()[0].GetHeader().GetValue()).To(Equal("eu"))
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package resources
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+const (
+	// Length of the hash of the cluster settings added to the names of clusters with their own settings
+	clusterPolicyKeyLength = 12
+)
+
+func millis(ms uint32) *durationpb.Duration {
+	return durationpb.New(time.Duration(ms) * time.Millisecond)
+}
+
+func createRouteTimeout(policy *scheduler.TrafficPolicy) *durationpb.Duration {
+	if policy.GetTimeoutMs() > 0 {
+		return millis(policy.GetTimeoutMs())
+	}
+	return durationpb.New(DefaultRouteTimeoutSecs * time.Second)
+}
+
+// createRetryPolicy returns nil when no retry conditions are set, as Envoy does not retry by default.
+// The conditions are passed through so both HTTP and gRPC conditions can be used.
+func createRetryPolicy(policy *scheduler.TrafficPolicy) *route.RetryPolicy {
+	retry := policy.GetRetry()
+	if retry.GetRetryOn() == "" {
+		return nil
+	}
+	retryPolicy := &route.RetryPolicy{
+		RetryOn:    retry.GetRetryOn(),
+		NumRetries: &wrappers.UInt32Value{Value: retry.GetNumRetries()},
+	}
+	if retry.PerTryTimeoutMs != nil {
+		retryPolicy.PerTryTimeout = millis(retry.GetPerTryTimeoutMs())
+	}
+	return retryPolicy
+}
+
+func createCircuitBreakers(policy *scheduler.TrafficPolicy) *cluster.CircuitBreakers {
+	circuitBreaker := policy.GetCircuitBreaker()
+	if circuitBreaker == nil {
+		return nil
+	}
+	thresholds := &cluster.CircuitBreakers_Thresholds{
+		Priority: core.RoutingPriority_DEFAULT,
+	}
+	if circuitBreaker.MaxConnections != nil {
+		thresholds.MaxConnections = &wrappers.UInt32Value{Value: circuitBreaker.GetMaxConnections()}
+	}
+	if circuitBreaker.MaxPendingRequests != nil {
+		thresholds.MaxPendingRequests = &wrappers.UInt32Value{Value: circuitBreaker.GetMaxPendingRequests()}
+	}
+	return &cluster.CircuitBreakers{
+		Thresholds: []*cluster.CircuitBreakers_Thresholds{thresholds},
+	}
+}
+
+// createOutlierDetection ejects replicas after consecutive 5xx responses, gRPC status codes are mapped to HTTP
+// status codes by Envoy so both protocols are covered
+func createOutlierDetection(policy *scheduler.TrafficPolicy) *cluster.OutlierDetection {
+	outlierDetection := policy.GetOutlierDetection()
+	if outlierDetection.GetConsecutiveErrors() == 0 {
+		return nil
+	}
+	detection := &cluster.OutlierDetection{
+		Consecutive_5Xx: &wrappers.UInt32Value{Value: outlierDetection.GetConsecutiveErrors()},
+	}
+	if outlierDetection.IntervalMs != nil {
+		detection.Interval = millis(outlierDetection.GetIntervalMs())
+	}
+	if outlierDetection.BaseEjectionTimeMs != nil {
+		detection.BaseEjectionTime = millis(outlierDetection.GetBaseEjectionTimeMs())
+	}
+	if outlierDetection.MaxEjectionPercent != nil {
+		detection.MaxEjectionPercent = &wrappers.UInt32Value{Value: outlierDetection.GetMaxEjectionPercent()}
+	}
+	return detection
+}
+
+// GetClusterPolicyKey returns a hash of the circuit breaker and outlier detection settings, or an empty string if
+// there are none. Models and pipelines only share clusters when they have the same settings.
+func GetClusterPolicyKey(policy *scheduler.TrafficPolicy) string {
+	if policy.GetCircuitBreaker() == nil && policy.GetOutlierDetection() == nil {
+		return ""
+	}
+	clusterPolicy := &scheduler.TrafficPolicy{
+		CircuitBreaker:   policy.GetCircuitBreaker(),
+		OutlierDetection: policy.GetOutlierDetection(),
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(clusterPolicy)
+	if err != nil {
+		// Marshalling only fails for invalid messages, so fall back to the string form
+		b = []byte(clusterPolicy.String())
+	}
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:])[:clusterPolicyKeyLength]
+}
+
+// GetPipelineGatewayClusterName returns the shared pipeline gateway cluster unless the pipeline has its own
+// circuit breaker or outlier detection settings
+func GetPipelineGatewayClusterName(policy *scheduler.TrafficPolicy, isGrpc bool) string {
+	clusterName := PipelineGatewayHttpClusterName
+	if isGrpc {
+		clusterName = PipelineGatewayGrpcClusterName
+	}
+	if key := GetClusterPolicyKey(policy); key != "" {
+		return fmt.Sprintf("%s_%s", clusterName, key)
+	}
+	return clusterName
+}
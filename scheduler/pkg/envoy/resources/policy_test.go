@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package resources
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+func TestCreateRoutePolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+	getUintPtr := func(val uint32) *uint32 { return &val }
+	type test struct {
+		name                  string
+		policy                *scheduler.TrafficPolicy
+		expectedTimeout       time.Duration
+		expectedRetryOn       string
+		expectedNumRetries    uint32
+		expectedPerTryTimeout time.Duration
+	}
+
+	tests := []test{
+		{
+			name:            "no policy",
+			expectedTimeout: DefaultRouteTimeoutSecs * time.Second,
+		},
+		{
+			name:            "timeout",
+			policy:          &scheduler.TrafficPolicy{TimeoutMs: getUintPtr(1500)},
+			expectedTimeout: 1500 * time.Millisecond,
+		},
+		{
+			name: "retries without conditions",
+			policy: &scheduler.TrafficPolicy{
+				Retry: &scheduler.RetryPolicy{NumRetries: 3},
+			},
+			expectedTimeout: DefaultRouteTimeoutSecs * time.Second,
+		},
+		{
+			name: "retries",
+			policy: &scheduler.TrafficPolicy{
+				TimeoutMs: getUintPtr(5000),
+				Retry: &scheduler.RetryPolicy{
+					RetryOn:         "5xx,unavailable",
+					NumRetries:      3,
+					PerTryTimeoutMs: getUintPtr(1000),
+				},
+			},
+			expectedTimeout:       5 * time.Second,
+			expectedRetryOn:       "5xx,unavailable",
+			expectedNumRetries:    3,
+			expectedPerTryTimeout: time.Second,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(createRouteTimeout(test.policy).AsDuration()).To(Equal(test.expectedTimeout))
+			retryPolicy := createRetryPolicy(test.policy)
+			if test.expectedRetryOn == "" {
+				g.Expect(retryPolicy).To(BeNil())
+			} else {
+				g.Expect(retryPolicy.RetryOn).To(Equal(test.expectedRetryOn))
+				g.Expect(retryPolicy.NumRetries.GetValue()).To(Equal(test.expectedNumRetries))
+				g.Expect(retryPolicy.PerTryTimeout.AsDuration()).To(Equal(test.expectedPerTryTimeout))
+			}
+		})
+	}
+}
+
+func TestMakeClusterPolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+	getUintPtr := func(val uint32) *uint32 { return &val }
+	type test struct {
+		name                       string
+		policy                     *scheduler.TrafficPolicy
+		expectedMaxConnections     uint32
+		expectedMaxPendingRequests uint32
+		expectedConsecutiveErrors  uint32
+		expectedMaxEjectionPercent uint32
+		expectedKey                bool
+	}
+
+	tests := []test{
+		{
+			name: "no policy",
+		},
+		{
+			name:   "route settings only",
+			policy: &scheduler.TrafficPolicy{TimeoutMs: getUintPtr(100)},
+		},
+		{
+			name: "circuit breaker",
+			policy: &scheduler.TrafficPolicy{
+				CircuitBreaker: &scheduler.CircuitBreaker{
+					MaxConnections:     getUintPtr(10),
+					MaxPendingRequests: getUintPtr(20),
+				},
+			},
+			expectedMaxConnections:     10,
+			expectedMaxPendingRequests: 20,
+			expectedKey:                true,
+		},
+		{
+			name: "outlier detection",
+			policy: &scheduler.TrafficPolicy{
+				OutlierDetection: &scheduler.OutlierDetection{
+					ConsecutiveErrors:  5,
+					MaxEjectionPercent: getUintPtr(50),
+				},
+			},
+			expectedConsecutiveErrors:  5,
+			expectedMaxEjectionPercent: 50,
+			expectedKey:                true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := MakeCluster("c1", nil, false, nil, test.policy)
+			if test.expectedMaxConnections == 0 && test.expectedMaxPendingRequests == 0 {
+				g.Expect(c.CircuitBreakers).To(BeNil())
+			} else {
+				thresholds := c.CircuitBreakers.Thresholds[0]
+				g.Expect(thresholds.MaxConnections.GetValue()).To(Equal(test.expectedMaxConnections))
+				g.Expect(thresholds.MaxPendingRequests.GetValue()).To(Equal(test.expectedMaxPendingRequests))
+			}
+			if test.expectedConsecutiveErrors == 0 {
+				g.Expect(c.OutlierDetection).To(BeNil())
+			} else {
+				g.Expect(c.OutlierDetection.Consecutive_5Xx.GetValue()).To(Equal(test.expectedConsecutiveErrors))
+				g.Expect(c.OutlierDetection.MaxEjectionPercent.GetValue()).To(Equal(test.expectedMaxEjectionPercent))
+			}
+			key := GetClusterPolicyKey(test.policy)
+			if test.expectedKey {
+				g.Expect(key).To(HaveLen(clusterPolicyKeyLength))
+				g.Expect(GetPipelineGatewayClusterName(test.policy, true)).To(Equal(PipelineGatewayGrpcClusterName + "_" + key))
+			} else {
+				g.Expect(key).To(BeEmpty())
+				g.Expect(GetPipelineGatewayClusterName(test.policy, true)).To(Equal(PipelineGatewayGrpcClusterName))
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package resources
+
+import (
+	"math"
+	"time"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	ratelimitconfig "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	ratelimitcommon "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	localratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+const (
+	RateLimitServiceClusterName  = "ratelimit_service"
+	DefaultRateLimitDomain       = "seldon"
+	localRateLimitFilterName     = "envoy.filters.http.local_ratelimit"
+	localRateLimitStatPrefix     = "http_local_rate_limiter"
+	localRateLimitRuntimeKey     = "local_rate_limit_enabled"
+	localRateLimitEnforcedKey    = "local_rate_limit_enforced"
+	rateLimitRouteDescriptorKey  = "route"
+	rateLimitCallerDescriptorKey = "caller"
+	// The local and global filters read the rate limit actions of the route with their own stage
+	localRateLimitStage  = 0
+	globalRateLimitStage = 1
+)
+
+// GlobalRateLimitConfig points Envoy at a gRPC service implementing the Envoy rate limit API, which is checked by
+// routes of models and pipelines asking for global rate limiting. Requests are allowed if the service is unavailable.
+type GlobalRateLimitConfig struct {
+	Host   string
+	Port   uint32
+	Domain string
+}
+
+func MakeRateLimitServiceCluster(config *GlobalRateLimitConfig) *cluster.Cluster {
+	return MakeCluster(RateLimitServiceClusterName, []Endpoint{
+		{
+			UpstreamHost: config.Host,
+			UpstreamPort: config.Port,
+		},
+	}, true, nil, nil)
+}
+
+func getRateLimitFillInterval(unit scheduler.RouteRateLimit_Unit) time.Duration {
+	switch unit {
+	case scheduler.RouteRateLimit_MINUTE:
+		return time.Minute
+	case scheduler.RouteRateLimit_HOUR:
+		return time.Hour
+	default:
+		return time.Second
+	}
+}
+
+func createTokenBucket(requests uint32, burst *uint32, fillInterval time.Duration) *typev3.TokenBucket {
+	maxTokens := requests
+	if burst != nil && *burst > 0 {
+		maxTokens = *burst
+	}
+	return &typev3.TokenBucket{
+		MaxTokens:     maxTokens,
+		TokensPerFill: &wrappers.UInt32Value{Value: requests},
+		FillInterval:  durationpb.New(fillInterval),
+	}
+}
+
+func allRequests(runtimeKey string) *core.RuntimeFractionalPercent {
+	return &core.RuntimeFractionalPercent{
+		DefaultValue: &typev3.FractionalPercent{
+			Numerator:   100,
+			Denominator: typev3.FractionalPercent_HUNDRED,
+		},
+		RuntimeKey: runtimeKey,
+	}
+}
+
+// createLocalRateLimitPerRoute returns the local rate limit of a route, or nil if it has no local limits.
+// All buckets of a route share the same fill interval as Envoy needs the interval of quotas to be a multiple of the
+// interval of the route bucket.
+func createLocalRateLimitPerRoute(rateLimit *scheduler.RouteRateLimit) *anypb.Any {
+	if rateLimit.GetRequests() == 0 && (rateLimit.GetHeader() == "" || len(rateLimit.GetQuotas()) == 0) {
+		return nil
+	}
+	fillInterval := getRateLimitFillInterval(rateLimit.GetUnit())
+	// Callers without a quota are not limited if only quotas are set
+	tokenBucket := createTokenBucket(math.MaxUint32, nil, fillInterval)
+	if rateLimit.GetRequests() > 0 {
+		tokenBucket = createTokenBucket(rateLimit.GetRequests(), rateLimit.Burst, fillInterval)
+	}
+	var descriptors []*ratelimitcommon.LocalRateLimitDescriptor
+	if rateLimit.GetHeader() != "" {
+		for _, quota := range rateLimit.GetQuotas() {
+			if quota.Requests == 0 {
+				continue
+			}
+			descriptors = append(descriptors, &ratelimitcommon.LocalRateLimitDescriptor{
+				Entries: []*ratelimitcommon.RateLimitDescriptor_Entry{
+					{
+						Key:   rateLimitCallerDescriptorKey,
+						Value: quota.Value,
+					},
+				},
+				TokenBucket: createTokenBucket(quota.Requests, quota.Burst, fillInterval),
+			})
+		}
+	}
+	localRateLimitAny, err := anypb.New(&localratelimit.LocalRateLimit{
+		StatPrefix:     localRateLimitStatPrefix,
+		TokenBucket:    tokenBucket,
+		FilterEnabled:  allRequests(localRateLimitRuntimeKey),
+		FilterEnforced: allRequests(localRateLimitEnforcedKey),
+		Descriptors:    descriptors,
+		Stage:          localRateLimitStage,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return localRateLimitAny
+}
+
+func createCallerRateLimitAction(header string, skipIfAbsent bool) *route.RateLimit_Action {
+	return &route.RateLimit_Action{
+		ActionSpecifier: &route.RateLimit_Action_RequestHeaders_{
+			RequestHeaders: &route.RateLimit_Action_RequestHeaders{
+				HeaderName:    header,
+				DescriptorKey: rateLimitCallerDescriptorKey,
+				SkipIfAbsent:  skipIfAbsent,
+			},
+		},
+	}
+}
+
+// createRouteRateLimits returns the actions creating the descriptors of a request. The local filter matches the
+// caller against the quotas. The global service gets the route name and the caller if present.
+func createRouteRateLimits(routeName string, rateLimit *scheduler.RouteRateLimit, global *GlobalRateLimitConfig) []*route.RateLimit {
+	var rateLimits []*route.RateLimit
+	header := rateLimit.GetHeader()
+	if header != "" && len(rateLimit.GetQuotas()) > 0 {
+		rateLimits = append(rateLimits, &route.RateLimit{
+			Stage:   &wrappers.UInt32Value{Value: localRateLimitStage},
+			Actions: []*route.RateLimit_Action{createCallerRateLimitAction(header, false)},
+		})
+	}
+	if rateLimit.GetGlobal() && global != nil {
+		actions := []*route.RateLimit_Action{
+			{
+				ActionSpecifier: &route.RateLimit_Action_GenericKey_{
+					GenericKey: &route.RateLimit_Action_GenericKey{
+						DescriptorKey:   rateLimitRouteDescriptorKey,
+						DescriptorValue: routeName,
+					},
+				},
+			},
+		}
+		if header != "" {
+			actions = append(actions, createCallerRateLimitAction(header, true))
+		}
+		rateLimits = append(rateLimits, &route.RateLimit{
+			Stage:   &wrappers.UInt32Value{Value: globalRateLimitStage},
+			Actions: actions,
+		})
+	}
+	return rateLimits
+}
+
+func setRouteRateLimit(rt *route.Route, localRateLimitPerRoute *anypb.Any, rateLimits []*route.RateLimit) {
+	if localRateLimitPerRoute != nil {
+		setTypedPerFilterConfig(rt, localRateLimitFilterName, localRateLimitPerRoute)
+	}
+	if len(rateLimits) > 0 && rt.GetRoute() != nil {
+		rt.GetRoute().RateLimits = rateLimits
+	}
+}
+
+// createRateLimitFilters returns the rate limit filters, which come after the authentication filters so requests
+// without valid credentials do not use up the limits. The local filter does nothing on routes without a local limit.
+func createRateLimitFilters(global *GlobalRateLimitConfig) []*hcm.HttpFilter {
+	localRateLimitAny, err := anypb.New(&localratelimit.LocalRateLimit{
+		StatPrefix: localRateLimitStatPrefix,
+	})
+	if err != nil {
+		panic(err)
+	}
+	filters := []*hcm.HttpFilter{
+		{
+			Name:       localRateLimitFilterName,
+			ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: localRateLimitAny},
+		},
+	}
+	if global != nil {
+		rateLimitAny, err := anypb.New(&ratelimit.RateLimit{
+			Domain: global.Domain,
+			Stage:  globalRateLimitStage,
+			RateLimitService: &ratelimitconfig.RateLimitServiceConfig{
+				GrpcService: &core.GrpcService{
+					TargetSpecifier: &core.GrpcService_EnvoyGrpc_{
+						EnvoyGrpc: &core.GrpcService_EnvoyGrpc{ClusterName: RateLimitServiceClusterName},
+					},
+				},
+				TransportApiVersion: core.ApiVersion_V3,
+			},
+		})
+		if err != nil {
+			panic(err)
+		}
+		filters = append(filters, &hcm.HttpFilter{
+			Name:       wellknown.HTTPRateLimit,
+			ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: rateLimitAny},
+		})
+	}
+	return filters
+}
@@ -0,0 +1,218 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package resources
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	localratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	. "github.com/onsi/gomega"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+func getStrPtr(val string) *string { return &val }
+
+func TestCreateLocalRateLimitPerRoute(t *testing.T) {
+	g := NewGomegaWithT(t)
+	getUintPtr := func(val uint32) *uint32 { return &val }
+
+	type test struct {
+		name                 string
+		rateLimit            *scheduler.RouteRateLimit
+		expectedNil          bool
+		expectedMaxTokens    uint32
+		expectedTokens       uint32
+		expectedFillInterval time.Duration
+		expectedDescriptors  map[string]uint32
+	}
+
+	tests := []test{
+		{
+			name:        "no rate limit",
+			expectedNil: true,
+		},
+		{
+			name:        "global only",
+			rateLimit:   &scheduler.RouteRateLimit{Global: true, Header: getStrPtr("x-tenant")},
+			expectedNil: true,
+		},
+		{
+			name:        "quotas without header",
+			rateLimit:   &scheduler.RouteRateLimit{Quotas: []*scheduler.RateLimitQuota{{Value: "a", Requests: 5}}},
+			expectedNil: true,
+		},
+		{
+			name:                 "route limit",
+			rateLimit:            &scheduler.RouteRateLimit{Requests: getUintPtr(10)},
+			expectedMaxTokens:    10,
+			expectedTokens:       10,
+			expectedFillInterval: time.Second,
+		},
+		{
+			name: "route limit with burst",
+			rateLimit: &scheduler.RouteRateLimit{
+				Unit:     scheduler.RouteRateLimit_MINUTE,
+				Requests: getUintPtr(600),
+				Burst:    getUintPtr(50),
+			},
+			expectedMaxTokens:    50,
+			expectedTokens:       600,
+			expectedFillInterval: time.Minute,
+		},
+		{
+			name: "quotas only",
+			rateLimit: &scheduler.RouteRateLimit{
+				Unit:   scheduler.RouteRateLimit_HOUR,
+				Header: getStrPtr("x-tenant"),
+				Quotas: []*scheduler.RateLimitQuota{
+					{Value: "tenant-a", Requests: 1000},
+					{Value: "tenant-b", Requests: 100, Burst: getUintPtr(10)},
+				},
+			},
+			expectedMaxTokens:    math.MaxUint32,
+			expectedTokens:       math.MaxUint32,
+			expectedFillInterval: time.Hour,
+			expectedDescriptors:  map[string]uint32{"tenant-a": 1000, "tenant-b": 10},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			localRateLimitAny := createLocalRateLimitPerRoute(test.rateLimit)
+			if test.expectedNil {
+				g.Expect(localRateLimitAny).To(BeNil())
+				return
+			}
+			localRateLimit := &localratelimit.LocalRateLimit{}
+			g.Expect(localRateLimitAny.UnmarshalTo(localRateLimit)).To(BeNil())
+			g.Expect(localRateLimit.TokenBucket.MaxTokens).To(Equal(test.expectedMaxTokens))
+			g.Expect(localRateLimit.TokenBucket.TokensPerFill.GetValue()).To(Equal(test.expectedTokens))
+			g.Expect(localRateLimit.TokenBucket.FillInterval.AsDuration()).To(Equal(test.expectedFillInterval))
+			g.Expect(localRateLimit.Descriptors).To(HaveLen(len(test.expectedDescriptors)))
+			for _, descriptor := range localRateLimit.Descriptors {
+				g.Expect(descriptor.Entries[0].Key).To(Equal(rateLimitCallerDescriptorKey))
+				g.Expect(descriptor.TokenBucket.MaxTokens).To(Equal(test.expectedDescriptors[descriptor.Entries[0].Value]))
+				g.Expect(descriptor.TokenBucket.FillInterval.AsDuration()).To(Equal(test.expectedFillInterval))
+			}
+		})
+	}
+}
+
+func TestCreateRouteRateLimits(t *testing.T) {
+	g := NewGomegaWithT(t)
+	global := &GlobalRateLimitConfig{Host: "ratelimit", Port: 8081, Domain: DefaultRateLimitDomain}
+
+	type test struct {
+		name           string
+		rateLimit      *scheduler.RouteRateLimit
+		global         *GlobalRateLimitConfig
+		expectedStages map[uint32]int // number of actions per stage
+	}
+
+	tests := []test{
+		{
+			name:           "no rate limit",
+			global:         global,
+			expectedStages: map[uint32]int{},
+		},
+		{
+			name: "quotas",
+			rateLimit: &scheduler.RouteRateLimit{
+				Header: getStrPtr("x-tenant"),
+				Quotas: []*scheduler.RateLimitQuota{{Value: "a", Requests: 5}},
+			},
+			expectedStages: map[uint32]int{localRateLimitStage: 1},
+		},
+		{
+			name:           "global without service",
+			rateLimit:      &scheduler.RouteRateLimit{Global: true},
+			expectedStages: map[uint32]int{},
+		},
+		{
+			name:           "global",
+			rateLimit:      &scheduler.RouteRateLimit{Global: true},
+			global:         global,
+			expectedStages: map[uint32]int{globalRateLimitStage: 1},
+		},
+		{
+			name: "global and quotas",
+			rateLimit: &scheduler.RouteRateLimit{
+				Header: getStrPtr("x-tenant"),
+				Quotas: []*scheduler.RateLimitQuota{{Value: "a", Requests: 5}},
+				Global: true,
+			},
+			global:         global,
+			expectedStages: map[uint32]int{localRateLimitStage: 1, globalRateLimitStage: 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rateLimits := createRouteRateLimits("model", test.rateLimit, test.global)
+			stages := map[uint32]int{}
+			for _, rateLimit := range rateLimits {
+				stages[rateLimit.Stage.GetValue()] = len(rateLimit.Actions)
+			}
+			g.Expect(stages).To(Equal(test.expectedStages))
+		})
+	}
+}
+
+func TestMakeRouteRateLimit(t *testing.T) {
+	g := NewGomegaWithT(t)
+	getUintPtr := func(val uint32) *uint32 { return &val }
+
+	modelRoutes := []*Route{
+		{
+			RouteName: "m1",
+			Clusters:  []TrafficSplits{{ModelName: "m1", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "h1", GrpcCluster: "g1"}},
+			TrafficPolicy: &scheduler.TrafficPolicy{
+				RateLimit: &scheduler.RouteRateLimit{Requests: getUintPtr(10), Global: true},
+			},
+			Authorization: &scheduler.AuthorizationPolicy{Subjects: []string{"team-a"}},
+		},
+		{
+			RouteName: "m2",
+			Clusters:  []TrafficSplits{{ModelName: "m2", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "h2", GrpcCluster: "g2"}},
+		},
+	}
+	auth := &AuthConfig{APIKeys: []APIKey{{Key: "k1", Subject: "team-a"}}}
+	global := &GlobalRateLimitConfig{Host: "ratelimit", Port: 8081, Domain: DefaultRateLimitDomain}
+
+	rcDef, _ := MakeRoute(modelRoutes, nil, auth, global)
+	limited := 0
+	for _, rt := range rcDef.VirtualHosts[0].Routes {
+		if _, ok := rt.TypedPerFilterConfig[localRateLimitFilterName]; ok {
+			limited++
+			// rate limits are set alongside authorization
+			g.Expect(rt.TypedPerFilterConfig).To(HaveKey(wellknown.HTTPRoleBasedAccessControl))
+			g.Expect(rt.GetRoute().RateLimits).To(HaveLen(1))
+		} else {
+			g.Expect(rt.GetRoute().RateLimits).To(BeEmpty())
+		}
+	}
+	// http and grpc routes of m1
+	g.Expect(limited).To(Equal(2))
+}
+
+func TestMakeHTTPListenerGlobalRateLimit(t *testing.T) {
+	g := NewGomegaWithT(t)
+	global := &GlobalRateLimitConfig{Host: "ratelimit", Port: 8081, Domain: DefaultRateLimitDomain}
+	g.Expect(getHttpFilterNames(g, nil, global)).To(Equal([]string{
+		"envoy.filters.http.tap", "envoy.filters.http.lua", localRateLimitFilterName, wellknown.HTTPRateLimit, wellknown.Router,
+	}))
+	c := MakeRateLimitServiceCluster(global)
+	g.Expect(c.Name).To(Equal(RateLimitServiceClusterName))
+	g.Expect(c.TypedExtensionProtocolOptions).ToNot(BeEmpty())
+}
@@ -16,6 +16,7 @@ package resources
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	accesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
@@ -37,11 +38,12 @@ import (
 	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
-	"github.com/golang/protobuf/ptypes/duration"
 	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
 
@@ -50,19 +52,21 @@ const (
 	EnvoyLogPathPrefix            = "/tmp/request-log"
 	SeldonModelHeader             = "seldon-model"
 	SeldonPipelineHeader          = "pipeline"
+	SeldonPipelineVersionHeader   = "seldon-pipeline-version"
 	SeldonInternalModelHeader     = "seldon-internal-model"
 	SeldonRouteHeader             = "x-seldon-route"
+	SeldonFederatedFromHeader     = "x-seldon-federated-from"
 	SeldonRouteSeparator          = ":" // Tried % but this seemed to break envoy matching. Maybe % is a special character or connected to regexp. A bug?
 	SeldonModelHeaderSuffix       = "model"
 	SeldonPipelineHeaderSuffix    = "pipeline"
-	DefaultRouteTimeoutSecs       = 0 //TODO allow configurable override
+	DefaultRouteTimeoutSecs       = 0 // no timeout unless set in the traffic policy of the model or pipeline
 	ExternalHeaderPrefix          = "x-"
 	DefaultRouteConfigurationName = "listener_0"
 	MirrorRouteConfigurationName  = "listener_1"
 	TLSRouteConfigurationName     = "listener_tls"
 )
 
-func MakeCluster(clusterName string, eps []Endpoint, isGrpc bool, clientSecret *Secret) *cluster.Cluster {
+func MakeCluster(clusterName string, eps []Endpoint, isGrpc bool, clientSecret *Secret, policy *scheduler.TrafficPolicy) *cluster.Cluster {
 	if isGrpc {
 		// Need to ensure http 2 is used
 		// https://github.com/envoyproxy/go-control-plane/blob/d1a10d9a9366e8ab48f3f76b44a35930bac46fec/envoy/extensions/upstreams/http/v3/http_protocol_options.pb.go#L165-L166
@@ -88,6 +92,10 @@ func MakeCluster(clusterName string, eps []Endpoint, isGrpc bool, clientSecret *
 			DnsLookupFamily:               cluster.Cluster_V4_ONLY,
 			TypedExtensionProtocolOptions: map[string]*anypb.Any{"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": hpoMarshalled},
 			TransportSocket:               createUpstreamTransportSocket(clientSecret),
+			CircuitBreakers:               createCircuitBreakers(policy),
+			OutlierDetection:              createFederationOutlierDetection(eps, policy),
+			HealthChecks:                  createFederationHealthChecks(eps),
+			CommonLbConfig:                createCommonLbConfig(eps),
 		}
 	} else {
 		return &cluster.Cluster{
@@ -98,38 +106,38 @@ func MakeCluster(clusterName string, eps []Endpoint, isGrpc bool, clientSecret *
 			LoadAssignment:       MakeEndpoint(clusterName, eps),
 			DnsLookupFamily:      cluster.Cluster_V4_ONLY,
 			TransportSocket:      createUpstreamTransportSocket(clientSecret),
+			CircuitBreakers:      createCircuitBreakers(policy),
+			OutlierDetection:     createFederationOutlierDetection(eps, policy),
+			HealthChecks:         createFederationHealthChecks(eps),
+			CommonLbConfig:       createCommonLbConfig(eps),
 		}
 	}
 }
 
-func MakeEndpoint(clusterName string, eps []Endpoint) *endpoint.ClusterLoadAssignment {
-	var endpoints []*endpoint.LbEndpoint
-
-	for _, e := range eps {
-		endpoints = append(endpoints, &endpoint.LbEndpoint{
-			HostIdentifier: &endpoint.LbEndpoint_Endpoint{
-				Endpoint: &endpoint.Endpoint{
-					Address: &core.Address{
-						Address: &core.Address_SocketAddress{
-							SocketAddress: &core.SocketAddress{
-								Protocol: core.SocketAddress_TCP,
-								Address:  e.UpstreamHost,
-								PortSpecifier: &core.SocketAddress_PortValue{
-									PortValue: e.UpstreamPort,
-								},
+func makeLbEndpoint(e Endpoint) *endpoint.LbEndpoint {
+	return &endpoint.LbEndpoint{
+		HostIdentifier: &endpoint.LbEndpoint_Endpoint{
+			Endpoint: &endpoint.Endpoint{
+				Address: &core.Address{
+					Address: &core.Address_SocketAddress{
+						SocketAddress: &core.SocketAddress{
+							Protocol: core.SocketAddress_TCP,
+							Address:  e.UpstreamHost,
+							PortSpecifier: &core.SocketAddress_PortValue{
+								PortValue: e.UpstreamPort,
 							},
 						},
 					},
 				},
 			},
-		})
+		},
 	}
+}
 
+func MakeEndpoint(clusterName string, eps []Endpoint) *endpoint.ClusterLoadAssignment {
 	return &endpoint.ClusterLoadAssignment{
 		ClusterName: clusterName,
-		Endpoints: []*endpoint.LocalityLbEndpoints{{
-			LbEndpoints: endpoints,
-		}},
+		Endpoints:   makeLocalityEndpoints(eps),
 	}
 }
 
@@ -157,7 +165,7 @@ func createMirrorRouteAction(trafficWeight uint32, rest bool) []*route.RouteActi
 
 // weighted clusters do not play well with session affinity see https://github.com/envoyproxy/envoy/issues/8167
 // Traffic shifting may need to be reinvesigated https://github.com/envoyproxy/envoy/pull/18207
-func createWeightedModelClusterAction(clusterTraffics []TrafficSplits, mirrorTraffics []TrafficSplits, rest bool) *route.Route_Route {
+func createWeightedModelClusterAction(clusterTraffics []TrafficSplits, mirrorTraffics []TrafficSplits, rest bool, policy *scheduler.TrafficPolicy) *route.Route_Route {
 	// Add Weighted Clusters with given traffic percentages to each internal model
 	var splits []*route.WeightedCluster_ClusterWeight
 	var mirrors []*route.RouteAction_RequestMirrorPolicy
@@ -206,7 +214,8 @@ func createWeightedModelClusterAction(clusterTraffics []TrafficSplits, mirrorTra
 	}
 	action := &route.Route_Route{
 		Route: &route.RouteAction{
-			Timeout: &duration.Duration{Seconds: DefaultRouteTimeoutSecs},
+			Timeout:     createRouteTimeout(policy),
+			RetryPolicy: createRetryPolicy(policy),
 			ClusterSpecifier: &route.RouteAction_WeightedClusters{
 				WeightedClusters: &route.WeightedCluster{
 					Clusters: splits,
@@ -261,14 +270,18 @@ func makeModelHttpRoute(r *Route, rt *route.Route, isMirror bool) {
 	}
 
 	if isMirror {
-		rt.Action = createWeightedModelClusterAction(r.Mirrors, []TrafficSplits{}, true)
+		rt.Action = createWeightedModelClusterAction(r.Mirrors, []TrafficSplits{}, true, r.TrafficPolicy)
 	} else {
-		rt.Action = createWeightedModelClusterAction(r.Clusters, r.Mirrors, true)
+		rt.Action = createWeightedModelClusterAction(r.Clusters, r.Mirrors, true, r.TrafficPolicy)
 	}
 
 	if r.LogPayloads {
 		rt.ResponseHeadersToAdd = modelRouteHeaders
 	}
+	// Responses of the default models are captured by the shadow capture route
+	if r.ShadowExperiment != "" && isMirror {
+		rt.ResponseHeadersToAdd = append(rt.ResponseHeadersToAdd, createShadowResponseHeaders(r.ShadowExperiment, isMirror)...)
+	}
 }
 
 func makeModelStickySessionRoute(r *Route, clusterTraffic *TrafficSplits, rt *route.Route, isGrpc bool) {
@@ -329,7 +342,8 @@ func makeModelStickySessionRoute(r *Route, clusterTraffic *TrafficSplits, rt *ro
 	if isGrpc {
 		rt.Action = &route.Route_Route{
 			Route: &route.RouteAction{
-				Timeout: &duration.Duration{Seconds: DefaultRouteTimeoutSecs},
+				Timeout:     createRouteTimeout(r.TrafficPolicy),
+				RetryPolicy: createRetryPolicy(r.TrafficPolicy),
 				ClusterSpecifier: &route.RouteAction_Cluster{
 					Cluster: clusterTraffic.GrpcCluster,
 				},
@@ -338,7 +352,8 @@ func makeModelStickySessionRoute(r *Route, clusterTraffic *TrafficSplits, rt *ro
 	} else {
 		rt.Action = &route.Route_Route{
 			Route: &route.RouteAction{
-				Timeout: &duration.Duration{Seconds: DefaultRouteTimeoutSecs},
+				Timeout:     createRouteTimeout(r.TrafficPolicy),
+				RetryPolicy: createRetryPolicy(r.TrafficPolicy),
 				ClusterSpecifier: &route.RouteAction_Cluster{
 					Cluster: clusterTraffic.HttpCluster,
 				},
@@ -371,14 +386,18 @@ func makeModelGrpcRoute(r *Route, rt *route.Route, isMirror bool) {
 	}
 
 	if isMirror {
-		rt.Action = createWeightedModelClusterAction(r.Mirrors, []TrafficSplits{}, false)
+		rt.Action = createWeightedModelClusterAction(r.Mirrors, []TrafficSplits{}, false, r.TrafficPolicy)
 	} else {
-		rt.Action = createWeightedModelClusterAction(r.Clusters, r.Mirrors, false)
+		rt.Action = createWeightedModelClusterAction(r.Clusters, r.Mirrors, false, r.TrafficPolicy)
 	}
 
 	if r.LogPayloads {
 		rt.ResponseHeadersToAdd = append(rt.RequestHeadersToAdd, modelRouteHeaders...)
 	}
+	// Responses of the default models are captured by the shadow capture route
+	if r.ShadowExperiment != "" && isMirror {
+		rt.ResponseHeadersToAdd = append(rt.ResponseHeadersToAdd, createShadowResponseHeaders(r.ShadowExperiment, isMirror)...)
+	}
 }
 
 var pipelineRoutePathHttp = &route.RouteMatch_Prefix{Prefix: "/v2"}
@@ -388,16 +407,41 @@ func getPipelineModelName(pipelineName string) string {
 	return fmt.Sprintf("%s.%s", pipelineName, SeldonPipelineHeaderSuffix)
 }
 
-func createWeightedPipelineClusterAction(clusterTraffics []PipelineTrafficSplits, mirrorTraffics []PipelineTrafficSplits, rest bool) *route.Route_Route {
+// getPipelineSplitName returns the route header value for a traffic split, which includes the version for splits between pipeline versions
+func getPipelineSplitName(clusterTraffic *PipelineTrafficSplits) string {
+	if clusterTraffic.PipelineVersion > 0 {
+		return fmt.Sprintf("%s_%d", getPipelineModelName(clusterTraffic.PipelineName), clusterTraffic.PipelineVersion)
+	}
+	return getPipelineModelName(clusterTraffic.PipelineName)
+}
+
+func getPipelineRequestHeaders(clusterTraffic *PipelineTrafficSplits) []*core.HeaderValueOption {
+	headers := []*core.HeaderValueOption{
+		{
+			Header: &core.HeaderValue{
+				Key:   SeldonInternalModelHeader,
+				Value: getPipelineModelName(clusterTraffic.PipelineName),
+			},
+		},
+	}
+	if clusterTraffic.PipelineVersion > 0 {
+		headers = append(headers, &core.HeaderValueOption{
+			Header: &core.HeaderValue{
+				Key:   SeldonPipelineVersionHeader,
+				Value: strconv.FormatUint(uint64(clusterTraffic.PipelineVersion), 10),
+			},
+		})
+	}
+	return headers
+}
+
+func createWeightedPipelineClusterAction(clusterTraffics []PipelineTrafficSplits, mirrorTraffics []PipelineTrafficSplits, rest bool, policy *scheduler.TrafficPolicy) *route.Route_Route {
 	// Add Weighted Clusters with given traffic percentages to each internal model
 	var splits []*route.WeightedCluster_ClusterWeight
 	var mirrors []*route.RouteAction_RequestMirrorPolicy
 	var totWeight uint32
 	for _, clusterTraffic := range clusterTraffics {
-		clusterName := PipelineGatewayHttpClusterName
-		if !rest {
-			clusterName = PipelineGatewayGrpcClusterName
-		}
+		clusterName := GetPipelineGatewayClusterName(policy, !rest)
 		totWeight = totWeight + clusterTraffic.TrafficWeight
 		splits = append(splits,
 			&route.WeightedCluster_ClusterWeight{
@@ -405,19 +449,14 @@ func createWeightedPipelineClusterAction(clusterTraffics []PipelineTrafficSplits
 				Weight: &wrappers.UInt32Value{
 					Value: clusterTraffic.TrafficWeight,
 				},
-				RequestHeadersToAdd: []*core.HeaderValueOption{
-					{
-						Header: &core.HeaderValue{
-							Key:   SeldonInternalModelHeader,
-							Value: getPipelineModelName(clusterTraffic.PipelineName),
-						},
-					},
-				},
+				RequestHeadersToAdd: getPipelineRequestHeaders(&clusterTraffic),
+				// Clients can not choose the pipeline version, it is only set for splits between versions
+				RequestHeadersToRemove: []string{SeldonPipelineVersionHeader},
 				ResponseHeadersToAdd: []*core.HeaderValueOption{
 					{
 						Header: &core.HeaderValue{
 							Key:   SeldonRouteHeader,
-							Value: wrapRouteHeader(getPipelineModelName(clusterTraffic.PipelineName)),
+							Value: wrapRouteHeader(getPipelineSplitName(&clusterTraffic)),
 						},
 					},
 				},
@@ -429,7 +468,8 @@ func createWeightedPipelineClusterAction(clusterTraffics []PipelineTrafficSplits
 	}
 	action := &route.Route_Route{
 		Route: &route.RouteAction{
-			Timeout: &duration.Duration{Seconds: DefaultRouteTimeoutSecs},
+			Timeout:     createRouteTimeout(policy),
+			RetryPolicy: createRetryPolicy(policy),
 			ClusterSpecifier: &route.RouteAction_WeightedClusters{
 				WeightedClusters: &route.WeightedCluster{
 					Clusters: splits,
@@ -462,9 +502,9 @@ func makePipelineHttpRoute(r *PipelineRoute, rt *route.Route, isMirror bool) {
 	}
 
 	if isMirror {
-		rt.Action = createWeightedPipelineClusterAction(r.Mirrors, []PipelineTrafficSplits{}, true)
+		rt.Action = createWeightedPipelineClusterAction(r.Mirrors, []PipelineTrafficSplits{}, true, r.TrafficPolicy)
 	} else {
-		rt.Action = createWeightedPipelineClusterAction(r.Clusters, r.Mirrors, true)
+		rt.Action = createWeightedPipelineClusterAction(r.Clusters, r.Mirrors, true, r.TrafficPolicy)
 	}
 }
 
@@ -489,9 +529,9 @@ func makePipelineGrpcRoute(r *PipelineRoute, rt *route.Route, isMirror bool) {
 	}
 
 	if isMirror {
-		rt.Action = createWeightedPipelineClusterAction(r.Mirrors, []PipelineTrafficSplits{}, false)
+		rt.Action = createWeightedPipelineClusterAction(r.Mirrors, []PipelineTrafficSplits{}, false, r.TrafficPolicy)
 	} else {
-		rt.Action = createWeightedPipelineClusterAction(r.Clusters, r.Mirrors, false)
+		rt.Action = createWeightedPipelineClusterAction(r.Clusters, r.Mirrors, false, r.TrafficPolicy)
 	}
 }
 
@@ -509,7 +549,7 @@ func makePipelineStickySessionRoute(r *PipelineRoute, clusterTraffic *PipelineTr
 		HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
 			StringMatch: &matcherv3.StringMatcher{
 				MatchPattern: &matcherv3.StringMatcher_Contains{
-					Contains: wrapRouteHeader(getPipelineModelName(clusterTraffic.PipelineName)),
+					Contains: wrapRouteHeader(getPipelineSplitName(clusterTraffic)),
 				},
 			},
 		},
@@ -524,37 +564,32 @@ func makePipelineStickySessionRoute(r *PipelineRoute, clusterTraffic *PipelineTr
 			},
 		},
 	}
-	rt.RequestHeadersToAdd = []*core.HeaderValueOption{
-		{
-			Header: &core.HeaderValue{
-				Key:   SeldonInternalModelHeader,
-				Value: getPipelineModelName(clusterTraffic.PipelineName),
-			},
-		},
-	}
+	rt.RequestHeadersToAdd = getPipelineRequestHeaders(clusterTraffic)
 	rt.ResponseHeadersToAdd = []*core.HeaderValueOption{
 		{
 			Header: &core.HeaderValue{
 				Key:   SeldonRouteHeader,
-				Value: wrapRouteHeader(getPipelineModelName(clusterTraffic.PipelineName)),
+				Value: wrapRouteHeader(getPipelineSplitName(clusterTraffic)),
 			},
 		},
 	}
 	if isGrpc {
 		rt.Action = &route.Route_Route{
 			Route: &route.RouteAction{
-				Timeout: &duration.Duration{Seconds: DefaultRouteTimeoutSecs},
+				Timeout:     createRouteTimeout(r.TrafficPolicy),
+				RetryPolicy: createRetryPolicy(r.TrafficPolicy),
 				ClusterSpecifier: &route.RouteAction_Cluster{
-					Cluster: PipelineGatewayGrpcClusterName,
+					Cluster: GetPipelineGatewayClusterName(r.TrafficPolicy, true),
 				},
 			},
 		}
 	} else {
 		rt.Action = &route.Route_Route{
 			Route: &route.RouteAction{
-				Timeout: &duration.Duration{Seconds: DefaultRouteTimeoutSecs},
+				Timeout:     createRouteTimeout(r.TrafficPolicy),
+				RetryPolicy: createRetryPolicy(r.TrafficPolicy),
 				ClusterSpecifier: &route.RouteAction_Cluster{
-					Cluster: PipelineGatewayHttpClusterName,
+					Cluster: GetPipelineGatewayClusterName(r.TrafficPolicy, false),
 				},
 			},
 		}
@@ -610,13 +645,35 @@ func calcNumberOfPipelineMirrorsNeeded(pipelineRoutes []*PipelineRoute) int {
 	return count
 }
 
-func MakeRoute(modelRoutes []*Route, pipelineRoutes []*PipelineRoute) (*route.RouteConfiguration, *route.RouteConfiguration) {
+func setTypedPerFilterConfig(rt *route.Route, filterName string, config *anypb.Any) {
+	if rt.TypedPerFilterConfig == nil {
+		rt.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	rt.TypedPerFilterConfig[filterName] = config
+}
+
+// MakeRoute creates the default and mirror route configurations. The authorization rules of models and pipelines are
+// only added to the default routes when authentication is configured. Rate limits are also only added to the default
+// routes, and global rate limits only when a global rate limit service is configured. The header rules of experiments
+// get their own routes, which requests without a sticky session header match before the weighted default routes.
+// Models failing over to other regions also get routes for requests forwarded from other regions, which only reach
+// local replicas.
+func MakeRoute(
+	modelRoutes []*Route,
+	pipelineRoutes []*PipelineRoute,
+	auth *AuthConfig,
+	globalRateLimit *GlobalRateLimitConfig,
+) (*route.RouteConfiguration, *route.RouteConfiguration) {
 	rts := make([]*route.Route, 2*(len(modelRoutes)+
 		len(pipelineRoutes))+
 		calcNumberOfModelStickySessionsNeeded(modelRoutes)+
-		calcNumberOfPipelineStickySessionsNeeded(pipelineRoutes))
+		calcNumberOfPipelineStickySessionsNeeded(pipelineRoutes)+
+		calcNumberOfModelRuleRoutesNeeded(modelRoutes)+
+		calcNumberOfPipelineRuleRoutesNeeded(pipelineRoutes)+
+		calcNumberOfModelForwardedRoutesNeeded(modelRoutes)+
+		calcNumberOfModelShadowCaptureRoutesNeeded(modelRoutes))
 	// Pre-allocate objects for better CPU pipelining
-	// Warning: assumes a fixes number of route-match headers
+	// Warning: assumes a fixes number of route-match headers, rule routes replace the route match with their own
 	for i := 0; i < len(rts); i++ {
 		rts[i] = &route.Route{
 			Match: &route.RouteMatch{
@@ -629,6 +686,15 @@ func MakeRoute(modelRoutes []*Route, pipelineRoutes []*PipelineRoute) (*route.Ro
 
 	// Create Model Routes
 	for _, r := range modelRoutes {
+		first := idx
+		// Requests forwarded from other regions match before any route that could forward them again
+		if r.FederationRegion != "" {
+			makeModelForwardedRoute(r, rts[idx], false)
+			idx++
+			makeModelForwardedRoute(r, rts[idx], true)
+			idx++
+		}
+		forwardable := idx
 		for _, clusterTraffic := range r.Clusters {
 			if isModelExperiment(r) {
 				makeModelStickySessionRoute(r, &clusterTraffic, rts[idx], false)
@@ -637,14 +703,38 @@ func MakeRoute(modelRoutes []*Route, pipelineRoutes []*PipelineRoute) (*route.Ro
 				idx++
 			}
 		}
+		for ruleIdx, rule := range r.Rules {
+			if clusters := getModelRuleClusters(r, rule); len(clusters) > 0 {
+				makeModelRuleRoute(r, ruleIdx, clusters, rts[idx], false)
+				idx = addModelShadowCaptureRoute(r, rts, idx)
+				makeModelRuleRoute(r, ruleIdx, clusters, rts[idx], true)
+				idx = addModelShadowCaptureRoute(r, rts, idx)
+			}
+		}
 		makeModelHttpRoute(r, rts[idx], false)
-		idx++
+		idx = addModelShadowCaptureRoute(r, rts, idx)
 		makeModelGrpcRoute(r, rts[idx], false)
-		idx++
+		idx = addModelShadowCaptureRoute(r, rts, idx)
+		rbacPerRoute := createRbacPerRoute(auth, r.Authorization)
+		localRateLimitPerRoute := createLocalRateLimitPerRoute(r.TrafficPolicy.GetRateLimit())
+		rateLimits := createRouteRateLimits(r.RouteName, r.TrafficPolicy.GetRateLimit(), globalRateLimit)
+		credentialHeaders := getCredentialHeaders(auth)
+		for i := first; i < idx; i++ {
+			setRouteAuthorization(rts[i], rbacPerRoute)
+			setRouteRateLimit(rts[i], localRateLimitPerRoute, rateLimits)
+			// Model servers never see credentials, which are only kept for other regions that check them again
+			if i < forwardable || r.FederationRegion == "" {
+				removeRouteCredentials(rts[i], credentialHeaders)
+			}
+		}
+		for i := forwardable; i < idx; i++ {
+			setRouteFederationRegion(rts[i], r.FederationRegion)
+		}
 	}
 
 	// Create Pipeline Routes
 	for _, r := range pipelineRoutes {
+		first := idx
 		if isPipelineExperiment(r) {
 			for _, clusterTraffic := range r.Clusters {
 				makePipelineStickySessionRoute(r, &clusterTraffic, rts[idx], false)
@@ -653,10 +743,25 @@ func MakeRoute(modelRoutes []*Route, pipelineRoutes []*PipelineRoute) (*route.Ro
 				idx++
 			}
 		}
+		for ruleIdx, rule := range r.Rules {
+			if clusters := getPipelineRuleClusters(r, rule); len(clusters) > 0 {
+				makePipelineRuleRoute(r, ruleIdx, clusters, rts[idx], false)
+				idx++
+				makePipelineRuleRoute(r, ruleIdx, clusters, rts[idx], true)
+				idx++
+			}
+		}
 		makePipelineHttpRoute(r, rts[idx], false)
 		idx++
 		makePipelineGrpcRoute(r, rts[idx], false)
 		idx++
+		rbacPerRoute := createRbacPerRoute(auth, r.Authorization)
+		localRateLimitPerRoute := createLocalRateLimitPerRoute(r.TrafficPolicy.GetRateLimit())
+		rateLimits := createRouteRateLimits(r.RouteName, r.TrafficPolicy.GetRateLimit(), globalRateLimit)
+		for i := first; i < idx; i++ {
+			setRouteAuthorization(rts[i], rbacPerRoute)
+			setRouteRateLimit(rts[i], localRateLimitPerRoute, rateLimits)
+		}
 	}
 
 	rtsMirrors := make([]*route.Route, calcNumberOfModelMirrorsNeeded(modelRoutes)+
@@ -677,8 +782,10 @@ func MakeRoute(modelRoutes []*Route, pipelineRoutes []*PipelineRoute) (*route.Ro
 	for _, r := range modelRoutes {
 		if len(r.Mirrors) > 0 {
 			makeModelHttpRoute(r, rtsMirrors[idx], true)
+			removeRouteCredentials(rtsMirrors[idx], getCredentialHeaders(auth))
 			idx++
 			makeModelGrpcRoute(r, rtsMirrors[idx], true)
+			removeRouteCredentials(rtsMirrors[idx], getCredentialHeaders(auth))
 			idx++
 		}
 	}
@@ -795,7 +902,8 @@ func createAccessLogConfig() *anypb.Any {
 
 // A filter to add the seldon-model header from the http path if its not passed
 // Does this for model and pipeline paths allowing us to keep our current header based routing
-func createHeaderFilter() *anypb.Any {
+// It also sets the hash buckets of the request headers matched by hash in experiment rules
+func createHeaderFilter(hashHeaders []string, shadowCaptureAPIKey string) *anypb.Any {
 	luaFilter := luav3.Lua{
 		DefaultSourceCode: &core.DataSource{
 			Specifier: &core.DataSource_InlineString{
@@ -824,8 +932,8 @@ func createHeaderFilter() *anypb.Any {
       end
     end
   end
-end
-`,
+` + createHashHeadersSource(hashHeaders) + `end
+` + createShadowCaptureSource(shadowCaptureAPIKey),
 			},
 		},
 	}
@@ -839,38 +947,48 @@ end
 func MakeHTTPListener(listenerName, address string,
 	port uint32,
 	routeConfigurationName string,
-	serverSecret *Secret) *listener.Listener {
+	serverSecret *Secret,
+	auth *AuthConfig,
+	globalRateLimit *GlobalRateLimitConfig,
+	hashHeaders []string,
+	shadowCaptureAPIKey string) *listener.Listener {
 	routerConfig, _ := anypb.New(&router.Router{})
+	httpFilters := []*hcm.HttpFilter{
+		{
+			Name: "envoy.filters.http.tap",
+			ConfigType: &hcm.HttpFilter_TypedConfig{
+				TypedConfig: createTapConfig(),
+			},
+		},
+		{
+			Name: "envoy.filters.http.lua",
+			ConfigType: &hcm.HttpFilter_TypedConfig{
+				TypedConfig: createHeaderFilter(hashHeaders, shadowCaptureAPIKey),
+			},
+		},
+	}
+	httpFilters = append(httpFilters, createAuthFilters(auth)...)
+	httpFilters = append(httpFilters, createRateLimitFilters(globalRateLimit)...)
+	httpFilters = append(httpFilters, &hcm.HttpFilter{
+		Name:       wellknown.Router,
+		ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: routerConfig},
+	})
 	// HTTP filter configuration
 	manager := &hcm.HttpConnectionManager{
 		CodecType:                    hcm.HttpConnectionManager_AUTO,
 		StatPrefix:                   "http",
 		AlwaysSetRequestIdInResponse: false,
-		GenerateRequestId:            &wrappers.BoolValue{Value: false},
+		// Primary and mirror requests of experiments comparing their mirror are joined by the request id, which the
+		// mirror listener must keep rather than regenerate
+		GenerateRequestId:         &wrappers.BoolValue{Value: true},
+		PreserveExternalRequestId: true,
 		RouteSpecifier: &hcm.HttpConnectionManager_Rds{
 			Rds: &hcm.Rds{
 				ConfigSource:    makeConfigSource(),
 				RouteConfigName: routeConfigurationName,
 			},
 		},
-		HttpFilters: []*hcm.HttpFilter{
-			{
-				Name: "envoy.filters.http.tap",
-				ConfigType: &hcm.HttpFilter_TypedConfig{
-					TypedConfig: createTapConfig(),
-				},
-			},
-			{
-				Name: "envoy.filters.http.lua",
-				ConfigType: &hcm.HttpFilter_TypedConfig{
-					TypedConfig: createHeaderFilter(),
-				},
-			},
-			{
-				Name:       wellknown.Router,
-				ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: routerConfig},
-			},
-		},
+		HttpFilters: httpFilters,
 		AccessLog: []*accesslog.AccessLog{
 			{
 				Name: "envoy.access_loggers.file",
@@ -81,6 +81,28 @@ func TestMakeRoute(t *testing.T) {
 			expectedDefaultRoutes: 6,
 			expectedMirrorRoutes:  0,
 		},
+		{
+			name: "pipeline canary",
+			pipelineRoutes: []*PipelineRoute{
+				{
+					RouteName: "r1",
+					Clusters: []PipelineTrafficSplits{
+						{
+							PipelineName:    "p1",
+							PipelineVersion: 1,
+							TrafficWeight:   90,
+						},
+						{
+							PipelineName:    "p1",
+							PipelineVersion: 2,
+							TrafficWeight:   10,
+						},
+					},
+				},
+			},
+			expectedDefaultRoutes: 6,
+			expectedMirrorRoutes:  0,
+		},
 		{
 			name: "pipeline experiment with mirror",
 			pipelineRoutes: []*PipelineRoute{
@@ -172,13 +194,57 @@ func TestMakeRoute(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			rcDef, rcMirror := MakeRoute(test.modelRoutes, test.pipelineRoutes)
+			rcDef, rcMirror := MakeRoute(test.modelRoutes, test.pipelineRoutes, nil, nil)
 			g.Expect(len(rcDef.VirtualHosts[0].Routes)).To(Equal(test.expectedDefaultRoutes))
 			g.Expect(len(rcMirror.VirtualHosts[0].Routes)).To(Equal(test.expectedMirrorRoutes))
 		})
 	}
 }
 
+func TestCreateWeightedPipelineClusterActionVersionHeader(t *testing.T) {
+	g := NewGomegaWithT(t)
+	type test struct {
+		name                string
+		split               PipelineTrafficSplits
+		expectedHeaders     map[string]string
+		expectedRouteHeader string
+	}
+
+	tests := []test{
+		{
+			name:  "unversioned",
+			split: PipelineTrafficSplits{PipelineName: "p1", TrafficWeight: 100},
+			expectedHeaders: map[string]string{
+				SeldonInternalModelHeader: "p1.pipeline",
+			},
+			expectedRouteHeader: wrapRouteHeader("p1.pipeline"),
+		},
+		{
+			name:  "versioned",
+			split: PipelineTrafficSplits{PipelineName: "p1", PipelineVersion: 2, TrafficWeight: 10},
+			expectedHeaders: map[string]string{
+				SeldonInternalModelHeader:   "p1.pipeline",
+				SeldonPipelineVersionHeader: "2",
+			},
+			expectedRouteHeader: wrapRouteHeader("p1.pipeline_2"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			action := createWeightedPipelineClusterAction([]PipelineTrafficSplits{test.split}, nil, true, nil)
+			cluster := action.Route.GetWeightedClusters().Clusters[0]
+			headers := map[string]string{}
+			for _, h := range cluster.RequestHeadersToAdd {
+				headers[h.Header.Key] = h.Header.Value
+			}
+			g.Expect(headers).To(Equal(test.expectedHeaders))
+			g.Expect(cluster.RequestHeadersToRemove).To(ContainElement(SeldonPipelineVersionHeader))
+			g.Expect(cluster.ResponseHeadersToAdd[0].Header.Value).To(Equal(test.expectedRouteHeader))
+		})
+	}
+}
+
 func TestGetRouteName(t *testing.T) {
 	g := NewGomegaWithT(t)
 	type test struct {
@@ -0,0 +1,200 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+const (
+	// The listener sets this header followed by the name of a hashed request header to the bucket of its value
+	SeldonHashHeaderPrefix = "x-seldon-hash-"
+	hashBuckets            = 100
+)
+
+func getHashHeaderName(name string) string {
+	return SeldonHashHeaderPrefix + name
+}
+
+func createRuleHeaderMatcher(header *scheduler.ExperimentHeaderMatch) *route.HeaderMatcher {
+	if header.Hash != nil {
+		return &route.HeaderMatcher{
+			Name: getHashHeaderName(header.Name),
+			HeaderMatchSpecifier: &route.HeaderMatcher_RangeMatch{
+				RangeMatch: &typev3.Int64Range{
+					Start: int64(header.Hash.Start),
+					End:   int64(header.Hash.End),
+				},
+			},
+		}
+	}
+	stringMatch := &matcherv3.StringMatcher{}
+	switch {
+	case header.Exact != nil:
+		stringMatch.MatchPattern = &matcherv3.StringMatcher_Exact{Exact: header.GetExact()}
+	case header.Prefix != nil:
+		stringMatch.MatchPattern = &matcherv3.StringMatcher_Prefix{Prefix: header.GetPrefix()}
+	default:
+		stringMatch.MatchPattern = &matcherv3.StringMatcher_SafeRegex{SafeRegex: &matcherv3.RegexMatcher{Regex: header.GetRegex()}}
+	}
+	return &route.HeaderMatcher{
+		Name:                 header.Name,
+		HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{StringMatch: stringMatch},
+	}
+}
+
+// createRuleRouteMatch matches requests to the route without sticky session header whose headers match the rule
+func createRuleRouteMatch(routeName string, path *route.RouteMatch_Prefix, rule *scheduler.ExperimentRule) *route.RouteMatch {
+	headers := []*route.HeaderMatcher{
+		{
+			Name: SeldonModelHeader,
+			HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+				StringMatch: &matcherv3.StringMatcher{
+					MatchPattern: &matcherv3.StringMatcher_Exact{
+						Exact: routeName,
+					},
+				},
+			},
+		},
+		{
+			Name: SeldonRouteHeader,
+			HeaderMatchSpecifier: &route.HeaderMatcher_PresentMatch{
+				PresentMatch: false,
+			},
+		},
+	}
+	for _, header := range rule.Headers {
+		headers = append(headers, createRuleHeaderMatcher(header))
+	}
+	return &route.RouteMatch{
+		PathSpecifier: path,
+		Headers:       headers,
+	}
+}
+
+func getRuleRouteName(routeName string, ruleIdx int, isPipeline bool, isGrpc bool) string {
+	return fmt.Sprintf("%s_rule_%d", getRouteName(routeName, isPipeline, isGrpc, false), ruleIdx)
+}
+
+// getModelRuleClusters returns the traffic splits of the candidate of a rule, which are empty if it can not take traffic.
+// A candidate only reached through rules has no weight in the experiment, so its versions then share the traffic equally
+// as Envoy needs a positive total weight.
+func getModelRuleClusters(r *Route, rule *scheduler.ExperimentRule) []TrafficSplits {
+	var clusters []TrafficSplits
+	var totWeight uint32
+	for _, clusterTraffic := range r.Clusters {
+		if clusterTraffic.ModelName == rule.Candidate {
+			clusters = append(clusters, clusterTraffic)
+			totWeight = totWeight + clusterTraffic.TrafficWeight
+		}
+	}
+	if totWeight == 0 {
+		for i := range clusters {
+			clusters[i].TrafficWeight = 1
+		}
+	}
+	return clusters
+}
+
+func getPipelineRuleClusters(r *PipelineRoute, rule *scheduler.ExperimentRule) []PipelineTrafficSplits {
+	var clusters []PipelineTrafficSplits
+	var totWeight uint32
+	for _, clusterTraffic := range r.Clusters {
+		if clusterTraffic.PipelineName == rule.Candidate {
+			clusters = append(clusters, clusterTraffic)
+			totWeight = totWeight + clusterTraffic.TrafficWeight
+		}
+	}
+	if totWeight == 0 {
+		for i := range clusters {
+			clusters[i].TrafficWeight = 1
+		}
+	}
+	return clusters
+}
+
+func makeModelRuleRoute(r *Route, ruleIdx int, clusters []TrafficSplits, rt *route.Route, isGrpc bool) {
+	rt.Name = getRuleRouteName(r.RouteName, ruleIdx, false, isGrpc)
+	if isGrpc {
+		rt.Match = createRuleRouteMatch(r.RouteName, modelRouteMatchPathGrpc, r.Rules[ruleIdx])
+	} else {
+		rt.Match = createRuleRouteMatch(r.RouteName, modelRouteMatchPathHttp, r.Rules[ruleIdx])
+	}
+	rt.Action = createWeightedModelClusterAction(clusters, r.Mirrors, !isGrpc, r.TrafficPolicy)
+	if r.LogPayloads {
+		rt.ResponseHeadersToAdd = modelRouteHeaders
+	}
+}
+
+func makePipelineRuleRoute(r *PipelineRoute, ruleIdx int, clusters []PipelineTrafficSplits, rt *route.Route, isGrpc bool) {
+	rt.Name = getRuleRouteName(r.RouteName, ruleIdx, true, isGrpc)
+	if isGrpc {
+		rt.Match = createRuleRouteMatch(r.RouteName, pipelineRoutePathGrpc, r.Rules[ruleIdx])
+	} else {
+		rt.Match = createRuleRouteMatch(r.RouteName, pipelineRoutePathHttp, r.Rules[ruleIdx])
+	}
+	rt.Action = createWeightedPipelineClusterAction(clusters, r.Mirrors, !isGrpc, r.TrafficPolicy)
+}
+
+func calcNumberOfModelRuleRoutesNeeded(modelRoutes []*Route) int {
+	count := 0
+	for _, r := range modelRoutes {
+		for _, rule := range r.Rules {
+			if len(getModelRuleClusters(r, rule)) > 0 {
+				count = count + 2 // REST and gRPC
+			}
+		}
+	}
+	return count
+}
+
+func calcNumberOfPipelineRuleRoutesNeeded(pipelineRoutes []*PipelineRoute) int {
+	count := 0
+	for _, r := range pipelineRoutes {
+		for _, rule := range r.Rules {
+			if len(getPipelineRuleClusters(r, rule)) > 0 {
+				count = count + 2 // REST and gRPC
+			}
+		}
+	}
+	return count
+}
+
+// createHashHeadersSource returns Lua setting the bucket header of each hashed request header with the djb2 hash of its
+// value, and removing the bucket header when the request header is missing so clients can not choose their bucket
+func createHashHeadersSource(hashHeaders []string) string {
+	if len(hashHeaders) == 0 {
+		return ""
+	}
+	quoted := make([]string, len(hashHeaders))
+	for i, name := range hashHeaders {
+		quoted[i] = fmt.Sprintf("%q", name)
+	}
+	return `  for _, name in ipairs({` + strings.Join(quoted, ", ") + `}) do
+    local value = request_handle:headers():get(name)
+    if value == nil then
+      request_handle:headers():remove("` + SeldonHashHeaderPrefix + `"..name)
+    else
+      local hash = 5381
+      for i = 1, string.len(value) do
+        hash = (hash * 33 + string.byte(value, i)) % 4294967296
+      end
+      request_handle:headers():replace("` + SeldonHashHeaderPrefix + `"..name, string.format("%d", hash % ` + fmt.Sprintf("%d", hashBuckets) + `))
+    end
+  end
+`
+}
@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	luav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+func TestCreateRuleHeaderMatcher(t *testing.T) {
+	g := NewGomegaWithT(t)
+	getStrPtr := func(val string) *string { return &val }
+
+	type test struct {
+		name     string
+		header   *scheduler.ExperimentHeaderMatch
+		expected *route.HeaderMatcher
+	}
+
+	tests := []test{
+		{
+			name:   "exact",
+			header: &scheduler.ExperimentHeaderMatch{Name: "x-tenant", Exact: getStrPtr("internal")},
+			expected: &route.HeaderMatcher{
+				Name: "x-tenant",
+				HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+					StringMatch: &matcherv3.StringMatcher{MatchPattern: &matcherv3.StringMatcher_Exact{Exact: "internal"}},
+				},
+			},
+		},
+		{
+			name:   "prefix",
+			header: &scheduler.ExperimentHeaderMatch{Name: "x-region", Prefix: getStrPtr("eu-")},
+			expected: &route.HeaderMatcher{
+				Name: "x-region",
+				HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+					StringMatch: &matcherv3.StringMatcher{MatchPattern: &matcherv3.StringMatcher_Prefix{Prefix: "eu-"}},
+				},
+			},
+		},
+		{
+			name:   "regex",
+			header: &scheduler.ExperimentHeaderMatch{Name: "x-cohort", Regex: getStrPtr("a|b")},
+			expected: &route.HeaderMatcher{
+				Name: "x-cohort",
+				HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+					StringMatch: &matcherv3.StringMatcher{MatchPattern: &matcherv3.StringMatcher_SafeRegex{SafeRegex: &matcherv3.RegexMatcher{Regex: "a|b"}}},
+				},
+			},
+		},
+		{
+			name:   "hash",
+			header: &scheduler.ExperimentHeaderMatch{Name: "x-user", Hash: &scheduler.ExperimentHashRange{Start: 0, End: 10}},
+			expected: &route.HeaderMatcher{
+				Name: "x-seldon-hash-x-user",
+				HeaderMatchSpecifier: &route.HeaderMatcher_RangeMatch{
+					RangeMatch: &typev3.Int64Range{Start: 0, End: 10},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(proto.Equal(createRuleHeaderMatcher(test.header), test.expected)).To(BeTrue())
+		})
+	}
+}
+
+func TestMakeRouteRules(t *testing.T) {
+	g := NewGomegaWithT(t)
+	getStrPtr := func(val string) *string { return &val }
+
+	rules := []*scheduler.ExperimentRule{
+		{Candidate: "m2", Headers: []*scheduler.ExperimentHeaderMatch{{Name: "x-tenant", Exact: getStrPtr("internal")}}},
+		{Candidate: "m3", Headers: []*scheduler.ExperimentHeaderMatch{{Name: "x-tenant", Exact: getStrPtr("beta")}}},
+	}
+	modelRoutes := []*Route{
+		{
+			RouteName: "m1",
+			Clusters: []TrafficSplits{
+				{ModelName: "m1", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "h1", GrpcCluster: "g1"},
+				{ModelName: "m2", ModelVersion: 1, TrafficWeight: 0, HttpCluster: "h2", GrpcCluster: "g2"},
+			},
+			// m3 has no traffic splits so its rule is skipped
+			Rules: rules,
+		},
+	}
+	pipelineRoutes := []*PipelineRoute{
+		{
+			RouteName: "p1.pipeline",
+			Clusters: []PipelineTrafficSplits{
+				{PipelineName: "p1", TrafficWeight: 100},
+				{PipelineName: "m2", TrafficWeight: 0},
+			},
+			Rules: rules,
+		},
+	}
+
+	rcDef, _ := MakeRoute(modelRoutes, pipelineRoutes, nil, nil)
+	var names []string
+	for _, rt := range rcDef.VirtualHosts[0].Routes {
+		names = append(names, rt.Name)
+	}
+	g.Expect(names).To(Equal([]string{
+		"m1_http_experiment", "m1_grpc_experiment", "m1_http_experiment", "m1_grpc_experiment",
+		"m1_http_rule_0", "m1_grpc_rule_0", "m1_http", "m1_grpc",
+		"p1.pipeline_http_experiment", "p1.pipeline_grpc_experiment", "p1.pipeline_http_experiment", "p1.pipeline_grpc_experiment",
+		"p1.pipeline_pipeline_http_rule_0", "p1.pipeline_pipeline_grpc_rule_0", "p1.pipeline_pipeline_http", "p1.pipeline_pipeline_grpc",
+	}))
+
+	ruleRoute := rcDef.VirtualHosts[0].Routes[4]
+	g.Expect(ruleRoute.Match.Headers).To(HaveLen(3))
+	g.Expect(ruleRoute.Match.Headers[0].Name).To(Equal(SeldonModelHeader))
+	g.Expect(ruleRoute.Match.Headers[1].GetPresentMatch()).To(BeFalse())
+	g.Expect(ruleRoute.Match.Headers[2].Name).To(Equal("x-tenant"))
+	clusters := ruleRoute.GetRoute().GetWeightedClusters().GetClusters()
+	g.Expect(clusters).To(HaveLen(1))
+	g.Expect(clusters[0].Name).To(Equal("h2"))
+	// the candidate only reached through the rule still gets its traffic
+	g.Expect(clusters[0].Weight.Value).To(Equal(uint32(1)))
+}
+
+func TestCreateHeaderFilterHashHeaders(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	luaFilter := &luav3.Lua{}
+	g.Expect(createHeaderFilter(nil, "").UnmarshalTo(luaFilter)).To(BeNil())
+	g.Expect(luaFilter.GetDefaultSourceCode().GetInlineString()).ToNot(ContainSubstring(SeldonHashHeaderPrefix))
+
+	g.Expect(createHeaderFilter([]string{"x-region", "x-user"}, "").UnmarshalTo(luaFilter)).To(BeNil())
+	source := luaFilter.GetDefaultSourceCode().GetInlineString()
+	g.Expect(source).To(ContainSubstring(`ipairs({"x-region", "x-user"})`))
+	g.Expect(source).To(ContainSubstring(`remove("x-seldon-hash-"..name)`))
+	g.Expect(source).To(ContainSubstring(`hash % 100`))
+}
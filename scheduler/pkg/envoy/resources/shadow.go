@@ -0,0 +1,145 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package resources
+
+import (
+	"strconv"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+const (
+	// Routes of experiments comparing their mirror add these response headers. The listener removes them and sends
+	// the response to the pipeline gateway, which writes it to the shadow topic for the scheduler to compare.
+	SeldonShadowHeader          = "x-seldon-shadow"
+	SeldonShadowRoleHeader      = "x-seldon-shadow-role"
+	SeldonShadowRequestIdHeader = "x-seldon-shadow-request-id"
+	// Headers of the captured responses sent to the pipeline gateway besides the experiment, role and x-request-id
+	SeldonShadowModelHeader      = "x-seldon-shadow-model"
+	SeldonShadowStatusHeader     = "x-seldon-shadow-status"
+	SeldonShadowGrpcStatusHeader = "x-seldon-shadow-grpc-status"
+	SeldonShadowLatencyHeader    = "x-seldon-shadow-latency-ms"
+	ShadowCapturePath            = "/v2/shadow"
+	ShadowRolePrimary            = "primary"
+	ShadowRoleMirror             = "mirror"
+	shadowCaptureTimeoutMs       = "5000"
+)
+
+// isModelShadowCapture returns whether the responses of the default models and the mirror are compared
+func isModelShadowCapture(r *Route) bool {
+	return r.ShadowExperiment != "" && len(r.Mirrors) > 0
+}
+
+func calcNumberOfModelShadowCaptureRoutesNeeded(modelRoutes []*Route) int {
+	count := 0
+	for _, r := range modelRoutes {
+		if isModelShadowCapture(r) {
+			count = count + 2 // REST and gRPC
+			for _, rule := range r.Rules {
+				if len(getModelRuleClusters(r, rule)) > 0 {
+					count = count + 2
+				}
+			}
+		}
+	}
+	return count
+}
+
+// addModelShadowCaptureRoute puts a copy of the route at idx in front of it, which matches the mirror percent of
+// requests, mirrors all of them and captures their responses. The original route then neither mirrors nor captures,
+// so default model responses are only captured for mirrored requests. It returns the index of the next route.
+func addModelShadowCaptureRoute(r *Route, rts []*route.Route, idx int) int {
+	if !isModelShadowCapture(r) {
+		return idx + 1
+	}
+	primary := rts[idx]
+	capture := proto.Clone(primary).(*route.Route)
+	capture.Name = primary.Name + "_shadow"
+	capture.Match.RuntimeFraction = &core.RuntimeFractionalPercent{
+		DefaultValue: &typev3.FractionalPercent{
+			Numerator:   r.Mirrors[0].TrafficWeight,
+			Denominator: typev3.FractionalPercent_HUNDRED,
+		},
+	}
+	for _, mirror := range capture.GetRoute().GetRequestMirrorPolicies() {
+		mirror.RuntimeFraction = nil
+	}
+	capture.ResponseHeadersToAdd = append(capture.ResponseHeadersToAdd, createShadowResponseHeaders(r.ShadowExperiment, false)...)
+	primary.GetRoute().RequestMirrorPolicies = nil
+	rts[idx] = capture
+	rts[idx+1] = primary
+	return idx + 2
+}
+
+// createShadowResponseHeaders marks responses of a route for capture. Primary and mirror requests share the request id
+// generated by the listener, which joins their responses.
+func createShadowResponseHeaders(experimentName string, isMirror bool) []*core.HeaderValueOption {
+	role := ShadowRolePrimary
+	if isMirror {
+		role = ShadowRoleMirror
+	}
+	return []*core.HeaderValueOption{
+		{Header: &core.HeaderValue{Key: SeldonShadowHeader, Value: experimentName}},
+		{Header: &core.HeaderValue{Key: SeldonShadowRoleHeader, Value: role}},
+		{Header: &core.HeaderValue{Key: SeldonShadowRequestIdHeader, Value: "%REQ(" + util.RequestIdHeader + ")%"}},
+	}
+}
+
+// createShadowCaptureSource returns Lua sending marked responses asynchronously to the pipeline gateway. The whole
+// response is buffered to capture it, so the gRPC status is read from the trailers unless the response only has headers.
+// Captures are sent with the API key, if any, as the pipeline gateway checks it.
+func createShadowCaptureSource(apiKey string) string {
+	apiKeySource := ""
+	if apiKey != "" {
+		apiKeySource = `
+    ["` + APIKeyHeader + `"] = ` + strconv.Quote(apiKey) + `,`
+	}
+	return `function envoy_on_response(response_handle)
+  local headers = response_handle:headers()
+  local experiment = headers:get("` + SeldonShadowHeader + `")
+  if experiment == nil then
+    return
+  end
+  local capture = {
+    [":method"] = "POST",
+    [":path"] = "` + ShadowCapturePath + `",
+    [":authority"] = "` + PipelineGatewayHttpClusterName + `",
+    ["` + SeldonShadowHeader + `"] = experiment,
+    ["` + SeldonShadowRoleHeader + `"] = headers:get("` + SeldonShadowRoleHeader + `") or "",
+    ["` + util.RequestIdHeader + `"] = headers:get("` + SeldonShadowRequestIdHeader + `") or "",
+    ["` + SeldonShadowModelHeader + `"] = headers:get("` + SeldonRouteHeader + `") or "",
+    ["` + SeldonShadowStatusHeader + `"] = headers:get(":status") or "",
+    ["` + SeldonShadowGrpcStatusHeader + `"] = headers:get("grpc-status") or "",
+    ["` + SeldonShadowLatencyHeader + `"] = headers:get("x-envoy-upstream-service-time") or "",
+    ["content-type"] = headers:get("content-type") or "",` + apiKeySource + `
+  }
+  headers:remove("` + SeldonShadowHeader + `")
+  headers:remove("` + SeldonShadowRoleHeader + `")
+  headers:remove("` + SeldonShadowRequestIdHeader + `")
+  local data = ""
+  local body = response_handle:body()
+  if body ~= nil then
+    data = body:getBytes(0, body:length())
+  end
+  if capture["` + SeldonShadowGrpcStatusHeader + `"] == "" then
+    local trailers = response_handle:trailers()
+    if trailers ~= nil then
+      capture["` + SeldonShadowGrpcStatusHeader + `"] = trailers:get("grpc-status") or ""
+    end
+  end
+  response_handle:httpCall("` + PipelineGatewayHttpClusterName + `", capture, data, ` + shadowCaptureTimeoutMs + `, true)
+end
+`
+}
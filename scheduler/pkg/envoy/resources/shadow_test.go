@@ -0,0 +1,107 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	luav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	. "github.com/onsi/gomega"
+)
+
+func TestMakeRouteShadowHeaders(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name         string
+		experiment   string
+		expectedRole map[string]string // route name to shadow role, empty if not captured
+	}
+
+	tests := []test{
+		{
+			name:       "comparing mirror",
+			experiment: "exp1",
+			expectedRole: map[string]string{
+				"m1_http_shadow": ShadowRolePrimary,
+				"m1_http":        "",
+				"m1_grpc_shadow": ShadowRolePrimary,
+				"m1_grpc":        "",
+				"m1_http_mirror": ShadowRoleMirror,
+				"m1_grpc_mirror": ShadowRoleMirror,
+			},
+		},
+		{
+			name: "not comparing mirror",
+			expectedRole: map[string]string{
+				"m1_http":        "",
+				"m1_grpc":        "",
+				"m1_http_mirror": "",
+				"m1_grpc_mirror": "",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			modelRoutes := []*Route{
+				{
+					RouteName: "m1",
+					Clusters:  []TrafficSplits{{ModelName: "m1", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "h1", GrpcCluster: "g1"}},
+					Mirrors:   []TrafficSplits{{ModelName: "m2", ModelVersion: 1, TrafficWeight: 10, HttpCluster: "h2", GrpcCluster: "g2"}},
+
+					ShadowExperiment: test.experiment,
+				},
+			}
+			rcDef, rcMirror := MakeRoute(modelRoutes, nil, nil, nil)
+			roles := make(map[string]string)
+			for _, rc := range []*route.RouteConfiguration{rcDef, rcMirror} {
+				for _, rt := range rc.VirtualHosts[0].Routes {
+					headers := make(map[string]string)
+					for _, header := range rt.ResponseHeadersToAdd {
+						headers[header.Header.Key] = header.Header.Value
+					}
+					roles[rt.Name] = headers[SeldonShadowRoleHeader]
+					if headers[SeldonShadowRoleHeader] != "" {
+						g.Expect(headers[SeldonShadowHeader]).To(Equal(test.experiment))
+						g.Expect(headers[SeldonShadowRequestIdHeader]).To(Equal("%REQ(x-request-id)%"))
+					}
+					// Default model responses are only captured for mirrored requests
+					if headers[SeldonShadowRoleHeader] == ShadowRolePrimary {
+						g.Expect(rt.Match.RuntimeFraction.DefaultValue.Numerator).To(Equal(uint32(10)))
+						g.Expect(rt.GetRoute().RequestMirrorPolicies).To(HaveLen(1))
+						g.Expect(rt.GetRoute().RequestMirrorPolicies[0].RuntimeFraction).To(BeNil())
+					} else if rc == rcDef && test.experiment != "" {
+						g.Expect(rt.GetRoute().RequestMirrorPolicies).To(BeEmpty())
+					}
+				}
+			}
+			g.Expect(roles).To(Equal(test.expectedRole))
+		})
+	}
+}
+
+func TestCreateHeaderFilterShadowCapture(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	luaFilter := &luav3.Lua{}
+	g.Expect(createHeaderFilter(nil, "").UnmarshalTo(luaFilter)).To(BeNil())
+	source := luaFilter.GetDefaultSourceCode().GetInlineString()
+	g.Expect(source).To(ContainSubstring("function envoy_on_response(response_handle)"))
+	g.Expect(source).To(ContainSubstring(`headers:remove("x-seldon-shadow")`))
+	g.Expect(source).To(ContainSubstring(`response_handle:httpCall("pipelinegateway_http", capture, data, 5000, true)`))
+	g.Expect(source).To(ContainSubstring(`[":path"] = "/v2/shadow"`))
+	g.Expect(source).ToNot(ContainSubstring(APIKeyHeader))
+
+	g.Expect(createHeaderFilter(nil, "internal").UnmarshalTo(luaFilter)).To(BeNil())
+	source = luaFilter.GetDefaultSourceCode().GetInlineString()
+	g.Expect(source).To(ContainSubstring(`["x-api-key"] = "internal",`))
+}
@@ -11,10 +11,12 @@ package xdscache
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	"github.com/sirupsen/logrus"
 
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
 	seldontls "github.com/seldonio/seldon-core/components/tls/v2/pkg/tls"
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
@@ -42,6 +44,8 @@ type SeldonXDSCache struct {
 	Pipelines              map[string]resources.PipelineRoute
 	Secrets                map[string]resources.Secret
 	PipelineGatewayDetails *PipelineGatewayDetails
+	AuthConfig             *resources.AuthConfig            // nil unless authentication of requests is configured
+	GlobalRateLimit        *resources.GlobalRateLimitConfig // nil unless a global rate limit service is configured
 	logger                 logrus.FieldLogger
 	TLSActive              bool
 }
@@ -81,14 +85,15 @@ func (xds *SeldonXDSCache) ClusterContents() []types.Resource {
 			UpstreamHost: xds.PipelineGatewayDetails.Host,
 			UpstreamPort: uint32(xds.PipelineGatewayDetails.HttpPort),
 		},
-	}, false, clientSecret))
+	}, false, clientSecret, nil))
 	xds.logger.Infof("Add grpc pipeline cluster %s host:%s port:%d", resources.PipelineGatewayGrpcClusterName, xds.PipelineGatewayDetails.Host, xds.PipelineGatewayDetails.GrpcPort)
 	r = append(r, resources.MakeCluster(resources.PipelineGatewayGrpcClusterName, []resources.Endpoint{
 		{
 			UpstreamHost: xds.PipelineGatewayDetails.Host,
 			UpstreamPort: uint32(xds.PipelineGatewayDetails.GrpcPort),
 		},
-	}, true, clientSecret))
+	}, true, clientSecret, nil))
+	r = append(r, xds.pipelineGatewayPolicyClusters(clientSecret)...)
 
 	// Add Mirror clusters
 	xds.logger.Infof("Add http mirror cluster %s host:%s port:%d", resources.MirrorHttpClusterName, mirrorListenerAddress, mirrorListenerPort)
@@ -97,26 +102,66 @@ func (xds *SeldonXDSCache) ClusterContents() []types.Resource {
 			UpstreamHost: mirrorListenerAddress,
 			UpstreamPort: mirrorListenerPort,
 		},
-	}, false, nil))
+	}, false, nil, nil))
 	xds.logger.Infof("Add grpc mirror cluster %s host:%s port:%d", resources.MirrorGrpcClusterName, mirrorListenerAddress, mirrorListenerPort)
 	r = append(r, resources.MakeCluster(resources.MirrorGrpcClusterName, []resources.Endpoint{
 		{
 			UpstreamHost: mirrorListenerAddress,
 			UpstreamPort: mirrorListenerPort,
 		},
-	}, true, nil))
+	}, true, nil, nil))
+
+	if xds.AuthConfig != nil && xds.AuthConfig.JWT != nil && xds.AuthConfig.JWT.JwksUri != "" {
+		jwksCluster, err := resources.MakeJwksCluster(xds.AuthConfig.JWT.JwksUri)
+		if err != nil {
+			xds.logger.WithError(err).Errorf("Failed to create JWKS cluster for %s", xds.AuthConfig.JWT.JwksUri)
+		} else {
+			r = append(r, jwksCluster)
+		}
+	}
+
+	if xds.GlobalRateLimit != nil {
+		r = append(r, resources.MakeRateLimitServiceCluster(xds.GlobalRateLimit))
+	}
 
 	for _, c := range xds.Clusters {
 		endpoints := make([]resources.Endpoint, 0, len(c.Endpoints))
 		for _, value := range c.Endpoints { // Likely to be small (<100?) as is number of model replicas
 			endpoints = append(endpoints, value)
 		}
-		r = append(r, resources.MakeCluster(c.Name, endpoints, c.Grpc, clientSecret))
+		r = append(r, resources.MakeCluster(c.Name, endpoints, c.Grpc, clientSecret, c.TrafficPolicy))
 	}
 
 	return r
 }
 
+// pipelineGatewayPolicyClusters adds pipeline gateway clusters for pipelines with their own circuit breaker or
+// outlier detection settings, shared by pipelines with the same settings
+func (xds *SeldonXDSCache) pipelineGatewayPolicyClusters(clientSecret *resources.Secret) []types.Resource {
+	var r []types.Resource
+	added := make(map[string]bool)
+	for _, pipelineRoute := range xds.Pipelines {
+		key := resources.GetClusterPolicyKey(pipelineRoute.TrafficPolicy)
+		if key == "" || added[key] {
+			continue
+		}
+		added[key] = true
+		r = append(r, resources.MakeCluster(resources.GetPipelineGatewayClusterName(pipelineRoute.TrafficPolicy, false), []resources.Endpoint{
+			{
+				UpstreamHost: xds.PipelineGatewayDetails.Host,
+				UpstreamPort: uint32(xds.PipelineGatewayDetails.HttpPort),
+			},
+		}, false, clientSecret, pipelineRoute.TrafficPolicy))
+		r = append(r, resources.MakeCluster(resources.GetPipelineGatewayClusterName(pipelineRoute.TrafficPolicy, true), []resources.Endpoint{
+			{
+				UpstreamHost: xds.PipelineGatewayDetails.Host,
+				UpstreamPort: uint32(xds.PipelineGatewayDetails.GrpcPort),
+			},
+		}, true, clientSecret, pipelineRoute.TrafficPolicy))
+	}
+	return r
+}
+
 func (xds *SeldonXDSCache) RouteContents() []types.Resource {
 	routesArray := make([]*resources.Route, len(xds.Routes))
 	rIdx := 0
@@ -134,7 +179,7 @@ func (xds *SeldonXDSCache) RouteContents() []types.Resource {
 		pIdx++
 	}
 
-	defaultRoutes, mirrorRoutes := resources.MakeRoute(routesArray, pipelinesArray)
+	defaultRoutes, mirrorRoutes := resources.MakeRoute(routesArray, pipelinesArray, xds.AuthConfig, xds.GlobalRateLimit)
 	return []types.Resource{defaultRoutes, mirrorRoutes}
 }
 
@@ -148,13 +193,46 @@ func (xds *SeldonXDSCache) ListenerContents() []types.Resource {
 		}
 	}
 
+	hashHeaders := xds.hashHeaders()
 	for _, l := range xds.Listeners {
-		r = append(r, resources.MakeHTTPListener(l.Name, l.Address, l.Port, l.RouteConfigurationName, serverSecret))
+		var auth *resources.AuthConfig
+		if l.Authenticate {
+			auth = xds.AuthConfig
+		}
+		// Captures of both listeners are authenticated, as mirror responses are captured on the mirror listener
+		r = append(r, resources.MakeHTTPListener(l.Name, l.Address, l.Port, l.RouteConfigurationName, serverSecret, auth, xds.GlobalRateLimit, hashHeaders, xds.AuthConfig.InternalAPIKey()))
 	}
 
 	return r
 }
 
+// hashHeaders returns the sorted request headers matched by hash in the experiment rules of the routes, which the
+// listeners hash into buckets
+func (xds *SeldonXDSCache) hashHeaders() []string {
+	names := map[string]bool{}
+	addRules := func(rules []*scheduler.ExperimentRule) {
+		for _, rule := range rules {
+			for _, header := range rule.Headers {
+				if header.Hash != nil {
+					names[header.Name] = true
+				}
+			}
+		}
+	}
+	for _, r := range xds.Routes {
+		addRules(r.Rules)
+	}
+	for _, r := range xds.Pipelines {
+		addRules(r.Rules)
+	}
+	var hashHeaders []string
+	for name := range names {
+		hashHeaders = append(hashHeaders, name)
+	}
+	sort.Strings(hashHeaders)
+	return hashHeaders
+}
+
 func (xds *SeldonXDSCache) SecretContents() []types.Resource {
 	logger := xds.logger.WithField("func", "SecretContents")
 	var r []types.Resource
@@ -210,6 +288,44 @@ func (xds *SeldonXDSCache) AddPipelineRoute(routeName string, pipelineName strin
 	}
 }
 
+// AddPipelineVersionRoute adds a traffic split to a single version of a pipeline, used to run a canary version alongside the stable one
+func (xds *SeldonXDSCache) AddPipelineVersionRoute(routeName string, pipelineName string, pipelineVersion uint32, trafficWeight uint32) {
+	pipelineRoute, ok := xds.Pipelines[routeName]
+	if !ok {
+		pipelineRoute = resources.PipelineRoute{
+			RouteName: routeName,
+		}
+	}
+	pipelineRoute.Clusters = append(pipelineRoute.Clusters, resources.PipelineTrafficSplits{
+		PipelineName:    pipelineName,
+		PipelineVersion: pipelineVersion,
+		TrafficWeight:   trafficWeight,
+	})
+	xds.Pipelines[routeName] = pipelineRoute
+}
+
+// SetPipelineRoutePolicies sets the timeout, retries, pipeline gateway cluster settings and authorization of a
+// pipeline route
+func (xds *SeldonXDSCache) SetPipelineRoutePolicies(routeName string, policy *scheduler.TrafficPolicy, authz *scheduler.AuthorizationPolicy) {
+	pipelineRoute, ok := xds.Pipelines[routeName]
+	if !ok {
+		return
+	}
+	pipelineRoute.TrafficPolicy = policy
+	pipelineRoute.Authorization = authz
+	xds.Pipelines[routeName] = pipelineRoute
+}
+
+// SetPipelineRouteRules sets the header rules of the experiment on a pipeline route
+func (xds *SeldonXDSCache) SetPipelineRouteRules(routeName string, rules []*scheduler.ExperimentRule) {
+	pipelineRoute, ok := xds.Pipelines[routeName]
+	if !ok {
+		return
+	}
+	pipelineRoute.Rules = rules
+	xds.Pipelines[routeName] = pipelineRoute
+}
+
 func (xds *SeldonXDSCache) RemovePipelineRoute(pipelineName string) {
 	delete(xds.Pipelines, pipelineName)
 }
@@ -255,6 +371,7 @@ func (xds *SeldonXDSCache) AddListeners() {
 		Address:                defaultListenerAddress,
 		Port:                   defaultListenerPort,
 		RouteConfigurationName: resources.DefaultRouteConfigurationName,
+		Authenticate:           true,
 	}
 	xds.Listeners[mirrorListenerName] = resources.Listener{
 		Name:                   mirrorListenerName,
@@ -273,6 +390,8 @@ func (xds *SeldonXDSCache) AddRouteClusterTraffic(
 	grpcClusterName string,
 	logPayloads bool,
 	isMirror bool,
+	policy *scheduler.TrafficPolicy,
+	authz *scheduler.AuthorizationPolicy,
 ) {
 	route, ok := xds.Routes[routeName]
 	if !ok {
@@ -285,6 +404,12 @@ func (xds *SeldonXDSCache) AddRouteClusterTraffic(
 	if logPayloads {
 		route.LogPayloads = true
 	}
+	// The route uses the policies of its own model, the latest version of which is added last during a rolling update.
+	// The policies of experiment routes are set with SetRoutePolicies.
+	if !isMirror && modelName == routeName {
+		route.TrafficPolicy = policy
+		route.Authorization = authz
+	}
 
 	clusterTraffic := resources.TrafficSplits{
 		ModelName:     modelName,
@@ -301,20 +426,90 @@ func (xds *SeldonXDSCache) AddRouteClusterTraffic(
 	xds.Routes[routeName] = route
 }
 
+// AddRouteLocalClusterTraffic adds the local replicas of a model version for requests forwarded to a route failing
+// over to other regions, which are not sent to other regions again
+func (xds *SeldonXDSCache) AddRouteLocalClusterTraffic(
+	routeName string,
+	modelName string,
+	modelVersion uint32,
+	trafficPercent uint32,
+	httpClusterName string,
+	grpcClusterName string,
+) {
+	route, ok := xds.Routes[routeName]
+	if !ok {
+		return
+	}
+	route.LocalClusters = append(route.LocalClusters, resources.TrafficSplits{
+		ModelName:     modelName,
+		ModelVersion:  modelVersion,
+		TrafficWeight: trafficPercent,
+		HttpCluster:   httpClusterName,
+		GrpcCluster:   grpcClusterName,
+	})
+	xds.Routes[routeName] = route
+}
+
+// SetRouteFederationRegion marks the requests of a route failing over to other regions with the region of this
+// scheduler
+func (xds *SeldonXDSCache) SetRouteFederationRegion(routeName string, region string) {
+	route, ok := xds.Routes[routeName]
+	if !ok {
+		return
+	}
+	route.FederationRegion = region
+	xds.Routes[routeName] = route
+}
+
+// SetRoutePolicies sets the traffic and authorization policies of an experiment on a model route
+func (xds *SeldonXDSCache) SetRoutePolicies(routeName string, policy *scheduler.TrafficPolicy, authz *scheduler.AuthorizationPolicy) {
+	route, ok := xds.Routes[routeName]
+	if !ok {
+		return
+	}
+	route.TrafficPolicy = policy
+	route.Authorization = authz
+	xds.Routes[routeName] = route
+}
+
+// SetRouteRules sets the header rules of the experiment on a model route, which has no traffic if none of the candidates
+// are available
+func (xds *SeldonXDSCache) SetRouteRules(routeName string, rules []*scheduler.ExperimentRule) {
+	route, ok := xds.Routes[routeName]
+	if !ok {
+		return
+	}
+	route.Rules = rules
+	xds.Routes[routeName] = route
+}
+
+// SetRouteShadowExperiment marks the responses of a model route and its mirror for capture by the experiment comparing
+// them
+func (xds *SeldonXDSCache) SetRouteShadowExperiment(routeName string, experimentName string) {
+	route, ok := xds.Routes[routeName]
+	if !ok {
+		return
+	}
+	route.ShadowExperiment = experimentName
+	xds.Routes[routeName] = route
+}
+
 func (xds *SeldonXDSCache) AddCluster(
 	name string,
 	routeName string,
 	modelName string,
 	modelVersion uint32,
 	isGrpc bool,
+	policy *scheduler.TrafficPolicy,
 ) {
 	cluster, ok := xds.Clusters[name]
 	if !ok {
 		cluster = resources.Cluster{
-			Name:      name,
-			Endpoints: make(map[string]resources.Endpoint),
-			Routes:    make(map[resources.RouteVersionKey]bool),
-			Grpc:      isGrpc,
+			Name:          name,
+			Endpoints:     make(map[string]resources.Endpoint),
+			Routes:        make(map[resources.RouteVersionKey]bool),
+			Grpc:          isGrpc,
+			TrafficPolicy: policy,
 		}
 	}
 	cluster.Routes[resources.RouteVersionKey{RouteName: routeName, ModelName: modelName, Version: modelVersion}] = true
@@ -363,6 +558,12 @@ func (xds *SeldonXDSCache) RemoveRoute(routeName string) error {
 			return err
 		}
 	}
+	for _, local := range route.LocalClusters {
+		err := xds.removeRouteFromCluster(routeName, route, local)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -376,3 +577,18 @@ func (xds *SeldonXDSCache) AddEndpoint(clusterName, upstreamHost string, upstrea
 
 	xds.Clusters[clusterName] = cluster
 }
+
+// AddRemoteEndpoint adds the Envoy of another region where the models of the cluster are available, weighted by the
+// replicas available there
+func (xds *SeldonXDSCache) AddRemoteEndpoint(clusterName, region, upstreamHost string, upstreamPort uint32, weight uint32) {
+	cluster := xds.Clusters[clusterName]
+	k := fmt.Sprintf("%s/%s:%d", region, upstreamHost, upstreamPort)
+	cluster.Endpoints[k] = resources.Endpoint{
+		UpstreamHost: upstreamHost,
+		UpstreamPort: upstreamPort,
+		Region:       region,
+		Weight:       weight,
+	}
+
+	xds.Clusters[clusterName] = cluster
+}
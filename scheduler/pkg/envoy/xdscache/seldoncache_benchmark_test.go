@@ -35,6 +35,8 @@ func benchmarkRouteContents(b *testing.B, numResources uint) {
 			"grpc",
 			false,
 			false,
+			nil,
+			nil,
 		)
 	}
 
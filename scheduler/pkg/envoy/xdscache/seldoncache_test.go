@@ -17,6 +17,7 @@ import (
 	"github.com/otiai10/copy"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
 	seldontls "github.com/seldonio/seldon-core/components/tls/v2/pkg/tls"
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
@@ -29,9 +30,9 @@ func TestAddRemoveHttpAndGrpcRoute(t *testing.T) {
 	c := NewSeldonXDSCache(logger, &PipelineGatewayDetails{})
 
 	addVersionedRoute := func(c *SeldonXDSCache, routeName string, modelName string, httpCluster string, grpcCluster string, traffic uint32, version uint32) {
-		c.AddCluster(httpCluster, routeName, modelName, version, false)
-		c.AddCluster(grpcCluster, routeName, modelName, version, true)
-		c.AddRouteClusterTraffic(routeName, modelName, version, traffic, httpCluster, grpcCluster, true, false)
+		c.AddCluster(httpCluster, routeName, modelName, version, false, nil)
+		c.AddCluster(grpcCluster, routeName, modelName, version, true, nil)
+		c.AddRouteClusterTraffic(routeName, modelName, version, traffic, httpCluster, grpcCluster, true, false, nil, nil)
 		c.AddEndpoint(httpCluster, "0.0.0.0", 9000)
 		c.AddEndpoint(grpcCluster, "0.0.0.0", 9001)
 	}
@@ -63,9 +64,9 @@ func TestAddRemoveHttpAndGrpcRouteVersions(t *testing.T) {
 	logger := log.New()
 
 	addVersionedRoute := func(c *SeldonXDSCache, routeName string, modelName string, httpCluster string, grpcCluster string, traffic uint32, version uint32) {
-		c.AddCluster(httpCluster, routeName, modelName, version, false)
-		c.AddCluster(grpcCluster, routeName, modelName, version, true)
-		c.AddRouteClusterTraffic(routeName, modelName, version, traffic, httpCluster, grpcCluster, true, false)
+		c.AddCluster(httpCluster, routeName, modelName, version, false, nil)
+		c.AddCluster(grpcCluster, routeName, modelName, version, true, nil)
+		c.AddRouteClusterTraffic(routeName, modelName, version, traffic, httpCluster, grpcCluster, true, false, nil, nil)
 		c.AddEndpoint(httpCluster, "0.0.0.0", 9000)
 		c.AddEndpoint(grpcCluster, "0.0.0.0", 9001)
 	}
@@ -124,9 +125,9 @@ func TestAddRemoveHttpAndGrpcRouteVersionsForSameModel(t *testing.T) {
 	logger := log.New()
 
 	addVersionedRoute := func(c *SeldonXDSCache, routeName string, modelName string, httpCluster string, grpcCluster string, traffic uint32, version uint32) {
-		c.AddCluster(httpCluster, routeName, modelName, version, false)
-		c.AddCluster(grpcCluster, routeName, modelName, version, true)
-		c.AddRouteClusterTraffic(routeName, modelName, version, traffic, httpCluster, grpcCluster, true, false)
+		c.AddCluster(httpCluster, routeName, modelName, version, false, nil)
+		c.AddCluster(grpcCluster, routeName, modelName, version, true, nil)
+		c.AddRouteClusterTraffic(routeName, modelName, version, traffic, httpCluster, grpcCluster, true, false, nil, nil)
 		c.AddEndpoint(httpCluster, "0.0.0.0", 9000)
 		c.AddEndpoint(grpcCluster, "0.0.0.0", 9001)
 	}
@@ -168,9 +169,9 @@ func TestAddRemoveHttpAndGrpcRouteVersionsForDifferentModels(t *testing.T) {
 	logger := log.New()
 
 	addVersionedRoute := func(c *SeldonXDSCache, modelRouteName string, modelName string, httpCluster string, grpcCluster string, traffic uint32, version uint32) {
-		c.AddCluster(httpCluster, modelRouteName, modelName, version, false)
-		c.AddCluster(grpcCluster, modelRouteName, modelName, version, true)
-		c.AddRouteClusterTraffic(modelRouteName, modelName, version, traffic, httpCluster, grpcCluster, true, false)
+		c.AddCluster(httpCluster, modelRouteName, modelName, version, false, nil)
+		c.AddCluster(grpcCluster, modelRouteName, modelName, version, true, nil)
+		c.AddRouteClusterTraffic(modelRouteName, modelName, version, traffic, httpCluster, grpcCluster, true, false, nil, nil)
 		c.AddEndpoint(httpCluster, "0.0.0.0", 9000)
 		c.AddEndpoint(grpcCluster, "0.0.0.0", 9001)
 	}
@@ -217,9 +218,9 @@ func TestAddRemoveHttpAndGrpcRouteVersionsForDifferentRoutesSameModel(t *testing
 	logger := log.New()
 
 	addVersionedRoute := func(c *SeldonXDSCache, modelRouteName string, modelName string, httpCluster string, grpcCluster string, traffic uint32, version uint32) {
-		c.AddCluster(httpCluster, modelRouteName, modelName, version, false)
-		c.AddCluster(grpcCluster, modelRouteName, modelName, version, true)
-		c.AddRouteClusterTraffic(modelRouteName, modelName, version, traffic, httpCluster, grpcCluster, true, false)
+		c.AddCluster(httpCluster, modelRouteName, modelName, version, false, nil)
+		c.AddCluster(grpcCluster, modelRouteName, modelName, version, true, nil)
+		c.AddRouteClusterTraffic(modelRouteName, modelName, version, traffic, httpCluster, grpcCluster, true, false, nil, nil)
 		c.AddEndpoint(httpCluster, "0.0.0.0", 9000)
 		c.AddEndpoint(grpcCluster, "0.0.0.0", 9001)
 	}
@@ -262,6 +263,31 @@ func TestAddRemoveHttpAndGrpcRouteVersionsForDifferentRoutesSameModel(t *testing
 	g.Expect(ok).To(BeFalse()) // grpc Cluster removed
 }
 
+// Checks experiment routes only take the policies set for the experiment, whichever candidate is added first
+func TestExperimentRoutePolicies(t *testing.T) {
+	g := NewGomegaWithT(t)
+	logger := log.New()
+	c := NewSeldonXDSCache(logger, &PipelineGatewayDetails{})
+	getUintPtr := func(val uint32) *uint32 { return &val }
+	candidatePolicy := &scheduler.TrafficPolicy{TimeoutMs: getUintPtr(1000)}
+	defaultPolicy := &scheduler.TrafficPolicy{TimeoutMs: getUintPtr(2000)}
+	authz := &scheduler.AuthorizationPolicy{Subjects: []string{"team-a"}}
+
+	c.AddRouteClusterTraffic("exp.experiment", "m2", 1, 50, "http2", "grpc2", false, false, candidatePolicy, authz)
+	c.AddRouteClusterTraffic("exp.experiment", "m1", 1, 50, "http1", "grpc1", false, false, defaultPolicy, nil)
+	g.Expect(c.Routes["exp.experiment"].TrafficPolicy).To(BeNil())
+	g.Expect(c.Routes["exp.experiment"].Authorization).To(BeNil())
+
+	c.SetRoutePolicies("exp.experiment", defaultPolicy, nil)
+	g.Expect(c.Routes["exp.experiment"].TrafficPolicy).To(Equal(defaultPolicy))
+	g.Expect(c.Routes["exp.experiment"].Authorization).To(BeNil())
+
+	// the route of a model keeps its own policies
+	c.AddRouteClusterTraffic("m2", "m2", 1, 100, "http2", "grpc2", false, false, candidatePolicy, authz)
+	g.Expect(c.Routes["m2"].TrafficPolicy).To(Equal(candidatePolicy))
+	g.Expect(c.Routes["m2"].Authorization).To(Equal(authz))
+}
+
 func TestSetupTLS(t *testing.T) {
 	g := NewGomegaWithT(t)
 	logger := log.New()
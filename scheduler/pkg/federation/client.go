@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package federation
+
+import (
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+	seldontls "github.com/seldonio/seldon-core/components/tls/v2/pkg/tls"
+)
+
+func controlPlaneTLS() bool {
+	return seldontls.GetSecurityProtocolFromEnv(seldontls.EnvSecurityPrefixControlPlane) == seldontls.SecurityProtocolSSL
+}
+
+// newPeerClient connects to the scheduler of another region, with mTLS if the control plane uses it
+func newPeerClient(address string) (pb.SchedulerClient, error) {
+	var transCreds credentials.TransportCredentials
+	if controlPlaneTLS() {
+		certificateStore, err := seldontls.NewCertificateStore(
+			seldontls.Prefix(seldontls.EnvSecurityPrefixControlPlaneClient),
+			seldontls.ValidationPrefix(seldontls.EnvSecurityPrefixControlPlaneServer),
+		)
+		if err != nil {
+			return nil, err
+		}
+		transCreds = certificateStore.CreateClientTransportCredentials()
+	} else {
+		transCreds = insecure.NewCredentials()
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(transCreds),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	}
+	conn, err := grpc.Dial(address, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewSchedulerClient(conn), nil
+}
@@ -0,0 +1,369 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package federation
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/coordinator"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+)
+
+const (
+	DefaultPollInterval   = 5 * time.Second
+	TokenMetadataKey      = "x-seldon-federation-token"
+	summaryQueryTimeout   = 5 * time.Second
+	federationEventSource = "federation"
+	// Regions not polled successfully for this many intervals are dropped, so traffic stops failing over to them
+	staleIntervals = 3
+)
+
+// Config of a scheduler taking part in a federation of schedulers in other regions
+type Config struct {
+	Region        string
+	InferenceHost string   // host of the Envoy of this region for requests from other regions
+	InferencePort uint32   // port of the Envoy of this region for requests from other regions
+	Peers         []string // host:port of the schedulers of the other regions
+	// Token shared by the schedulers of the federation to authenticate each other, needed unless the control plane
+	// uses mTLS
+	Token string
+}
+
+func (c *Config) Validate() error {
+	if c.Region == "" {
+		return fmt.Errorf("federation needs the region of the scheduler")
+	}
+	if c.InferenceHost == "" || c.InferencePort == 0 {
+		return fmt.Errorf("federation needs the inference host and port of region %s", c.Region)
+	}
+	return nil
+}
+
+// RemoteModel is a model available in another region, reached through the Envoy of that region
+type RemoteModel struct {
+	Region   string
+	Host     string
+	Port     uint32
+	Replicas uint32
+}
+
+// RemoteModels gives the other regions a model is available in
+type RemoteModels interface {
+	GetRegion() string
+	// GetRemoteModels returns the other regions with available replicas of a model with the same name and spec hash
+	GetRemoteModels(model *pb.Model) []RemoteModel
+}
+
+type regionModel struct {
+	replicas uint32
+	specHash string
+}
+
+type regionSummary struct {
+	host    string
+	port    uint32
+	models  map[string]regionModel // model name to available replicas and spec hash
+	updated time.Time
+}
+
+// Federation polls the schedulers of other regions for the models available there and shares the models available
+// in this region, so Envoy can send requests to other regions when no local replica is available
+type Federation struct {
+	config   Config
+	store    store.ModelStore
+	eventHub *coordinator.EventHub
+	peers    map[string]pb.SchedulerClient // scheduler address to client
+	logger   log.FieldLogger
+	mu       sync.RWMutex
+	regions  map[string]*regionSummary
+	done     chan struct{}
+}
+
+func NewFederation(
+	config Config,
+	schedStore store.ModelStore,
+	eventHub *coordinator.EventHub,
+	logger log.FieldLogger,
+) (*Federation, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	// The summary rpc is also served on the plain text port, so peers could not be told apart without mTLS
+	if config.Token == "" && !controlPlaneTLS() {
+		return nil, fmt.Errorf("federation of region %s needs control plane mTLS or a token to authenticate other regions", config.Region)
+	}
+	f := &Federation{
+		config:   config,
+		store:    schedStore,
+		eventHub: eventHub,
+		peers:    make(map[string]pb.SchedulerClient),
+		logger:   logger.WithField("source", "Federation"),
+		regions:  make(map[string]*regionSummary),
+		done:     make(chan struct{}),
+	}
+	for _, peer := range config.Peers {
+		client, err := newPeerClient(peer)
+		if err != nil {
+			return nil, err
+		}
+		f.peers[peer] = client
+	}
+	return f, nil
+}
+
+func (f *Federation) Start(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-f.done:
+			return
+		case <-ticker.C:
+			f.poll(interval)
+		}
+	}
+}
+
+func (f *Federation) Stop() {
+	close(f.done)
+}
+
+// GetSummary returns the models with available replicas in this region
+func (f *Federation) GetSummary() (*pb.FederationSummaryResponse, error) {
+	models, err := f.store.GetModels()
+	if err != nil {
+		return nil, err
+	}
+	summary := &pb.FederationSummaryResponse{
+		Region:        f.config.Region,
+		InferenceHost: f.config.InferenceHost,
+		InferencePort: f.config.InferencePort,
+	}
+	for _, model := range models {
+		if model.Deleted {
+			continue
+		}
+		if replicas := availableReplicas(model); replicas > 0 {
+			summary.Models = append(summary.Models, &pb.FederatedModel{
+				Name:              model.Name,
+				AvailableReplicas: replicas,
+				SpecHash:          GetModelSpecHash(model.GetLatest().GetModel()),
+			})
+		}
+	}
+	sort.Slice(summary.Models, func(i, j int) bool { return summary.Models[i].Name < summary.Models[j].Name })
+	return summary, nil
+}
+
+// AuthenticatePeer checks a summary request comes from a scheduler of the federation, by its token if the
+// federation has one or else by its verified mTLS client certificate
+func (f *Federation) AuthenticatePeer(ctx context.Context) error {
+	if f.config.Token != "" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		for _, token := range md.Get(TokenMetadataKey) {
+			if subtle.ConstantTimeCompare([]byte(token), []byte(f.config.Token)) == 1 {
+				return nil
+			}
+		}
+		return &PeerUnauthenticatedErr{reason: "missing or invalid federation token"}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 {
+			return nil
+		}
+	}
+	return &PeerUnauthenticatedErr{reason: "no verified mTLS client certificate"}
+}
+
+// availableReplicas counts the local replicas able to serve a model, which excludes traffic sent to other regions
+func availableReplicas(model *store.ModelSnapshot) uint32 {
+	if !model.CanReceiveTraffic() {
+		return 0
+	}
+	replicas := 0
+	latest := model.GetLatest()
+	if latest != nil {
+		replicas += len(latest.GetAssignment())
+	}
+	if lastAvailable := model.GetLastAvailableModel(); lastAvailable != nil && lastAvailable != latest {
+		replicas += len(lastAvailable.GetAssignment())
+	}
+	return uint32(replicas)
+}
+
+// GetModelSpecHash returns a hash of the artifacts and parameters of a model, so requests only fail over to regions
+// serving the same model. Settings which may differ between regions, such as storage secrets, the server and
+// policies, are left out.
+func GetModelSpecHash(model *pb.Model) string {
+	modelSpec := model.GetModelSpec()
+	spec := &pb.ModelSpec{
+		Uri:             modelSpec.GetUri(),
+		ArtifactVersion: modelSpec.ArtifactVersion,
+		Explainer:       modelSpec.GetExplainer(),
+		Parameters:      modelSpec.GetParameters(),
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(spec)
+	if err != nil {
+		// Marshalling only fails for invalid messages, so fall back to the string form
+		b = []byte(spec.String())
+	}
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:])
+}
+
+func (f *Federation) GetRegion() string {
+	return f.config.Region
+}
+
+// GetRemoteModels returns the other regions a model is available in with the same spec, sorted by region. Regions
+// with another version of the model, or not sending spec hashes, are left out.
+func (f *Federation) GetRemoteModels(model *pb.Model) []RemoteModel {
+	modelName := model.GetMeta().GetName()
+	specHash := GetModelSpecHash(model)
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	var remoteModels []RemoteModel
+	for region, summary := range f.regions {
+		if remoteModel, ok := summary.models[modelName]; ok && remoteModel.specHash == specHash {
+			remoteModels = append(remoteModels, RemoteModel{
+				Region:   region,
+				Host:     summary.host,
+				Port:     summary.port,
+				Replicas: remoteModel.replicas,
+			})
+		}
+	}
+	sort.Slice(remoteModels, func(i, j int) bool { return remoteModels[i].Region < remoteModels[j].Region })
+	return remoteModels
+}
+
+func (f *Federation) poll(interval time.Duration) {
+	logger := f.logger.WithField("func", "poll")
+	changed := make(map[string]bool)
+	for address, client := range f.peers {
+		ctx, cancel := context.WithTimeout(context.Background(), summaryQueryTimeout)
+		if f.config.Token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, TokenMetadataKey, f.config.Token)
+		}
+		summary, err := client.FederationSummary(ctx, &pb.FederationSummaryRequest{Region: f.config.Region})
+		cancel()
+		if err != nil {
+			logger.WithError(err).Warnf("Failed to get federation summary from scheduler %s", address)
+			continue
+		}
+		for _, modelName := range f.updateRegion(summary, time.Now()) {
+			changed[modelName] = true
+		}
+	}
+	for _, modelName := range f.expireRegions(time.Now().Add(-staleIntervals * interval)) {
+		changed[modelName] = true
+	}
+	f.publishModelEvents(changed)
+}
+
+// updateRegion stores the summary of another region and returns the models whose availability there changed
+func (f *Federation) updateRegion(summary *pb.FederationSummaryResponse, now time.Time) []string {
+	if summary.GetRegion() == "" || summary.GetRegion() == f.config.Region {
+		f.logger.Warnf("Ignoring federation summary of region %q as the region of this scheduler is %q", summary.GetRegion(), f.config.Region)
+		return nil
+	}
+	next := &regionSummary{
+		host:    summary.GetInferenceHost(),
+		port:    summary.GetInferencePort(),
+		models:  make(map[string]regionModel),
+		updated: now,
+	}
+	for _, model := range summary.GetModels() {
+		if model.GetAvailableReplicas() > 0 {
+			next.models[model.GetName()] = regionModel{
+				replicas: model.GetAvailableReplicas(),
+				specHash: model.GetSpecHash(),
+			}
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	previous := f.regions[summary.GetRegion()]
+	f.regions[summary.GetRegion()] = next
+	return changedModels(previous, next)
+}
+
+// expireRegions drops the regions not updated since the given time and returns the models that were available there
+func (f *Federation) expireRegions(updatedBefore time.Time) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var changed []string
+	for region, summary := range f.regions {
+		if summary.updated.Before(updatedBefore) {
+			f.logger.Warnf("Dropping models of region %s not updated since %s", region, summary.updated.Format(time.RFC3339))
+			delete(f.regions, region)
+			changed = append(changed, changedModels(summary, nil)...)
+		}
+	}
+	return changed
+}
+
+func changedModels(previous *regionSummary, next *regionSummary) []string {
+	var changed []string
+	if previous == nil && next == nil {
+		return nil
+	}
+	if previous == nil || next == nil || previous.host != next.host || previous.port != next.port {
+		for _, summary := range []*regionSummary{previous, next} {
+			if summary != nil {
+				for modelName := range summary.models {
+					changed = append(changed, modelName)
+				}
+			}
+		}
+		return changed
+	}
+	for modelName, model := range next.models {
+		if previousModel, ok := previous.models[modelName]; !ok || previousModel != model {
+			changed = append(changed, modelName)
+		}
+	}
+	for modelName := range previous.models {
+		if _, ok := next.models[modelName]; !ok {
+			changed = append(changed, modelName)
+		}
+	}
+	return changed
+}
+
+// publishModelEvents updates the routes of the changed models known to this scheduler
+func (f *Federation) publishModelEvents(changed map[string]bool) {
+	if f.eventHub == nil {
+		return
+	}
+	for modelName := range changed {
+		if model, err := f.store.GetModel(modelName); err != nil || model == nil || model.GetLatest() == nil {
+			continue
+		}
+		f.logger.Debugf("Availability of model %s in other regions changed", modelName)
+		f.eventHub.PublishModelEvent(federationEventSource, coordinator.ModelEventMsg{ModelName: modelName})
+	}
+}
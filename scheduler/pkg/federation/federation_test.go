@@ -0,0 +1,532 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package federation
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	pba "github.com/seldonio/seldon-core/apis/go/v2/mlops/agent"
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/coordinator"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+)
+
+func createTestStore(g *WithT, models map[string][]store.ModelReplicaState) store.ModelStore {
+	memoryStore := store.NewMemoryStore(log.New(), store.NewLocalSchedulerStore(), nil)
+	serverName := "server"
+	numReplicas := 0
+	for _, states := range models {
+		if len(states) > numReplicas {
+			numReplicas = len(states)
+		}
+	}
+	for i := 0; i < numReplicas; i++ {
+		err := memoryStore.AddServerReplica(&pba.AgentSubscribeRequest{
+			ServerName: serverName,
+			ReplicaIdx: uint32(i),
+			ReplicaConfig: &pba.ReplicaConfig{
+				InferenceSvc:      fmt.Sprintf("%s.%d", serverName, i),
+				InferenceHttpPort: 1234,
+				MemoryBytes:       1000,
+			},
+		})
+		g.Expect(err).To(BeNil())
+	}
+	server, err := memoryStore.GetServer(serverName, false, true)
+	g.Expect(err).To(BeNil())
+	for modelName, states := range models {
+		err := memoryStore.UpdateModel(&pb.LoadModelRequest{
+			Model: &pb.Model{
+				Meta:           &pb.MetaData{Name: modelName},
+				ModelSpec:      &pb.ModelSpec{Uri: "gs://" + modelName},
+				DeploymentSpec: &pb.DeploymentSpec{Replicas: uint32(len(states))},
+			},
+		})
+		g.Expect(err).To(BeNil())
+		var serverReplicas []*store.ServerReplica
+		for idx := range states {
+			serverReplicas = append(serverReplicas, server.Replicas[idx])
+		}
+		err = memoryStore.UpdateLoadedModels(modelName, 1, serverName, serverReplicas)
+		g.Expect(err).To(BeNil())
+		for idx, state := range states {
+			err = memoryStore.UpdateModelState(modelName, 1, serverName, idx, nil, store.LoadRequested, state, "")
+			g.Expect(err).To(BeNil())
+		}
+	}
+	return memoryStore
+}
+
+// createTestModel returns a model with the spec of the models of createTestStore
+func createTestModel(modelName string) *pb.Model {
+	return &pb.Model{
+		Meta:      &pb.MetaData{Name: modelName},
+		ModelSpec: &pb.ModelSpec{Uri: "gs://" + modelName},
+	}
+}
+
+func testSpecHash(modelName string) string {
+	return GetModelSpecHash(createTestModel(modelName))
+}
+
+const testToken = "token"
+
+func createTestFederation(g *WithT, region string, schedStore store.ModelStore, eventHub *coordinator.EventHub, peers []string) *Federation {
+	f, err := NewFederation(
+		Config{Region: region, InferenceHost: region + ".seldon", InferencePort: 80, Peers: peers, Token: testToken},
+		schedStore,
+		eventHub,
+		log.New(),
+	)
+	g.Expect(err).To(BeNil())
+	return f
+}
+
+func TestConfigValidate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name   string
+		config Config
+		err    bool
+	}
+
+	tests := []test{
+		{
+			name:   "valid",
+			config: Config{Region: "eu", InferenceHost: "eu.seldon", InferencePort: 80},
+		},
+		{
+			name:   "no region",
+			config: Config{InferenceHost: "eu.seldon", InferencePort: 80},
+			err:    true,
+		},
+		{
+			name:   "no inference host",
+			config: Config{Region: "eu", InferencePort: 80},
+			err:    true,
+		},
+		{
+			name:   "no inference port",
+			config: Config{Region: "eu", InferenceHost: "eu.seldon"},
+			err:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.err {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestNewFederationNeedsAuthentication(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, err := NewFederation(Config{Region: "eu", InferenceHost: "eu.seldon", InferencePort: 80}, nil, nil, log.New())
+	g.Expect(err).ToNot(BeNil())
+}
+
+func TestAuthenticatePeer(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	verifiedTLS := credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{}}}}}
+	type test struct {
+		name     string
+		token    string
+		ctx      context.Context
+		expected bool
+	}
+
+	tests := []test{
+		{
+			name:     "valid token",
+			token:    testToken,
+			ctx:      metadata.NewIncomingContext(context.Background(), metadata.Pairs(TokenMetadataKey, testToken)),
+			expected: true,
+		},
+		{
+			name:  "invalid token",
+			token: testToken,
+			ctx:   metadata.NewIncomingContext(context.Background(), metadata.Pairs(TokenMetadataKey, "other")),
+		},
+		{
+			name:  "no token",
+			token: testToken,
+			ctx:   context.Background(),
+		},
+		{
+			name:  "token needed over mTLS when the federation has one",
+			token: testToken,
+			ctx:   peer.NewContext(context.Background(), &peer.Peer{AuthInfo: verifiedTLS}),
+		},
+		{
+			name:     "mTLS",
+			ctx:      peer.NewContext(context.Background(), &peer.Peer{AuthInfo: verifiedTLS}),
+			expected: true,
+		},
+		{
+			name: "plain text",
+			ctx:  peer.NewContext(context.Background(), &peer.Peer{}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f := &Federation{config: Config{Region: "eu", Token: test.token}}
+			err := f.AuthenticatePeer(test.ctx)
+			if test.expected {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).ToNot(BeNil())
+			}
+		})
+	}
+}
+
+func TestGetSummary(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	schedStore := createTestStore(g, map[string][]store.ModelReplicaState{
+		"iris":    {store.Available, store.Available},
+		"mnist":   {store.Available, store.LoadFailed},
+		"loading": {store.Loading},
+		"failed":  {store.LoadFailed},
+	})
+	f := createTestFederation(g, "eu", schedStore, nil, nil)
+
+	summary, err := f.GetSummary()
+	g.Expect(err).To(BeNil())
+	g.Expect(summary.GetRegion()).To(Equal("eu"))
+	g.Expect(summary.GetInferenceHost()).To(Equal("eu.seldon"))
+	g.Expect(summary.GetInferencePort()).To(Equal(uint32(80)))
+	g.Expect(summary.GetModels()).To(HaveLen(2))
+	g.Expect(summary.GetModels()[0].GetName()).To(Equal("iris"))
+	g.Expect(summary.GetModels()[0].GetAvailableReplicas()).To(Equal(uint32(2)))
+	g.Expect(summary.GetModels()[1].GetName()).To(Equal("mnist"))
+	g.Expect(summary.GetModels()[1].GetAvailableReplicas()).To(Equal(uint32(1)))
+	g.Expect(summary.GetModels()[1].GetSpecHash()).To(Equal(testSpecHash("mnist")))
+}
+
+func TestGetModelSpecHash(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	getUintPtr := func(val uint32) *uint32 { return &val }
+	getStrPtr := func(val string) *string { return &val }
+	model := createTestModel("iris")
+	hash := GetModelSpecHash(model)
+	g.Expect(hash).ToNot(BeEmpty())
+
+	// settings which may differ between regions don't change the hash
+	otherRegion := createTestModel("iris")
+	otherRegion.ModelSpec.Server = getStrPtr("mlserver")
+	otherRegion.ModelSpec.StorageConfig = &pb.StorageConfig{Config: &pb.StorageConfig_StorageSecretName{StorageSecretName: "secret"}}
+	otherRegion.ModelSpec.TrafficPolicy = &pb.TrafficPolicy{TimeoutMs: getUintPtr(1000)}
+	g.Expect(GetModelSpecHash(otherRegion)).To(Equal(hash))
+
+	otherArtifacts := createTestModel("iris")
+	otherArtifacts.ModelSpec.ArtifactVersion = getUintPtr(2)
+	g.Expect(GetModelSpecHash(otherArtifacts)).ToNot(Equal(hash))
+
+	otherUri := createTestModel("iris")
+	otherUri.ModelSpec.Uri = "gs://iris-v2"
+	g.Expect(GetModelSpecHash(otherUri)).ToNot(Equal(hash))
+}
+
+func TestUpdateRegion(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name            string
+		summaries       []*pb.FederationSummaryResponse
+		expectedChanged []string
+		expectedRemote  map[string][]RemoteModel
+	}
+
+	now := time.Now()
+	tests := []test{
+		{
+			name: "new region",
+			summaries: []*pb.FederationSummaryResponse{
+				{
+					Region: "us", InferenceHost: "us.seldon", InferencePort: 80,
+					Models: []*pb.FederatedModel{{Name: "iris", AvailableReplicas: 2, SpecHash: testSpecHash("iris")}, {Name: "mnist", AvailableReplicas: 0, SpecHash: testSpecHash("mnist")}},
+				},
+			},
+			expectedChanged: []string{"iris"},
+			expectedRemote: map[string][]RemoteModel{
+				"iris":  {{Region: "us", Host: "us.seldon", Port: 80, Replicas: 2}},
+				"mnist": nil,
+			},
+		},
+		{
+			name: "replicas changed",
+			summaries: []*pb.FederationSummaryResponse{
+				{
+					Region: "us", InferenceHost: "us.seldon", InferencePort: 80,
+					Models: []*pb.FederatedModel{{Name: "iris", AvailableReplicas: 2, SpecHash: testSpecHash("iris")}, {Name: "mnist", AvailableReplicas: 1, SpecHash: testSpecHash("mnist")}},
+				},
+				{
+					Region: "us", InferenceHost: "us.seldon", InferencePort: 80,
+					Models: []*pb.FederatedModel{{Name: "iris", AvailableReplicas: 3, SpecHash: testSpecHash("iris")}, {Name: "mnist", AvailableReplicas: 1, SpecHash: testSpecHash("mnist")}},
+				},
+			},
+			expectedChanged: []string{"iris"},
+			expectedRemote: map[string][]RemoteModel{
+				"iris":  {{Region: "us", Host: "us.seldon", Port: 80, Replicas: 3}},
+				"mnist": {{Region: "us", Host: "us.seldon", Port: 80, Replicas: 1}},
+			},
+		},
+		{
+			name: "model removed",
+			summaries: []*pb.FederationSummaryResponse{
+				{
+					Region: "us", InferenceHost: "us.seldon", InferencePort: 80,
+					Models: []*pb.FederatedModel{{Name: "iris", AvailableReplicas: 2, SpecHash: testSpecHash("iris")}, {Name: "mnist", AvailableReplicas: 1, SpecHash: testSpecHash("mnist")}},
+				},
+				{
+					Region: "us", InferenceHost: "us.seldon", InferencePort: 80,
+					Models: []*pb.FederatedModel{{Name: "iris", AvailableReplicas: 2, SpecHash: testSpecHash("iris")}},
+				},
+			},
+			expectedChanged: []string{"mnist"},
+			expectedRemote: map[string][]RemoteModel{
+				"iris":  {{Region: "us", Host: "us.seldon", Port: 80, Replicas: 2}},
+				"mnist": nil,
+			},
+		},
+		{
+			name: "inference host changed",
+			summaries: []*pb.FederationSummaryResponse{
+				{
+					Region: "us", InferenceHost: "us.seldon", InferencePort: 80,
+					Models: []*pb.FederatedModel{{Name: "iris", AvailableReplicas: 2, SpecHash: testSpecHash("iris")}, {Name: "mnist", AvailableReplicas: 1, SpecHash: testSpecHash("mnist")}},
+				},
+				{
+					Region: "us", InferenceHost: "us2.seldon", InferencePort: 80,
+					Models: []*pb.FederatedModel{{Name: "iris", AvailableReplicas: 2, SpecHash: testSpecHash("iris")}, {Name: "mnist", AvailableReplicas: 1, SpecHash: testSpecHash("mnist")}},
+				},
+			},
+			expectedChanged: []string{"iris", "iris", "mnist", "mnist"},
+			expectedRemote: map[string][]RemoteModel{
+				"iris": {{Region: "us", Host: "us2.seldon", Port: 80, Replicas: 2}},
+			},
+		},
+		{
+			name: "several regions",
+			summaries: []*pb.FederationSummaryResponse{
+				{
+					Region: "us", InferenceHost: "us.seldon", InferencePort: 80,
+					Models: []*pb.FederatedModel{{Name: "iris", AvailableReplicas: 2, SpecHash: testSpecHash("iris")}},
+				},
+				{
+					Region: "ap", InferenceHost: "ap.seldon", InferencePort: 80,
+					Models: []*pb.FederatedModel{{Name: "iris", AvailableReplicas: 1, SpecHash: testSpecHash("iris")}},
+				},
+			},
+			expectedChanged: []string{"iris"},
+			expectedRemote: map[string][]RemoteModel{
+				"iris": {
+					{Region: "ap", Host: "ap.seldon", Port: 80, Replicas: 1},
+					{Region: "us", Host: "us.seldon", Port: 80, Replicas: 2},
+				},
+			},
+		},
+		{
+			name: "spec changed",
+			summaries: []*pb.FederationSummaryResponse{
+				{
+					Region: "us", InferenceHost: "us.seldon", InferencePort: 80,
+					Models: []*pb.FederatedModel{{Name: "iris", AvailableReplicas: 2, SpecHash: testSpecHash("iris")}, {Name: "mnist", AvailableReplicas: 1, SpecHash: testSpecHash("mnist")}},
+				},
+				{
+					Region: "us", InferenceHost: "us.seldon", InferencePort: 80,
+					Models: []*pb.FederatedModel{{Name: "iris", AvailableReplicas: 2, SpecHash: "other"}, {Name: "mnist", AvailableReplicas: 1, SpecHash: testSpecHash("mnist")}},
+				},
+			},
+			expectedChanged: []string{"iris"},
+			expectedRemote: map[string][]RemoteModel{
+				"iris":  nil,
+				"mnist": {{Region: "us", Host: "us.seldon", Port: 80, Replicas: 1}},
+			},
+		},
+		{
+			name: "no spec hash",
+			summaries: []*pb.FederationSummaryResponse{
+				{
+					Region: "us", InferenceHost: "us.seldon", InferencePort: 80,
+					Models: []*pb.FederatedModel{{Name: "iris", AvailableReplicas: 2}},
+				},
+			},
+			expectedChanged: []string{"iris"},
+			expectedRemote: map[string][]RemoteModel{
+				"iris": nil,
+			},
+		},
+		{
+			name: "own region ignored",
+			summaries: []*pb.FederationSummaryResponse{
+				{
+					Region: "eu", InferenceHost: "eu.seldon", InferencePort: 80,
+					Models: []*pb.FederatedModel{{Name: "iris", AvailableReplicas: 2, SpecHash: testSpecHash("iris")}},
+				},
+			},
+			expectedRemote: map[string][]RemoteModel{
+				"iris": nil,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f := createTestFederation(g, "eu", nil, nil, nil)
+			var changed []string
+			for _, summary := range test.summaries {
+				changed = f.updateRegion(summary, now)
+			}
+			sort.Strings(changed)
+			g.Expect(changed).To(Equal(test.expectedChanged))
+			for modelName, expected := range test.expectedRemote {
+				g.Expect(f.GetRemoteModels(createTestModel(modelName))).To(Equal(expected))
+			}
+		})
+	}
+}
+
+func TestExpireRegions(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Now()
+	f := createTestFederation(g, "eu", nil, nil, nil)
+	f.updateRegion(&pb.FederationSummaryResponse{
+		Region: "us", InferenceHost: "us.seldon", InferencePort: 80,
+		Models: []*pb.FederatedModel{{Name: "iris", AvailableReplicas: 2, SpecHash: testSpecHash("iris")}},
+	}, now.Add(-time.Minute))
+	f.updateRegion(&pb.FederationSummaryResponse{
+		Region: "ap", InferenceHost: "ap.seldon", InferencePort: 80,
+		Models: []*pb.FederatedModel{{Name: "iris", AvailableReplicas: 1, SpecHash: testSpecHash("iris")}, {Name: "mnist", AvailableReplicas: 1, SpecHash: testSpecHash("mnist")}},
+	}, now)
+
+	changed := f.expireRegions(now.Add(-time.Second))
+	g.Expect(changed).To(Equal([]string{"iris"}))
+	g.Expect(f.GetRemoteModels(createTestModel("iris"))).To(Equal([]RemoteModel{{Region: "ap", Host: "ap.seldon", Port: 80, Replicas: 1}}))
+	g.Expect(f.GetRemoteModels(createTestModel("mnist"))).To(HaveLen(1))
+
+	g.Expect(f.expireRegions(now.Add(-time.Second))).To(BeEmpty())
+}
+
+func TestPublishModelEvents(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	eventHub, err := coordinator.NewEventHub(log.New())
+	g.Expect(err).To(BeNil())
+	defer eventHub.Close()
+
+	var mu sync.Mutex
+	var events []string
+	eventHub.RegisterModelEventHandler("test", 10, log.New(), func(event coordinator.ModelEventMsg) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event.ModelName)
+	})
+
+	schedStore := createTestStore(g, map[string][]store.ModelReplicaState{
+		"iris": {store.LoadFailed},
+	})
+	f := createTestFederation(g, "eu", schedStore, eventHub, nil)
+	f.publishModelEvents(map[string]bool{"iris": true, "unknown": true})
+
+	g.Eventually(func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return events
+	}).Should(Equal([]string{"iris"}))
+	g.Consistently(func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(events)
+	}, 100*time.Millisecond).Should(Equal(1))
+}
+
+type testSchedulerServer struct {
+	pb.UnimplementedSchedulerServer
+	f *Federation
+}
+
+func (s *testSchedulerServer) FederationSummary(ctx context.Context, req *pb.FederationSummaryRequest) (*pb.FederationSummaryResponse, error) {
+	if err := s.f.AuthenticatePeer(ctx); err != nil {
+		return nil, err
+	}
+	return s.f.GetSummary()
+}
+
+// Two schedulers on this machine, each with a model only available in its own region
+func TestTwoRegions(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	euListener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).To(BeNil())
+	usListener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).To(BeNil())
+
+	eu := createTestFederation(g, "eu", createTestStore(g, map[string][]store.ModelReplicaState{
+		"iris":  {store.Available},
+		"mnist": {store.LoadFailed},
+	}), nil, []string{usListener.Addr().String()})
+	us := createTestFederation(g, "us", createTestStore(g, map[string][]store.ModelReplicaState{
+		"iris":  {store.LoadFailed},
+		"mnist": {store.Available, store.Available},
+	}), nil, []string{euListener.Addr().String()})
+
+	for _, s := range []struct {
+		listener net.Listener
+		f        *Federation
+	}{{euListener, eu}, {usListener, us}} {
+		grpcServer := grpc.NewServer()
+		pb.RegisterSchedulerServer(grpcServer, &testSchedulerServer{f: s.f})
+		go func(listener net.Listener) {
+			_ = grpcServer.Serve(listener)
+		}(s.listener)
+		defer grpcServer.Stop()
+	}
+
+	eu.poll(DefaultPollInterval)
+	us.poll(DefaultPollInterval)
+
+	g.Expect(eu.GetRemoteModels(createTestModel("iris"))).To(BeEmpty())
+	g.Expect(eu.GetRemoteModels(createTestModel("mnist"))).To(Equal([]RemoteModel{{Region: "us", Host: "us.seldon", Port: 80, Replicas: 2}}))
+	g.Expect(us.GetRemoteModels(createTestModel("iris"))).To(Equal([]RemoteModel{{Region: "eu", Host: "eu.seldon", Port: 80, Replicas: 1}}))
+	g.Expect(us.GetRemoteModels(createTestModel("mnist"))).To(BeEmpty())
+
+	// Regions that are no longer polled are dropped once stale
+	eu.expireRegions(time.Now().Add(time.Second))
+	g.Expect(eu.GetRemoteModels(createTestModel("mnist"))).To(BeEmpty())
+}
@@ -0,0 +1,140 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+// Package claimcheck moves large payloads out of Kafka messages. Payloads above a threshold are written to an
+// object store and the message carries a reference to them in a header, which consumers use to load the payload.
+package claimcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+)
+
+const (
+	// HeaderKeyClaimCheck holds the object store key of a payload removed from a message
+	HeaderKeyClaimCheck = "seldon-claim-check"
+	// Below the default broker message.max.bytes of 1MB, leaving room for the headers
+	DefaultThresholdBytes = 900 * 1024
+)
+
+var ErrNoStore = errors.New("message payload is held in an object store but no claim check store is configured")
+
+type ObjectStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// ClaimChecker offloads and rehydrates message payloads. A nil ClaimChecker leaves payloads inline.
+type ClaimChecker struct {
+	store          ObjectStore
+	thresholdBytes int
+}
+
+func NewClaimChecker(store ObjectStore, thresholdBytes int) *ClaimChecker {
+	if thresholdBytes <= 0 {
+		thresholdBytes = DefaultThresholdBytes
+	}
+	return &ClaimChecker{
+		store:          store,
+		thresholdBytes: thresholdBytes,
+	}
+}
+
+// NewClaimCheckerFromConfig returns nil if no claim check store is configured
+func NewClaimCheckerFromConfig(kafkaConfig *config.KafkaConfig) (*ClaimChecker, error) {
+	claimCheck := kafkaConfig.ClaimCheck
+	if claimCheck == nil {
+		return nil, nil
+	}
+	switch {
+	case claimCheck.S3 != nil:
+		store, err := NewS3Store(claimCheck.S3, S3Credentials{
+			AccessKeyId:     os.Getenv(config.EnvAwsAccessKeyId),
+			SecretAccessKey: os.Getenv(config.EnvAwsSecretAccessKey),
+			SessionToken:    os.Getenv(config.EnvAwsSessionToken),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return NewClaimChecker(store, claimCheck.ThresholdBytes), nil
+	case claimCheck.Path != "":
+		store, err := NewFileStore(claimCheck.Path)
+		if err != nil {
+			return nil, err
+		}
+		return NewClaimChecker(store, claimCheck.ThresholdBytes), nil
+	default:
+		return nil, nil
+	}
+}
+
+// Offload moves the payload of a message above the threshold to the object store, leaving an empty payload and a
+// reference header. Any reference copied from the message being responded to is removed.
+func (c *ClaimChecker) Offload(ctx context.Context, msg *transport.Message) error {
+	msg.Headers = removeReference(msg.Headers)
+	if c == nil || len(msg.Value) <= c.thresholdBytes {
+		return nil
+	}
+	key := fmt.Sprintf("%s/%s", msg.Topic, uuid.New().String())
+	err := c.store.Put(ctx, key, msg.Value)
+	if err != nil {
+		return fmt.Errorf("failed to store payload for topic %s: %w", msg.Topic, err)
+	}
+	msg.Value = []byte{}
+	msg.Headers = append(msg.Headers, transport.Header{Key: HeaderKeyClaimCheck, Value: []byte(key)})
+	return nil
+}
+
+// Rehydrate loads the payload of a message holding a reference. Messages without a reference are unchanged.
+func (c *ClaimChecker) Rehydrate(ctx context.Context, msg *transport.Message) error {
+	key, ok := getReference(msg.Headers)
+	if !ok {
+		return nil
+	}
+	if c == nil {
+		return ErrNoStore
+	}
+	data, err := c.store.Get(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to load payload %s: %w", key, err)
+	}
+	msg.Value = data
+	msg.Headers = removeReference(msg.Headers)
+	return nil
+}
+
+func getReference(headers []transport.Header) (string, bool) {
+	for _, header := range headers {
+		if header.Key == HeaderKeyClaimCheck {
+			return string(header.Value), true
+		}
+	}
+	return "", false
+}
+
+// removeReference returns a new slice so headers shared with the caller are not changed
+func removeReference(headers []transport.Header) []transport.Header {
+	if _, ok := getReference(headers); !ok {
+		return headers
+	}
+	var filtered []transport.Header
+	for _, header := range headers {
+		if header.Key != HeaderKeyClaimCheck {
+			filtered = append(filtered, header)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package claimcheck
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+)
+
+func TestOffloadAndRehydrate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name        string
+		value       []byte
+		headers     []transport.Header
+		expectStore bool
+	}
+
+	tests := []test{
+		{
+			name:  "small payloads stay inline",
+			value: []byte("small"),
+		},
+		{
+			name:        "large payloads are stored",
+			value:       []byte(strings.Repeat("a", 11)),
+			expectStore: true,
+		},
+		{
+			name:  "references copied from a request are removed",
+			value: []byte("small"),
+			headers: []transport.Header{
+				{Key: "x-request-id", Value: []byte("1")},
+				{Key: HeaderKeyClaimCheck, Value: []byte("seldon.default.model.m1.inputs/1")},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			store, err := NewFileStore(t.TempDir())
+			g.Expect(err).To(BeNil())
+			claimChecker := NewClaimChecker(store, 10)
+			msg := &transport.Message{
+				Topic:   "seldon.default.model.m1.outputs",
+				Value:   test.value,
+				Headers: test.headers,
+			}
+
+			err = claimChecker.Offload(ctx, msg)
+			g.Expect(err).To(BeNil())
+			key, stored := getReference(msg.Headers)
+			g.Expect(stored).To(Equal(test.expectStore))
+			if test.expectStore {
+				g.Expect(msg.Value).To(BeEmpty())
+				g.Expect(key).To(HavePrefix("seldon.default.model.m1.outputs/"))
+			} else {
+				g.Expect(msg.Value).To(Equal(test.value))
+			}
+
+			err = claimChecker.Rehydrate(ctx, msg)
+			g.Expect(err).To(BeNil())
+			g.Expect(msg.Value).To(Equal(test.value))
+			_, stored = getReference(msg.Headers)
+			g.Expect(stored).To(BeFalse())
+		})
+	}
+}
+
+func TestNilClaimChecker(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var claimChecker *ClaimChecker
+	ctx := context.Background()
+	msg := &transport.Message{
+		Value:   []byte(strings.Repeat("a", DefaultThresholdBytes+1)),
+		Headers: []transport.Header{{Key: HeaderKeyClaimCheck, Value: []byte("t/1")}},
+	}
+	g.Expect(claimChecker.Offload(ctx, msg)).To(BeNil())
+	g.Expect(msg.Headers).To(BeEmpty())
+	g.Expect(msg.Value).To(HaveLen(DefaultThresholdBytes + 1))
+
+	msg.Headers = []transport.Header{{Key: HeaderKeyClaimCheck, Value: []byte("t/1")}}
+	g.Expect(claimChecker.Rehydrate(ctx, msg)).To(Equal(ErrNoStore))
+}
+
+func TestFileStoreRejectsKeysOutsideDirectory(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	store, err := NewFileStore(t.TempDir())
+	g.Expect(err).To(BeNil())
+	_, err = store.Get(context.Background(), "../../etc/passwd")
+	g.Expect(err).ToNot(BeNil())
+	err = store.Put(context.Background(), "../outside", []byte("a"))
+	g.Expect(err).ToNot(BeNil())
+}
+
+func TestNewClaimCheckerFromConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name              string
+		claimCheck        *config.ClaimCheckConfig
+		expectNil         bool
+		err               bool
+		expectedThreshold int
+	}
+
+	dir := t.TempDir()
+	tests := []test{
+		{
+			name:      "not configured",
+			expectNil: true,
+		},
+		{
+			name:       "no store",
+			claimCheck: &config.ClaimCheckConfig{ThresholdBytes: 10},
+			expectNil:  true,
+		},
+		{
+			name:              "file store with default threshold",
+			claimCheck:        &config.ClaimCheckConfig{Path: dir},
+			expectedThreshold: DefaultThresholdBytes,
+		},
+		{
+			name:              "s3 store",
+			claimCheck:        &config.ClaimCheckConfig{ThresholdBytes: 10, S3: &config.S3Config{Bucket: "payloads"}},
+			expectedThreshold: 10,
+		},
+		{
+			name:       "s3 store without bucket",
+			claimCheck: &config.ClaimCheckConfig{S3: &config.S3Config{}},
+			err:        true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			claimChecker, err := NewClaimCheckerFromConfig(&config.KafkaConfig{ClaimCheck: test.claimCheck})
+			switch {
+			case test.err:
+				g.Expect(err).ToNot(BeNil())
+			case test.expectNil:
+				g.Expect(err).To(BeNil())
+				g.Expect(claimChecker).To(BeNil())
+			default:
+				g.Expect(err).To(BeNil())
+				g.Expect(claimChecker.thresholdBytes).To(Equal(test.expectedThreshold))
+			}
+		})
+	}
+}
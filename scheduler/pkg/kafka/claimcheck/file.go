@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package claimcheck
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileStore keeps payloads under a directory, which must be shared by every gateway and dataflow engine replica
+type FileStore struct {
+	dir string
+}
+
+func NewFileStore(dir string) (*FileStore, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: filepath.Clean(dir)}, nil
+}
+
+// getPath rejects keys, which are read from message headers, that resolve outside of the store directory
+func (f *FileStore) getPath(key string) (string, error) {
+	path := filepath.Join(f.dir, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, f.dir+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid claim check key %s", key)
+	}
+	return path, nil
+}
+
+func (f *FileStore) Put(_ context.Context, key string, data []byte) error {
+	path, err := f.getPath(key)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first so readers never see a partial payload
+	tmp := path + ".tmp"
+	err = os.WriteFile(tmp, data, 0o644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (f *FileStore) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := f.getPath(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(path)
+}
@@ -0,0 +1,169 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package claimcheck
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+)
+
+const (
+	defaultS3Endpoint = "https://s3.amazonaws.com"
+	defaultS3Region   = "us-east-1"
+	s3Timeout         = 30 * time.Second
+	amzDateFormat     = "20060102T150405Z"
+	amzShortFormat    = "20060102"
+	sigV4Algorithm    = "AWS4-HMAC-SHA256"
+)
+
+type S3Credentials struct {
+	AccessKeyId     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+// S3Store keeps payloads in an S3 compatible bucket using path style requests. Requests are signed with AWS
+// signature version 4 if credentials are given.
+type S3Store struct {
+	endpoint    *url.URL
+	bucket      string
+	region      string
+	credentials S3Credentials
+	client      *http.Client
+	now         func() time.Time
+}
+
+func NewS3Store(s3Config *config.S3Config, credentials S3Credentials) (*S3Store, error) {
+	if s3Config.Bucket == "" {
+		return nil, fmt.Errorf("claim check s3 bucket is required")
+	}
+	endpoint := s3Config.Endpoint
+	if endpoint == "" {
+		endpoint = defaultS3Endpoint
+	}
+	endpointUrl, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+	region := s3Config.Region
+	if region == "" {
+		region = defaultS3Region
+	}
+	return &S3Store{
+		endpoint:    endpointUrl,
+		bucket:      s3Config.Bucket,
+		region:      region,
+		credentials: credentials,
+		client:      &http.Client{Timeout: s3Timeout},
+		now:         time.Now,
+	}, nil
+}
+
+func (s *S3Store) Put(ctx context.Context, key string, data []byte) error {
+	_, err := s.do(ctx, http.MethodPut, key, data)
+	return err
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) ([]byte, error) {
+	return s.do(ctx, http.MethodGet, key, nil)
+}
+
+func (s *S3Store) do(ctx context.Context, method string, key string, body []byte) ([]byte, error) {
+	objectUrl := *s.endpoint
+	objectUrl.Path = fmt.Sprintf("%s/%s/%s", s.endpoint.Path, s.bucket, key)
+	req, err := http.NewRequestWithContext(ctx, method, objectUrl.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	s.sign(req, body)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("s3 %s of %s failed with status %d: %s", method, key, resp.StatusCode, string(b))
+	}
+	return b, nil
+}
+
+// sign adds the AWS signature version 4 headers, see
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/create-signed-request.html
+func (s *S3Store) sign(req *http.Request, body []byte) {
+	if s.credentials.AccessKeyId == "" {
+		return
+	}
+	now := s.now().UTC()
+	amzDate := now.Format(amzDateFormat)
+	payloadHash := hashHex(body)
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+	signedHeaders := []string{"host", "x-amz-content-sha256", "x-amz-date"}
+	if s.credentials.SessionToken != "" {
+		req.Header.Set("x-amz-security-token", s.credentials.SessionToken)
+		signedHeaders = append(signedHeaders, "x-amz-security-token")
+	}
+
+	var canonicalHeaders strings.Builder
+	for _, name := range signedHeaders {
+		value := req.Header.Get(name)
+		if name == "host" {
+			value = req.URL.Host
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", now.Format(amzShortFormat), s.region)
+	stringToSign := strings.Join([]string{sigV4Algorithm, amzDate, scope, hashHex([]byte(canonicalRequest))}, "\n")
+	signingKey := hmacSha256([]byte("AWS4"+s.credentials.SecretAccessKey), now.Format(amzShortFormat))
+	signingKey = hmacSha256(signingKey, s.region)
+	signingKey = hmacSha256(signingKey, "s3")
+	signingKey = hmacSha256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSha256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		sigV4Algorithm, s.credentials.AccessKeyId, scope, strings.Join(signedHeaders, ";"), signature,
+	))
+}
+
+func hashHex(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSha256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -12,6 +12,7 @@ package config
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"os"
 
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
@@ -25,6 +26,36 @@ type KafkaConfig struct {
 	Streams               kafka.ConfigMap `json:"streams,omitempty"`
 	TopicPrefix           string          `json:"topicPrefix,omitempty"`
 	ConsumerGroupIdPrefix string          `json:"consumerGroupIdPrefix,omitempty"`
+	// SchemaRegistry is optional, payloads on model and pipeline topics are raw V2 protobuf if not set
+	SchemaRegistry *SchemaRegistryConfig `json:"schemaRegistry,omitempty"`
+	// ClaimCheck is optional, payloads are always sent inline if not set
+	ClaimCheck *ClaimCheckConfig `json:"claimCheck,omitempty"`
+	// Transport is kafka, the default, or inprocess, which keeps messages in the memory of each process
+	Transport string `json:"transport,omitempty"`
+}
+
+type SchemaRegistryConfig struct {
+	// Url of a Confluent compatible schema registry, mock:// uses an in memory registry
+	Url string `json:"url,omitempty"`
+	// Views are the extra formats, avro or json, pipeline topics are copied to for external consumers
+	Views []string `json:"views,omitempty"`
+}
+
+// ClaimCheckConfig sets where payloads above the threshold are stored instead of being sent inline on Kafka.
+// S3 is used if set, otherwise Path.
+type ClaimCheckConfig struct {
+	// ThresholdBytes is the payload size above which payloads are stored, defaults to 900KiB
+	ThresholdBytes int `json:"thresholdBytes,omitempty"`
+	// Path of a directory shared by the gateways and the dataflow engine
+	Path string    `json:"path,omitempty"`
+	S3   *S3Config `json:"s3,omitempty"`
+}
+
+type S3Config struct {
+	// Endpoint of an S3 compatible store, defaults to AWS S3
+	Endpoint string `json:"endpoint,omitempty"`
+	Bucket   string `json:"bucket,omitempty"`
+	Region   string `json:"region,omitempty"`
 }
 
 type none = struct{}
@@ -33,6 +64,15 @@ type stringSet = map[string]none
 const (
 	KafkaBootstrapServers = "bootstrap.servers"
 	KafkaDebug            = "debug"
+	// Values of the transport setting
+	TransportKafka     = "kafka"
+	TransportInProcess = "inprocess"
+	// EnvSchemaRegistryUserInfo holds the schema registry basic auth credentials as <username>:<password>
+	EnvSchemaRegistryUserInfo = "SCHEMA_REGISTRY_BASIC_AUTH_USER_INFO"
+	// Credentials for the claim check S3 store
+	EnvAwsAccessKeyId     = "AWS_ACCESS_KEY_ID"
+	EnvAwsSecretAccessKey = "AWS_SECRET_ACCESS_KEY"
+	EnvAwsSessionToken    = "AWS_SESSION_TOKEN"
 )
 
 // Based on config options defined for librdkafka:
@@ -70,6 +110,10 @@ func NewKafkaConfig(path string) (*KafkaConfig, error) {
 		return nil, err
 	}
 
+	if kc.Transport != "" && kc.Transport != TransportKafka && kc.Transport != TransportInProcess {
+		return nil, fmt.Errorf("unknown transport %s, must be %s or %s", kc.Transport, TransportKafka, TransportInProcess)
+	}
+
 	kc.Consumer, err = convertConfigMap(kc.Consumer)
 	if err != nil {
 		return nil, err
@@ -128,6 +172,11 @@ func (kc KafkaConfig) HasKafkaBootstrapServer() bool {
 	return bs != nil && bs != ""
 }
 
+// IsInProcessTransport returns true if messages are kept in memory rather than sent to Kafka
+func (kc KafkaConfig) IsInProcessTransport() bool {
+	return kc.Transport == TransportInProcess
+}
+
 func WithoutSecrets(c kafka.ConfigMap) kafka.ConfigMap {
 	safe := make(kafka.ConfigMap)
 
@@ -63,6 +63,62 @@ func TestNewKafkaConfig(t *testing.T) {
 				Streams:          kafka.ConfigMap{"bootstrap.servers": "foo", "replication.factor": 1},
 			},
 		},
+		{
+			name: "with schema registry",
+			data: `
+{
+  "bootstrap.servers":"kafka:9092",
+  "schemaRegistry": {"url": "http://schema-registry:8081", "views": ["avro", "json"]}
+}
+`,
+			expected: &KafkaConfig{
+				BootstrapServers: "kafka:9092",
+				Consumer:         kafka.ConfigMap{"bootstrap.servers": "kafka:9092"},
+				Producer:         kafka.ConfigMap{"bootstrap.servers": "kafka:9092"},
+				Streams:          kafka.ConfigMap{"bootstrap.servers": "kafka:9092"},
+				SchemaRegistry: &SchemaRegistryConfig{
+					Url:   "http://schema-registry:8081",
+					Views: []string{"avro", "json"},
+				},
+			},
+		},
+		{
+			name: "with claim check",
+			data: `
+{
+  "bootstrap.servers":"kafka:9092",
+  "claimCheck": {"thresholdBytes": 1024, "s3": {"endpoint": "http://minio:9000", "bucket": "payloads"}}
+}
+`,
+			expected: &KafkaConfig{
+				BootstrapServers: "kafka:9092",
+				Consumer:         kafka.ConfigMap{"bootstrap.servers": "kafka:9092"},
+				Producer:         kafka.ConfigMap{"bootstrap.servers": "kafka:9092"},
+				Streams:          kafka.ConfigMap{"bootstrap.servers": "kafka:9092"},
+				ClaimCheck: &ClaimCheckConfig{
+					ThresholdBytes: 1024,
+					S3: &S3Config{
+						Endpoint: "http://minio:9000",
+						Bucket:   "payloads",
+					},
+				},
+			},
+		},
+		{
+			name: "with in-process transport",
+			data: `{"transport": "inprocess"}`,
+			expected: &KafkaConfig{
+				Consumer:  kafka.ConfigMap{"bootstrap.servers": ""},
+				Producer:  kafka.ConfigMap{"bootstrap.servers": ""},
+				Streams:   kafka.ConfigMap{"bootstrap.servers": ""},
+				Transport: TransportInProcess,
+			},
+		},
+		{
+			name: "unknown transport",
+			data: `{"bootstrap.servers":"kafka:9092", "transport": "nats"}`,
+			err:  true,
+		},
 		{
 			name: "error",
 			data: `{"foo":"bar"}`,
@@ -36,6 +36,9 @@ const (
 	sourceChainerServer          = "chainer-server"
 )
 
+// default time to wait for all element outputs of a forEach step before dropping the request
+const defaultForEachGatherTimeoutMs = 60_000
+
 type ChainerServer struct {
 	logger          log.FieldLogger
 	mu              sync.Mutex
@@ -203,6 +206,39 @@ func (c *ChainerServer) createTriggerSources(inputs []string, pipelineName strin
 	return sources
 }
 
+func createTransforms(transforms []*pipeline.Transform) []*chainer.PipelineTransform {
+	var chainerTransforms []*chainer.PipelineTransform
+	for _, transform := range transforms {
+		chainerTransforms = append(chainerTransforms, &chainer.PipelineTransform{
+			Type:     chainer.PipelineTransform_TransformType(transform.Type),
+			Tensors:  transform.Tensors,
+			Outputs:  transform.Outputs,
+			Axis:     transform.Axis,
+			Datatype: transform.Datatype,
+			Shape:    transform.Shape,
+			Values:   transform.Values,
+			Path:     transform.Path,
+		})
+	}
+	return chainerTransforms
+}
+
+func (c *ChainerServer) createForEach(step *pipeline.PipelineStep) *chainer.ForEach {
+	forEach := &chainer.ForEach{
+		Tensor:          step.ForEach.Tensor,
+		Axis:            step.ForEach.Axis,
+		GatherTimeoutMs: defaultForEachGatherTimeoutMs,
+		GatherTopic:     c.topicNamer.GetModelTopicOutputs(step.Name),
+	}
+	if step.ForEach.Parallelism != nil {
+		forEach.Parallelism = *step.ForEach.Parallelism
+	}
+	if step.ForEach.GatherTimeoutMs != nil {
+		forEach.GatherTimeoutMs = *step.ForEach.GatherTimeoutMs
+	}
+	return forEach
+}
+
 func (c *ChainerServer) createPipelineMessage(pv *pipeline.PipelineVersion) *chainer.PipelineUpdateMessage {
 	var stepUpdates []*chainer.PipelineStepUpdate
 	for _, step := range pv.Steps {
@@ -212,6 +248,12 @@ func (c *ChainerServer) createPipelineMessage(pv *pipeline.PipelineVersion) *cha
 			Sink:      &chainer.PipelineTopic{PipelineName: pv.Name, TopicName: c.topicNamer.GetModelTopicInputs(step.Name), Tensor: nil},
 			TensorMap: c.topicNamer.GetFullyQualifiedTensorMap(pv.Name, step.TensorMap),
 		}
+		// Transform steps have no model so the dataflow engine writes the transformed tensors
+		// directly to the step outputs topic for downstream steps to consume
+		if step.IsTransform() {
+			stepUpdate.Sink = &chainer.PipelineTopic{PipelineName: pv.Name, TopicName: c.topicNamer.GetModelTopicOutputs(step.Name), Tensor: nil}
+			stepUpdate.Transforms = createTransforms(step.Transforms)
+		}
 		switch step.InputsJoinType {
 		case pipeline.JoinInner:
 			stepUpdate.InputJoinTy = chainer.PipelineStepUpdate_Inner
@@ -234,10 +276,15 @@ func (c *ChainerServer) createPipelineMessage(pv *pipeline.PipelineVersion) *cha
 				WindowMs: step.Batch.WindowMs,
 			}
 		}
+		// ForEach steps have their sink requests split into one message per element by the dataflow engine,
+		// which gathers the element outputs back into a single message on the step outputs topic
+		if step.ForEach != nil {
+			stepUpdate.ForEach = c.createForEach(step)
+		}
 		c.logger.Infof("Adding sources %v to %s", stepUpdate.Sources, stepUpdate.Sink)
 		stepUpdates = append(stepUpdates, &stepUpdate)
 	}
-	if pv.Input != nil {
+	if pv.Input != nil && len(pv.Input.ExternalInputs) > 0 {
 		stepUpdate := chainer.PipelineStepUpdate{
 			Sources:   c.createPipelineTopicSources(pv.Input.ExternalInputs),
 			Sink:      &chainer.PipelineTopic{PipelineName: pv.Name, TopicName: c.topicNamer.GetPipelineTopicInputs(pv.Name), Tensor: nil},
@@ -280,6 +327,7 @@ func (c *ChainerServer) createPipelineMessage(pv *pipeline.PipelineVersion) *cha
 		c.logger.Infof("Adding sources %v to %s", stepUpdate.Sources, stepUpdate.Sink)
 		stepUpdates = append(stepUpdates, &stepUpdate)
 	}
+	stepUpdates = append(stepUpdates, c.createKafkaAdapterSteps(pv)...)
 	//Append an error step to send any errors to pipeline output
 	stepUpdates = append(stepUpdates, &chainer.PipelineStepUpdate{
 		Sources:     []*chainer.PipelineTopic{{PipelineName: pv.Name, TopicName: c.topicNamer.GetModelErrorTopic(), Tensor: nil}},
@@ -292,11 +340,78 @@ func (c *ChainerServer) createPipelineMessage(pv *pipeline.PipelineVersion) *cha
 		op = chainer.PipelineUpdateMessage_Delete
 	}
 	return &chainer.PipelineUpdateMessage{
-		Pipeline: pv.Name,
-		Version:  pv.Version,
-		Uid:      pv.UID,
-		Updates:  stepUpdates,
-		Op:       op,
+		Pipeline:    pv.Name,
+		Version:     pv.Version,
+		Uid:         pv.UID,
+		Updates:     stepUpdates,
+		Op:          op,
+		TopicConfig: createPipelineTopicConfig(pv.TopicConfig),
+		Canary:      c.isCanaryVersion(pv),
+	}
+}
+
+// isCanaryVersion returns whether the version is the canary of the pipeline, which only processes messages routed to it
+func (c *ChainerServer) isCanaryVersion(pv *pipeline.PipelineVersion) bool {
+	p, err := c.pipelineHandler.GetPipeline(pv.Name)
+	if err != nil || p.Canary == nil {
+		return false
+	}
+	latest := p.GetLatestPipelineVersion()
+	return latest != nil && latest.Version == pv.Version
+}
+
+// External Kafka sources are converted to requests on the pipeline inputs topic, and the pipeline outputs
+// are converted to records on external Kafka sinks
+func (c *ChainerServer) createKafkaAdapterSteps(pv *pipeline.PipelineVersion) []*chainer.PipelineStepUpdate {
+	var stepUpdates []*chainer.PipelineStepUpdate
+	if pv.Input != nil {
+		for _, source := range pv.Input.KafkaSources {
+			stepUpdates = append(stepUpdates, &chainer.PipelineStepUpdate{
+				Sources:      []*chainer.PipelineTopic{{PipelineName: pv.Name, TopicName: source.Topic}},
+				Sink:         &chainer.PipelineTopic{PipelineName: pv.Name, TopicName: c.topicNamer.GetPipelineTopicInputs(pv.Name)},
+				InputJoinTy:  chainer.PipelineStepUpdate_Inner,
+				KafkaAdapter: createKafkaAdapter(source, chainer.KafkaAdapter_SOURCE),
+			})
+		}
+	}
+	if pv.Output != nil {
+		for _, sink := range pv.Output.KafkaSinks {
+			stepUpdates = append(stepUpdates, &chainer.PipelineStepUpdate{
+				Sources:      []*chainer.PipelineTopic{{PipelineName: pv.Name, TopicName: c.topicNamer.GetPipelineTopicOutputs(pv.Name)}},
+				Sink:         &chainer.PipelineTopic{PipelineName: pv.Name, TopicName: sink.Topic},
+				InputJoinTy:  chainer.PipelineStepUpdate_Inner,
+				KafkaAdapter: createKafkaAdapter(sink, chainer.KafkaAdapter_SINK),
+			})
+		}
+	}
+	return stepUpdates
+}
+
+func createKafkaAdapter(topic *pipeline.KafkaTopic, direction chainer.KafkaAdapter_Direction) *chainer.KafkaAdapter {
+	adapter := &chainer.KafkaAdapter{
+		Format:    chainer.KafkaAdapter_Format(topic.Format),
+		Direction: direction,
+	}
+	for _, tensor := range topic.Tensors {
+		adapter.Tensors = append(adapter.Tensors, &chainer.KafkaAdapterTensor{
+			Name:     tensor.Name,
+			Datatype: tensor.Datatype,
+			Shape:    tensor.Shape,
+		})
+	}
+	return adapter
+}
+
+func createPipelineTopicConfig(topicConfig *pipeline.TopicConfig) *chainer.PipelineTopicConfig {
+	if topicConfig == nil {
+		return nil
+	}
+	return &chainer.PipelineTopicConfig{
+		Partitions:        topicConfig.Partitions,
+		ReplicationFactor: topicConfig.ReplicationFactor,
+		RetentionMs:       topicConfig.RetentionMs,
+		CleanupPolicy:     topicConfig.CleanupPolicy,
+		CompressionType:   topicConfig.CompressionType,
 	}
 }
 
@@ -436,6 +551,13 @@ func (c *ChainerServer) handlePipelineEvent(event coordinator.PipelineEventMsg)
 			}
 			msg := c.createPipelineMessage(pv) // note pv is a copy and does not include the new change to terminating state
 			c.sendPipelineMsgToSelectedServers(msg, pv)
+
+		default:
+			// A promoted canary is resent so the dataflow engine processes messages without a version for it
+			if event.CanaryUpdate {
+				msg := c.createPipelineMessage(pv)
+				c.sendPipelineMsgToSelectedServers(msg, pv)
+			}
 		}
 	}()
 }
@@ -16,8 +16,10 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/seldonio/seldon-core/apis/go/v2/mlops/chainer"
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/pipeline"
 )
 
 func TestCreateTopicSources(t *testing.T) {
@@ -168,3 +170,317 @@ func TestCreateTriggerSources(t *testing.T) {
 		})
 	}
 }
+
+func TestCreatePipelineMessageTransformSteps(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name       string
+		pv         *pipeline.PipelineVersion
+		sinks      map[string]string
+		transforms map[string][]*chainer.PipelineTransform
+	}
+
+	topicNamer, err := kafka.NewTopicNamer("default", "seldon")
+	g.Expect(err).To(BeNil())
+	tests := []test{
+		{
+			name: "model step and transform step",
+			pv: &pipeline.PipelineVersion{
+				Name: "p1",
+				Steps: map[string]*pipeline.PipelineStep{
+					"a": {Name: "a"},
+					"b": {
+						Name:   "b",
+						Inputs: []string{"a.outputs"},
+						Transforms: []*pipeline.Transform{
+							{Type: pipeline.TransformConcat, Tensors: []string{"t1", "t2"}, Outputs: []string{"t3"}, Axis: 1},
+						},
+					},
+				},
+				State: &pipeline.PipelineState{Status: pipeline.PipelineCreate},
+			},
+			sinks: map[string]string{
+				"seldon.default.model.a.outputs":    "seldon.default.model.b.outputs",
+				"seldon.default.pipeline.p1.inputs": "seldon.default.model.a.inputs",
+			},
+			transforms: map[string][]*chainer.PipelineTransform{
+				"seldon.default.model.b.outputs": {
+					{Type: chainer.PipelineTransform_CONCAT, Tensors: []string{"t1", "t2"}, Outputs: []string{"t3"}, Axis: 1},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := &ChainerServer{
+				logger:          log.New(),
+				topicNamer:      topicNamer,
+				pipelineHandler: pipeline.NewPipelineStore(log.New(), nil, nil),
+			}
+			msg := server.createPipelineMessage(test.pv)
+			for _, update := range msg.Updates {
+				if update.Sources[0].TopicName == topicNamer.GetModelErrorTopic() {
+					continue
+				}
+				g.Expect(update.Sink.TopicName).To(Equal(test.sinks[update.Sources[0].TopicName]))
+				g.Expect(update.Transforms).To(Equal(test.transforms[update.Sink.TopicName]))
+			}
+		})
+	}
+}
+
+func TestCreatePipelineMessageForEachSteps(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name     string
+		pv       *pipeline.PipelineVersion
+		forEachs map[string]*chainer.ForEach
+	}
+
+	getUintPtr := func(val uint32) *uint32 { return &val }
+	topicNamer, err := kafka.NewTopicNamer("default", "seldon")
+	g.Expect(err).To(BeNil())
+	tests := []test{
+		{
+			name: "forEach step with defaults",
+			pv: &pipeline.PipelineVersion{
+				Name: "p1",
+				Steps: map[string]*pipeline.PipelineStep{
+					"a": {Name: "a"},
+					"b": {
+						Name:    "b",
+						Inputs:  []string{"a.outputs"},
+						ForEach: &pipeline.ForEach{Tensor: "boxes"},
+					},
+				},
+				State: &pipeline.PipelineState{Status: pipeline.PipelineCreate},
+			},
+			forEachs: map[string]*chainer.ForEach{
+				"seldon.default.model.b.inputs": {
+					Tensor:          "boxes",
+					GatherTimeoutMs: defaultForEachGatherTimeoutMs,
+					GatherTopic:     "seldon.default.model.b.outputs",
+				},
+			},
+		},
+		{
+			name: "forEach step with settings",
+			pv: &pipeline.PipelineVersion{
+				Name: "p1",
+				Steps: map[string]*pipeline.PipelineStep{
+					"a": {
+						Name:    "a",
+						ForEach: &pipeline.ForEach{Tensor: "boxes", Axis: 1, Parallelism: getUintPtr(2), GatherTimeoutMs: getUintPtr(500)},
+					},
+				},
+				State: &pipeline.PipelineState{Status: pipeline.PipelineCreate},
+			},
+			forEachs: map[string]*chainer.ForEach{
+				"seldon.default.model.a.inputs": {
+					Tensor:          "boxes",
+					Axis:            1,
+					Parallelism:     2,
+					GatherTimeoutMs: 500,
+					GatherTopic:     "seldon.default.model.a.outputs",
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := &ChainerServer{
+				logger:          log.New(),
+				topicNamer:      topicNamer,
+				pipelineHandler: pipeline.NewPipelineStore(log.New(), nil, nil),
+			}
+			msg := server.createPipelineMessage(test.pv)
+			for _, update := range msg.Updates {
+				g.Expect(update.ForEach).To(Equal(test.forEachs[update.Sink.TopicName]))
+			}
+		})
+	}
+}
+
+func TestCreatePipelineMessageTopicConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name        string
+		pv          *pipeline.PipelineVersion
+		topicConfig *chainer.PipelineTopicConfig
+	}
+
+	getUintPtr := func(val uint32) *uint32 { return &val }
+	getInt64Ptr := func(val int64) *int64 { return &val }
+	cleanupPolicy := "compact"
+	compressionType := "lz4"
+	topicNamer, err := kafka.NewTopicNamer("default", "seldon")
+	g.Expect(err).To(BeNil())
+	tests := []test{
+		{
+			name: "no topic config",
+			pv: &pipeline.PipelineVersion{
+				Name:  "p1",
+				Steps: map[string]*pipeline.PipelineStep{"a": {Name: "a"}},
+				State: &pipeline.PipelineState{Status: pipeline.PipelineCreate},
+			},
+		},
+		{
+			name: "topic config",
+			pv: &pipeline.PipelineVersion{
+				Name:  "p1",
+				Steps: map[string]*pipeline.PipelineStep{"a": {Name: "a"}},
+				State: &pipeline.PipelineState{Status: pipeline.PipelineCreate},
+				TopicConfig: &pipeline.TopicConfig{
+					Partitions:      getUintPtr(6),
+					RetentionMs:     getInt64Ptr(3600000),
+					CleanupPolicy:   &cleanupPolicy,
+					CompressionType: &compressionType,
+				},
+			},
+			topicConfig: &chainer.PipelineTopicConfig{
+				Partitions:      getUintPtr(6),
+				RetentionMs:     getInt64Ptr(3600000),
+				CleanupPolicy:   &cleanupPolicy,
+				CompressionType: &compressionType,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := &ChainerServer{
+				logger:          log.New(),
+				topicNamer:      topicNamer,
+				pipelineHandler: pipeline.NewPipelineStore(log.New(), nil, nil),
+			}
+			msg := server.createPipelineMessage(test.pv)
+			g.Expect(msg.TopicConfig).To(Equal(test.topicConfig))
+		})
+	}
+}
+
+func TestCreatePipelineMessageKafkaAdapterSteps(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name     string
+		pv       *pipeline.PipelineVersion
+		adapters map[string]*chainer.KafkaAdapter
+		sinks    map[string]string
+	}
+
+	topicNamer, err := kafka.NewTopicNamer("default", "seldon")
+	g.Expect(err).To(BeNil())
+	tests := []test{
+		{
+			name: "no kafka topics",
+			pv: &pipeline.PipelineVersion{
+				Name:  "p1",
+				Steps: map[string]*pipeline.PipelineStep{"a": {Name: "a"}},
+				State: &pipeline.PipelineState{Status: pipeline.PipelineCreate},
+			},
+			adapters: map[string]*chainer.KafkaAdapter{},
+			sinks:    map[string]string{},
+		},
+		{
+			name: "kafka source and sink",
+			pv: &pipeline.PipelineVersion{
+				Name:  "p1",
+				Steps: map[string]*pipeline.PipelineStep{"a": {Name: "a"}},
+				State: &pipeline.PipelineState{Status: pipeline.PipelineCreate},
+				Input: &pipeline.PipelineInput{
+					KafkaSources: []*pipeline.KafkaTopic{
+						{
+							Topic:   "events",
+							Format:  pipeline.KafkaFormatCsv,
+							Tensors: []*pipeline.TensorSchema{{Name: "x", Datatype: "FP32", Shape: []int64{4}}},
+						},
+					},
+				},
+				Output: &pipeline.PipelineOutput{
+					Steps: []string{"a"},
+					KafkaSinks: []*pipeline.KafkaTopic{
+						{Topic: "predictions", Format: pipeline.KafkaFormatJson},
+					},
+				},
+			},
+			adapters: map[string]*chainer.KafkaAdapter{
+				"events": {
+					Format:    chainer.KafkaAdapter_CSV,
+					Direction: chainer.KafkaAdapter_SOURCE,
+					Tensors:   []*chainer.KafkaAdapterTensor{{Name: "x", Datatype: "FP32", Shape: []int64{4}}},
+				},
+				"seldon.default.pipeline.p1.outputs": {
+					Format:    chainer.KafkaAdapter_JSON,
+					Direction: chainer.KafkaAdapter_SINK,
+				},
+			},
+			sinks: map[string]string{
+				"events":                             "seldon.default.pipeline.p1.inputs",
+				"seldon.default.pipeline.p1.outputs": "predictions",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := &ChainerServer{
+				logger:          log.New(),
+				topicNamer:      topicNamer,
+				pipelineHandler: pipeline.NewPipelineStore(log.New(), nil, nil),
+			}
+			msg := server.createPipelineMessage(test.pv)
+			adapters := 0
+			for _, update := range msg.Updates {
+				if update.KafkaAdapter == nil {
+					continue
+				}
+				adapters++
+				g.Expect(update.Sources).To(HaveLen(1))
+				g.Expect(update.KafkaAdapter).To(Equal(test.adapters[update.Sources[0].TopicName]))
+				g.Expect(update.Sink.TopicName).To(Equal(test.sinks[update.Sources[0].TopicName]))
+			}
+			g.Expect(adapters).To(Equal(len(test.adapters)))
+		})
+	}
+}
+
+func TestCreatePipelineMessageCanary(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	topicNamer, err := kafka.NewTopicNamer("default", "seldon")
+	g.Expect(err).To(BeNil())
+	ps := pipeline.NewPipelineStore(log.New(), nil, nil)
+	p := &scheduler.Pipeline{
+		Name:  "p1",
+		Steps: []*scheduler.PipelineStep{{Name: "a"}},
+	}
+	setReady := func(version uint32) {
+		pl, err := ps.GetPipeline("p1")
+		g.Expect(err).To(BeNil())
+		pv := pl.GetPipelineVersion(version)
+		g.Expect(ps.SetPipelineState("p1", version, pv.UID, pipeline.PipelineReady, "", "test")).To(BeNil())
+	}
+	g.Expect(ps.AddPipeline(p)).To(BeNil())
+	setReady(1)
+	p.Canary = &scheduler.PipelineCanary{TrafficPercent: 10}
+	g.Expect(ps.AddPipeline(p)).To(BeNil())
+	setReady(2)
+	server := &ChainerServer{
+		logger:          log.New(),
+		topicNamer:      topicNamer,
+		pipelineHandler: ps,
+	}
+
+	pl, err := ps.GetPipeline("p1")
+	g.Expect(err).To(BeNil())
+	for _, version := range []uint32{1, 2} {
+		g.Expect(server.createPipelineMessage(pl.GetPipelineVersion(version)).Canary).To(Equal(version == 2))
+	}
+
+	g.Expect(ps.UpdatePipelineCanary("p1", 100)).To(BeNil())
+	pl, err = ps.GetPipeline("p1")
+	g.Expect(err).To(BeNil())
+	g.Expect(server.createPipelineMessage(pl.GetPipelineVersion(2)).Canary).To(BeFalse())
+}
@@ -156,7 +156,7 @@ func (kc *KafkaSchedulerClient) SubscribeModelEvents() error {
 		switch latestVersionStatus.State.State {
 		case scheduler.ModelStatus_ModelAvailable:
 			logger.Infof("Adding model %s", event.ModelName)
-			err := kc.consumerManager.AddModel(event.ModelName)
+			err := kc.consumerManager.AddModel(event.ModelName, latestVersionStatus.GetModelDefn().GetStreamSpec())
 			if err != nil {
 				kc.logger.WithError(err).Errorf("Failed to add model %s", event.ModelName)
 			}
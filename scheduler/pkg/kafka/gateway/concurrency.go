@@ -0,0 +1,107 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package gateway
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	EnvVarMaxInFlight     = "MODELGATEWAY_MAX_IN_FLIGHT"
+	EnvVarTargetLatencyMs = "MODELGATEWAY_TARGET_LATENCY_MS"
+	DefaultMaxInFlight    = 64
+	// Low priority requests are paused once they use this percentage of the limit
+	EnvVarLowPriorityPercent  = "MODELGATEWAY_LOW_PRIORITY_PERCENT"
+	DefaultLowPriorityPercent = 25
+	// multiplicative decrease applied to the limit on a slow or failed request
+	concurrencyBackoffRatio = 0.9
+)
+
+// concurrencyLimiter tracks requests in flight against a limit. With a target latency the limit adapts,
+// growing by one for each limit's worth of fast successful requests and shrinking on slow or failed requests.
+// Without a target latency the limit stays at the maximum.
+// Low priority requests may only use a percentage of the limit so the rest is kept for high priority requests.
+type concurrencyLimiter struct {
+	mu                 sync.Mutex
+	inFlight           int
+	lowInFlight        int
+	limit              float64
+	maxLimit           float64
+	targetLatency      time.Duration
+	lowPriorityPercent int
+}
+
+func newConcurrencyLimiter(maxLimit int, targetLatency time.Duration, lowPriorityPercent int) *concurrencyLimiter {
+	if maxLimit < 1 {
+		maxLimit = 1
+	}
+	if lowPriorityPercent <= 0 || lowPriorityPercent > 100 {
+		lowPriorityPercent = DefaultLowPriorityPercent
+	}
+	return &concurrencyLimiter{
+		limit:              float64(maxLimit),
+		maxLimit:           float64(maxLimit),
+		targetLatency:      targetLatency,
+		lowPriorityPercent: lowPriorityPercent,
+	}
+}
+
+func (l *concurrencyLimiter) Start(lowPriority bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inFlight++
+	if lowPriority {
+		l.lowInFlight++
+	}
+}
+
+func (l *concurrencyLimiter) Done(latency time.Duration, failed bool, lowPriority bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inFlight--
+	if lowPriority {
+		l.lowInFlight--
+	}
+	if l.targetLatency <= 0 {
+		return
+	}
+	if failed || latency > l.targetLatency {
+		l.limit = math.Max(1, l.limit*concurrencyBackoffRatio)
+	} else {
+		l.limit = math.Min(l.maxLimit, l.limit+1/l.limit)
+	}
+}
+
+// Exceeded is true when no more requests should be consumed until some in flight complete
+func (l *concurrencyLimiter) Exceeded() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inFlight >= int(l.limit)
+}
+
+// LowPriorityExceeded is true when no more low priority requests should be consumed. At least one low priority
+// request is always allowed so the low priority lane is never starved completely.
+func (l *concurrencyLimiter) LowPriorityExceeded() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	lowLimit := int(l.limit) * l.lowPriorityPercent / 100
+	if lowLimit < 1 {
+		lowLimit = 1
+	}
+	return l.lowInFlight >= lowLimit
+}
+
+func (l *concurrencyLimiter) Stats() (inFlight int, limit int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inFlight, int(l.limit)
+}
@@ -0,0 +1,469 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package gateway
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+	seldontracer "github.com/seldonio/seldon-core/scheduler/v2/pkg/tracing"
+)
+
+func TestConcurrencyLimiter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type completion struct {
+		latency time.Duration
+		failed  bool
+	}
+	type test struct {
+		name             string
+		maxLimit         int
+		targetLatency    time.Duration
+		started          int
+		completed        []completion
+		expectedInFlight int
+		expectedLimit    int
+		expectedExceeded bool
+	}
+	tests := []test{
+		{
+			name:             "fixed limit not exceeded",
+			maxLimit:         4,
+			started:          3,
+			expectedInFlight: 3,
+			expectedLimit:    4,
+		},
+		{
+			name:             "fixed limit exceeded",
+			maxLimit:         4,
+			started:          4,
+			expectedInFlight: 4,
+			expectedLimit:    4,
+			expectedExceeded: true,
+		},
+		{
+			name:             "fixed limit ignores latency",
+			maxLimit:         4,
+			started:          2,
+			completed:        []completion{{latency: time.Hour, failed: true}},
+			expectedInFlight: 1,
+			expectedLimit:    4,
+		},
+		{
+			name:             "slow requests reduce limit",
+			maxLimit:         10,
+			targetLatency:    time.Second,
+			started:          2,
+			completed:        []completion{{latency: 2 * time.Second}, {latency: 2 * time.Second}},
+			expectedInFlight: 0,
+			expectedLimit:    8,
+		},
+		{
+			name:             "failed requests reduce limit",
+			maxLimit:         10,
+			targetLatency:    time.Second,
+			started:          4,
+			completed:        []completion{{latency: time.Millisecond, failed: true}},
+			expectedInFlight: 3,
+			expectedLimit:    9,
+		},
+		{
+			name:             "limit not below one",
+			maxLimit:         1,
+			targetLatency:    time.Second,
+			started:          2,
+			completed:        []completion{{failed: true}},
+			expectedInFlight: 1,
+			expectedLimit:    1,
+			expectedExceeded: true,
+		},
+		{
+			name:          "fast requests recover limit up to max",
+			maxLimit:      10,
+			targetLatency: time.Second,
+			started:       22,
+			completed: []completion{
+				{failed: true}, {failed: true},
+				{}, {}, {}, {}, {}, {}, {}, {}, {}, {},
+				{}, {}, {}, {}, {}, {}, {}, {}, {}, {},
+			},
+			expectedInFlight: 0,
+			expectedLimit:    10,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			limiter := newConcurrencyLimiter(test.maxLimit, test.targetLatency, DefaultLowPriorityPercent)
+			for i := 0; i < test.started; i++ {
+				limiter.Start(false)
+			}
+			for _, c := range test.completed {
+				limiter.Done(c.latency, c.failed, false)
+			}
+			inFlight, limit := limiter.Stats()
+			g.Expect(inFlight).To(Equal(test.expectedInFlight))
+			g.Expect(limit).To(Equal(test.expectedLimit))
+			g.Expect(limiter.Exceeded()).To(Equal(test.expectedExceeded))
+		})
+	}
+}
+
+func TestConcurrencyLimiterLowPriority(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name               string
+		maxLimit           int
+		lowPriorityPercent int
+		startedHigh        int
+		startedLow         int
+		completedLow       int
+		expectedExceeded   bool
+	}
+	tests := []test{
+		{
+			name:               "low priority share not used",
+			maxLimit:           8,
+			lowPriorityPercent: 25,
+			startedHigh:        6,
+			startedLow:         1,
+		},
+		{
+			name:               "low priority share used",
+			maxLimit:           8,
+			lowPriorityPercent: 25,
+			startedLow:         2,
+			expectedExceeded:   true,
+		},
+		{
+			name:               "low priority share freed",
+			maxLimit:           8,
+			lowPriorityPercent: 25,
+			startedLow:         2,
+			completedLow:       1,
+		},
+		{
+			name:               "one low priority request always allowed",
+			maxLimit:           2,
+			lowPriorityPercent: 25,
+			startedHigh:        1,
+		},
+		{
+			name:             "default share",
+			maxLimit:         8,
+			startedLow:       2,
+			expectedExceeded: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			limiter := newConcurrencyLimiter(test.maxLimit, 0, test.lowPriorityPercent)
+			for i := 0; i < test.startedHigh; i++ {
+				limiter.Start(false)
+			}
+			for i := 0; i < test.startedLow; i++ {
+				limiter.Start(true)
+			}
+			for i := 0; i < test.completedLow; i++ {
+				limiter.Done(time.Millisecond, false, true)
+			}
+			g.Expect(limiter.LowPriorityExceeded()).To(Equal(test.expectedExceeded))
+		})
+	}
+}
+
+type fakeModelGatewayMetrics struct {
+	mu           sync.Mutex
+	paused       map[string]bool
+	lag          map[string]int64
+	partitionLag map[string]int64
+	queueTimes   map[string]int
+	serviceTimes map[string]int
+}
+
+func newFakeModelGatewayMetrics() *fakeModelGatewayMetrics {
+	return &fakeModelGatewayMetrics{
+		paused:       make(map[string]bool),
+		lag:          make(map[string]int64),
+		partitionLag: make(map[string]int64),
+		queueTimes:   make(map[string]int),
+		serviceTimes: make(map[string]int),
+	}
+}
+
+func (f *fakeModelGatewayMetrics) SetConsumerLag(modelName string, lag int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.lag[modelName] = lag
+}
+
+func (f *fakeModelGatewayMetrics) SetConsumerPaused(consumerName string, paused bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.paused[consumerName] = paused
+}
+
+func (f *fakeModelGatewayMetrics) SetConsumerConcurrency(consumerName string, inFlight int, limit int) {
+}
+
+func (f *fakeModelGatewayMetrics) AddRateLimited(modelName string) {}
+
+func (f *fakeModelGatewayMetrics) SetTopicPartitionLag(topic string, partition int32, lag int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.partitionLag[fmt.Sprintf("%s/%d", topic, partition)] = lag
+}
+
+func (f *fakeModelGatewayMetrics) AddQueueTime(modelName string, pipelineName string, seconds float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.queueTimes[modelName+"/"+pipelineName]++
+}
+
+func (f *fakeModelGatewayMetrics) AddServiceTime(modelName string, pipelineName string, seconds float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.serviceTimes[modelName+"/"+pipelineName]++
+}
+
+func (f *fakeModelGatewayMetrics) isPaused(consumerName string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.paused[consumerName]
+}
+
+func (f *fakeModelGatewayMetrics) getLag(modelName string) int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.lag[modelName]
+}
+
+func (f *fakeModelGatewayMetrics) getPartitionLag() map[string]int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	lag := make(map[string]int64)
+	for k, v := range f.partitionLag {
+		lag[k] = v
+	}
+	return lag
+}
+
+func (f *fakeModelGatewayMetrics) getTimings(key string) (int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.queueTimes[key], f.serviceTimes[key]
+}
+
+func TestServePausesWhenInFlightExceeded(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	logger := log.New()
+	tp, err := seldontracer.NewTraceProvider("test", nil, logger)
+	g.Expect(err).To(BeNil())
+	streamTransport := transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic)
+	fakeMetrics := newFakeModelGatewayMetrics()
+	managerConfig := &ManagerConfig{
+		SeldonKafkaConfig:     &config.KafkaConfig{},
+		Namespace:             "default",
+		InferenceServerConfig: &InferenceServerConfig{},
+		TraceProvider:         tp,
+		NumWorkers:            0, // requests are never completed
+		Transport:             streamTransport,
+		MaxInFlight:           2,
+		Metrics:               fakeMetrics,
+	}
+	ic, err := NewInferKafkaHandler(logger, managerConfig, streamTransport, "dummy")
+	g.Expect(err).To(BeNil())
+	g.Expect(ic.AddModel("foo", nil)).To(BeNil())
+
+	tn, err := kafka2.NewTopicNamer("default", "seldon")
+	g.Expect(err).To(BeNil())
+	producer, err := streamTransport.NewProducer()
+	g.Expect(err).To(BeNil())
+	for _, key := range []string{"1", "2", "3"} {
+		g.Expect(producer.Produce(&transport.Message{Topic: tn.GetModelTopicInputs("foo"), Key: []byte(key)}, nil)).To(BeNil())
+	}
+	go ic.Serve()
+	defer ic.Stop()
+
+	g.Eventually(func() bool { return fakeMetrics.isPaused("dummy") }).Should(BeTrue())
+	inFlight, limit := ic.limiter.Stats()
+	g.Expect(inFlight).To(Equal(2))
+	g.Expect(limit).To(Equal(2))
+	ic.reportLag()
+	g.Expect(fakeMetrics.getLag("foo")).To(Equal(int64(1)))
+	g.Expect(fakeMetrics.getPartitionLag()).To(Equal(map[string]int64{
+		tn.GetModelTopicInputs("foo") + "/0":            1,
+		tn.GetModelTopicInputsLowPriority("foo") + "/0": 0,
+	}))
+}
+
+func TestServePausesLowPriorityTopics(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	logger := log.New()
+	tp, err := seldontracer.NewTraceProvider("test", nil, logger)
+	g.Expect(err).To(BeNil())
+	streamTransport := transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic)
+	managerConfig := &ManagerConfig{
+		SeldonKafkaConfig:     &config.KafkaConfig{},
+		Namespace:             "default",
+		InferenceServerConfig: &InferenceServerConfig{},
+		TraceProvider:         tp,
+		NumWorkers:            0, // requests are never completed
+		Transport:             streamTransport,
+		MaxInFlight:           4,
+		LowPriorityPercent:    25,
+	}
+	ic, err := NewInferKafkaHandler(logger, managerConfig, streamTransport, "dummy")
+	g.Expect(err).To(BeNil())
+	g.Expect(ic.AddModel("foo", nil)).To(BeNil())
+
+	tn, err := kafka2.NewTopicNamer("default", "seldon")
+	g.Expect(err).To(BeNil())
+	producer, err := streamTransport.NewProducer()
+	g.Expect(err).To(BeNil())
+	for _, key := range []string{"1", "2", "3"} {
+		g.Expect(producer.Produce(&transport.Message{Topic: tn.GetModelTopicInputsLowPriority("foo"), Key: []byte(key)}, nil)).To(BeNil())
+	}
+	go ic.Serve()
+	defer ic.Stop()
+
+	// only one low priority request is consumed, leaving the rest of the limit for high priority requests
+	g.Eventually(func() int { inFlight, _ := ic.limiter.Stats(); return inFlight }).Should(Equal(1))
+	g.Expect(producer.Produce(&transport.Message{Topic: tn.GetModelTopicInputs("foo"), Key: []byte("4")}, nil)).To(BeNil())
+	g.Eventually(func() int { inFlight, _ := ic.limiter.Stats(); return inFlight }).Should(Equal(2))
+	g.Consistently(func() int { inFlight, _ := ic.limiter.Stats(); return inFlight }, 200*time.Millisecond).Should(Equal(2))
+}
+
+func TestSetRateLimit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name          string
+		rateLimit     *scheduler.StreamRateLimit
+		expectLimiter bool
+		expectedLimit rate.Limit
+		expectedBurst int
+	}
+	tests := []test{
+		{
+			name: "no rate limit",
+		},
+		{
+			name:      "zero requests per second",
+			rateLimit: &scheduler.StreamRateLimit{},
+		},
+		{
+			name:          "burst defaults to requests per second",
+			rateLimit:     &scheduler.StreamRateLimit{RequestsPerSecond: 5},
+			expectLimiter: true,
+			expectedLimit: 5,
+			expectedBurst: 5,
+		},
+		{
+			name:          "burst",
+			rateLimit:     &scheduler.StreamRateLimit{RequestsPerSecond: 5, Burst: 20},
+			expectLimiter: true,
+			expectedLimit: 5,
+			expectedBurst: 20,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ic := &InferKafkaHandler{rateLimiters: map[string]*rate.Limiter{"foo": rate.NewLimiter(1, 1)}}
+			ic.setRateLimit("foo", test.rateLimit)
+			limiter := ic.getRateLimiter("foo")
+			if test.expectLimiter {
+				g.Expect(limiter).ToNot(BeNil())
+				g.Expect(limiter.Limit()).To(Equal(test.expectedLimit))
+				g.Expect(limiter.Burst()).To(Equal(test.expectedBurst))
+			} else {
+				g.Expect(limiter).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestRateLimitedRequestIsRequeued(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ic := &InferKafkaHandler{
+		consumerConfig: &ManagerConfig{},
+		rateLimiters:   map[string]*rate.Limiter{"foo": rate.NewLimiter(rate.Every(100*time.Millisecond), 1)},
+		jobChan:        make(chan *InferWork, 1),
+		done:           make(chan bool),
+	}
+	defer close(ic.done)
+	iw := &InferWorker{consumer: ic}
+
+	first := &InferWork{modelName: "foo"}
+	g.Expect(iw.reserveRateLimit(first)).To(Equal(time.Duration(0)))
+
+	// the second request is over the limit, so it is sent to the workers again later rather than blocking this one
+	second := &InferWork{modelName: "foo"}
+	delay := iw.reserveRateLimit(second)
+	g.Expect(delay).To(BeNumerically(">", 0))
+	g.Expect(second.rateLimited).To(BeTrue())
+	ic.requeueAfter(second, delay)
+	g.Expect(ic.jobChan).ToNot(Receive())
+	g.Eventually(ic.jobChan).Should(Receive(Equal(second)))
+
+	// the requeued request has its turn already so is not delayed again
+	g.Expect(iw.reserveRateLimit(second)).To(Equal(time.Duration(0)))
+}
+
+func TestServePausesLowPriorityTopicsOfAddedModels(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	logger := log.New()
+	tp, err := seldontracer.NewTraceProvider("test", nil, logger)
+	g.Expect(err).To(BeNil())
+	streamTransport := transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic)
+	managerConfig := &ManagerConfig{
+		SeldonKafkaConfig:     &config.KafkaConfig{},
+		Namespace:             "default",
+		InferenceServerConfig: &InferenceServerConfig{},
+		TraceProvider:         tp,
+		NumWorkers:            0, // requests are never completed
+		Transport:             streamTransport,
+		MaxInFlight:           4,
+		LowPriorityPercent:    25,
+	}
+	ic, err := NewInferKafkaHandler(logger, managerConfig, streamTransport, "dummy")
+	g.Expect(err).To(BeNil())
+	g.Expect(ic.AddModel("foo", nil)).To(BeNil())
+
+	tn, err := kafka2.NewTopicNamer("default", "seldon")
+	g.Expect(err).To(BeNil())
+	producer, err := streamTransport.NewProducer()
+	g.Expect(err).To(BeNil())
+	g.Expect(producer.Produce(&transport.Message{Topic: tn.GetModelTopicInputsLowPriority("foo"), Key: []byte("1")}, nil)).To(BeNil())
+	go ic.Serve()
+	defer ic.Stop()
+	g.Eventually(func() int { inFlight, _ := ic.limiter.Stats(); return inFlight }).Should(Equal(1))
+
+	// the low priority topic of a model added while low priority topics are paused is paused too
+	g.Expect(ic.AddModel("bar", nil)).To(BeNil())
+	g.Expect(producer.Produce(&transport.Message{Topic: tn.GetModelTopicInputsLowPriority("bar"), Key: []byte("2")}, nil)).To(BeNil())
+	g.Consistently(func() int { inFlight, _ := ic.limiter.Stats(); return inFlight }, 200*time.Millisecond).Should(Equal(1))
+}
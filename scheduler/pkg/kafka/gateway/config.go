@@ -20,4 +20,5 @@ type InferenceServerConfig struct {
 	Host     string
 	HttpPort int
 	GrpcPort int
+	APIKey   string // sent to Envoy when it checks the API key of requests
 }
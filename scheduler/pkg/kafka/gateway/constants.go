@@ -18,6 +18,9 @@ const (
 	HeaderValueProtoReq = "proto/InferModelRequest"
 	HeaderValueProtoRes = "proto/InferModelResponse"
 
+	// Set on every produced response so downstream consumers can deduplicate redelivered results
+	HeaderKeyIdempotencyKey = "seldon-idempotency-key"
+
 	// Topic creation retries
 	TopicCreateTimeout      = time.Minute
 	TopicDescribeTimeout    = time.Second
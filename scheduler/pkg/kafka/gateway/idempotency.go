@@ -0,0 +1,74 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package gateway
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+)
+
+const (
+	EnvVarIdempotent            = "MODELGATEWAY_IDEMPOTENT"
+	EnvVarIdempotencyCacheSize  = "MODELGATEWAY_IDEMPOTENCY_CACHE_SIZE"
+	DefaultIdempotencyCacheSize = 100000
+)
+
+// getIdempotencyKey identifies a model input message by its topic, partition and offset, which only stay the same
+// when the message is redelivered. Request ids and message keys can not be used, as replays and the elements of a
+// forEach step reuse them for new messages.
+func getIdempotencyKey(msg *transport.Message) string {
+	return fmt.Sprintf("%s.%d.%d", msg.Topic, msg.Partition, msg.Offset)
+}
+
+// idempotencyCache remembers the most recent processed idempotency keys, evicting the oldest once full. It is held
+// by each replica, so redelivery to another replica after a consumer rebalance is not detected.
+type idempotencyCache struct {
+	mu       sync.Mutex
+	keys     map[string]struct{}
+	order    []string
+	next     int
+	capacity int
+}
+
+func newIdempotencyCache(capacity int) *idempotencyCache {
+	return &idempotencyCache{
+		keys:     make(map[string]struct{}),
+		order:    make([]string, 0, capacity),
+		capacity: capacity,
+	}
+}
+
+func (c *idempotencyCache) Contains(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.keys[key]
+	return ok
+}
+
+func (c *idempotencyCache) Add(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.capacity <= 0 {
+		return
+	}
+	if _, ok := c.keys[key]; ok {
+		return
+	}
+	if len(c.order) < c.capacity {
+		c.order = append(c.order, key)
+	} else {
+		delete(c.keys, c.order[c.next])
+		c.order[c.next] = key
+		c.next = (c.next + 1) % c.capacity
+	}
+	c.keys[key] = struct{}{}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+	seldontracer "github.com/seldonio/seldon-core/scheduler/v2/pkg/tracing"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+func TestGetIdempotencyKey(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name     string
+		msg      *transport.Message
+		expected string
+	}
+	tests := []test{
+		{
+			name:     "topic partition and offset",
+			msg:      &transport.Message{Topic: "seldon.default.model.foo.inputs", Partition: 2, Offset: 10, Key: []byte("pipeline.1234")},
+			expected: "seldon.default.model.foo.inputs.2.10",
+		},
+		{
+			name: "message key and request id ignored",
+			msg: &transport.Message{
+				Topic:   "seldon.default.model.foo.inputs",
+				Offset:  11,
+				Key:     []byte("pipeline.1234"),
+				Headers: []transport.Header{{Key: util.RequestIdHeader, Value: []byte("1234")}},
+			},
+			expected: "seldon.default.model.foo.inputs.0.11",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(getIdempotencyKey(test.msg)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestIdempotencyCache(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name        string
+		capacity    int
+		added       []string
+		contains    []string
+		notContains []string
+	}
+	tests := []test{
+		{
+			name:     "added keys found",
+			capacity: 3,
+			added:    []string{"a", "b", "a"},
+			contains: []string{"a", "b"},
+		},
+		{
+			name:        "oldest keys evicted",
+			capacity:    2,
+			added:       []string{"a", "b", "c", "d"},
+			contains:    []string{"c", "d"},
+			notContains: []string{"a", "b"},
+		},
+		{
+			name:        "no capacity",
+			capacity:    0,
+			added:       []string{"a"},
+			notContains: []string{"a"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cache := newIdempotencyCache(test.capacity)
+			for _, key := range test.added {
+				cache.Add(key)
+			}
+			for _, key := range test.contains {
+				g.Expect(cache.Contains(key)).To(BeTrue())
+			}
+			for _, key := range test.notContains {
+				g.Expect(cache.Contains(key)).To(BeFalse())
+			}
+		})
+	}
+}
+
+func TestIdempotentProcessing(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	serverConfig := InferenceServerConfig{Host: "0.0.0.0", HttpPort: 1234, GrpcPort: 1235}
+	createTestV2ClientMockResponders(serverConfig.Host, serverConfig.HttpPort, "foo")
+	logger := log.New()
+	tp, err := seldontracer.NewTraceProvider("test", nil, logger)
+	g.Expect(err).To(BeNil())
+	streamTransport := transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic)
+	managerConfig := &ManagerConfig{
+		SeldonKafkaConfig:     &config.KafkaConfig{},
+		Namespace:             "default",
+		InferenceServerConfig: &serverConfig,
+		TraceProvider:         tp,
+		NumWorkers:            1,
+		Transport:             streamTransport,
+		Idempotent:            true,
+		IdempotencyCacheSize:  10,
+	}
+	ic, err := NewInferKafkaHandler(logger, managerConfig, streamTransport, "dummy")
+	g.Expect(err).To(BeNil())
+	g.Expect(ic.AddModel("foo", nil)).To(BeNil())
+	go ic.Serve()
+	defer ic.Stop()
+
+	tn, err := kafka2.NewTopicNamer("default", "seldon")
+	g.Expect(err).To(BeNil())
+	producer, err := streamTransport.NewProducer()
+	g.Expect(err).To(BeNil())
+	request := &transport.Message{
+		Topic: tn.GetModelTopicInputs("foo"),
+		Key:   []byte("pipeline.1234"),
+		Value: []byte("{}"),
+		// a key set by a previous step is replaced
+		Headers: []transport.Header{{Key: HeaderKeyIdempotencyKey, Value: []byte("bar.pipeline.1234")}},
+	}
+	g.Expect(producer.Produce(request, nil)).To(BeNil())
+	g.Eventually(httpmock.GetTotalCallCount).Should(Equal(1))
+	firstKey := tn.GetModelTopicInputs("foo") + ".0.0"
+	g.Eventually(func() bool { return ic.processed.Contains(firstKey) }).Should(BeTrue())
+
+	consumer, err := streamTransport.NewConsumer("test")
+	g.Expect(err).To(BeNil())
+	g.Expect(consumer.Subscribe([]string{tn.GetModelTopicOutputs("foo")})).To(BeNil())
+	msg, err := consumer.Poll(time.Second)
+	g.Expect(err).To(BeNil())
+	g.Expect(msg).ToNot(BeNil())
+	g.Expect(msg.Headers).To(ContainElement(transport.Header{Key: HeaderKeyIdempotencyKey, Value: []byte(firstKey)}))
+	g.Expect(msg.Headers).ToNot(ContainElement(transport.Header{Key: HeaderKeyIdempotencyKey, Value: []byte("bar.pipeline.1234")}))
+
+	// a replayed request with the same key and request id is a new message and is sent to the model again
+	g.Expect(producer.Produce(request, nil)).To(BeNil())
+	g.Eventually(httpmock.GetTotalCallCount).Should(Equal(2))
+
+	// a redelivered request already processed is not sent to the model again
+	ic.markProcessed(tn.GetModelTopicInputs("foo") + ".0.2")
+	g.Expect(producer.Produce(request, nil)).To(BeNil())
+	g.Consistently(httpmock.GetTotalCallCount, 200*time.Millisecond).Should(Equal(2))
+}
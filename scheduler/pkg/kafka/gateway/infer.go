@@ -12,32 +12,39 @@ package gateway
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
-	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
-	"github.com/signalfx/splunk-otel-go/instrumentation/github.com/confluentinc/confluent-kafka-go/v2/kafka/splunkkafka"
 	log "github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/time/rate"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
 
 	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
-	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
 	pipeline "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
 
 const (
-	pollTimeoutMillisecs        = 10000
+	pollTimeout                 = 10 * time.Second
+	pausedPollTimeout           = 100 * time.Millisecond
+	lagReportInterval           = 10 * time.Second
 	DefaultNumWorkers           = 8
 	EnvVarNumWorkers            = "MODELGATEWAY_NUM_WORKERS"
 	envDefaultReplicationFactor = "KAFKA_DEFAULT_REPLICATION_FACTOR"
 	envDefaultNumPartitions     = "KAFKA_DEFAULT_NUM_PARTITIONS"
 	defaultReplicationFactor    = 1
 	defaultNumPartitions        = 1
+	topicConfigRetentionMs      = "retention.ms"
+	topicConfigCleanupPolicy    = "cleanup.policy"
+	topicConfigCompressionType  = "compression.type"
 )
 
 type InferKafkaHandler struct {
@@ -46,26 +53,34 @@ type InferKafkaHandler struct {
 	loadedModels      map[string]bool
 	subscribedTopics  map[string]bool
 	workers           []*InferWorker
-	consumer          *kafka.Consumer
-	producer          *kafka.Producer
+	transport         transport.Transport
+	consumer          transport.Consumer
+	producer          transport.Producer
 	done              chan bool
 	tracer            trace.Tracer
 	topicNamer        *kafka2.TopicNamer
 	consumerConfig    *ManagerConfig
-	adminClient       *kafka.AdminClient
+	adminClient       transport.Admin
 	consumerName      string
 	replicationFactor int
 	numPartitions     int
 	tlsClientOptions  *util.TLSOptions
 	producerMu        sync.RWMutex
 	producerActive    atomic.Bool
+	processed         *idempotencyCache
+	limiter           *concurrencyLimiter
+	paused            bool // only used by the Serve loop
+	lowPriorityPaused bool // only used by the Serve loop
+	rateLimiters      map[string]*rate.Limiter
+	// jobs are sent to the workers on these, and sent again once a rate limit delay is over
+	jobChan            chan *InferWork
+	lowPriorityJobChan chan *InferWork
 }
 
 func NewInferKafkaHandler(
 	logger log.FieldLogger,
 	consumerConfig *ManagerConfig,
-	consumerConfigMap kafka.ConfigMap,
-	producerConfigMap kafka.ConfigMap,
+	streamTransport transport.Transport,
 	consumerName string,
 ) (*InferKafkaHandler, error) {
 	replicationFactor, err := util.GetIntEnvar(envDefaultReplicationFactor, defaultReplicationFactor)
@@ -86,48 +101,53 @@ func NewInferKafkaHandler(
 	}
 
 	ic := &InferKafkaHandler{
-		logger:            logger.WithField("source", "InferConsumer"),
-		done:              make(chan bool),
-		tracer:            consumerConfig.TraceProvider.GetTraceProvider().Tracer("Worker"),
-		topicNamer:        topicNamer,
-		loadedModels:      make(map[string]bool),
-		subscribedTopics:  make(map[string]bool),
-		consumerConfig:    consumerConfig,
-		consumerName:      consumerName,
-		replicationFactor: replicationFactor,
-		numPartitions:     numPartitions,
-		tlsClientOptions:  tlsClientOptions,
-	}
-	return ic, ic.setup(consumerConfigMap, producerConfigMap)
+		logger:             logger.WithField("source", "InferConsumer"),
+		done:               make(chan bool),
+		tracer:             consumerConfig.TraceProvider.GetTraceProvider().Tracer("Worker"),
+		topicNamer:         topicNamer,
+		loadedModels:       make(map[string]bool),
+		subscribedTopics:   make(map[string]bool),
+		consumerConfig:     consumerConfig,
+		transport:          streamTransport,
+		consumerName:       consumerName,
+		replicationFactor:  replicationFactor,
+		numPartitions:      numPartitions,
+		tlsClientOptions:   tlsClientOptions,
+		limiter:            newConcurrencyLimiter(getMaxInFlight(consumerConfig), consumerConfig.TargetLatency, consumerConfig.LowPriorityPercent),
+		rateLimiters:       make(map[string]*rate.Limiter),
+		jobChan:            make(chan *InferWork, getMaxInFlight(consumerConfig)),
+		lowPriorityJobChan: make(chan *InferWork, getMaxInFlight(consumerConfig)),
+	}
+	if consumerConfig.Idempotent {
+		ic.processed = newIdempotencyCache(consumerConfig.IdempotencyCacheSize)
+	}
+	return ic, ic.setup()
 }
 
-func (kc *InferKafkaHandler) setup(consumerConfig kafka.ConfigMap, producerConfig kafka.ConfigMap) error {
+func (kc *InferKafkaHandler) setup() error {
 	logger := kc.logger.WithField("func", "setup")
 	var err error
 
-	producerConfigWithoutSecrets := config.WithoutSecrets(producerConfig)
-	kc.logger.Infof("Creating producer with config %v", producerConfigWithoutSecrets)
-	kc.producer, err = kafka.NewProducer(&producerConfig)
+	kc.producer, err = kc.transport.NewProducer()
 	if err != nil {
 		return err
 	}
 	kc.producerActive.Store(true)
-	logger.Infof("Created producer %s", kc.producer.String())
+	logger.Infof("Created producer for %s", kc.consumerName)
 
 	// we map topics consistently to consumers and we choose the consumer group.id based on this mapping
 	// for eg. hash(topic1) -> modelgateway-0
 	// this is done by the caller i.e. ConsumerManager (store.go)
-	consumerConfig["group.id"] = kc.consumerName
-	consumerConfigWithoutSecrets := config.WithoutSecrets(consumerConfig)
-	kc.logger.Infof("Creating consumer with config %v", consumerConfigWithoutSecrets)
-	kc.consumer, err = kafka.NewConsumer(&consumerConfig)
+	kc.consumer, err = kc.transport.NewConsumer(kc.consumerName)
 	if err != nil {
 		return err
 	}
-	logger.Infof("Created consumer %s", kc.consumer.String())
+	kc.consumer.SetRebalanceCallback(kc.reapplyPause)
+	logger.Infof("Created consumer %s", kc.consumerName)
 
-	if kc.consumerConfig.SeldonKafkaConfig.HasKafkaBootstrapServer() {
-		kc.adminClient, err = kafka.NewAdminClient(&consumerConfig)
+	// topics can't be created on Kafka without bootstrap servers, other transports always manage their own topics
+	if kc.consumerConfig.Transport != nil || kc.consumerConfig.SeldonKafkaConfig.HasKafkaBootstrapServer() {
+		kc.adminClient, err = kc.transport.NewAdmin()
 		if err != nil {
 			return err
 		}
@@ -144,7 +164,7 @@ func (kc *InferKafkaHandler) setup(consumerConfig kafka.ConfigMap, producerConfi
 }
 
 // Will overwrite duplicate stream headers
-func collectHeaders(kheaders []kafka.Header) map[string]string {
+func collectHeaders(kheaders []transport.Header) map[string]string {
 	headers := make(map[string]string)
 	for _, kheader := range kheaders {
 		headers[kheader.Key] = string(kheader.Value)
@@ -152,19 +172,33 @@ func collectHeaders(kheaders []kafka.Header) map[string]string {
 	return headers
 }
 
-func (kc *InferKafkaHandler) Produce(msg *kafka.Message, deliveryChan chan kafka.Event) error {
+func (kc *InferKafkaHandler) Produce(msg *transport.Message, delivered func(error)) error {
 	logger := kc.logger.WithField("func", "Produce")
 	kc.producerMu.RLock()
 	defer kc.producerMu.RUnlock()
 	if kc.producerActive.Load() {
-		return kc.producer.Produce(msg, deliveryChan)
+		return kc.producer.Produce(msg, delivered)
 	} else {
-		err := fmt.Errorf("The infer producer %s is no longer running", kc.producer.String())
+		err := fmt.Errorf("The infer producer %s is no longer running", kc.consumerName)
 		logger.WithError(err).Error("Failed to produce kafka message")
 		return err
 	}
 }
 
+func getMaxInFlight(consumerConfig *ManagerConfig) int {
+	if consumerConfig.MaxInFlight > 0 {
+		return consumerConfig.MaxInFlight
+	}
+	return DefaultMaxInFlight
+}
+
+// markProcessed records a request whose response has been delivered so redeliveries of it are skipped
+func (kc *InferKafkaHandler) markProcessed(idempotencyKey string) {
+	if kc.processed != nil && idempotencyKey != "" {
+		kc.processed.Add(idempotencyKey)
+	}
+}
+
 func (kc *InferKafkaHandler) closeProducer() {
 	kc.producerMu.Lock()
 	defer kc.producerMu.Unlock()
@@ -182,7 +216,7 @@ func (kc *InferKafkaHandler) subscribeTopics() error {
 		topics[idx] = k
 		idx++
 	}
-	err := kc.consumer.SubscribeTopics(topics, nil)
+	err := kc.consumer.Subscribe(topics)
 	return err
 }
 
@@ -192,7 +226,7 @@ func (kc *InferKafkaHandler) GetNumModels() int {
 	return len(kc.loadedModels)
 }
 
-func (kc *InferKafkaHandler) createTopics(topicNames []string) error {
+func (kc *InferKafkaHandler) createTopics(topicNames []string, topicConfig *scheduler.KafkaTopicConfig) error {
 	logger := kc.logger.WithField("func", "createTopics")
 	if kc.adminClient == nil {
 		logger.Warnf("no kafka admin client, can't create any of the following topics: %v", topicNames)
@@ -203,19 +237,10 @@ func (kc *InferKafkaHandler) createTopics(topicNames []string) error {
 	}
 	t1 := time.Now()
 
-	var topicSpecs []kafka.TopicSpecification
-	for _, topicName := range topicNames {
-		topicSpecs = append(topicSpecs, kafka.TopicSpecification{
-			Topic:             topicName,
-			NumPartitions:     kc.numPartitions,
-			ReplicationFactor: kc.replicationFactor,
-		})
-	}
-	results, err := kc.adminClient.CreateTopics(
-		context.Background(),
-		topicSpecs,
-		kafka.SetAdminOperationTimeout(TopicCreateTimeout),
-	)
+	topicSpecs := kc.getTopicSpecs(topicNames, topicConfig)
+	ctx, cancel := context.WithTimeout(context.Background(), TopicCreateTimeout)
+	defer cancel()
+	err := kc.adminClient.CreateTopics(ctx, topicSpecs)
 	if err != nil {
 		return err
 	}
@@ -244,49 +269,81 @@ func (kc *InferKafkaHandler) createTopics(topicNames []string) error {
 		logger.Infof("all topics created")
 	}
 
-	for _, result := range results {
-		logger.Debugf("topic result for %s", result.String())
-	}
-
 	t2 := time.Now()
 	logger.Debugf("kafka topics created in %d millis", t2.Sub(t1).Milliseconds())
 
+	// topics may already exist from an earlier version of the model so reconcile them with the model settings
+	if topicConfig != nil {
+		return kc.updateTopics(topicSpecs)
+	}
 	return nil
 }
 
-func (kc *InferKafkaHandler) ensureTopicsExist(topicNames []string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), TopicDescribeTimeout)
-	defer cancel()
-	topicsDescResult, err := kc.adminClient.DescribeTopics(
-		ctx,
-		kafka.NewTopicCollectionOfTopicNames(topicNames),
-		kafka.SetAdminOptionIncludeAuthorizedOperations(false))
-	if err != nil {
-		return err
+// getTopicSpecs applies the model topic settings over the defaults from the environment
+func (kc *InferKafkaHandler) getTopicSpecs(topicNames []string, topicConfig *scheduler.KafkaTopicConfig) []transport.TopicSpec {
+	numPartitions := kc.numPartitions
+	if topicConfig.GetPartitions() > 0 {
+		numPartitions = int(topicConfig.GetPartitions())
+	}
+	replicationFactor := kc.replicationFactor
+	if topicConfig.GetReplicationFactor() > 0 {
+		replicationFactor = int(topicConfig.GetReplicationFactor())
+	}
+	config := make(map[string]string)
+	if topicConfig != nil && topicConfig.RetentionMs != nil {
+		config[topicConfigRetentionMs] = strconv.FormatInt(topicConfig.GetRetentionMs(), 10)
+	}
+	if topicConfig.GetCleanupPolicy() != "" {
+		config[topicConfigCleanupPolicy] = topicConfig.GetCleanupPolicy()
+	}
+	if topicConfig.GetCompressionType() != "" {
+		config[topicConfigCompressionType] = topicConfig.GetCompressionType()
 	}
+	var topicSpecs []transport.TopicSpec
+	for _, topicName := range topicNames {
+		topicSpecs = append(topicSpecs, transport.TopicSpec{
+			Name:              topicName,
+			NumPartitions:     numPartitions,
+			ReplicationFactor: replicationFactor,
+			Config:            config,
+		})
+	}
+	return topicSpecs
+}
 
-	for _, topicDescription := range topicsDescResult.TopicDescriptions {
-		if topicDescription.Error.Code() != kafka.ErrNoError {
-			return fmt.Errorf("topic description failure: %s", topicDescription.Error.Error())
-		}
+func (kc *InferKafkaHandler) updateTopics(topicSpecs []transport.TopicSpec) error {
+	logger := kc.logger.WithField("func", "updateTopics")
+	ctx, cancel := context.WithTimeout(context.Background(), TopicCreateTimeout)
+	defer cancel()
+	increased, err := kc.adminClient.UpdateTopics(ctx, topicSpecs)
+	for _, topicName := range increased {
+		logger.Warnf("Increased partitions of topic %s, messages with the same key may now be read out of order", topicName)
 	}
+	return err
+}
 
-	return nil
+func (kc *InferKafkaHandler) ensureTopicsExist(topicNames []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), TopicDescribeTimeout)
+	defer cancel()
+	return kc.adminClient.CheckTopicsExist(ctx, topicNames)
 }
 
-func (kc *InferKafkaHandler) AddModel(modelName string) error {
+func (kc *InferKafkaHandler) AddModel(modelName string, streamSpec *scheduler.StreamSpec) error {
 	kc.mu.Lock()
 	defer kc.mu.Unlock()
 	kc.loadedModels[modelName] = true
+	kc.setRateLimit(modelName, streamSpec.GetRateLimit())
 
 	// create topics
 	inputTopic := kc.topicNamer.GetModelTopicInputs(modelName)
+	lowPriorityInputTopic := kc.topicNamer.GetModelTopicInputsLowPriority(modelName)
 	outputTopic := kc.topicNamer.GetModelTopicOutputs(modelName)
-	if err := kc.createTopics([]string{inputTopic, outputTopic}); err != nil {
+	if err := kc.createTopics([]string{inputTopic, lowPriorityInputTopic, outputTopic}, streamSpec.GetTopicConfig()); err != nil {
 		return err
 	}
 
 	kc.subscribedTopics[inputTopic] = true
+	kc.subscribedTopics[lowPriorityInputTopic] = true
 	err := kc.subscribeTopics()
 	if err != nil {
 		kc.logger.WithError(err).Errorf("failed to subscribe to topics")
@@ -295,11 +352,157 @@ func (kc *InferKafkaHandler) AddModel(modelName string) error {
 	return nil
 }
 
+// setRateLimit must be called with the lock held. An existing limiter is kept if the limit is unchanged.
+func (kc *InferKafkaHandler) setRateLimit(modelName string, rateLimit *scheduler.StreamRateLimit) {
+	if rateLimit == nil || rateLimit.RequestsPerSecond == 0 {
+		delete(kc.rateLimiters, modelName)
+		return
+	}
+	burst := int(rateLimit.Burst)
+	if burst == 0 {
+		burst = int(rateLimit.RequestsPerSecond)
+	}
+	limit := rate.Limit(rateLimit.RequestsPerSecond)
+	if limiter, ok := kc.rateLimiters[modelName]; ok && limiter.Limit() == limit && limiter.Burst() == burst {
+		return
+	}
+	kc.rateLimiters[modelName] = rate.NewLimiter(limit, burst)
+}
+
+func (kc *InferKafkaHandler) getJobChan(lowPriority bool) chan *InferWork {
+	if lowPriority {
+		return kc.lowPriorityJobChan
+	}
+	return kc.jobChan
+}
+
+// requeueAfter sends a rate limited job to the workers again once its delay is over, so the worker is free for
+// other models meanwhile. The job stays in flight, so the concurrency limit pauses consuming if many are delayed.
+func (kc *InferKafkaHandler) requeueAfter(job *InferWork, delay time.Duration) {
+	time.AfterFunc(delay, func() {
+		select {
+		case kc.getJobChan(job.lowPriority) <- job:
+		case <-kc.done:
+		}
+	})
+}
+
+func (kc *InferKafkaHandler) getRateLimiter(modelName string) *rate.Limiter {
+	kc.mu.RLock()
+	defer kc.mu.RUnlock()
+	return kc.rateLimiters[modelName]
+}
+
+// updatePause pauses consuming while the requests in flight exceed the concurrency limit
+func (kc *InferKafkaHandler) updatePause() {
+	kc.applyPause(false)
+}
+
+// reapplyPause applies the pause state to all assigned partitions after a rebalance or a change of the subscribed
+// topics, as newly assigned partitions are not paused
+func (kc *InferKafkaHandler) reapplyPause() {
+	kc.applyPause(true)
+}
+
+func (kc *InferKafkaHandler) applyPause(force bool) {
+	logger := kc.logger.WithField("func", "applyPause")
+	exceeded := kc.limiter.Exceeded()
+	if exceeded && (!kc.paused || force) {
+		if err := kc.consumer.Pause(); err != nil {
+			logger.WithError(err).Error("Failed to pause consumer")
+			return
+		}
+		logger.Debugf("Paused consumer %s", kc.consumerName)
+		kc.paused = true
+	} else if !exceeded && (kc.paused || force) {
+		if err := kc.consumer.Resume(); err != nil {
+			logger.WithError(err).Error("Failed to resume consumer")
+			return
+		}
+		logger.Debugf("Resumed consumer %s", kc.consumerName)
+		kc.paused = false
+		// resuming the consumer also resumes the low priority topics
+		kc.lowPriorityPaused = false
+	}
+	if !kc.paused {
+		kc.updateLowPriorityPause(force)
+	}
+	if kc.consumerConfig.Metrics != nil {
+		inFlight, limit := kc.limiter.Stats()
+		kc.consumerConfig.Metrics.SetConsumerPaused(kc.consumerName, kc.paused)
+		kc.consumerConfig.Metrics.SetConsumerConcurrency(kc.consumerName, inFlight, limit)
+	}
+}
+
+// updateLowPriorityPause pauses the low priority input topics while low priority requests use their share of the
+// concurrency limit. With force the state is applied even if unchanged, to include newly assigned partitions.
+func (kc *InferKafkaHandler) updateLowPriorityPause(force bool) {
+	logger := kc.logger.WithField("func", "updateLowPriorityPause")
+	exceeded := kc.limiter.LowPriorityExceeded()
+	if exceeded == kc.lowPriorityPaused && !force {
+		return
+	}
+	topics := kc.getLowPriorityTopics()
+	if exceeded {
+		if err := kc.consumer.PauseTopics(topics); err != nil {
+			logger.WithError(err).Error("Failed to pause low priority topics")
+			return
+		}
+		logger.Debugf("Paused low priority topics of consumer %s", kc.consumerName)
+	} else {
+		if err := kc.consumer.ResumeTopics(topics); err != nil {
+			logger.WithError(err).Error("Failed to resume low priority topics")
+			return
+		}
+		logger.Debugf("Resumed low priority topics of consumer %s", kc.consumerName)
+	}
+	kc.lowPriorityPaused = exceeded
+}
+
+func (kc *InferKafkaHandler) getLowPriorityTopics() []string {
+	kc.mu.RLock()
+	defer kc.mu.RUnlock()
+	var topics []string
+	for topic := range kc.subscribedTopics {
+		if kc.topicNamer.IsLowPriorityTopic(topic) {
+			topics = append(topics, topic)
+		}
+	}
+	return topics
+}
+
+func (kc *InferKafkaHandler) reportLag() {
+	logger := kc.logger.WithField("func", "reportLag")
+	if kc.consumerConfig.Metrics == nil {
+		return
+	}
+	partitions, err := kc.consumer.PartitionLag()
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get consumer lag")
+		return
+	}
+	// the lag of a model includes all partitions of both its high and low priority input topics
+	modelLag := make(map[string]int64)
+	for _, partition := range partitions {
+		kc.consumerConfig.Metrics.SetTopicPartitionLag(partition.Topic, partition.Partition, partition.Lag)
+		modelName, err := kc.topicNamer.GetModelNameFromModelInputTopic(partition.Topic)
+		if err != nil {
+			continue
+		}
+		modelLag[modelName] += partition.Lag
+	}
+	for modelName, lag := range modelLag {
+		kc.consumerConfig.Metrics.SetConsumerLag(modelName, lag)
+	}
+}
+
 func (kc *InferKafkaHandler) RemoveModel(modelName string) error {
 	kc.mu.Lock()
 	defer kc.mu.Unlock()
 	delete(kc.loadedModels, modelName)
+	delete(kc.rateLimiters, modelName)
 	delete(kc.subscribedTopics, kc.topicNamer.GetModelTopicInputs(modelName))
+	delete(kc.subscribedTopics, kc.topicNamer.GetModelTopicInputsLowPriority(modelName))
 	if len(kc.subscribedTopics) > 0 {
 		err := kc.subscribeTopics()
 		if err != nil {
@@ -315,75 +518,87 @@ func (kc *InferKafkaHandler) Serve() {
 	run := true
 	// create a cancel and job channel
 	cancelChan := make(chan struct{})
-	jobChan := make(chan *InferWork, kc.consumerConfig.NumWorkers)
 	// Start workers
 	for i := 0; i < kc.consumerConfig.NumWorkers; i++ {
-		go kc.workers[i].Start(jobChan, cancelChan)
+		go kc.workers[i].Start(kc.jobChan, kc.lowPriorityJobChan, cancelChan)
 	}
 
+	lastLagReport := time.Now()
 	for run {
 		select {
 		case <-kc.done:
-			logger.Infof("stopping consumer %s", kc.consumer.String())
+			logger.Infof("stopping consumer %s", kc.consumerName)
 			kc.producerActive.Store(false)
 			run = false
 		default:
-			ev := kc.consumer.Poll(pollTimeoutMillisecs)
-			if ev == nil {
+			kc.updatePause()
+			if time.Since(lastLagReport) > lagReportInterval {
+				kc.reportLag()
+				lastLagReport = time.Now()
+			}
+			timeout := pollTimeout
+			if kc.paused {
+				timeout = pausedPollTimeout
+			}
+			e, err := kc.consumer.Poll(timeout)
+			if err != nil {
+				logger.WithError(err).Error("Failed to poll for messages")
+				continue
+			}
+			if e == nil {
 				continue
 			}
 
-			switch e := ev.(type) {
-			case *kafka.Message:
-
-				var modelName string
-				var err error
-				if e.TopicPartition.Topic != nil {
-					modelName, err = kc.topicNamer.GetModelNameFromModelInputTopic(*e.TopicPartition.Topic)
-					if err != nil {
-						logger.WithError(err).Errorf("Failed to extract modelName from topic %s", *e.TopicPartition.Topic)
-						continue
-					}
-				} else {
-					logger.Errorf("Received message with no topic name")
+			var modelName string
+			if e.Topic != "" {
+				modelName, err = kc.topicNamer.GetModelNameFromModelInputTopic(e.Topic)
+				if err != nil {
+					logger.WithError(err).Errorf("Failed to extract modelName from topic %s", e.Topic)
 					continue
 				}
+			} else {
+				logger.Errorf("Received message with no topic name")
+				continue
+			}
 
-				kc.mu.Lock()
-				if _, ok := kc.loadedModels[modelName]; !ok {
-					kc.mu.Unlock()
-					logger.Infof("Failed to find model %s in loaded models", modelName)
-					continue
-				}
+			kc.mu.Lock()
+			if _, ok := kc.loadedModels[modelName]; !ok {
 				kc.mu.Unlock()
+				logger.Infof("Failed to find model %s in loaded models", modelName)
+				continue
+			}
+			kc.mu.Unlock()
 
-				// Add tracing span
-				ctx := context.Background()
-				carrierIn := splunkkafka.NewMessageCarrier(e)
-				ctx = otel.GetTextMapPropagator().Extract(ctx, carrierIn)
-				_, span := kc.tracer.Start(ctx, "Consume")
-				requestId := pipeline.GetRequestIdFromKafkaHeaders(e.Headers)
-				if requestId == "" {
-					logger.Warnf("Missing request id in Kafka headers for key %s", string(e.Key))
-				}
-				span.SetAttributes(attribute.String(util.RequestIdHeader, requestId))
-				headers := collectHeaders(e.Headers)
-				logger.Debugf("Headers received from kafka for model %s %v", modelName, e.Headers)
-
-				job := InferWork{
-					modelName: modelName,
-					msg:       e,
-					headers:   headers,
-				}
-				// enqueue a job
-				jobChan <- &job
-				span.End()
-
-			case kafka.Error:
-				logger.Errorf("Kafka error, code: [%s] msg: [%s]", e.Code().String(), e.Error())
-			default:
-				logger.Debugf("Ignored %s", e.String())
+			idempotencyKey := getIdempotencyKey(e)
+			if kc.processed != nil && kc.processed.Contains(idempotencyKey) {
+				logger.Infof("Skipping already processed request %s for model %s", idempotencyKey, modelName)
+				continue
+			}
+
+			// Add tracing span
+			ctx := context.Background()
+			carrierIn := transport.NewMessageCarrier(e)
+			ctx = otel.GetTextMapPropagator().Extract(ctx, carrierIn)
+			_, span := kc.tracer.Start(ctx, "Consume")
+			requestId := pipeline.GetRequestIdFromKafkaHeaders(e.Headers)
+			if requestId == "" {
+				logger.Warnf("Missing request id in Kafka headers for key %s", string(e.Key))
+			}
+			span.SetAttributes(attribute.String(util.RequestIdHeader, requestId))
+			headers := collectHeaders(e.Headers)
+			logger.Debugf("Headers received from kafka for model %s %v", modelName, e.Headers)
+
+			job := InferWork{
+				modelName:      modelName,
+				msg:            e,
+				headers:        headers,
+				idempotencyKey: idempotencyKey,
+				lowPriority:    kc.topicNamer.IsLowPriorityTopic(e.Topic),
 			}
+			// enqueue a job
+			kc.limiter.Start(job.lowPriority)
+			kc.getJobChan(job.lowPriority) <- &job
+			span.End()
 		}
 	}
 
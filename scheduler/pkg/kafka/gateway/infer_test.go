@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package gateway
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+	seldontracer "github.com/seldonio/seldon-core/scheduler/v2/pkg/tracing"
+)
+
+func TestAddModelTopicConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	getUintPtr := func(val uint32) *uint32 { return &val }
+	getInt64Ptr := func(val int64) *int64 { return &val }
+	getStringPtr := func(val string) *string { return &val }
+
+	type test struct {
+		name           string
+		streamSpecs    []*scheduler.StreamSpec // applied in order as model versions
+		expectedTopics transport.TopicSpec
+	}
+
+	tests := []test{
+		{
+			name:           "defaults",
+			streamSpecs:    []*scheduler.StreamSpec{nil},
+			expectedTopics: transport.TopicSpec{NumPartitions: defaultNumPartitions, Config: map[string]string{}},
+		},
+		{
+			name: "topic config",
+			streamSpecs: []*scheduler.StreamSpec{
+				{
+					TopicConfig: &scheduler.KafkaTopicConfig{
+						Partitions:      getUintPtr(4),
+						RetentionMs:     getInt64Ptr(3600000),
+						CleanupPolicy:   getStringPtr("compact"),
+						CompressionType: getStringPtr("zstd"),
+					},
+				},
+			},
+			expectedTopics: transport.TopicSpec{
+				NumPartitions: 4,
+				Config:        map[string]string{"retention.ms": "3600000", "cleanup.policy": "compact", "compression.type": "zstd"},
+			},
+		},
+		{
+			name: "existing topics are updated",
+			streamSpecs: []*scheduler.StreamSpec{
+				nil,
+				{
+					TopicConfig: &scheduler.KafkaTopicConfig{
+						Partitions:  getUintPtr(3),
+						RetentionMs: getInt64Ptr(1000),
+					},
+				},
+			},
+			expectedTopics: transport.TopicSpec{
+				NumPartitions: 3,
+				Config:        map[string]string{"retention.ms": "1000"},
+			},
+		},
+		{
+			name: "partitions are not reduced",
+			streamSpecs: []*scheduler.StreamSpec{
+				{TopicConfig: &scheduler.KafkaTopicConfig{Partitions: getUintPtr(4)}},
+				{TopicConfig: &scheduler.KafkaTopicConfig{Partitions: getUintPtr(2)}},
+			},
+			expectedTopics: transport.TopicSpec{NumPartitions: 4, Config: map[string]string{}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			logger := log.New()
+			tp, err := seldontracer.NewTraceProvider("test", nil, logger)
+			g.Expect(err).To(BeNil())
+			streamTransport := transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic)
+			managerConfig := &ManagerConfig{
+				SeldonKafkaConfig:     &config.KafkaConfig{},
+				Namespace:             "default",
+				InferenceServerConfig: &InferenceServerConfig{},
+				TraceProvider:         tp,
+				Transport:             streamTransport,
+			}
+			ic, err := NewInferKafkaHandler(logger, managerConfig, streamTransport, "dummy")
+			g.Expect(err).To(BeNil())
+			for _, streamSpec := range test.streamSpecs {
+				g.Expect(ic.AddModel("foo", streamSpec)).To(BeNil())
+			}
+
+			tn, err := kafka2.NewTopicNamer("default", "seldon")
+			g.Expect(err).To(BeNil())
+			for _, topicName := range []string{
+				tn.GetModelTopicInputs("foo"),
+				tn.GetModelTopicInputsLowPriority("foo"),
+				tn.GetModelTopicOutputs("foo"),
+			} {
+				spec, ok := streamTransport.DescribeTopic(topicName)
+				g.Expect(ok).To(BeTrue())
+				g.Expect(spec.NumPartitions).To(Equal(test.expectedTopics.NumPartitions))
+				g.Expect(spec.Config).To(Equal(test.expectedTopics.Config))
+			}
+		})
+	}
+}
@@ -12,11 +12,17 @@ package gateway
 import (
 	"encoding/json"
 	"sync"
+	"time"
 
-	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/claimcheck"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/serde"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
 	seldontracer "github.com/seldonio/seldon-core/scheduler/v2/pkg/tracing"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
@@ -31,11 +37,10 @@ type ConsumerManager struct {
 	logger log.FieldLogger
 	mu     sync.Mutex
 	// all consumers we have
-	consumers         map[string]*InferKafkaHandler
-	managerConfig     *ManagerConfig
-	maxNumConsumers   int
-	consumerConfigMap kafka.ConfigMap
-	producerConfigMap kafka.ConfigMap
+	consumers       map[string]*InferKafkaHandler
+	managerConfig   *ManagerConfig
+	maxNumConsumers int
+	transport       transport.Transport
 }
 
 type ManagerConfig struct {
@@ -44,14 +49,24 @@ type ManagerConfig struct {
 	InferenceServerConfig *InferenceServerConfig
 	TraceProvider         *seldontracer.TracerProvider
 	NumWorkers            int // infer workers
-}
-
-func cloneKafkaConfigMap(m kafka.ConfigMap) kafka.ConfigMap {
-	m2 := make(kafka.ConfigMap)
-	for k, v := range m {
-		m2[k] = v
-	}
-	return m2
+	// Idempotent skips redelivered requests already processed by this gateway and uses an idempotent producer
+	Idempotent           bool
+	IdempotencyCacheSize int
+	// Consuming is paused while more requests than the concurrency limit are in flight. The limit adapts
+	// between 1 and MaxInFlight if TargetLatency is set.
+	MaxInFlight   int
+	TargetLatency time.Duration
+	// LowPriorityPercent is the percentage of the concurrency limit requests from the low priority input topics
+	// can use, the rest is kept for high priority requests
+	LowPriorityPercent int
+	// Metrics is optional
+	Metrics metrics.ModelGatewayMetricsHandler
+	// Encoder is optional, protobuf responses are written in the schema registry wire format if set
+	Encoder *serde.Encoder
+	// ClaimChecker is optional, large payloads are sent inline if not set
+	ClaimChecker *claimcheck.ClaimChecker
+	// Transport is optional, the transport selected in SeldonKafkaConfig is created if not set
+	Transport transport.Transport
 }
 
 func NewConsumerManager(
@@ -65,6 +80,13 @@ func NewConsumerManager(
 		consumers:       make(map[string]*InferKafkaHandler),
 		maxNumConsumers: maxNumConsumers,
 	}
+	if managerConfig.Transport == nil && managerConfig.SeldonKafkaConfig.IsInProcessTransport() {
+		managerConfig.Transport = transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic)
+	}
+	if managerConfig.Transport != nil {
+		cm.transport = managerConfig.Transport
+		return cm, nil
+	}
 	err := cm.createKafkaConfigs(managerConfig)
 	if err != nil {
 		return nil, err
@@ -78,6 +100,9 @@ func (cm *ConsumerManager) createKafkaConfigs(kafkaConfig *ManagerConfig) error
 
 	producerConfig := config.CloneKafkaConfigMap(kafkaConfig.SeldonKafkaConfig.Producer)
 	producerConfig["go.delivery.reports"] = true
+	if kafkaConfig.Idempotent {
+		producerConfig["enable.idempotence"] = true
+	}
 	err = config.AddKafkaSSLOptions(producerConfig)
 	if err != nil {
 		return err
@@ -105,8 +130,7 @@ func (cm *ConsumerManager) createKafkaConfigs(kafkaConfig *ManagerConfig) error
 		logger.WithField("config", string(consumerConfigMaskedJson)).Info("Creating consumer config for use later")
 	}
 
-	cm.consumerConfigMap = consumerConfig
-	cm.producerConfigMap = producerConfig
+	cm.transport = transport.NewKafkaTransport(cm.logger, consumerConfig, producerConfig)
 	return nil
 }
 
@@ -129,8 +153,7 @@ func (cm *ConsumerManager) getInferKafkaConsumer(modelName string, create bool)
 		var err error
 		ic, err = NewInferKafkaHandler(cm.logger,
 			cm.managerConfig,
-			cloneKafkaConfigMap(cm.consumerConfigMap),
-			cloneKafkaConfigMap(cm.producerConfigMap),
+			cm.transport,
 			consumerBucketId)
 		if err != nil {
 			return nil, err
@@ -143,7 +166,7 @@ func (cm *ConsumerManager) getInferKafkaConsumer(modelName string, create bool)
 	return ic, nil
 }
 
-func (cm *ConsumerManager) AddModel(modelName string) error {
+func (cm *ConsumerManager) AddModel(modelName string, streamSpec *scheduler.StreamSpec) error {
 	cm.mu.Lock()
 	defer cm.mu.Unlock()
 
@@ -151,7 +174,7 @@ func (cm *ConsumerManager) AddModel(modelName string) error {
 	if err != nil {
 		return err
 	}
-	err = ic.AddModel(modelName)
+	err = ic.AddModel(modelName, streamSpec)
 	if err != nil {
 		return err
 	}
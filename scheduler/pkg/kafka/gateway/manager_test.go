@@ -70,7 +70,7 @@ func TestAddRemoveModel(t *testing.T) {
 			cm, err := NewConsumerManager(logger, c, test.numConsumers)
 			g.Expect(err).To(BeNil())
 			for _, model := range test.models {
-				err := cm.AddModel(model)
+				err := cm.AddModel(model, nil)
 				g.Expect(err).To(BeNil())
 			}
 			g.Expect(len(cm.consumers)).To(Equal(test.runningConsumers))
@@ -20,10 +20,9 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
-	"github.com/signalfx/splunk-otel-go/instrumentation/github.com/confluentinc/confluent-kafka-go/v2/kafka/splunkkafka"
 	log "github.com/sirupsen/logrus"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
@@ -40,6 +39,8 @@ import (
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
 	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
 	pipeline "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/serde"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
 	seldontracer "github.com/seldonio/seldon-core/scheduler/v2/pkg/tracing"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
@@ -55,9 +56,13 @@ type InferWorker struct {
 }
 
 type InferWork struct {
-	modelName string
-	headers   map[string]string
-	msg       *kafka.Message
+	modelName      string
+	headers        map[string]string
+	msg            *transport.Message
+	idempotencyKey string
+	lowPriority    bool // read from the low priority input topic of the model
+	failed         bool // set when an error response is produced
+	rateLimited    bool // set when the job has reserved its turn in the rate limit of the model
 }
 
 type V2Error struct {
@@ -162,28 +167,84 @@ func getProtoInferRequest(job *InferWork) (*v2.ModelInferRequest, error) {
 // Extract tracing context from Kafka message
 func createContextFromKafkaMsg(job *InferWork) context.Context {
 	ctx := context.Background()
-	carrierIn := splunkkafka.NewMessageCarrier(job.msg)
+	carrierIn := transport.NewMessageCarrier(job.msg)
 	ctx = otel.GetTextMapPropagator().Extract(ctx, carrierIn)
 	return ctx
 }
 
-func (iw *InferWorker) Start(jobChan <-chan *InferWork, cancelChan <-chan struct{}) {
+// Start processes jobs until cancelled, taking low priority jobs only when no high priority jobs are waiting
+func (iw *InferWorker) Start(jobChan <-chan *InferWork, lowPriorityJobChan <-chan *InferWork, cancelChan <-chan struct{}) {
 	for {
 		select {
 		case <-cancelChan:
 			return
+		case job := <-jobChan:
+			iw.process(job)
+			continue
+		default:
+		}
 
+		select {
+		case <-cancelChan:
+			return
 		case job := <-jobChan:
-			ctx := createContextFromKafkaMsg(job)
-			err := iw.processRequest(ctx, job)
-			if err != nil {
-				iw.logger.WithError(err).Errorf("Failed to process request for model %s", job.modelName)
-			}
+			iw.process(job)
+		case job := <-lowPriorityJobChan:
+			iw.process(job)
 		}
 	}
 }
 
+func (iw *InferWorker) process(job *InferWork) {
+	if delay := iw.reserveRateLimit(job); delay > 0 {
+		iw.consumer.requeueAfter(job, delay)
+		return
+	}
+	ctx := createContextFromKafkaMsg(job)
+	start := time.Now()
+	// time spent waiting for the rate limit is counted as queue time rather than service time
+	metrics := iw.consumer.consumerConfig.Metrics
+	pipelineName := job.headers[resources.SeldonPipelineHeader]
+	if queueTime, ok := kafka2.GetQueueTime(job.msg, start); ok && metrics != nil {
+		metrics.AddQueueTime(job.modelName, pipelineName, queueTime.Seconds())
+	}
+	err := iw.processRequest(ctx, job)
+	if err != nil {
+		iw.logger.WithError(err).Errorf("Failed to process request for model %s", job.modelName)
+	}
+	serviceTime := time.Since(start)
+	if metrics != nil {
+		metrics.AddServiceTime(job.modelName, pipelineName, serviceTime.Seconds())
+	}
+	iw.consumer.limiter.Done(serviceTime, err != nil || job.failed, job.lowPriority)
+}
+
+// reserveRateLimit takes the turn of the job in the rate limit of its model and returns how long the job must wait
+func (iw *InferWorker) reserveRateLimit(job *InferWork) time.Duration {
+	if job.rateLimited {
+		return 0
+	}
+	limiter := iw.consumer.getRateLimiter(job.modelName)
+	if limiter == nil {
+		return 0
+	}
+	job.rateLimited = true
+	delay := limiter.Reserve().Delay()
+	if delay > 0 && iw.consumer.consumerConfig.Metrics != nil {
+		iw.consumer.consumerConfig.Metrics.AddRateLimited(job.modelName)
+	}
+	return delay
+}
+
 func (iw *InferWorker) processRequest(ctx context.Context, job *InferWork) error {
+	// Large requests are held in the claim check store with a reference in the headers
+	err := iw.consumer.consumerConfig.ClaimChecker.Rehydrate(ctx, job.msg)
+	if err != nil {
+		return iw.produce(ctx, job, iw.topicNamer.GetModelErrorTopic(), []byte(err.Error()), true, nil)
+	}
+	// requests may be in the schema registry wire format
+	job.msg.Value = serde.Unwrap(job.msg.Value)
+
 	// Has Type Header
 	if typeValue, ok := job.headers[HeaderKeyType]; ok {
 		switch typeValue {
@@ -216,7 +277,7 @@ func (iw *InferWorker) processRequest(ctx context.Context, job *InferWork) error
 	}
 }
 
-func existsKafkaHeader(headers []kafka.Header, key string, val string) bool {
+func existsKafkaHeader(headers []transport.Header, key string, val string) bool {
 	for _, header := range headers {
 		if header.Key == key && string(header.Value) == val {
 			return true
@@ -235,19 +296,30 @@ func (iw *InferWorker) produce(
 ) error {
 	logger := iw.logger.WithField("func", "produce")
 
-	kafkaHeaders := job.msg.Headers
+	// Replace any idempotency key set by the previous step with the key for this inference
+	var kafkaHeaders []transport.Header
+	for _, header := range job.msg.Headers {
+		if header.Key != HeaderKeyIdempotencyKey {
+			kafkaHeaders = append(kafkaHeaders, header)
+		}
+	}
+	if job.idempotencyKey != "" {
+		kafkaHeaders = append(kafkaHeaders, transport.Header{Key: HeaderKeyIdempotencyKey, Value: []byte(job.idempotencyKey)})
+	}
 	if errorTopic {
-		kafkaHeaders = append(kafkaHeaders, kafka.Header{Key: kafka2.TopicErrorHeader, Value: []byte(job.modelName)})
+		job.failed = true
+		kafkaHeaders = append(kafkaHeaders, transport.Header{Key: kafka2.TopicErrorHeader, Value: []byte(job.modelName)})
 	}
 
 	for k, vs := range headers {
 		for _, v := range vs {
 			if !existsKafkaHeader(kafkaHeaders, k, v) {
 				logger.Debugf("Adding header to kafka response %s:%s", k, v)
-				kafkaHeaders = append(kafkaHeaders, kafka.Header{Key: k, Value: []byte(v)})
+				kafkaHeaders = append(kafkaHeaders, transport.Header{Key: k, Value: []byte(v)})
 			}
 		}
 	}
+	kafkaHeaders = kafka2.SetProduceTime(kafkaHeaders, time.Now())
 
 	if logger.Logger.IsLevelEnabled(log.DebugLevel) {
 		for _, h := range kafkaHeaders {
@@ -256,11 +328,16 @@ func (iw *InferWorker) produce(
 	}
 	logger.Infof("Produce response to topic %s", topic)
 
-	msg := &kafka.Message{
-		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
-		Key:            job.msg.Key,
-		Value:          b,
-		Headers:        kafkaHeaders,
+	msg := &transport.Message{
+		Topic:   topic,
+		Key:     job.msg.Key,
+		Value:   b,
+		Headers: kafkaHeaders,
+	}
+
+	err := iw.consumer.consumerConfig.ClaimChecker.Offload(ctx, msg)
+	if err != nil {
+		logger.WithError(err).Warnf("Failed to offload response for topic %s, sending inline", topic)
 	}
 
 	ctx, span := iw.tracer.Start(ctx, "Produce")
@@ -269,23 +346,52 @@ func (iw *InferWorker) produce(
 		logger.Warnf("Missing request id in Kafka headers for key %s", string(job.msg.Key))
 	}
 	span.SetAttributes(attribute.String(util.RequestIdHeader, requestId))
-	carrierOut := splunkkafka.NewMessageCarrier(msg)
+	carrierOut := transport.NewMessageCarrier(msg)
 	otel.GetTextMapPropagator().Inject(ctx, carrierOut)
 
-	deliveryChan := make(chan kafka.Event)
-	err := iw.consumer.Produce(msg, deliveryChan)
+	err = iw.consumer.Produce(msg, func(err error) {
+		if err == nil {
+			iw.consumer.markProcessed(job.idempotencyKey)
+		}
+		span.End()
+	})
 	if err != nil {
 		iw.logger.WithError(err).Errorf("Failed to produce response for model %s", topic)
 		return err
 	}
-	go func() {
-		<-deliveryChan
-		span.End()
-	}()
 
 	return nil
 }
 
+// encode writes protobuf responses in the schema registry wire format if configured. Responses are written
+// unencoded if their schema can't be registered so inference is not interrupted.
+func (iw *InferWorker) encode(topic string, b []byte) []byte {
+	encoder := iw.consumer.consumerConfig.Encoder
+	if encoder == nil {
+		return b
+	}
+	encoded, err := encoder.Encode(topic, b)
+	if err != nil {
+		iw.logger.WithError(err).Warnf("Failed to encode response for topic %s, writing raw protobuf", topic)
+		return b
+	}
+	return encoded
+}
+
+// encodeJson converts REST responses to protobuf so they can be written in the schema registry wire format if
+// configured. Responses that can't be converted are written as JSON.
+func (iw *InferWorker) encodeJson(topic string, b []byte) []byte {
+	if iw.consumer.consumerConfig.Encoder == nil {
+		return b
+	}
+	converted, err := pipeline.ConvertResponseToV2Bytes(b)
+	if err != nil {
+		iw.logger.WithError(err).Warnf("Failed to convert response for topic %s to protobuf, writing JSON", topic)
+		return b
+	}
+	return iw.encode(topic, converted)
+}
+
 func (iw *InferWorker) restRequest(ctx context.Context, job *InferWork, maybeConvert bool) error {
 	logger := iw.logger.WithField("func", "restRequest")
 
@@ -309,6 +415,9 @@ func (iw *InferWorker) restRequest(ctx context.Context, job *InferWork, maybeCon
 
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set(resources.SeldonModelHeader, job.modelName)
+	if apiKey := iw.consumer.consumerConfig.InferenceServerConfig.APIKey; apiKey != "" {
+		req.Header.Set(resources.APIKeyHeader, apiKey)
+	}
 	if reqId, ok := job.headers[util.RequestIdHeader]; ok {
 		req.Header[util.RequestIdHeader] = []string{reqId}
 	}
@@ -335,26 +444,31 @@ func (iw *InferWorker) restRequest(ctx context.Context, job *InferWork, maybeCon
 		return iw.produce(ctx, job, iw.topicNamer.GetModelErrorTopic(), b, true, nil)
 	}
 
+	topic := iw.topicNamer.GetModelTopicOutputs(job.modelName)
 	return iw.produce(
 		ctx,
 		job,
-		iw.topicNamer.GetModelTopicOutputs(job.modelName),
-		b,
+		topic,
+		iw.encodeJson(topic, b),
 		false,
 		extractHeadersHttp(response.Header),
 	)
 }
 
 // Add all external headers to request metadata
-func addMetadataToOutgoingContext(ctx context.Context, job *InferWork, logger log.FieldLogger) context.Context {
+func addMetadataToOutgoingContext(ctx context.Context, job *InferWork, apiKey string, logger log.FieldLogger) context.Context {
 	for k, v := range job.headers {
 		if strings.HasPrefix(k, resources.ExternalHeaderPrefix) &&
-			k != resources.SeldonRouteHeader { // We don;t want to send x-seldon-route as this will confuse envoy
+			k != resources.SeldonRouteHeader && // We don;t want to send x-seldon-route as this will confuse envoy
+			k != resources.APIKeyHeader {
 			logger.Debugf("Adding outgoing ctx metadata %s:%s", k, v)
 			ctx = metadata.AppendToOutgoingContext(ctx, k, v)
 		}
 	}
 	ctx = metadata.AppendToOutgoingContext(ctx, resources.SeldonModelHeader, job.modelName)
+	if apiKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, resources.APIKeyHeader, apiKey)
+	}
 	return ctx
 }
 
@@ -365,7 +479,7 @@ func (iw *InferWorker) grpcRequest(ctx context.Context, job *InferWork, req *v2.
 	req.ModelName = job.modelName
 	req.ModelVersion = fmt.Sprintf("%d", util.GetPinnedModelVersion())
 
-	ctx = addMetadataToOutgoingContext(ctx, job, logger)
+	ctx = addMetadataToOutgoingContext(ctx, job, iw.consumer.consumerConfig.InferenceServerConfig.APIKey, logger)
 
 	var header, trailer metadata.MD
 	opts := append(iw.callOptions, grpc.Header(&header))
@@ -379,11 +493,12 @@ func (iw *InferWorker) grpcRequest(ctx context.Context, job *InferWork, req *v2.
 	if err != nil {
 		return err
 	}
+	topic := iw.topicNamer.GetModelTopicOutputs(job.modelName)
 	return iw.produce(
 		ctx,
 		job,
-		iw.topicNamer.GetModelTopicOutputs(job.modelName),
-		b,
+		topic,
+		iw.encode(topic, b),
 		false,
 		extractHeadersGrpc(header, trailer),
 	)
@@ -15,8 +15,9 @@ import (
 	"net"
 	"net/http"
 	"testing"
+	"time"
 
-	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
 	"github.com/jarcoal/httpmock"
 	. "github.com/onsi/gomega"
 	log "github.com/sirupsen/logrus"
@@ -32,7 +33,10 @@ import (
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
 	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/claimcheck"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/serde"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
 	seldontracer "github.com/seldonio/seldon-core/scheduler/v2/pkg/tracing"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
@@ -82,17 +86,96 @@ func TestRestRequest(t *testing.T) {
 			tp, err := seldontracer.NewTraceProvider("test", nil, logger)
 			g.Expect(err).To(BeNil())
 			config := &ManagerConfig{SeldonKafkaConfig: &config.KafkaConfig{}, Namespace: "default", InferenceServerConfig: &kafkaServerConfig, TraceProvider: tp, NumWorkers: 0}
-			ic, err := NewInferKafkaHandler(logger, config, kafka.ConfigMap{}, kafka.ConfigMap{}, "dummy")
+			ic, err := NewInferKafkaHandler(logger, config, transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic), "dummy")
 			g.Expect(err).To(BeNil())
 			tn, err := kafka2.NewTopicNamer("default", "seldon")
 			g.Expect(err).To(BeNil())
 			iw, err := NewInferWorker(ic, logger, tp, tn)
 			g.Expect(err).To(BeNil())
-			err = iw.restRequest(context.Background(), &InferWork{modelName: "foo", msg: &kafka.Message{Value: test.data}}, false)
+			err = iw.restRequest(context.Background(), &InferWork{modelName: "foo", msg: &transport.Message{Value: test.data}}, false)
 			g.Expect(err).To(BeNil())
 			ic.Stop()
 			g.Expect(httpmock.GetTotalCallCount()).To(Equal(1))
-			g.Expect(ic.producer.Len()).To(Equal(1))
+			g.Expect(numProducedMessages(ic)).To(Equal(1))
+		})
+	}
+}
+
+func TestRestRequestSchemaRegistry(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name             string
+		response         string
+		expectedResponse *v2.ModelInferResponse
+	}
+	tests := []test{
+		{
+			name:     "V2 response is written as encoded protobuf",
+			response: `{"model_name":"foo","id":"1","outputs":[{"name":"out1","shape":[2],"datatype":"INT32","data":[1,2]}]}`,
+			expectedResponse: &v2.ModelInferResponse{
+				ModelName:  "foo",
+				Id:         "1",
+				Parameters: map[string]*v2.InferParameter{},
+				Outputs: []*v2.ModelInferResponse_InferOutputTensor{
+					{
+						Name:       "out1",
+						Datatype:   "INT32",
+						Shape:      []int64{2},
+						Parameters: map[string]*v2.InferParameter{},
+						Contents:   &v2.InferTensorContents{IntContents: []int32{1, 2}},
+					},
+				},
+			},
+		},
+		{
+			name:     "response which is not V2 is written as JSON",
+			response: `not json`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+			kafkaServerConfig := InferenceServerConfig{
+				Host:     "0.0.0.0",
+				HttpPort: 1234,
+				GrpcPort: 1235,
+			}
+			httpmock.RegisterResponder("POST", fmt.Sprintf("http://%s:%d/v2/models/foo/infer", kafkaServerConfig.Host, kafkaServerConfig.HttpPort),
+				httpmock.NewStringResponder(http.StatusOK, test.response))
+			logger := log.New()
+			tp, err := seldontracer.NewTraceProvider("test", nil, logger)
+			g.Expect(err).To(BeNil())
+			registry, err := schemaregistry.NewClient(schemaregistry.NewConfig("mock://"))
+			g.Expect(err).To(BeNil())
+			encoder, err := serde.NewEncoder(registry)
+			g.Expect(err).To(BeNil())
+			config := &ManagerConfig{SeldonKafkaConfig: &config.KafkaConfig{}, Namespace: "default", InferenceServerConfig: &kafkaServerConfig, TraceProvider: tp, NumWorkers: 0, Encoder: encoder}
+			ic, err := NewInferKafkaHandler(logger, config, transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic), "dummy")
+			g.Expect(err).To(BeNil())
+			defer ic.Stop()
+			tn, err := kafka2.NewTopicNamer("default", "seldon")
+			g.Expect(err).To(BeNil())
+			iw, err := NewInferWorker(ic, logger, tp, tn)
+			g.Expect(err).To(BeNil())
+			err = iw.restRequest(context.Background(), &InferWork{modelName: "foo", msg: &transport.Message{Value: []byte("{}")}}, false)
+			g.Expect(err).To(BeNil())
+
+			consumer, err := ic.transport.NewConsumer("test")
+			g.Expect(err).To(BeNil())
+			g.Expect(consumer.Subscribe([]string{"seldon.default.model.foo.outputs"})).To(BeNil())
+			msg, err := consumer.Poll(time.Second)
+			g.Expect(err).To(BeNil())
+			g.Expect(msg).ToNot(BeNil())
+			if test.expectedResponse == nil {
+				g.Expect(string(msg.Value)).To(Equal(test.response))
+				return
+			}
+			g.Expect(msg.Value[0]).To(Equal(byte(0)))
+			response := &v2.ModelInferResponse{}
+			g.Expect(proto.Unmarshal(serde.Unwrap(msg.Value), response)).To(BeNil())
+			g.Expect(proto.Equal(response, test.expectedResponse)).To(BeTrue())
 		})
 	}
 }
@@ -129,17 +212,17 @@ func TestProcessRequestRest(t *testing.T) {
 			tp, err := seldontracer.NewTraceProvider("test", nil, logger)
 			g.Expect(err).To(BeNil())
 			config := &ManagerConfig{SeldonKafkaConfig: &config.KafkaConfig{}, Namespace: "default", InferenceServerConfig: &kafkaServerConfig, TraceProvider: tp, NumWorkers: 0}
-			ic, err := NewInferKafkaHandler(logger, config, kafka.ConfigMap{}, kafka.ConfigMap{}, "dummy")
+			ic, err := NewInferKafkaHandler(logger, config, transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic), "dummy")
 			g.Expect(err).To(BeNil())
 			tn, err := kafka2.NewTopicNamer("default", "seldon")
 			g.Expect(err).To(BeNil())
 			iw, err := NewInferWorker(ic, logger, tp, tn)
 			g.Expect(err).To(BeNil())
-			err = iw.processRequest(context.Background(), &InferWork{modelName: "foo", msg: &kafka.Message{Value: test.data}})
+			err = iw.processRequest(context.Background(), &InferWork{modelName: "foo", msg: &transport.Message{Value: test.data}})
 			g.Expect(err).To(BeNil())
 			ic.Stop()
 			g.Eventually(httpmock.GetTotalCallCount).Should(Equal(1))
-			g.Eventually(ic.producer.Len).Should(Equal(1))
+			g.Eventually(func() int { return numProducedMessages(ic) }).Should(Equal(1))
 		})
 	}
 }
@@ -203,7 +286,7 @@ func createInferWorkerWithMockConn(
 	tp, err := seldontracer.NewTraceProvider("test", nil, logger)
 	g.Expect(err).To(BeNil())
 	config := &ManagerConfig{SeldonKafkaConfig: &config.KafkaConfig{}, Namespace: "default", InferenceServerConfig: serverConfig, TraceProvider: tp, NumWorkers: 0}
-	ic, err := NewInferKafkaHandler(logger, config, kafka.ConfigMap{}, kafka.ConfigMap{}, "dummy")
+	ic, err := NewInferKafkaHandler(logger, config, transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic), "dummy")
 	g.Expect(err).To(BeNil())
 	topicNamer, err := kafka2.NewTopicNamer("default", "seldon")
 	g.Expect(err).To(BeNil())
@@ -267,10 +350,10 @@ func TestProcessRequestGrpc(t *testing.T) {
 			g.Eventually(check).Should(BeTrue())
 			b, err := proto.Marshal(test.req)
 			g.Expect(err).To(BeNil())
-			err = iw.processRequest(context.Background(), &InferWork{modelName: "foo", msg: &kafka.Message{Value: b}})
+			err = iw.processRequest(context.Background(), &InferWork{modelName: "foo", msg: &transport.Message{Value: b}})
 			g.Expect(err).To(BeNil())
 			g.Eventually(func() int { return mockMLGrpcServer.recv }).Should(Equal(1))
-			g.Eventually(ic.producer.Len).Should(Equal(1))
+			g.Eventually(func() int { return numProducedMessages(ic) }).Should(Equal(1))
 			t.Log("End test", test.name)
 		})
 	}
@@ -321,7 +404,7 @@ func TestProcessRequest(t *testing.T) {
 			job: &InferWork{
 				modelName: "foo",
 				headers:   make(map[string]string),
-				msg:       &kafka.Message{Value: []byte{}, Key: []byte{}},
+				msg:       &transport.Message{Value: []byte{}, Key: []byte{}},
 			},
 			grpcCalls: 1,
 		},
@@ -330,7 +413,7 @@ func TestProcessRequest(t *testing.T) {
 			job: &InferWork{
 				modelName: "foo",
 				headers:   make(map[string]string),
-				msg:       &kafka.Message{Value: []byte("{}"), Key: []byte{}},
+				msg:       &transport.Message{Value: []byte("{}"), Key: []byte{}},
 			},
 			restCalls: 1,
 		},
@@ -339,7 +422,7 @@ func TestProcessRequest(t *testing.T) {
 			job: &InferWork{
 				modelName: "foo",
 				headers:   make(map[string]string),
-				msg:       &kafka.Message{Value: []byte(`{"inputs": [{"name": "predict", "shape": [1, 4], "datatype": "FP32", "data": [[1, 2, 3, 4]]}]}`), Key: []byte{}},
+				msg:       &transport.Message{Value: []byte(`{"inputs": [{"name": "predict", "shape": [1, 4], "datatype": "FP32", "data": [[1, 2, 3, 4]]}]}`), Key: []byte{}},
 			},
 			restCalls: 1,
 		},
@@ -348,7 +431,7 @@ func TestProcessRequest(t *testing.T) {
 			job: &InferWork{
 				modelName: "foo",
 				headers:   make(map[string]string),
-				msg:       &kafka.Message{Value: []byte(`{"model_name":"iris_1","model_version":"1","id":"903964e4-2419-41ce-b5d1-3ca0c8df9e0c","parameters":null,"outputs":[{"name":"predict","shape":[1],"datatype":"INT64","parameters":null,"data":[2]}]}`), Key: []byte{}},
+				msg:       &transport.Message{Value: []byte(`{"model_name":"iris_1","model_version":"1","id":"903964e4-2419-41ce-b5d1-3ca0c8df9e0c","parameters":null,"outputs":[{"name":"predict","shape":[1],"datatype":"INT64","parameters":null,"data":[2]}]}`), Key: []byte{}},
 			},
 			restCalls: 1,
 		},
@@ -357,7 +440,7 @@ func TestProcessRequest(t *testing.T) {
 			job: &InferWork{
 				modelName: "foo",
 				headers:   map[string]string{HeaderKeyType: HeaderValueJsonReq},
-				msg:       &kafka.Message{Value: []byte(`{"inputs": [{"name": "predict", "shape": [1, 4], "datatype": "FP32", "data": [[1, 2, 3, 4]]}]}`), Key: []byte{}},
+				msg:       &transport.Message{Value: []byte(`{"inputs": [{"name": "predict", "shape": [1, 4], "datatype": "FP32", "data": [[1, 2, 3, 4]]}]}`), Key: []byte{}},
 			},
 			restCalls: 1,
 		},
@@ -366,7 +449,7 @@ func TestProcessRequest(t *testing.T) {
 			job: &InferWork{
 				modelName: "foo",
 				headers:   map[string]string{HeaderKeyType: HeaderValueJsonRes},
-				msg:       &kafka.Message{Value: []byte(`{"model_name":"iris_1","model_version":"1","id":"903964e4-2419-41ce-b5d1-3ca0c8df9e0c","parameters":null,"outputs":[{"name":"predict","shape":[1],"datatype":"INT64","parameters":null,"data":[2]}]}`), Key: []byte{}},
+				msg:       &transport.Message{Value: []byte(`{"model_name":"iris_1","model_version":"1","id":"903964e4-2419-41ce-b5d1-3ca0c8df9e0c","parameters":null,"outputs":[{"name":"predict","shape":[1],"datatype":"INT64","parameters":null,"data":[2]}]}`), Key: []byte{}},
 			},
 			restCalls: 1,
 		},
@@ -375,7 +458,7 @@ func TestProcessRequest(t *testing.T) {
 			job: &InferWork{
 				modelName: "foo",
 				headers:   make(map[string]string),
-				msg:       &kafka.Message{Value: getProtoBytes(testRequest), Key: []byte{}},
+				msg:       &transport.Message{Value: getProtoBytes(testRequest), Key: []byte{}},
 			},
 			grpcCalls: 1,
 		},
@@ -384,7 +467,7 @@ func TestProcessRequest(t *testing.T) {
 			job: &InferWork{
 				modelName: "foo",
 				headers:   map[string]string{HeaderKeyType: HeaderValueProtoReq},
-				msg:       &kafka.Message{Value: getProtoBytes(testRequest), Key: []byte{}},
+				msg:       &transport.Message{Value: getProtoBytes(testRequest), Key: []byte{}},
 			},
 			grpcCalls: 1,
 		},
@@ -393,7 +476,7 @@ func TestProcessRequest(t *testing.T) {
 			job: &InferWork{
 				modelName: "foo",
 				headers:   make(map[string]string),
-				msg:       &kafka.Message{Value: getProtoBytes(testResponse), Key: []byte{}},
+				msg:       &transport.Message{Value: getProtoBytes(testResponse), Key: []byte{}},
 			},
 			grpcCalls: 1,
 		},
@@ -402,7 +485,7 @@ func TestProcessRequest(t *testing.T) {
 			job: &InferWork{
 				modelName: "foo",
 				headers:   map[string]string{HeaderKeyType: HeaderValueProtoRes},
-				msg:       &kafka.Message{Value: getProtoBytes(testResponse), Key: []byte{}},
+				msg:       &transport.Message{Value: getProtoBytes(testResponse), Key: []byte{}},
 			},
 			grpcCalls: 1,
 		},
@@ -411,7 +494,7 @@ func TestProcessRequest(t *testing.T) {
 			job: &InferWork{
 				modelName: "foo",
 				headers:   map[string]string{HeaderKeyType: HeaderValueProtoReq},
-				msg:       &kafka.Message{Value: []byte(`{"inputs": [{"name": "predict", "shape": [1, 4], "datatype": "FP32", "data": [[1, 2, 3, 4]]}]}`), Key: []byte{}},
+				msg:       &transport.Message{Value: []byte(`{"inputs": [{"name": "predict", "shape": [1, 4], "datatype": "FP32", "data": [[1, 2, 3, 4]]}]}`), Key: []byte{}},
 			},
 			error: true,
 		},
@@ -420,7 +503,7 @@ func TestProcessRequest(t *testing.T) {
 			job: &InferWork{
 				modelName: "foo",
 				headers:   map[string]string{HeaderKeyType: HeaderValueProtoRes},
-				msg:       &kafka.Message{Value: []byte(`{"inputs": [{"name": "predict", "shape": [1, 4], "datatype": "FP32", "data": [[1, 2, 3, 4]]}]}`), Key: []byte{}},
+				msg:       &transport.Message{Value: []byte(`{"inputs": [{"name": "predict", "shape": [1, 4], "datatype": "FP32", "data": [[1, 2, 3, 4]]}]}`), Key: []byte{}},
 			},
 			error: true,
 		},
@@ -429,7 +512,7 @@ func TestProcessRequest(t *testing.T) {
 			job: &InferWork{
 				modelName: "foo",
 				headers:   map[string]string{HeaderKeyType: HeaderValueJsonReq},
-				msg:       &kafka.Message{Value: getProtoBytes(testRequest), Key: []byte{}},
+				msg:       &transport.Message{Value: getProtoBytes(testRequest), Key: []byte{}},
 			},
 			restCalls: 1,
 		},
@@ -465,13 +548,17 @@ func TestProcessRequest(t *testing.T) {
 				g.Expect(err).To(BeNil())
 				g.Eventually(httpmock.GetTotalCallCount).Should(Equal(test.restCalls))
 				g.Eventually(func() int { return mockMLGrpcServer.recv }).Should(Equal(test.grpcCalls))
-				g.Eventually(ic.producer.Len).Should(Equal(1))
+				g.Eventually(func() int { return numProducedMessages(ic) }).Should(Equal(1))
 			}
 			t.Log("End test", test.name)
 		})
 	}
 }
 
+func numProducedMessages(ic *InferKafkaHandler) int {
+	return ic.transport.(*transport.InProcessTransport).NumMessages()
+}
+
 func TestAddMetadataToOutgoingContext(t *testing.T) {
 	g := NewGomegaWithT(t)
 
@@ -479,6 +566,7 @@ func TestAddMetadataToOutgoingContext(t *testing.T) {
 		name            string
 		ctx             context.Context
 		job             *InferWork
+		apiKey          string
 		expectedHeaders map[string][]string
 	}
 
@@ -507,11 +595,18 @@ func TestAddMetadataToOutgoingContext(t *testing.T) {
 			job:             &InferWork{modelName: "foo", headers: map[string]string{"myheader": "1234"}},
 			expectedHeaders: map[string][]string{resources.SeldonModelHeader: {"foo"}},
 		},
+		{
+			name:            "api key of gateway replaces api key of request",
+			ctx:             metadata.NewIncomingContext(context.TODO(), metadata.New(map[string]string{})),
+			job:             &InferWork{modelName: "foo", headers: map[string]string{resources.APIKeyHeader: "caller"}},
+			apiKey:          "gateway",
+			expectedHeaders: map[string][]string{resources.SeldonModelHeader: {"foo"}, resources.APIKeyHeader: {"gateway"}},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			logger := log.New()
-			ctx := addMetadataToOutgoingContext(test.ctx, test.job, logger)
+			ctx := addMetadataToOutgoingContext(test.ctx, test.job, test.apiKey, logger)
 			md, found := metadata.FromOutgoingContext(ctx)
 			g.Expect(found).To(BeTrue())
 			for k, v := range md {
@@ -522,3 +617,111 @@ func TestAddMetadataToOutgoingContext(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessRequestClaimCheck(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	logger := log.New()
+	kafkaServerConfig := InferenceServerConfig{
+		Host:     "0.0.0.0",
+		HttpPort: 1234,
+		GrpcPort: 1235,
+	}
+	kafkaModelConfig := KafkaModelConfig{
+		ModelName:   "foo",
+		InputTopic:  "input",
+		OutputTopic: "output",
+	}
+	mockMLGrpcServer := createMLMockGrpcServer(g)
+	defer mockMLGrpcServer.stop()
+	ic, iw := createInferWorkerWithMockConn(mockMLGrpcServer, logger, &kafkaServerConfig, &kafkaModelConfig, g)
+	defer ic.Stop()
+	g.Eventually(creatMockServerHealthFunc(mockMLGrpcServer)).Should(BeTrue())
+
+	ctx := context.Background()
+	store, err := claimcheck.NewFileStore(t.TempDir())
+	g.Expect(err).To(BeNil())
+	// A threshold of 1 byte offloads every response
+	ic.consumerConfig.ClaimChecker = claimcheck.NewClaimChecker(store, 1)
+	request, err := proto.Marshal(&v2.ModelInferRequest{ModelName: "foo"})
+	g.Expect(err).To(BeNil())
+	g.Expect(store.Put(ctx, "seldon.default.model.foo.inputs/1", request)).To(BeNil())
+
+	job := &InferWork{
+		modelName: "foo",
+		headers:   make(map[string]string),
+		msg: &transport.Message{
+			Key:     []byte{},
+			Value:   []byte{},
+			Headers: []transport.Header{{Key: claimcheck.HeaderKeyClaimCheck, Value: []byte("seldon.default.model.foo.inputs/1")}},
+		},
+	}
+	err = iw.processRequest(ctx, job)
+	g.Expect(err).To(BeNil())
+	g.Eventually(func() int { return mockMLGrpcServer.recv }).Should(Equal(1))
+
+	consumer, err := ic.transport.NewConsumer("test")
+	g.Expect(err).To(BeNil())
+	g.Expect(consumer.Subscribe([]string{"seldon.default.model.foo.outputs"})).To(BeNil())
+	msg, err := consumer.Poll(time.Second)
+	g.Expect(err).To(BeNil())
+	g.Expect(msg).ToNot(BeNil())
+	g.Expect(msg.Value).To(BeEmpty())
+	g.Expect(claimcheck.NewClaimChecker(store, 1).Rehydrate(ctx, msg)).To(BeNil())
+	response := &v2.ModelInferResponse{}
+	g.Expect(proto.Unmarshal(msg.Value, response)).To(BeNil())
+	g.Expect(response.ModelName).To(Equal("foo"))
+}
+
+func TestProcessRecordsQueueAndServiceTime(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	logger := log.New()
+	kafkaServerConfig := InferenceServerConfig{
+		Host:     "0.0.0.0",
+		HttpPort: 1234,
+		GrpcPort: 1235,
+	}
+	kafkaModelConfig := KafkaModelConfig{
+		ModelName:   "foo",
+		InputTopic:  "input",
+		OutputTopic: "output",
+	}
+	mockMLGrpcServer := createMLMockGrpcServer(g)
+	defer mockMLGrpcServer.stop()
+	ic, iw := createInferWorkerWithMockConn(mockMLGrpcServer, logger, &kafkaServerConfig, &kafkaModelConfig, g)
+	defer ic.Stop()
+	g.Eventually(creatMockServerHealthFunc(mockMLGrpcServer)).Should(BeTrue())
+	fakeMetrics := newFakeModelGatewayMetrics()
+	ic.consumerConfig.Metrics = fakeMetrics
+
+	request, err := proto.Marshal(&v2.ModelInferRequest{ModelName: "foo"})
+	g.Expect(err).To(BeNil())
+	job := &InferWork{
+		modelName: "foo",
+		headers:   map[string]string{resources.SeldonPipelineHeader: "p1"},
+		msg: &transport.Message{
+			Key:     []byte{},
+			Value:   request,
+			Headers: kafka2.SetProduceTime(nil, time.Now().Add(-time.Second)),
+		},
+	}
+	ic.limiter.Start(false)
+	iw.process(job)
+	g.Eventually(func() int { return mockMLGrpcServer.recv }).Should(Equal(1))
+
+	queueTimes, serviceTimes := fakeMetrics.getTimings("foo/p1")
+	g.Expect(queueTimes).To(Equal(1))
+	g.Expect(serviceTimes).To(Equal(1))
+
+	// the response carries the time it was produced rather than the time of the request
+	consumer, err := ic.transport.NewConsumer("test")
+	g.Expect(err).To(BeNil())
+	g.Expect(consumer.Subscribe([]string{"seldon.default.model.foo.outputs"})).To(BeNil())
+	msg, err := consumer.Poll(time.Second)
+	g.Expect(err).To(BeNil())
+	g.Expect(msg).ToNot(BeNil())
+	queueTime, ok := kafka2.GetQueueTime(msg, time.Now())
+	g.Expect(ok).To(BeTrue())
+	g.Expect(queueTime).To(BeNumerically("<", time.Second))
+}
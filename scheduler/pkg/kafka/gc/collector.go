@@ -0,0 +1,313 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package gc
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/pipeline"
+)
+
+const (
+	DefaultGracePeriod    = 24 * time.Hour
+	collectTimeout        = 5 * time.Minute
+	dataflowAppIdInfix    = "seldon-dataflow-"
+	maxDataflowAppIdChars = 16
+)
+
+// KafkaGarbageCollector deletes the topics and consumer groups of models and pipelines that have been deleted
+// for longer than a grace period.
+//
+// Topics of a model or pipeline are kept while it exists. The consumer group and internal topics of the dataflow
+// engine are kept while the pipeline version they were created for is not terminated. Owners with no known deletion
+// time, such as models no longer in the store, are timed from when the collector first finds their resources
+// orphaned. This includes models not yet resent after a scheduler restart, which the grace period protects.
+type KafkaGarbageCollector struct {
+	logger              log.FieldLogger
+	admin               transport.Admin
+	topicNamer          *kafka.TopicNamer
+	modelStore          modelLister
+	pipelineHandler     pipelineLister
+	dataflowAppIdPrefix string
+	gracePeriod         time.Duration
+	mu                  sync.Mutex
+	orphanedSince       map[string]time.Time
+	done                chan struct{}
+}
+
+type modelLister interface {
+	GetModels() ([]*store.ModelSnapshot, error)
+}
+
+type pipelineLister interface {
+	GetPipelines() ([]*pipeline.Pipeline, error)
+}
+
+type OrphanedResource struct {
+	Name     string
+	Owner    string
+	Orphaned time.Duration
+	Deleted  bool
+}
+
+type CollectResult struct {
+	Topics         []*OrphanedResource
+	ConsumerGroups []*OrphanedResource
+}
+
+func NewKafkaGarbageCollector(
+	logger log.FieldLogger,
+	admin transport.Admin,
+	topicNamer *kafka.TopicNamer,
+	modelStore store.ModelStore,
+	pipelineHandler pipeline.PipelineHandler,
+	namespace string,
+	consumerGroupIdPrefix string,
+	gracePeriod time.Duration,
+) *KafkaGarbageCollector {
+	return &KafkaGarbageCollector{
+		logger:              logger.WithField("source", "KafkaGarbageCollector"),
+		admin:               admin,
+		topicNamer:          topicNamer,
+		modelStore:          modelStore,
+		pipelineHandler:     pipelineHandler,
+		dataflowAppIdPrefix: getDataflowAppIdPrefix(namespace, consumerGroupIdPrefix),
+		gracePeriod:         gracePeriod,
+		orphanedSince:       make(map[string]time.Time),
+		done:                make(chan struct{}),
+	}
+}
+
+// getDataflowAppIdPrefix matches the application id the dataflow engine uses for its consumer groups and internal topics
+func getDataflowAppIdPrefix(namespace string, consumerGroupIdPrefix string) string {
+	var sb strings.Builder
+	if consumerGroupIdPrefix != "" {
+		sb.WriteString(consumerGroupIdPrefix + "-")
+	}
+	if namespace != "" {
+		sb.WriteString(namespace + "-")
+	}
+	sb.WriteString(dataflowAppIdInfix)
+	return sb.String()
+}
+
+// getDataflowAppId matches the hashing of pipeline version ids by the dataflow engine
+func getDataflowAppId(uid string) string {
+	if len(uid) <= maxDataflowAppIdChars {
+		return uid
+	}
+	sum := md5.Sum([]byte(uid))
+	hash := new(big.Int).SetBytes(sum[:]).Text(16)
+	if len(hash) < maxDataflowAppIdChars {
+		hash = strings.Repeat("0", maxDataflowAppIdChars-len(hash)) + hash
+	}
+	return hash
+}
+
+func (c *KafkaGarbageCollector) GracePeriod() time.Duration {
+	return c.gracePeriod
+}
+
+// Start collects garbage every interval until Stop is called
+func (c *KafkaGarbageCollector) Start(interval time.Duration) {
+	logger := c.logger.WithField("func", "Start")
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+			_, err := c.Collect(ctx, false, c.gracePeriod)
+			cancel()
+			if err != nil {
+				logger.WithError(err).Warn("Kafka garbage collection failed")
+			}
+		}
+	}
+}
+
+func (c *KafkaGarbageCollector) Stop() {
+	close(c.done)
+	c.admin.Close()
+}
+
+type owners struct {
+	models            map[string]bool
+	pipelines         map[string]bool
+	pipelineDeleted   map[string]time.Time
+	dataflowApps      map[string]bool
+	dataflowOwners    map[string]string
+	dataflowAppsEnded map[string]time.Time
+}
+
+func (c *KafkaGarbageCollector) getOwners() (*owners, error) {
+	o := &owners{
+		models:            make(map[string]bool),
+		pipelines:         make(map[string]bool),
+		pipelineDeleted:   make(map[string]time.Time),
+		dataflowApps:      make(map[string]bool),
+		dataflowOwners:    make(map[string]string),
+		dataflowAppsEnded: make(map[string]time.Time),
+	}
+	models, err := c.modelStore.GetModels()
+	if err != nil {
+		return nil, err
+	}
+	for _, model := range models {
+		if !model.Deleted {
+			o.models[model.Name] = true
+		}
+	}
+	pipelines, err := c.pipelineHandler.GetPipelines()
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range pipelines {
+		for _, pv := range p.Versions {
+			if pv.State == nil {
+				continue
+			}
+			appId := getDataflowAppId(pv.UID)
+			o.dataflowOwners[appId] = pv.String()
+			if pv.State.Status == pipeline.PipelineTerminated {
+				o.dataflowAppsEnded[appId] = pv.State.Timestamp
+				continue
+			}
+			o.dataflowApps[appId] = true
+			o.pipelines[p.Name] = true
+			// steps of running pipelines keep reading model topics even if the model is deleted
+			for stepName := range pv.Steps {
+				o.models[stepName] = true
+			}
+		}
+		if latest := p.GetLatestPipelineVersion(); latest != nil && latest.State != nil && !o.pipelines[p.Name] {
+			o.pipelineDeleted[p.Name] = latest.State.Timestamp
+		}
+	}
+	return o, nil
+}
+
+// findOwner returns the owner of a Seldon topic or consumer group, whether the owner is deleted and when it was
+// deleted if known. Resources not created by Seldon for this namespace have no owner.
+func (c *KafkaGarbageCollector) findOwner(name string, o *owners) (owner string, deleted bool, deletedAt time.Time) {
+	if isModel, ownerName, ok := c.topicNamer.GetOwnerFromTopic(name); ok {
+		if isModel {
+			return "model " + ownerName, !o.models[ownerName], time.Time{}
+		}
+		return "pipeline " + ownerName, !o.pipelines[ownerName], o.pipelineDeleted[ownerName]
+	}
+	if rest, ok := strings.CutPrefix(name, c.dataflowAppIdPrefix); ok && rest != "" {
+		// consumer groups are named by the application id and internal topics start with it
+		appId, _, _ := strings.Cut(rest, "-")
+		owner, ok := o.dataflowOwners[appId]
+		if !ok {
+			owner = "dataflow " + c.dataflowAppIdPrefix + appId
+		} else {
+			owner = "pipeline " + owner
+		}
+		return owner, !o.dataflowApps[appId], o.dataflowAppsEnded[appId]
+	}
+	return "", false, time.Time{}
+}
+
+// Collect finds the orphaned topics and consumer groups and deletes those orphaned for longer than the grace period
+// unless this is a dry run
+func (c *KafkaGarbageCollector) Collect(ctx context.Context, dryRun bool, gracePeriod time.Duration) (*CollectResult, error) {
+	logger := c.logger.WithField("func", "Collect")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	o, err := c.getOwners()
+	if err != nil {
+		return nil, err
+	}
+	topics, err := c.admin.ListTopics(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := c.admin.ListConsumerGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	orphanedSince := make(map[string]time.Time)
+	findOrphans := func(kind string, names []string) ([]*OrphanedResource, []string) {
+		var orphans []*OrphanedResource
+		var expired []string
+		for _, name := range names {
+			owner, deleted, deletedAt := c.findOwner(name, o)
+			if !deleted {
+				continue
+			}
+			key := kind + ":" + name
+			since, ok := c.orphanedSince[key]
+			if !ok {
+				since = now
+			}
+			if !deletedAt.IsZero() && deletedAt.Before(since) {
+				since = deletedAt
+			}
+			orphanedSince[key] = since
+			orphan := &OrphanedResource{Name: name, Owner: owner, Orphaned: now.Sub(since)}
+			orphans = append(orphans, orphan)
+			if orphan.Orphaned >= gracePeriod {
+				expired = append(expired, name)
+			}
+		}
+		return orphans, expired
+	}
+	result := &CollectResult{}
+	var expiredTopics, expiredGroups []string
+	result.Topics, expiredTopics = findOrphans("topic", topics)
+	result.ConsumerGroups, expiredGroups = findOrphans("group", groups)
+	c.orphanedSince = orphanedSince
+
+	if dryRun {
+		return result, nil
+	}
+	deletedTopics, err := c.admin.DeleteTopics(ctx, expiredTopics)
+	markDeleted(result.Topics, deletedTopics)
+	if err != nil {
+		return result, err
+	}
+	deletedGroups, err := c.admin.DeleteConsumerGroups(ctx, expiredGroups)
+	markDeleted(result.ConsumerGroups, deletedGroups)
+	if len(deletedTopics)+len(deletedGroups) > 0 {
+		logger.Infof("Deleted orphaned Kafka topics %v and consumer groups %v", deletedTopics, deletedGroups)
+	}
+	if err != nil {
+		return result, fmt.Errorf("deleted topics but not all consumer groups: %w", err)
+	}
+	return result, nil
+}
+
+func markDeleted(orphans []*OrphanedResource, deleted []string) {
+	deletedNames := make(map[string]bool)
+	for _, name := range deleted {
+		deletedNames[name] = true
+	}
+	for _, orphan := range orphans {
+		orphan.Deleted = deletedNames[orphan.Name]
+	}
+}
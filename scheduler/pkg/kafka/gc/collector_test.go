@@ -0,0 +1,242 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package gc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/pipeline"
+)
+
+type fakeModelLister struct {
+	models []*store.ModelSnapshot
+}
+
+func (f *fakeModelLister) GetModels() ([]*store.ModelSnapshot, error) {
+	return f.models, nil
+}
+
+type fakePipelineLister struct {
+	pipelines []*pipeline.Pipeline
+}
+
+func (f *fakePipelineLister) GetPipelines() ([]*pipeline.Pipeline, error) {
+	return f.pipelines, nil
+}
+
+func TestGetDataflowAppId(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(getDataflowAppId("short")).To(Equal("short"))
+	// md5 of the xid as hex, as computed by the dataflow engine
+	g.Expect(getDataflowAppId("cn0sn6mhrcdc73a0dpug")).To(HaveLen(32))
+	g.Expect(getDataflowAppId("cn0sn6mhrcdc73a0dpug")).To(Equal(getDataflowAppId("cn0sn6mhrcdc73a0dpug")))
+	g.Expect(getDataflowAppIdPrefix("default", "")).To(Equal("default-seldon-dataflow-"))
+	g.Expect(getDataflowAppIdPrefix("default", "prefix")).To(Equal("prefix-default-seldon-dataflow-"))
+}
+
+func TestCollect(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name            string
+		models          []*store.ModelSnapshot
+		pipelines       []*pipeline.Pipeline
+		topics          []string
+		groups          []string
+		dryRun          bool
+		gracePeriod     time.Duration
+		expectedOrphans []string
+		expectedDeleted []string
+	}
+
+	terminatedAt := time.Now().Add(-2 * time.Hour)
+	runningPipeline := &pipeline.Pipeline{
+		Name: "p1",
+		Versions: []*pipeline.PipelineVersion{
+			{
+				Name:    "p1",
+				Version: 1,
+				UID:     "old",
+				Steps:   map[string]*pipeline.PipelineStep{"m2": {Name: "m2"}},
+				State:   &pipeline.PipelineState{Status: pipeline.PipelineTerminated, Timestamp: terminatedAt},
+			},
+			{
+				Name:    "p1",
+				Version: 2,
+				UID:     "new",
+				Steps:   map[string]*pipeline.PipelineStep{"m3": {Name: "m3"}},
+				State:   &pipeline.PipelineState{Status: pipeline.PipelineReady},
+			},
+		},
+	}
+	deletedPipeline := &pipeline.Pipeline{
+		Name: "p2",
+		Versions: []*pipeline.PipelineVersion{
+			{
+				Name:    "p2",
+				Version: 1,
+				UID:     "gone",
+				State:   &pipeline.PipelineState{Status: pipeline.PipelineTerminated, Timestamp: terminatedAt},
+			},
+		},
+	}
+
+	tests := []test{
+		{
+			name:   "live resources are kept",
+			models: []*store.ModelSnapshot{{Name: "m1"}},
+			pipelines: []*pipeline.Pipeline{
+				runningPipeline,
+			},
+			topics: []string{
+				"seldon.default.model.m1.inputs",
+				"seldon.default.model.m3.outputs", // used by a running pipeline
+				"seldon.default.pipeline.p1.inputs",
+				"default-seldon-dataflow-new-store-changelog",
+				"seldon.default.errors.errors",
+				"seldon.other.model.m9.inputs",
+			},
+			groups: []string{"default-seldon-dataflow-new", "default-seldon-pipelinegateway-1234"},
+		},
+		{
+			name: "deleted resources within the grace period are only listed",
+			models: []*store.ModelSnapshot{
+				{Name: "m1", Deleted: true},
+			},
+			topics:          []string{"seldon.default.model.m1.inputs", "seldon.default.model.m2.outputs"},
+			gracePeriod:     time.Hour,
+			expectedOrphans: []string{"seldon.default.model.m1.inputs", "seldon.default.model.m2.outputs"},
+		},
+		{
+			name:      "known deletion times are used for the grace period",
+			pipelines: []*pipeline.Pipeline{runningPipeline, deletedPipeline},
+			topics: []string{
+				"seldon.default.pipeline.p1.outputs",
+				"seldon.default.pipeline.p2.inputs",
+				"default-seldon-dataflow-old-store-changelog",
+			},
+			groups:      []string{"default-seldon-dataflow-old", "default-seldon-dataflow-new", "default-seldon-dataflow-gone"},
+			gracePeriod: time.Hour,
+			expectedOrphans: []string{
+				"seldon.default.pipeline.p2.inputs",
+				"default-seldon-dataflow-old-store-changelog",
+				"default-seldon-dataflow-old",
+				"default-seldon-dataflow-gone",
+			},
+			expectedDeleted: []string{
+				"seldon.default.pipeline.p2.inputs",
+				"default-seldon-dataflow-old-store-changelog",
+				"default-seldon-dataflow-old",
+				"default-seldon-dataflow-gone",
+			},
+		},
+		{
+			name:            "dry run deletes nothing",
+			topics:          []string{"seldon.default.model.m1.inputs"},
+			groups:          []string{"default-seldon-dataflow-gone"},
+			dryRun:          true,
+			expectedOrphans: []string{"seldon.default.model.m1.inputs", "default-seldon-dataflow-gone"},
+		},
+		{
+			name:            "no grace period",
+			topics:          []string{"seldon.default.model.m1.inputs"},
+			groups:          []string{"default-seldon-dataflow-gone"},
+			expectedOrphans: []string{"seldon.default.model.m1.inputs", "default-seldon-dataflow-gone"},
+			expectedDeleted: []string{"seldon.default.model.m1.inputs", "default-seldon-dataflow-gone"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			streamTransport := transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic)
+			admin, err := streamTransport.NewAdmin()
+			g.Expect(err).To(BeNil())
+			var topicSpecs []transport.TopicSpec
+			for _, topic := range test.topics {
+				topicSpecs = append(topicSpecs, transport.TopicSpec{Name: topic})
+			}
+			g.Expect(admin.CreateTopics(ctx, topicSpecs)).To(BeNil())
+			for _, group := range test.groups {
+				consumer, err := streamTransport.NewConsumer(group)
+				g.Expect(err).To(BeNil())
+				g.Expect(consumer.Close()).To(BeNil())
+			}
+
+			topicNamer, err := kafka.NewTopicNamer("default", "seldon")
+			g.Expect(err).To(BeNil())
+			collector := NewKafkaGarbageCollector(log.New(), admin, topicNamer, nil, nil, "default", "", test.gracePeriod)
+			collector.modelStore = &fakeModelLister{models: test.models}
+			collector.pipelineHandler = &fakePipelineLister{pipelines: test.pipelines}
+
+			result, err := collector.Collect(ctx, test.dryRun, test.gracePeriod)
+			g.Expect(err).To(BeNil())
+
+			var orphans, deleted []string
+			for _, orphan := range append(result.Topics, result.ConsumerGroups...) {
+				orphans = append(orphans, orphan.Name)
+				if orphan.Deleted {
+					deleted = append(deleted, orphan.Name)
+				}
+			}
+			g.Expect(orphans).To(ConsistOf(test.expectedOrphans))
+			g.Expect(deleted).To(ConsistOf(test.expectedDeleted))
+
+			remainingTopics, err := admin.ListTopics(ctx)
+			g.Expect(err).To(BeNil())
+			remainingGroups, err := admin.ListConsumerGroups(ctx)
+			g.Expect(err).To(BeNil())
+			g.Expect(len(remainingTopics) + len(remainingGroups)).To(Equal(len(test.topics) + len(test.groups) - len(test.expectedDeleted)))
+		})
+	}
+}
+
+func TestCollectTracksOrphanedTime(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+	streamTransport := transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic)
+	admin, err := streamTransport.NewAdmin()
+	g.Expect(err).To(BeNil())
+	g.Expect(admin.CreateTopics(ctx, []transport.TopicSpec{{Name: "seldon.default.model.m1.inputs"}})).To(BeNil())
+	topicNamer, err := kafka.NewTopicNamer("default", "seldon")
+	g.Expect(err).To(BeNil())
+	modelLister := &fakeModelLister{}
+	collector := NewKafkaGarbageCollector(log.New(), admin, topicNamer, nil, nil, "default", "", time.Hour)
+	collector.modelStore = modelLister
+	collector.pipelineHandler = &fakePipelineLister{}
+
+	result, err := collector.Collect(ctx, false, time.Hour)
+	g.Expect(err).To(BeNil())
+	g.Expect(result.Topics).To(HaveLen(1))
+	g.Expect(result.Topics[0].Deleted).To(BeFalse())
+
+	// the first time the topic was found orphaned is remembered
+	collector.orphanedSince["topic:seldon.default.model.m1.inputs"] = time.Now().Add(-2 * time.Hour)
+	result, err = collector.Collect(ctx, true, time.Hour)
+	g.Expect(err).To(BeNil())
+	g.Expect(result.Topics[0].Orphaned).To(BeNumerically(">=", 2*time.Hour))
+
+	// a model loaded again resets the time
+	modelLister.models = []*store.ModelSnapshot{{Name: "m1"}}
+	result, err = collector.Collect(ctx, false, time.Hour)
+	g.Expect(err).To(BeNil())
+	g.Expect(result.Topics).To(BeEmpty())
+	g.Expect(collector.orphanedSince).To(BeEmpty())
+}
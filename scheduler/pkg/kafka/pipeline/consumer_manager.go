@@ -19,6 +19,8 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
 )
 
 const (
@@ -35,19 +37,23 @@ type ConsumerManager struct {
 	// all consumers we have
 	consumers               []*MultiTopicsKafkaConsumer
 	consumerConfig          *config.KafkaConfig
+	transport               transport.Transport
 	maxNumConsumers         int
 	maxNumTopicsPerConsumer int
 	tracer                  trace.Tracer
 	namespace               string
+	metrics                 metrics.PipelineConsumerMetricsHandler
 }
 
 func NewConsumerManager(
 	namespace string,
 	logger log.FieldLogger,
 	consumerConfig *config.KafkaConfig,
+	streamTransport transport.Transport,
 	maxNumTopicsPerConsumer,
 	maxNumConsumers int,
 	tracer trace.Tracer,
+	consumerMetrics metrics.PipelineConsumerMetricsHandler,
 ) *ConsumerManager {
 	logger.
 		WithField("max consumers", maxNumConsumers).
@@ -58,9 +64,11 @@ func NewConsumerManager(
 		namespace:               namespace,
 		logger:                  logger.WithField("source", "ConsumerManager"),
 		consumerConfig:          consumerConfig,
+		transport:               streamTransport,
 		maxNumTopicsPerConsumer: maxNumTopicsPerConsumer,
 		maxNumConsumers:         maxNumConsumers,
 		tracer:                  tracer,
+		metrics:                 consumerMetrics,
 	}
 }
 
@@ -71,9 +79,10 @@ func (cm *ConsumerManager) createConsumer() error {
 
 	c, err := NewMultiTopicsKafkaConsumer(
 		cm.logger,
-		cm.consumerConfig,
+		cm.transport,
 		getKafkaConsumerName(cm.namespace, cm.consumerConfig.ConsumerGroupIdPrefix, kafkaConsumerNamePrefix, uuid.New().String()),
 		cm.tracer,
+		cm.metrics,
 	)
 	if err != nil {
 		return err
@@ -27,6 +27,7 @@ import (
 	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
+	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
 	status2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline/status"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
@@ -128,7 +129,12 @@ func (g *GatewayGrpcServer) ModelInfer(ctx context.Context, r *v2.ModelInferRequ
 	if err != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
 	}
-	kafkaRequest, err := g.gateway.Infer(ctx, resourceName, isModel, b, convertGrpcMetadataToKafkaHeaders(md), g.getRequestId(md))
+	kafkaHeaders := convertGrpcMetadataToKafkaHeaders(md)
+	kafkaHeaders = addPipelineVersionToKafkaHeaders(kafkaHeaders, extractHeader(resources.SeldonPipelineVersionHeader, md))
+	if err := kafka2.ValidatePriority(getPriorityFromKafkaHeaders(kafkaHeaders)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	kafkaRequest, err := g.gateway.Infer(ctx, resourceName, isModel, b, kafkaHeaders, g.getRequestId(md))
 	elapsedTime := time.Since(startTime).Seconds()
 	if err != nil {
 		go g.metrics.AddPipelineInferMetrics(resourceName, metrics.MethodTypeGrpc, elapsedTime, codes.FailedPrecondition.String())
@@ -24,6 +24,7 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
+	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline/status"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
@@ -44,6 +45,10 @@ type GatewayHttpServer struct {
 	metrics              metrics.PipelineMetricsHandler
 	tlsOptions           *util.TLSOptions
 	pipelineReadyChecker status.PipelineReadyChecker
+	// Optional: if set responses captured by Envoy for experiments comparing their mirror are accepted
+	shadowCapturer ShadowCapturer
+	// API key of shadow captures, not checked if empty
+	shadowCaptureAPIKey string
 }
 
 type TLSDetails struct {
@@ -118,6 +123,9 @@ func (g *GatewayHttpServer) setupRoutes() {
 		v2ModelPathPrefix + "{" + ResourceNameVariable + "}/ready").HandlerFunc(g.pipelineReadyFromModelPath)
 	g.router.NewRoute().Path(
 		v2PipelinePathPrefix + "{" + ResourceNameVariable + "}/ready").HandlerFunc(g.pipelineReadyFromPipelinePath)
+	if g.shadowCapturer != nil {
+		g.router.NewRoute().Path(resources.ShadowCapturePath).Methods(http.MethodPost).HandlerFunc(g.captureShadow)
+	}
 }
 
 // Get or create a request ID
@@ -148,7 +156,15 @@ func (g *GatewayHttpServer) infer(w http.ResponseWriter, req *http.Request, reso
 		return
 	}
 
-	kafkaRequest, err := g.gateway.Infer(req.Context(), resourceName, isModel, dataProto, convertHttpHeadersToKafkaHeaders(req.Header), g.getRequestId(req))
+	kafkaHeaders := convertHttpHeadersToKafkaHeaders(req.Header)
+	kafkaHeaders = addPipelineVersionToKafkaHeaders(kafkaHeaders, req.Header.Get(resources.SeldonPipelineVersionHeader))
+	if err := kafka2.ValidatePriority(getPriorityFromKafkaHeaders(kafkaHeaders)); err != nil {
+		logger.WithError(err).Errorf("Bad priority for resource %s", resourceName)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	kafkaRequest, err := g.gateway.Infer(req.Context(), resourceName, isModel, dataProto, kafkaHeaders, g.getRequestId(req))
 	elapsedTime := time.Since(startTime).Seconds()
 	if kafkaRequest != nil {
 		for k, vals := range convertKafkaHeadersToHttpHeaders(kafkaRequest.headers) {
@@ -21,7 +21,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	. "github.com/onsi/gomega"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
@@ -30,6 +29,8 @@ import (
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/internal/testing_utils"
+	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
 
@@ -41,7 +42,7 @@ type fakePipelineInferer struct {
 	errorModel   string
 }
 
-func (f *fakePipelineInferer) Infer(ctx context.Context, resourceName string, isModel bool, data []byte, headers []kafka.Header, requestId string) (*Request, error) {
+func (f *fakePipelineInferer) Infer(ctx context.Context, resourceName string, isModel bool, data []byte, headers []transport.Header, requestId string) (*Request, error) {
 	if f.err != nil {
 		return nil, f.err
 	} else {
@@ -74,6 +75,7 @@ func TestHttpServer(t *testing.T) {
 		name         string
 		path         string
 		header       string
+		priority     string
 		req          string
 		res          *v2.ModelInferResponse
 		errRes       []byte
@@ -136,6 +138,23 @@ func TestHttpServer(t *testing.T) {
 			req:        ``,
 			statusCode: http.StatusBadRequest,
 		},
+		{
+			name:       "low priority",
+			path:       "/v2/models/foo/infer",
+			header:     "foo",
+			priority:   "low",
+			req:        `{"inputs":[{"name":"input1","datatype":"BOOL","shape":[5],"data":[true,false,true,false,true]}]}`,
+			res:        &v2.ModelInferResponse{ModelName: "model"},
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "bad priority",
+			path:       "/v2/models/foo/infer",
+			header:     "foo",
+			priority:   "urgent",
+			req:        `{"inputs":[{"name":"input1","datatype":"BOOL","shape":[5],"data":[true,false,true,false,true]}]}`,
+			statusCode: http.StatusBadRequest,
+		},
 	}
 
 	testRequestId := "test-id"
@@ -176,6 +195,9 @@ func TestHttpServer(t *testing.T) {
 			g.Expect(err).To(BeNil())
 			req.Header.Set(resources.SeldonModelHeader, test.header)
 			req.Header.Set("contentType", "application/json")
+			if test.priority != "" {
+				req.Header.Set(kafka2.PriorityHeader, test.priority)
+			}
 			resp, err := http.DefaultClient.Do(req)
 			g.Expect(err).To(BeNil())
 			g.Expect(resp.StatusCode).To(Equal(test.statusCode))
@@ -13,9 +13,8 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
-	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
-	"github.com/signalfx/splunk-otel-go/instrumentation/github.com/confluentinc/confluent-kafka-go/v2/kafka/splunkkafka"
 	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -23,13 +22,18 @@ import (
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
 	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/claimcheck"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/serde"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
 	seldontracer "github.com/seldonio/seldon-core/scheduler/v2/pkg/tracing"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
 
 const (
-	pollTimeoutMillisecs = 10000
+	pollTimeout       = 10 * time.Second
+	lagReportInterval = 10 * time.Second
 )
 
 type PipelineInferer interface {
@@ -38,20 +42,27 @@ type PipelineInferer interface {
 		resourceName string,
 		isModel bool,
 		data []byte,
-		headers []kafka.Header,
+		headers []transport.Header,
 		requestId string,
 	) (*Request, error)
 }
 
 type KafkaManager struct {
 	kafkaConfig     *config.KafkaConfig
-	producer        *kafka.Producer
+	transport       transport.Transport
+	producer        transport.Producer
 	pipelines       sync.Map
 	logger          logrus.FieldLogger
 	mu              sync.RWMutex
 	topicNamer      *kafka2.TopicNamer
 	tracer          trace.Tracer
 	consumerManager *ConsumerManager
+	// Optional: if set requests are written in schema registry wire format
+	encoder *serde.Encoder
+	// Optional: if set large requests and responses are held in the claim check store
+	claimChecker *claimcheck.ClaimChecker
+	// Optional: if set the lag and queue time of pipeline outputs are recorded
+	metrics metrics.PipelineConsumerMetricsHandler
 }
 
 type Pipeline struct {
@@ -67,7 +78,7 @@ type Request struct {
 	wg         *sync.WaitGroup
 	key        string
 	response   []byte
-	headers    []kafka.Header
+	headers    []transport.Header
 	isError    bool
 	errorModel string
 }
@@ -76,6 +87,10 @@ func NewKafkaManager(
 	logger logrus.FieldLogger,
 	namespace string,
 	kafkaConfig *config.KafkaConfig,
+	streamTransport transport.Transport,
+	encoder *serde.Encoder,
+	claimChecker *claimcheck.ClaimChecker,
+	consumerMetrics metrics.PipelineConsumerMetricsHandler,
 	traceProvider *seldontracer.TracerProvider,
 	maxNumConsumers,
 	maxNumTopicsPerConsumer int,
@@ -88,11 +103,15 @@ func NewKafkaManager(
 	tracer := traceProvider.GetTraceProvider().Tracer("KafkaManager")
 	km := &KafkaManager{
 		kafkaConfig:     kafkaConfig,
+		transport:       streamTransport,
 		logger:          logger.WithField("source", "KafkaManager"),
 		topicNamer:      topicNamer,
 		tracer:          tracer,
-		consumerManager: NewConsumerManager(namespace, logger, kafkaConfig, maxNumTopicsPerConsumer, maxNumConsumers, tracer),
+		consumerManager: NewConsumerManager(namespace, logger, kafkaConfig, streamTransport, maxNumTopicsPerConsumer, maxNumConsumers, tracer, consumerMetrics),
 		mu:              sync.RWMutex{},
+		encoder:         encoder,
+		claimChecker:    claimChecker,
+		metrics:         consumerMetrics,
 	}
 
 	err = km.createProducer()
@@ -120,18 +139,7 @@ func (km *KafkaManager) createProducer() error {
 		km.producer.Close()
 	}
 	var err error
-
-	producerConfigMap := config.CloneKafkaConfigMap(km.kafkaConfig.Producer)
-	producerConfigMap["go.delivery.reports"] = true
-	err = config.AddKafkaSSLOptions(producerConfigMap)
-	if err != nil {
-		return err
-	}
-
-	configWithoutSecrets := config.WithoutSecrets(producerConfigMap)
-	km.logger.Infof("Creating producer with config %v", configWithoutSecrets)
-
-	km.producer, err = kafka.NewProducer(&producerConfigMap)
+	km.producer, err = km.transport.NewProducer()
 	return err
 }
 
@@ -192,7 +200,7 @@ func (km *KafkaManager) Infer(
 	resourceName string,
 	isModel bool,
 	data []byte,
-	headers []kafka.Header,
+	headers []transport.Header,
 	requestId string,
 ) (*Request, error) {
 	logger := km.logger.WithField("func", "Infer")
@@ -215,45 +223,85 @@ func (km *KafkaManager) Infer(
 
 	outputTopic := km.topicNamer.GetPipelineTopicInputs(resourceName)
 	if isModel {
-		outputTopic = km.topicNamer.GetModelTopicInputs(resourceName)
+		// pipelines send low priority requests to the low priority topics of their steps in the dataflow engine
+		if getPriorityFromKafkaHeaders(headers) == kafka2.PriorityLow {
+			outputTopic = km.topicNamer.GetModelTopicInputsLowPriority(resourceName)
+		} else {
+			outputTopic = km.topicNamer.GetModelTopicInputs(resourceName)
+		}
 	}
 	logger.Debugf("Produce on topic %s with key %s", outputTopic, compositeKey)
-	kafkaHeaders := append(headers, kafka.Header{Key: resources.SeldonPipelineHeader, Value: []byte(resourceName)})
+	kafkaHeaders := append(headers, transport.Header{Key: resources.SeldonPipelineHeader, Value: []byte(resourceName)})
 	kafkaHeaders = addRequestIdToKafkaHeadersIfMissing(kafkaHeaders, requestId)
+	kafkaHeaders = kafka2.SetProduceTime(kafkaHeaders, time.Now())
 
-	msg := &kafka.Message{
-		TopicPartition: kafka.TopicPartition{Topic: &outputTopic, Partition: kafka.PartitionAny},
-		Key:            []byte(compositeKey),
-		Value:          data,
-		Headers:        kafkaHeaders,
+	msg := &transport.Message{
+		Topic:   outputTopic,
+		Key:     []byte(compositeKey),
+		Value:   km.encode(outputTopic, data),
+		Headers: kafkaHeaders,
+	}
+	err = km.claimChecker.Offload(ctx, msg)
+	if err != nil {
+		logger.WithError(err).Warnf("Failed to offload request for topic %s, sending inline", outputTopic)
 	}
 
 	ctx, span := km.tracer.Start(ctx, "Produce")
 	span.SetAttributes(attribute.String(util.RequestIdHeader, requestId))
 	// Add trace headers
-	carrier := splunkkafka.NewMessageCarrier(msg)
+	carrier := transport.NewMessageCarrier(msg)
 	otel.GetTextMapPropagator().Inject(ctx, carrier)
 
-	deliveryChan := make(chan kafka.Event)
-	err = km.producer.Produce(msg, deliveryChan)
+	err = km.producer.Produce(msg, func(err error) {
+		if err != nil {
+			logger.WithError(err).Errorf("Failed to deliver message with key %s", compositeKey)
+		} else {
+			logger.Infof("Delivered message with key %s to topic %s", compositeKey, outputTopic)
+		}
+		span.End()
+	})
 	if err != nil {
 		km.mu.RUnlock()
 		span.End()
 		return nil, err
 	}
-	go func() {
-		evt := <-deliveryChan
-		logger.Infof("Received delivery event %s", evt.String())
-		span.End()
-	}()
 	km.mu.RUnlock()
 	logger.Debugf("Waiting for response for request id %s for resource %s", requestId, resourceName)
 	request.wg.Wait()
 	logger.Debugf("Got response for request id %s for resource %s", requestId, resourceName)
+	err = km.rehydrate(ctx, request)
+	if err != nil {
+		return nil, err
+	}
 	return request, nil
 }
 
-func extractErrorHeader(headers []kafka.Header) (string, bool) {
+// rehydrate loads responses held in the claim check store
+func (km *KafkaManager) rehydrate(ctx context.Context, request *Request) error {
+	msg := &transport.Message{Value: request.response, Headers: request.headers}
+	err := km.claimChecker.Rehydrate(ctx, msg)
+	if err != nil {
+		return err
+	}
+	// responses may be in the schema registry wire format
+	request.response = serde.Unwrap(msg.Value)
+	request.headers = msg.Headers
+	return nil
+}
+
+func (km *KafkaManager) encode(topic string, data []byte) []byte {
+	if km.encoder == nil {
+		return data
+	}
+	encoded, err := km.encoder.Encode(topic, data)
+	if err != nil {
+		km.logger.WithError(err).Warnf("Failed to encode request for topic %s, writing raw protobuf", topic)
+		return data
+	}
+	return encoded
+}
+
+func extractErrorHeader(headers []transport.Header) (string, bool) {
 	for _, header := range headers {
 		if header.Key == kafka2.TopicErrorHeader {
 			return string(header.Value), true
@@ -272,7 +320,7 @@ func (km *KafkaManager) consume(pipeline *Pipeline) error {
 	if pipeline.isModel {
 		topicName = km.topicNamer.GetModelTopicOutputs(pipeline.resourceName)
 	}
-	err := pipeline.consumer.AddTopic(topicName, nil)
+	err := pipeline.consumer.AddTopic(topicName)
 	pipeline.wg.Done()
 	logger.Infof("Topic %s added in consumer id %s", topicName, pipeline.consumer.id)
 	if err != nil {
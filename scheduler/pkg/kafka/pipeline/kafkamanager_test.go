@@ -10,13 +10,16 @@ the Change License after the Change Date as each is defined in accordance with t
 package pipeline
 
 import (
+	"context"
 	"sync"
 	"testing"
 
 	. "github.com/onsi/gomega"
 	"github.com/sirupsen/logrus"
 
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/claimcheck"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/tracing"
 )
 
@@ -96,7 +99,7 @@ func TestLoadOrStorePipeline(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			km, err := NewKafkaManager(logrus.New(), "default", &config.KafkaConfig{}, tracer, 10, 10)
+			km, err := NewKafkaManager(logrus.New(), "default", &config.KafkaConfig{}, transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic), nil, nil, nil, tracer, 10, 10)
 			g.Expect(err).To(BeNil())
 			if test.pipeline != nil {
 				km.pipelines.Store(getPipelineKey(test.resourceName, test.isModel), test.pipeline)
@@ -116,3 +119,66 @@ func TestLoadOrStorePipeline(t *testing.T) {
 		})
 	}
 }
+
+func TestRehydrate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name             string
+		withClaimChecker bool
+		headers          []transport.Header
+		response         []byte
+		expected         []byte
+		err              bool
+	}
+	reference := transport.Header{Key: claimcheck.HeaderKeyClaimCheck, Value: []byte("seldon.default.pipeline.p1.outputs/1")}
+	requestId := transport.Header{Key: "x-request-id", Value: []byte("1")}
+	tests := []test{
+		{
+			name:             "inline response",
+			withClaimChecker: true,
+			headers:          []transport.Header{requestId},
+			response:         []byte("inline"),
+			expected:         []byte("inline"),
+		},
+		{
+			name:             "stored response",
+			withClaimChecker: true,
+			headers:          []transport.Header{requestId, reference},
+			response:         []byte{},
+			expected:         []byte("stored"),
+		},
+		{
+			name:     "stored response without claim check store",
+			headers:  []transport.Header{requestId, reference},
+			response: []byte{},
+			err:      true,
+		},
+	}
+	logger := logrus.New()
+	tracer, err := tracing.NewTraceProvider("test", nil, logger)
+	g.Expect(err).To(BeNil())
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			var claimChecker *claimcheck.ClaimChecker
+			if test.withClaimChecker {
+				store, err := claimcheck.NewFileStore(t.TempDir())
+				g.Expect(err).To(BeNil())
+				g.Expect(store.Put(ctx, string(reference.Value), []byte("stored"))).To(BeNil())
+				claimChecker = claimcheck.NewClaimChecker(store, 0)
+			}
+			km, err := NewKafkaManager(logger, "default", &config.KafkaConfig{}, transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic), nil, claimChecker, nil, tracer, 10, 10)
+			g.Expect(err).To(BeNil())
+			request := &Request{response: test.response, headers: test.headers}
+			err = km.rehydrate(ctx, request)
+			if test.err {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(request.response).To(Equal(test.expected))
+				g.Expect(request.headers).To(Equal([]transport.Header{requestId}))
+			}
+		})
+	}
+}
@@ -13,62 +13,59 @@ import (
 	"context"
 	"sync"
 	"sync/atomic"
+	"time"
 
-	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	cmap "github.com/orcaman/concurrent-map"
-	"github.com/signalfx/splunk-otel-go/instrumentation/github.com/confluentinc/confluent-kafka-go/v2/kafka/splunkkafka"
 	log "github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
-	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
+	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/serde"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
 
 type MultiTopicsKafkaConsumer struct {
-	config   *config.KafkaConfig
-	logger   log.FieldLogger
-	mu       sync.RWMutex
-	topics   map[string]struct{}
-	id       string
-	consumer *kafka.Consumer
-	isActive atomic.Bool
+	transport transport.Transport
+	logger    log.FieldLogger
+	mu        sync.RWMutex
+	topics    map[string]struct{}
+	id        string
+	consumer  transport.Consumer
+	isActive  atomic.Bool
 	// map of kafka id to request
 	requests cmap.ConcurrentMap
 	tracer   trace.Tracer
+	metrics  metrics.PipelineConsumerMetricsHandler
 }
 
 func NewMultiTopicsKafkaConsumer(
 	logger log.FieldLogger,
-	consumerConfig *config.KafkaConfig,
+	streamTransport transport.Transport,
 	id string,
 	tracer trace.Tracer,
+	consumerMetrics metrics.PipelineConsumerMetricsHandler,
 ) (*MultiTopicsKafkaConsumer, error) {
 	consumer := &MultiTopicsKafkaConsumer{
-		logger:   logger.WithField("source", "MultiTopicsKafkaConsumer"),
-		config:   consumerConfig,
-		mu:       sync.RWMutex{},
-		topics:   make(map[string]struct{}),
-		id:       id,
-		requests: cmap.New(),
-		tracer:   tracer,
+		logger:    logger.WithField("source", "MultiTopicsKafkaConsumer"),
+		transport: streamTransport,
+		mu:        sync.RWMutex{},
+		topics:    make(map[string]struct{}),
+		id:        id,
+		requests:  cmap.New(),
+		tracer:    tracer,
+		metrics:   consumerMetrics,
 	}
 	err := consumer.createConsumer()
 	return consumer, err
 }
 
 func (c *MultiTopicsKafkaConsumer) createConsumer() error {
-	consumerConfig := config.CloneKafkaConfigMap(c.config.Consumer)
-	consumerConfig["group.id"] = c.id
-	err := config.AddKafkaSSLOptions(consumerConfig)
-	if err != nil {
-		return err
-	}
-
-	configWithoutSecrets := config.WithoutSecrets(consumerConfig)
-	c.logger.Infof("Creating consumer with config %v", configWithoutSecrets)
-	consumer, err := kafka.NewConsumer(&consumerConfig)
+	consumer, err := c.transport.NewConsumer(c.id)
 	if err != nil {
 		return err
 	}
@@ -85,7 +82,7 @@ func (c *MultiTopicsKafkaConsumer) createConsumer() error {
 	return nil
 }
 
-func (c *MultiTopicsKafkaConsumer) AddTopic(topic string, cb kafka.RebalanceCb) error {
+func (c *MultiTopicsKafkaConsumer) AddTopic(topic string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
@@ -94,7 +91,7 @@ func (c *MultiTopicsKafkaConsumer) AddTopic(topic string, cb kafka.RebalanceCb)
 	}
 
 	c.topics[topic] = struct{}{}
-	return c.subscribeTopics(cb)
+	return c.subscribeTopics()
 }
 
 func (c *MultiTopicsKafkaConsumer) RemoveTopic(topic string) error {
@@ -110,8 +107,7 @@ func (c *MultiTopicsKafkaConsumer) RemoveTopic(topic string) error {
 		return c.Close()
 	} else {
 		// TODO: we want to make sure that this does not affect the already existing subscription
-		// specifically after we mark a given consumer to be ready initially (with a cb)
-		return c.subscribeTopics(nil)
+		return c.subscribeTopics()
 	}
 }
 
@@ -129,68 +125,103 @@ func (c *MultiTopicsKafkaConsumer) Close() error {
 	return nil
 }
 
-func (c *MultiTopicsKafkaConsumer) subscribeTopics(cb kafka.RebalanceCb) error {
+func (c *MultiTopicsKafkaConsumer) subscribeTopics() error {
 	topics := make([]string, len(c.topics))
 	idx := 0
 	for k := range c.topics {
 		topics[idx] = k
 		idx++
 	}
-	return c.consumer.SubscribeTopics(topics, cb)
+	return c.consumer.Subscribe(topics)
 }
 
 func (c *MultiTopicsKafkaConsumer) pollAndMatch() error {
 	logger := c.logger.WithField("func", "pollAndMatch")
+	lastLagReport := time.Now()
 	for c.isActive.Load() {
+		if time.Since(lastLagReport) > lagReportInterval {
+			c.reportLag()
+			lastLagReport = time.Now()
+		}
 
-		ev := c.consumer.Poll(pollTimeoutMillisecs)
-		if ev == nil {
+		e, err := c.consumer.Poll(pollTimeout)
+		if err != nil {
+			logger.WithError(err).Error("Failed to poll for messages")
+			continue
+		}
+		if e == nil {
 			continue
 		}
 
-		switch e := ev.(type) {
-		case *kafka.Message:
-			logger.
-				WithField("topic", *e.TopicPartition.Topic).
-				WithField("key", string(e.Key)).
-				Debugf("received message")
-
-			if val, ok := c.requests.Get(string(e.Key)); ok {
-				ctx := context.Background()
-				carrierIn := splunkkafka.NewMessageCarrier(e)
-				ctx = otel.GetTextMapPropagator().Extract(ctx, carrierIn)
-
-				// Add tracing span
-				_, span := c.tracer.Start(ctx, "Consume")
-				// Use the original request id from kafka headers, as key here is a composite key with the resource name
-				requestId := GetRequestIdFromKafkaHeaders(e.Headers)
-				if requestId == "" {
-					logger.Warnf("Missing request id in Kafka headers for key %s", string(e.Key))
-				}
-				span.SetAttributes(attribute.String(util.RequestIdHeader, requestId))
-
-				request := val.(*Request)
-				request.mu.Lock()
-				if request.active {
-					logger.Debugf("Process response for key %s", string(e.Key))
-					request.errorModel, request.isError = extractErrorHeader(e.Headers)
-					request.response = e.Value
-					request.headers = e.Headers
-					request.wg.Done()
-					request.active = false
-				} else {
-					logger.Warnf("Got duplicate request with key %s", string(e.Key))
-				}
-				request.mu.Unlock()
-				span.End()
+		logger.
+			WithField("topic", e.Topic).
+			WithField("key", string(e.Key)).
+			Debugf("received message")
+
+		if val, ok := c.requests.Get(string(e.Key)); ok {
+			ctx := context.Background()
+			carrierIn := transport.NewMessageCarrier(e)
+			ctx = otel.GetTextMapPropagator().Extract(ctx, carrierIn)
+
+			// Add tracing span
+			_, span := c.tracer.Start(ctx, "Consume")
+			// Use the original request id from kafka headers, as key here is a composite key with the resource name
+			requestId := GetRequestIdFromKafkaHeaders(e.Headers)
+			if requestId == "" {
+				logger.Warnf("Missing request id in Kafka headers for key %s", string(e.Key))
 			}
-
-		case kafka.Error:
-			logger.Errorf("Kafka error, code: [%s] msg: [%s]", e.Code().String(), e.Error())
-		default:
-			logger.Debugf("Ignored %s", e.String())
+			span.SetAttributes(attribute.String(util.RequestIdHeader, requestId))
+
+			c.recordQueueTime(e)
+			request := val.(*Request)
+			request.mu.Lock()
+			if request.active {
+				logger.Debugf("Process response for key %s", string(e.Key))
+				request.errorModel, request.isError = extractErrorHeader(e.Headers)
+				request.response = serde.Unwrap(e.Value)
+				request.headers = e.Headers
+				request.wg.Done()
+				request.active = false
+			} else {
+				logger.Warnf("Got duplicate request with key %s", string(e.Key))
+			}
+			request.mu.Unlock()
+			span.End()
 		}
 	}
 	logger.Warning("Ending kafka consumer poll")
 	return nil // assumption here is that the connection has already terminated
 }
+
+func (c *MultiTopicsKafkaConsumer) reportLag() {
+	if c.metrics == nil {
+		return
+	}
+	partitions, err := c.consumer.PartitionLag()
+	if err != nil {
+		c.logger.WithError(err).Warn("Failed to get consumer lag")
+		return
+	}
+	for _, partition := range partitions {
+		c.metrics.SetTopicPartitionLag(partition.Topic, partition.Partition, partition.Lag)
+	}
+}
+
+// recordQueueTime records how long the output waited on its topic, which is the time the last step of the pipeline
+// produced it as the dataflow engine sets the produce time header
+func (c *MultiTopicsKafkaConsumer) recordQueueTime(msg *transport.Message) {
+	if c.metrics == nil {
+		return
+	}
+	queueTime, ok := kafka2.GetQueueTime(msg, time.Now())
+	if !ok {
+		return
+	}
+	var resourceName string
+	for _, header := range msg.Headers {
+		if header.Key == resources.SeldonPipelineHeader {
+			resourceName = string(header.Value)
+		}
+	}
+	c.metrics.AddPipelineQueueTime(resourceName, queueTime.Seconds())
+}
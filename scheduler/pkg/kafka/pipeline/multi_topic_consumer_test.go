@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package pipeline
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
+	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/tracing"
+)
+
+type fakePipelineConsumerMetrics struct {
+	mu           sync.Mutex
+	partitionLag map[string]int64
+	queueTimes   map[string][]float64
+}
+
+func (f *fakePipelineConsumerMetrics) SetTopicPartitionLag(topic string, partition int32, lag int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.partitionLag[fmt.Sprintf("%s/%d", topic, partition)] = lag
+}
+
+func (f *fakePipelineConsumerMetrics) AddPipelineQueueTime(pipelineName string, seconds float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.queueTimes[pipelineName] = append(f.queueTimes[pipelineName], seconds)
+}
+
+func TestMultiTopicsKafkaConsumerMetrics(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name               string
+		headers            []transport.Header
+		expectedQueueTimes map[string]int
+	}
+	tests := []test{
+		{
+			name: "produce time header",
+			headers: append(
+				kafka2.SetProduceTime(nil, time.Now().Add(-time.Second)),
+				transport.Header{Key: resources.SeldonPipelineHeader, Value: []byte("p1")},
+			),
+			expectedQueueTimes: map[string]int{"p1": 1},
+		},
+		{
+			name:               "no produce time",
+			headers:            []transport.Header{{Key: resources.SeldonPipelineHeader, Value: []byte("p1")}},
+			expectedQueueTimes: map[string]int{},
+		},
+	}
+
+	logger := logrus.New()
+	tp, err := tracing.NewTraceProvider("test", nil, logger)
+	g.Expect(err).To(BeNil())
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeMetrics := &fakePipelineConsumerMetrics{
+				partitionLag: make(map[string]int64),
+				queueTimes:   make(map[string][]float64),
+			}
+			streamTransport := transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic)
+			c, err := NewMultiTopicsKafkaConsumer(logger, streamTransport, "c1", tp.GetTraceProvider().Tracer("test"), fakeMetrics)
+			g.Expect(err).To(BeNil())
+			defer func() { _ = c.Close() }()
+			g.Expect(c.AddTopic("seldon.default.pipeline.p1.outputs")).To(BeNil())
+
+			c.reportLag()
+			g.Expect(fakeMetrics.partitionLag).To(Equal(map[string]int64{"seldon.default.pipeline.p1.outputs/0": 0}))
+
+			// the message has no Kafka timestamp so the queue time is only known from the header
+			c.recordQueueTime(&transport.Message{Topic: "seldon.default.pipeline.p1.outputs", Headers: test.headers})
+			fakeMetrics.mu.Lock()
+			defer fakeMetrics.mu.Unlock()
+			g.Expect(fakeMetrics.queueTimes).To(HaveLen(len(test.expectedQueueTimes)))
+			for pipelineName, count := range test.expectedQueueTimes {
+				g.Expect(fakeMetrics.queueTimes[pipelineName]).To(HaveLen(count))
+				g.Expect(fakeMetrics.queueTimes[pipelineName][0]).To(BeNumerically(">=", 1))
+			}
+		})
+	}
+}
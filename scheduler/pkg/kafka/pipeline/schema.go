@@ -0,0 +1,138 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline/status"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/pipeline"
+)
+
+// SchemaValidatingInferer checks pipeline requests against the pipeline input schema before they are produced to Kafka
+// and pipeline responses against the output schema. Requests for models and pipelines without a schema are passed through.
+type SchemaValidatingInferer struct {
+	inferer                PipelineInferer
+	pipelineStatusProvider status.PipelineStatusProvider
+}
+
+func NewSchemaValidatingInferer(inferer PipelineInferer, pipelineStatusProvider status.PipelineStatusProvider) *SchemaValidatingInferer {
+	return &SchemaValidatingInferer{
+		inferer:                inferer,
+		pipelineStatusProvider: pipelineStatusProvider,
+	}
+}
+
+type tensorMeta struct {
+	name     string
+	datatype string
+	shape    []int64
+}
+
+func (s *SchemaValidatingInferer) Infer(
+	ctx context.Context,
+	resourceName string,
+	isModel bool,
+	data []byte,
+	headers []transport.Header,
+	requestId string,
+) (*Request, error) {
+	var schema *pipeline.PipelineSchema
+	if !isModel {
+		if pv := s.pipelineStatusProvider.Get(resourceName); pv != nil {
+			schema = pv.Schema
+		}
+	}
+	if schema == nil {
+		return s.inferer.Infer(ctx, resourceName, isModel, data, headers, requestId)
+	}
+
+	if len(schema.Inputs) > 0 {
+		req := &v2.ModelInferRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return nil, err
+		}
+		var tensors []tensorMeta
+		for _, input := range req.Inputs {
+			tensors = append(tensors, tensorMeta{name: input.Name, datatype: input.Datatype, shape: input.Shape})
+		}
+		if err := validateTensors(schema.Inputs, tensors); err != nil {
+			return createSchemaErrorRequest(resourceName, requestId, fmt.Sprintf("request does not match pipeline input schema. %s", err.Error())), nil
+		}
+	}
+
+	request, err := s.inferer.Infer(ctx, resourceName, isModel, data, headers, requestId)
+	if err != nil || request.isError || len(schema.Outputs) == 0 {
+		return request, err
+	}
+	res := &v2.ModelInferResponse{}
+	if err := proto.Unmarshal(request.response, res); err != nil {
+		return nil, err
+	}
+	var tensors []tensorMeta
+	for _, output := range res.Outputs {
+		tensors = append(tensors, tensorMeta{name: output.Name, datatype: output.Datatype, shape: output.Shape})
+	}
+	if err := validateTensors(schema.Outputs, tensors); err != nil {
+		request.isError = true
+		request.errorModel = resourceName
+		request.response = []byte(fmt.Sprintf("response does not match pipeline output schema. %s", err.Error()))
+	}
+	return request, nil
+}
+
+func createSchemaErrorRequest(resourceName string, requestId string, reason string) *Request {
+	return &Request{
+		key:        getCompositeKey(resourceName, requestId, "."),
+		isError:    true,
+		errorModel: resourceName,
+		response:   []byte(reason),
+	}
+}
+
+// validateTensors checks every tensor in the schema is present with a matching datatype and shape.
+// Tensors not in the schema are allowed.
+func validateTensors(schema []*pipeline.TensorSchema, tensors []tensorMeta) error {
+	byName := make(map[string]tensorMeta)
+	for _, tensor := range tensors {
+		byName[tensor.name] = tensor
+	}
+	for _, expected := range schema {
+		tensor, ok := byName[expected.Name]
+		if !ok {
+			return fmt.Errorf("tensor %s is missing", expected.Name)
+		}
+		if expected.Datatype != "" && tensor.datatype != expected.Datatype {
+			return fmt.Errorf("tensor %s has datatype %s but expected %s", expected.Name, tensor.datatype, expected.Datatype)
+		}
+		if len(expected.Shape) > 0 && !matchShape(expected.Shape, tensor.shape) {
+			return fmt.Errorf("tensor %s has shape %v but expected %v", expected.Name, tensor.shape, expected.Shape)
+		}
+	}
+	return nil
+}
+
+func matchShape(expected []int64, shape []int64) bool {
+	if len(expected) != len(shape) {
+		return false
+	}
+	for i, dim := range expected {
+		if dim != -1 && dim != shape[i] {
+			return false
+		}
+	}
+	return true
+}
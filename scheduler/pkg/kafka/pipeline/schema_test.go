@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/pipeline"
+)
+
+type fakeSchemaStatusProvider struct {
+	pipelines map[string]*pipeline.PipelineVersion
+}
+
+func (f fakeSchemaStatusProvider) Get(name string) *pipeline.PipelineVersion {
+	return f.pipelines[name]
+}
+
+func TestSchemaValidatingInferer(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name          string
+		isModel       bool
+		schema        *pipeline.PipelineSchema
+		request       *v2.ModelInferRequest
+		response      *v2.ModelInferResponse
+		expectedError bool
+	}
+
+	schema := &pipeline.PipelineSchema{
+		Inputs:  []*pipeline.TensorSchema{{Name: "x", Datatype: "FP32", Shape: []int64{-1, 4}}},
+		Outputs: []*pipeline.TensorSchema{{Name: "y", Datatype: "INT64"}},
+	}
+	validRequest := &v2.ModelInferRequest{
+		Inputs: []*v2.ModelInferRequest_InferInputTensor{
+			{Name: "x", Datatype: "FP32", Shape: []int64{2, 4}},
+			{Name: "extra", Datatype: "BYTES", Shape: []int64{1}},
+		},
+	}
+	validResponse := &v2.ModelInferResponse{
+		Outputs: []*v2.ModelInferResponse_InferOutputTensor{{Name: "y", Datatype: "INT64", Shape: []int64{2}}},
+	}
+	tests := []test{
+		{
+			name:     "valid request and response",
+			schema:   schema,
+			request:  validRequest,
+			response: validResponse,
+		},
+		{
+			name:     "no schema",
+			request:  &v2.ModelInferRequest{},
+			response: &v2.ModelInferResponse{},
+		},
+		{
+			name:     "model requests are not validated",
+			isModel:  true,
+			schema:   schema,
+			request:  &v2.ModelInferRequest{},
+			response: &v2.ModelInferResponse{},
+		},
+		{
+			name:   "missing input tensor",
+			schema: schema,
+			request: &v2.ModelInferRequest{
+				Inputs: []*v2.ModelInferRequest_InferInputTensor{{Name: "z", Datatype: "FP32", Shape: []int64{2, 4}}},
+			},
+			response:      validResponse,
+			expectedError: true,
+		},
+		{
+			name:   "wrong input datatype",
+			schema: schema,
+			request: &v2.ModelInferRequest{
+				Inputs: []*v2.ModelInferRequest_InferInputTensor{{Name: "x", Datatype: "FP64", Shape: []int64{2, 4}}},
+			},
+			response:      validResponse,
+			expectedError: true,
+		},
+		{
+			name:   "wrong input shape",
+			schema: schema,
+			request: &v2.ModelInferRequest{
+				Inputs: []*v2.ModelInferRequest_InferInputTensor{{Name: "x", Datatype: "FP32", Shape: []int64{2, 3}}},
+			},
+			response:      validResponse,
+			expectedError: true,
+		},
+		{
+			name:    "wrong output datatype",
+			schema:  schema,
+			request: validRequest,
+			response: &v2.ModelInferResponse{
+				Outputs: []*v2.ModelInferResponse_InferOutputTensor{{Name: "y", Datatype: "FP32", Shape: []int64{2}}},
+			},
+			expectedError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := proto.Marshal(test.request)
+			g.Expect(err).To(BeNil())
+			resData, err := proto.Marshal(test.response)
+			g.Expect(err).To(BeNil())
+			statusProvider := fakeSchemaStatusProvider{pipelines: map[string]*pipeline.PipelineVersion{
+				"foo": {Name: "foo", Schema: test.schema},
+			}}
+			inferer := NewSchemaValidatingInferer(&fakePipelineInferer{data: resData, key: "foo.1"}, statusProvider)
+
+			request, err := inferer.Infer(context.Background(), "foo", test.isModel, data, nil, "1")
+			g.Expect(err).To(BeNil())
+			g.Expect(request.key).To(Equal("foo.1"))
+			g.Expect(request.isError).To(Equal(test.expectedError))
+			if test.expectedError {
+				g.Expect(request.errorModel).To(Equal("foo"))
+			} else {
+				g.Expect(request.response).To(Equal(resData))
+			}
+		})
+	}
+}
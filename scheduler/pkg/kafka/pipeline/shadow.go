@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"crypto/subtle"
+	"io"
+	"net/http"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+// ShadowCapturer writes the responses Envoy captures for experiments comparing their mirror to the shadow topic
+type ShadowCapturer interface {
+	CaptureShadow(ctx context.Context, requestId string, data []byte, headers []transport.Header) error
+}
+
+// Headers of a captured response kept in the shadow topic
+var shadowCaptureHeaders = []string{
+	resources.SeldonShadowHeader,
+	resources.SeldonShadowRoleHeader,
+	resources.SeldonShadowModelHeader,
+	resources.SeldonShadowStatusHeader,
+	resources.SeldonShadowGrpcStatusHeader,
+	resources.SeldonShadowLatencyHeader,
+	util.RequestIdHeader,
+	"content-type",
+}
+
+// CaptureShadow keys captures by request id so the responses of the default model and the mirror share a partition
+func (km *KafkaManager) CaptureShadow(ctx context.Context, requestId string, data []byte, headers []transport.Header) error {
+	logger := km.logger.WithField("func", "CaptureShadow")
+	topic := km.topicNamer.GetShadowTopic()
+	msg := &transport.Message{
+		Topic:   topic,
+		Key:     []byte(requestId),
+		Value:   data,
+		Headers: headers,
+	}
+	km.mu.RLock()
+	defer km.mu.RUnlock()
+	return km.producer.Produce(msg, func(err error) {
+		if err != nil {
+			logger.WithError(err).Warnf("Failed to deliver shadow capture for request %s to topic %s", requestId, topic)
+		}
+	})
+}
+
+// SetShadowCapturer accepts the responses captured by Envoy for experiments comparing their mirror. When the gateway
+// has an Envoy API key, captures must have it as Envoy sends them with the API key of the internal scope.
+func (g *GatewayHttpServer) SetShadowCapturer(shadowCapturer ShadowCapturer, apiKey string) {
+	g.shadowCapturer = shadowCapturer
+	g.shadowCaptureAPIKey = apiKey
+}
+
+func (g *GatewayHttpServer) isShadowCaptureAuthenticated(req *http.Request) bool {
+	if g.shadowCaptureAPIKey == "" {
+		return true
+	}
+	apiKey := req.Header.Get(resources.APIKeyHeader)
+	return subtle.ConstantTimeCompare([]byte(apiKey), []byte(g.shadowCaptureAPIKey)) == 1
+}
+
+func (g *GatewayHttpServer) captureShadow(w http.ResponseWriter, req *http.Request) {
+	logger := g.logger.WithField("func", "captureShadow")
+	if !g.isShadowCaptureAuthenticated(req) {
+		logger.Warnf("Ignoring shadow capture without a valid API key")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	experimentName := req.Header.Get(resources.SeldonShadowHeader)
+	requestId := req.Header.Get(util.RequestIdHeader)
+	if experimentName == "" || requestId == "" {
+		logger.Warnf("Ignoring shadow capture without experiment or request id")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	var headers []transport.Header
+	for _, name := range shadowCaptureHeaders {
+		if value := req.Header.Get(name); value != "" {
+			headers = append(headers, transport.Header{Key: name, Value: []byte(value)})
+		}
+	}
+	err = g.shadowCapturer.CaptureShadow(req.Context(), requestId, data, headers)
+	if err != nil {
+		logger.WithError(err).Warnf("Failed to capture response for request %s of experiment %s", requestId, experimentName)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
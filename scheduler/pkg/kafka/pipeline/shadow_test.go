@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+type fakeShadowCapturer struct {
+	requestId string
+	data      []byte
+	headers   []transport.Header
+}
+
+func (f *fakeShadowCapturer) CaptureShadow(ctx context.Context, requestId string, data []byte, headers []transport.Header) error {
+	f.requestId = requestId
+	f.data = data
+	f.headers = headers
+	return nil
+}
+
+func TestCaptureShadow(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name            string
+		method          string
+		headers         map[string]string
+		apiKey          string
+		expectedCode    int
+		expectedHeaders []transport.Header
+	}
+
+	tests := []test{
+		{
+			name:   "captured",
+			method: http.MethodPost,
+			headers: map[string]string{
+				resources.SeldonShadowHeader:       "exp1",
+				resources.SeldonShadowRoleHeader:   resources.ShadowRoleMirror,
+				resources.SeldonShadowStatusHeader: "200",
+				util.RequestIdHeader:               "r1",
+				"content-type":                     "application/json",
+				"x-other":                          "dropped",
+			},
+			expectedCode: http.StatusAccepted,
+			expectedHeaders: []transport.Header{
+				{Key: resources.SeldonShadowHeader, Value: []byte("exp1")},
+				{Key: resources.SeldonShadowRoleHeader, Value: []byte(resources.ShadowRoleMirror)},
+				{Key: resources.SeldonShadowStatusHeader, Value: []byte("200")},
+				{Key: util.RequestIdHeader, Value: []byte("r1")},
+				{Key: "content-type", Value: []byte("application/json")},
+			},
+		},
+		{
+			name:   "captured with API key",
+			method: http.MethodPost,
+			headers: map[string]string{
+				resources.SeldonShadowHeader: "exp1",
+				resources.APIKeyHeader:       "internal",
+				util.RequestIdHeader:         "r1",
+			},
+			apiKey:       "internal",
+			expectedCode: http.StatusAccepted,
+			expectedHeaders: []transport.Header{
+				{Key: resources.SeldonShadowHeader, Value: []byte("exp1")},
+				{Key: util.RequestIdHeader, Value: []byte("r1")},
+			},
+		},
+		{
+			name:         "missing API key",
+			method:       http.MethodPost,
+			headers:      map[string]string{resources.SeldonShadowHeader: "exp1", util.RequestIdHeader: "r1"},
+			apiKey:       "internal",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:   "wrong API key",
+			method: http.MethodPost,
+			headers: map[string]string{
+				resources.SeldonShadowHeader: "exp1",
+				resources.APIKeyHeader:       "other",
+				util.RequestIdHeader:         "r1",
+			},
+			apiKey:       "internal",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "missing request id",
+			method:       http.MethodPost,
+			headers:      map[string]string{resources.SeldonShadowHeader: "exp1"},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "not a post",
+			method:       http.MethodGet,
+			headers:      map[string]string{resources.SeldonShadowHeader: "exp1", util.RequestIdHeader: "r1"},
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			capturer := &fakeShadowCapturer{}
+			httpServer := NewGatewayHttpServer(0, logrus.New(), &fakePipelineInferer{}, fakePipelineMetricsHandler{}, &util.TLSOptions{}, nil)
+			httpServer.SetShadowCapturer(capturer, test.apiKey)
+			httpServer.setupRoutes()
+
+			req := httptest.NewRequest(test.method, resources.ShadowCapturePath, strings.NewReader(`{"outputs":[]}`))
+			for k, v := range test.headers {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			httpServer.router.ServeHTTP(rec, req)
+			g.Expect(rec.Code).To(Equal(test.expectedCode))
+			if test.expectedCode == http.StatusAccepted {
+				g.Expect(capturer.requestId).To(Equal("r1"))
+				g.Expect(string(capturer.data)).To(Equal(`{"outputs":[]}`))
+				g.Expect(capturer.headers).To(Equal(test.expectedHeaders))
+			} else {
+				g.Expect(capturer.requestId).To(Equal(""))
+			}
+		})
+	}
+}
@@ -26,12 +26,13 @@ import (
 type ModelRestCaller struct {
 	envoyHost  string
 	envoyPort  int
+	apiKey     string
 	logger     logrus.FieldLogger
 	tlsOptions *util.TLSOptions
 	httpClient *http.Client
 }
 
-func NewModelRestStatusCaller(logger logrus.FieldLogger, envoyHost string, envoyPort int) (*ModelRestCaller, error) {
+func NewModelRestStatusCaller(logger logrus.FieldLogger, envoyHost string, envoyPort int, apiKey string) (*ModelRestCaller, error) {
 	tlsOptions, err := util.CreateTLSClientOptions()
 	if err != nil {
 		return nil, err
@@ -43,6 +44,7 @@ func NewModelRestStatusCaller(logger logrus.FieldLogger, envoyHost string, envoy
 	return &ModelRestCaller{
 		envoyHost:  envoyHost,
 		envoyPort:  envoyPort,
+		apiKey:     apiKey,
 		logger:     logger.WithField("source", "ModelRestCaller"),
 		tlsOptions: tlsOptions,
 		httpClient: httpClient,
@@ -71,6 +73,9 @@ func (mr *ModelRestCaller) CheckModelReady(ctx context.Context, modelName string
 	}
 	req.Header.Set(resources.SeldonModelHeader, modelName)
 	req.Header.Set(util.RequestIdHeader, requestId)
+	if mr.apiKey != "" {
+		req.Header.Set(resources.APIKeyHeader, mr.apiKey)
+	}
 	response, err := mr.httpClient.Do(req)
 	if err != nil {
 		return false, err
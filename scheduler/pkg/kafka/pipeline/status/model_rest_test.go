@@ -53,7 +53,7 @@ func TestCheckModelReady(t *testing.T) {
 			defer httpmock.DeactivateAndReset()
 			httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("http://%s:%d/v2/models/%s/ready", envoyHost, envoyPort, test.modelName),
 				httpmock.NewStringResponder(test.status, `{}`))
-			mrc, err := NewModelRestStatusCaller(logrus.New(), envoyHost, envoyPort)
+			mrc, err := NewModelRestStatusCaller(logrus.New(), envoyHost, envoyPort, "")
 			g.Expect(err).To(BeNil())
 			ready, err := mrc.CheckModelReady(context.TODO(), test.modelName, "1")
 			g.Expect(err).To(BeNil())
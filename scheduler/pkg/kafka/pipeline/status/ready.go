@@ -41,7 +41,10 @@ func (s *SimpleReadyChecker) CheckPipelineReady(ctx context.Context, pipelineNam
 	if pipeline == nil {
 		return false, PipelineNotFoundErr
 	}
-	for modelName := range pipeline.Steps {
+	for modelName, step := range pipeline.Steps {
+		if step.IsTransform() {
+			continue
+		}
 		ready, err := s.modelReadyCaller.CheckModelReady(ctx, modelName, requestId)
 		if err != nil || !ready {
 			return ready, err
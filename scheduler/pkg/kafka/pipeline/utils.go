@@ -14,10 +14,11 @@ import (
 	"net/http"
 	"strings"
 
-	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
+	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
 
@@ -40,33 +41,58 @@ func createResourceNameFromHeader(header string) (string, bool, error) {
 		"Bad or missing header %s %s", resources.SeldonModelHeader, header)
 }
 
-func addRequestIdToKafkaHeadersIfMissing(headers []kafka.Header, requestId string) []kafka.Header {
+func addRequestIdToKafkaHeadersIfMissing(headers []transport.Header, requestId string) []transport.Header {
 	for _, kafkaHeader := range headers {
 		if kafkaHeader.Key == util.RequestIdHeader { //already exists
 			return headers
 		}
 	}
-	return append(headers, kafka.Header{
+	return append(headers, transport.Header{
 		Key:   util.RequestIdHeader,
 		Value: []byte(requestId),
 	})
 }
 
+// isForwardedHeader checks for external headers, leaving out API keys so they are not stored in Kafka and
+// the internal headers read by the dataflow engine so clients can not set them
+func isForwardedHeader(key string) bool {
+	if !strings.HasPrefix(key, resources.ExternalHeaderPrefix) {
+		return false
+	}
+	switch key {
+	case resources.APIKeyHeader, kafka2.PipelineVersionHeader, kafka2.ReplaySinkHeader, kafka2.ForEachIndexHeader:
+		return false
+	default:
+		return true
+	}
+}
+
+// addPipelineVersionToKafkaHeaders passes on the pipeline version Envoy chose for a split between pipeline versions
+func addPipelineVersionToKafkaHeaders(headers []transport.Header, version string) []transport.Header {
+	if version == "" {
+		return headers
+	}
+	return append(headers, transport.Header{
+		Key:   kafka2.PipelineVersionHeader,
+		Value: []byte(version),
+	})
+}
+
 // We ensure the Kafka headers are lower case as http headers may have been canonical uppercased
-func convertHttpHeadersToKafkaHeaders(httpHeaders http.Header) []kafka.Header {
-	var kafkaHeaders []kafka.Header
+func convertHttpHeadersToKafkaHeaders(httpHeaders http.Header) []transport.Header {
+	var kafkaHeaders []transport.Header
 	for k, vals := range httpHeaders {
 		key := strings.ToLower(k)
-		if strings.HasPrefix(key, resources.ExternalHeaderPrefix) {
+		if isForwardedHeader(key) {
 			for _, headerValue := range vals {
-				kafkaHeaders = append(kafkaHeaders, kafka.Header{Key: key, Value: []byte(headerValue)})
+				kafkaHeaders = append(kafkaHeaders, transport.Header{Key: key, Value: []byte(headerValue)})
 			}
 		}
 	}
 	return kafkaHeaders
 }
 
-func convertKafkaHeadersToHttpHeaders(kafkaHeaders []kafka.Header) http.Header {
+func convertKafkaHeadersToHttpHeaders(kafkaHeaders []transport.Header) http.Header {
 	httpHeaders := make(http.Header)
 	for _, kafkaHeader := range kafkaHeaders {
 		if strings.HasPrefix(strings.ToLower(kafkaHeader.Key), resources.ExternalHeaderPrefix) {
@@ -81,19 +107,19 @@ func convertKafkaHeadersToHttpHeaders(kafkaHeaders []kafka.Header) http.Header {
 	return httpHeaders
 }
 
-func convertGrpcMetadataToKafkaHeaders(grpcMetadata metadata.MD) []kafka.Header {
-	var kafkaHeaders []kafka.Header
+func convertGrpcMetadataToKafkaHeaders(grpcMetadata metadata.MD) []transport.Header {
+	var kafkaHeaders []transport.Header
 	for k, vals := range grpcMetadata {
-		if strings.HasPrefix(strings.ToLower(k), resources.ExternalHeaderPrefix) {
+		if isForwardedHeader(strings.ToLower(k)) {
 			for _, headerValue := range vals {
-				kafkaHeaders = append(kafkaHeaders, kafka.Header{Key: k, Value: []byte(headerValue)})
+				kafkaHeaders = append(kafkaHeaders, transport.Header{Key: k, Value: []byte(headerValue)})
 			}
 		}
 	}
 	return kafkaHeaders
 }
 
-func convertKafkaHeadersToGrpcMetadata(kafkaHeaders []kafka.Header) metadata.MD {
+func convertKafkaHeadersToGrpcMetadata(kafkaHeaders []transport.Header) metadata.MD {
 	grpcMetadata := make(metadata.MD)
 	for _, kafkaHeader := range kafkaHeaders {
 		if strings.HasPrefix(strings.ToLower(kafkaHeader.Key), resources.ExternalHeaderPrefix) {
@@ -112,7 +138,18 @@ func getCompositeKey(key1 string, key2 string, separator string) string {
 	return fmt.Sprintf("%s%s%s", key1, separator, key2)
 }
 
-func GetRequestIdFromKafkaHeaders(headers []kafka.Header) string {
+// getPriorityFromKafkaHeaders returns the last priority header, which is empty for the default high priority
+func getPriorityFromKafkaHeaders(headers []transport.Header) string {
+	var priority string
+	for _, kafkaHeader := range headers {
+		if kafkaHeader.Key == kafka2.PriorityHeader {
+			priority = string(kafkaHeader.Value)
+		}
+	}
+	return priority
+}
+
+func GetRequestIdFromKafkaHeaders(headers []transport.Header) string {
 	for _, kafkaHeader := range headers {
 		if kafkaHeader.Key == util.RequestIdHeader {
 			return string(kafkaHeader.Value)
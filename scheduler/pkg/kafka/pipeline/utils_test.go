@@ -13,10 +13,11 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	. "github.com/onsi/gomega"
 	"google.golang.org/grpc/metadata"
 
+	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
 
@@ -79,7 +80,7 @@ func TestConvertHttpHeadersToKafkaHeaders(t *testing.T) {
 	type test struct {
 		name                 string
 		httpHeaders          http.Header
-		expectedKafkaHeaders map[string]kafka.Header
+		expectedKafkaHeaders map[string]transport.Header
 	}
 	tests := []test{
 		{
@@ -89,16 +90,39 @@ func TestConvertHttpHeadersToKafkaHeaders(t *testing.T) {
 				"X-foo":        []string{"bar"},
 				"X-foo2":       []string{"bar2"},
 			},
-			expectedKafkaHeaders: map[string]kafka.Header{
+			expectedKafkaHeaders: map[string]transport.Header{
 				"x-foo":  {Key: "x-foo", Value: []byte("bar")},
 				"x-foo2": {Key: "x-foo2", Value: []byte("bar2")},
 			},
 		},
+		{
+			name: "api key not stored",
+			httpHeaders: http.Header{
+				"X-Api-Key": []string{"secret"},
+				"X-foo":     []string{"bar"},
+			},
+			expectedKafkaHeaders: map[string]transport.Header{
+				"x-foo": {Key: "x-foo", Value: []byte("bar")},
+			},
+		},
+		{
+			name: "client replay sink, pipeline version and forEach index ignored",
+			httpHeaders: http.Header{
+				"X-Seldon-Replay-Sink":      []string{"other.topic"},
+				"X-Seldon-Pipeline-Version": []string{"2"},
+				"X-Seldon-Foreach-Index":    []string{"0"},
+				"X-foo":                     []string{"bar"},
+			},
+			expectedKafkaHeaders: map[string]transport.Header{
+				"x-foo": {Key: "x-foo", Value: []byte("bar")},
+			},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			kafkaHeaders := convertHttpHeadersToKafkaHeaders(test.httpHeaders)
+			g.Expect(kafkaHeaders).To(HaveLen(len(test.expectedKafkaHeaders)))
 			for _, kafkaHeader := range kafkaHeaders {
 				v, ok := test.expectedKafkaHeaders[kafkaHeader.Key]
 				g.Expect(ok).To(BeTrue())
@@ -113,13 +137,13 @@ func TestConvertKafkaHeadersToHttpHeaders(t *testing.T) {
 
 	type test struct {
 		name                string
-		kafkaHeaders        []kafka.Header
+		kafkaHeaders        []transport.Header
 		expectedHttpHeaders http.Header
 	}
 	tests := []test{
 		{
 			name: "example kafka headers to http headers",
-			kafkaHeaders: []kafka.Header{
+			kafkaHeaders: []transport.Header{
 				{Key: "X-foo", Value: []byte("bar")},
 				{Key: "Content-Type", Value: []byte("json")},
 			},
@@ -147,7 +171,7 @@ func TestConvertGrpcMetadataToKafkaHeaders(t *testing.T) {
 	type test struct {
 		name                 string
 		meta                 metadata.MD
-		expectedKafkaHeaders map[string]kafka.Header
+		expectedKafkaHeaders map[string]transport.Header
 	}
 	tests := []test{
 		{
@@ -156,15 +180,28 @@ func TestConvertGrpcMetadataToKafkaHeaders(t *testing.T) {
 				"X-foo":        []string{"bar"},
 				"Content-Type": []string{"json"},
 			},
-			expectedKafkaHeaders: map[string]kafka.Header{
+			expectedKafkaHeaders: map[string]transport.Header{
 				"X-foo": {Key: "X-foo", Value: []byte("bar")},
 			},
 		},
+		{
+			name: "client replay sink, pipeline version and forEach index ignored",
+			meta: metadata.MD{
+				"x-seldon-replay-sink":      []string{"other.topic"},
+				"x-seldon-pipeline-version": []string{"2"},
+				"x-seldon-foreach-index":    []string{"0"},
+				"x-foo":                     []string{"bar"},
+			},
+			expectedKafkaHeaders: map[string]transport.Header{
+				"x-foo": {Key: "x-foo", Value: []byte("bar")},
+			},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			kafkaHeaders := convertGrpcMetadataToKafkaHeaders(test.meta)
+			g.Expect(kafkaHeaders).To(HaveLen(len(test.expectedKafkaHeaders)))
 			for _, kafkaHeader := range kafkaHeaders {
 				v, ok := test.expectedKafkaHeaders[kafkaHeader.Key]
 				g.Expect(ok).To(BeTrue())
@@ -179,13 +216,13 @@ func TestConvertKafkaHeadersToGrpcMetadata(t *testing.T) {
 
 	type test struct {
 		name         string
-		kafkaHeaders []kafka.Header
+		kafkaHeaders []transport.Header
 		expectedMeta metadata.MD
 	}
 	tests := []test{
 		{
 			name: "example kafka headers to grpc headers",
-			kafkaHeaders: []kafka.Header{
+			kafkaHeaders: []transport.Header{
 				{Key: "X-foo", Value: []byte("bar")},
 				{Key: "Content-Type", Value: []byte("json")},
 			},
@@ -213,7 +250,7 @@ func TestAddRequestIdToKafkaHeaders(t *testing.T) {
 	type test struct {
 		name              string
 		requestId         string
-		headers           []kafka.Header
+		headers           []transport.Header
 		expectedRequestId string
 	}
 
@@ -221,7 +258,7 @@ func TestAddRequestIdToKafkaHeaders(t *testing.T) {
 		{
 			name:      "request id header exists",
 			requestId: "foo",
-			headers: []kafka.Header{
+			headers: []transport.Header{
 				{
 					Key:   "a",
 					Value: []byte("v1"),
@@ -236,7 +273,7 @@ func TestAddRequestIdToKafkaHeaders(t *testing.T) {
 		{
 			name:      "request id header does not exists",
 			requestId: "foo",
-			headers: []kafka.Header{
+			headers: []transport.Header{
 				{
 					Key:   "a",
 					Value: []byte("v1"),
@@ -265,14 +302,14 @@ func TestGetRequestIdFromKafkaheaders(t *testing.T) {
 
 	type test struct {
 		name              string
-		headers           []kafka.Header
+		headers           []transport.Header
 		expectedRequestId string
 	}
 
 	tests := []test{
 		{
 			name: "request id header exists",
-			headers: []kafka.Header{
+			headers: []transport.Header{
 				{
 					Key:   "a",
 					Value: []byte("v1"),
@@ -286,7 +323,7 @@ func TestGetRequestIdFromKafkaheaders(t *testing.T) {
 		},
 		{
 			name: "request id header does not exists",
-			headers: []kafka.Header{
+			headers: []transport.Header{
 				{
 					Key:   "a",
 					Value: []byte("v1"),
@@ -303,3 +340,71 @@ func TestGetRequestIdFromKafkaheaders(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPriorityFromKafkaHeaders(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name             string
+		headers          []transport.Header
+		expectedPriority string
+	}
+
+	tests := []test{
+		{
+			name:    "no priority header",
+			headers: []transport.Header{{Key: "a", Value: []byte("v1")}},
+		},
+		{
+			name:             "priority header",
+			headers:          []transport.Header{{Key: kafka2.PriorityHeader, Value: []byte("low")}},
+			expectedPriority: "low",
+		},
+		{
+			name: "last priority header is used",
+			headers: []transport.Header{
+				{Key: kafka2.PriorityHeader, Value: []byte("high")},
+				{Key: kafka2.PriorityHeader, Value: []byte("low")},
+			},
+			expectedPriority: "low",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(getPriorityFromKafkaHeaders(test.headers)).To(Equal(test.expectedPriority))
+		})
+	}
+}
+
+func TestAddPipelineVersionToKafkaHeaders(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name     string
+		headers  []transport.Header
+		version  string
+		expected []transport.Header
+	}
+	tests := []test{
+		{
+			name:     "no version",
+			headers:  []transport.Header{{Key: "x-foo", Value: []byte("bar")}},
+			expected: []transport.Header{{Key: "x-foo", Value: []byte("bar")}},
+		},
+		{
+			name:    "version from envoy",
+			headers: []transport.Header{{Key: "x-foo", Value: []byte("bar")}},
+			version: "2",
+			expected: []transport.Header{
+				{Key: "x-foo", Value: []byte("bar")},
+				{Key: kafka2.PipelineVersionHeader, Value: []byte("2")},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(addPipelineVersionToKafkaHeaders(test.headers, test.version)).To(Equal(test.expected))
+		})
+	}
+}
@@ -194,6 +194,52 @@ func convertRequestToV2(data []byte, modelName string, modelVersion string) (*v2
 	return inferenceRequestToV2Proto(infReq, modelName, modelVersion)
 }
 
+// ConvertResponseToV2Bytes converts a V2 REST response to a V2 protobuf response
+func ConvertResponseToV2Bytes(data []byte) ([]byte, error) {
+	res, err := convertResponseToV2(data)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(res)
+}
+
+func convertResponseToV2(data []byte) (*v2_dataplane.ModelInferResponse, error) {
+	infRes := &InferenceResponse{}
+	err := json.Unmarshal(data, infRes)
+	if err != nil {
+		return nil, err
+	}
+	params, err := parametersToV2(infRes.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	var outputs []*v2_dataplane.ModelInferResponse_InferOutputTensor
+	for _, out := range infRes.Outputs {
+		err := convertTensors(out)
+		if err != nil {
+			return nil, err
+		}
+		outParams, err := parametersToV2(out.Parameters)
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, &v2_dataplane.ModelInferResponse_InferOutputTensor{
+			Name:       out.Name,
+			Datatype:   out.Datatype,
+			Shape:      out.Shape,
+			Parameters: outParams,
+			Contents:   tensorToV2(out.tensorData, out.Datatype),
+		})
+	}
+	return &v2_dataplane.ModelInferResponse{
+		ModelName:    infRes.ModelName,
+		ModelVersion: infRes.ModelVersion,
+		Id:           infRes.Id,
+		Parameters:   params,
+		Outputs:      outputs,
+	}, nil
+}
+
 func ConvertV2ResponseBytesToJson(res []byte) ([]byte, error) {
 	v2Res := &v2_dataplane.ModelInferResponse{}
 	err := proto.Unmarshal(res, v2Res)
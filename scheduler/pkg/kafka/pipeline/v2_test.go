@@ -1100,3 +1100,60 @@ func TestConvertRawBytesToByteContents(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertResponseToV2(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name  string
+		inp   string
+		out   *v2_dataplane.ModelInferResponse
+		error bool
+	}
+
+	tests := []test{
+		{
+			name: "fp32",
+			inp:  `{"model_name":"foo","model_version":"1","id":"a","parameters":{"content_type":"np"},"outputs":[{"data":[1.5,2.5],"name":"out1","shape":[2],"datatype":"FP32"}]}`,
+			out: &v2_dataplane.ModelInferResponse{
+				ModelName:    "foo",
+				ModelVersion: "1",
+				Id:           "a",
+				Parameters: map[string]*v2_dataplane.InferParameter{
+					"content_type": {ParameterChoice: &v2_dataplane.InferParameter_StringParam{StringParam: "np"}},
+				},
+				Outputs: []*v2_dataplane.ModelInferResponse_InferOutputTensor{
+					{
+						Name:       "out1",
+						Datatype:   tyFp32,
+						Shape:      []int64{2},
+						Parameters: map[string]*v2_dataplane.InferParameter{},
+						Contents:   &v2_dataplane.InferTensorContents{Fp32Contents: []float32{1.5, 2.5}},
+					},
+				},
+			},
+		},
+		{
+			name:  "unknown datatype",
+			inp:   `{"model_name":"foo","outputs":[{"data":[1],"name":"out1","shape":[1],"datatype":"FOO"}]}`,
+			error: true,
+		},
+		{
+			name:  "not json",
+			inp:   `foo`,
+			error: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := convertResponseToV2([]byte(test.inp))
+			if test.error {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(res).To(Equal(test.out))
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package replay
+
+import "fmt"
+
+type JobNotFoundErr struct {
+	id string
+}
+
+func (jnf *JobNotFoundErr) Error() string {
+	return fmt.Sprintf("replay %s not found", jnf.id)
+}
+
+type InvalidSinkTopicErr struct {
+	topic string
+}
+
+func (ist *InvalidSinkTopicErr) Error() string {
+	return fmt.Sprintf("sink topic %s is a model or pipeline topic", ist.topic)
+}
@@ -0,0 +1,427 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+// Package replay re-sends the historical requests of a pipeline inputs topic through a pipeline. Replayed requests
+// carry a sink header so the dataflow engine writes their pipeline outputs to a separate topic.
+//
+// Replayed requests run through every step of the pipeline, so they are written to the input and output topics of
+// the models of the pipeline like any other request and are seen by anything else reading those topics.
+package replay
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
+	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+)
+
+const (
+	pollTimeoutMs     = 1000
+	maxEmptyPolls     = 30
+	kafkaTimeoutMs    = 30000
+	deliveryTimeout   = 30 * time.Second
+	maxFinishedJobs   = 100
+	consumerGroupName = "seldon-replay-"
+)
+
+type JobState int
+
+const (
+	JobRunning JobState = iota
+	JobSucceeded
+	JobFailed
+)
+
+// Range selects the messages of a pipeline inputs topic to replay.
+// Offsets apply to every partition of the topic. Unset bounds default to the start and the current end of the topic.
+type Range struct {
+	FromOffset *int64
+	ToOffset   *int64 // inclusive
+	FromTime   *time.Time
+	ToTime     *time.Time // exclusive
+}
+
+type Request struct {
+	// Pipeline to run the replayed requests through
+	Pipeline string
+	// Pipeline whose inputs topic is replayed, defaults to Pipeline
+	SourcePipeline string
+	// Only run the requests through this version of the pipeline, e.g. a canary version. 0 for any running version.
+	Version uint32
+	// Topic the pipeline outputs are written to, defaults to the replay topic of the pipeline
+	SinkTopic string
+	Range     Range
+}
+
+type Job struct {
+	Id        string
+	Pipeline  string
+	SinkTopic string
+	State     JobState
+	Replayed  uint64
+	Reason    string
+	finished  time.Time
+}
+
+type PipelineReplayer struct {
+	logger         log.FieldLogger
+	consumerConfig kafka.ConfigMap
+	groupIdPrefix  string
+	transport      transport.Transport
+	topicNamer     *kafka2.TopicNamer
+	mu             sync.Mutex
+	jobs           map[string]*Job
+}
+
+// offsetQuerier is the part of the Kafka consumer used to find the offsets to replay
+type offsetQuerier interface {
+	QueryWatermarkOffsets(topic string, partition int32, timeoutMs int) (int64, int64, error)
+	OffsetsForTimes(times []kafka.TopicPartition, timeoutMs int) ([]kafka.TopicPartition, error)
+}
+
+func NewPipelineReplayer(
+	logger log.FieldLogger,
+	kafkaConfig *config.KafkaConfig,
+	streamTransport transport.Transport,
+	topicNamer *kafka2.TopicNamer,
+) (*PipelineReplayer, error) {
+	consumerConfig := config.CloneKafkaConfigMap(kafkaConfig.Consumer)
+	if err := config.AddKafkaSSLOptions(consumerConfig); err != nil {
+		return nil, err
+	}
+	// Replays read fixed offset ranges so no offsets are committed for the consumer group
+	consumerConfig["enable.auto.commit"] = false
+	return &PipelineReplayer{
+		logger:         logger.WithField("source", "PipelineReplayer"),
+		consumerConfig: consumerConfig,
+		groupIdPrefix:  kafkaConfig.ConsumerGroupIdPrefix,
+		transport:      streamTransport,
+		topicNamer:     topicNamer,
+		jobs:           make(map[string]*Job),
+	}, nil
+}
+
+// Start validates the request and replays it in the background, returning the job to follow its progress
+func (r *PipelineReplayer) Start(req *Request) (*Job, error) {
+	sinkTopic := req.SinkTopic
+	if sinkTopic == "" {
+		sinkTopic = r.topicNamer.GetPipelineTopicReplay(req.Pipeline)
+	} else if _, _, ok := r.topicNamer.GetOwnerFromTopic(sinkTopic); ok {
+		return nil, &InvalidSinkTopicErr{topic: sinkTopic}
+	}
+	if req.SourcePipeline == "" {
+		req.SourcePipeline = req.Pipeline
+	}
+
+	job := &Job{
+		Id:        uuid.New().String(),
+		Pipeline:  req.Pipeline,
+		SinkTopic: sinkTopic,
+		State:     JobRunning,
+	}
+	r.mu.Lock()
+	r.removeFinishedJobs()
+	r.jobs[job.Id] = job
+	r.mu.Unlock()
+
+	go r.run(job, req)
+	return r.copyJob(job), nil
+}
+
+// Get returns a copy of the job so its progress can be read while it runs
+func (r *PipelineReplayer) Get(id string) (*Job, error) {
+	r.mu.Lock()
+	job, ok := r.jobs[id]
+	r.mu.Unlock()
+	if !ok {
+		return nil, &JobNotFoundErr{id: id}
+	}
+	return r.copyJob(job), nil
+}
+
+func (r *PipelineReplayer) copyJob(job *Job) *Job {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	jobCopy := *job
+	return &jobCopy
+}
+
+// removeFinishedJobs keeps the most recently finished jobs, must be called with the lock held
+func (r *PipelineReplayer) removeFinishedJobs() {
+	var finished []*Job
+	for _, job := range r.jobs {
+		if job.State != JobRunning {
+			finished = append(finished, job)
+		}
+	}
+	for len(finished) >= maxFinishedJobs {
+		oldest := 0
+		for i, job := range finished {
+			if job.finished.Before(finished[oldest].finished) {
+				oldest = i
+			}
+		}
+		delete(r.jobs, finished[oldest].Id)
+		finished = append(finished[:oldest], finished[oldest+1:]...)
+	}
+}
+
+func (r *PipelineReplayer) run(job *Job, req *Request) {
+	logger := r.logger.WithField("func", "run").WithField("replay", job.Id)
+	logger.Infof("Replaying inputs of pipeline %s through pipeline %s to topic %s", req.SourcePipeline, req.Pipeline, job.SinkTopic)
+	err := r.replay(job, req)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job.finished = time.Now()
+	if err != nil {
+		logger.WithError(err).Errorf("Replay failed after %d requests", job.Replayed)
+		job.State = JobFailed
+		job.Reason = err.Error()
+		return
+	}
+	logger.Infof("Replayed %d requests", job.Replayed)
+	job.State = JobSucceeded
+}
+
+func (r *PipelineReplayer) replay(job *Job, req *Request) error {
+	sourceTopic := r.topicNamer.GetPipelineTopicInputs(req.SourcePipeline)
+	targetTopic := r.topicNamer.GetPipelineTopicInputs(req.Pipeline)
+
+	consumerConfig := config.CloneKafkaConfigMap(r.consumerConfig)
+	consumerConfig["group.id"] = r.groupIdPrefix + consumerGroupName + job.Id
+	consumer, err := kafka.NewConsumer(&consumerConfig)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = consumer.Close() }()
+
+	md, err := consumer.GetMetadata(&sourceTopic, false, kafkaTimeoutMs)
+	if err != nil {
+		return err
+	}
+	topicMeta, ok := md.Topics[sourceTopic]
+	if !ok || len(topicMeta.Partitions) == 0 {
+		return fmt.Errorf("topic %s not found", sourceTopic)
+	}
+	if err := r.createSinkTopic(job.SinkTopic, len(topicMeta.Partitions)); err != nil {
+		return err
+	}
+	starts, ends, err := getReplayOffsets(consumer, sourceTopic, topicMeta.Partitions, &req.Range)
+	if err != nil {
+		return err
+	}
+	var assignments []kafka.TopicPartition
+	for partition, start := range starts {
+		if start < ends[partition] {
+			assignments = append(assignments, kafka.TopicPartition{Topic: &sourceTopic, Partition: partition, Offset: start})
+		} else {
+			delete(ends, partition)
+		}
+	}
+	if len(assignments) == 0 {
+		return nil
+	}
+	if err := consumer.Assign(assignments); err != nil {
+		return err
+	}
+
+	producer, err := r.transport.NewProducer()
+	if err != nil {
+		return err
+	}
+	defer producer.Close()
+	var deliveries sync.WaitGroup
+	deliveryErrs := make(chan error, 1)
+	delivered := func(err error) {
+		defer deliveries.Done()
+		if err != nil {
+			select {
+			case deliveryErrs <- err:
+			default:
+			}
+		}
+	}
+
+	headers := []transport.Header{
+		{Key: resources.SeldonPipelineHeader, Value: []byte(req.Pipeline)},
+		{Key: kafka2.ReplaySinkHeader, Value: []byte(job.SinkTopic)},
+	}
+	if req.Version > 0 {
+		headers = append(headers, transport.Header{Key: kafka2.PipelineVersionHeader, Value: []byte(strconv.FormatUint(uint64(req.Version), 10))})
+	}
+	emptyPolls := 0
+	for len(ends) > 0 {
+		ev := consumer.Poll(pollTimeoutMs)
+		if ev == nil {
+			emptyPolls++
+			if emptyPolls > maxEmptyPolls {
+				return fmt.Errorf("timed out reading %s", sourceTopic)
+			}
+			continue
+		}
+		emptyPolls = 0
+		switch e := ev.(type) {
+		case *kafka.Message:
+			end, ok := ends[e.TopicPartition.Partition]
+			if !ok || e.TopicPartition.Offset >= end {
+				continue
+			}
+			if e.TopicPartition.Offset+1 >= end {
+				delete(ends, e.TopicPartition.Partition)
+			}
+			if getPipelineNameFromHeaders(e.Headers) != req.SourcePipeline {
+				continue
+			}
+			deliveries.Add(1)
+			err := producer.Produce(&transport.Message{
+				Topic:   targetTopic,
+				Key:     e.Key,
+				Value:   e.Value,
+				Headers: replayHeaders(e.Headers, headers),
+			}, delivered)
+			if err != nil {
+				deliveries.Done()
+				return err
+			}
+			r.mu.Lock()
+			job.Replayed++
+			r.mu.Unlock()
+		case kafka.Error:
+			return e
+		}
+	}
+	return waitForDeliveries(&deliveries, deliveryErrs)
+}
+
+func waitForDeliveries(deliveries *sync.WaitGroup, deliveryErrs chan error) error {
+	done := make(chan struct{})
+	go func() {
+		deliveries.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(deliveryTimeout):
+		return fmt.Errorf("timed out waiting for replayed requests to be delivered")
+	}
+	select {
+	case err := <-deliveryErrs:
+		return err
+	default:
+		return nil
+	}
+}
+
+func (r *PipelineReplayer) createSinkTopic(topic string, partitions int) error {
+	admin, err := r.transport.NewAdmin()
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), kafkaTimeoutMs*time.Millisecond)
+	defer cancel()
+	return admin.CreateTopics(ctx, []transport.TopicSpec{{Name: topic, NumPartitions: partitions, ReplicationFactor: -1}})
+}
+
+func getPipelineNameFromHeaders(headers []kafka.Header) string {
+	for _, header := range headers {
+		if header.Key == resources.SeldonPipelineHeader {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+// replayHeaders keeps the external headers of the original request, replacing the pipeline routing headers
+func replayHeaders(original []kafka.Header, headers []transport.Header) []transport.Header {
+	var replayed []transport.Header
+	for _, header := range original {
+		key := strings.ToLower(header.Key)
+		if strings.HasPrefix(key, resources.ExternalHeaderPrefix) && key != kafka2.PipelineVersionHeader && key != kafka2.ReplaySinkHeader {
+			replayed = append(replayed, transport.Header{Key: header.Key, Value: header.Value})
+		}
+	}
+	return append(replayed, headers...)
+}
+
+// getReplayOffsets returns the start offset and the exclusive end offset of each partition to replay
+func getReplayOffsets(consumer offsetQuerier, topic string, partitions []kafka.PartitionMetadata, r *Range) (map[int32]kafka.Offset, map[int32]kafka.Offset, error) {
+	starts := map[int32]kafka.Offset{}
+	ends := map[int32]kafka.Offset{}
+	for _, partition := range partitions {
+		low, high, err := consumer.QueryWatermarkOffsets(topic, partition.ID, kafkaTimeoutMs)
+		if err != nil {
+			return nil, nil, err
+		}
+		starts[partition.ID] = kafka.Offset(low)
+		ends[partition.ID] = kafka.Offset(high)
+		if r.FromOffset != nil && *r.FromOffset > low {
+			starts[partition.ID] = kafka.Offset(*r.FromOffset)
+		}
+		if r.ToOffset != nil && *r.ToOffset+1 < high {
+			ends[partition.ID] = kafka.Offset(*r.ToOffset + 1)
+		}
+	}
+	for _, bound := range []struct {
+		time    *time.Time
+		isStart bool
+	}{{r.FromTime, true}, {r.ToTime, false}} {
+		if bound.time == nil {
+			continue
+		}
+		var tps []kafka.TopicPartition
+		for _, partition := range partitions {
+			tps = append(tps, kafka.TopicPartition{Topic: &topic, Partition: partition.ID, Offset: kafka.Offset(bound.time.UnixMilli())})
+		}
+		found, err := consumer.OffsetsForTimes(tps, kafkaTimeoutMs)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, tp := range found {
+			if bound.isStart {
+				starts[tp.Partition] = startFromTimeOffset(starts[tp.Partition], ends[tp.Partition], tp.Offset)
+			} else {
+				ends[tp.Partition] = endFromTimeOffset(ends[tp.Partition], tp.Offset)
+			}
+		}
+	}
+	return starts, ends, nil
+}
+
+// startFromTimeOffset moves the start forward to the first offset at or after the from time.
+// A negative offset means no message is at or after the time so nothing is replayed from the partition.
+func startFromTimeOffset(start kafka.Offset, end kafka.Offset, offset kafka.Offset) kafka.Offset {
+	if offset < 0 {
+		return end
+	}
+	if offset > start {
+		return offset
+	}
+	return start
+}
+
+// endFromTimeOffset moves the end back to the first offset at or after the to time.
+// A negative offset means no message is at or after the time so the end is unchanged.
+func endFromTimeOffset(end kafka.Offset, offset kafka.Offset) kafka.Offset {
+	if offset >= 0 && offset < end {
+		return offset
+	}
+	return end
+}
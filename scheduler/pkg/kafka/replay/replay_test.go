@@ -0,0 +1,213 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package replay
+
+import (
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
+	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+)
+
+type fakeOffsetQuerier struct {
+	low   int64
+	high  int64
+	times map[int64]kafka.Offset
+}
+
+func (f *fakeOffsetQuerier) QueryWatermarkOffsets(topic string, partition int32, timeoutMs int) (int64, int64, error) {
+	return f.low, f.high, nil
+}
+
+func (f *fakeOffsetQuerier) OffsetsForTimes(times []kafka.TopicPartition, timeoutMs int) ([]kafka.TopicPartition, error) {
+	var found []kafka.TopicPartition
+	for _, tp := range times {
+		tp.Offset = f.times[int64(tp.Offset)]
+		found = append(found, tp)
+	}
+	return found, nil
+}
+
+func TestReplayHeaders(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name     string
+		original []kafka.Header
+		expected []transport.Header
+	}
+
+	replay := []transport.Header{
+		{Key: resources.SeldonPipelineHeader, Value: []byte("p2")},
+		{Key: kafka2.ReplaySinkHeader, Value: []byte("sink")},
+	}
+	tests := []test{
+		{
+			name:     "no headers",
+			expected: replay,
+		},
+		{
+			name: "keeps external headers",
+			original: []kafka.Header{
+				{Key: resources.SeldonPipelineHeader, Value: []byte("p1")},
+				{Key: "x-request-id", Value: []byte("1")},
+			},
+			expected: append([]transport.Header{{Key: "x-request-id", Value: []byte("1")}}, replay...),
+		},
+		{
+			name: "drops previous replay headers",
+			original: []kafka.Header{
+				{Key: kafka2.PipelineVersionHeader, Value: []byte("1")},
+				{Key: "X-Seldon-Replay-Sink", Value: []byte("old")},
+			},
+			expected: replay,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(replayHeaders(test.original, replay)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestReplayTimeOffsets(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name          string
+		start         kafka.Offset
+		end           kafka.Offset
+		fromOffset    kafka.Offset
+		toOffset      kafka.Offset
+		expectedStart kafka.Offset
+		expectedEnd   kafka.Offset
+	}
+
+	tests := []test{
+		{
+			name:          "within range",
+			start:         0,
+			end:           10,
+			fromOffset:    3,
+			toOffset:      7,
+			expectedStart: 3,
+			expectedEnd:   7,
+		},
+		{
+			name:          "outside offset range",
+			start:         4,
+			end:           6,
+			fromOffset:    3,
+			toOffset:      7,
+			expectedStart: 4,
+			expectedEnd:   6,
+		},
+		{
+			name:          "no messages after times",
+			start:         0,
+			end:           10,
+			fromOffset:    kafka.OffsetEnd,
+			toOffset:      kafka.OffsetEnd,
+			expectedStart: 10,
+			expectedEnd:   10,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(startFromTimeOffset(test.start, test.end, test.fromOffset)).To(Equal(test.expectedStart))
+			g.Expect(endFromTimeOffset(test.end, test.toOffset)).To(Equal(test.expectedEnd))
+		})
+	}
+}
+
+func TestGetReplayOffsets(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name           string
+		replayRange    Range
+		expectedStarts map[int32]kafka.Offset
+		expectedEnds   map[int32]kafka.Offset
+	}
+
+	fromOffset := int64(3)
+	toOffset := int64(5)
+	fromTime := time.UnixMilli(1000)
+	toTime := time.UnixMilli(2000)
+	querier := &fakeOffsetQuerier{low: 1, high: 10, times: map[int64]kafka.Offset{1000: 4, 2000: 8}}
+	partitions := []kafka.PartitionMetadata{{ID: 0}, {ID: 1}}
+	tests := []test{
+		{
+			name:           "whole topic",
+			expectedStarts: map[int32]kafka.Offset{0: 1, 1: 1},
+			expectedEnds:   map[int32]kafka.Offset{0: 10, 1: 10},
+		},
+		{
+			name:           "offset range",
+			replayRange:    Range{FromOffset: &fromOffset, ToOffset: &toOffset},
+			expectedStarts: map[int32]kafka.Offset{0: 3, 1: 3},
+			expectedEnds:   map[int32]kafka.Offset{0: 6, 1: 6},
+		},
+		{
+			name:           "time range",
+			replayRange:    Range{FromTime: &fromTime, ToTime: &toTime},
+			expectedStarts: map[int32]kafka.Offset{0: 4, 1: 4},
+			expectedEnds:   map[int32]kafka.Offset{0: 8, 1: 8},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			starts, ends, err := getReplayOffsets(querier, "topic", partitions, &test.replayRange)
+			g.Expect(err).To(BeNil())
+			g.Expect(starts).To(Equal(test.expectedStarts))
+			g.Expect(ends).To(Equal(test.expectedEnds))
+		})
+	}
+}
+
+func TestStartInvalidSinkTopic(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name      string
+		sinkTopic string
+	}
+
+	tests := []test{
+		{
+			name:      "pipeline outputs topic",
+			sinkTopic: "seldon.default.pipeline.p1.outputs",
+		},
+		{
+			name:      "model inputs topic",
+			sinkTopic: "seldon.default.model.m1.inputs",
+		},
+	}
+	topicNamer, err := kafka2.NewTopicNamer("default", "seldon")
+	g.Expect(err).To(BeNil())
+	replayer, err := NewPipelineReplayer(log.New(), &config.KafkaConfig{}, nil, topicNamer)
+	g.Expect(err).To(BeNil())
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := replayer.Start(&Request{Pipeline: "p1", SinkTopic: test.sinkTopic})
+			g.Expect(err).ToNot(BeNil())
+			g.Expect(err).To(BeAssignableToTypeOf(&InvalidSinkTopicErr{}))
+		})
+	}
+	_, err = replayer.Get("unknown")
+	g.Expect(err).To(BeAssignableToTypeOf(&JobNotFoundErr{}))
+}
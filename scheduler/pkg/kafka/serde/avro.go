@@ -0,0 +1,200 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package serde
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// getAvroSchema returns an Avro record schema for a protobuf message. Fields that track presence, such as messages
+// and oneof members, are unions with null. Unsigned 64 bit integers are written as Avro longs.
+func getAvroSchema(md protoreflect.MessageDescriptor) (string, error) {
+	b, err := json.Marshal(getAvroRecord(md, make(map[protoreflect.FullName]bool)))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// getAvroRecord returns the record for a message, or its name if the record is already defined
+func getAvroRecord(md protoreflect.MessageDescriptor, defined map[protoreflect.FullName]bool) interface{} {
+	if defined[md.FullName()] {
+		return string(md.FullName())
+	}
+	defined[md.FullName()] = true
+	fields := make([]map[string]interface{}, 0, md.Fields().Len())
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		fieldType, defaultValue := getAvroFieldType(fd, defined)
+		fields = append(fields, map[string]interface{}{
+			"name":    string(fd.Name()),
+			"type":    fieldType,
+			"default": defaultValue,
+		})
+	}
+	return map[string]interface{}{
+		"type":   "record",
+		"name":   string(md.FullName()),
+		"fields": fields,
+	}
+}
+
+func getAvroFieldType(fd protoreflect.FieldDescriptor, defined map[protoreflect.FullName]bool) (interface{}, interface{}) {
+	switch {
+	case fd.IsMap():
+		return map[string]interface{}{"type": "map", "values": getAvroValueType(fd.MapValue(), defined)}, map[string]interface{}{}
+	case fd.IsList():
+		return map[string]interface{}{"type": "array", "items": getAvroValueType(fd, defined)}, []interface{}{}
+	case fd.HasPresence():
+		return []interface{}{"null", getAvroValueType(fd, defined)}, nil
+	default:
+		return getAvroValueType(fd, defined), getAvroDefault(fd)
+	}
+}
+
+func getAvroValueType(fd protoreflect.FieldDescriptor, defined map[protoreflect.FullName]bool) interface{} {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return "boolean"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int"
+	case protoreflect.FloatKind:
+		return "float"
+	case protoreflect.DoubleKind:
+		return "double"
+	case protoreflect.StringKind:
+		return "string"
+	case protoreflect.BytesKind:
+		return "bytes"
+	case protoreflect.EnumKind:
+		ed := fd.Enum()
+		if defined[ed.FullName()] {
+			return string(ed.FullName())
+		}
+		defined[ed.FullName()] = true
+		symbols := make([]string, 0, ed.Values().Len())
+		for i := 0; i < ed.Values().Len(); i++ {
+			symbols = append(symbols, string(ed.Values().Get(i).Name()))
+		}
+		return map[string]interface{}{"type": "enum", "name": string(ed.FullName()), "symbols": symbols}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return getAvroRecord(fd.Message(), defined)
+	default: // other integers
+		return "long"
+	}
+}
+
+func getAvroDefault(fd protoreflect.FieldDescriptor) interface{} {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return false
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return ""
+	case protoreflect.EnumKind:
+		return string(fd.Enum().Values().Get(0).Name())
+	default:
+		return 0
+	}
+}
+
+// encodeAvro writes a protobuf message in the Avro binary encoding of its getAvroSchema schema
+func encodeAvro(msg proto.Message) ([]byte, error) {
+	return appendAvroMessage(nil, msg.ProtoReflect()), nil
+}
+
+func appendAvroMessage(b []byte, m protoreflect.Message) []byte {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		b = appendAvroField(b, m, fields.Get(i))
+	}
+	return b
+}
+
+func appendAvroField(b []byte, m protoreflect.Message, fd protoreflect.FieldDescriptor) []byte {
+	switch {
+	case fd.IsMap():
+		entries := m.Get(fd).Map()
+		if entries.Len() > 0 {
+			keys := make([]protoreflect.MapKey, 0, entries.Len())
+			entries.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+				keys = append(keys, key)
+				return true
+			})
+			sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+			b = binary.AppendVarint(b, int64(len(keys)))
+			for _, key := range keys {
+				b = appendAvroBytes(b, []byte(key.String()))
+				b = appendAvroValue(b, fd.MapValue(), entries.Get(key))
+			}
+		}
+		return binary.AppendVarint(b, 0)
+	case fd.IsList():
+		list := m.Get(fd).List()
+		if list.Len() > 0 {
+			b = binary.AppendVarint(b, int64(list.Len()))
+			for i := 0; i < list.Len(); i++ {
+				b = appendAvroValue(b, fd, list.Get(i))
+			}
+		}
+		return binary.AppendVarint(b, 0)
+	case fd.HasPresence():
+		if !m.Has(fd) {
+			return binary.AppendVarint(b, 0)
+		}
+		b = binary.AppendVarint(b, 1)
+		return appendAvroValue(b, fd, m.Get(fd))
+	default:
+		return appendAvroValue(b, fd, m.Get(fd))
+	}
+}
+
+func appendAvroValue(b []byte, fd protoreflect.FieldDescriptor, v protoreflect.Value) []byte {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		if v.Bool() {
+			return append(b, 1)
+		}
+		return append(b, 0)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return binary.AppendVarint(b, v.Int())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return binary.AppendVarint(b, int64(v.Uint()))
+	case protoreflect.FloatKind:
+		return binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(v.Float())))
+	case protoreflect.DoubleKind:
+		return binary.LittleEndian.AppendUint64(b, math.Float64bits(v.Float()))
+	case protoreflect.StringKind:
+		return appendAvroBytes(b, []byte(v.String()))
+	case protoreflect.BytesKind:
+		return appendAvroBytes(b, v.Bytes())
+	case protoreflect.EnumKind:
+		index := 0
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			index = ev.Index()
+		}
+		return binary.AppendVarint(b, int64(index))
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return appendAvroMessage(b, v.Message())
+	default:
+		return b
+	}
+}
+
+func appendAvroBytes(b []byte, data []byte) []byte {
+	b = binary.AppendVarint(b, int64(len(data)))
+	return append(b, data...)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package serde
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sync"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+)
+
+const (
+	schemaTypeProtobuf = "PROTOBUF"
+)
+
+// Encoder writes V2 payloads in the Confluent wire format. The V2 dataplane proto file is registered for each topic
+// under the subject <topic>-value the first time the topic is written to.
+type Encoder struct {
+	registry schemaregistry.Client
+	schema   string
+	headers  sync.Map // topic to wire header
+}
+
+func NewEncoder(registry schemaregistry.Client) (*Encoder, error) {
+	schema, err := getProtobufSchema()
+	if err != nil {
+		return nil, err
+	}
+	return &Encoder{
+		registry: registry,
+		schema:   schema,
+	}, nil
+}
+
+// NewEncoderFromConfig returns nil if no schema registry is configured
+func NewEncoderFromConfig(kafkaConfig *config.KafkaConfig) (*Encoder, error) {
+	if kafkaConfig.SchemaRegistry == nil || kafkaConfig.SchemaRegistry.Url == "" {
+		return nil, nil
+	}
+	registry, err := NewSchemaRegistryClient(kafkaConfig.SchemaRegistry)
+	if err != nil {
+		return nil, err
+	}
+	return NewEncoder(registry)
+}
+
+// getProtobufSchema returns the V2 dataplane proto file as a base64 encoded FileDescriptorProto, which schema
+// registries accept in place of the proto source
+func getProtobufSchema() (string, error) {
+	b, err := proto.Marshal(protodesc.ToFileDescriptorProto(v2.File_mlops_v2_dataplane_v2_dataplane_proto))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// Encode adds the wire format header to the payload of a model or pipeline topic. Payloads of other topics are
+// returned unchanged.
+func (e *Encoder) Encode(topic string, payload []byte) ([]byte, error) {
+	header, err := e.getHeader(topic)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return payload, nil
+	}
+	encoded := make([]byte, 0, len(header)+len(payload))
+	encoded = append(encoded, header...)
+	return append(encoded, payload...), nil
+}
+
+func (e *Encoder) getHeader(topic string) ([]byte, error) {
+	if header, ok := e.headers.Load(topic); ok {
+		return header.([]byte), nil
+	}
+	msg := newV2Message(topic)
+	if msg == nil {
+		return nil, nil
+	}
+	schemaId, err := e.registry.Register(getSubject(topic), schemaregistry.SchemaInfo{
+		Schema:     e.schema,
+		SchemaType: schemaTypeProtobuf,
+	}, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register schema for topic %s: %w", topic, err)
+	}
+	header := appendMessageIndexes(createWireHeader(schemaId), msg.ProtoReflect().Descriptor())
+	e.headers.Store(topic, header)
+	return header, nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package serde
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	jsonSchemaDraft       = "http://json-schema.org/draft-07/schema#"
+	jsonSchemaDefinitions = "#/definitions/"
+)
+
+// getJsonSchema returns a JSON schema for the protojson encoding of a protobuf message. Nested messages are held in
+// definitions.
+func getJsonSchema(md protoreflect.MessageDescriptor) (string, error) {
+	builder := &jsonSchemaBuilder{
+		root:        md.FullName(),
+		definitions: make(map[string]interface{}),
+	}
+	schema := builder.getObject(md)
+	schema["$schema"] = jsonSchemaDraft
+	schema["title"] = string(md.FullName())
+	if len(builder.definitions) > 0 {
+		schema["definitions"] = builder.definitions
+	}
+	b, err := json.Marshal(schema)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+type jsonSchemaBuilder struct {
+	root        protoreflect.FullName
+	definitions map[string]interface{}
+}
+
+func (j *jsonSchemaBuilder) getObject(md protoreflect.MessageDescriptor) map[string]interface{} {
+	properties := make(map[string]interface{})
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		properties[fd.JSONName()] = j.getField(fd)
+	}
+	return map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+}
+
+func (j *jsonSchemaBuilder) getRef(md protoreflect.MessageDescriptor) map[string]interface{} {
+	if md.FullName() == j.root {
+		return map[string]interface{}{"$ref": "#"}
+	}
+	name := string(md.FullName())
+	if _, ok := j.definitions[name]; !ok {
+		j.definitions[name] = nil // stops recursive messages being defined again
+		j.definitions[name] = j.getObject(md)
+	}
+	return map[string]interface{}{"$ref": jsonSchemaDefinitions + name}
+}
+
+func (j *jsonSchemaBuilder) getField(fd protoreflect.FieldDescriptor) map[string]interface{} {
+	switch {
+	case fd.IsMap():
+		return map[string]interface{}{"type": "object", "additionalProperties": j.getValue(fd.MapValue())}
+	case fd.IsList():
+		return map[string]interface{}{"type": "array", "items": j.getValue(fd)}
+	default:
+		return j.getValue(fd)
+	}
+}
+
+// getValue follows protojson, which writes 64 bit integers as strings and non finite floats as names
+func (j *jsonSchemaBuilder) getValue(fd protoreflect.FieldDescriptor) map[string]interface{} {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]interface{}{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]interface{}{"type": "integer"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]interface{}{"type": "string", "pattern": "^-?[0-9]+$"}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return map[string]interface{}{
+			"oneOf": []interface{}{
+				map[string]interface{}{"type": "number"},
+				map[string]interface{}{"type": "string", "enum": []string{"NaN", "Infinity", "-Infinity"}},
+			},
+		}
+	case protoreflect.BytesKind:
+		return map[string]interface{}{"type": "string", "contentEncoding": "base64"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		names := make([]string, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		return map[string]interface{}{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return j.getRef(fd.Message())
+	default:
+		return map[string]interface{}{"type": "string"}
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package serde
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+)
+
+type viewFormat struct {
+	schemaType string
+	getSchema  func(md protoreflect.MessageDescriptor) (string, error)
+	encode     func(msg proto.Message) ([]byte, error)
+}
+
+var viewFormats = map[string]*viewFormat{
+	kafka.ViewFormatAvro: {schemaType: "AVRO", getSchema: getAvroSchema, encode: encodeAvro},
+	kafka.ViewFormatJson: {schemaType: "JSON", getSchema: getJsonSchema, encode: protojson.Marshal},
+}
+
+type View struct {
+	Topic string
+	Value []byte
+}
+
+// ViewEncoder copies the V2 payloads of model and pipeline topics to view topics in other formats, written in the
+// Confluent wire format with their schemas registered under the subject <view topic>-value
+type ViewEncoder struct {
+	registry   schemaregistry.Client
+	topicNamer *kafka.TopicNamer
+	views      []string
+	headers    sync.Map // view topic to wire header
+}
+
+func NewViewEncoder(registry schemaregistry.Client, topicNamer *kafka.TopicNamer, views []string) (*ViewEncoder, error) {
+	for _, view := range views {
+		if _, ok := viewFormats[view]; !ok {
+			return nil, fmt.Errorf("unknown view format %s, expected %s or %s", view, kafka.ViewFormatAvro, kafka.ViewFormatJson)
+		}
+	}
+	return &ViewEncoder{
+		registry:   registry,
+		topicNamer: topicNamer,
+		views:      views,
+	}, nil
+}
+
+func (v *ViewEncoder) GetViewTopics(topic string) []string {
+	viewTopics := make([]string, 0, len(v.views))
+	for _, view := range v.views {
+		viewTopics = append(viewTopics, v.topicNamer.GetViewTopic(topic, view))
+	}
+	return viewTopics
+}
+
+// Encode returns the views of a payload read from a topic. The payload may be raw V2 protobuf or in the wire format.
+// Payloads of other topics have no views.
+func (v *ViewEncoder) Encode(topic string, payload []byte) ([]*View, error) {
+	msg := newV2Message(topic)
+	if msg == nil || len(v.views) == 0 {
+		return nil, nil
+	}
+	err := proto.Unmarshal(Unwrap(payload), msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read V2 payload from topic %s: %w", topic, err)
+	}
+	views := make([]*View, 0, len(v.views))
+	for _, view := range v.views {
+		format := viewFormats[view]
+		viewTopic := v.topicNamer.GetViewTopic(topic, view)
+		header, err := v.getHeader(viewTopic, format, msg.ProtoReflect().Descriptor())
+		if err != nil {
+			return nil, err
+		}
+		value, err := format.encode(msg)
+		if err != nil {
+			return nil, err
+		}
+		encoded := make([]byte, 0, len(header)+len(value))
+		encoded = append(encoded, header...)
+		views = append(views, &View{Topic: viewTopic, Value: append(encoded, value...)})
+	}
+	return views, nil
+}
+
+func (v *ViewEncoder) getHeader(viewTopic string, format *viewFormat, md protoreflect.MessageDescriptor) ([]byte, error) {
+	if header, ok := v.headers.Load(viewTopic); ok {
+		return header.([]byte), nil
+	}
+	schema, err := format.getSchema(md)
+	if err != nil {
+		return nil, err
+	}
+	schemaId, err := v.registry.Register(getSubject(viewTopic), schemaregistry.SchemaInfo{
+		Schema:     schema,
+		SchemaType: format.schemaType,
+	}, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register schema for topic %s: %w", viewTopic, err)
+	}
+	header := createWireHeader(schemaId)
+	v.headers.Store(viewTopic, header)
+	return header, nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package serde
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+)
+
+func TestEncodeAvro(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name     string
+		msg      proto.Message
+		expected []byte
+	}
+
+	tests := []test{
+		{
+			name:     "oneof",
+			msg:      &v2.InferParameter{ParameterChoice: &v2.InferParameter_Int64Param{Int64Param: 3}},
+			expected: []byte{0, 2, 6, 0},
+		},
+		{
+			name:     "empty oneof",
+			msg:      &v2.InferParameter{},
+			expected: []byte{0, 0, 0},
+		},
+		{
+			name: "response",
+			msg: &v2.ModelInferResponse{
+				ModelName: "m",
+				Id:        "1",
+				Outputs:   []*v2.ModelInferResponse_InferOutputTensor{{Name: "t", Shape: []int64{2}}},
+			},
+			expected: []byte{2, 'm', 0, 2, '1', 0, 2, 2, 't', 0, 2, 4, 0, 0, 0, 0, 0},
+		},
+		{
+			name: "map and bytes",
+			msg: &v2.ModelInferResponse{
+				Parameters: map[string]*v2.InferParameter{
+					"b": {ParameterChoice: &v2.InferParameter_BoolParam{BoolParam: true}},
+					"a": {ParameterChoice: &v2.InferParameter_StringParam{StringParam: "x"}},
+				},
+				RawOutputContents: [][]byte{{7}},
+			},
+			expected: []byte{0, 0, 0, 4, 2, 'a', 0, 0, 2, 2, 'x', 2, 'b', 2, 1, 0, 0, 0, 0, 2, 2, 7, 0},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			encoded, err := encodeAvro(test.msg)
+			g.Expect(err).To(BeNil())
+			g.Expect(encoded).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestGetAvroSchema(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	schema, err := getAvroSchema((&v2.ModelInferResponse{}).ProtoReflect().Descriptor())
+	g.Expect(err).To(BeNil())
+	record := map[string]interface{}{}
+	g.Expect(json.Unmarshal([]byte(schema), &record)).To(BeNil())
+	g.Expect(record["name"]).To(Equal("inference.ModelInferResponse"))
+
+	fieldTypes := make(map[string]interface{})
+	for _, field := range record["fields"].([]interface{}) {
+		field := field.(map[string]interface{})
+		fieldTypes[field["name"].(string)] = field["type"]
+	}
+	g.Expect(fieldTypes["model_name"]).To(Equal("string"))
+	g.Expect(fieldTypes["raw_output_contents"]).To(Equal(map[string]interface{}{"type": "array", "items": "bytes"}))
+	parameters := fieldTypes["parameters"].(map[string]interface{})
+	g.Expect(parameters["type"]).To(Equal("map"))
+	g.Expect(parameters["values"].(map[string]interface{})["name"]).To(Equal("inference.InferParameter"))
+	// records are defined once and then referenced by name
+	outputs := fieldTypes["outputs"].(map[string]interface{})["items"].(map[string]interface{})
+	for _, field := range outputs["fields"].([]interface{}) {
+		field := field.(map[string]interface{})
+		switch field["name"] {
+		case "parameters":
+			g.Expect(field["type"]).To(Equal(map[string]interface{}{"type": "map", "values": "inference.InferParameter"}))
+		case "contents":
+			g.Expect(field["type"].([]interface{})[0]).To(Equal("null"))
+			g.Expect(field["default"]).To(BeNil())
+		}
+	}
+}
+
+func TestGetJsonSchema(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	schema, err := getJsonSchema((&v2.ModelInferRequest{}).ProtoReflect().Descriptor())
+	g.Expect(err).To(BeNil())
+	object := map[string]interface{}{}
+	g.Expect(json.Unmarshal([]byte(schema), &object)).To(BeNil())
+	g.Expect(object["title"]).To(Equal("inference.ModelInferRequest"))
+	properties := object["properties"].(map[string]interface{})
+	definitions := object["definitions"].(map[string]interface{})
+	g.Expect(definitions).To(HaveKey("inference.ModelInferRequest.InferInputTensor"))
+	g.Expect(definitions).To(HaveKey("inference.InferParameter"))
+	g.Expect(definitions).To(HaveKey("inference.InferTensorContents"))
+	g.Expect(properties["rawInputContents"]).To(Equal(map[string]interface{}{
+		"type":  "array",
+		"items": map[string]interface{}{"type": "string", "contentEncoding": "base64"},
+	}))
+
+	// every field written by protojson is in the schema
+	req := &v2.ModelInferRequest{
+		ModelName:  "m",
+		Parameters: map[string]*v2.InferParameter{"a": {ParameterChoice: &v2.InferParameter_Int64Param{Int64Param: 1}}},
+		Inputs: []*v2.ModelInferRequest_InferInputTensor{
+			{Name: "t", Datatype: "INT64", Shape: []int64{1}, Contents: &v2.InferTensorContents{Int64Contents: []int64{1}}},
+		},
+		RawInputContents: [][]byte{{1}},
+	}
+	b, err := protojson.Marshal(req)
+	g.Expect(err).To(BeNil())
+	written := map[string]interface{}{}
+	g.Expect(json.Unmarshal(b, &written)).To(BeNil())
+	for key := range written {
+		g.Expect(properties).To(HaveKey(key))
+	}
+	inputProperties := definitions["inference.ModelInferRequest.InferInputTensor"].(map[string]interface{})["properties"].(map[string]interface{})
+	for key := range written["inputs"].([]interface{})[0].(map[string]interface{}) {
+		g.Expect(inputProperties).To(HaveKey(key))
+	}
+}
+
+func TestViewEncoder(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name          string
+		views         []string
+		topic         string
+		payload       []byte
+		expectedViews []string
+		err           bool
+	}
+
+	request, err := proto.Marshal(&v2.ModelInferRequest{ModelName: "foo"})
+	g.Expect(err).To(BeNil())
+
+	tests := []test{
+		{
+			name:          "raw payload",
+			views:         []string{kafka.ViewFormatAvro, kafka.ViewFormatJson},
+			topic:         "seldon.default.pipeline.foo.inputs",
+			payload:       request,
+			expectedViews: []string{"seldon.default.pipeline.foo.inputs.avro", "seldon.default.pipeline.foo.inputs.json"},
+		},
+		{
+			name:          "wire format payload",
+			views:         []string{kafka.ViewFormatJson},
+			topic:         "seldon.default.pipeline.foo.inputs",
+			payload:       append([]byte{0, 0, 0, 0, 1, 2, 20}, request...),
+			expectedViews: []string{"seldon.default.pipeline.foo.inputs.json"},
+		},
+		{
+			name:    "other topic",
+			views:   []string{kafka.ViewFormatJson},
+			topic:   "seldon.default.errors.errors",
+			payload: []byte("error"),
+		},
+		{
+			name:    "invalid payload",
+			views:   []string{kafka.ViewFormatJson},
+			topic:   "seldon.default.pipeline.foo.inputs",
+			payload: []byte("not protobuf"),
+			err:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			registry, err := schemaregistry.NewClient(schemaregistry.NewConfig("mock://"))
+			g.Expect(err).To(BeNil())
+			topicNamer, err := kafka.NewTopicNamer("default", "seldon")
+			g.Expect(err).To(BeNil())
+			encoder, err := NewViewEncoder(registry, topicNamer, test.views)
+			g.Expect(err).To(BeNil())
+
+			views, err := encoder.Encode(test.topic, test.payload)
+			if test.err {
+				g.Expect(err).ToNot(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			var viewTopics []string
+			for _, view := range views {
+				viewTopics = append(viewTopics, view.Topic)
+				g.Expect(view.Value[0]).To(Equal(byte(magicByte)))
+				schemaId := int(binary.BigEndian.Uint32(view.Value[1:wireHeaderLength]))
+				_, err := registry.GetBySubjectAndID(view.Topic+"-value", schemaId)
+				g.Expect(err).To(BeNil())
+			}
+			g.Expect(viewTopics).To(Equal(test.expectedViews))
+		})
+	}
+}
+
+func TestNewViewEncoderUnknownFormat(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, err := NewViewEncoder(nil, nil, []string{"xml"})
+	g.Expect(err).ToNot(BeNil())
+}
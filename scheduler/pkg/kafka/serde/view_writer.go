@@ -0,0 +1,211 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package serde
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/claimcheck"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+)
+
+const (
+	viewConsumerName           = "seldon-pipelinegateway-views"
+	defaultViewRefreshInterval = time.Minute
+	viewPollTimeout            = time.Second
+	viewAdminTimeout           = 30 * time.Second
+)
+
+// ViewWriter copies the payloads of the pipeline topics of a namespace to their view topics. The pipeline topics
+// are found by listing the topics every refresh interval. All replicas share one consumer group so each message
+// is copied once.
+type ViewWriter struct {
+	logger            log.FieldLogger
+	transport         transport.Transport
+	topicNamer        *kafka.TopicNamer
+	encoder           *ViewEncoder
+	claimChecker      *claimcheck.ClaimChecker
+	consumerGroupId   string
+	numPartitions     int
+	replicationFactor int
+	refreshInterval   time.Duration
+	done              chan struct{}
+}
+
+func NewViewWriter(
+	logger log.FieldLogger,
+	streamTransport transport.Transport,
+	topicNamer *kafka.TopicNamer,
+	encoder *ViewEncoder,
+	claimChecker *claimcheck.ClaimChecker,
+	namespace string,
+	consumerGroupIdPrefix string,
+	numPartitions int,
+	replicationFactor int,
+) *ViewWriter {
+	return &ViewWriter{
+		logger:            logger.WithField("source", "ViewWriter"),
+		transport:         streamTransport,
+		topicNamer:        topicNamer,
+		encoder:           encoder,
+		claimChecker:      claimChecker,
+		consumerGroupId:   getViewConsumerGroupId(namespace, consumerGroupIdPrefix),
+		numPartitions:     numPartitions,
+		replicationFactor: replicationFactor,
+		refreshInterval:   defaultViewRefreshInterval,
+		done:              make(chan struct{}),
+	}
+}
+
+func getViewConsumerGroupId(namespace string, consumerGroupIdPrefix string) string {
+	var sb strings.Builder
+	if consumerGroupIdPrefix != "" {
+		sb.WriteString(consumerGroupIdPrefix + "-")
+	}
+	if namespace != "" {
+		sb.WriteString(namespace + "-")
+	}
+	sb.WriteString(viewConsumerName)
+	return sb.String()
+}
+
+// Start copies messages until Stop is called
+func (w *ViewWriter) Start() error {
+	logger := w.logger.WithField("func", "Start")
+	admin, err := w.transport.NewAdmin()
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+	// pipeline topics are only subscribed to at the next refresh after they are created, so they are read from
+	// their first message to copy messages written in between
+	consumer, err := w.transport.NewConsumer(w.consumerGroupId, transport.FromEarliest())
+	if err != nil {
+		return err
+	}
+	defer consumer.Close()
+	producer, err := w.transport.NewProducer()
+	if err != nil {
+		return err
+	}
+	defer producer.Close()
+
+	var topics []string
+	var lastRefresh time.Time
+	for {
+		select {
+		case <-w.done:
+			return nil
+		default:
+		}
+		if time.Since(lastRefresh) >= w.refreshInterval {
+			topics, err = w.refreshTopics(admin, consumer, topics)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to refresh pipeline topics")
+			}
+			lastRefresh = time.Now()
+		}
+		msg, err := consumer.Poll(viewPollTimeout)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to read from pipeline topics")
+			continue
+		}
+		if msg != nil {
+			w.write(producer, msg)
+		}
+	}
+}
+
+func (w *ViewWriter) Stop() {
+	close(w.done)
+}
+
+// refreshTopics subscribes to the current pipeline topics and creates their view topics
+func (w *ViewWriter) refreshTopics(admin transport.Admin, consumer transport.Consumer, subscribed []string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), viewAdminTimeout)
+	defer cancel()
+	allTopics, err := admin.ListTopics(ctx)
+	if err != nil {
+		return subscribed, err
+	}
+	var topics []string
+	for _, topic := range allTopics {
+		if w.topicNamer.IsPipelineTopic(topic) {
+			topics = append(topics, topic)
+		}
+	}
+	sort.Strings(topics)
+	if len(topics) == 0 || strings.Join(topics, ",") == strings.Join(subscribed, ",") {
+		return subscribed, nil
+	}
+
+	var viewTopics []transport.TopicSpec
+	for _, topic := range topics {
+		for _, viewTopic := range w.encoder.GetViewTopics(topic) {
+			viewTopics = append(viewTopics, transport.TopicSpec{
+				Name:              viewTopic,
+				NumPartitions:     w.numPartitions,
+				ReplicationFactor: w.replicationFactor,
+			})
+		}
+	}
+	err = admin.CreateTopics(ctx, viewTopics)
+	if err != nil {
+		return subscribed, err
+	}
+	err = consumer.Subscribe(topics)
+	if err != nil {
+		return subscribed, err
+	}
+	w.logger.Infof("Writing views of pipeline topics %v", topics)
+	return topics, nil
+}
+
+func (w *ViewWriter) write(producer transport.Producer, msg *transport.Message) {
+	logger := w.logger.WithField("func", "write")
+	ctx, cancel := context.WithTimeout(context.Background(), viewAdminTimeout)
+	defer cancel()
+	err := w.claimChecker.Rehydrate(ctx, msg)
+	if err != nil {
+		logger.WithError(err).Warnf("Failed to load payload of message with key %s", string(msg.Key))
+		return
+	}
+	views, err := w.encoder.Encode(msg.Topic, msg.Value)
+	if err != nil {
+		logger.WithError(err).Warnf("Failed to create views of message with key %s", string(msg.Key))
+		return
+	}
+	for _, view := range views {
+		viewMsg := &transport.Message{
+			Topic:   view.Topic,
+			Key:     msg.Key,
+			Value:   view.Value,
+			Headers: msg.Headers,
+		}
+		err := w.claimChecker.Offload(ctx, viewMsg)
+		if err != nil {
+			logger.WithError(err).Warnf("Failed to offload view of message with key %s, sending inline", string(msg.Key))
+		}
+		err = producer.Produce(viewMsg, func(err error) {
+			if err != nil {
+				logger.WithError(err).Warnf("Failed to deliver view of message with key %s", string(msg.Key))
+			}
+		})
+		if err != nil {
+			logger.WithError(err).Warnf("Failed to write view of message with key %s to %s", string(msg.Key), view.Topic)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package serde
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+)
+
+func TestGetViewConsumerGroupId(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(getViewConsumerGroupId("default", "")).To(Equal("default-seldon-pipelinegateway-views"))
+	g.Expect(getViewConsumerGroupId("default", "prefix")).To(Equal("prefix-default-seldon-pipelinegateway-views"))
+}
+
+func TestViewWriter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+	streamTransport := transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic)
+	admin, err := streamTransport.NewAdmin()
+	g.Expect(err).To(BeNil())
+	g.Expect(admin.CreateTopics(ctx, []transport.TopicSpec{
+		{Name: "seldon.default.pipeline.p1.outputs"},
+		{Name: "seldon.default.model.m1.outputs"},
+	})).To(BeNil())
+
+	registry, err := schemaregistry.NewClient(schemaregistry.NewConfig("mock://"))
+	g.Expect(err).To(BeNil())
+	topicNamer, err := kafka.NewTopicNamer("default", "seldon")
+	g.Expect(err).To(BeNil())
+	encoder, err := NewViewEncoder(registry, topicNamer, []string{kafka.ViewFormatJson})
+	g.Expect(err).To(BeNil())
+	writer := NewViewWriter(log.New(), streamTransport, topicNamer, encoder, nil, "default", "", 1, 1)
+	writer.refreshInterval = 10 * time.Millisecond
+	go func() {
+		g.Expect(writer.Start()).To(BeNil())
+	}()
+	defer writer.Stop()
+
+	response := &v2.ModelInferResponse{ModelName: "m1", Id: "1"}
+	payload, err := proto.Marshal(response)
+	g.Expect(err).To(BeNil())
+	producer, err := streamTransport.NewProducer()
+	g.Expect(err).To(BeNil())
+	for _, topic := range []string{"seldon.default.pipeline.p1.outputs", "seldon.default.model.m1.outputs"} {
+		msg := &transport.Message{Topic: topic, Key: []byte("p1.1"), Value: payload, Headers: []transport.Header{{Key: "foo", Value: []byte("bar")}}}
+		g.Expect(producer.Produce(msg, nil)).To(BeNil())
+	}
+
+	consumer, err := streamTransport.NewConsumer("test")
+	g.Expect(err).To(BeNil())
+	defer consumer.Close()
+	g.Expect(consumer.Subscribe([]string{"seldon.default.pipeline.p1.outputs.json", "seldon.default.model.m1.outputs.json"})).To(BeNil())
+	var view *transport.Message
+	g.Eventually(func() *transport.Message {
+		view, _ = consumer.Poll(10 * time.Millisecond)
+		return view
+	}).ShouldNot(BeNil())
+	g.Expect(view.Topic).To(Equal("seldon.default.pipeline.p1.outputs.json"))
+	g.Expect(view.Key).To(Equal([]byte("p1.1")))
+	g.Expect(view.Headers).To(Equal([]transport.Header{{Key: "foo", Value: []byte("bar")}}))
+	viewed := &v2.ModelInferResponse{}
+	g.Expect(protojson.Unmarshal(view.Value[wireHeaderLength:], viewed)).To(BeNil())
+	g.Expect(proto.Equal(viewed, response)).To(BeTrue())
+
+	// model topics have no views
+	g.Consistently(func() *transport.Message {
+		msg, _ := consumer.Poll(10 * time.Millisecond)
+		return msg
+	}, 200*time.Millisecond).Should(BeNil())
+}
+
+func TestViewWriterCopiesMessagesWrittenBeforeRefresh(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+	streamTransport := transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic)
+	admin, err := streamTransport.NewAdmin()
+	g.Expect(err).To(BeNil())
+	g.Expect(admin.CreateTopics(ctx, []transport.TopicSpec{{Name: "seldon.default.pipeline.p1.outputs"}})).To(BeNil())
+
+	registry, err := schemaregistry.NewClient(schemaregistry.NewConfig("mock://"))
+	g.Expect(err).To(BeNil())
+	topicNamer, err := kafka.NewTopicNamer("default", "seldon")
+	g.Expect(err).To(BeNil())
+	encoder, err := NewViewEncoder(registry, topicNamer, []string{kafka.ViewFormatJson})
+	g.Expect(err).To(BeNil())
+	writer := NewViewWriter(log.New(), streamTransport, topicNamer, encoder, nil, "default", "", 1, 1)
+	writer.refreshInterval = 200 * time.Millisecond
+	go func() {
+		g.Expect(writer.Start()).To(BeNil())
+	}()
+	defer writer.Stop()
+
+	// the topic of a new pipeline is written to before the writer subscribes to it
+	g.Expect(admin.CreateTopics(ctx, []transport.TopicSpec{{Name: "seldon.default.pipeline.p2.outputs"}})).To(BeNil())
+	payload, err := proto.Marshal(&v2.ModelInferResponse{ModelName: "m1", Id: "1"})
+	g.Expect(err).To(BeNil())
+	producer, err := streamTransport.NewProducer()
+	g.Expect(err).To(BeNil())
+	g.Expect(producer.Produce(&transport.Message{Topic: "seldon.default.pipeline.p2.outputs", Key: []byte("p2.1"), Value: payload}, nil)).To(BeNil())
+
+	consumer, err := streamTransport.NewConsumer("test")
+	g.Expect(err).To(BeNil())
+	defer consumer.Close()
+	g.Expect(consumer.Subscribe([]string{"seldon.default.pipeline.p2.outputs.json"})).To(BeNil())
+	var view *transport.Message
+	g.Eventually(func() *transport.Message {
+		view, _ = consumer.Poll(10 * time.Millisecond)
+		return view
+	}, time.Second).ShouldNot(BeNil())
+	g.Expect(view.Key).To(Equal([]byte("p2.1")))
+}
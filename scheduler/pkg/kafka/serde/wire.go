@@ -0,0 +1,111 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+// Package serde encodes the payloads of model and pipeline topics in the Confluent wire format with schemas
+// held in a schema registry, so they can be read with standard Kafka tooling.
+package serde
+
+import (
+	"encoding/binary"
+	"os"
+	"strings"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+)
+
+const (
+	magicByte          = 0
+	wireHeaderLength   = 5
+	subjectSuffix      = "-value"
+	inputsTopicSuffix  = kafka.TopicSeparator + "inputs"
+	outputsTopicSuffix = kafka.TopicSeparator + "outputs"
+)
+
+func NewSchemaRegistryClient(registryConfig *config.SchemaRegistryConfig) (schemaregistry.Client, error) {
+	clientConfig := schemaregistry.NewConfig(registryConfig.Url)
+	if userInfo := os.Getenv(config.EnvSchemaRegistryUserInfo); userInfo != "" {
+		clientConfig.BasicAuthUserInfo = userInfo
+		clientConfig.BasicAuthCredentialsSource = "USER_INFO"
+	}
+	return schemaregistry.NewClient(clientConfig)
+}
+
+// Unwrap returns the payload of a protobuf message in the Confluent wire format. Other payloads are returned
+// unchanged, which includes raw V2 protobuf as a protobuf message can never start with a zero byte.
+func Unwrap(data []byte) []byte {
+	if len(data) <= wireHeaderLength || data[0] != magicByte {
+		return data
+	}
+	rest := data[wireHeaderLength:]
+	count, n := binary.Varint(rest)
+	if n <= 0 || count < 0 {
+		return data
+	}
+	rest = rest[n:]
+	for i := int64(0); i < count; i++ {
+		_, n = binary.Varint(rest)
+		if n <= 0 {
+			return data
+		}
+		rest = rest[n:]
+	}
+	return rest
+}
+
+// newV2Message returns the V2 message held on a model or pipeline topic, requests on inputs topics and
+// responses on outputs topics, or nil for other topics
+func newV2Message(topic string) proto.Message {
+	switch {
+	case strings.HasSuffix(topic, inputsTopicSuffix):
+		return &v2.ModelInferRequest{}
+	case strings.HasSuffix(topic, outputsTopicSuffix):
+		return &v2.ModelInferResponse{}
+	default:
+		return nil
+	}
+}
+
+func getSubject(topic string) string {
+	return topic + subjectSuffix
+}
+
+func createWireHeader(schemaId int) []byte {
+	header := make([]byte, wireHeaderLength)
+	header[0] = magicByte
+	binary.BigEndian.PutUint32(header[1:], uint32(schemaId))
+	return header
+}
+
+// appendMessageIndexes adds the path to the message within its proto file, with the first message written as a
+// single zero
+func appendMessageIndexes(b []byte, md protoreflect.MessageDescriptor) []byte {
+	var indexes []int
+	for d := protoreflect.Descriptor(md); ; d = d.Parent() {
+		msg, ok := d.(protoreflect.MessageDescriptor)
+		if !ok {
+			break
+		}
+		indexes = append([]int{msg.Index()}, indexes...)
+	}
+	if len(indexes) == 1 && indexes[0] == 0 {
+		return append(b, 0)
+	}
+	b = binary.AppendVarint(b, int64(len(indexes)))
+	for _, index := range indexes {
+		b = binary.AppendVarint(b, int64(index))
+	}
+	return b
+}
@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package serde
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+)
+
+func TestUnwrap(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	payload, err := proto.Marshal(&v2.ModelInferRequest{ModelName: "foo"})
+	g.Expect(err).To(BeNil())
+
+	type test struct {
+		name     string
+		data     []byte
+		expected []byte
+	}
+
+	tests := []test{
+		{
+			name:     "raw protobuf",
+			data:     payload,
+			expected: payload,
+		},
+		{
+			name:     "json",
+			data:     []byte(`{"inputs":[]}`),
+			expected: []byte(`{"inputs":[]}`),
+		},
+		{
+			name:     "first message",
+			data:     append([]byte{0, 0, 0, 0, 1, 0}, payload...),
+			expected: payload,
+		},
+		{
+			name:     "message indexes",
+			data:     append([]byte{0, 0, 0, 0, 1, 4, 20, 0}, payload...),
+			expected: payload,
+		},
+		{
+			name:     "empty message",
+			data:     []byte{0, 0, 0, 0, 1, 0},
+			expected: []byte{},
+		},
+		{
+			name:     "truncated header",
+			data:     []byte{0, 0, 0, 0, 1},
+			expected: []byte{0, 0, 0, 0, 1},
+		},
+		{
+			name:     "truncated message indexes",
+			data:     []byte{0, 0, 0, 0, 1, 4, 20},
+			expected: []byte{0, 0, 0, 0, 1, 4, 20},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(Unwrap(test.data)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestAppendMessageIndexes(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name     string
+		md       protoreflect.MessageDescriptor
+		expected []byte
+	}
+
+	tests := []test{
+		{
+			name:     "first message",
+			md:       (&v2.ServerLiveRequest{}).ProtoReflect().Descriptor(),
+			expected: []byte{0},
+		},
+		{
+			name:     "request",
+			md:       (&v2.ModelInferRequest{}).ProtoReflect().Descriptor(),
+			expected: []byte{2, 20},
+		},
+		{
+			name:     "response",
+			md:       (&v2.ModelInferResponse{}).ProtoReflect().Descriptor(),
+			expected: []byte{2, 22},
+		},
+		{
+			name:     "nested message",
+			md:       (&v2.ModelInferRequest_InferInputTensor{}).ProtoReflect().Descriptor(),
+			expected: []byte{4, 20, 0},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(appendMessageIndexes(nil, test.md)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestEncode(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name            string
+		topic           string
+		expectedIndexes []byte
+	}
+
+	tests := []test{
+		{
+			name:            "model inputs",
+			topic:           "seldon.default.model.foo.inputs",
+			expectedIndexes: []byte{2, 20},
+		},
+		{
+			name:            "pipeline outputs",
+			topic:           "seldon.default.pipeline.foo.outputs",
+			expectedIndexes: []byte{2, 22},
+		},
+		{
+			name:  "errors topic",
+			topic: "seldon.default.errors.errors",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			registry, err := schemaregistry.NewClient(schemaregistry.NewConfig("mock://"))
+			g.Expect(err).To(BeNil())
+			encoder, err := NewEncoder(registry)
+			g.Expect(err).To(BeNil())
+			payload := []byte{10, 3, 'f', 'o', 'o'}
+
+			encoded, err := encoder.Encode(test.topic, payload)
+			g.Expect(err).To(BeNil())
+			if test.expectedIndexes == nil {
+				g.Expect(encoded).To(Equal(payload))
+				return
+			}
+			g.Expect(encoded[0]).To(Equal(byte(magicByte)))
+			schemaId := int(binary.BigEndian.Uint32(encoded[1:wireHeaderLength]))
+			schema, err := registry.GetBySubjectAndID(test.topic+"-value", schemaId)
+			g.Expect(err).To(BeNil())
+			g.Expect(schema.SchemaType).To(Equal("PROTOBUF"))
+			g.Expect(encoded[wireHeaderLength : wireHeaderLength+len(test.expectedIndexes)]).To(Equal(test.expectedIndexes))
+			g.Expect(Unwrap(encoded)).To(Equal(payload))
+
+			// the header is cached
+			encodedAgain, err := encoder.Encode(test.topic, payload)
+			g.Expect(err).To(BeNil())
+			g.Expect(encodedAgain).To(Equal(encoded))
+		})
+	}
+}
@@ -0,0 +1,289 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+// Package shadow compares the responses of the mirrors of experiments with those of the default models. Envoy captures
+// both responses to a mirrored request and the pipeline gateway writes them to the shadow topic, where they are joined
+// by request id.
+package shadow
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/experiment"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+const (
+	// DefaultMatchTimeout is how long a response waits for the other response to the same request
+	DefaultMatchTimeout = 10 * time.Second
+	// Responses waiting beyond this are dropped so memory stays bounded under heavy traffic
+	maxPendingCaptures = 10000
+	pollTimeout        = 500 * time.Millisecond
+	comparatorName     = "seldon-scheduler-shadow"
+)
+
+// capture is a response of the default model or the mirror of an experiment
+type capture struct {
+	experimentName string
+	requestId      string
+	isMirror       bool
+	statusCode     int
+	grpcStatus     string
+	latencyMs      float64
+	contentType    string
+	data           []byte
+	received       time.Time
+}
+
+func parseCapture(msg *transport.Message, received time.Time) (*capture, error) {
+	headers := make(map[string]string)
+	for _, header := range msg.Headers {
+		headers[header.Key] = string(header.Value)
+	}
+	c := &capture{
+		experimentName: headers[resources.SeldonShadowHeader],
+		requestId:      headers[util.RequestIdHeader],
+		grpcStatus:     headers[resources.SeldonShadowGrpcStatusHeader],
+		contentType:    headers["content-type"],
+		data:           msg.Value,
+		received:       received,
+	}
+	if c.experimentName == "" || c.requestId == "" {
+		return nil, fmt.Errorf("capture has no experiment or request id")
+	}
+	switch headers[resources.SeldonShadowRoleHeader] {
+	case resources.ShadowRolePrimary:
+	case resources.ShadowRoleMirror:
+		c.isMirror = true
+	default:
+		return nil, fmt.Errorf("capture of request %s has unknown role %q", c.requestId, headers[resources.SeldonShadowRoleHeader])
+	}
+	// the status and latency are set by Envoy, so a missing value only loses the error or latency of the response
+	c.statusCode, _ = strconv.Atoi(headers[resources.SeldonShadowStatusHeader])
+	c.latencyMs, _ = strconv.ParseFloat(headers[resources.SeldonShadowLatencyHeader], 64)
+	return c, nil
+}
+
+func (c *capture) isError() bool {
+	return c.statusCode >= http.StatusBadRequest || (c.grpcStatus != "" && c.grpcStatus != "0")
+}
+
+func (c *capture) key() string {
+	return c.experimentName + "." + c.requestId
+}
+
+// ShadowComparator joins the captured responses to mirrored requests, compares them and adds the results to the
+// reports of the experiments and the metrics
+type ShadowComparator struct {
+	logger           logrus.FieldLogger
+	transport        transport.Transport
+	topic            string
+	consumerGroupId  string
+	experimentServer experiment.ExperimentServer
+	metrics          metrics.ShadowMetricsHandler
+	matchTimeout     time.Duration
+	pending          map[string]*capture // first response captured for each experiment and request id
+	done             chan struct{}
+}
+
+func NewShadowComparator(
+	logger logrus.FieldLogger,
+	streamTransport transport.Transport,
+	topicNamer *kafka.TopicNamer,
+	namespace string,
+	consumerGroupIdPrefix string,
+	experimentServer experiment.ExperimentServer,
+	shadowMetrics metrics.ShadowMetricsHandler,
+	matchTimeout time.Duration,
+) *ShadowComparator {
+	return &ShadowComparator{
+		logger:           logger.WithField("source", "ShadowComparator"),
+		transport:        streamTransport,
+		topic:            topicNamer.GetShadowTopic(),
+		consumerGroupId:  getShadowConsumerGroupId(namespace, consumerGroupIdPrefix),
+		experimentServer: experimentServer,
+		metrics:          shadowMetrics,
+		matchTimeout:     matchTimeout,
+		pending:          make(map[string]*capture),
+		done:             make(chan struct{}),
+	}
+}
+
+func getShadowConsumerGroupId(namespace string, consumerGroupIdPrefix string) string {
+	var sb strings.Builder
+	if consumerGroupIdPrefix != "" {
+		sb.WriteString(consumerGroupIdPrefix + "-")
+	}
+	if namespace != "" {
+		sb.WriteString(namespace + "-")
+	}
+	sb.WriteString(comparatorName)
+	return sb.String()
+}
+
+// Start compares responses until Stop is called
+func (sc *ShadowComparator) Start() error {
+	logger := sc.logger.WithField("func", "Start")
+	consumer, err := sc.transport.NewConsumer(sc.consumerGroupId)
+	if err != nil {
+		return err
+	}
+	defer consumer.Close()
+	if err := consumer.Subscribe([]string{sc.topic}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-sc.done:
+			return nil
+		default:
+		}
+		msg, err := consumer.Poll(pollTimeout)
+		if err != nil {
+			logger.WithError(err).Warnf("Failed to read from %s", sc.topic)
+			continue
+		}
+		now := time.Now()
+		if msg != nil {
+			sc.handleCapture(msg, now)
+		}
+		sc.expireCaptures(now)
+	}
+}
+
+func (sc *ShadowComparator) Stop() {
+	close(sc.done)
+}
+
+func (sc *ShadowComparator) handleCapture(msg *transport.Message, now time.Time) {
+	logger := sc.logger.WithField("func", "handleCapture")
+	c, err := parseCapture(msg, now)
+	if err != nil {
+		logger.WithError(err).Warn("Ignoring invalid capture")
+		return
+	}
+	other, ok := sc.pending[c.key()]
+	if !ok || other.isMirror == c.isMirror {
+		if !ok && len(sc.pending) >= maxPendingCaptures {
+			logger.Debugf("Dropping capture of request %s of experiment %s as too many are waiting", c.requestId, c.experimentName)
+			if sc.metrics != nil {
+				sc.metrics.AddShadowDropped(c.experimentName)
+			}
+			return
+		}
+		sc.pending[c.key()] = c
+		return
+	}
+	delete(sc.pending, c.key())
+	if c.isMirror {
+		sc.compare(other, c)
+	} else {
+		sc.compare(c, other)
+	}
+}
+
+// expireCaptures counts responses whose other response did not arrive as unmatched. The default model response is
+// only captured for mirrored requests, so either side missing means the request could not be compared.
+func (sc *ShadowComparator) expireCaptures(now time.Time) {
+	logger := sc.logger.WithField("func", "expireCaptures")
+	for key, c := range sc.pending {
+		if now.Sub(c.received) < sc.matchTimeout {
+			continue
+		}
+		delete(sc.pending, key)
+		if err := sc.experimentServer.AddShadowUnmatched(c.experimentName); err != nil {
+			logger.WithError(err).Debugf("Failed to count unmatched request %s", c.requestId)
+			continue
+		}
+		if sc.metrics != nil {
+			sc.metrics.AddShadowUnmatched(c.experimentName)
+		}
+	}
+}
+
+func (sc *ShadowComparator) compare(primary *capture, mirror *capture) {
+	logger := sc.logger.WithField("func", "compare")
+	exp, err := sc.experimentServer.GetExperiment(primary.experimentName)
+	if err != nil || exp.GetShadowComparison() == nil {
+		logger.Debugf("Ignoring request %s as experiment %s does not compare its mirror", primary.requestId, primary.experimentName)
+		return
+	}
+	result := compareResponses(primary, mirror, exp.GetShadowComparison())
+	if err := sc.experimentServer.AddShadowResult(exp.Name, result); err != nil {
+		logger.WithError(err).Debugf("Failed to add comparison of request %s", primary.requestId)
+		return
+	}
+	if sc.metrics != nil {
+		sc.metrics.AddShadowComparison(exp.Name, primary.latencyMs/1000, mirror.latencyMs/1000, result.PrimaryError, result.MirrorError)
+		for _, output := range result.Outputs {
+			sc.metrics.AddShadowOutputComparison(exp.Name, output.Name, output.Mismatched)
+		}
+	}
+}
+
+// compareResponses compares the configured outputs, or all outputs of either response, of successful responses.
+// A response which can not be decoded counts as an error.
+func compareResponses(primary *capture, mirror *capture, comparison *experiment.ShadowComparison) *experiment.ShadowResult {
+	result := &experiment.ShadowResult{
+		PrimaryError:   primary.isError(),
+		MirrorError:    mirror.isError(),
+		LatencyDeltaMs: mirror.latencyMs - primary.latencyMs,
+	}
+	if result.PrimaryError || result.MirrorError {
+		return result
+	}
+	primaryOutputs, err := decodeResponse(primary.contentType, primary.data)
+	if err != nil {
+		result.PrimaryError = true
+	}
+	mirrorOutputs, err := decodeResponse(mirror.contentType, mirror.data)
+	if err != nil {
+		result.MirrorError = true
+	}
+	if result.PrimaryError || result.MirrorError {
+		return result
+	}
+	names := comparison.Outputs
+	if len(names) == 0 {
+		for name := range primaryOutputs {
+			names = append(names, name)
+		}
+		for name := range mirrorOutputs {
+			if _, ok := primaryOutputs[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+	}
+	for _, name := range names {
+		primaryOutput, inPrimary := primaryOutputs[name]
+		mirrorOutput, inMirror := mirrorOutputs[name]
+		switch {
+		case !inPrimary && !inMirror:
+			continue
+		case !inPrimary || !inMirror:
+			result.Outputs = append(result.Outputs, experiment.ShadowOutputResult{Name: name, Mismatched: true})
+		default:
+			maxAbsDiff, mismatched := compareTensors(primaryOutput, mirrorOutput, comparison.Tolerance)
+			result.Outputs = append(result.Outputs, experiment.ShadowOutputResult{Name: name, MaxAbsDiff: maxAbsDiff, Mismatched: mismatched})
+		}
+	}
+	return result
+}
@@ -0,0 +1,304 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package shadow
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/resources"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/experiment"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+type fakeExperimentServer struct {
+	experiment.ExperimentServer
+	mu          sync.Mutex
+	experiments map[string]*experiment.Experiment
+	results     []*experiment.ShadowResult
+	unmatched   int
+}
+
+func (f *fakeExperimentServer) GetExperiment(experimentName string) (*experiment.Experiment, error) {
+	if exp, ok := f.experiments[experimentName]; ok {
+		return exp, nil
+	}
+	return nil, &experiment.ExperimentNotFound{}
+}
+
+func (f *fakeExperimentServer) AddShadowResult(experimentName string, result *experiment.ShadowResult) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.results = append(f.results, result)
+	return nil
+}
+
+func (f *fakeExperimentServer) AddShadowUnmatched(experimentName string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.unmatched++
+	return nil
+}
+
+func (f *fakeExperimentServer) numResults() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.results)
+}
+
+type fakeShadowMetrics struct {
+	compared   int
+	mismatches map[string]int
+	unmatched  int
+	dropped    int
+}
+
+func (f *fakeShadowMetrics) AddShadowComparison(experimentName string, primaryLatency float64, mirrorLatency float64, primaryError bool, mirrorError bool) {
+	f.compared++
+}
+
+func (f *fakeShadowMetrics) AddShadowOutputComparison(experimentName string, outputName string, mismatched bool) {
+	if mismatched {
+		f.mismatches[outputName]++
+	}
+}
+
+func (f *fakeShadowMetrics) AddShadowUnmatched(experimentName string) {
+	f.unmatched++
+}
+
+func (f *fakeShadowMetrics) AddShadowDropped(experimentName string) {
+	f.dropped++
+}
+
+func captureMessage(experimentName string, role string, requestId string, status string, body string) *transport.Message {
+	return &transport.Message{
+		Key:   []byte(requestId),
+		Value: []byte(body),
+		Headers: []transport.Header{
+			{Key: resources.SeldonShadowHeader, Value: []byte(experimentName)},
+			{Key: resources.SeldonShadowRoleHeader, Value: []byte(role)},
+			{Key: resources.SeldonShadowStatusHeader, Value: []byte(status)},
+			{Key: resources.SeldonShadowLatencyHeader, Value: []byte("10")},
+			{Key: util.RequestIdHeader, Value: []byte(requestId)},
+			{Key: "content-type", Value: []byte("application/json")},
+		},
+	}
+}
+
+func newComparingExperiment(name string, comparison *experiment.ShadowComparison) *experiment.Experiment {
+	return &experiment.Experiment{
+		Name:   name,
+		Mirror: &experiment.Mirror{Name: "m2", Percent: 100, Comparison: comparison},
+	}
+}
+
+func TestParseCapture(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Now()
+	c, err := parseCapture(captureMessage("exp1", resources.ShadowRoleMirror, "r1", "503", "{}"), now)
+	g.Expect(err).To(BeNil())
+	g.Expect(c).To(Equal(&capture{
+		experimentName: "exp1",
+		requestId:      "r1",
+		isMirror:       true,
+		statusCode:     503,
+		latencyMs:      10,
+		contentType:    "application/json",
+		data:           []byte("{}"),
+		received:       now,
+	}))
+	g.Expect(c.isError()).To(BeTrue())
+
+	_, err = parseCapture(captureMessage("exp1", "other", "r1", "200", "{}"), now)
+	g.Expect(err).ToNot(BeNil())
+	_, err = parseCapture(captureMessage("exp1", resources.ShadowRolePrimary, "", "200", "{}"), now)
+	g.Expect(err).ToNot(BeNil())
+
+	grpcError := &capture{statusCode: 200, grpcStatus: "14"}
+	g.Expect(grpcError.isError()).To(BeTrue())
+	grpcOk := &capture{statusCode: 200, grpcStatus: "0"}
+	g.Expect(grpcOk.isError()).To(BeFalse())
+}
+
+func TestCompareResponses(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name       string
+		primary    *capture
+		mirror     *capture
+		comparison *experiment.ShadowComparison
+		expected   *experiment.ShadowResult
+	}
+
+	response := func(body string, latencyMs float64) *capture {
+		return &capture{statusCode: 200, contentType: "application/json", data: []byte(body), latencyMs: latencyMs}
+	}
+
+	tests := []test{
+		{
+			name:       "all outputs",
+			primary:    response(`{"outputs":[{"name":"a","shape":[1],"data":[1]},{"name":"b","shape":[1],"data":[2]}]}`, 10),
+			mirror:     response(`{"outputs":[{"name":"a","shape":[1],"data":[1.5]},{"name":"c","shape":[1],"data":[2]}]}`, 15),
+			comparison: &experiment.ShadowComparison{Tolerance: 1},
+			expected: &experiment.ShadowResult{
+				LatencyDeltaMs: 5,
+				Outputs: []experiment.ShadowOutputResult{
+					{Name: "a", MaxAbsDiff: 0.5},
+					{Name: "b", Mismatched: true},
+					{Name: "c", Mismatched: true},
+				},
+			},
+		},
+		{
+			name:       "configured outputs",
+			primary:    response(`{"outputs":[{"name":"a","shape":[1],"data":[1]},{"name":"b","shape":[1],"data":[2]}]}`, 10),
+			mirror:     response(`{"outputs":[{"name":"a","shape":[1],"data":[1.5]}]}`, 5),
+			comparison: &experiment.ShadowComparison{Outputs: []string{"a", "d"}},
+			expected: &experiment.ShadowResult{
+				LatencyDeltaMs: -5,
+				Outputs:        []experiment.ShadowOutputResult{{Name: "a", MaxAbsDiff: 0.5, Mismatched: true}},
+			},
+		},
+		{
+			name:       "mirror error",
+			primary:    response(`{"outputs":[]}`, 10),
+			mirror:     &capture{statusCode: 500, latencyMs: 10},
+			comparison: &experiment.ShadowComparison{},
+			expected:   &experiment.ShadowResult{MirrorError: true},
+		},
+		{
+			name:       "primary not decoded",
+			primary:    response(`not json`, 10),
+			mirror:     response(`{"outputs":[]}`, 10),
+			comparison: &experiment.ShadowComparison{},
+			expected:   &experiment.ShadowResult{PrimaryError: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(compareResponses(test.primary, test.mirror, test.comparison)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestHandleCaptures(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	es := &fakeExperimentServer{
+		experiments: map[string]*experiment.Experiment{
+			"exp1": newComparingExperiment("exp1", &experiment.ShadowComparison{}),
+			"exp2": newComparingExperiment("exp2", nil),
+		},
+	}
+	metrics := &fakeShadowMetrics{mismatches: make(map[string]int)}
+	topicNamer, err := kafka.NewTopicNamer("default", "seldon")
+	g.Expect(err).To(BeNil())
+	sc := NewShadowComparator(log.New(), nil, topicNamer, "default", "", es, metrics, time.Second)
+
+	start := time.Now()
+	primary := `{"outputs":[{"name":"a","shape":[1],"data":[1]}]}`
+	mirror := `{"outputs":[{"name":"a","shape":[1],"data":[2]}]}`
+	// joined in either order
+	sc.handleCapture(captureMessage("exp1", resources.ShadowRolePrimary, "r1", "200", primary), start)
+	sc.handleCapture(captureMessage("exp1", resources.ShadowRoleMirror, "r1", "200", mirror), start)
+	sc.handleCapture(captureMessage("exp1", resources.ShadowRoleMirror, "r2", "200", mirror), start)
+	sc.handleCapture(captureMessage("exp1", resources.ShadowRolePrimary, "r2", "200", primary), start)
+	// mirror response never arrives
+	sc.handleCapture(captureMessage("exp1", resources.ShadowRolePrimary, "r3", "200", primary), start)
+	// default model response never arrives
+	sc.handleCapture(captureMessage("exp1", resources.ShadowRoleMirror, "r4", "200", mirror), start)
+	// experiment no longer comparing
+	sc.handleCapture(captureMessage("exp2", resources.ShadowRolePrimary, "r5", "200", primary), start)
+	sc.handleCapture(captureMessage("exp2", resources.ShadowRoleMirror, "r5", "200", mirror), start)
+
+	g.Expect(es.results).To(HaveLen(2))
+	g.Expect(metrics.compared).To(Equal(2))
+	g.Expect(metrics.mismatches).To(Equal(map[string]int{"a": 2}))
+	g.Expect(sc.pending).To(HaveLen(2))
+
+	sc.expireCaptures(start.Add(500 * time.Millisecond))
+	g.Expect(sc.pending).To(HaveLen(2))
+	sc.expireCaptures(start.Add(time.Second))
+	g.Expect(sc.pending).To(BeEmpty())
+	g.Expect(es.unmatched).To(Equal(2))
+	g.Expect(metrics.unmatched).To(Equal(2))
+}
+
+func TestHandleCapturesDropped(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	es := &fakeExperimentServer{
+		experiments: map[string]*experiment.Experiment{"exp1": newComparingExperiment("exp1", &experiment.ShadowComparison{})},
+	}
+	metrics := &fakeShadowMetrics{mismatches: make(map[string]int)}
+	topicNamer, err := kafka.NewTopicNamer("default", "seldon")
+	g.Expect(err).To(BeNil())
+	sc := NewShadowComparator(log.New(), nil, topicNamer, "default", "", es, metrics, time.Second)
+
+	start := time.Now()
+	primary := `{"outputs":[{"name":"a","shape":[1],"data":[1]}]}`
+	for i := 0; i < maxPendingCaptures; i++ {
+		sc.pending[fmt.Sprintf("exp1.p%d", i)] = &capture{experimentName: "exp1", received: start}
+	}
+	sc.handleCapture(captureMessage("exp1", resources.ShadowRolePrimary, "r1", "200", primary), start)
+
+	g.Expect(sc.pending).To(HaveLen(maxPendingCaptures))
+	g.Expect(metrics.dropped).To(Equal(1))
+}
+
+func TestShadowComparator(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	streamTransport := transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic)
+	topicNamer, err := kafka.NewTopicNamer("default", "seldon")
+	g.Expect(err).To(BeNil())
+	admin, err := streamTransport.NewAdmin()
+	g.Expect(err).To(BeNil())
+	g.Expect(admin.CreateTopics(context.Background(), []transport.TopicSpec{{Name: topicNamer.GetShadowTopic()}})).To(BeNil())
+
+	es := &fakeExperimentServer{
+		experiments: map[string]*experiment.Experiment{"exp1": newComparingExperiment("exp1", &experiment.ShadowComparison{})},
+	}
+	sc := NewShadowComparator(log.New(), streamTransport, topicNamer, "default", "", es, nil, DefaultMatchTimeout)
+	go func() {
+		g.Expect(sc.Start()).To(BeNil())
+	}()
+	defer sc.Stop()
+
+	producer, err := streamTransport.NewProducer()
+	g.Expect(err).To(BeNil())
+	for _, role := range []string{resources.ShadowRolePrimary, resources.ShadowRoleMirror} {
+		msg := captureMessage("exp1", role, "r1", "200", `{"outputs":[{"name":"a","shape":[1],"data":[1]}]}`)
+		msg.Topic = topicNamer.GetShadowTopic()
+		g.Expect(producer.Produce(msg, nil)).To(BeNil())
+	}
+
+	g.Eventually(es.numResults).Should(Equal(1))
+	g.Expect(es.results[0]).To(Equal(&experiment.ShadowResult{Outputs: []experiment.ShadowOutputResult{{Name: "a"}}}))
+}
+
+func TestGetShadowConsumerGroupId(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(getShadowConsumerGroupId("default", "")).To(Equal("default-seldon-scheduler-shadow"))
+	g.Expect(getShadowConsumerGroupId("default", "prefix")).To(Equal("prefix-default-seldon-scheduler-shadow"))
+}
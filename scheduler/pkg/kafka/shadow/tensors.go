@@ -0,0 +1,303 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package shadow
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+)
+
+const (
+	tyBool   = "BOOL"
+	tyUint8  = "UINT8"
+	tyUint16 = "UINT16"
+	tyUint32 = "UINT32"
+	tyUint64 = "UINT64"
+	tyInt8   = "INT8"
+	tyInt16  = "INT16"
+	tyInt32  = "INT32"
+	tyInt64  = "INT64"
+	tyFp16   = "FP16"
+	tyFp32   = "FP32"
+	tyFp64   = "FP64"
+	tyBytes  = "BYTES"
+
+	grpcContentTypePrefix = "application/grpc"
+	grpcMessageHeaderSize = 5 // compressed flag and message length
+)
+
+var rawElementSizes = map[string]int{
+	tyBool:   1,
+	tyUint8:  1,
+	tyUint16: 2,
+	tyUint32: 4,
+	tyUint64: 8,
+	tyInt8:   1,
+	tyInt16:  2,
+	tyInt32:  4,
+	tyInt64:  8,
+	tyFp16:   2,
+	tyFp32:   4,
+	tyFp64:   8,
+}
+
+// tensor holds the flattened elements of an output, numeric and boolean elements as numbers and others as strings
+type tensor struct {
+	shape   []int64
+	numbers []float64
+	strings []string
+}
+
+// decodeResponse returns the outputs of a V2 inference response in JSON or in a gRPC message by name
+func decodeResponse(contentType string, data []byte) (map[string]*tensor, error) {
+	if strings.HasPrefix(contentType, grpcContentTypePrefix) {
+		return decodeGrpcResponse(data)
+	}
+	return decodeJsonResponse(data)
+}
+
+func decodeJsonResponse(data []byte) (map[string]*tensor, error) {
+	response := struct {
+		Outputs []struct {
+			Name  string          `json:"name"`
+			Shape []int64         `json:"shape"`
+			Data  json.RawMessage `json:"data"`
+		} `json:"outputs"`
+	}{}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+	tensors := make(map[string]*tensor)
+	for _, output := range response.Outputs {
+		var elements []interface{}
+		if err := json.Unmarshal(output.Data, &elements); err != nil {
+			return nil, fmt.Errorf("failed to decode data of output %s: %w", output.Name, err)
+		}
+		t := &tensor{shape: output.Shape}
+		flattenJsonElements(elements, t)
+		tensors[output.Name] = t
+	}
+	return tensors, nil
+}
+
+func flattenJsonElements(elements []interface{}, t *tensor) {
+	for _, element := range elements {
+		switch value := element.(type) {
+		case []interface{}:
+			flattenJsonElements(value, t)
+		case float64:
+			t.numbers = append(t.numbers, value)
+		case bool:
+			t.numbers = append(t.numbers, boolToFloat(value))
+		default:
+			t.strings = append(t.strings, fmt.Sprint(value))
+		}
+	}
+}
+
+func decodeGrpcResponse(data []byte) (map[string]*tensor, error) {
+	if len(data) < grpcMessageHeaderSize {
+		return nil, fmt.Errorf("gRPC message of %d bytes is too short", len(data))
+	}
+	if data[0] != 0 {
+		return nil, fmt.Errorf("compressed gRPC messages are not supported")
+	}
+	length := binary.BigEndian.Uint32(data[1:grpcMessageHeaderSize])
+	if uint64(length) > uint64(len(data)-grpcMessageHeaderSize) {
+		return nil, fmt.Errorf("gRPC message length %d is beyond the %d bytes captured", length, len(data))
+	}
+	response := &v2_dataplane.ModelInferResponse{}
+	if err := proto.Unmarshal(data[grpcMessageHeaderSize:grpcMessageHeaderSize+length], response); err != nil {
+		return nil, err
+	}
+	tensors := make(map[string]*tensor)
+	for idx, output := range response.Outputs {
+		t := &tensor{shape: output.Shape}
+		// raw contents are used for all outputs or none
+		if idx < len(response.RawOutputContents) {
+			if err := decodeRawContents(output.Datatype, response.RawOutputContents[idx], t); err != nil {
+				return nil, fmt.Errorf("failed to decode raw contents of output %s: %w", output.Name, err)
+			}
+		} else {
+			appendContents(output.Contents, t)
+		}
+		tensors[output.Name] = t
+	}
+	return tensors, nil
+}
+
+func appendContents(contents *v2_dataplane.InferTensorContents, t *tensor) {
+	for _, value := range contents.GetBoolContents() {
+		t.numbers = append(t.numbers, boolToFloat(value))
+	}
+	for _, value := range contents.GetIntContents() {
+		t.numbers = append(t.numbers, float64(value))
+	}
+	for _, value := range contents.GetInt64Contents() {
+		t.numbers = append(t.numbers, float64(value))
+	}
+	for _, value := range contents.GetUintContents() {
+		t.numbers = append(t.numbers, float64(value))
+	}
+	for _, value := range contents.GetUint64Contents() {
+		t.numbers = append(t.numbers, float64(value))
+	}
+	for _, value := range contents.GetFp32Contents() {
+		t.numbers = append(t.numbers, float64(value))
+	}
+	for _, value := range contents.GetFp64Contents() {
+		t.numbers = append(t.numbers, value)
+	}
+	for _, value := range contents.GetBytesContents() {
+		t.strings = append(t.strings, string(value))
+	}
+}
+
+// decodeRawContents reads little endian elements, with each BYTES element prefixed by its 4 byte length
+func decodeRawContents(datatype string, raw []byte, t *tensor) error {
+	if datatype == tyBytes {
+		for offset := 0; offset < len(raw); {
+			if offset+4 > len(raw) {
+				return fmt.Errorf("truncated length of BYTES element at offset %d", offset)
+			}
+			elementLen := int(binary.LittleEndian.Uint32(raw[offset:]))
+			offset += 4
+			if elementLen > len(raw)-offset {
+				return fmt.Errorf("truncated BYTES element at offset %d", offset)
+			}
+			t.strings = append(t.strings, string(raw[offset:offset+elementLen]))
+			offset += elementLen
+		}
+		return nil
+	}
+	size, ok := rawElementSizes[datatype]
+	if !ok {
+		return fmt.Errorf("unknown datatype %s", datatype)
+	}
+	if len(raw)%size != 0 {
+		return fmt.Errorf("%d bytes are not a whole number of %s elements", len(raw), datatype)
+	}
+	for offset := 0; offset < len(raw); offset += size {
+		element := raw[offset : offset+size]
+		var value float64
+		switch datatype {
+		case tyBool, tyUint8:
+			value = float64(element[0])
+		case tyInt8:
+			value = float64(int8(element[0]))
+		case tyUint16:
+			value = float64(binary.LittleEndian.Uint16(element))
+		case tyInt16:
+			value = float64(int16(binary.LittleEndian.Uint16(element)))
+		case tyUint32:
+			value = float64(binary.LittleEndian.Uint32(element))
+		case tyInt32:
+			value = float64(int32(binary.LittleEndian.Uint32(element)))
+		case tyUint64:
+			value = float64(binary.LittleEndian.Uint64(element))
+		case tyInt64:
+			value = float64(int64(binary.LittleEndian.Uint64(element)))
+		case tyFp16:
+			value = float16ToFloat64(binary.LittleEndian.Uint16(element))
+		case tyFp32:
+			value = float64(math.Float32frombits(binary.LittleEndian.Uint32(element)))
+		case tyFp64:
+			value = math.Float64frombits(binary.LittleEndian.Uint64(element))
+		}
+		t.numbers = append(t.numbers, value)
+	}
+	return nil
+}
+
+// float16ToFloat64 converts an IEEE 754 half precision number
+func float16ToFloat64(bits uint16) float64 {
+	sign := 1.0
+	if bits&0x8000 != 0 {
+		sign = -1.0
+	}
+	exponent := int(bits>>10) & 0x1f
+	fraction := float64(bits & 0x3ff)
+	switch exponent {
+	case 0:
+		return sign * math.Ldexp(fraction, -24)
+	case 0x1f:
+		if fraction == 0 {
+			return math.Inf(int(sign))
+		}
+		return math.NaN()
+	}
+	return sign * math.Ldexp(fraction+1024, exponent-25)
+}
+
+func boolToFloat(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+// compareTensors returns the largest absolute difference of finite elements and whether the tensors differ beyond the
+// tolerance. Tensors of different shapes or with different non-numeric, NaN or infinite elements are mismatched.
+func compareTensors(primary *tensor, mirror *tensor, tolerance float64) (float64, bool) {
+	if !equalShapes(primary.shape, mirror.shape) ||
+		len(primary.numbers) != len(mirror.numbers) || len(primary.strings) != len(mirror.strings) {
+		return 0, true
+	}
+	mismatched := false
+	for idx, value := range primary.strings {
+		if value != mirror.strings[idx] {
+			mismatched = true
+		}
+	}
+	maxAbsDiff := 0.0
+	for idx, value := range primary.numbers {
+		other := mirror.numbers[idx]
+		if math.IsNaN(value) || math.IsNaN(other) {
+			if math.IsNaN(value) != math.IsNaN(other) {
+				mismatched = true
+			}
+			continue
+		}
+		if value == other {
+			continue
+		}
+		if math.IsInf(value, 0) || math.IsInf(other, 0) {
+			mismatched = true
+			continue
+		}
+		diff := math.Abs(value - other)
+		if diff > maxAbsDiff {
+			maxAbsDiff = diff
+		}
+	}
+	if maxAbsDiff > tolerance {
+		mismatched = true
+	}
+	return maxAbsDiff, mismatched
+}
+
+func equalShapes(a []int64, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for idx := range a {
+		if a[idx] != b[idx] {
+			return false
+		}
+	}
+	return true
+}
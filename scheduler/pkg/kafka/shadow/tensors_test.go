@@ -0,0 +1,227 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package shadow
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+)
+
+func grpcFrame(g *WithT, response *v2_dataplane.ModelInferResponse) []byte {
+	message, err := proto.Marshal(response)
+	g.Expect(err).To(BeNil())
+	frame := make([]byte, grpcMessageHeaderSize, grpcMessageHeaderSize+len(message))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(message)))
+	return append(frame, message...)
+}
+
+func TestDecodeResponse(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	fp32 := make([]byte, 8)
+	binary.LittleEndian.PutUint32(fp32, math.Float32bits(1.5))
+	binary.LittleEndian.PutUint32(fp32[4:], math.Float32bits(-2))
+
+	type test struct {
+		name        string
+		contentType string
+		data        func(g *WithT) []byte
+		expected    map[string]*tensor
+		err         bool
+	}
+
+	tests := []test{
+		{
+			name:        "json",
+			contentType: "application/json",
+			data: func(_ *WithT) []byte {
+				return []byte(`{"outputs":[{"name":"a","shape":[2,2],"datatype":"FP32","data":[[1,2],[3,4.5]]},` +
+					`{"name":"b","shape":[2],"datatype":"BOOL","data":[true,false]},` +
+					`{"name":"c","shape":[1],"datatype":"BYTES","data":["x"]}]}`)
+			},
+			expected: map[string]*tensor{
+				"a": {shape: []int64{2, 2}, numbers: []float64{1, 2, 3, 4.5}},
+				"b": {shape: []int64{2}, numbers: []float64{1, 0}},
+				"c": {shape: []int64{1}, strings: []string{"x"}},
+			},
+		},
+		{
+			name:        "invalid json",
+			contentType: "application/json",
+			data:        func(_ *WithT) []byte { return []byte(`{"outputs":`) },
+			err:         true,
+		},
+		{
+			name:        "grpc contents",
+			contentType: "application/grpc",
+			data: func(g *WithT) []byte {
+				return grpcFrame(g, &v2_dataplane.ModelInferResponse{
+					Outputs: []*v2_dataplane.ModelInferResponse_InferOutputTensor{
+						{Name: "a", Datatype: tyInt64, Shape: []int64{2}, Contents: &v2_dataplane.InferTensorContents{Int64Contents: []int64{7, -8}}},
+						{Name: "b", Datatype: tyBytes, Shape: []int64{1}, Contents: &v2_dataplane.InferTensorContents{BytesContents: [][]byte{[]byte("x")}}},
+					},
+				})
+			},
+			expected: map[string]*tensor{
+				"a": {shape: []int64{2}, numbers: []float64{7, -8}},
+				"b": {shape: []int64{1}, strings: []string{"x"}},
+			},
+		},
+		{
+			name:        "grpc raw contents",
+			contentType: "application/grpc+proto",
+			data: func(g *WithT) []byte {
+				return grpcFrame(g, &v2_dataplane.ModelInferResponse{
+					Outputs: []*v2_dataplane.ModelInferResponse_InferOutputTensor{
+						{Name: "a", Datatype: tyFp32, Shape: []int64{2}},
+						{Name: "b", Datatype: tyFp16, Shape: []int64{1}},
+						{Name: "c", Datatype: tyBytes, Shape: []int64{1}},
+					},
+					RawOutputContents: [][]byte{fp32, {0x00, 0x3c}, {2, 0, 0, 0, 'h', 'i'}},
+				})
+			},
+			expected: map[string]*tensor{
+				"a": {shape: []int64{2}, numbers: []float64{1.5, -2}},
+				"b": {shape: []int64{1}, numbers: []float64{1}},
+				"c": {shape: []int64{1}, strings: []string{"hi"}},
+			},
+		},
+		{
+			name:        "grpc truncated raw contents",
+			contentType: "application/grpc",
+			data: func(g *WithT) []byte {
+				return grpcFrame(g, &v2_dataplane.ModelInferResponse{
+					Outputs:           []*v2_dataplane.ModelInferResponse_InferOutputTensor{{Name: "a", Datatype: tyFp32, Shape: []int64{1}}},
+					RawOutputContents: [][]byte{{0, 0}},
+				})
+			},
+			err: true,
+		},
+		{
+			name:        "grpc compressed",
+			contentType: "application/grpc",
+			data:        func(_ *WithT) []byte { return []byte{1, 0, 0, 0, 0} },
+			err:         true,
+		},
+		{
+			name:        "grpc truncated message",
+			contentType: "application/grpc",
+			data:        func(_ *WithT) []byte { return []byte{0, 0, 0, 0, 10, 1} },
+			err:         true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tensors, err := decodeResponse(test.contentType, test.data(g))
+			if test.err {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(tensors).To(Equal(test.expected))
+			}
+		})
+	}
+}
+
+func TestFloat16ToFloat64(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(float16ToFloat64(0x3c00)).To(Equal(1.0))
+	g.Expect(float16ToFloat64(0xc000)).To(Equal(-2.0))
+	g.Expect(float16ToFloat64(0x3555)).To(BeNumerically("~", 0.333, 0.001))
+	g.Expect(float16ToFloat64(0x0001)).To(Equal(math.Ldexp(1, -24)))
+	g.Expect(math.IsInf(float16ToFloat64(0xfc00), -1)).To(BeTrue())
+	g.Expect(math.IsNaN(float16ToFloat64(0x7e00))).To(BeTrue())
+}
+
+func TestCompareTensors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name               string
+		primary            *tensor
+		mirror             *tensor
+		tolerance          float64
+		expectedMaxAbsDiff float64
+		expectedMismatched bool
+	}
+
+	tests := []test{
+		{
+			name:               "equal",
+			primary:            &tensor{shape: []int64{2}, numbers: []float64{1, 2}},
+			mirror:             &tensor{shape: []int64{2}, numbers: []float64{1, 2}},
+			expectedMaxAbsDiff: 0,
+		},
+		{
+			name:               "within tolerance",
+			primary:            &tensor{shape: []int64{2}, numbers: []float64{1, 2}},
+			mirror:             &tensor{shape: []int64{2}, numbers: []float64{1.25, 1.5}},
+			tolerance:          0.5,
+			expectedMaxAbsDiff: 0.5,
+		},
+		{
+			name:               "beyond tolerance",
+			primary:            &tensor{shape: []int64{2}, numbers: []float64{1, 2}},
+			mirror:             &tensor{shape: []int64{2}, numbers: []float64{1.25, 1.5}},
+			tolerance:          0.1,
+			expectedMaxAbsDiff: 0.5,
+			expectedMismatched: true,
+		},
+		{
+			name:               "different shapes",
+			primary:            &tensor{shape: []int64{1, 2}, numbers: []float64{1, 2}},
+			mirror:             &tensor{shape: []int64{2, 1}, numbers: []float64{1, 2}},
+			expectedMismatched: true,
+		},
+		{
+			name:               "different strings",
+			primary:            &tensor{shape: []int64{1}, strings: []string{"a"}},
+			mirror:             &tensor{shape: []int64{1}, strings: []string{"b"}},
+			tolerance:          1,
+			expectedMismatched: true,
+		},
+		{
+			name:    "both NaN",
+			primary: &tensor{shape: []int64{1}, numbers: []float64{math.NaN()}},
+			mirror:  &tensor{shape: []int64{1}, numbers: []float64{math.NaN()}},
+		},
+		{
+			name:               "one NaN",
+			primary:            &tensor{shape: []int64{1}, numbers: []float64{math.NaN()}},
+			mirror:             &tensor{shape: []int64{1}, numbers: []float64{1}},
+			tolerance:          1,
+			expectedMismatched: true,
+		},
+		{
+			name:               "different infinities",
+			primary:            &tensor{shape: []int64{2}, numbers: []float64{math.Inf(1), 1}},
+			mirror:             &tensor{shape: []int64{2}, numbers: []float64{math.Inf(-1), 1.5}},
+			tolerance:          1,
+			expectedMaxAbsDiff: 0.5,
+			expectedMismatched: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			maxAbsDiff, mismatched := compareTensors(test.primary, test.mirror, test.tolerance)
+			g.Expect(maxAbsDiff).To(Equal(test.expectedMaxAbsDiff))
+			g.Expect(mismatched).To(Equal(test.expectedMismatched))
+		})
+	}
+}
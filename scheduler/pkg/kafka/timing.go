@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package kafka
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+)
+
+// ProduceTimeHeader holds the time a message was produced in unix milliseconds.
+// Kafka Streams keeps the timestamp of the input record on the records it produces, so the Kafka timestamp of a
+// message written by the dataflow engine is the time the pipeline was called rather than the time of the step.
+const ProduceTimeHeader = "seldon-produce-time"
+
+// SetProduceTime replaces any produce time copied from the request with the given time
+func SetProduceTime(headers []transport.Header, now time.Time) []transport.Header {
+	filtered := make([]transport.Header, 0, len(headers)+1)
+	for _, header := range headers {
+		if header.Key != ProduceTimeHeader {
+			filtered = append(filtered, header)
+		}
+	}
+	return append(filtered, transport.Header{
+		Key:   ProduceTimeHeader,
+		Value: []byte(strconv.FormatInt(now.UnixMilli(), 10)),
+	})
+}
+
+// GetQueueTime returns how long a message waited on its topic, using the produce time header and falling back to
+// the Kafka timestamp of the message. Clock skew between hosts can make the time negative, which is reported as zero.
+func GetQueueTime(msg *transport.Message, now time.Time) (time.Duration, bool) {
+	produced := msg.Timestamp
+	for _, header := range msg.Headers {
+		if header.Key != ProduceTimeHeader {
+			continue
+		}
+		millis, err := strconv.ParseInt(string(header.Value), 10, 64)
+		if err == nil {
+			produced = time.UnixMilli(millis)
+		}
+	}
+	if produced.IsZero() {
+		return 0, false
+	}
+	queueTime := now.Sub(produced)
+	if queueTime < 0 {
+		queueTime = 0
+	}
+	return queueTime, true
+}
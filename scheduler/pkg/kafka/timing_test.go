@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package kafka
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
+)
+
+func TestSetProduceTime(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.UnixMilli(1700000000123)
+	headers := []transport.Header{
+		{Key: "pipeline", Value: []byte("p1")},
+		{Key: ProduceTimeHeader, Value: []byte("1")},
+	}
+
+	updated := SetProduceTime(headers, now)
+
+	g.Expect(updated).To(Equal([]transport.Header{
+		{Key: "pipeline", Value: []byte("p1")},
+		{Key: ProduceTimeHeader, Value: []byte("1700000000123")},
+	}))
+	// the headers of the request are left as they were
+	g.Expect(headers[1].Value).To(Equal([]byte("1")))
+}
+
+func TestGetQueueTime(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.UnixMilli(1700000010000)
+	type test struct {
+		name       string
+		msg        *transport.Message
+		expected   time.Duration
+		expectedOk bool
+	}
+
+	tests := []test{
+		{
+			name: "header",
+			msg: &transport.Message{
+				Headers:   []transport.Header{{Key: ProduceTimeHeader, Value: []byte("1700000009500")}},
+				Timestamp: time.UnixMilli(1700000000000),
+			},
+			expected:   500 * time.Millisecond,
+			expectedOk: true,
+		},
+		{
+			name:       "timestamp",
+			msg:        &transport.Message{Timestamp: time.UnixMilli(1700000008000)},
+			expected:   2 * time.Second,
+			expectedOk: true,
+		},
+		{
+			name: "invalid header uses timestamp",
+			msg: &transport.Message{
+				Headers:   []transport.Header{{Key: ProduceTimeHeader, Value: []byte("abc")}},
+				Timestamp: time.UnixMilli(1700000009000),
+			},
+			expected:   time.Second,
+			expectedOk: true,
+		},
+		{
+			name: "clock skew",
+			msg: &transport.Message{
+				Headers: []transport.Header{{Key: ProduceTimeHeader, Value: []byte("1700000011000")}},
+			},
+			expected:   0,
+			expectedOk: true,
+		},
+		{
+			name:       "unknown",
+			msg:        &transport.Message{},
+			expected:   0,
+			expectedOk: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			queueTime, ok := GetQueueTime(test.msg, now)
+			g.Expect(ok).To(Equal(test.expectedOk))
+			g.Expect(queueTime).To(Equal(test.expected))
+		})
+	}
+}
@@ -27,8 +27,25 @@ const (
 	inputsSuffix             = "inputs"
 	outputsSuffix            = "outputs"
 	errorsSuffix             = "errors"
+	shadowTopic              = "shadow"
+	capturesSuffix           = "captures"
+	replaySuffix             = "replay"
 	TopicErrorHeader         = "seldon-pipeline-errors"
 	TopicSeparator           = "."
+	// Views of pipeline topics in other formats are written to topics with the format as an extra suffix
+	ViewFormatAvro = "avro"
+	ViewFormatJson = "json"
+	// Requests with the priority header set to low are sent to the low priority input topic of a model, which has
+	// the priority as an extra suffix, so bulk traffic does not delay real-time traffic
+	PriorityHeader = "x-seldon-priority"
+	PriorityHigh   = "high"
+	PriorityLow    = "low"
+	// Headers read by the dataflow engine to run a request through one pipeline version, to write the outputs
+	// of replayed requests to a separate topic and to gather the elements of forEach steps in order. They are only
+	// set by Seldon components and never taken from clients.
+	PipelineVersionHeader = "x-seldon-pipeline-version"
+	ReplaySinkHeader      = "x-seldon-replay-sink"
+	ForEachIndexHeader    = "x-seldon-foreach-index"
 )
 
 type TopicNamer struct {
@@ -57,10 +74,20 @@ func NewTopicNamer(namespace string, topicPrefix string) (*TopicNamer, error) {
 	}, nil
 }
 
+// GetInternalTopicPrefix returns the prefix of all topics of Seldon in the namespace
+func (tn *TopicNamer) GetInternalTopicPrefix() string {
+	return tn.topicPrefix + TopicSeparator + tn.namespace + TopicSeparator
+}
+
 func (tn *TopicNamer) GetModelErrorTopic() string {
 	return strings.Join([]string{tn.topicPrefix, tn.namespace, errorsTopic, errorsSuffix}, TopicSeparator)
 }
 
+// GetShadowTopic returns the topic of the responses captured for experiments comparing their mirror
+func (tn *TopicNamer) GetShadowTopic() string {
+	return strings.Join([]string{tn.topicPrefix, tn.namespace, shadowTopic, capturesSuffix}, TopicSeparator)
+}
+
 func (tn *TopicNamer) GetKafkaModelTopicRegex() string {
 	return fmt.Sprintf("^%s%s.%s.*.%s", tn.topicPrefix, tn.namespace, modelTopic, inputsSuffix)
 }
@@ -79,10 +106,67 @@ func (tn *TopicNamer) GetModelNameFromModelInputTopic(topic string) (string, err
 	return parts[2], nil
 }
 
+// GetOwnerFromTopic returns whether the topic holds the inputs or outputs of a model or a pipeline, or a view or
+// low priority lane of them, and its name. Other topics, such as those of other namespaces, return false.
+func (tn *TopicNamer) GetOwnerFromTopic(topic string) (isModel bool, name string, ok bool) {
+	for _, suffix := range []string{ViewFormatAvro, ViewFormatJson, PriorityLow} {
+		if base, found := strings.CutSuffix(topic, TopicSeparator+suffix); found {
+			topic = base
+			break
+		}
+	}
+	return tn.parseTopic(topic)
+}
+
+// IsPipelineTopic returns whether the topic holds the inputs or outputs of a pipeline
+func (tn *TopicNamer) IsPipelineTopic(topic string) bool {
+	isModel, _, ok := tn.parseTopic(topic)
+	return ok && !isModel
+}
+
+func (tn *TopicNamer) GetViewTopic(topic string, view string) string {
+	return topic + TopicSeparator + view
+}
+
+func (tn *TopicNamer) parseTopic(topic string) (isModel bool, name string, ok bool) {
+	rest, found := strings.CutPrefix(topic, tn.topicPrefix+TopicSeparator+tn.namespace+TopicSeparator)
+	if !found {
+		return false, "", false
+	}
+	owner, rest, found := strings.Cut(rest, TopicSeparator)
+	if !found || (owner != modelTopic && owner != pipelineTopic) {
+		return false, "", false
+	}
+	for _, suffix := range []string{inputsSuffix, outputsSuffix} {
+		if name, found := strings.CutSuffix(rest, TopicSeparator+suffix); found && name != "" {
+			return owner == modelTopic, name, true
+		}
+	}
+	return false, "", false
+}
+
 func (tn *TopicNamer) GetModelTopicInputs(modelName string) string {
 	return strings.Join([]string{tn.topicPrefix, tn.namespace, modelTopic, modelName, inputsSuffix}, TopicSeparator)
 }
 
+func (tn *TopicNamer) GetModelTopicInputsLowPriority(modelName string) string {
+	return tn.GetModelTopicInputs(modelName) + TopicSeparator + PriorityLow
+}
+
+func (tn *TopicNamer) IsLowPriorityTopic(topic string) bool {
+	return strings.HasSuffix(topic, TopicSeparator+inputsSuffix+TopicSeparator+PriorityLow)
+}
+
+// ValidatePriority accepts an empty priority, which is treated as high
+func ValidatePriority(priority string) error {
+	switch priority {
+	case "", PriorityHigh, PriorityLow:
+		return nil
+	default:
+		return fmt.Errorf("invalid priority %s, must be %s or %s", priority, PriorityHigh, PriorityLow)
+	}
+}
+
 func (tn *TopicNamer) GetModelTopicOutputs(modelName string) string {
 	return strings.Join([]string{tn.topicPrefix, tn.namespace, modelTopic, modelName, outputsSuffix}, TopicSeparator)
 }
@@ -95,6 +179,11 @@ func (tn *TopicNamer) GetPipelineTopicOutputs(pipelineName string) string {
 	return strings.Join([]string{tn.topicPrefix, tn.namespace, pipelineTopic, pipelineName, outputsSuffix}, TopicSeparator)
 }
 
+// GetPipelineTopicReplay returns the default topic the outputs of requests replayed through a pipeline are written to
+func (tn *TopicNamer) GetPipelineTopicReplay(pipelineName string) string {
+	return strings.Join([]string{tn.topicPrefix, tn.namespace, pipelineTopic, pipelineName, replaySuffix}, TopicSeparator)
+}
+
 func (tn *TopicNamer) GetModelOrPipelineTopicAndTensor(pipelineName string, stepReference string) (string, *string) {
 	stepName := strings.Split(stepReference, pipeline.StepNameSeperator)[0]
 	stepReference, tensor := tn.getTopicReferenceAndTensor(stepReference)
@@ -293,6 +293,12 @@ func TestGetModelNameFromModelInputTopic(t *testing.T) {
 			topic:         "seldon.default.model.mymodel.inputs",
 			expectedModel: "mymodel",
 		},
+		{
+			name:          "model from low priority topic ok",
+			namespace:     "default",
+			topic:         "seldon.default.model.mymodel.inputs.low",
+			expectedModel: "mymodel",
+		},
 		{
 			name:          "model from topic ok with dot in prefix",
 			namespace:     "default",
@@ -468,3 +474,182 @@ func TestNewTopicNamer(t *testing.T) {
 		})
 	}
 }
+
+func TestGetOwnerFromTopic(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name            string
+		topicPrefix     string
+		topic           string
+		expectedIsModel bool
+		expectedName    string
+		expectedOk      bool
+	}
+
+	tests := []test{
+		{
+			name:            "model inputs",
+			topic:           "seldon.default.model.mymodel.inputs",
+			expectedIsModel: true,
+			expectedName:    "mymodel",
+			expectedOk:      true,
+		},
+		{
+			name:            "model outputs with dot in name",
+			topic:           "seldon.default.model.my.model.outputs",
+			expectedIsModel: true,
+			expectedName:    "my.model",
+			expectedOk:      true,
+		},
+		{
+			name:         "pipeline outputs",
+			topic:        "seldon.default.pipeline.mypipeline.outputs",
+			expectedName: "mypipeline",
+			expectedOk:   true,
+		},
+		{
+			name:         "pipeline outputs avro view",
+			topic:        "seldon.default.pipeline.mypipeline.outputs.avro",
+			expectedName: "mypipeline",
+			expectedOk:   true,
+		},
+		{
+			name:         "pipeline inputs json view",
+			topic:        "seldon.default.pipeline.mypipeline.inputs.json",
+			expectedName: "mypipeline",
+			expectedOk:   true,
+		},
+		{
+			name:            "model inputs low priority lane",
+			topic:           "seldon.default.model.mymodel.inputs.low",
+			expectedIsModel: true,
+			expectedName:    "mymodel",
+			expectedOk:      true,
+		},
+		{
+			name:            "custom prefix",
+			topicPrefix:     "foo.bar",
+			topic:           "foo.bar.default.model.mymodel.inputs",
+			expectedIsModel: true,
+			expectedName:    "mymodel",
+			expectedOk:      true,
+		},
+		{
+			name:  "other namespace",
+			topic: "seldon.other.model.mymodel.inputs",
+		},
+		{
+			name:  "errors topic",
+			topic: "seldon.default.errors.errors",
+		},
+		{
+			name:  "shadow topic",
+			topic: "seldon.default.shadow.captures",
+		},
+		{
+			name:  "no name",
+			topic: "seldon.default.model.inputs",
+		},
+		{
+			name:  "not a seldon topic",
+			topic: "__consumer_offsets",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tn, err := NewTopicNamer("default", test.topicPrefix)
+			g.Expect(err).To(BeNil())
+			isModel, name, ok := tn.GetOwnerFromTopic(test.topic)
+			g.Expect(ok).To(Equal(test.expectedOk))
+			g.Expect(isModel).To(Equal(test.expectedIsModel))
+			g.Expect(name).To(Equal(test.expectedName))
+		})
+	}
+}
+
+func TestIsPipelineTopic(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name     string
+		topic    string
+		expected bool
+	}
+
+	tests := []test{
+		{
+			name:     "pipeline inputs",
+			topic:    "seldon.default.pipeline.mypipeline.inputs",
+			expected: true,
+		},
+		{
+			name:     "pipeline outputs",
+			topic:    "seldon.default.pipeline.mypipeline.outputs",
+			expected: true,
+		},
+		{
+			name:  "pipeline view",
+			topic: "seldon.default.pipeline.mypipeline.outputs.avro",
+		},
+		{
+			name:  "model outputs",
+			topic: "seldon.default.model.mymodel.outputs",
+		},
+		{
+			name:  "other namespace",
+			topic: "seldon.other.pipeline.mypipeline.inputs",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tn, err := NewTopicNamer("default", "")
+			g.Expect(err).To(BeNil())
+			g.Expect(tn.IsPipelineTopic(test.topic)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestLowPriorityTopic(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tn, err := NewTopicNamer("default", "")
+	g.Expect(err).To(BeNil())
+	topic := tn.GetModelTopicInputsLowPriority("mymodel")
+	g.Expect(topic).To(Equal("seldon.default.model.mymodel.inputs.low"))
+	g.Expect(tn.IsLowPriorityTopic(topic)).To(BeTrue())
+	g.Expect(tn.IsLowPriorityTopic(tn.GetModelTopicInputs("mymodel"))).To(BeFalse())
+	g.Expect(tn.IsLowPriorityTopic(tn.GetModelTopicOutputs("low"))).To(BeFalse())
+}
+
+func TestShadowTopic(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tn, err := NewTopicNamer("ns1", "custom")
+	g.Expect(err).To(BeNil())
+	g.Expect(tn.GetShadowTopic()).To(Equal("custom.ns1.shadow.captures"))
+	_, _, ok := tn.GetOwnerFromTopic(tn.GetShadowTopic())
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestInternalTopicPrefix(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tn, err := NewTopicNamer("ns1", "custom")
+	g.Expect(err).To(BeNil())
+	g.Expect(tn.GetInternalTopicPrefix()).To(Equal("custom.ns1."))
+	for _, topic := range []string{tn.GetShadowTopic(), tn.GetModelTopicInputs("m1"), tn.GetPipelineTopicOutputs("p1")} {
+		g.Expect(topic).To(HavePrefix(tn.GetInternalTopicPrefix()))
+	}
+}
+
+func TestValidatePriority(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	for _, priority := range []string{"", PriorityHigh, PriorityLow} {
+		g.Expect(ValidatePriority(priority)).To(BeNil())
+	}
+	g.Expect(ValidatePriority("urgent")).ToNot(BeNil())
+}
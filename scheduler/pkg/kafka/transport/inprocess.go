@@ -0,0 +1,472 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package transport
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	DefaultInProcessMaxMessagesPerTopic = 10000
+)
+
+// InProcessTransport is a broker held in memory. Messages are only seen by producers and consumers created from
+// the same transport and are not persisted, so it suits tests and components running in a single process.
+// A new consumer group reads each topic from the oldest message kept.
+type InProcessTransport struct {
+	mu                  sync.Mutex
+	topics              map[string]*inProcessTopic
+	offsets             map[string]map[string]int // consumer group -> topic -> next offset
+	members             map[string]int            // consumer group -> open consumers
+	notify              chan struct{}
+	maxMessagesPerTopic int
+}
+
+type inProcessTopic struct {
+	base          int // offset of the first message kept
+	messages      []*Message
+	numPartitions int // recorded from the admin client, messages are kept in a single ordered log
+	config        map[string]string
+}
+
+func NewInProcessTransport(maxMessagesPerTopic int) *InProcessTransport {
+	return &InProcessTransport{
+		topics:              make(map[string]*inProcessTopic),
+		offsets:             make(map[string]map[string]int),
+		members:             make(map[string]int),
+		notify:              make(chan struct{}),
+		maxMessagesPerTopic: maxMessagesPerTopic,
+	}
+}
+
+func (t *InProcessTransport) NewProducer() (Producer, error) {
+	return &inProcessProducer{transport: t}, nil
+}
+
+// NewConsumer creates a consumer which reads topics from their first retained message, so options have no effect
+func (t *InProcessTransport) NewConsumer(groupId string, _ ...ConsumerOption) (Consumer, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.offsets[groupId]; !ok {
+		t.offsets[groupId] = make(map[string]int)
+	}
+	t.members[groupId]++
+	return &inProcessConsumer{transport: t, groupId: groupId}, nil
+}
+
+func (t *InProcessTransport) NewAdmin() (Admin, error) {
+	return &inProcessAdmin{transport: t}, nil
+}
+
+// NumMessages returns the number of messages kept across all topics
+func (t *InProcessTransport) NumMessages() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	count := 0
+	for _, topic := range t.topics {
+		count += len(topic.messages)
+	}
+	return count
+}
+
+// DescribeTopic returns the partitions and config recorded for a topic and whether the topic exists
+func (t *InProcessTransport) DescribeTopic(name string) (TopicSpec, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	topic, ok := t.topics[name]
+	if !ok {
+		return TopicSpec{}, false
+	}
+	config := make(map[string]string, len(topic.config))
+	for k, v := range topic.config {
+		config[k] = v
+	}
+	return TopicSpec{Name: name, NumPartitions: topic.numPartitions, Config: config}, true
+}
+
+func (t *InProcessTransport) getOrCreateTopic(name string) *inProcessTopic {
+	topic, ok := t.topics[name]
+	if !ok {
+		topic = &inProcessTopic{}
+		t.topics[name] = topic
+	}
+	return topic
+}
+
+func (t *InProcessTransport) produce(msg *Message) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	topic := t.getOrCreateTopic(msg.Topic)
+	stored := copyMessage(msg)
+	stored.Timestamp = time.Now()
+	topic.messages = append(topic.messages, stored)
+	if len(topic.messages) > t.maxMessagesPerTopic {
+		dropped := len(topic.messages) - t.maxMessagesPerTopic
+		topic.messages = topic.messages[dropped:]
+		topic.base += dropped
+	}
+	t.wakeConsumers()
+}
+
+// wakeConsumers must be called with the lock held
+func (t *InProcessTransport) wakeConsumers() {
+	close(t.notify)
+	t.notify = make(chan struct{})
+}
+
+func (t *InProcessTransport) notifyChannel() chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.notify
+}
+
+// next returns the next message for the consumer group from the topics, starting with the topic at index start
+func (t *InProcessTransport) next(groupId string, topics []string, start int) (*Message, chan struct{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	groupOffsets, ok := t.offsets[groupId]
+	if !ok {
+		groupOffsets = make(map[string]int)
+		t.offsets[groupId] = groupOffsets
+	}
+	for i := range topics {
+		name := topics[(start+i)%len(topics)]
+		topic, ok := t.topics[name]
+		if !ok {
+			continue
+		}
+		offset := groupOffsets[name]
+		if offset < topic.base {
+			offset = topic.base
+		}
+		if offset < topic.base+len(topic.messages) {
+			groupOffsets[name] = offset + 1
+			msg := copyMessage(topic.messages[offset-topic.base])
+			msg.Offset = int64(offset)
+			return msg, nil
+		}
+	}
+	return nil, t.notify
+}
+
+func (t *InProcessTransport) lag(groupId string, topics []string) map[string]int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	lag := make(map[string]int64)
+	for _, name := range topics {
+		topic, ok := t.topics[name]
+		if !ok {
+			lag[name] = 0
+			continue
+		}
+		offset := t.offsets[groupId][name]
+		if offset < topic.base {
+			offset = topic.base
+		}
+		lag[name] = int64(topic.base + len(topic.messages) - offset)
+	}
+	return lag
+}
+
+func copyMessage(msg *Message) *Message {
+	return &Message{
+		Topic:     msg.Topic,
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Headers:   append([]Header(nil), msg.Headers...),
+		Timestamp: msg.Timestamp,
+	}
+}
+
+type inProcessProducer struct {
+	transport *InProcessTransport
+	closed    atomic.Bool
+}
+
+func (p *inProcessProducer) Produce(msg *Message, delivered func(error)) error {
+	if p.closed.Load() {
+		return fmt.Errorf("producer is closed")
+	}
+	p.transport.produce(msg)
+	if delivered != nil {
+		delivered(nil)
+	}
+	return nil
+}
+
+func (p *inProcessProducer) Close() {
+	p.closed.Store(true)
+}
+
+type inProcessConsumer struct {
+	transport *InProcessTransport
+	groupId   string
+	mu        sync.Mutex
+	topics    []string
+	start     int
+	paused    atomic.Bool
+	// pausedTopics are skipped when polling, guarded by mu
+	pausedTopics map[string]bool
+	// rebalanced is set by Subscribe so the next Poll calls rebalanceCallback before reading the new topics, as a
+	// Kafka consumer does when partitions are assigned. Both are guarded by mu.
+	rebalanced        bool
+	rebalanceCallback func()
+	closed            atomic.Bool
+}
+
+func (c *inProcessConsumer) SetRebalanceCallback(callback func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rebalanceCallback = callback
+}
+
+func (c *inProcessConsumer) Subscribe(topics []string) error {
+	c.mu.Lock()
+	c.topics = append([]string(nil), topics...)
+	c.start = 0
+	c.rebalanced = true
+	c.mu.Unlock()
+	c.transport.mu.Lock()
+	defer c.transport.mu.Unlock()
+	c.transport.wakeConsumers()
+	return nil
+}
+
+func (c *inProcessConsumer) Poll(timeout time.Duration) (*Message, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for !c.closed.Load() {
+		c.mu.Lock()
+		if c.rebalanced {
+			c.rebalanced = false
+			callback := c.rebalanceCallback
+			c.mu.Unlock()
+			if callback != nil {
+				callback()
+			}
+			continue
+		}
+		topics := c.getActiveTopics()
+		start := c.start
+		c.start++
+		c.mu.Unlock()
+
+		var msg *Message
+		var notify chan struct{}
+		if len(topics) > 0 && !c.paused.Load() {
+			msg, notify = c.transport.next(c.groupId, topics, start)
+			if msg != nil {
+				return msg, nil
+			}
+		} else {
+			notify = c.transport.notifyChannel()
+		}
+		select {
+		case <-notify:
+		case <-timer.C:
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *inProcessConsumer) Pause() error {
+	c.paused.Store(true)
+	return nil
+}
+
+func (c *inProcessConsumer) Resume() error {
+	c.paused.Store(false)
+	c.transport.mu.Lock()
+	defer c.transport.mu.Unlock()
+	c.transport.wakeConsumers()
+	return nil
+}
+
+func (c *inProcessConsumer) PauseTopics(topics []string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.pausedTopics == nil {
+		c.pausedTopics = make(map[string]bool)
+	}
+	for _, topic := range topics {
+		c.pausedTopics[topic] = true
+	}
+	return nil
+}
+
+func (c *inProcessConsumer) ResumeTopics(topics []string) error {
+	c.mu.Lock()
+	for _, topic := range topics {
+		delete(c.pausedTopics, topic)
+	}
+	c.mu.Unlock()
+	c.transport.mu.Lock()
+	defer c.transport.mu.Unlock()
+	c.transport.wakeConsumers()
+	return nil
+}
+
+// getActiveTopics must be called with the lock held
+func (c *inProcessConsumer) getActiveTopics() []string {
+	if len(c.pausedTopics) == 0 {
+		return c.topics
+	}
+	var topics []string
+	for _, topic := range c.topics {
+		if !c.pausedTopics[topic] {
+			topics = append(topics, topic)
+		}
+	}
+	return topics
+}
+
+func (c *inProcessConsumer) Lag() (map[string]int64, error) {
+	c.mu.Lock()
+	topics := c.topics
+	c.mu.Unlock()
+	return c.transport.lag(c.groupId, topics), nil
+}
+
+// PartitionLag reports a single partition per topic, as in process topics are not partitioned
+func (c *inProcessConsumer) PartitionLag() ([]PartitionLag, error) {
+	c.mu.Lock()
+	topics := c.topics
+	c.mu.Unlock()
+	lag := c.transport.lag(c.groupId, topics)
+	partitions := make([]PartitionLag, 0, len(topics))
+	for _, topic := range topics {
+		partitions = append(partitions, PartitionLag{Topic: topic, Lag: lag[topic]})
+	}
+	return partitions, nil
+}
+
+func (c *inProcessConsumer) Close() error {
+	if c.closed.Swap(true) {
+		return nil
+	}
+	c.transport.mu.Lock()
+	defer c.transport.mu.Unlock()
+	c.transport.members[c.groupId]--
+	c.transport.wakeConsumers()
+	return nil
+}
+
+type inProcessAdmin struct {
+	transport *InProcessTransport
+}
+
+func (a *inProcessAdmin) CreateTopics(_ context.Context, topics []TopicSpec) error {
+	a.transport.mu.Lock()
+	defer a.transport.mu.Unlock()
+	for _, spec := range topics {
+		if _, ok := a.transport.topics[spec.Name]; ok {
+			continue
+		}
+		topic := a.transport.getOrCreateTopic(spec.Name)
+		topic.numPartitions = spec.NumPartitions
+		topic.config = make(map[string]string)
+		for k, v := range spec.Config {
+			topic.config[k] = v
+		}
+	}
+	return nil
+}
+
+func (a *inProcessAdmin) CheckTopicsExist(_ context.Context, topics []string) error {
+	a.transport.mu.Lock()
+	defer a.transport.mu.Unlock()
+	for _, topic := range topics {
+		if _, ok := a.transport.topics[topic]; !ok {
+			return fmt.Errorf("topic %s does not exist", topic)
+		}
+	}
+	return nil
+}
+
+func (a *inProcessAdmin) UpdateTopics(_ context.Context, topics []TopicSpec) ([]string, error) {
+	a.transport.mu.Lock()
+	defer a.transport.mu.Unlock()
+	var increased []string
+	for _, spec := range topics {
+		topic, ok := a.transport.topics[spec.Name]
+		if !ok {
+			return increased, fmt.Errorf("topic %s does not exist", spec.Name)
+		}
+		if spec.NumPartitions > topic.numPartitions {
+			topic.numPartitions = spec.NumPartitions
+			increased = append(increased, spec.Name)
+		}
+		if topic.config == nil {
+			topic.config = make(map[string]string)
+		}
+		for k, v := range spec.Config {
+			topic.config[k] = v
+		}
+	}
+	return increased, nil
+}
+
+func (a *inProcessAdmin) ListTopics(_ context.Context) ([]string, error) {
+	a.transport.mu.Lock()
+	defer a.transport.mu.Unlock()
+	var topics []string
+	for name := range a.transport.topics {
+		topics = append(topics, name)
+	}
+	return topics, nil
+}
+
+func (a *inProcessAdmin) DeleteTopics(_ context.Context, topics []string) ([]string, error) {
+	a.transport.mu.Lock()
+	defer a.transport.mu.Unlock()
+	for _, name := range topics {
+		delete(a.transport.topics, name)
+		for _, groupOffsets := range a.transport.offsets {
+			delete(groupOffsets, name)
+		}
+	}
+	return topics, nil
+}
+
+func (a *inProcessAdmin) ListConsumerGroups(_ context.Context) ([]string, error) {
+	a.transport.mu.Lock()
+	defer a.transport.mu.Unlock()
+	var groups []string
+	for groupId := range a.transport.offsets {
+		groups = append(groups, groupId)
+	}
+	return groups, nil
+}
+
+func (a *inProcessAdmin) DeleteConsumerGroups(_ context.Context, groups []string) ([]string, error) {
+	a.transport.mu.Lock()
+	defer a.transport.mu.Unlock()
+	var deleted []string
+	var failure error
+	for _, groupId := range groups {
+		if a.transport.members[groupId] > 0 {
+			if failure == nil {
+				failure = fmt.Errorf("consumer group %s has active members", groupId)
+			}
+			continue
+		}
+		delete(a.transport.offsets, groupId)
+		delete(a.transport.members, groupId)
+		deleted = append(deleted, groupId)
+	}
+	return deleted, failure
+}
+
+func (a *inProcessAdmin) Close() {}
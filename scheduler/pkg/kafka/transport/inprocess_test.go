@@ -0,0 +1,325 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package transport
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestInProcessTransport(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name                string
+		maxMessagesPerTopic int
+		produce             []*Message
+		groups              []string
+		topics              []string
+		messagesPerConsumer int // 0 to read until no more messages
+		expectedKeys        []string
+	}
+
+	tests := []test{
+		{
+			name:                "single consumer",
+			maxMessagesPerTopic: 10,
+			produce:             []*Message{{Topic: "a", Key: []byte("1")}, {Topic: "a", Key: []byte("2")}},
+			groups:              []string{"g1"},
+			topics:              []string{"a"},
+			expectedKeys:        []string{"1", "2"},
+		},
+		{
+			name:                "consumer groups each read all messages",
+			maxMessagesPerTopic: 10,
+			produce:             []*Message{{Topic: "a", Key: []byte("1")}},
+			groups:              []string{"g1", "g2"},
+			topics:              []string{"a"},
+			expectedKeys:        []string{"1", "1"},
+		},
+		{
+			name:                "consumers in a group share messages",
+			maxMessagesPerTopic: 10,
+			produce:             []*Message{{Topic: "a", Key: []byte("1")}, {Topic: "a", Key: []byte("2")}},
+			groups:              []string{"g1", "g1"},
+			topics:              []string{"a"},
+			messagesPerConsumer: 1,
+			expectedKeys:        []string{"1", "2"},
+		},
+		{
+			name:                "only subscribed topics",
+			maxMessagesPerTopic: 10,
+			produce:             []*Message{{Topic: "a", Key: []byte("1")}, {Topic: "b", Key: []byte("2")}},
+			groups:              []string{"g1"},
+			topics:              []string{"b"},
+			expectedKeys:        []string{"2"},
+		},
+		{
+			name:                "oldest messages dropped",
+			maxMessagesPerTopic: 1,
+			produce:             []*Message{{Topic: "a", Key: []byte("1")}, {Topic: "a", Key: []byte("2")}},
+			groups:              []string{"g1"},
+			topics:              []string{"a"},
+			expectedKeys:        []string{"2"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			transport := NewInProcessTransport(test.maxMessagesPerTopic)
+			producer, err := transport.NewProducer()
+			g.Expect(err).To(BeNil())
+			for _, msg := range test.produce {
+				var deliveryErr error
+				delivered := false
+				err := producer.Produce(msg, func(err error) {
+					delivered = true
+					deliveryErr = err
+				})
+				g.Expect(err).To(BeNil())
+				g.Expect(delivered).To(BeTrue())
+				g.Expect(deliveryErr).To(BeNil())
+			}
+
+			var keys []string
+			for _, group := range test.groups {
+				consumer, err := transport.NewConsumer(group)
+				g.Expect(err).To(BeNil())
+				g.Expect(consumer.Subscribe(test.topics)).To(BeNil())
+				for read := 0; test.messagesPerConsumer == 0 || read < test.messagesPerConsumer; read++ {
+					msg, err := consumer.Poll(10 * time.Millisecond)
+					g.Expect(err).To(BeNil())
+					if msg == nil {
+						break
+					}
+					keys = append(keys, string(msg.Key))
+				}
+				g.Expect(consumer.Close()).To(BeNil())
+			}
+			g.Expect(keys).To(Equal(test.expectedKeys))
+		})
+	}
+}
+
+func TestInProcessTransportPollWaitsForMessage(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	transport := NewInProcessTransport(DefaultInProcessMaxMessagesPerTopic)
+	consumer, err := transport.NewConsumer("g1")
+	g.Expect(err).To(BeNil())
+	g.Expect(consumer.Subscribe([]string{"a"})).To(BeNil())
+	producer, err := transport.NewProducer()
+	g.Expect(err).To(BeNil())
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_ = producer.Produce(&Message{Topic: "a", Key: []byte("1"), Headers: []Header{{Key: "h", Value: []byte("v")}}}, nil)
+	}()
+	msg, err := consumer.Poll(5 * time.Second)
+	g.Expect(err).To(BeNil())
+	g.Expect(msg).ToNot(BeNil())
+	g.Expect(msg.Topic).To(Equal("a"))
+	g.Expect(msg.Headers).To(Equal([]Header{{Key: "h", Value: []byte("v")}}))
+	g.Expect(transport.NumMessages()).To(Equal(1))
+}
+
+func TestInProcessAdmin(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	transport := NewInProcessTransport(DefaultInProcessMaxMessagesPerTopic)
+	admin, err := transport.NewAdmin()
+	g.Expect(err).To(BeNil())
+	g.Expect(admin.CheckTopicsExist(context.Background(), []string{"a"})).ToNot(BeNil())
+	g.Expect(admin.CreateTopics(context.Background(), []TopicSpec{{Name: "a", NumPartitions: 1, ReplicationFactor: 1}})).To(BeNil())
+	g.Expect(admin.CheckTopicsExist(context.Background(), []string{"a"})).To(BeNil())
+}
+
+func TestInProcessAdminUpdateTopics(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	transport := NewInProcessTransport(DefaultInProcessMaxMessagesPerTopic)
+	admin, err := transport.NewAdmin()
+	g.Expect(err).To(BeNil())
+	ctx := context.Background()
+
+	_, err = admin.UpdateTopics(ctx, []TopicSpec{{Name: "a", NumPartitions: 2}})
+	g.Expect(err).ToNot(BeNil())
+
+	g.Expect(admin.CreateTopics(ctx, []TopicSpec{{Name: "a", NumPartitions: 2, Config: map[string]string{"retention.ms": "1000"}}})).To(BeNil())
+	spec, ok := transport.DescribeTopic("a")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(spec.NumPartitions).To(Equal(2))
+	g.Expect(spec.Config).To(Equal(map[string]string{"retention.ms": "1000"}))
+
+	// creating again leaves the topic unchanged
+	g.Expect(admin.CreateTopics(ctx, []TopicSpec{{Name: "a", NumPartitions: 4}})).To(BeNil())
+	spec, _ = transport.DescribeTopic("a")
+	g.Expect(spec.NumPartitions).To(Equal(2))
+
+	increased, err := admin.UpdateTopics(ctx, []TopicSpec{{Name: "a", NumPartitions: 4, Config: map[string]string{"cleanup.policy": "compact"}}})
+	g.Expect(err).To(BeNil())
+	g.Expect(increased).To(Equal([]string{"a"}))
+	spec, _ = transport.DescribeTopic("a")
+	g.Expect(spec.NumPartitions).To(Equal(4))
+	g.Expect(spec.Config).To(Equal(map[string]string{"retention.ms": "1000", "cleanup.policy": "compact"}))
+
+	// partitions are never reduced
+	increased, err = admin.UpdateTopics(ctx, []TopicSpec{{Name: "a", NumPartitions: 1}})
+	g.Expect(err).To(BeNil())
+	g.Expect(increased).To(BeEmpty())
+	spec, _ = transport.DescribeTopic("a")
+	g.Expect(spec.NumPartitions).To(Equal(4))
+}
+
+func TestInProcessAdminDelete(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	transport := NewInProcessTransport(DefaultInProcessMaxMessagesPerTopic)
+	admin, err := transport.NewAdmin()
+	g.Expect(err).To(BeNil())
+	ctx := context.Background()
+
+	g.Expect(admin.CreateTopics(ctx, []TopicSpec{{Name: "a"}, {Name: "b"}})).To(BeNil())
+	topics, err := admin.ListTopics(ctx)
+	g.Expect(err).To(BeNil())
+	g.Expect(topics).To(ConsistOf("a", "b"))
+	deleted, err := admin.DeleteTopics(ctx, []string{"a"})
+	g.Expect(err).To(BeNil())
+	g.Expect(deleted).To(Equal([]string{"a"}))
+	topics, err = admin.ListTopics(ctx)
+	g.Expect(err).To(BeNil())
+	g.Expect(topics).To(ConsistOf("b"))
+
+	active, err := transport.NewConsumer("active")
+	g.Expect(err).To(BeNil())
+	closed, err := transport.NewConsumer("closed")
+	g.Expect(err).To(BeNil())
+	g.Expect(closed.Close()).To(BeNil())
+	groups, err := admin.ListConsumerGroups(ctx)
+	g.Expect(err).To(BeNil())
+	g.Expect(groups).To(ConsistOf("active", "closed"))
+
+	// groups with open consumers are kept
+	deleted, err = admin.DeleteConsumerGroups(ctx, []string{"active", "closed"})
+	g.Expect(err).ToNot(BeNil())
+	g.Expect(deleted).To(Equal([]string{"closed"}))
+	groups, err = admin.ListConsumerGroups(ctx)
+	g.Expect(err).To(BeNil())
+	g.Expect(groups).To(ConsistOf("active"))
+	g.Expect(active.Close()).To(BeNil())
+}
+
+func TestMessageCarrier(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	msg := &Message{Headers: []Header{{Key: "a", Value: []byte("1")}}}
+	carrier := NewMessageCarrier(msg)
+	carrier.Set("a", "2")
+	carrier.Set("b", "3")
+
+	g.Expect(carrier.Get("a")).To(Equal("2"))
+	g.Expect(carrier.Get("b")).To(Equal("3"))
+	g.Expect(carrier.Get("c")).To(Equal(""))
+	g.Expect(carrier.Keys()).To(Equal([]string{"a", "b"}))
+	g.Expect(msg.Headers).To(HaveLen(2))
+}
+
+func TestInProcessConsumerPauseAndLag(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	transport := NewInProcessTransport(DefaultInProcessMaxMessagesPerTopic)
+	producer, err := transport.NewProducer()
+	g.Expect(err).To(BeNil())
+	consumer, err := transport.NewConsumer("g1")
+	g.Expect(err).To(BeNil())
+	g.Expect(consumer.Subscribe([]string{"a", "b"})).To(BeNil())
+	for _, key := range []string{"1", "2"} {
+		g.Expect(producer.Produce(&Message{Topic: "a", Key: []byte(key)}, nil)).To(BeNil())
+	}
+
+	lag, err := consumer.Lag()
+	g.Expect(err).To(BeNil())
+	g.Expect(lag).To(Equal(map[string]int64{"a": 2, "b": 0}))
+
+	g.Expect(consumer.Pause()).To(BeNil())
+	msg, err := consumer.Poll(10 * time.Millisecond)
+	g.Expect(err).To(BeNil())
+	g.Expect(msg).To(BeNil())
+
+	g.Expect(consumer.Resume()).To(BeNil())
+	msg, err = consumer.Poll(10 * time.Millisecond)
+	g.Expect(err).To(BeNil())
+	g.Expect(string(msg.Key)).To(Equal("1"))
+	g.Expect(msg.Timestamp.IsZero()).To(BeFalse())
+	g.Expect(msg.Offset).To(Equal(int64(0)))
+
+	lag, err = consumer.Lag()
+	g.Expect(err).To(BeNil())
+	g.Expect(lag).To(Equal(map[string]int64{"a": 1, "b": 0}))
+
+	partitionLag, err := consumer.PartitionLag()
+	g.Expect(err).To(BeNil())
+	g.Expect(partitionLag).To(Equal([]PartitionLag{{Topic: "a", Lag: 1}, {Topic: "b", Lag: 0}}))
+}
+
+func TestInProcessConsumerPauseTopics(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	transport := NewInProcessTransport(DefaultInProcessMaxMessagesPerTopic)
+	producer, err := transport.NewProducer()
+	g.Expect(err).To(BeNil())
+	consumer, err := transport.NewConsumer("g1")
+	g.Expect(err).To(BeNil())
+	g.Expect(consumer.Subscribe([]string{"a", "b"})).To(BeNil())
+	g.Expect(producer.Produce(&Message{Topic: "a", Key: []byte("1")}, nil)).To(BeNil())
+	g.Expect(producer.Produce(&Message{Topic: "b", Key: []byte("2")}, nil)).To(BeNil())
+
+	g.Expect(consumer.PauseTopics([]string{"a"})).To(BeNil())
+	msg, err := consumer.Poll(10 * time.Millisecond)
+	g.Expect(err).To(BeNil())
+	g.Expect(msg.Topic).To(Equal("b"))
+	msg, err = consumer.Poll(10 * time.Millisecond)
+	g.Expect(err).To(BeNil())
+	g.Expect(msg).To(BeNil())
+
+	g.Expect(consumer.ResumeTopics([]string{"a"})).To(BeNil())
+	msg, err = consumer.Poll(10 * time.Millisecond)
+	g.Expect(err).To(BeNil())
+	g.Expect(msg.Topic).To(Equal("a"))
+}
+
+func TestInProcessConsumerRebalanceCallback(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	transport := NewInProcessTransport(DefaultInProcessMaxMessagesPerTopic)
+	producer, err := transport.NewProducer()
+	g.Expect(err).To(BeNil())
+	consumer, err := transport.NewConsumer("g1")
+	g.Expect(err).To(BeNil())
+	calls := 0
+	consumer.SetRebalanceCallback(func() {
+		calls++
+		// the callback runs before the new topics are read, so it can pause them
+		g.Expect(consumer.PauseTopics([]string{"b"})).To(BeNil())
+	})
+	g.Expect(producer.Produce(&Message{Topic: "a", Key: []byte("1")}, nil)).To(BeNil())
+	g.Expect(producer.Produce(&Message{Topic: "b", Key: []byte("2")}, nil)).To(BeNil())
+
+	g.Expect(consumer.Subscribe([]string{"a", "b"})).To(BeNil())
+	msg, err := consumer.Poll(10 * time.Millisecond)
+	g.Expect(err).To(BeNil())
+	g.Expect(msg.Topic).To(Equal("a"))
+	msg, err = consumer.Poll(10 * time.Millisecond)
+	g.Expect(err).To(BeNil())
+	g.Expect(msg).To(BeNil())
+	g.Expect(calls).To(Equal(1))
+}
@@ -0,0 +1,500 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package transport
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+)
+
+const (
+	defaultAdminTimeout = 30 * time.Second
+)
+
+type KafkaTransport struct {
+	logger         log.FieldLogger
+	consumerConfig kafka.ConfigMap
+	producerConfig kafka.ConfigMap
+}
+
+func NewKafkaTransport(logger log.FieldLogger, consumerConfig kafka.ConfigMap, producerConfig kafka.ConfigMap) *KafkaTransport {
+	return &KafkaTransport{
+		logger:         logger.WithField("source", "KafkaTransport"),
+		consumerConfig: consumerConfig,
+		producerConfig: producerConfig,
+	}
+}
+
+// NewTransportFromConfig creates the transport selected in the Seldon Kafka config, Kafka unless it is inprocess
+func NewTransportFromConfig(logger log.FieldLogger, kafkaConfig *config.KafkaConfig) (Transport, error) {
+	if kafkaConfig.IsInProcessTransport() {
+		logger.Warn("Using the in-process transport, messages are only passed between components in this process")
+		return NewInProcessTransport(DefaultInProcessMaxMessagesPerTopic), nil
+	}
+	return NewKafkaTransportFromConfig(logger, kafkaConfig)
+}
+
+// NewKafkaTransportFromConfig creates a transport using the producer and consumer settings of the Seldon Kafka config
+func NewKafkaTransportFromConfig(logger log.FieldLogger, kafkaConfig *config.KafkaConfig) (*KafkaTransport, error) {
+	producerConfig := config.CloneKafkaConfigMap(kafkaConfig.Producer)
+	producerConfig["go.delivery.reports"] = true
+	if err := config.AddKafkaSSLOptions(producerConfig); err != nil {
+		return nil, err
+	}
+	consumerConfig := config.CloneKafkaConfigMap(kafkaConfig.Consumer)
+	if err := config.AddKafkaSSLOptions(consumerConfig); err != nil {
+		return nil, err
+	}
+	return NewKafkaTransport(logger, consumerConfig, producerConfig), nil
+}
+
+func (t *KafkaTransport) NewProducer() (Producer, error) {
+	producerConfig := config.CloneKafkaConfigMap(t.producerConfig)
+	t.logger.Infof("Creating producer with config %v", config.WithoutSecrets(producerConfig))
+	producer, err := kafka.NewProducer(&producerConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaProducer{producer: producer}, nil
+}
+
+func (t *KafkaTransport) NewConsumer(groupId string, opts ...ConsumerOption) (Consumer, error) {
+	consumerConfig := config.CloneKafkaConfigMap(t.consumerConfig)
+	consumerConfig["group.id"] = groupId
+	if getConsumerOptions(opts).fromEarliest {
+		consumerConfig["auto.offset.reset"] = "earliest"
+	}
+	t.logger.Infof("Creating consumer with config %v", config.WithoutSecrets(consumerConfig))
+	consumer, err := kafka.NewConsumer(&consumerConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaConsumer{consumer: consumer}, nil
+}
+
+func (t *KafkaTransport) NewAdmin() (Admin, error) {
+	adminConfig := config.CloneKafkaConfigMap(t.consumerConfig)
+	admin, err := kafka.NewAdminClient(&adminConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaAdmin{admin: admin}, nil
+}
+
+type kafkaProducer struct {
+	producer *kafka.Producer
+}
+
+func (p *kafkaProducer) Produce(msg *Message, delivered func(error)) error {
+	deliveryChan := make(chan kafka.Event, 1)
+	if err := p.producer.Produce(toKafkaMessage(msg), deliveryChan); err != nil {
+		return err
+	}
+	go func() {
+		evt := <-deliveryChan
+		if delivered == nil {
+			return
+		}
+		switch e := evt.(type) {
+		case *kafka.Message:
+			delivered(e.TopicPartition.Error)
+		case kafka.Error:
+			delivered(e)
+		default:
+			delivered(nil)
+		}
+	}()
+	return nil
+}
+
+func (p *kafkaProducer) Close() {
+	p.producer.Close()
+}
+
+type kafkaConsumer struct {
+	consumer *kafka.Consumer
+	// rebalanced is set by the rebalance callback, which only runs within Poll
+	rebalanced        bool
+	rebalanceCallback func()
+}
+
+func (c *kafkaConsumer) Subscribe(topics []string) error {
+	return c.consumer.SubscribeTopics(topics, c.onRebalance)
+}
+
+// onRebalance is called before the default assignment, so the callback is only called once Poll returns
+func (c *kafkaConsumer) onRebalance(_ *kafka.Consumer, event kafka.Event) error {
+	if _, ok := event.(kafka.AssignedPartitions); ok {
+		c.rebalanced = true
+	}
+	return nil
+}
+
+func (c *kafkaConsumer) SetRebalanceCallback(callback func()) {
+	c.rebalanceCallback = callback
+}
+
+func (c *kafkaConsumer) Poll(timeout time.Duration) (*Message, error) {
+	event := c.consumer.Poll(int(timeout.Milliseconds()))
+	if c.rebalanced {
+		c.rebalanced = false
+		if c.rebalanceCallback != nil {
+			c.rebalanceCallback()
+		}
+	}
+	switch e := event.(type) {
+	case *kafka.Message:
+		return fromKafkaMessage(e), nil
+	case kafka.Error:
+		return nil, e
+	default:
+		return nil, nil
+	}
+}
+
+func (c *kafkaConsumer) Pause() error {
+	partitions, err := c.consumer.Assignment()
+	if err != nil {
+		return err
+	}
+	return c.consumer.Pause(partitions)
+}
+
+func (c *kafkaConsumer) Resume() error {
+	partitions, err := c.consumer.Assignment()
+	if err != nil {
+		return err
+	}
+	return c.consumer.Resume(partitions)
+}
+
+func (c *kafkaConsumer) PauseTopics(topics []string) error {
+	partitions, err := c.getTopicPartitions(topics)
+	if err != nil {
+		return err
+	}
+	return c.consumer.Pause(partitions)
+}
+
+func (c *kafkaConsumer) ResumeTopics(topics []string) error {
+	partitions, err := c.getTopicPartitions(topics)
+	if err != nil {
+		return err
+	}
+	return c.consumer.Resume(partitions)
+}
+
+// getTopicPartitions returns the assigned partitions of the topics
+func (c *kafkaConsumer) getTopicPartitions(topics []string) ([]kafka.TopicPartition, error) {
+	assignment, err := c.consumer.Assignment()
+	if err != nil {
+		return nil, err
+	}
+	wanted := make(map[string]bool, len(topics))
+	for _, topic := range topics {
+		wanted[topic] = true
+	}
+	var partitions []kafka.TopicPartition
+	for _, partition := range assignment {
+		if partition.Topic != nil && wanted[*partition.Topic] {
+			partitions = append(partitions, partition)
+		}
+	}
+	return partitions, nil
+}
+
+func (c *kafkaConsumer) Lag() (map[string]int64, error) {
+	partitions, err := c.PartitionLag()
+	if err != nil {
+		return nil, err
+	}
+	lag := make(map[string]int64)
+	for _, partition := range partitions {
+		lag[partition.Topic] += partition.Lag
+	}
+	return lag, nil
+}
+
+func (c *kafkaConsumer) PartitionLag() ([]PartitionLag, error) {
+	partitions, err := c.consumer.Assignment()
+	if err != nil {
+		return nil, err
+	}
+	positions, err := c.consumer.Position(partitions)
+	if err != nil {
+		return nil, err
+	}
+	var lag []PartitionLag
+	for _, position := range positions {
+		if position.Topic == nil {
+			continue
+		}
+		_, high, err := c.consumer.GetWatermarkOffsets(*position.Topic, position.Partition)
+		if err != nil {
+			return nil, err
+		}
+		lag = append(lag, PartitionLag{
+			Topic:     *position.Topic,
+			Partition: position.Partition,
+			Lag:       partitionLag(high, int64(position.Offset)),
+		})
+	}
+	return lag, nil
+}
+
+// partitionLag is zero when the consumer has no position yet, as the cached high watermark may not be known either
+func partitionLag(high int64, offset int64) int64 {
+	if offset < 0 || high < offset {
+		return 0
+	}
+	return high - offset
+}
+
+func (c *kafkaConsumer) Close() error {
+	return c.consumer.Close()
+}
+
+type kafkaAdmin struct {
+	admin *kafka.AdminClient
+}
+
+func (a *kafkaAdmin) CreateTopics(ctx context.Context, topics []TopicSpec) error {
+	var topicSpecs []kafka.TopicSpecification
+	for _, topic := range topics {
+		topicSpecs = append(topicSpecs, kafka.TopicSpecification{
+			Topic:             topic.Name,
+			NumPartitions:     topic.NumPartitions,
+			ReplicationFactor: topic.ReplicationFactor,
+			Config:            topic.Config,
+		})
+	}
+	var opts []kafka.CreateTopicsAdminOption
+	if deadline, ok := ctx.Deadline(); ok {
+		opts = append(opts, kafka.SetAdminOperationTimeout(time.Until(deadline)))
+	}
+	results, err := a.admin.CreateTopics(ctx, topicSpecs, opts...)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if code := result.Error.Code(); code != kafka.ErrNoError && code != kafka.ErrTopicAlreadyExists {
+			return fmt.Errorf("failed to create topic %s: %w", result.Topic, result.Error)
+		}
+	}
+	return nil
+}
+
+func (a *kafkaAdmin) CheckTopicsExist(ctx context.Context, topics []string) error {
+	result, err := a.admin.DescribeTopics(
+		ctx,
+		kafka.NewTopicCollectionOfTopicNames(topics),
+		kafka.SetAdminOptionIncludeAuthorizedOperations(false))
+	if err != nil {
+		return err
+	}
+	for _, topicDescription := range result.TopicDescriptions {
+		if topicDescription.Error.Code() != kafka.ErrNoError {
+			return fmt.Errorf("topic description failure: %s", topicDescription.Error.Error())
+		}
+	}
+	return nil
+}
+
+func (a *kafkaAdmin) UpdateTopics(ctx context.Context, topics []TopicSpec) ([]string, error) {
+	var names []string
+	for _, topic := range topics {
+		names = append(names, topic.Name)
+	}
+	result, err := a.admin.DescribeTopics(
+		ctx,
+		kafka.NewTopicCollectionOfTopicNames(names),
+		kafka.SetAdminOptionIncludeAuthorizedOperations(false))
+	if err != nil {
+		return nil, err
+	}
+	currentPartitions := make(map[string]int)
+	for _, topicDescription := range result.TopicDescriptions {
+		if topicDescription.Error.Code() != kafka.ErrNoError {
+			return nil, fmt.Errorf("topic description failure: %s", topicDescription.Error.Error())
+		}
+		currentPartitions[topicDescription.Name] = len(topicDescription.Partitions)
+	}
+
+	var partitionSpecs []kafka.PartitionsSpecification
+	var configResources []kafka.ConfigResource
+	for _, topic := range topics {
+		if topic.NumPartitions > currentPartitions[topic.Name] {
+			partitionSpecs = append(partitionSpecs, kafka.PartitionsSpecification{
+				Topic:      topic.Name,
+				IncreaseTo: topic.NumPartitions,
+			})
+		}
+		if len(topic.Config) > 0 {
+			ops := make(map[string]kafka.AlterConfigOpType)
+			for key := range topic.Config {
+				ops[key] = kafka.AlterConfigOpTypeSet
+			}
+			configResources = append(configResources, kafka.ConfigResource{
+				Type:   kafka.ResourceTopic,
+				Name:   topic.Name,
+				Config: kafka.StringMapToIncrementalConfigEntries(topic.Config, ops),
+			})
+		}
+	}
+
+	var increased []string
+	if len(partitionSpecs) > 0 {
+		results, err := a.admin.CreatePartitions(ctx, partitionSpecs)
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range results {
+			if result.Error.Code() != kafka.ErrNoError {
+				return increased, fmt.Errorf("failed to increase partitions of topic %s: %w", result.Topic, result.Error)
+			}
+			increased = append(increased, result.Topic)
+		}
+	}
+	if len(configResources) > 0 {
+		results, err := a.admin.IncrementalAlterConfigs(ctx, configResources)
+		if err != nil {
+			return increased, err
+		}
+		for _, result := range results {
+			if result.Error.Code() != kafka.ErrNoError {
+				return increased, fmt.Errorf("failed to update config of topic %s: %w", result.Name, result.Error)
+			}
+		}
+	}
+	return increased, nil
+}
+
+func (a *kafkaAdmin) ListTopics(ctx context.Context) ([]string, error) {
+	timeout := defaultAdminTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+	metadata, err := a.admin.GetMetadata(nil, true, int(timeout.Milliseconds()))
+	if err != nil {
+		return nil, err
+	}
+	var topics []string
+	for topic := range metadata.Topics {
+		topics = append(topics, topic)
+	}
+	return topics, nil
+}
+
+func (a *kafkaAdmin) DeleteTopics(ctx context.Context, topics []string) ([]string, error) {
+	if len(topics) == 0 {
+		return nil, nil
+	}
+	var opts []kafka.DeleteTopicsAdminOption
+	if deadline, ok := ctx.Deadline(); ok {
+		opts = append(opts, kafka.SetAdminOperationTimeout(time.Until(deadline)))
+	}
+	results, err := a.admin.DeleteTopics(ctx, topics, opts...)
+	if err != nil {
+		return nil, err
+	}
+	var deleted []string
+	var failure error
+	for _, result := range results {
+		if code := result.Error.Code(); code != kafka.ErrNoError && code != kafka.ErrUnknownTopicOrPart {
+			if failure == nil {
+				failure = fmt.Errorf("failed to delete topic %s: %w", result.Topic, result.Error)
+			}
+			continue
+		}
+		deleted = append(deleted, result.Topic)
+	}
+	return deleted, failure
+}
+
+func (a *kafkaAdmin) ListConsumerGroups(ctx context.Context) ([]string, error) {
+	result, err := a.admin.ListConsumerGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Errors) > 0 {
+		return nil, fmt.Errorf("failed to list consumer groups: %w", result.Errors[0])
+	}
+	var groups []string
+	for _, listing := range result.Valid {
+		groups = append(groups, listing.GroupID)
+	}
+	return groups, nil
+}
+
+func (a *kafkaAdmin) DeleteConsumerGroups(ctx context.Context, groups []string) ([]string, error) {
+	if len(groups) == 0 {
+		return nil, nil
+	}
+	result, err := a.admin.DeleteConsumerGroups(ctx, groups)
+	if err != nil {
+		return nil, err
+	}
+	var deleted []string
+	var failure error
+	for _, groupResult := range result.ConsumerGroupResults {
+		if code := groupResult.Error.Code(); code != kafka.ErrNoError && code != kafka.ErrGroupIDNotFound {
+			if failure == nil {
+				failure = fmt.Errorf("failed to delete consumer group %s: %w", groupResult.Group, groupResult.Error)
+			}
+			continue
+		}
+		deleted = append(deleted, groupResult.Group)
+	}
+	return deleted, failure
+}
+
+func (a *kafkaAdmin) Close() {
+	a.admin.Close()
+}
+
+func toKafkaMessage(msg *Message) *kafka.Message {
+	topic := msg.Topic
+	headers := make([]kafka.Header, len(msg.Headers))
+	for i, header := range msg.Headers {
+		headers[i] = kafka.Header{Key: header.Key, Value: header.Value}
+	}
+	return &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+		Key:            msg.Key,
+		Value:          msg.Value,
+		Headers:        headers,
+	}
+}
+
+func fromKafkaMessage(msg *kafka.Message) *Message {
+	var topic string
+	if msg.TopicPartition.Topic != nil {
+		topic = *msg.TopicPartition.Topic
+	}
+	headers := make([]Header, len(msg.Headers))
+	for i, header := range msg.Headers {
+		headers[i] = Header{Key: header.Key, Value: header.Value}
+	}
+	return &Message{
+		Topic:     topic,
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Headers:   headers,
+		Timestamp: msg.Timestamp,
+		Partition: msg.TopicPartition.Partition,
+		Offset:    int64(msg.TopicPartition.Offset),
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package transport
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+)
+
+func TestPartitionLag(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name     string
+		high     int64
+		offset   int64
+		expected int64
+	}
+	tests := []test{
+		{
+			name:     "behind",
+			high:     10,
+			offset:   4,
+			expected: 6,
+		},
+		{
+			name:     "caught up",
+			high:     10,
+			offset:   10,
+			expected: 0,
+		},
+		{
+			name:     "no position",
+			high:     10,
+			offset:   -1001,
+			expected: 0,
+		},
+		{
+			name:     "watermark not known",
+			high:     -1001,
+			offset:   4,
+			expected: 0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(partitionLag(test.high, test.offset)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestNewTransportFromConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name      string
+		transport string
+		expected  Transport
+	}
+	tests := []test{
+		{
+			name:     "kafka by default",
+			expected: &KafkaTransport{},
+		},
+		{
+			name:      "kafka",
+			transport: config.TransportKafka,
+			expected:  &KafkaTransport{},
+		},
+		{
+			name:      "in-process",
+			transport: config.TransportInProcess,
+			expected:  &InProcessTransport{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			streamTransport, err := NewTransportFromConfig(log.New(), &config.KafkaConfig{Transport: test.transport})
+			g.Expect(err).To(BeNil())
+			g.Expect(streamTransport).To(BeAssignableToTypeOf(test.expected))
+		})
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+// Package transport abstracts the streaming system used by the model and pipeline gateways to pass
+// inference requests and responses between components. Kafka is the default implementation.
+package transport
+
+import (
+	"context"
+	"time"
+)
+
+type Header struct {
+	Key   string
+	Value []byte
+}
+
+type Message struct {
+	Topic   string
+	Key     []byte
+	Value   []byte
+	Headers []Header
+	// Timestamp is when the message was appended to the topic, zero if not known
+	Timestamp time.Time
+	// Partition and Offset locate a consumed message in its topic and are ignored when producing
+	Partition int32
+	Offset    int64
+}
+
+type PartitionLag struct {
+	Topic     string
+	Partition int32
+	Lag       int64
+}
+
+type TopicSpec struct {
+	Name              string
+	NumPartitions     int
+	ReplicationFactor int
+	Config            map[string]string // topic level settings such as retention.ms
+}
+
+type Producer interface {
+	// Produce sends a message asynchronously. The delivered callback, if not nil, is called once with the delivery result.
+	Produce(msg *Message, delivered func(error)) error
+	Close()
+}
+
+type Consumer interface {
+	// Subscribe replaces the topics the consumer reads from
+	Subscribe(topics []string) error
+	// Poll waits up to the timeout for the next message and returns nil if none arrived
+	Poll(timeout time.Duration) (*Message, error)
+	// Pause stops messages being returned from the subscribed topics until Resume is called
+	Pause() error
+	Resume() error
+	// PauseTopics and ResumeTopics only pause and resume the given subscribed topics
+	PauseTopics(topics []string) error
+	ResumeTopics(topics []string) error
+	// Lag returns the number of messages not yet consumed for each subscribed topic
+	Lag() (map[string]int64, error)
+	// PartitionLag returns the number of messages not yet consumed for each assigned partition
+	PartitionLag() ([]PartitionLag, error)
+	// SetRebalanceCallback sets a function Poll calls once partitions are assigned to the consumer, as newly
+	// assigned partitions are never paused
+	SetRebalanceCallback(callback func())
+	Close() error
+}
+
+type Admin interface {
+	// CreateTopics creates the topics, topics that already exist are left unchanged
+	CreateTopics(ctx context.Context, topics []TopicSpec) error
+	// CheckTopicsExist returns an error if any of the topics is not yet available
+	CheckTopicsExist(ctx context.Context, topics []string) error
+	// UpdateTopics increases the partitions of existing topics to NumPartitions and sets their Config.
+	// Partitions are never reduced and the replication factor is left unchanged.
+	// It returns the topics whose partitions were increased.
+	UpdateTopics(ctx context.Context, topics []TopicSpec) ([]string, error)
+	ListTopics(ctx context.Context) ([]string, error)
+	// DeleteTopics returns the topics deleted. Topics that do not exist are treated as deleted.
+	DeleteTopics(ctx context.Context, topics []string) ([]string, error)
+	ListConsumerGroups(ctx context.Context) ([]string, error)
+	// DeleteConsumerGroups returns the groups deleted. Groups with active members are not deleted.
+	DeleteConsumerGroups(ctx context.Context, groups []string) ([]string, error)
+	Close()
+}
+
+type Transport interface {
+	NewProducer() (Producer, error)
+	// NewConsumer creates a consumer in a consumer group. Each message is read by one consumer of each group.
+	NewConsumer(groupId string, opts ...ConsumerOption) (Consumer, error)
+	NewAdmin() (Admin, error)
+}
+
+type consumerOptions struct {
+	fromEarliest bool
+}
+
+type ConsumerOption func(*consumerOptions)
+
+// FromEarliest reads partitions without a committed offset from their first message rather than as configured by
+// auto.offset.reset, so messages written before the consumer subscribed to a topic are not skipped. In-process
+// consumers always read from the first message.
+func FromEarliest() ConsumerOption {
+	return func(o *consumerOptions) {
+		o.fromEarliest = true
+	}
+}
+
+func getConsumerOptions(opts []ConsumerOption) *consumerOptions {
+	options := &consumerOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+// MessageCarrier allows tracing context to be propagated in message headers
+type MessageCarrier struct {
+	msg *Message
+}
+
+func NewMessageCarrier(msg *Message) *MessageCarrier {
+	return &MessageCarrier{msg: msg}
+}
+
+func (c *MessageCarrier) Get(key string) string {
+	for _, header := range c.msg.Headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+func (c *MessageCarrier) Set(key string, value string) {
+	for i, header := range c.msg.Headers {
+		if header.Key == key {
+			c.msg.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+	c.msg.Headers = append(c.msg.Headers, Header{Key: key, Value: []byte(value)})
+}
+
+func (c *MessageCarrier) Keys() []string {
+	keys := make([]string, len(c.msg.Headers))
+	for i, header := range c.msg.Headers {
+		keys[i] = header.Key
+	}
+	return keys
+}
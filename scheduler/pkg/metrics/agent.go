@@ -29,6 +29,8 @@ import (
 const (
 	// Histograms do no include pipeline label for efficiency
 	modelHistogramName = "seldon_model_infer_api_seconds"
+	// Latencies by model for the statistics of experiments, without the code and method labels for efficiency
+	modelLatencyHistogramName = "seldon_model_infer_latency_seconds"
 	// We use base infer counters to store core metrics per pipeline
 	modelInferCounterName                 = "seldon_model_infer_total"
 	modelInferLatencyCounterName          = "seldon_model_infer_seconds_total"
@@ -84,6 +86,7 @@ type PrometheusMetrics struct {
 	logger           log.FieldLogger
 	// Model metrics
 	modelHistogram                                 *prometheus.HistogramVec
+	modelLatencyHistogram                          *prometheus.HistogramVec
 	modelInferCounter                              *prometheus.CounterVec
 	modelInferLatencyCounter                       *prometheus.CounterVec
 	modelAggregateInferCounter                     *prometheus.CounterVec
@@ -106,6 +109,15 @@ func NewPrometheusModelMetrics(serverName string, serverReplicaIdx uint, logger
 		return nil, err
 	}
 
+	latencyHistogram, err := createHistogramVec(
+		modelLatencyHistogramName,
+		"A histogram of inference latencies by model",
+		[]string{SeldonServerMetric, SeldonServerReplicaMetric, SeldonModelMetric},
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	inferCounter, err := createModelInferCounter()
 	if err != nil {
 		return nil, err
@@ -177,6 +189,7 @@ func NewPrometheusModelMetrics(serverName string, serverReplicaIdx uint, logger
 		serverReplicaIdx:                  fmt.Sprintf("%d", serverReplicaIdx),
 		logger:                            logger.WithField("source", "PrometheusMetrics"),
 		modelHistogram:                    histogram,
+		modelLatencyHistogram:             latencyHistogram,
 		modelInferCounter:                 inferCounter,
 		modelInferLatencyCounter:          inferLatencyCounter,
 		modelAggregateInferCounter:        aggregateInferCounter,
@@ -505,6 +518,11 @@ func (pm *PrometheusMetrics) addInferLatency(externalModelName, internalModelNam
 		SeldonServerReplicaMetric: pm.serverReplicaIdx,
 		MethodTypeMetric:          method,
 	}).Add(latency)
+	pm.modelLatencyHistogram.With(prometheus.Labels{
+		SeldonServerMetric:        pm.serverName,
+		SeldonServerReplicaMetric: pm.serverReplicaIdx,
+		SeldonModelMetric:         externalModelName,
+	}).Observe(latency)
 }
 
 func (pm *PrometheusMetrics) Start(port int) error {
@@ -212,10 +212,13 @@ func TestInferModelMetrics(t *testing.T) {
 		}))
 	g.Expect(float64(1)).To(Equal(actualVal))
 
+	g.Expect(testutil.CollectAndCount(promMetrics.modelLatencyHistogram, modelLatencyHistogramName)).To(Equal(1))
+
 	promMetrics.modelInferLatencyCounter.Reset()
 	promMetrics.modelInferCounter.Reset()
 	promMetrics.modelAggregateInferLatencyCounter.Reset()
 	promMetrics.modelAggregateInferCounter.Reset()
+	promMetrics.modelLatencyHistogram.Reset()
 }
 
 func TestServerEarlyStop(t *testing.T) {
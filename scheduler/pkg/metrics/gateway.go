@@ -33,6 +33,8 @@ const (
 	pipelineInferLatencyCounterName          = "seldon_pipeline_infer_seconds_total"
 	pipelineAggregateInferCounterName        = "seldon_pipeline_aggregate_infer_total"
 	pipelineAggregateInferLatencyCounterName = "seldon_pipeline_aggregate_infer_seconds_total"
+	pipelineGatewayPartitionLagGaugeName     = "seldon_pipelinegateway_consumer_partition_lag"
+	pipelineGatewayQueueHistogramName        = "seldon_pipelinegateway_queue_seconds"
 )
 
 // Docs End Metrics
@@ -47,6 +49,12 @@ type PipelineMetricsHandler interface {
 	AddPipelineInferMetrics(pipelineName string, method string, elapsedTime float64, code string)
 }
 
+// PipelineConsumerMetricsHandler records metrics for the consumers of pipeline output topics
+type PipelineConsumerMetricsHandler interface {
+	SetTopicPartitionLag(topic string, partition int32, lag int64)
+	AddPipelineQueueTime(pipelineName string, seconds float64)
+}
+
 type PrometheusPipelineMetrics struct {
 	serverName string
 	logger     log.FieldLogger
@@ -56,6 +64,9 @@ type PrometheusPipelineMetrics struct {
 	pipelineInferLatencyCounter          *prometheus.CounterVec
 	pipelineAggregateInferCounter        *prometheus.CounterVec
 	pipelineAggregateInferLatencyCounter *prometheus.CounterVec
+	// Consumer metrics
+	partitionLagGauge *prometheus.GaugeVec
+	queueHistogram    *prometheus.HistogramVec
 
 	server *http.Server
 }
@@ -87,6 +98,24 @@ func NewPrometheusPipelineMetrics(logger log.FieldLogger) (*PrometheusPipelineMe
 		return nil, err
 	}
 
+	partitionLagGauge, err := createGaugeVec(
+		pipelineGatewayPartitionLagGaugeName,
+		"Messages waiting to be consumed from each partition of the pipeline output topics",
+		[]string{SeldonTopicMetric, SeldonPartitionMetric},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	queueHistogram, err := createHistogramVec(
+		pipelineGatewayQueueHistogramName,
+		"A histogram of the time pipeline outputs waited on the output topic before being returned",
+		[]string{SeldonPipelineMetric},
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &PrometheusPipelineMetrics{
 		serverName:                           "pipeline-gateway",
 		logger:                               logger.WithField("source", "PrometheusMetrics"),
@@ -95,6 +124,8 @@ func NewPrometheusPipelineMetrics(logger log.FieldLogger) (*PrometheusPipelineMe
 		pipelineInferLatencyCounter:          inferLatencyCounter,
 		pipelineAggregateInferCounter:        aggregateInferCounter,
 		pipelineAggregateInferLatencyCounter: aggregateInferLatencyCounter,
+		partitionLagGauge:                    partitionLagGauge,
+		queueHistogram:                       queueHistogram,
 	}, nil
 }
 
@@ -193,6 +224,17 @@ func (pm *PrometheusPipelineMetrics) addInferLatency(pipelineName, method string
 	pm.pipelineHistogram.WithLabelValues(pm.serverName, pipelineName, method, code).Observe(latency)
 }
 
+func (pm *PrometheusPipelineMetrics) SetTopicPartitionLag(topic string, partition int32, lag int64) {
+	pm.partitionLagGauge.With(prometheus.Labels{
+		SeldonTopicMetric:     topic,
+		SeldonPartitionMetric: strconv.Itoa(int(partition)),
+	}).Set(float64(lag))
+}
+
+func (pm *PrometheusPipelineMetrics) AddPipelineQueueTime(pipelineName string, seconds float64) {
+	pm.queueHistogram.With(prometheus.Labels{SeldonPipelineMetric: pipelineName}).Observe(seconds)
+}
+
 func (pm *PrometheusPipelineMetrics) Start(port int) error {
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.Handler())
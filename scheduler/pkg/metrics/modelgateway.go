@@ -0,0 +1,208 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+)
+
+// Keep next line as used in docs
+// Docs Start Metrics
+const (
+	modelGatewayConsumerLagGaugeName      = "seldon_modelgateway_consumer_lag"
+	modelGatewayConsumerPausedGaugeName   = "seldon_modelgateway_consumer_paused"
+	modelGatewayInFlightGaugeName         = "seldon_modelgateway_inflight_requests"
+	modelGatewayConcurrencyLimitGaugeName = "seldon_modelgateway_concurrency_limit"
+	modelGatewayRateLimitedCounterName    = "seldon_modelgateway_rate_limited_total"
+	modelGatewayPartitionLagGaugeName     = "seldon_modelgateway_consumer_partition_lag"
+	modelGatewayQueueHistogramName        = "seldon_modelgateway_queue_seconds"
+	modelGatewayServiceHistogramName      = "seldon_modelgateway_service_seconds"
+)
+
+// Docs End Metrics
+// Keep above line as used in docs
+// Metric labels
+const (
+	SeldonConsumerMetric  = "consumer"
+	SeldonTopicMetric     = "topic"
+	SeldonPartitionMetric = "partition"
+)
+
+type ModelGatewayMetricsHandler interface {
+	SetConsumerLag(modelName string, lag int64)
+	SetConsumerPaused(consumerName string, paused bool)
+	SetConsumerConcurrency(consumerName string, inFlight int, limit int)
+	AddRateLimited(modelName string)
+	SetTopicPartitionLag(topic string, partition int32, lag int64)
+	AddQueueTime(modelName string, pipelineName string, seconds float64)
+	AddServiceTime(modelName string, pipelineName string, seconds float64)
+}
+
+type PrometheusModelGatewayMetrics struct {
+	logger                log.FieldLogger
+	consumerLagGauge      *prometheus.GaugeVec
+	consumerPausedGauge   *prometheus.GaugeVec
+	inFlightGauge         *prometheus.GaugeVec
+	concurrencyLimitGauge *prometheus.GaugeVec
+	rateLimitedCounter    *prometheus.CounterVec
+	partitionLagGauge     *prometheus.GaugeVec
+	queueHistogram        *prometheus.HistogramVec
+	serviceHistogram      *prometheus.HistogramVec
+	server                *http.Server
+}
+
+func NewPrometheusModelGatewayMetrics(logger log.FieldLogger) (*PrometheusModelGatewayMetrics, error) {
+	consumerLagGauge, err := createGaugeVec(
+		modelGatewayConsumerLagGaugeName,
+		"Messages waiting to be consumed from the model input topic",
+		[]string{SeldonModelMetric},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	consumerPausedGauge, err := createGaugeVec(
+		modelGatewayConsumerPausedGaugeName,
+		"Whether the model gateway consumer is paused as too many requests are in flight",
+		[]string{SeldonConsumerMetric},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	inFlightGauge, err := createGaugeVec(
+		modelGatewayInFlightGaugeName,
+		"Requests consumed and not yet answered by the model gateway consumer",
+		[]string{SeldonConsumerMetric},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrencyLimitGauge, err := createGaugeVec(
+		modelGatewayConcurrencyLimitGaugeName,
+		"Limit on requests in flight before the model gateway consumer is paused",
+		[]string{SeldonConsumerMetric},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimitedCounter, err := createCounterVec(
+		modelGatewayRateLimitedCounterName,
+		"A count of requests delayed by the model rate limit",
+		[]string{SeldonModelMetric},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	partitionLagGauge, err := createGaugeVec(
+		modelGatewayPartitionLagGaugeName,
+		"Messages waiting to be consumed from each partition of the model input topics",
+		[]string{SeldonTopicMetric, SeldonPartitionMetric},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	queueHistogram, err := createHistogramVec(
+		modelGatewayQueueHistogramName,
+		"A histogram of the time requests waited on the model input topic before being sent to the model",
+		[]string{SeldonModelMetric, SeldonPipelineMetric},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceHistogram, err := createHistogramVec(
+		modelGatewayServiceHistogramName,
+		"A histogram of the time taken to call the model and produce its response",
+		[]string{SeldonModelMetric, SeldonPipelineMetric},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrometheusModelGatewayMetrics{
+		logger:                logger.WithField("source", "PrometheusModelGatewayMetrics"),
+		consumerLagGauge:      consumerLagGauge,
+		consumerPausedGauge:   consumerPausedGauge,
+		inFlightGauge:         inFlightGauge,
+		concurrencyLimitGauge: concurrencyLimitGauge,
+		rateLimitedCounter:    rateLimitedCounter,
+		partitionLagGauge:     partitionLagGauge,
+		queueHistogram:        queueHistogram,
+		serviceHistogram:      serviceHistogram,
+	}, nil
+}
+
+func (pm *PrometheusModelGatewayMetrics) SetConsumerLag(modelName string, lag int64) {
+	pm.consumerLagGauge.With(prometheus.Labels{SeldonModelMetric: modelName}).Set(float64(lag))
+}
+
+func (pm *PrometheusModelGatewayMetrics) SetConsumerPaused(consumerName string, paused bool) {
+	value := 0.0
+	if paused {
+		value = 1.0
+	}
+	pm.consumerPausedGauge.With(prometheus.Labels{SeldonConsumerMetric: consumerName}).Set(value)
+}
+
+func (pm *PrometheusModelGatewayMetrics) SetConsumerConcurrency(consumerName string, inFlight int, limit int) {
+	pm.inFlightGauge.With(prometheus.Labels{SeldonConsumerMetric: consumerName}).Set(float64(inFlight))
+	pm.concurrencyLimitGauge.With(prometheus.Labels{SeldonConsumerMetric: consumerName}).Set(float64(limit))
+}
+
+func (pm *PrometheusModelGatewayMetrics) AddRateLimited(modelName string) {
+	pm.rateLimitedCounter.With(prometheus.Labels{SeldonModelMetric: modelName}).Inc()
+}
+
+func (pm *PrometheusModelGatewayMetrics) SetTopicPartitionLag(topic string, partition int32, lag int64) {
+	pm.partitionLagGauge.With(prometheus.Labels{
+		SeldonTopicMetric:     topic,
+		SeldonPartitionMetric: strconv.Itoa(int(partition)),
+	}).Set(float64(lag))
+}
+
+func (pm *PrometheusModelGatewayMetrics) AddQueueTime(modelName string, pipelineName string, seconds float64) {
+	pm.queueHistogram.With(prometheus.Labels{
+		SeldonModelMetric:    modelName,
+		SeldonPipelineMetric: pipelineName,
+	}).Observe(seconds)
+}
+
+func (pm *PrometheusModelGatewayMetrics) AddServiceTime(modelName string, pipelineName string, seconds float64) {
+	pm.serviceHistogram.With(prometheus.Labels{
+		SeldonModelMetric:    modelName,
+		SeldonPipelineMetric: pipelineName,
+	}).Observe(seconds)
+}
+
+func (pm *PrometheusModelGatewayMetrics) Start(port int) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	pm.server = &http.Server{
+		Addr:    ":" + strconv.Itoa(port),
+		Handler: mux,
+	}
+	pm.logger.Infof("Starting metrics server on port %d", port)
+	return pm.server.ListenAndServe()
+}
+
+func (pm *PrometheusModelGatewayMetrics) Stop() error {
+	return pm.server.Shutdown(context.Background())
+}
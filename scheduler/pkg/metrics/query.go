@@ -0,0 +1,69 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/sirupsen/logrus"
+)
+
+// PrometheusQuerier runs instant PromQL queries against a Prometheus server
+type PrometheusQuerier struct {
+	api    promv1.API
+	logger logrus.FieldLogger
+}
+
+func NewPrometheusQuerier(address string, logger logrus.FieldLogger) (*PrometheusQuerier, error) {
+	client, err := api.NewClient(api.Config{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	return &PrometheusQuerier{
+		api:    promv1.NewAPI(client),
+		logger: logger.WithField("source", "PrometheusQuerier"),
+	}, nil
+}
+
+// Query returns the result of the query summed over all returned series, which is 0 if there are none
+func (p *PrometheusQuerier) Query(ctx context.Context, query string) (float64, error) {
+	result, warnings, err := p.api.Query(ctx, query, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to run query %s: %w", query, err)
+	}
+	for _, warning := range warnings {
+		p.logger.Warnf("Query %s: %s", query, warning)
+	}
+	return sumQueryResult(result)
+}
+
+func sumQueryResult(result model.Value) (float64, error) {
+	sum := 0.0
+	switch value := result.(type) {
+	case *model.Scalar:
+		sum = float64(value.Value)
+	case model.Vector:
+		for _, sample := range value {
+			sum += float64(sample.Value)
+		}
+	default:
+		return 0, fmt.Errorf("unsupported query result type %s", result.Type())
+	}
+	if math.IsNaN(sum) {
+		return 0, nil
+	}
+	return sum, nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package metrics
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/common/model"
+)
+
+func TestSumQueryResult(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name     string
+		result   model.Value
+		expected float64
+		err      bool
+	}
+
+	tests := []test{
+		{
+			name:     "scalar",
+			result:   &model.Scalar{Value: 2.5},
+			expected: 2.5,
+		},
+		{
+			name: "vector",
+			result: model.Vector{
+				{Metric: model.Metric{"model": "a"}, Value: 1},
+				{Metric: model.Metric{"model": "b"}, Value: 2},
+			},
+			expected: 3,
+		},
+		{
+			name:     "empty vector",
+			result:   model.Vector{},
+			expected: 0,
+		},
+		{
+			name:     "not a number",
+			result:   &model.Scalar{Value: model.SampleValue(math.NaN())},
+			expected: 0,
+		},
+		{
+			name:   "matrix",
+			result: model.Matrix{},
+			err:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sum, err := sumQueryResult(test.result)
+			if test.err {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(sum).To(Equal(test.expected))
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+)
+
+// The latency delta of the mirror of an experiment is the difference of the rates of the latency sums of the roles
+// divided by the rate of compared requests
+const (
+	shadowRequestCounterName = "seldon_experiment_shadow_requests_total"
+	shadowErrorCounterName   = "seldon_experiment_shadow_errors_total"
+	shadowLatencyCounterName = "seldon_experiment_shadow_latency_seconds_total"
+	shadowOutputCounterName  = "seldon_experiment_shadow_output_comparisons_total"
+)
+
+// Metric labels
+const (
+	SeldonExperimentMetric = "experiment"
+	ShadowRoleMetric       = "role"
+	ShadowOutputMetric     = "output"
+	ShadowResultMetric     = "result"
+	ShadowResultCompared   = "compared"
+	ShadowResultUnmatched  = "unmatched"
+	ShadowResultDropped    = "dropped"
+	ShadowResultMatch      = "match"
+	ShadowResultMismatch   = "mismatch"
+	ShadowRolePrimary      = "primary"
+	ShadowRoleMirror       = "mirror"
+)
+
+// ShadowMetricsHandler records the comparison of the responses of the mirrors of experiments with the default models
+type ShadowMetricsHandler interface {
+	AddShadowComparison(experimentName string, primaryLatency float64, mirrorLatency float64, primaryError bool, mirrorError bool)
+	AddShadowOutputComparison(experimentName string, outputName string, mismatched bool)
+	AddShadowUnmatched(experimentName string)
+	AddShadowDropped(experimentName string)
+}
+
+type PrometheusShadowMetrics struct {
+	logger               log.FieldLogger
+	requestCounter       *prometheus.CounterVec
+	errorCounter         *prometheus.CounterVec
+	latencyCounter       *prometheus.CounterVec
+	outputCompareCounter *prometheus.CounterVec
+	server               *http.Server
+}
+
+func NewPrometheusShadowMetrics(logger log.FieldLogger) (*PrometheusShadowMetrics, error) {
+	requestCounter, err := createCounterVec(
+		shadowRequestCounterName,
+		"A count of mirrored requests compared, unmatched as a response did not arrive in time or dropped as too many were waiting",
+		[]string{SeldonExperimentMetric, ShadowResultMetric},
+	)
+	if err != nil {
+		return nil, err
+	}
+	errorCounter, err := createCounterVec(
+		shadowErrorCounterName,
+		"A count of compared requests failing on the default model or the mirror",
+		[]string{SeldonExperimentMetric, ShadowRoleMetric},
+	)
+	if err != nil {
+		return nil, err
+	}
+	latencyCounter, err := createCounterVec(
+		shadowLatencyCounterName,
+		"A sum of the latencies of compared requests on the default model or the mirror",
+		[]string{SeldonExperimentMetric, ShadowRoleMetric},
+	)
+	if err != nil {
+		return nil, err
+	}
+	outputCompareCounter, err := createCounterVec(
+		shadowOutputCounterName,
+		"A count of compared outputs matching within the tolerance or not",
+		[]string{SeldonExperimentMetric, ShadowOutputMetric, ShadowResultMetric},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &PrometheusShadowMetrics{
+		logger:               logger.WithField("source", "PrometheusShadowMetrics"),
+		requestCounter:       requestCounter,
+		errorCounter:         errorCounter,
+		latencyCounter:       latencyCounter,
+		outputCompareCounter: outputCompareCounter,
+	}, nil
+}
+
+func (sm *PrometheusShadowMetrics) AddShadowComparison(experimentName string, primaryLatency float64, mirrorLatency float64, primaryError bool, mirrorError bool) {
+	sm.requestCounter.With(prometheus.Labels{SeldonExperimentMetric: experimentName, ShadowResultMetric: ShadowResultCompared}).Inc()
+	sm.latencyCounter.With(prometheus.Labels{SeldonExperimentMetric: experimentName, ShadowRoleMetric: ShadowRolePrimary}).Add(primaryLatency)
+	sm.latencyCounter.With(prometheus.Labels{SeldonExperimentMetric: experimentName, ShadowRoleMetric: ShadowRoleMirror}).Add(mirrorLatency)
+	if primaryError {
+		sm.errorCounter.With(prometheus.Labels{SeldonExperimentMetric: experimentName, ShadowRoleMetric: ShadowRolePrimary}).Inc()
+	}
+	if mirrorError {
+		sm.errorCounter.With(prometheus.Labels{SeldonExperimentMetric: experimentName, ShadowRoleMetric: ShadowRoleMirror}).Inc()
+	}
+}
+
+func (sm *PrometheusShadowMetrics) AddShadowOutputComparison(experimentName string, outputName string, mismatched bool) {
+	result := ShadowResultMatch
+	if mismatched {
+		result = ShadowResultMismatch
+	}
+	sm.outputCompareCounter.With(prometheus.Labels{
+		SeldonExperimentMetric: experimentName,
+		ShadowOutputMetric:     outputName,
+		ShadowResultMetric:     result,
+	}).Inc()
+}
+
+func (sm *PrometheusShadowMetrics) AddShadowUnmatched(experimentName string) {
+	sm.requestCounter.With(prometheus.Labels{SeldonExperimentMetric: experimentName, ShadowResultMetric: ShadowResultUnmatched}).Inc()
+}
+
+func (sm *PrometheusShadowMetrics) AddShadowDropped(experimentName string) {
+	sm.requestCounter.With(prometheus.Labels{SeldonExperimentMetric: experimentName, ShadowResultMetric: ShadowResultDropped}).Inc()
+}
+
+func (sm *PrometheusShadowMetrics) Start(port int) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	sm.server = &http.Server{
+		Addr:    ":" + strconv.Itoa(port),
+		Handler: mux,
+	}
+	sm.logger.Infof("Starting metrics server on port %d", port)
+	return sm.server.ListenAndServe()
+}
+
+func (sm *PrometheusShadowMetrics) Stop() error {
+	return sm.server.Shutdown(context.Background())
+}
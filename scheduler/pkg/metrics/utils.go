@@ -56,3 +56,23 @@ func createGaugeVec(gaugeName string, helperName string, labelNames []string) (*
 	}
 	return gauge, nil
 }
+
+func createHistogramVec(histogramName string, helperName string, labelNames []string) (*prometheus.HistogramVec, error) {
+	histogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    histogramName,
+			Help:    helperName,
+			Buckets: DefaultHistogramBuckets,
+		},
+		labelNames,
+	)
+	err := prometheus.Register(histogram)
+	if err != nil {
+		if e, ok := err.(prometheus.AlreadyRegisteredError); ok {
+			histogram = e.ExistingCollector.(*prometheus.HistogramVec)
+		} else {
+			return nil, err
+		}
+	}
+	return histogram, nil
+}
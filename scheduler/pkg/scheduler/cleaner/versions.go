@@ -65,6 +65,10 @@ func (v *VersionCleaner) cleanupOldVersions(modelName string) error {
 	if latest == nil {
 		return fmt.Errorf("Failed to find latest model for %s", modelName)
 	}
+	if model.Rollout.InProgress() {
+		logger.Debugf("Skipping cleanup for model %s as rollout of version %d is in progress", modelName, model.Rollout.Version)
+		return nil
+	}
 	if latest.ModelState().State == store.ModelAvailable {
 		for _, mv := range model.GetVersionsBeforeLastAvailable() {
 			_, err := v.store.UnloadVersionModels(modelName, mv.GetVersion())
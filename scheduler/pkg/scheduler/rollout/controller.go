@@ -0,0 +1,240 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+const (
+	DefaultAnalysisInterval = 10 * time.Second
+	analysisQueryTimeout    = 10 * time.Second
+	// Agent metrics of the new version over the current step, failed requests are those without a 2xx or OK code
+	requestsQuery = `sum(increase(seldon_model_infer_total{model_internal="%s"}[%ds]))`
+	errorsQuery   = `sum(increase(seldon_model_infer_total{model_internal="%s",code!~"2..|OK"}[%ds]))`
+	latencyQuery  = `sum(increase(seldon_model_infer_seconds_total{model_internal="%s"}[%ds]))`
+)
+
+// MetricsQuerier runs PromQL queries for the analysis of rollout steps
+type MetricsQuerier interface {
+	Query(ctx context.Context, query string) (float64, error)
+}
+
+// RolloutController moves model rollouts through their steps, checking the analysis criteria of each step
+// and pausing or rolling back the rollout when they fail
+type RolloutController struct {
+	store   store.ModelStore
+	querier MetricsQuerier
+	logger  log.FieldLogger
+	done    chan struct{}
+}
+
+func NewRolloutController(
+	schedStore store.ModelStore,
+	querier MetricsQuerier,
+	logger log.FieldLogger,
+) *RolloutController {
+	return &RolloutController{
+		store:   schedStore,
+		querier: querier,
+		logger:  logger.WithField("source", "RolloutController"),
+		done:    make(chan struct{}),
+	}
+}
+
+func (r *RolloutController) Start(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+			r.evaluateRollouts()
+		}
+	}
+}
+
+func (r *RolloutController) Stop() {
+	close(r.done)
+}
+
+func (r *RolloutController) evaluateRollouts() {
+	logger := r.logger.WithField("func", "evaluateRollouts")
+	models, err := r.store.GetModels()
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get models")
+		return
+	}
+	for _, model := range models {
+		if model.Rollout == nil || model.Rollout.State != store.RolloutProgressing {
+			continue
+		}
+		if err := r.evaluateRollout(model.Name); err != nil {
+			logger.WithError(err).Warnf("Failed to evaluate rollout for model %s", model.Name)
+		}
+	}
+}
+
+func (r *RolloutController) evaluateRollout(modelName string) error {
+	r.store.LockModel(modelName)
+	model, err := r.store.GetModel(modelName)
+	if err != nil {
+		r.store.UnlockModel(modelName)
+		return err
+	}
+	rollout := model.Rollout
+	latest := model.GetLatest()
+	if rollout == nil || rollout.State != store.RolloutProgressing || latest == nil || latest.GetVersion() != rollout.Version {
+		r.store.UnlockModel(modelName)
+		return nil
+	}
+	available := latest.ModelState().State == store.ModelAvailable
+	strategy := latest.GetModel().GetModelSpec().GetRollout()
+	r.store.UnlockModel(modelName)
+
+	// Steps only start once the new version can take its share of traffic
+	if !available {
+		return nil
+	}
+
+	now := time.Now()
+	var analysis *stepAnalysis
+	if !rollout.StepStart.IsZero() && hasCriteria(strategy.GetAnalysis()) {
+		if r.querier == nil {
+			next := *rollout
+			next.State = store.RolloutPaused
+			next.Reason = "no Prometheus server configured to check the rollout analysis"
+			return r.store.SetModelRollout(modelName, &next)
+		}
+		analysis, err = r.analyseStep(modelName, rollout.Version, now.Sub(rollout.StepStart))
+		if err != nil {
+			return err
+		}
+	}
+
+	next, changed := nextRollout(*rollout, strategy, analysis, now)
+	if !changed {
+		return nil
+	}
+	r.logger.Debugf("Rollout of model %s version %d is %s at step %d", modelName, next.Version, next.State.String(), next.Step)
+	return r.store.SetModelRollout(modelName, &next)
+}
+
+func hasCriteria(criteria *pb.RolloutAnalysis) bool {
+	return criteria != nil && (criteria.MaxErrorRate != nil || criteria.MaxLatencyMs != nil)
+}
+
+// stepAnalysis holds the requests to the new version since the start of the current step
+type stepAnalysis struct {
+	requests       float64
+	errors         float64
+	latencySeconds float64 // summed over all requests
+}
+
+func (r *RolloutController) analyseStep(modelName string, version uint32, elapsed time.Duration) (*stepAnalysis, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), analysisQueryTimeout)
+	defer cancel()
+
+	internalModelName := util.GetVersionedModelName(modelName, version)
+	window := int(math.Ceil(elapsed.Seconds()))
+	if window < 1 {
+		window = 1
+	}
+	analysis := &stepAnalysis{}
+	var err error
+	if analysis.requests, err = r.querier.Query(ctx, fmt.Sprintf(requestsQuery, internalModelName, window)); err != nil {
+		return nil, err
+	}
+	if analysis.errors, err = r.querier.Query(ctx, fmt.Sprintf(errorsQuery, internalModelName, window)); err != nil {
+		return nil, err
+	}
+	if analysis.latencySeconds, err = r.querier.Query(ctx, fmt.Sprintf(latencyQuery, internalModelName, window)); err != nil {
+		return nil, err
+	}
+	return analysis, nil
+}
+
+// failure returns why the step fails the criteria, or an empty string if it passes or has too few requests to tell
+func (s *stepAnalysis) failure(criteria *pb.RolloutAnalysis) string {
+	if s.requests == 0 || s.requests < float64(criteria.GetMinRequests()) {
+		return ""
+	}
+	if criteria.MaxErrorRate != nil {
+		errorRate := s.errors / s.requests
+		if errorRate > float64(criteria.GetMaxErrorRate()) {
+			return fmt.Sprintf("error rate %.3f above %.3f", errorRate, criteria.GetMaxErrorRate())
+		}
+	}
+	if criteria.MaxLatencyMs != nil {
+		latencyMs := s.latencySeconds * 1000 / s.requests
+		if latencyMs > float64(criteria.GetMaxLatencyMs()) {
+			return fmt.Sprintf("mean latency %.0fms above %dms", latencyMs, criteria.GetMaxLatencyMs())
+		}
+	}
+	return ""
+}
+
+// nextRollout moves a progressing rollout on given the analysis of its current step, which is nil if there are
+// no criteria to check. It returns false if the rollout is unchanged.
+func nextRollout(rollout store.ModelRollout, strategy *pb.RolloutStrategy, analysis *stepAnalysis, now time.Time) (store.ModelRollout, bool) {
+	if rollout.StepStart.IsZero() {
+		rollout.StepStart = now
+		return rollout, true
+	}
+
+	criteria := strategy.GetAnalysis()
+	if analysis != nil {
+		if reason := analysis.failure(criteria); reason != "" {
+			if criteria.GetFailureAction() == pb.RolloutAnalysis_PAUSE {
+				rollout.State = store.RolloutPaused
+			} else {
+				rollout.State = store.RolloutRolledBack
+			}
+			rollout.Reason = fmt.Sprintf("step %d failed: %s", rollout.Step, reason)
+			return rollout, true
+		}
+	}
+
+	steps := strategy.GetSteps()
+	if rollout.Step < len(steps) && now.Sub(rollout.StepStart) < time.Duration(steps[rollout.Step].GetHoldSeconds())*time.Second {
+		return rollout, false
+	}
+	minRequests := criteria.GetMinRequests()
+	if minRequests == 0 {
+		minRequests = 1
+	}
+	if analysis != nil && analysis.requests < float64(minRequests) {
+		reason := fmt.Sprintf("waiting for %d requests to check step %d", minRequests, rollout.Step)
+		if reason == rollout.Reason {
+			return rollout, false
+		}
+		rollout.Reason = reason
+		return rollout, true
+	}
+
+	rollout.Reason = ""
+	rollout.Step++
+	rollout.StepStart = now
+	if rollout.Step >= len(steps) {
+		rollout.State = store.RolloutSucceeded
+	}
+	return rollout, true
+}
@@ -0,0 +1,302 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+)
+
+type fakeModelStore struct {
+	store.ModelStore
+	model   *store.ModelSnapshot
+	updated *store.ModelRollout
+}
+
+func (f *fakeModelStore) GetModels() ([]*store.ModelSnapshot, error) {
+	return []*store.ModelSnapshot{f.model}, nil
+}
+
+func (f *fakeModelStore) GetModel(key string) (*store.ModelSnapshot, error) {
+	if key != f.model.Name {
+		return nil, fmt.Errorf("model %s not found", key)
+	}
+	return f.model, nil
+}
+
+func (f *fakeModelStore) LockModel(modelId string) {}
+
+func (f *fakeModelStore) UnlockModel(modelId string) {}
+
+func (f *fakeModelStore) SetModelRollout(modelKey string, rollout *store.ModelRollout) error {
+	f.updated = rollout
+	return nil
+}
+
+type fakeMetricsQuerier struct {
+	requests       float64
+	errors         float64
+	latencySeconds float64
+	err            error
+}
+
+func (f *fakeMetricsQuerier) Query(ctx context.Context, query string) (float64, error) {
+	switch {
+	case f.err != nil:
+		return 0, f.err
+	case strings.Contains(query, "seldon_model_infer_seconds_total"):
+		return f.latencySeconds, nil
+	case strings.Contains(query, "code!~"):
+		return f.errors, nil
+	default:
+		return f.requests, nil
+	}
+}
+
+func TestNextRollout(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Now()
+	maxErrorRate := float32(0.1)
+	maxLatencyMs := uint32(100)
+	strategy := &pb.RolloutStrategy{
+		Steps: []*pb.RolloutStep{
+			{TrafficPercent: 10, HoldSeconds: 60},
+			{TrafficPercent: 50, HoldSeconds: 60},
+		},
+		Analysis: &pb.RolloutAnalysis{
+			MaxErrorRate: &maxErrorRate,
+			MaxLatencyMs: &maxLatencyMs,
+			MinRequests:  10,
+		},
+	}
+	pauseStrategy := &pb.RolloutStrategy{
+		Steps: strategy.Steps,
+		Analysis: &pb.RolloutAnalysis{
+			MaxErrorRate:  &maxErrorRate,
+			FailureAction: pb.RolloutAnalysis_PAUSE,
+		},
+	}
+
+	type test struct {
+		name            string
+		rollout         store.ModelRollout
+		strategy        *pb.RolloutStrategy
+		analysis        *stepAnalysis
+		expectedRollout store.ModelRollout
+		expectedChanged bool
+	}
+
+	tests := []test{
+		{
+			name:            "start first step",
+			rollout:         store.ModelRollout{Version: 2},
+			strategy:        strategy,
+			expectedRollout: store.ModelRollout{Version: 2, StepStart: now},
+			expectedChanged: true,
+		},
+		{
+			name:            "holding",
+			rollout:         store.ModelRollout{Version: 2, StepStart: now.Add(-30 * time.Second)},
+			strategy:        strategy,
+			analysis:        &stepAnalysis{requests: 20, errors: 1, latencySeconds: 1},
+			expectedRollout: store.ModelRollout{Version: 2, StepStart: now.Add(-30 * time.Second)},
+			expectedChanged: false,
+		},
+		{
+			name:            "next step",
+			rollout:         store.ModelRollout{Version: 2, StepStart: now.Add(-time.Minute)},
+			strategy:        strategy,
+			analysis:        &stepAnalysis{requests: 20, errors: 1, latencySeconds: 1},
+			expectedRollout: store.ModelRollout{Version: 2, Step: 1, StepStart: now},
+			expectedChanged: true,
+		},
+		{
+			name:            "succeeded after last step",
+			rollout:         store.ModelRollout{Version: 2, Step: 1, StepStart: now.Add(-time.Minute)},
+			strategy:        strategy,
+			analysis:        &stepAnalysis{requests: 20},
+			expectedRollout: store.ModelRollout{Version: 2, Step: 2, StepStart: now, State: store.RolloutSucceeded},
+			expectedChanged: true,
+		},
+		{
+			name:            "no analysis",
+			rollout:         store.ModelRollout{Version: 2, StepStart: now.Add(-time.Minute)},
+			strategy:        &pb.RolloutStrategy{Steps: strategy.Steps},
+			expectedRollout: store.ModelRollout{Version: 2, Step: 1, StepStart: now},
+			expectedChanged: true,
+		},
+		{
+			name:            "waiting for requests",
+			rollout:         store.ModelRollout{Version: 2, StepStart: now.Add(-time.Minute)},
+			strategy:        strategy,
+			analysis:        &stepAnalysis{requests: 5},
+			expectedRollout: store.ModelRollout{Version: 2, StepStart: now.Add(-time.Minute), Reason: "waiting for 10 requests to check step 0"},
+			expectedChanged: true,
+		},
+		{
+			name:            "still waiting for requests",
+			rollout:         store.ModelRollout{Version: 2, StepStart: now.Add(-time.Minute), Reason: "waiting for 10 requests to check step 0"},
+			strategy:        strategy,
+			analysis:        &stepAnalysis{requests: 5},
+			expectedRollout: store.ModelRollout{Version: 2, StepStart: now.Add(-time.Minute), Reason: "waiting for 10 requests to check step 0"},
+			expectedChanged: false,
+		},
+		{
+			name:            "error rate rolls back before hold ends",
+			rollout:         store.ModelRollout{Version: 2, StepStart: now.Add(-30 * time.Second)},
+			strategy:        strategy,
+			analysis:        &stepAnalysis{requests: 20, errors: 4},
+			expectedRollout: store.ModelRollout{Version: 2, StepStart: now.Add(-30 * time.Second), State: store.RolloutRolledBack, Reason: "step 0 failed: error rate 0.200 above 0.100"},
+			expectedChanged: true,
+		},
+		{
+			name:            "latency rolls back",
+			rollout:         store.ModelRollout{Version: 2, Step: 1, StepStart: now.Add(-time.Minute)},
+			strategy:        strategy,
+			analysis:        &stepAnalysis{requests: 20, latencySeconds: 4},
+			expectedRollout: store.ModelRollout{Version: 2, Step: 1, StepStart: now.Add(-time.Minute), State: store.RolloutRolledBack, Reason: "step 1 failed: mean latency 200ms above 100ms"},
+			expectedChanged: true,
+		},
+		{
+			name:            "too few requests to fail",
+			rollout:         store.ModelRollout{Version: 2, StepStart: now.Add(-30 * time.Second)},
+			strategy:        strategy,
+			analysis:        &stepAnalysis{requests: 5, errors: 5},
+			expectedRollout: store.ModelRollout{Version: 2, StepStart: now.Add(-30 * time.Second)},
+			expectedChanged: false,
+		},
+		{
+			name:            "error rate pauses",
+			rollout:         store.ModelRollout{Version: 2, StepStart: now.Add(-30 * time.Second)},
+			strategy:        pauseStrategy,
+			analysis:        &stepAnalysis{requests: 20, errors: 4},
+			expectedRollout: store.ModelRollout{Version: 2, StepStart: now.Add(-30 * time.Second), State: store.RolloutPaused, Reason: "step 0 failed: error rate 0.200 above 0.100"},
+			expectedChanged: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rollout, changed := nextRollout(test.rollout, test.strategy, test.analysis, now)
+			g.Expect(changed).To(Equal(test.expectedChanged))
+			g.Expect(rollout).To(Equal(test.expectedRollout))
+		})
+	}
+}
+
+func TestEvaluateRollout(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	maxErrorRate := float32(0.1)
+	strategy := &pb.RolloutStrategy{
+		Steps: []*pb.RolloutStep{
+			{TrafficPercent: 10},
+		},
+		Analysis: &pb.RolloutAnalysis{
+			MaxErrorRate: &maxErrorRate,
+		},
+	}
+	createModel := func(state store.ModelState, rollout *store.ModelRollout) *store.ModelSnapshot {
+		return &store.ModelSnapshot{
+			Name: "model",
+			Versions: []*store.ModelVersion{
+				store.NewModelVersion(&pb.Model{Meta: &pb.MetaData{Name: "model"}}, 1, "server", map[int]store.ReplicaStatus{0: {State: store.Available}}, false, store.ModelAvailable),
+				store.NewModelVersion(&pb.Model{Meta: &pb.MetaData{Name: "model"}, ModelSpec: &pb.ModelSpec{Rollout: strategy}}, 2, "server", map[int]store.ReplicaStatus{1: {State: store.Available}}, false, state),
+			},
+			Rollout: rollout,
+		}
+	}
+	stepStart := time.Now().Add(-time.Minute)
+
+	type test struct {
+		name          string
+		model         *store.ModelSnapshot
+		querier       MetricsQuerier
+		expectedState *store.RolloutState
+		expectedErr   bool
+	}
+
+	progressing := store.RolloutProgressing
+	paused := store.RolloutPaused
+	rolledBack := store.RolloutRolledBack
+	succeeded := store.RolloutSucceeded
+	tests := []test{
+		{
+			name:    "new version not available",
+			model:   createModel(store.ModelProgressing, &store.ModelRollout{Version: 2, StableVersion: 1}),
+			querier: &fakeMetricsQuerier{},
+		},
+		{
+			name:          "step starts",
+			model:         createModel(store.ModelAvailable, &store.ModelRollout{Version: 2, StableVersion: 1}),
+			querier:       &fakeMetricsQuerier{},
+			expectedState: &progressing,
+		},
+		{
+			name:          "succeeded",
+			model:         createModel(store.ModelAvailable, &store.ModelRollout{Version: 2, StableVersion: 1, StepStart: stepStart}),
+			querier:       &fakeMetricsQuerier{requests: 10},
+			expectedState: &succeeded,
+		},
+		{
+			name:          "rolled back",
+			model:         createModel(store.ModelAvailable, &store.ModelRollout{Version: 2, StableVersion: 1, StepStart: stepStart}),
+			querier:       &fakeMetricsQuerier{requests: 10, errors: 5},
+			expectedState: &rolledBack,
+		},
+		{
+			name:          "no querier",
+			model:         createModel(store.ModelAvailable, &store.ModelRollout{Version: 2, StableVersion: 1, StepStart: stepStart}),
+			expectedState: &paused,
+		},
+		{
+			name:        "query fails",
+			model:       createModel(store.ModelAvailable, &store.ModelRollout{Version: 2, StableVersion: 1, StepStart: stepStart}),
+			querier:     &fakeMetricsQuerier{err: fmt.Errorf("prometheus unavailable")},
+			expectedErr: true,
+		},
+		{
+			name:    "already finished",
+			model:   createModel(store.ModelAvailable, &store.ModelRollout{Version: 2, StableVersion: 1, StepStart: stepStart, State: store.RolloutSucceeded}),
+			querier: &fakeMetricsQuerier{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			modelStore := &fakeModelStore{model: test.model}
+			controller := NewRolloutController(modelStore, test.querier, log.New())
+			err := controller.evaluateRollout("model")
+			if test.expectedErr {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			if test.expectedState == nil {
+				g.Expect(modelStore.updated).To(BeNil())
+			} else {
+				g.Expect(modelStore.updated).ToNot(BeNil())
+				g.Expect(modelStore.updated.State).To(Equal(*test.expectedState))
+				g.Expect(modelStore.updated.StepStart.IsZero()).To(BeFalse())
+			}
+		})
+	}
+}
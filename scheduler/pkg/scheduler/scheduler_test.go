@@ -98,6 +98,10 @@ func (f *mockStore) UpdateLoadedModels(modelKey string, version uint32, serverKe
 	return nil
 }
 
+func (f mockStore) SetModelRollout(modelKey string, rollout *store.ModelRollout) error {
+	panic("implement me")
+}
+
 func (f mockStore) UpdateModelState(modelKey string, version uint32, serverKey string, replicaIdx int, availableMemory *uint64, expectedState, desiredState store.ModelReplicaState, reason string) error {
 	panic("implement me")
 }
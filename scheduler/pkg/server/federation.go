@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/federation"
+)
+
+// SetFederation enables the FederationSummary rpc, which is only available when the scheduler has a region
+func (s *SchedulerServer) SetFederation(fed *federation.Federation) {
+	s.federation = fed
+}
+
+func (s *SchedulerServer) FederationSummary(ctx context.Context, req *pb.FederationSummaryRequest) (*pb.FederationSummaryResponse, error) {
+	logger := s.logger.WithField("func", "FederationSummary")
+	if s.federation == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "federation is not configured for the scheduler")
+	}
+	if err := s.federation.AuthenticatePeer(ctx); err != nil {
+		logger.WithError(err).Warnf("Refusing federation summary requested by region %s", req.GetRegion())
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+	logger.Debugf("Federation summary requested by region %s", req.GetRegion())
+	summary, err := s.federation.GetSummary()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return summary, nil
+}
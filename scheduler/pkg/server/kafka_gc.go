@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/gc"
+)
+
+// SetKafkaGarbageCollector enables the KafkaGarbageCollect rpc, which is only available when Kafka is configured
+func (s *SchedulerServer) SetKafkaGarbageCollector(collector *gc.KafkaGarbageCollector) {
+	s.kafkaGarbageCollector = collector
+}
+
+func (s *SchedulerServer) KafkaGarbageCollect(ctx context.Context, req *pb.KafkaGarbageCollectRequest) (*pb.KafkaGarbageCollectResponse, error) {
+	logger := s.logger.WithField("func", "KafkaGarbageCollect")
+	if s.kafkaGarbageCollector == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Kafka is not configured for the scheduler")
+	}
+	gracePeriod := s.kafkaGarbageCollector.GracePeriod()
+	if req.GracePeriodSeconds != nil {
+		gracePeriod = time.Duration(req.GetGracePeriodSeconds()) * time.Second
+	}
+	logger.Infof("Collecting Kafka garbage with grace period %s (dry run %v)", gracePeriod, req.GetDryRun())
+	result, err := s.kafkaGarbageCollector.Collect(ctx, req.GetDryRun(), gracePeriod)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return &pb.KafkaGarbageCollectResponse{
+		Topics:         createKafkaOrphanedResources(result.Topics),
+		ConsumerGroups: createKafkaOrphanedResources(result.ConsumerGroups),
+	}, nil
+}
+
+func createKafkaOrphanedResources(orphans []*gc.OrphanedResource) []*pb.KafkaOrphanedResource {
+	var resources []*pb.KafkaOrphanedResource
+	for _, orphan := range orphans {
+		resources = append(resources, &pb.KafkaOrphanedResource{
+			Name:            orphan.Name,
+			Owner:           orphan.Owner,
+			OrphanedSeconds: uint64(orphan.Orphaned.Seconds()),
+			Deleted:         orphan.Deleted,
+		})
+	}
+	return resources
+}
@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/replay"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/pipeline"
+)
+
+// SetPipelineReplayer enables the ReplayPipeline and PipelineReplayStatus rpcs, which are only available when Kafka is configured
+func (s *SchedulerServer) SetPipelineReplayer(replayer *replay.PipelineReplayer) {
+	s.pipelineReplayer = replayer
+}
+
+func (s *SchedulerServer) ReplayPipeline(ctx context.Context, req *pb.ReplayPipelineRequest) (*pb.ReplayPipelineResponse, error) {
+	logger := s.logger.WithField("func", "ReplayPipeline")
+	if s.pipelineReplayer == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Kafka is not configured for the scheduler")
+	}
+	if err := s.checkReplayPipelines(req); err != nil {
+		return nil, err
+	}
+	replayRequest := &replay.Request{
+		Pipeline:       req.GetName(),
+		SourcePipeline: req.GetSourcePipeline(),
+		Version:        req.GetVersion(),
+		SinkTopic:      req.GetSinkTopic(),
+		Range: replay.Range{
+			FromOffset: req.FromOffset,
+			ToOffset:   req.ToOffset,
+		},
+	}
+	if req.FromTime != nil {
+		fromTime := req.FromTime.AsTime()
+		replayRequest.Range.FromTime = &fromTime
+	}
+	if req.ToTime != nil {
+		toTime := req.ToTime.AsTime()
+		replayRequest.Range.ToTime = &toTime
+	}
+	job, err := s.pipelineReplayer.Start(replayRequest)
+	if err != nil {
+		if errors.As(err, new(*replay.InvalidSinkTopicErr)) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	logger.Infof("Started replay %s of pipeline %s", job.Id, job.Pipeline)
+	return &pb.ReplayPipelineResponse{
+		ReplayId:  job.Id,
+		SinkTopic: job.SinkTopic,
+	}, nil
+}
+
+// checkReplayPipelines checks the pipelines exist, including the version the requests are replayed through if set
+func (s *SchedulerServer) checkReplayPipelines(req *pb.ReplayPipelineRequest) error {
+	for _, name := range []string{req.GetName(), req.GetSourcePipeline()} {
+		if name == "" {
+			continue
+		}
+		p, err := s.pipelineHandler.GetPipeline(name)
+		if err != nil {
+			if errors.Is(err, &pipeline.PipelineNotFoundErr{}) {
+				return status.Errorf(codes.NotFound, err.Error())
+			}
+			return status.Errorf(codes.FailedPrecondition, err.Error())
+		}
+		if name == req.GetName() && req.GetVersion() > 0 && p.GetPipelineVersion(req.GetVersion()) == nil {
+			return status.Errorf(codes.NotFound, fmt.Sprintf("pipeline %s has no version %d", name, req.GetVersion()))
+		}
+	}
+	return nil
+}
+
+func (s *SchedulerServer) PipelineReplayStatus(ctx context.Context, req *pb.PipelineReplayStatusRequest) (*pb.PipelineReplayStatusResponse, error) {
+	if s.pipelineReplayer == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Kafka is not configured for the scheduler")
+	}
+	job, err := s.pipelineReplayer.Get(req.GetReplayId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	}
+	return &pb.PipelineReplayStatusResponse{
+		ReplayId:  job.Id,
+		Name:      job.Pipeline,
+		SinkTopic: job.SinkTopic,
+		State:     createReplayState(job.State),
+		Replayed:  job.Replayed,
+		Reason:    job.Reason,
+	}, nil
+}
+
+func createReplayState(state replay.JobState) pb.PipelineReplayStatusResponse_ReplayState {
+	switch state {
+	case replay.JobSucceeded:
+		return pb.PipelineReplayStatusResponse_REPLAY_SUCCEEDED
+	case replay.JobFailed:
+		return pb.PipelineReplayStatusResponse_REPLAY_FAILED
+	default:
+		return pb.PipelineReplayStatusResponse_REPLAY_RUNNING
+	}
+}
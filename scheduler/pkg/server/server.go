@@ -11,6 +11,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"sync"
@@ -27,6 +28,9 @@ import (
 	seldontls "github.com/seldonio/seldon-core/components/tls/v2/pkg/tls"
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/coordinator"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/federation"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/gc"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/replay"
 	scheduler2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/experiment"
@@ -59,6 +63,9 @@ type SchedulerServer struct {
 	experimentEventStream ExperimentEventStream
 	pipelineEventStream   PipelineEventStream
 	certificateStore      *seldontls.CertificateStore
+	kafkaGarbageCollector *gc.KafkaGarbageCollector
+	pipelineReplayer      *replay.PipelineReplayer
+	federation            *federation.Federation
 }
 
 type ModelEventStream struct {
@@ -261,7 +268,12 @@ func (s *SchedulerServer) rescheduleModels(serverKey string) {
 func (s *SchedulerServer) LoadModel(ctx context.Context, req *pb.LoadModelRequest) (*pb.LoadModelResponse, error) {
 	logger := s.logger.WithField("func", "LoadModel")
 	logger.Debugf("Load model %+v k8s meta %+v", req.GetModel().GetMeta(), req.GetModel().GetMeta().GetKubernetesMeta())
-	err := s.modelStore.UpdateModel(req)
+	// transform steps write to the outputs topic of their name, so models can not share the name of one
+	err := s.pipelineHandler.CheckModelName(req.GetModel().GetMeta().GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+	}
+	err = s.modelStore.UpdateModel(req)
 	if err != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
 	}
@@ -332,10 +344,31 @@ func (s *SchedulerServer) modelStatusImpl(model *store.ModelSnapshot, allVersion
 		ModelName: model.Name,
 		Versions:  modelVersionStatuses,
 		Deleted:   model.Deleted,
+		Rollout:   createModelRolloutStatus(model),
 	}
 	return msr, nil
 }
 
+func createModelRolloutStatus(model *store.ModelSnapshot) *pb.ModelRolloutStatus {
+	rollout := model.Rollout
+	if rollout == nil {
+		return nil
+	}
+	var strategy *pb.RolloutStrategy
+	if mv := model.GetVersion(rollout.Version); mv != nil {
+		strategy = mv.GetModel().GetModelSpec().GetRollout()
+	}
+	return &pb.ModelRolloutStatus{
+		State:               pb.ModelRolloutStatus_RolloutState(pb.ModelRolloutStatus_RolloutState_value[rollout.State.String()]),
+		Version:             rollout.Version,
+		StableVersion:       rollout.StableVersion,
+		Step:                uint32(rollout.Step),
+		TrafficPercent:      rollout.TrafficPercent(strategy),
+		Reason:              rollout.Reason,
+		LastChangeTimestamp: timestamppb.New(rollout.Timestamp),
+	}
+}
+
 func (s *SchedulerServer) ModelStatus(
 	req *pb.ModelStatusRequest,
 	stream pb.Scheduler_ModelStatusServer,
@@ -471,6 +504,36 @@ func (s *SchedulerServer) StopExperiment(ctx context.Context, req *pb.StopExperi
 	return &pb.StopExperimentResponse{}, nil
 }
 
+func (s *SchedulerServer) ExperimentFeedback(ctx context.Context, req *pb.ExperimentFeedbackRequest) (*pb.ExperimentFeedbackResponse, error) {
+	err := s.experimentServer.AddFeedback(req.GetExperimentName(), req.GetCandidate(), req.GetRequestId(), req.GetReward())
+	if err != nil {
+		if errors.Is(err, &experiment.ExperimentNotFound{}) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+	}
+	return &pb.ExperimentFeedbackResponse{}, nil
+}
+
+func (s *SchedulerServer) ExperimentStats(ctx context.Context, req *pb.ExperimentStatsRequest) (*pb.ExperimentStatsResponse, error) {
+	var window *time.Duration
+	if req.WindowSeconds != nil {
+		if req.GetWindowSeconds() == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "window for statistics of experiment %s must be positive", req.GetExperimentName())
+		}
+		w := time.Duration(req.GetWindowSeconds()) * time.Second
+		window = &w
+	}
+	stats, err := s.experimentServer.GetExperimentStats(req.GetExperimentName(), window, req.ConfidenceLevel)
+	if err != nil {
+		if errors.Is(err, &experiment.ExperimentNotFound{}) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+	}
+	return experiment.CreateExperimentStatsProto(stats), nil
+}
+
 func (s *SchedulerServer) ExperimentStatus(
 	req *pb.ExperimentStatusRequest,
 	stream pb.Scheduler_ExperimentStatusServer,
@@ -486,7 +549,7 @@ func (s *SchedulerServer) ExperimentStatus(
 		}
 
 		for _, e := range experiments {
-			resp := createExperimentStatus(e)
+			resp := s.createExperimentStatus(e)
 			err = stream.Send(resp)
 			if err != nil {
 				return status.Errorf(codes.Internal, err.Error())
@@ -500,7 +563,7 @@ func (s *SchedulerServer) ExperimentStatus(
 			return status.Errorf(codes.FailedPrecondition, err.Error())
 		}
 
-		resp := createExperimentStatus(exp)
+		resp := s.createExperimentStatus(exp)
 		err = stream.Send(resp)
 		if err != nil {
 			return status.Errorf(codes.Internal, err.Error())
@@ -509,13 +572,21 @@ func (s *SchedulerServer) ExperimentStatus(
 	}
 }
 
-func createExperimentStatus(e *experiment.Experiment) *pb.ExperimentStatusResponse {
+func (s *SchedulerServer) createExperimentStatus(e *experiment.Experiment) *pb.ExperimentStatusResponse {
 	response := &pb.ExperimentStatusResponse{
 		ExperimentName:    e.Name,
 		Active:            e.Active,
 		StatusDescription: e.StatusDescription,
 		CandidatesReady:   e.AreCandidatesReady(),
 		MirrorReady:       e.IsMirrorReady(),
+		ShadowComparison:  experiment.CreateShadowReportProto(s.experimentServer.GetShadowReport(e.Name)),
+		Schedule:          experiment.CreateScheduleStateProto(e),
+	}
+	for _, candidate := range e.Candidates {
+		response.Candidates = append(response.Candidates, &pb.ExperimentCandidate{
+			Name:   candidate.Name,
+			Weight: candidate.Weight,
+		})
 	}
 	if e.KubernetesMeta != nil {
 		response.KubernetesMeta = &pb.KubernetesMeta{
@@ -542,6 +613,17 @@ func (s *SchedulerServer) UnloadPipeline(ctx context.Context, req *pb.UnloadPipe
 	return &pb.UnloadPipelineResponse{}, nil
 }
 
+func (s *SchedulerServer) UpdatePipelineCanary(ctx context.Context, req *pb.UpdatePipelineCanaryRequest) (*pb.UpdatePipelineCanaryResponse, error) {
+	err := s.pipelineHandler.UpdatePipelineCanary(req.GetName(), req.GetTrafficPercent())
+	if err != nil {
+		if errors.Is(err, &pipeline.PipelineNotFoundErr{}) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+	}
+	return &pb.UpdatePipelineCanaryResponse{}, nil
+}
+
 func createPipelineStatus(p *pipeline.Pipeline, allVersions bool) *pb.PipelineStatusResponse {
 	var pipelineVersions []*pb.PipelineWithState
 	pipelineLastVersion := p.GetLatestPipelineVersion()
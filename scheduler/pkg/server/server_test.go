@@ -18,12 +18,19 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	pba "github.com/seldonio/seldon-core/apis/go/v2/mlops/agent"
 	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/coordinator"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/federation"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/config"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/gc"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/replay"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/transport"
 	scheduler2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/experiment"
@@ -671,3 +678,355 @@ func (s *stubPipelineStatusServer) Send(r *pb.PipelineStatusResponse) error {
 	s.msgs <- r
 	return nil
 }
+
+func TestKafkaGarbageCollect(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name            string
+		withCollector   bool
+		req             *pb.KafkaGarbageCollectRequest
+		expectedTopics  []*pb.KafkaOrphanedResource
+		remainingTopics []string
+		code            codes.Code
+	}
+
+	gracePeriodZero := uint32(0)
+	tests := []test{
+		{
+			name: "kafka not configured",
+			req:  &pb.KafkaGarbageCollectRequest{},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name:          "dry run",
+			withCollector: true,
+			req:           &pb.KafkaGarbageCollectRequest{DryRun: true, GracePeriodSeconds: &gracePeriodZero},
+			expectedTopics: []*pb.KafkaOrphanedResource{
+				{Name: "seldon.default.model.foo.inputs", Owner: "model foo"},
+			},
+			remainingTopics: []string{"seldon.default.model.foo.inputs"},
+		},
+		{
+			name:          "within grace period",
+			withCollector: true,
+			req:           &pb.KafkaGarbageCollectRequest{},
+			expectedTopics: []*pb.KafkaOrphanedResource{
+				{Name: "seldon.default.model.foo.inputs", Owner: "model foo"},
+			},
+			remainingTopics: []string{"seldon.default.model.foo.inputs"},
+		},
+		{
+			name:          "grace period override deletes",
+			withCollector: true,
+			req:           &pb.KafkaGarbageCollectRequest{GracePeriodSeconds: &gracePeriodZero},
+			expectedTopics: []*pb.KafkaOrphanedResource{
+				{Name: "seldon.default.model.foo.inputs", Owner: "model foo", Deleted: true},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &SchedulerServer{
+				logger:     log.New(),
+				modelStore: store.NewMemoryStore(log.New(), store.NewLocalSchedulerStore(), nil),
+			}
+			streamTransport := transport.NewInProcessTransport(transport.DefaultInProcessMaxMessagesPerTopic)
+			admin, err := streamTransport.NewAdmin()
+			g.Expect(err).To(BeNil())
+			g.Expect(admin.CreateTopics(context.Background(), []transport.TopicSpec{{Name: "seldon.default.model.foo.inputs"}})).To(BeNil())
+			if test.withCollector {
+				topicNamer, err := kafka.NewTopicNamer("default", "seldon")
+				g.Expect(err).To(BeNil())
+				s.SetKafkaGarbageCollector(gc.NewKafkaGarbageCollector(
+					log.New(), admin, topicNamer, s.modelStore, pipeline.NewPipelineStore(log.New(), nil, nil), "default", "", gc.DefaultGracePeriod))
+			}
+
+			res, err := s.KafkaGarbageCollect(context.Background(), test.req)
+			if test.code != codes.OK {
+				e, ok := status.FromError(err)
+				g.Expect(ok).To(BeTrue())
+				g.Expect(e.Code()).To(Equal(test.code))
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(res.Topics).To(HaveLen(len(test.expectedTopics)))
+			for i, topic := range res.Topics {
+				g.Expect(topic.Name).To(Equal(test.expectedTopics[i].Name))
+				g.Expect(topic.Owner).To(Equal(test.expectedTopics[i].Owner))
+				g.Expect(topic.Deleted).To(Equal(test.expectedTopics[i].Deleted))
+			}
+			topics, err := admin.ListTopics(context.Background())
+			g.Expect(err).To(BeNil())
+			g.Expect(topics).To(ConsistOf(test.remainingTopics))
+		})
+	}
+}
+
+func TestReplayPipeline(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name         string
+		withReplayer bool
+		req          *pb.ReplayPipelineRequest
+		code         codes.Code
+	}
+
+	tests := []test{
+		{
+			name: "kafka not configured",
+			req:  &pb.ReplayPipelineRequest{Name: "p1"},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name:         "pipeline not found",
+			withReplayer: true,
+			req:          &pb.ReplayPipelineRequest{Name: "p1"},
+			code:         codes.NotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &SchedulerServer{
+				logger:          log.New(),
+				pipelineHandler: pipeline.NewPipelineStore(log.New(), nil, nil),
+			}
+			if test.withReplayer {
+				topicNamer, err := kafka.NewTopicNamer("default", "seldon")
+				g.Expect(err).To(BeNil())
+				replayer, err := replay.NewPipelineReplayer(log.New(), &config.KafkaConfig{}, nil, topicNamer)
+				g.Expect(err).To(BeNil())
+				s.SetPipelineReplayer(replayer)
+			}
+			_, err := s.ReplayPipeline(context.Background(), test.req)
+			e, ok := status.FromError(err)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(e.Code()).To(Equal(test.code))
+			if test.withReplayer {
+				_, err := s.PipelineReplayStatus(context.Background(), &pb.PipelineReplayStatusRequest{ReplayId: "unknown"})
+				e, ok := status.FromError(err)
+				g.Expect(ok).To(BeTrue())
+				g.Expect(e.Code()).To(Equal(codes.NotFound))
+			}
+		})
+	}
+}
+
+func TestExperimentFeedback(t *testing.T) {
+	g := NewGomegaWithT(t)
+	type test struct {
+		name         string
+		req          *pb.ExperimentFeedbackRequest
+		expectedCode codes.Code
+	}
+
+	tests := []test{
+		{
+			name:         "feedback",
+			req:          &pb.ExperimentFeedbackRequest{ExperimentName: "bandit", Candidate: ":model2_1:", RequestId: "1", Reward: 1},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "experiment not found",
+			req:          &pb.ExperimentFeedbackRequest{ExperimentName: "foo", Candidate: "model1", RequestId: "1", Reward: 1},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "invalid reward",
+			req:          &pb.ExperimentFeedbackRequest{ExperimentName: "bandit", Candidate: "model1", RequestId: "1", Reward: -1},
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			experimentServer := experiment.NewExperimentServer(log.New(), nil, nil, nil)
+			err := experimentServer.StartExperiment(experiment.CreateExperimentFromRequest(&pb.Experiment{
+				Name: "bandit",
+				Candidates: []*pb.ExperimentCandidate{
+					{Name: "model1", Weight: 50},
+					{Name: "model2", Weight: 50},
+				},
+				Config: &pb.ExperimentConfig{Bandit: &pb.BanditConfig{}},
+			}))
+			g.Expect(err).To(BeNil())
+			s := &SchedulerServer{experimentServer: experimentServer}
+			_, err = s.ExperimentFeedback(context.Background(), test.req)
+			g.Expect(status.Code(err)).To(Equal(test.expectedCode))
+		})
+	}
+}
+
+type fakeMetricsQuerier struct {
+	value float64
+}
+
+func (f *fakeMetricsQuerier) Query(_ context.Context, _ string) (float64, error) {
+	return f.value, nil
+}
+
+func TestExperimentStats(t *testing.T) {
+	g := NewGomegaWithT(t)
+	type test struct {
+		name               string
+		req                *pb.ExperimentStatsRequest
+		metricsQuerier     experiment.MetricsQuerier
+		expectedCode       codes.Code
+		expectedCandidates int
+	}
+
+	getUint32Ptr := func(val uint32) *uint32 { return &val }
+	getFloatPtr := func(val float64) *float64 { return &val }
+	tests := []test{
+		{
+			name:               "stats",
+			req:                &pb.ExperimentStatsRequest{ExperimentName: "a", WindowSeconds: getUint32Ptr(600), ConfidenceLevel: getFloatPtr(0.9)},
+			metricsQuerier:     &fakeMetricsQuerier{value: 10},
+			expectedCode:       codes.OK,
+			expectedCandidates: 2,
+		},
+		{
+			name:           "experiment not found",
+			req:            &pb.ExperimentStatsRequest{ExperimentName: "foo"},
+			metricsQuerier: &fakeMetricsQuerier{value: 10},
+			expectedCode:   codes.NotFound,
+		},
+		{
+			name:           "zero window",
+			req:            &pb.ExperimentStatsRequest{ExperimentName: "a", WindowSeconds: getUint32Ptr(0)},
+			metricsQuerier: &fakeMetricsQuerier{value: 10},
+			expectedCode:   codes.InvalidArgument,
+		},
+		{
+			name:         "no Prometheus",
+			req:          &pb.ExperimentStatsRequest{ExperimentName: "a"},
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			experimentServer := experiment.NewExperimentServer(log.New(), nil, nil, nil)
+			experimentServer.SetMetricsQuerier(test.metricsQuerier)
+			err := experimentServer.StartExperiment(experiment.CreateExperimentFromRequest(&pb.Experiment{
+				Name: "a",
+				Candidates: []*pb.ExperimentCandidate{
+					{Name: "model1", Weight: 50},
+					{Name: "model2", Weight: 50},
+				},
+			}))
+			g.Expect(err).To(BeNil())
+			s := &SchedulerServer{experimentServer: experimentServer}
+			resp, err := s.ExperimentStats(context.Background(), test.req)
+			g.Expect(status.Code(err)).To(Equal(test.expectedCode))
+			if test.expectedCode == codes.OK {
+				g.Expect(resp.WindowSeconds).To(Equal(test.req.GetWindowSeconds()))
+				g.Expect(resp.ConfidenceLevel).To(Equal(test.req.GetConfidenceLevel()))
+				g.Expect(resp.Baseline).To(Equal("model1"))
+				g.Expect(resp.Candidates).To(HaveLen(test.expectedCandidates))
+				g.Expect(resp.Comparisons).To(HaveLen(test.expectedCandidates - 1))
+			}
+		})
+	}
+}
+
+func TestFederationSummary(t *testing.T) {
+	g := NewGomegaWithT(t)
+	type test struct {
+		name         string
+		federation   bool
+		token        string
+		expectedCode codes.Code
+	}
+
+	tests := []test{
+		{
+			name:         "summary",
+			federation:   true,
+			token:        "token",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "invalid token",
+			federation:   true,
+			token:        "other",
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "no token",
+			federation:   true,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "no federation",
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schedStore := store.NewMemoryStore(log.New(), store.NewLocalSchedulerStore(), nil)
+			s := &SchedulerServer{modelStore: schedStore, logger: log.New()}
+			if test.federation {
+				fed, err := federation.NewFederation(
+					federation.Config{Region: "eu", InferenceHost: "eu.seldon", InferencePort: 80, Token: "token"},
+					schedStore,
+					nil,
+					log.New(),
+				)
+				g.Expect(err).To(BeNil())
+				s.SetFederation(fed)
+			}
+			ctx := context.Background()
+			if test.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(federation.TokenMetadataKey, test.token))
+			}
+			resp, err := s.FederationSummary(ctx, &pb.FederationSummaryRequest{Region: "us"})
+			g.Expect(status.Code(err)).To(Equal(test.expectedCode))
+			if test.expectedCode == codes.OK {
+				g.Expect(resp.Region).To(Equal("eu"))
+				g.Expect(resp.InferenceHost).To(Equal("eu.seldon"))
+				g.Expect(resp.Models).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestUpdatePipelineCanary(t *testing.T) {
+	g := NewGomegaWithT(t)
+	type test struct {
+		name         string
+		req          *pb.UpdatePipelineCanaryRequest
+		expectedCode codes.Code
+	}
+
+	tests := []test{
+		{
+			name:         "pipeline not found",
+			req:          &pb.UpdatePipelineCanaryRequest{Name: "foo", TrafficPercent: 50},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "no canary",
+			req:          &pb.UpdatePipelineCanaryRequest{Name: "p", TrafficPercent: 50},
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pipelineStore := pipeline.NewPipelineStore(log.New(), nil, nil)
+			err := pipelineStore.AddPipeline(&pb.Pipeline{
+				Name:  "p",
+				Steps: []*pb.PipelineStep{{Name: "step1"}},
+			})
+			g.Expect(err).To(BeNil())
+			s := &SchedulerServer{pipelineHandler: pipelineStore}
+			_, err = s.UpdatePipelineCanary(context.Background(), test.req)
+			g.Expect(status.Code(err)).To(Equal(test.expectedCode))
+		})
+	}
+}
@@ -0,0 +1,494 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package experiment
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/coordinator"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+type BanditPolicy uint32
+
+const (
+	EpsilonGreedyPolicy BanditPolicy = iota
+	ThompsonSamplingPolicy
+)
+
+const (
+	DefaultBanditEpsilon        = 0.1
+	DefaultBanditUpdateInterval = time.Minute
+	banditTotalWeight           = 100
+	thompsonSamplingDraws       = 1000
+	feedbackRequestIdRetention  = time.Hour
+	experimentBanditEventSource = "experiment.store.bandit"
+	rewardQueryTimeout          = 10 * time.Second
+	routeHeaderSeparator        = ":"
+	pipelineRouteSuffix         = ".pipeline"
+)
+
+// Bandit updates the weights of the candidates of an experiment from their rewards. The weights of the experiment
+// are the starting weights.
+type Bandit struct {
+	Policy                BanditPolicy
+	Epsilon               *float32
+	MinWeight             uint32
+	MaxWeight             *uint32
+	UpdateIntervalSeconds *uint32
+	PrometheusReward      *PrometheusReward
+}
+
+// PrometheusReward has the queries returning the sum and number of rewards of a candidate over the update interval
+type PrometheusReward struct {
+	RewardQuery string
+	CountQuery  string
+}
+
+type Reward struct {
+	Sum   float64
+	Count float64
+}
+
+func (r Reward) Mean() float64 {
+	if r.Count == 0 {
+		return 0
+	}
+	return r.Sum / r.Count
+}
+
+// clamp keeps the sum of rewards between 0 and the count, as if each reward was in [0,1], which the Beta posterior
+// of Thompson sampling needs. Without a positive count there are no rewards. Returns whether the reward changed.
+func (r Reward) clamp() (Reward, bool) {
+	if math.IsNaN(r.Count) || math.IsInf(r.Count, 0) || r.Count <= 0 || math.IsNaN(r.Sum) {
+		return Reward{}, r != Reward{}
+	}
+	clamped := Reward{Sum: math.Min(math.Max(r.Sum, 0), r.Count), Count: r.Count}
+	return clamped, clamped != r
+}
+
+func (b *Bandit) GetEpsilon() float64 {
+	if b.Epsilon != nil {
+		return float64(*b.Epsilon)
+	}
+	return DefaultBanditEpsilon
+}
+
+func (b *Bandit) GetMaxWeight() uint32 {
+	if b.MaxWeight != nil {
+		return *b.MaxWeight
+	}
+	return banditTotalWeight
+}
+
+func (b *Bandit) GetUpdateInterval() time.Duration {
+	if b.UpdateIntervalSeconds != nil {
+		return time.Duration(*b.UpdateIntervalSeconds) * time.Second
+	}
+	return DefaultBanditUpdateInterval
+}
+
+// MetricsQuerier returns the result of a PromQL query summed over all returned series
+type MetricsQuerier interface {
+	Query(ctx context.Context, query string) (float64, error)
+}
+
+type rewardQueryParams struct {
+	Candidate string
+	Interval  string
+}
+
+func renderRewardQuery(query string, candidate string, interval time.Duration) (string, error) {
+	tmpl, err := template.New("reward").Option("missingkey=error").Parse(query)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, rewardQueryParams{
+		Candidate: candidate,
+		Interval:  fmt.Sprintf("%ds", int64(interval.Seconds())),
+	})
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+type banditState struct {
+	stop       chan struct{}
+	requestIds map[string]time.Time // requests with feedback, to count each request once
+}
+
+// computeBanditWeights returns the weights of the candidates from their rewards, or nil if there are no rewards yet
+func computeBanditWeights(bandit *Bandit, candidates []*Candidate) []uint32 {
+	rewards := make([]Reward, len(candidates))
+	hasRewards := false
+	for idx, candidate := range candidates {
+		rewards[idx] = candidate.Reward
+		if candidate.Reward.Count > 0 {
+			hasRewards = true
+		}
+	}
+	if !hasRewards {
+		return nil
+	}
+	var shares []float64
+	switch bandit.Policy {
+	case ThompsonSamplingPolicy:
+		shares = thompsonSamplingShares(rewards)
+	default:
+		shares = epsilonGreedyShares(rewards, bandit.GetEpsilon())
+	}
+	return limitWeights(shares, bandit.MinWeight, bandit.GetMaxWeight())
+}
+
+// epsilonGreedyShares sends epsilon of the traffic evenly to all candidates and the rest to the candidate with the
+// best mean reward
+func epsilonGreedyShares(rewards []Reward, epsilon float64) []float64 {
+	best := -1
+	for idx, reward := range rewards {
+		if reward.Count > 0 && (best == -1 || reward.Mean() > rewards[best].Mean()) {
+			best = idx
+		}
+	}
+	shares := make([]float64, len(rewards))
+	for idx := range shares {
+		shares[idx] = epsilon / float64(len(rewards))
+	}
+	shares[best] += 1 - epsilon
+	return shares
+}
+
+// thompsonSamplingShares sends traffic to each candidate in proportion to the probability of it having the best
+// reward, with a Beta(1+sum, 1+count-sum) posterior for the reward of each candidate
+func thompsonSamplingShares(rewards []Reward) []float64 {
+	wins := make([]float64, len(rewards))
+	for draw := 0; draw < thompsonSamplingDraws; draw++ {
+		best := 0
+		bestSample := -1.0
+		for idx, reward := range rewards {
+			sample := sampleBeta(1+reward.Sum, 1+math.Max(0, reward.Count-reward.Sum))
+			if sample > bestSample {
+				best = idx
+				bestSample = sample
+			}
+		}
+		wins[best]++
+	}
+	for idx := range wins {
+		wins[idx] /= thompsonSamplingDraws
+	}
+	return wins
+}
+
+func sampleBeta(alpha float64, beta float64) float64 {
+	x := sampleGamma(alpha)
+	y := sampleGamma(beta)
+	return x / (x + y)
+}
+
+// sampleGamma draws from Gamma(shape, 1) for shape >= 1 with the method of Marsaglia and Tsang
+func sampleGamma(shape float64) float64 {
+	d := shape - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		x := rand.NormFloat64()
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+		v = v * v * v
+		if math.Log(rand.Float64()) < 0.5*x*x+d-d*v+d*math.Log(v) {
+			return d * v
+		}
+	}
+}
+
+// limitWeights turns the shares into weights adding up to the total weight, each within the min and max weight.
+// The same amount is added to or taken from each weight until the limited weights add up to the total.
+func limitWeights(shares []float64, minWeight uint32, maxWeight uint32) []uint32 {
+	limitedWeights := func(offset float64) ([]float64, float64) {
+		weights := make([]float64, len(shares))
+		total := 0.0
+		for idx, share := range shares {
+			weights[idx] = math.Min(math.Max(share*banditTotalWeight+offset, float64(minWeight)), float64(maxWeight))
+			total += weights[idx]
+		}
+		return weights, total
+	}
+	low, high := -float64(banditTotalWeight), float64(banditTotalWeight)
+	for i := 0; i < 100; i++ {
+		offset := (low + high) / 2
+		if _, total := limitedWeights(offset); total < banditTotalWeight {
+			low = offset
+		} else {
+			high = offset
+		}
+	}
+	weights, _ := limitedWeights(high)
+	return roundWeights(weights, maxWeight)
+}
+
+// roundWeights rounds down the weights and gives what is left of the total weight to the weights with the largest
+// remainders
+func roundWeights(weights []float64, maxWeight uint32) []uint32 {
+	rounded := make([]uint32, len(weights))
+	remaining := banditTotalWeight
+	var order []int
+	for idx, weight := range weights {
+		rounded[idx] = uint32(math.Floor(weight + 1e-9))
+		remaining -= int(rounded[idx])
+		order = append(order, idx)
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return weights[order[i]]-math.Floor(weights[order[i]]) > weights[order[j]]-math.Floor(weights[order[j]])
+	})
+	for remaining > 0 {
+		added := false
+		for _, idx := range order {
+			if remaining > 0 && rounded[idx] < maxWeight {
+				rounded[idx]++
+				remaining--
+				added = true
+			}
+		}
+		if !added {
+			break
+		}
+	}
+	return rounded
+}
+
+// getCandidate finds a candidate by name or by the x-seldon-route header Envoy returns for requests sent to it
+func getCandidate(experiment *Experiment, name string) *Candidate {
+	names := []string{name}
+	if route := strings.Trim(name, routeHeaderSeparator); route != name {
+		names = append(names, route)
+		if unversioned, _, err := util.GetOrignalModelNameAndVersion(route); err == nil {
+			route = unversioned
+			names = append(names, route)
+		}
+		names = append(names, strings.TrimSuffix(route, pipelineRouteSuffix))
+	}
+	for _, candidateName := range names {
+		for _, candidate := range experiment.Candidates {
+			if candidate.Name == candidateName {
+				return candidate
+			}
+		}
+	}
+	return nil
+}
+
+func (es *ExperimentStore) SetMetricsQuerier(metricsQuerier MetricsQuerier) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	es.metricsQuerier = metricsQuerier
+}
+
+// AddFeedback adds the reward of a request sent to a candidate of a bandit experiment. Feedback for a request which
+// already has feedback is ignored.
+func (es *ExperimentStore) AddFeedback(experimentName string, candidateName string, requestId string, reward float64) error {
+	if math.IsNaN(reward) || reward < 0 || reward > 1 {
+		return &ExperimentFeedbackInvalid{experimentName: experimentName, reason: fmt.Sprintf("reward %v is not in [0,1]", reward)}
+	}
+	if requestId == "" {
+		return &ExperimentFeedbackInvalid{experimentName: experimentName, reason: "request id is missing"}
+	}
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	experiment, ok := es.experiments[experimentName]
+	if !ok || experiment.Deleted {
+		return &ExperimentNotFound{experimentName: experimentName}
+	}
+	state, ok := es.bandits[experimentName]
+	if !ok {
+		return &ExperimentNotBandit{experimentName: experimentName}
+	}
+	candidate := getCandidate(experiment, candidateName)
+	if candidate == nil {
+		return &ExperimentFeedbackInvalid{experimentName: experimentName, reason: fmt.Sprintf("candidate %s not found", candidateName)}
+	}
+	if _, ok := state.requestIds[requestId]; ok {
+		es.logger.Debugf("Ignoring repeated feedback for request %s of experiment %s", requestId, experimentName)
+		return nil
+	}
+	state.requestIds[requestId] = time.Now()
+	candidate.Reward.Sum += reward
+	candidate.Reward.Count++
+	return nil
+}
+
+// startBandit stops the bandit of the previous version of the experiment and starts a new one if needed. Rewards of
+// candidates kept by the new version are kept, as are the weights if the experiment was already a bandit.
+func (es *ExperimentStore) startBandit(experiment *Experiment, previous *Experiment) {
+	es.stopBandit(experiment.Name)
+	bandit := experiment.GetBandit()
+	if bandit == nil {
+		return
+	}
+	if previous != nil && !previous.Deleted {
+		for _, candidate := range experiment.Candidates {
+			if previousCandidate := getCandidate(previous, candidate.Name); previousCandidate != nil {
+				candidate.Reward = previousCandidate.Reward
+				if previous.GetBandit() != nil {
+					candidate.Weight = previousCandidate.Weight
+				}
+			}
+		}
+	}
+	state := &banditState{
+		stop:       make(chan struct{}),
+		requestIds: make(map[string]time.Time),
+	}
+	es.bandits[experiment.Name] = state
+	go es.runBandit(experiment.Name, bandit.GetUpdateInterval(), state.stop)
+}
+
+func (es *ExperimentStore) stopBandit(experimentName string) {
+	if state, ok := es.bandits[experimentName]; ok {
+		close(state.stop)
+		delete(es.bandits, experimentName)
+	}
+}
+
+func (es *ExperimentStore) runBandit(experimentName string, interval time.Duration, stop chan struct{}) {
+	logger := es.logger.WithField("func", "runBandit")
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := es.updateBanditWeights(experimentName); err != nil {
+				logger.WithError(err).Warnf("Failed to update weights of experiment %s", experimentName)
+			}
+		}
+	}
+}
+
+func (es *ExperimentStore) updateBanditWeights(experimentName string) error {
+	rewards, queryErr := es.queryRewards(experimentName)
+	evt, err := es.updateBanditWeightsImpl(experimentName, rewards)
+	if err != nil {
+		return err
+	}
+	if es.eventHub != nil && evt != nil {
+		es.eventHub.PublishExperimentEvent(experimentBanditEventSource, *evt)
+	}
+	return queryErr
+}
+
+// queryRewards gets the rewards of each candidate over the last interval from Prometheus if the bandit has queries
+func (es *ExperimentStore) queryRewards(experimentName string) (map[string]Reward, error) {
+	es.mu.RLock()
+	experiment, ok := es.experiments[experimentName]
+	if !ok || experiment.GetBandit() == nil || experiment.GetBandit().PrometheusReward == nil {
+		es.mu.RUnlock()
+		return nil, nil
+	}
+	bandit := experiment.GetBandit()
+	var candidateNames []string
+	for _, candidate := range experiment.Candidates {
+		candidateNames = append(candidateNames, candidate.Name)
+	}
+	metricsQuerier := es.metricsQuerier
+	es.mu.RUnlock()
+
+	logger := es.logger.WithField("func", "queryRewards")
+	if metricsQuerier == nil {
+		return nil, fmt.Errorf("no Prometheus to query rewards of experiment %s", experimentName)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), rewardQueryTimeout)
+	defer cancel()
+	rewards := make(map[string]Reward)
+	for _, candidateName := range candidateNames {
+		var reward Reward
+		for _, q := range []struct {
+			query string
+			value *float64
+		}{
+			{bandit.PrometheusReward.RewardQuery, &reward.Sum},
+			{bandit.PrometheusReward.CountQuery, &reward.Count},
+		} {
+			query, err := renderRewardQuery(q.query, candidateName, bandit.GetUpdateInterval())
+			if err != nil {
+				return nil, err
+			}
+			value, err := metricsQuerier.Query(ctx, query)
+			if err != nil {
+				return nil, err
+			}
+			*q.value = value
+		}
+		clamped, changed := reward.clamp()
+		if changed {
+			logger.Warnf("Reward sum %v over %v rewards of experiment %s candidate %s is outside [0,count], using sum %v over %v rewards. Rewards must be in [0,1]",
+				reward.Sum, reward.Count, experimentName, candidateName, clamped.Sum, clamped.Count)
+		}
+		rewards[candidateName] = clamped
+	}
+	return rewards, nil
+}
+
+func (es *ExperimentStore) updateBanditWeightsImpl(experimentName string, rewards map[string]Reward) (*coordinator.ExperimentEventMsg, error) {
+	logger := es.logger.WithField("func", "updateBanditWeights")
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	experiment, ok := es.experiments[experimentName]
+	if !ok {
+		return nil, &ExperimentNotFound{experimentName: experimentName}
+	}
+	state, ok := es.bandits[experimentName]
+	if !ok || experiment.Deleted {
+		return nil, nil
+	}
+	for requestId, received := range state.requestIds {
+		if time.Since(received) > feedbackRequestIdRetention {
+			delete(state.requestIds, requestId)
+		}
+	}
+	for _, candidate := range experiment.Candidates {
+		if reward, ok := rewards[candidate.Name]; ok && reward.Count > 0 {
+			candidate.Reward.Sum += reward.Sum
+			candidate.Reward.Count += reward.Count
+		}
+	}
+	// Traffic is only sent to candidates of active experiments
+	if !experiment.Active {
+		return nil, nil
+	}
+	weights := computeBanditWeights(experiment.GetBandit(), experiment.Candidates)
+	if weights == nil {
+		return nil, nil
+	}
+	updated := false
+	for idx, candidate := range experiment.Candidates {
+		if candidate.Weight != weights[idx] {
+			logger.Infof("Experiment %s candidate %s weight %d -> %d (mean reward %.3f over %.0f rewards)",
+				experimentName, candidate.Name, candidate.Weight, weights[idx], candidate.Reward.Mean(), candidate.Reward.Count)
+			candidate.Weight = weights[idx]
+			updated = true
+		}
+	}
+	if !updated {
+		return nil, nil
+	}
+	return es.createExperimentEventMsg(experiment, true), nil
+}
@@ -0,0 +1,440 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package experiment
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+func TestLimitWeights(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name      string
+		shares    []float64
+		minWeight uint32
+		maxWeight uint32
+		expected  []uint32
+	}
+
+	tests := []test{
+		{
+			name:      "no limits",
+			shares:    []float64{0.9, 0.1},
+			maxWeight: 100,
+			expected:  []uint32{90, 10},
+		},
+		{
+			name:      "min weight",
+			shares:    []float64{1, 0},
+			minWeight: 10,
+			maxWeight: 100,
+			expected:  []uint32{90, 10},
+		},
+		{
+			name:      "min and max weight",
+			shares:    []float64{0.02, 0.98, 0},
+			minWeight: 10,
+			maxWeight: 50,
+			expected:  []uint32{26, 50, 24},
+		},
+		{
+			name:      "rounding",
+			shares:    []float64{1.0 / 3, 1.0 / 3, 1.0 / 3},
+			maxWeight: 100,
+			expected:  []uint32{34, 33, 33},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			weights := limitWeights(test.shares, test.minWeight, test.maxWeight)
+			g.Expect(weights).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestComputeBanditWeights(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name       string
+		bandit     *Bandit
+		rewards    []Reward
+		expected   []uint32
+		expectedGE []uint32 // lower bounds of the weights for policies drawing samples
+	}
+
+	getFloat32Ptr := func(val float32) *float32 { return &val }
+	tests := []test{
+		{
+			name:    "no rewards",
+			bandit:  &Bandit{},
+			rewards: []Reward{{}, {}},
+		},
+		{
+			name:     "epsilon greedy",
+			bandit:   &Bandit{Epsilon: getFloat32Ptr(0.2)},
+			rewards:  []Reward{{Sum: 10, Count: 100}, {Sum: 50, Count: 100}},
+			expected: []uint32{10, 90},
+		},
+		{
+			name:     "epsilon greedy unexplored candidate",
+			bandit:   &Bandit{Epsilon: getFloat32Ptr(0.2), MinWeight: 20},
+			rewards:  []Reward{{Sum: 1, Count: 10}, {}, {}},
+			expected: []uint32{60, 20, 20},
+		},
+		{
+			name:       "thompson sampling",
+			bandit:     &Bandit{Policy: ThompsonSamplingPolicy},
+			rewards:    []Reward{{Sum: 10, Count: 100}, {Sum: 50, Count: 100}},
+			expectedGE: []uint32{0, 95},
+		},
+		{
+			name:       "thompson sampling with min weight",
+			bandit:     &Bandit{Policy: ThompsonSamplingPolicy, MinWeight: 15},
+			rewards:    []Reward{{Sum: 10, Count: 100}, {Sum: 50, Count: 100}},
+			expectedGE: []uint32{15, 80},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var candidates []*Candidate
+			for _, reward := range test.rewards {
+				candidates = append(candidates, &Candidate{Reward: reward})
+			}
+			weights := computeBanditWeights(test.bandit, candidates)
+			switch {
+			case test.expectedGE != nil:
+				total := uint32(0)
+				for idx, weight := range weights {
+					g.Expect(weight).To(BeNumerically(">=", test.expectedGE[idx]))
+					total += weight
+				}
+				g.Expect(total).To(Equal(uint32(banditTotalWeight)))
+			default:
+				g.Expect(weights).To(Equal(test.expected))
+			}
+		})
+	}
+}
+
+func TestGetCandidate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	experiment := &Experiment{
+		Candidates: []*Candidate{
+			{Name: "iris_v1"},
+			{Name: "iris2"},
+			{Name: "pipeline1"},
+		},
+	}
+
+	type test struct {
+		name     string
+		expected string
+	}
+
+	tests := []test{
+		{name: "iris2", expected: "iris2"},
+		{name: "iris_v1", expected: "iris_v1"},
+		{name: ":iris_v1_3:", expected: "iris_v1"},
+		{name: ":iris2_1:", expected: "iris2"},
+		{name: ":pipeline1.pipeline:", expected: "pipeline1"},
+		{name: ":pipeline1.pipeline_2:", expected: "pipeline1"},
+		{name: "iris3"},
+		{name: ":iris3_1:"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			candidate := getCandidate(experiment, test.name)
+			if test.expected == "" {
+				g.Expect(candidate).To(BeNil())
+			} else {
+				g.Expect(candidate.Name).To(Equal(test.expected))
+			}
+		})
+	}
+}
+
+func createTestBanditStore(experiments ...*Experiment) *ExperimentStore {
+	es := NewExperimentServer(logrus.New(), nil, nil, nil)
+	for _, experiment := range experiments {
+		es.experiments[experiment.Name] = experiment
+		if experiment.GetBandit() != nil {
+			es.bandits[experiment.Name] = &banditState{stop: make(chan struct{}), requestIds: map[string]time.Time{}}
+		}
+	}
+	return es
+}
+
+func TestAddFeedback(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type feedback struct {
+		experiment string
+		candidate  string
+		requestId  string
+		reward     float64
+		err        error
+	}
+
+	type test struct {
+		name            string
+		feedback        []feedback
+		expectedRewards map[string]Reward
+	}
+
+	tests := []test{
+		{
+			name: "rewards",
+			feedback: []feedback{
+				{experiment: "bandit", candidate: "model1", requestId: "1", reward: 1},
+				{experiment: "bandit", candidate: ":model2_1:", requestId: "2", reward: 0.5},
+				{experiment: "bandit", candidate: "model2", requestId: "3", reward: 0},
+			},
+			expectedRewards: map[string]Reward{"model1": {Sum: 1, Count: 1}, "model2": {Sum: 0.5, Count: 2}},
+		},
+		{
+			name: "repeated request",
+			feedback: []feedback{
+				{experiment: "bandit", candidate: "model1", requestId: "1", reward: 1},
+				{experiment: "bandit", candidate: "model1", requestId: "1", reward: 1},
+			},
+			expectedRewards: map[string]Reward{"model1": {Sum: 1, Count: 1}, "model2": {}},
+		},
+		{
+			name: "invalid feedback",
+			feedback: []feedback{
+				{experiment: "bandit", candidate: "model1", requestId: "1", reward: 2, err: &ExperimentFeedbackInvalid{}},
+				{experiment: "bandit", candidate: "model1", reward: 1, err: &ExperimentFeedbackInvalid{}},
+				{experiment: "bandit", candidate: "model3", requestId: "1", reward: 1, err: &ExperimentFeedbackInvalid{}},
+				{experiment: "static", candidate: "model1", requestId: "1", reward: 1, err: &ExperimentNotBandit{}},
+				{experiment: "unknown", candidate: "model1", requestId: "1", reward: 1, err: &ExperimentNotFound{}},
+			},
+			expectedRewards: map[string]Reward{"model1": {}, "model2": {}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			es := createTestBanditStore(
+				&Experiment{
+					Name:       "bandit",
+					Candidates: []*Candidate{{Name: "model1"}, {Name: "model2"}},
+					Config:     &Config{Bandit: &Bandit{}},
+				},
+				&Experiment{
+					Name:       "static",
+					Candidates: []*Candidate{{Name: "model1"}, {Name: "model2"}},
+				},
+			)
+			for _, f := range test.feedback {
+				err := es.AddFeedback(f.experiment, f.candidate, f.requestId, f.reward)
+				if f.err != nil {
+					g.Expect(errors.Is(err, f.err)).To(BeTrue())
+				} else {
+					g.Expect(err).To(BeNil())
+				}
+			}
+			for _, candidate := range es.experiments["bandit"].Candidates {
+				g.Expect(candidate.Reward).To(Equal(test.expectedRewards[candidate.Name]))
+			}
+		})
+	}
+}
+
+type fakeMetricsQuerier struct {
+	values map[string]float64
+}
+
+func (f *fakeMetricsQuerier) Query(_ context.Context, query string) (float64, error) {
+	for substring, value := range f.values {
+		if strings.Contains(query, substring) {
+			return value, nil
+		}
+	}
+	return 0, nil
+}
+
+func TestUpdateBanditWeights(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name            string
+		experiment      *Experiment
+		metricsQuerier  MetricsQuerier
+		expectedEvent   bool
+		expectedWeights []uint32
+		expectedRewards []Reward
+	}
+
+	getFloat32Ptr := func(val float32) *float32 { return &val }
+	tests := []test{
+		{
+			name: "feedback",
+			experiment: &Experiment{
+				Name:   "a",
+				Active: true,
+				Candidates: []*Candidate{
+					{Name: "model1", Weight: 50, Reward: Reward{Sum: 2, Count: 10}},
+					{Name: "model2", Weight: 50, Reward: Reward{Sum: 8, Count: 10}},
+				},
+				Config: &Config{Bandit: &Bandit{Epsilon: getFloat32Ptr(0.1)}},
+			},
+			expectedEvent:   true,
+			expectedWeights: []uint32{5, 95},
+			expectedRewards: []Reward{{Sum: 2, Count: 10}, {Sum: 8, Count: 10}},
+		},
+		{
+			name: "prometheus",
+			experiment: &Experiment{
+				Name:   "a",
+				Active: true,
+				Candidates: []*Candidate{
+					{Name: "model1", Weight: 50},
+					{Name: "model2", Weight: 50},
+				},
+				Config: &Config{Bandit: &Bandit{
+					Epsilon: getFloat32Ptr(0.1),
+					PrometheusReward: &PrometheusReward{
+						RewardQuery: `sum(increase(reward_sum{model="{{.Candidate}}"}[{{.Interval}}]))`,
+						CountQuery:  `sum(increase(reward_count{model="{{.Candidate}}"}[{{.Interval}}]))`,
+					},
+				}},
+			},
+			metricsQuerier: &fakeMetricsQuerier{values: map[string]float64{
+				`reward_sum{model="model1"}[60s]`:   9,
+				`reward_count{model="model1"}[60s]`: 10,
+				`reward_sum{model="model2"}[60s]`:   1,
+				`reward_count{model="model2"}[60s]`: 10,
+			}},
+			expectedEvent:   true,
+			expectedWeights: []uint32{95, 5},
+			expectedRewards: []Reward{{Sum: 9, Count: 10}, {Sum: 1, Count: 10}},
+		},
+		{
+			name: "prometheus rewards outside [0,1] are clamped",
+			experiment: &Experiment{
+				Name:   "a",
+				Active: true,
+				Candidates: []*Candidate{
+					{Name: "model1", Weight: 50},
+					{Name: "model2", Weight: 50},
+					{Name: "model3", Weight: 0},
+				},
+				Config: &Config{Bandit: &Bandit{
+					Epsilon: getFloat32Ptr(0.3),
+					PrometheusReward: &PrometheusReward{
+						RewardQuery: `sum(increase(reward_sum{model="{{.Candidate}}"}[{{.Interval}}]))`,
+						CountQuery:  `sum(increase(reward_count{model="{{.Candidate}}"}[{{.Interval}}]))`,
+					},
+				}},
+			},
+			metricsQuerier: &fakeMetricsQuerier{values: map[string]float64{
+				`reward_sum{model="model1"}[60s]`:   250,
+				`reward_count{model="model1"}[60s]`: 100,
+				`reward_sum{model="model2"}[60s]`:   -5,
+				`reward_count{model="model2"}[60s]`: 100,
+				`reward_sum{model="model3"}[60s]`:   3,
+				`reward_count{model="model3"}[60s]`: -1,
+			}},
+			expectedEvent:   true,
+			expectedWeights: []uint32{80, 10, 10},
+			expectedRewards: []Reward{{Sum: 100, Count: 100}, {Sum: 0, Count: 100}, {}},
+		},
+		{
+			name: "inactive experiment",
+			experiment: &Experiment{
+				Name: "a",
+				Candidates: []*Candidate{
+					{Name: "model1", Weight: 50, Reward: Reward{Sum: 2, Count: 10}},
+					{Name: "model2", Weight: 50, Reward: Reward{Sum: 8, Count: 10}},
+				},
+				Config: &Config{Bandit: &Bandit{}},
+			},
+			expectedWeights: []uint32{50, 50},
+			expectedRewards: []Reward{{Sum: 2, Count: 10}, {Sum: 8, Count: 10}},
+		},
+		{
+			name: "no rewards",
+			experiment: &Experiment{
+				Name:   "a",
+				Active: true,
+				Candidates: []*Candidate{
+					{Name: "model1", Weight: 70},
+					{Name: "model2", Weight: 30},
+				},
+				Config: &Config{Bandit: &Bandit{}},
+			},
+			expectedWeights: []uint32{70, 30},
+			expectedRewards: []Reward{{}, {}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			es := createTestBanditStore(test.experiment)
+			es.SetMetricsQuerier(test.metricsQuerier)
+			rewards, err := es.queryRewards(test.experiment.Name)
+			g.Expect(err).To(BeNil())
+			evt, err := es.updateBanditWeightsImpl(test.experiment.Name, rewards)
+			g.Expect(err).To(BeNil())
+			g.Expect(evt != nil).To(Equal(test.expectedEvent))
+			if evt != nil {
+				g.Expect(evt.UpdatedExperiment).To(BeTrue())
+			}
+			experiment, err := es.GetExperiment(test.experiment.Name)
+			g.Expect(err).To(BeNil())
+			for idx, candidate := range experiment.Candidates {
+				g.Expect(candidate.Weight).To(Equal(test.expectedWeights[idx]))
+				g.Expect(candidate.Reward).To(Equal(test.expectedRewards[idx]))
+			}
+		})
+	}
+}
+
+func TestStartBanditKeepsRewards(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	es := NewExperimentServer(logrus.New(), nil, nil, nil)
+	createExperiment := func() *Experiment {
+		return &Experiment{
+			Name:       "a",
+			Candidates: []*Candidate{{Name: "model1", Weight: 50}, {Name: "model2", Weight: 50}},
+			Config:     &Config{Bandit: &Bandit{}},
+		}
+	}
+	g.Expect(es.StartExperiment(createExperiment())).To(BeNil())
+	g.Expect(es.AddFeedback("a", "model2", "1", 1)).To(BeNil())
+	es.experiments["a"].Candidates[1].Weight = 90
+	es.experiments["a"].Candidates[0].Weight = 10
+
+	// the operator sending the experiment again keeps the weights and rewards
+	g.Expect(es.StartExperiment(createExperiment())).To(BeNil())
+	experiment, err := es.GetExperiment("a")
+	g.Expect(err).To(BeNil())
+	g.Expect(experiment.Candidates[0].Weight).To(Equal(uint32(10)))
+	g.Expect(experiment.Candidates[1].Weight).To(Equal(uint32(90)))
+	g.Expect(experiment.Candidates[1].Reward).To(Equal(Reward{Sum: 1, Count: 1}))
+
+	g.Expect(es.StopExperiment("a")).To(BeNil())
+	g.Expect(es.bandits).To(BeEmpty())
+	g.Expect(errors.Is(es.AddFeedback("a", "model2", "2", 1), &ExperimentNotFound{})).To(BeTrue())
+}
@@ -12,6 +12,7 @@ package experiment
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
@@ -25,6 +26,8 @@ func TestSaveAndRestore(t *testing.T) {
 		experiments []*Experiment
 	}
 
+	started := time.Now().UTC().Truncate(time.Second).Add(-time.Hour)
+	end := started.Add(48 * time.Hour)
 	tests := []test{
 		{
 			name: "basic model experiment",
@@ -85,6 +88,33 @@ func TestSaveAndRestore(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "scheduled experiment",
+			experiments: []*Experiment{
+				{
+					Name: "test1",
+					Candidates: []*Candidate{
+						{
+							Name:   "model1",
+							Weight: 50,
+						},
+						{
+							Name:   "model2",
+							Weight: 50,
+						},
+					},
+					Config: &Config{
+						Schedule: &Schedule{
+							StartTime: &started,
+							EndTime:   &end,
+						},
+					},
+					ScheduleState: &ScheduleState{
+						StartedAt: &started,
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
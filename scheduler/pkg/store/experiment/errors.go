@@ -68,3 +68,113 @@ func (enc *ExperimentNoDuplicates) Is(tgt error) bool {
 func (enc *ExperimentNoDuplicates) Error() string {
 	return fmt.Sprintf("each candidate and mirror must be unique but found resource %s duplicated in experiment %s", enc.resource, enc.experimentName)
 }
+
+type ExperimentBanditInvalid struct {
+	experimentName string
+	reason         string
+}
+
+func (ebi *ExperimentBanditInvalid) Is(tgt error) bool {
+	_, ok := tgt.(*ExperimentBanditInvalid)
+	return ok
+}
+
+func (ebi *ExperimentBanditInvalid) Error() string {
+	return fmt.Sprintf("invalid bandit for experiment %s: %s", ebi.experimentName, ebi.reason)
+}
+
+type ExperimentNotBandit struct {
+	experimentName string
+}
+
+func (enb *ExperimentNotBandit) Is(tgt error) bool {
+	_, ok := tgt.(*ExperimentNotBandit)
+	return ok
+}
+
+func (enb *ExperimentNotBandit) Error() string {
+	return fmt.Sprintf("experiment %s is not a bandit experiment", enb.experimentName)
+}
+
+type ExperimentFeedbackInvalid struct {
+	experimentName string
+	reason         string
+}
+
+func (efi *ExperimentFeedbackInvalid) Is(tgt error) bool {
+	_, ok := tgt.(*ExperimentFeedbackInvalid)
+	return ok
+}
+
+func (efi *ExperimentFeedbackInvalid) Error() string {
+	return fmt.Sprintf("invalid feedback for experiment %s: %s", efi.experimentName, efi.reason)
+}
+
+type ExperimentRuleInvalid struct {
+	experimentName string
+	reason         string
+}
+
+func (eri *ExperimentRuleInvalid) Is(tgt error) bool {
+	_, ok := tgt.(*ExperimentRuleInvalid)
+	return ok
+}
+
+func (eri *ExperimentRuleInvalid) Error() string {
+	return fmt.Sprintf("invalid rule for experiment %s: %s", eri.experimentName, eri.reason)
+}
+
+type ExperimentShadowComparisonInvalid struct {
+	experimentName string
+	reason         string
+}
+
+func (esi *ExperimentShadowComparisonInvalid) Is(tgt error) bool {
+	_, ok := tgt.(*ExperimentShadowComparisonInvalid)
+	return ok
+}
+
+func (esi *ExperimentShadowComparisonInvalid) Error() string {
+	return fmt.Sprintf("invalid shadow comparison for experiment %s: %s", esi.experimentName, esi.reason)
+}
+
+type ExperimentNoShadowComparison struct {
+	experimentName string
+}
+
+func (ens *ExperimentNoShadowComparison) Is(tgt error) bool {
+	_, ok := tgt.(*ExperimentNoShadowComparison)
+	return ok
+}
+
+func (ens *ExperimentNoShadowComparison) Error() string {
+	return fmt.Sprintf("experiment %s does not compare the responses of its mirror", ens.experimentName)
+}
+
+type ExperimentScheduleInvalid struct {
+	experimentName string
+	reason         string
+}
+
+func (esi *ExperimentScheduleInvalid) Is(tgt error) bool {
+	_, ok := tgt.(*ExperimentScheduleInvalid)
+	return ok
+}
+
+func (esi *ExperimentScheduleInvalid) Error() string {
+	return fmt.Sprintf("invalid schedule for experiment %s: %s", esi.experimentName, esi.reason)
+}
+
+type ExperimentStatsInvalid struct {
+	experimentName string
+	reason         string
+}
+
+func (esi *ExperimentStatsInvalid) Is(tgt error) bool {
+	_, ok := tgt.(*ExperimentStatsInvalid)
+	return ok
+}
+
+func (esi *ExperimentStatsInvalid) Error() string {
+	return fmt.Sprintf("unable to compute statistics of experiment %s: %s", esi.experimentName, esi.reason)
+}
@@ -27,6 +27,7 @@ type Experiment struct {
 	Config            *Config
 	StatusDescription string
 	KubernetesMeta    *KubernetesMeta
+	ScheduleState     *ScheduleState
 }
 
 func (e *Experiment) AreCandidatesReady() bool {
@@ -54,14 +55,71 @@ type Candidate struct {
 	Name   string
 	Weight uint32
 	Ready  bool
+	Reward Reward // rewards collected for bandit experiments, which are not persisted
 }
 
 type Mirror struct {
-	Name    string
-	Percent uint32
-	Ready   bool
+	Name       string
+	Percent    uint32
+	Ready      bool
+	Comparison *ShadowComparison
+	Report     *ShadowReport // results of the comparison, which are not persisted
+}
+
+// ShadowComparison compares the responses of the mirror of a model experiment with those of the default model
+type ShadowComparison struct {
+	Outputs   []string // output tensors compared, all if empty
+	Tolerance float64  // largest absolute difference of an output element counted as a match
 }
 
 type Config struct {
 	StickySessions bool
+	Bandit         *Bandit
+	Rules          []*Rule
+	Schedule       *Schedule
+}
+
+// Rule sends requests whose headers all match to a candidate ahead of the weighted split. Rules are checked in order.
+type Rule struct {
+	Candidate string
+	Headers   []*HeaderMatch
+}
+
+// HeaderMatch matches a request header by exactly one of its exact value, prefix, regex or hash range
+type HeaderMatch struct {
+	Name   string
+	Exact  *string
+	Prefix *string
+	Regex  *string
+	Hash   *HashRange
+}
+
+// hashBuckets is the number of buckets header values are hashed into by Envoy
+const hashBuckets = 100
+
+// HashRange matches header values whose hash falls in buckets [Start, End) out of 100
+type HashRange struct {
+	Start uint32
+	End   uint32
+}
+
+func (e *Experiment) GetBandit() *Bandit {
+	if e.Config != nil {
+		return e.Config.Bandit
+	}
+	return nil
+}
+
+func (e *Experiment) GetShadowComparison() *ShadowComparison {
+	if e.Mirror != nil {
+		return e.Mirror.Comparison
+	}
+	return nil
+}
+
+func (e *Experiment) GetRules() []*Rule {
+	if e.Config != nil {
+		return e.Config.Rules
+	}
+	return nil
 }
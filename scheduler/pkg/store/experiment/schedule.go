@@ -0,0 +1,410 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package experiment
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/coordinator"
+)
+
+const (
+	experimentScheduleEventSource = "experiment.store.schedule"
+	// How long to wait before trying again to start or conclude an experiment after a failure
+	scheduleRetryInterval = time.Minute
+	promotionTotalWeight  = 100
+)
+
+// Schedule starts an experiment at StartTime and concludes it at EndTime or after MaxDurationSeconds, whichever is
+// first. A concluded experiment sends all its traffic to the promoted candidate, or stops routing if there is none.
+type Schedule struct {
+	StartTime          *time.Time
+	EndTime            *time.Time
+	MaxDurationSeconds *uint32
+	Promotion          *Promotion
+}
+
+// Promotion has the query returning the metric of a candidate over the experiment, where {{.Candidate}} and
+// {{.Interval}} are replaced by the candidate name and the time the experiment ran for
+type Promotion struct {
+	Query         string
+	LowerIsBetter bool
+}
+
+// ScheduleState is the progress of the schedule of an experiment, which is persisted so schedules survive restarts
+type ScheduleState struct {
+	StartedAt         *time.Time
+	ConcludedAt       *time.Time
+	PromotedCandidate *string
+}
+
+func (e *Experiment) GetSchedule() *Schedule {
+	if e.Config != nil {
+		return e.Config.Schedule
+	}
+	return nil
+}
+
+// IsPending is true for scheduled experiments which have not started yet
+func (e *Experiment) IsPending() bool {
+	return e.GetSchedule() != nil && (e.ScheduleState == nil || e.ScheduleState.StartedAt == nil)
+}
+
+func (e *Experiment) IsConcluded() bool {
+	return e.ScheduleState != nil && e.ScheduleState.ConcludedAt != nil
+}
+
+// RoutesTraffic is false for experiments which are pending or were concluded without promoting a candidate
+func (e *Experiment) RoutesTraffic() bool {
+	if e.IsPending() {
+		return false
+	}
+	return !e.IsConcluded() || e.ScheduleState.PromotedCandidate != nil
+}
+
+// GetScheduleEnd returns when a scheduled experiment concludes, or nil if it runs until it is stopped
+func (e *Experiment) GetScheduleEnd() *time.Time {
+	schedule := e.GetSchedule()
+	if schedule == nil {
+		return nil
+	}
+	end := schedule.EndTime
+	if schedule.MaxDurationSeconds != nil {
+		start := schedule.StartTime
+		if e.ScheduleState != nil && e.ScheduleState.StartedAt != nil {
+			start = e.ScheduleState.StartedAt
+		}
+		if start != nil {
+			maxEnd := start.Add(time.Duration(*schedule.MaxDurationSeconds) * time.Second)
+			if end == nil || maxEnd.Before(*end) {
+				end = &maxEnd
+			}
+		}
+	}
+	return end
+}
+
+// ScheduleDescription describes the state of scheduled experiments which are not simply running
+func (e *Experiment) ScheduleDescription() string {
+	switch {
+	case e.IsPending():
+		if start := e.GetSchedule().StartTime; start != nil {
+			return fmt.Sprintf("experiment starts at %s", start.UTC().Format(time.RFC3339))
+		}
+		return "experiment has not started"
+	case e.IsConcluded():
+		description := fmt.Sprintf("experiment concluded at %s", e.ScheduleState.ConcludedAt.UTC().Format(time.RFC3339))
+		if e.ScheduleState.PromotedCandidate != nil {
+			description += fmt.Sprintf(", promoted candidate %s", *e.ScheduleState.PromotedCandidate)
+		}
+		return description
+	}
+	return ""
+}
+
+// nextScheduleTransition returns when a scheduled experiment starts or concludes next
+func (e *Experiment) nextScheduleTransition() (time.Time, bool) {
+	switch {
+	case e.GetSchedule() == nil || e.IsConcluded():
+		return time.Time{}, false
+	case e.IsPending():
+		if start := e.GetSchedule().StartTime; start != nil {
+			return *start, true
+		}
+		return time.Time{}, true
+	default:
+		if end := e.GetScheduleEnd(); end != nil {
+			return *end, true
+		}
+		return time.Time{}, false
+	}
+}
+
+func timesEqual(t1 *time.Time, t2 *time.Time) bool {
+	if t1 == nil || t2 == nil {
+		return t1 == t2
+	}
+	return t1.Equal(*t2)
+}
+
+func schedulesEqual(s1 *Schedule, s2 *Schedule) bool {
+	if s1 == nil || s2 == nil {
+		return s1 == s2
+	}
+	if !timesEqual(s1.StartTime, s2.StartTime) || !timesEqual(s1.EndTime, s2.EndTime) {
+		return false
+	}
+	if (s1.MaxDurationSeconds == nil) != (s2.MaxDurationSeconds == nil) ||
+		(s1.MaxDurationSeconds != nil && *s1.MaxDurationSeconds != *s2.MaxDurationSeconds) {
+		return false
+	}
+	if s1.Promotion == nil || s2.Promotion == nil {
+		return s1.Promotion == s2.Promotion
+	}
+	return *s1.Promotion == *s2.Promotion
+}
+
+// startSchedule carries over the progress of the schedule of the previous version of the experiment if the schedule
+// is unchanged, and starts the experiment if it is due. Experiments restored from the DB already have their progress.
+func startSchedule(experiment *Experiment, previous *Experiment, now time.Time) {
+	schedule := experiment.GetSchedule()
+	if schedule == nil {
+		experiment.ScheduleState = nil
+		return
+	}
+	if experiment.ScheduleState == nil && previous != nil && !previous.Deleted &&
+		previous.ScheduleState != nil && schedulesEqual(schedule, previous.GetSchedule()) {
+		state := *previous.ScheduleState
+		experiment.ScheduleState = &state
+	}
+	if experiment.ScheduleState == nil {
+		experiment.ScheduleState = &ScheduleState{}
+	}
+	state := experiment.ScheduleState
+	// The experiment concludes again if its promoted candidate was removed
+	if state.PromotedCandidate != nil && getCandidate(experiment, *state.PromotedCandidate) == nil {
+		state.ConcludedAt = nil
+		state.PromotedCandidate = nil
+	}
+	if state.StartedAt == nil && (schedule.StartTime == nil || !now.Before(*schedule.StartTime)) {
+		state.StartedAt = &now
+	}
+}
+
+// applyPromotion sends all traffic of a concluded experiment to its promoted candidate
+func applyPromotion(experiment *Experiment) {
+	if !experiment.IsConcluded() || experiment.ScheduleState.PromotedCandidate == nil {
+		return
+	}
+	for _, candidate := range experiment.Candidates {
+		if candidate.Name == *experiment.ScheduleState.PromotedCandidate {
+			candidate.Weight = promotionTotalWeight
+		} else {
+			candidate.Weight = 0
+		}
+	}
+	experiment.Mirror = nil
+	experiment.Config.Bandit = nil
+	experiment.Config.Rules = nil
+}
+
+// selectPromotedCandidate returns the candidate with the best metric, the first one if several are best
+func selectPromotedCandidate(candidateNames []string, values map[string]float64, lowerIsBetter bool) *string {
+	var best *string
+	for idx, name := range candidateNames {
+		value, ok := values[name]
+		if !ok || math.IsNaN(value) {
+			continue
+		}
+		if best == nil || (lowerIsBetter && value < values[*best]) || (!lowerIsBetter && value > values[*best]) {
+			best = &candidateNames[idx]
+		}
+	}
+	return best
+}
+
+func (es *ExperimentStore) startScheduleRunner(experiment *Experiment) {
+	es.stopScheduleRunner(experiment.Name)
+	if _, ok := experiment.nextScheduleTransition(); !ok {
+		return
+	}
+	stop := make(chan struct{})
+	es.schedules[experiment.Name] = stop
+	go es.runSchedule(experiment.Name, stop)
+}
+
+func (es *ExperimentStore) stopScheduleRunner(experimentName string) {
+	if stop, ok := es.schedules[experimentName]; ok {
+		close(stop)
+		delete(es.schedules, experimentName)
+	}
+}
+
+func (es *ExperimentStore) runSchedule(experimentName string, stop chan struct{}) {
+	logger := es.logger.WithField("func", "runSchedule")
+	for {
+		es.mu.RLock()
+		experiment, ok := es.experiments[experimentName]
+		var next time.Time
+		if ok && !experiment.Deleted {
+			next, ok = experiment.nextScheduleTransition()
+		}
+		es.mu.RUnlock()
+		if !ok {
+			return
+		}
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-stop:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		if err := es.advanceSchedule(experimentName, time.Now()); err != nil {
+			logger.WithError(err).Warnf("Failed to advance schedule of experiment %s, retrying in %s", experimentName, scheduleRetryInterval)
+			timer := time.NewTimer(scheduleRetryInterval)
+			select {
+			case <-stop:
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+// advanceSchedule starts or concludes a scheduled experiment if it is due
+func (es *ExperimentStore) advanceSchedule(experimentName string, now time.Time) error {
+	logger := es.logger.WithField("func", "advanceSchedule")
+	promoted, err := es.queryPromotion(experimentName, now)
+	if err != nil {
+		logger.WithError(err).Warnf("Failed to select the candidate to promote for experiment %s, concluding without promotion", experimentName)
+	}
+	expEvt, modelEvt, pipelineEvt, err := es.advanceScheduleImpl(experimentName, now, promoted)
+	if err != nil {
+		return err
+	}
+	if es.eventHub != nil {
+		if modelEvt != nil {
+			es.eventHub.PublishModelEvent(experimentScheduleEventSource, *modelEvt)
+		}
+		if pipelineEvt != nil {
+			es.eventHub.PublishPipelineEvent(experimentScheduleEventSource, *pipelineEvt)
+		}
+		if expEvt != nil {
+			es.eventHub.PublishExperimentEvent(experimentScheduleEventSource, *expEvt)
+		}
+	}
+	return nil
+}
+
+// queryPromotion gets the metric of each candidate over the experiment from Prometheus if the experiment is
+// concluding with a promotion, and returns the candidate with the best metric
+func (es *ExperimentStore) queryPromotion(experimentName string, now time.Time) (*string, error) {
+	es.mu.RLock()
+	experiment, ok := es.experiments[experimentName]
+	if !ok || experiment.Deleted || experiment.IsPending() || experiment.IsConcluded() ||
+		experiment.GetSchedule().Promotion == nil {
+		es.mu.RUnlock()
+		return nil, nil
+	}
+	if end := experiment.GetScheduleEnd(); end == nil || now.Before(*end) {
+		es.mu.RUnlock()
+		return nil, nil
+	}
+	promotion := *experiment.GetSchedule().Promotion
+	interval := now.Sub(*experiment.ScheduleState.StartedAt)
+	var candidateNames []string
+	for _, candidate := range experiment.Candidates {
+		candidateNames = append(candidateNames, candidate.Name)
+	}
+	metricsQuerier := es.metricsQuerier
+	es.mu.RUnlock()
+
+	if metricsQuerier == nil {
+		return nil, fmt.Errorf("no Prometheus to query metrics of experiment %s", experimentName)
+	}
+	if interval < time.Second {
+		interval = time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), rewardQueryTimeout)
+	defer cancel()
+	values := make(map[string]float64)
+	for _, candidateName := range candidateNames {
+		query, err := renderRewardQuery(promotion.Query, candidateName, interval)
+		if err != nil {
+			return nil, err
+		}
+		value, err := metricsQuerier.Query(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		values[candidateName] = value
+	}
+	promoted := selectPromotedCandidate(candidateNames, values, promotion.LowerIsBetter)
+	if promoted == nil {
+		return nil, fmt.Errorf("no candidate of experiment %s has a metric", experimentName)
+	}
+	return promoted, nil
+}
+
+func (es *ExperimentStore) advanceScheduleImpl(experimentName string, now time.Time, promoted *string) (*coordinator.ExperimentEventMsg, *coordinator.ModelEventMsg, *coordinator.PipelineEventMsg, error) {
+	logger := es.logger.WithField("func", "advanceSchedule")
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	experiment, ok := es.experiments[experimentName]
+	if !ok {
+		return nil, nil, nil, &ExperimentNotFound{experimentName: experimentName}
+	}
+	next, ok := experiment.nextScheduleTransition()
+	if experiment.Deleted || !ok || now.Before(next) {
+		return nil, nil, nil, nil
+	}
+	state := experiment.ScheduleState
+	if experiment.IsPending() {
+		// Other experiments may have taken the default while this one was pending
+		if err := es.validateNoExistingDefault(experiment); err != nil {
+			return nil, nil, nil, err
+		}
+		logger.Infof("Starting scheduled experiment %s", experimentName)
+		state.StartedAt = &now
+		if es.db != nil {
+			if err := es.db.save(experiment); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+		experiment.StatusDescription = ""
+		es.updateExperimentState(experiment)
+		es.startBandit(experiment, nil)
+		return es.createExperimentEventMsg(experiment, true), nil, nil, nil
+	}
+
+	if promoted != nil && getCandidate(experiment, *promoted) == nil {
+		promoted = nil
+	}
+	logger.Infof("Concluding scheduled experiment %s", experimentName)
+	state.ConcludedAt = &now
+	state.PromotedCandidate = promoted
+	if es.db != nil {
+		if err := es.db.save(experiment); err != nil {
+			state.ConcludedAt = nil
+			state.PromotedCandidate = nil
+			return nil, nil, nil, err
+		}
+	}
+	es.stopBandit(experimentName)
+	es.cleanExperimentState(experiment)
+	if experiment.RoutesTraffic() {
+		applyPromotion(experiment)
+		es.updateExperimentState(experiment)
+	} else {
+		experiment.Active = false
+	}
+	experiment.StatusDescription = experiment.ScheduleDescription()
+	var modelEvt *coordinator.ModelEventMsg
+	var pipelineEvt *coordinator.PipelineEventMsg
+	if experiment.Default != nil {
+		switch experiment.ResourceType {
+		case PipelineResourceType:
+			pipelineEvt = &coordinator.PipelineEventMsg{
+				PipelineName:     *experiment.Default,
+				ExperimentUpdate: true,
+			}
+		case ModelResourceType:
+			modelEvt = &coordinator.ModelEventMsg{
+				ModelName: *experiment.Default,
+			}
+		}
+	}
+	return es.createExperimentEventMsg(experiment, true), modelEvt, pipelineEvt, nil
+}
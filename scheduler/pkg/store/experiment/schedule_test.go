@@ -0,0 +1,414 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package experiment
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+func TestGetScheduleEnd(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	started := start.Add(time.Hour)
+	getTimePtr := func(val time.Time) *time.Time { return &val }
+	getUint32Ptr := func(val uint32) *uint32 { return &val }
+	type test struct {
+		name       string
+		experiment *Experiment
+		expected   *time.Time
+	}
+
+	tests := []test{
+		{
+			name:       "not scheduled",
+			experiment: &Experiment{Name: "a"},
+		},
+		{
+			name:       "no end",
+			experiment: &Experiment{Name: "a", Config: &Config{Schedule: &Schedule{StartTime: &start}}},
+		},
+		{
+			name: "end time",
+			experiment: &Experiment{Name: "a", Config: &Config{Schedule: &Schedule{
+				StartTime: &start,
+				EndTime:   getTimePtr(start.Add(2 * time.Hour)),
+			}}},
+			expected: getTimePtr(start.Add(2 * time.Hour)),
+		},
+		{
+			name: "max duration from start time while pending",
+			experiment: &Experiment{Name: "a", Config: &Config{Schedule: &Schedule{
+				StartTime:          &start,
+				MaxDurationSeconds: getUint32Ptr(60),
+			}}},
+			expected: getTimePtr(start.Add(time.Minute)),
+		},
+		{
+			name: "max duration from when started",
+			experiment: &Experiment{
+				Name: "a",
+				Config: &Config{Schedule: &Schedule{
+					StartTime:          &start,
+					MaxDurationSeconds: getUint32Ptr(60),
+				}},
+				ScheduleState: &ScheduleState{StartedAt: &started},
+			},
+			expected: getTimePtr(started.Add(time.Minute)),
+		},
+		{
+			name: "end time before max duration",
+			experiment: &Experiment{
+				Name: "a",
+				Config: &Config{Schedule: &Schedule{
+					EndTime:            getTimePtr(started.Add(time.Minute)),
+					MaxDurationSeconds: getUint32Ptr(3600),
+				}},
+				ScheduleState: &ScheduleState{StartedAt: &started},
+			},
+			expected: getTimePtr(started.Add(time.Minute)),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			end := test.experiment.GetScheduleEnd()
+			if test.expected == nil {
+				g.Expect(end).To(BeNil())
+			} else {
+				g.Expect(end).ToNot(BeNil())
+				g.Expect(end.Equal(*test.expected)).To(BeTrue())
+			}
+		})
+	}
+}
+
+func TestStartSchedule(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+	later := now.Add(time.Hour)
+	getStrPtr := func(val string) *string { return &val }
+	createExperiment := func(schedule *Schedule, state *ScheduleState) *Experiment {
+		return &Experiment{
+			Name:          "a",
+			Candidates:    []*Candidate{{Name: "model1", Weight: 50}, {Name: "model2", Weight: 50}},
+			Config:        &Config{Schedule: schedule},
+			ScheduleState: state,
+		}
+	}
+	type test struct {
+		name          string
+		experiment    *Experiment
+		previous      *Experiment
+		expected      *ScheduleState
+		expectPending bool
+		expectRoutes  bool
+	}
+
+	tests := []test{
+		{
+			name:         "not scheduled",
+			experiment:   createExperiment(nil, &ScheduleState{StartedAt: &earlier}),
+			expectRoutes: true,
+		},
+		{
+			name:          "start time in the future",
+			experiment:    createExperiment(&Schedule{StartTime: &later}, nil),
+			expected:      &ScheduleState{},
+			expectPending: true,
+		},
+		{
+			name:         "start time passed",
+			experiment:   createExperiment(&Schedule{StartTime: &earlier, EndTime: &later}, nil),
+			expected:     &ScheduleState{StartedAt: &now},
+			expectRoutes: true,
+		},
+		{
+			name:         "progress carried over",
+			experiment:   createExperiment(&Schedule{EndTime: &later}, nil),
+			previous:     createExperiment(&Schedule{EndTime: &later}, &ScheduleState{StartedAt: &earlier}),
+			expected:     &ScheduleState{StartedAt: &earlier},
+			expectRoutes: true,
+		},
+		{
+			name:       "progress of deleted experiment not carried over",
+			experiment: createExperiment(&Schedule{EndTime: &later}, nil),
+			previous: func() *Experiment {
+				e := createExperiment(&Schedule{EndTime: &later}, &ScheduleState{StartedAt: &earlier, ConcludedAt: &earlier})
+				e.Deleted = true
+				return e
+			}(),
+			expected:     &ScheduleState{StartedAt: &now},
+			expectRoutes: true,
+		},
+		{
+			name:         "schedule changed",
+			experiment:   createExperiment(&Schedule{EndTime: &later}, nil),
+			previous:     createExperiment(&Schedule{EndTime: &now}, &ScheduleState{StartedAt: &earlier, ConcludedAt: &now}),
+			expected:     &ScheduleState{StartedAt: &now},
+			expectRoutes: true,
+		},
+		{
+			name:       "concluded without promotion",
+			experiment: createExperiment(&Schedule{EndTime: &now}, nil),
+			previous:   createExperiment(&Schedule{EndTime: &now}, &ScheduleState{StartedAt: &earlier, ConcludedAt: &now}),
+			expected:   &ScheduleState{StartedAt: &earlier, ConcludedAt: &now},
+		},
+		{
+			name:         "concluded with promotion",
+			experiment:   createExperiment(&Schedule{EndTime: &now}, nil),
+			previous:     createExperiment(&Schedule{EndTime: &now}, &ScheduleState{StartedAt: &earlier, ConcludedAt: &now, PromotedCandidate: getStrPtr("model2")}),
+			expected:     &ScheduleState{StartedAt: &earlier, ConcludedAt: &now, PromotedCandidate: getStrPtr("model2")},
+			expectRoutes: true,
+		},
+		{
+			name:         "promoted candidate removed",
+			experiment:   createExperiment(&Schedule{EndTime: &now}, nil),
+			previous:     createExperiment(&Schedule{EndTime: &now}, &ScheduleState{StartedAt: &earlier, ConcludedAt: &now, PromotedCandidate: getStrPtr("model3")}),
+			expected:     &ScheduleState{StartedAt: &earlier},
+			expectRoutes: true,
+		},
+		{
+			name:         "restored from the db",
+			experiment:   createExperiment(&Schedule{EndTime: &later}, &ScheduleState{StartedAt: &earlier}),
+			expected:     &ScheduleState{StartedAt: &earlier},
+			expectRoutes: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			startSchedule(test.experiment, test.previous, now)
+			g.Expect(test.experiment.ScheduleState).To(Equal(test.expected))
+			g.Expect(test.experiment.IsPending()).To(Equal(test.expectPending))
+			g.Expect(test.experiment.RoutesTraffic()).To(Equal(test.expectRoutes))
+		})
+	}
+}
+
+func TestSelectPromotedCandidate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name          string
+		values        map[string]float64
+		lowerIsBetter bool
+		expected      *string
+	}
+
+	getStrPtr := func(val string) *string { return &val }
+	tests := []test{
+		{
+			name:     "highest",
+			values:   map[string]float64{"model1": 0.5, "model2": 0.9, "model3": 0.1},
+			expected: getStrPtr("model2"),
+		},
+		{
+			name:          "lowest",
+			values:        map[string]float64{"model1": 0.5, "model2": 0.9, "model3": 0.1},
+			lowerIsBetter: true,
+			expected:      getStrPtr("model3"),
+		},
+		{
+			name:     "tie goes to first candidate",
+			values:   map[string]float64{"model1": 0.5, "model2": 0.5, "model3": 0.5},
+			expected: getStrPtr("model1"),
+		},
+		{
+			name:     "no data ignored",
+			values:   map[string]float64{"model1": math.NaN(), "model2": 0.1},
+			expected: getStrPtr("model2"),
+		},
+		{
+			name:   "no values",
+			values: map[string]float64{"model1": math.NaN()},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			promoted := selectPromotedCandidate([]string{"model1", "model2", "model3"}, test.values, test.lowerIsBetter)
+			g.Expect(promoted).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestAdvanceSchedule(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name              string
+		promotion         *Promotion
+		metricsQuerier    MetricsQuerier
+		expectedPromoted  *string
+		expectedWeights   []uint32
+		expectedConcluded bool
+	}
+
+	getStrPtr := func(val string) *string { return &val }
+	tests := []test{
+		{
+			name: "promote highest metric",
+			promotion: &Promotion{
+				Query: `sum(increase(reward_sum{model="{{.Candidate}}"}[{{.Interval}}]))`,
+			},
+			metricsQuerier: &fakeMetricsQuerier{values: map[string]float64{
+				`reward_sum{model="model1"}[3600s]`: 1,
+				`reward_sum{model="model2"}[3600s]`: 9,
+			}},
+			expectedPromoted: getStrPtr("model2"),
+			expectedWeights:  []uint32{0, 100},
+		},
+		{
+			name: "promote lowest metric",
+			promotion: &Promotion{
+				Query:         `latency{model="{{.Candidate}}"}`,
+				LowerIsBetter: true,
+			},
+			metricsQuerier: &fakeMetricsQuerier{values: map[string]float64{
+				`latency{model="model1"}`: 0.1,
+				`latency{model="model2"}`: 0.3,
+			}},
+			expectedPromoted: getStrPtr("model1"),
+			expectedWeights:  []uint32{100, 0},
+		},
+		{
+			name: "no Prometheus concludes without promotion",
+			promotion: &Promotion{
+				Query: `latency{model="{{.Candidate}}"}`,
+			},
+			expectedWeights: []uint32{50, 50},
+		},
+		{
+			name:            "no promotion",
+			expectedWeights: []uint32{50, 50},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			now := time.Now().UTC()
+			start := now.Add(time.Hour)
+			end := start.Add(time.Hour)
+			es := NewExperimentServer(logrus.New(), nil, nil, nil)
+			es.SetMetricsQuerier(test.metricsQuerier)
+			err := es.StartExperiment(&Experiment{
+				Name:       "a",
+				Default:    getStrPtr("model1"),
+				Candidates: []*Candidate{{Name: "model1", Weight: 50}, {Name: "model2", Weight: 50}},
+				Mirror:     &Mirror{Name: "model3", Percent: 10},
+				Config: &Config{Schedule: &Schedule{
+					StartTime: &start,
+					EndTime:   &end,
+					Promotion: test.promotion,
+				}},
+			})
+			g.Expect(err).To(BeNil())
+			g.Expect(es.schedules).To(HaveKey("a"))
+
+			// pending
+			experiment, err := es.GetExperiment("a")
+			g.Expect(err).To(BeNil())
+			g.Expect(experiment.IsPending()).To(BeTrue())
+			g.Expect(experiment.StatusDescription).To(ContainSubstring("experiment starts at"))
+			g.Expect(es.GetExperimentForBaselineModel("model1")).To(BeNil())
+			expEvt, _, _, err := es.advanceScheduleImpl("a", now, nil)
+			g.Expect(err).To(BeNil())
+			g.Expect(expEvt).To(BeNil())
+
+			// started
+			expEvt, modelEvt, _, err := es.advanceScheduleImpl("a", start, nil)
+			g.Expect(err).To(BeNil())
+			g.Expect(expEvt).ToNot(BeNil())
+			g.Expect(expEvt.UpdatedExperiment).To(BeTrue())
+			g.Expect(modelEvt).To(BeNil())
+			g.Expect(es.GetExperimentForBaselineModel("model1")).ToNot(BeNil())
+			experiment, err = es.GetExperiment("a")
+			g.Expect(err).To(BeNil())
+			g.Expect(experiment.RoutesTraffic()).To(BeTrue())
+			g.Expect(experiment.ScheduleState.StartedAt.Equal(start)).To(BeTrue())
+
+			// concluded
+			promoted, err := es.queryPromotion("a", end)
+			if test.promotion != nil && test.metricsQuerier == nil {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			g.Expect(promoted).To(Equal(test.expectedPromoted))
+			expEvt, modelEvt, _, err = es.advanceScheduleImpl("a", end, promoted)
+			g.Expect(err).To(BeNil())
+			g.Expect(expEvt).ToNot(BeNil())
+			g.Expect(modelEvt).ToNot(BeNil())
+			g.Expect(modelEvt.ModelName).To(Equal("model1"))
+			experiment, err = es.GetExperiment("a")
+			g.Expect(err).To(BeNil())
+			g.Expect(experiment.IsConcluded()).To(BeTrue())
+			g.Expect(experiment.ScheduleState.PromotedCandidate).To(Equal(test.expectedPromoted))
+			for idx, candidate := range experiment.Candidates {
+				g.Expect(candidate.Weight).To(Equal(test.expectedWeights[idx]))
+			}
+			if test.expectedPromoted != nil {
+				g.Expect(experiment.Active).To(BeFalse()) // set by the processor once routed
+				g.Expect(experiment.Mirror).To(BeNil())
+				g.Expect(experiment.RoutesTraffic()).To(BeTrue())
+				g.Expect(es.GetExperimentForBaselineModel("model1")).ToNot(BeNil())
+				g.Expect(experiment.StatusDescription).To(ContainSubstring("promoted candidate " + *test.expectedPromoted))
+			} else {
+				g.Expect(experiment.RoutesTraffic()).To(BeFalse())
+				g.Expect(es.GetExperimentForBaselineModel("model1")).To(BeNil())
+			}
+
+			// nothing left to do once concluded
+			expEvt, _, _, err = es.advanceScheduleImpl("a", end.Add(time.Hour), nil)
+			g.Expect(err).To(BeNil())
+			g.Expect(expEvt).To(BeNil())
+
+			g.Expect(es.StopExperiment("a")).To(BeNil())
+			g.Expect(es.schedules).To(BeEmpty())
+		})
+	}
+}
+
+func TestAdvanceScheduleDefaultTaken(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	getStrPtr := func(val string) *string { return &val }
+	now := time.Now().UTC()
+	start := now.Add(time.Hour)
+	es := NewExperimentServer(logrus.New(), nil, nil, nil)
+	err := es.StartExperiment(&Experiment{
+		Name:       "a",
+		Default:    getStrPtr("model1"),
+		Candidates: []*Candidate{{Name: "model1", Weight: 50}, {Name: "model2", Weight: 50}},
+		Config:     &Config{Schedule: &Schedule{StartTime: &start}},
+	})
+	g.Expect(err).To(BeNil())
+	err = es.StartExperiment(&Experiment{
+		Name:       "b",
+		Default:    getStrPtr("model1"),
+		Candidates: []*Candidate{{Name: "model1", Weight: 50}, {Name: "model3", Weight: 50}},
+	})
+	g.Expect(err).To(BeNil())
+
+	_, _, _, err = es.advanceScheduleImpl("a", start, nil)
+	g.Expect(err).ToNot(BeNil())
+	experiment, err := es.GetExperiment("a")
+	g.Expect(err).To(BeNil())
+	g.Expect(experiment.IsPending()).To(BeTrue())
+	g.Expect(es.GetExperimentForBaselineModel("model1").Name).To(Equal("b"))
+}
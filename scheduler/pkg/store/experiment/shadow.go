@@ -0,0 +1,189 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package experiment
+
+import (
+	"sort"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+// ShadowResult is the comparison of the responses of the default model and the mirror to one mirrored request.
+// Outputs are only compared when both requests succeeded.
+type ShadowResult struct {
+	PrimaryError   bool
+	MirrorError    bool
+	LatencyDeltaMs float64 // mirror latency minus default model latency
+	Outputs        []ShadowOutputResult
+}
+
+type ShadowOutputResult struct {
+	Name       string
+	MaxAbsDiff float64 // largest absolute difference of a numeric element, 0 if the shapes differ
+	Mismatched bool
+}
+
+// ShadowReport aggregates the results of the shadow comparison of an experiment
+type ShadowReport struct {
+	ComparedRequests   uint64
+	UnmatchedRequests  uint64
+	PrimaryErrors      uint64
+	MirrorErrors       uint64
+	MismatchedRequests uint64
+	LatencyDeltaMsSum  float64
+	Outputs            map[string]*ShadowOutputReport
+}
+
+type ShadowOutputReport struct {
+	Compared   uint64
+	Mismatched uint64
+	MaxAbsDiff float64
+	AbsDiffSum float64 // sum over requests of the largest absolute difference of an element
+}
+
+func newShadowReport() *ShadowReport {
+	return &ShadowReport{Outputs: make(map[string]*ShadowOutputReport)}
+}
+
+func (r *ShadowReport) add(result *ShadowResult) {
+	r.ComparedRequests++
+	r.LatencyDeltaMsSum += result.LatencyDeltaMs
+	if result.PrimaryError {
+		r.PrimaryErrors++
+	}
+	if result.MirrorError {
+		r.MirrorErrors++
+	}
+	mismatched := false
+	for _, output := range result.Outputs {
+		outputReport, ok := r.Outputs[output.Name]
+		if !ok {
+			outputReport = &ShadowOutputReport{}
+			r.Outputs[output.Name] = outputReport
+		}
+		outputReport.Compared++
+		outputReport.AbsDiffSum += output.MaxAbsDiff
+		if output.MaxAbsDiff > outputReport.MaxAbsDiff {
+			outputReport.MaxAbsDiff = output.MaxAbsDiff
+		}
+		if output.Mismatched {
+			outputReport.Mismatched++
+			mismatched = true
+		}
+	}
+	if mismatched {
+		r.MismatchedRequests++
+	}
+}
+
+func (r *ShadowReport) copy() *ShadowReport {
+	report := *r
+	report.Outputs = make(map[string]*ShadowOutputReport, len(r.Outputs))
+	for name, output := range r.Outputs {
+		outputReport := *output
+		report.Outputs[name] = &outputReport
+	}
+	return &report
+}
+
+// getShadowComparisonReport returns the report of an experiment comparing the responses of its mirror
+func (es *ExperimentStore) getShadowComparisonReport(experimentName string) (*ShadowReport, error) {
+	experiment, ok := es.experiments[experimentName]
+	if !ok || experiment.Deleted {
+		return nil, &ExperimentNotFound{experimentName: experimentName}
+	}
+	if experiment.Mirror == nil || experiment.Mirror.Comparison == nil || experiment.Mirror.Report == nil {
+		return nil, &ExperimentNoShadowComparison{experimentName: experimentName}
+	}
+	return experiment.Mirror.Report, nil
+}
+
+// AddShadowResult adds the comparison of the responses to a mirrored request to the report of the experiment
+func (es *ExperimentStore) AddShadowResult(experimentName string, result *ShadowResult) error {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	report, err := es.getShadowComparisonReport(experimentName)
+	if err != nil {
+		return err
+	}
+	report.add(result)
+	return nil
+}
+
+// AddShadowUnmatched counts a mirror response whose default model response was not captured in time
+func (es *ExperimentStore) AddShadowUnmatched(experimentName string) error {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	report, err := es.getShadowComparisonReport(experimentName)
+	if err != nil {
+		return err
+	}
+	report.UnmatchedRequests++
+	return nil
+}
+
+// GetShadowReport returns a copy of the comparison report of the experiment, nil if it does not compare its mirror
+func (es *ExperimentStore) GetShadowReport(experimentName string) *ShadowReport {
+	es.mu.RLock()
+	defer es.mu.RUnlock()
+	report, err := es.getShadowComparisonReport(experimentName)
+	if err != nil {
+		return nil
+	}
+	return report.copy()
+}
+
+// startShadowComparison keeps the report of the previous version of the experiment if it compared the same mirror
+func startShadowComparison(experiment *Experiment, previous *Experiment) {
+	if experiment.Mirror == nil || experiment.Mirror.Comparison == nil {
+		return
+	}
+	if previous != nil && !previous.Deleted && previous.Mirror != nil && previous.Mirror.Report != nil &&
+		previous.Mirror.Name == experiment.Mirror.Name {
+		experiment.Mirror.Report = previous.Mirror.Report
+		return
+	}
+	experiment.Mirror.Report = newShadowReport()
+}
+
+func CreateShadowReportProto(report *ShadowReport) *scheduler.ShadowComparisonReport {
+	if report == nil {
+		return nil
+	}
+	protoReport := &scheduler.ShadowComparisonReport{
+		ComparedRequests:   report.ComparedRequests,
+		UnmatchedRequests:  report.UnmatchedRequests,
+		PrimaryErrors:      report.PrimaryErrors,
+		MirrorErrors:       report.MirrorErrors,
+		MismatchedRequests: report.MismatchedRequests,
+	}
+	if report.ComparedRequests > 0 {
+		protoReport.MeanLatencyDeltaMs = report.LatencyDeltaMsSum / float64(report.ComparedRequests)
+	}
+	names := make([]string, 0, len(report.Outputs))
+	for name := range report.Outputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		output := report.Outputs[name]
+		protoOutput := &scheduler.ShadowOutputReport{
+			Name:       name,
+			Compared:   output.Compared,
+			Mismatched: output.Mismatched,
+			MaxAbsDiff: output.MaxAbsDiff,
+		}
+		if output.Compared > 0 {
+			protoOutput.MeanAbsDiff = output.AbsDiffSum / float64(output.Compared)
+		}
+		protoReport.Outputs = append(protoReport.Outputs, protoOutput)
+	}
+	return protoReport
+}
@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package experiment
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+func TestShadowReport(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	es := NewExperimentServer(logrus.New(), nil, nil, nil)
+	createExperiment := func(mirror string) *Experiment {
+		return &Experiment{
+			Name:       "a",
+			Candidates: []*Candidate{{Name: "model1", Weight: 100}},
+			Mirror:     &Mirror{Name: mirror, Percent: 50, Comparison: &ShadowComparison{Tolerance: 0.1}},
+		}
+	}
+	g.Expect(es.StartExperiment(createExperiment("model2"))).To(BeNil())
+	g.Expect(es.GetShadowReport("a")).To(Equal(newShadowReport()))
+
+	g.Expect(es.AddShadowResult("a", &ShadowResult{
+		LatencyDeltaMs: 4,
+		Outputs:        []ShadowOutputResult{{Name: "y", MaxAbsDiff: 0.5, Mismatched: true}, {Name: "x", MaxAbsDiff: 0.05}},
+	})).To(BeNil())
+	g.Expect(es.AddShadowResult("a", &ShadowResult{
+		LatencyDeltaMs: -2,
+		Outputs:        []ShadowOutputResult{{Name: "y", MaxAbsDiff: 0.1}},
+	})).To(BeNil())
+	g.Expect(es.AddShadowResult("a", &ShadowResult{MirrorError: true, LatencyDeltaMs: 1})).To(BeNil())
+	g.Expect(es.AddShadowUnmatched("a")).To(BeNil())
+
+	report := es.GetShadowReport("a")
+	g.Expect(proto.Equal(CreateShadowReportProto(report), &scheduler.ShadowComparisonReport{
+		ComparedRequests:   3,
+		UnmatchedRequests:  1,
+		MirrorErrors:       1,
+		MismatchedRequests: 1,
+		MeanLatencyDeltaMs: 1,
+		Outputs: []*scheduler.ShadowOutputReport{
+			{Name: "x", Compared: 1, MaxAbsDiff: 0.05, MeanAbsDiff: 0.05},
+			{Name: "y", Compared: 2, Mismatched: 1, MaxAbsDiff: 0.5, MeanAbsDiff: 0.3},
+		},
+	})).To(BeTrue())
+
+	// the report returned is a copy
+	report.Outputs["y"].Compared = 10
+	g.Expect(es.GetShadowReport("a").Outputs["y"].Compared).To(Equal(uint64(2)))
+
+	// the operator sending the experiment again keeps the report of the same mirror
+	g.Expect(es.StartExperiment(createExperiment("model2"))).To(BeNil())
+	g.Expect(es.GetShadowReport("a").ComparedRequests).To(Equal(uint64(3)))
+
+	// a new mirror starts a new report
+	g.Expect(es.StartExperiment(createExperiment("model3"))).To(BeNil())
+	g.Expect(es.GetShadowReport("a").ComparedRequests).To(Equal(uint64(0)))
+
+	g.Expect(es.StopExperiment("a")).To(BeNil())
+	g.Expect(es.GetShadowReport("a")).To(BeNil())
+	g.Expect(errors.Is(es.AddShadowUnmatched("a"), &ExperimentNotFound{})).To(BeTrue())
+	g.Expect(CreateShadowReportProto(nil)).To(BeNil())
+}
+
+func TestShadowReportNotCompared(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	es := NewExperimentServer(logrus.New(), nil, nil, nil)
+	g.Expect(es.StartExperiment(&Experiment{
+		Name:       "a",
+		Candidates: []*Candidate{{Name: "model1", Weight: 100}},
+		Mirror:     &Mirror{Name: "model2", Percent: 50},
+	})).To(BeNil())
+	g.Expect(es.GetShadowReport("a")).To(BeNil())
+	err := es.AddShadowResult("a", &ShadowResult{})
+	g.Expect(errors.Is(err, &ExperimentNoShadowComparison{})).To(BeTrue())
+}
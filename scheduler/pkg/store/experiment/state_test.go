@@ -743,6 +743,14 @@ func (f fakePipelineStore) GetAllRunningPipelineVersions() []coordinator.Pipelin
 	panic("implement me")
 }
 
+func (f fakePipelineStore) UpdatePipelineCanary(name string, trafficPercent uint32) error {
+	panic("implement me")
+}
+
+func (f fakePipelineStore) CheckModelName(modelName string) error {
+	panic("implement me")
+}
+
 func TestSetCandidateAndMirrorPipelineReadiness(t *testing.T) {
 	g := NewGomegaWithT(t)
 
@@ -0,0 +1,413 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package experiment
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
+)
+
+const (
+	DefaultStatsWindow          = time.Hour
+	DefaultStatsConfidenceLevel = 0.95
+	statsQueryTimeout           = 30 * time.Second
+	// Agent and pipeline gateway metrics of a candidate over the window, failed requests are those without a 2xx or
+	// OK code
+	modelRequestsQuery    = `sum(increase(seldon_model_infer_total{model="%s"}[%ds]))`
+	modelErrorsQuery      = `sum(increase(seldon_model_infer_total{model="%s",code!~"2..|OK"}[%ds]))`
+	modelLatencyQuery     = `sum(increase(seldon_model_infer_seconds_total{model="%s"}[%ds]))`
+	modelLatencyBucket    = `sum(increase(seldon_model_infer_latency_seconds_bucket{model="%s",le="%g"}[%ds]))`
+	pipelineRequestsQuery = `sum(increase(seldon_pipeline_infer_total{pipeline="%s"}[%ds]))`
+	pipelineErrorsQuery   = `sum(increase(seldon_pipeline_infer_total{pipeline="%s",code!~"2..|OK"}[%ds]))`
+	pipelineLatencyQuery  = `sum(increase(seldon_pipeline_infer_seconds_total{pipeline="%s"}[%ds]))`
+	pipelineLatencyBucket = `sum(increase(seldon_pipeline_infer_api_seconds_bucket{pipeline="%s",le="%g"}[%ds]))`
+	minStatsWindow        = time.Second
+)
+
+var statsLatencyQuantiles = []float64{0.5, 0.95, 0.99}
+
+// ExperimentStats are the statistics of the candidates of an experiment over a window ending now
+type ExperimentStats struct {
+	ExperimentName  string
+	Window          time.Duration
+	ConfidenceLevel float64
+	Baseline        string
+	Candidates      []*CandidateStats
+	Comparisons     []*CandidateComparison
+}
+
+type Interval struct {
+	Lower float64
+	Upper float64
+}
+
+type LatencyQuantile struct {
+	Quantile float64
+	Seconds  float64
+}
+
+type CandidateStats struct {
+	Name               string
+	Requests           float64
+	Errors             float64
+	ErrorRate          float64
+	ErrorRateInterval  Interval
+	MeanLatencySeconds float64
+	LatencyQuantiles   []LatencyQuantile
+	Reward             Reward
+	MeanRewardInterval *Interval // only if the candidate has rewards
+	// Observations in each latency histogram bucket, the last being those above the largest bucket bound
+	latencyCounts []float64
+}
+
+// CandidateComparison is the difference of a candidate from the baseline with the p-value of a two sided test of
+// there being no difference
+type CandidateComparison struct {
+	Candidate              string
+	ErrorRateDiff          float64
+	ErrorRateDiffInterval  Interval
+	ErrorRatePValue        float64
+	MeanLatencyDiffSeconds float64
+	MeanRewardDiff         *float64 // only if both have rewards
+	MeanRewardDiffInterval *Interval
+	MeanRewardPValue       *float64
+	// Only if both have latency histogram observations
+	MeanLatencyDiffInterval *Interval
+	LatencyPValue           *float64
+}
+
+// zScore returns the standard normal quantile of a two sided interval at the confidence level
+func zScore(confidenceLevel float64) float64 {
+	return math.Sqrt2 * math.Erfinv(confidenceLevel)
+}
+
+// wilsonInterval is the Wilson score interval of a proportion, which unlike the normal approximation stays within
+// [0,1] and behaves for few samples or proportions near 0 or 1
+func wilsonInterval(successes float64, n float64, z float64) Interval {
+	if n <= 0 {
+		return Interval{Lower: 0, Upper: 1}
+	}
+	p := math.Min(math.Max(successes/n, 0), 1)
+	z2 := z * z
+	denominator := 1 + z2/n
+	center := (p + z2/(2*n)) / denominator
+	halfWidth := z * math.Sqrt(p*(1-p)/n+z2/(4*n*n)) / denominator
+	return Interval{
+		Lower: math.Max(center-halfWidth, 0),
+		Upper: math.Min(center+halfWidth, 1),
+	}
+}
+
+// compareProportions returns the difference of the second proportion from the first with its normal approximation
+// interval, and the p-value of the pooled two proportion z-test
+func compareProportions(successes1 float64, n1 float64, successes2 float64, n2 float64, z float64) (float64, Interval, float64) {
+	if n1 <= 0 || n2 <= 0 {
+		return 0, Interval{Lower: -1, Upper: 1}, 1
+	}
+	p1 := math.Min(math.Max(successes1/n1, 0), 1)
+	p2 := math.Min(math.Max(successes2/n2, 0), 1)
+	diff := p2 - p1
+	se := math.Sqrt(p1*(1-p1)/n1 + p2*(1-p2)/n2)
+	interval := Interval{Lower: math.Max(diff-z*se, -1), Upper: math.Min(diff+z*se, 1)}
+	pooled := math.Min(math.Max((successes1+successes2)/(n1+n2), 0), 1)
+	pooledSe := math.Sqrt(pooled * (1 - pooled) * (1/n1 + 1/n2))
+	if pooledSe == 0 {
+		return diff, interval, 1
+	}
+	return diff, interval, math.Erfc(math.Abs(diff/pooledSe) / math.Sqrt2)
+}
+
+// latencyCounts returns the observations in each latency bucket from the cumulative bucket counts, the last being
+// those above the largest bound. Counts from increase over a window can be slightly out of order so are made monotonic
+// as histogram_quantile does.
+func latencyCounts(cumulative []float64) []float64 {
+	counts := make([]float64, len(cumulative))
+	previous := 0.0
+	for idx, count := range cumulative {
+		count = math.Max(count, previous)
+		counts[idx] = count - previous
+		previous = count
+	}
+	return counts
+}
+
+// latencyQuantile interpolates a quantile within its bucket as histogram_quantile does, returning the largest bound
+// for a quantile above it
+func latencyQuantile(quantile float64, counts []float64) float64 {
+	bounds := metrics.DefaultHistogramBuckets
+	total := 0.0
+	for _, count := range counts {
+		total += count
+	}
+	rank := quantile * total
+	lower := 0.0
+	for idx, bound := range bounds {
+		if counts[idx] > 0 && rank <= counts[idx] {
+			return lower + (bound-lower)*rank/counts[idx]
+		}
+		rank -= counts[idx]
+		lower = bound
+	}
+	return bounds[len(bounds)-1]
+}
+
+// latencyVariance is the sample variance of the latencies taking each observation at the middle of its bucket and
+// those above the largest bound at that bound
+func latencyVariance(counts []float64) (float64, float64) {
+	bounds := metrics.DefaultHistogramBuckets
+	var n, sum, sumSquares, lower float64
+	for idx, count := range counts {
+		value := bounds[len(bounds)-1]
+		if idx < len(bounds) {
+			value = (lower + bounds[idx]) / 2
+			lower = bounds[idx]
+		}
+		n += count
+		sum += count * value
+		sumSquares += count * value * value
+	}
+	if n < 2 {
+		return 0, n
+	}
+	return math.Max(sumSquares-sum*sum/n, 0) / (n - 1), n
+}
+
+// compareLatencies returns the normal approximation interval of the difference of the second mean latency from the
+// first, and the p-value of a Mann-Whitney U test of the latencies of the second being no larger or smaller than those
+// of the first. Latencies in the same bucket are counted as ties.
+func compareLatencies(mean1 float64, counts1 []float64, mean2 float64, counts2 []float64, z float64) (*Interval, *float64) {
+	variance1, n1 := latencyVariance(counts1)
+	variance2, n2 := latencyVariance(counts2)
+	if n1 < 2 || n2 < 2 {
+		return nil, nil
+	}
+	diff := mean2 - mean1
+	se := math.Sqrt(variance1/n1 + variance2/n2)
+	interval := &Interval{Lower: diff - z*se, Upper: diff + z*se}
+	// U counts the pairs where the second is slower, with ties counting as half
+	var u, below, tieTerm float64
+	for idx := range counts1 {
+		u += counts2[idx] * (below + counts1[idx]/2)
+		below += counts1[idx]
+		ties := counts1[idx] + counts2[idx]
+		tieTerm += ties*ties*ties - ties
+	}
+	n := n1 + n2
+	sigma := math.Sqrt(math.Max(n1*n2/12*((n+1)-tieTerm/(n*(n-1))), 0))
+	pValue := 1.0
+	if sigma > 0 {
+		pValue = math.Erfc(math.Abs(u-n1*n2/2) / sigma / math.Sqrt2)
+	}
+	return interval, &pValue
+}
+
+// statsWindow is the requested window, else the time a scheduled experiment has run for, else the default window
+func statsWindow(experiment *Experiment, requested *time.Duration, now time.Time) time.Duration {
+	window := DefaultStatsWindow
+	switch {
+	case requested != nil:
+		window = *requested
+	case experiment.ScheduleState != nil && experiment.ScheduleState.StartedAt != nil:
+		window = now.Sub(*experiment.ScheduleState.StartedAt)
+	}
+	if window < minStatsWindow {
+		window = minStatsWindow
+	}
+	return window.Truncate(time.Second)
+}
+
+// computeComparisons compares each candidate with the baseline. Rewards in [0,1] are compared as proportions, which
+// is exact for binary rewards and conservative otherwise as their variance is at most that of a binary reward.
+func computeComparisons(baseline *CandidateStats, candidates []*CandidateStats, z float64) []*CandidateComparison {
+	var comparisons []*CandidateComparison
+	for _, candidate := range candidates {
+		if candidate.Name == baseline.Name {
+			continue
+		}
+		comparison := &CandidateComparison{
+			Candidate:              candidate.Name,
+			MeanLatencyDiffSeconds: candidate.MeanLatencySeconds - baseline.MeanLatencySeconds,
+		}
+		comparison.ErrorRateDiff, comparison.ErrorRateDiffInterval, comparison.ErrorRatePValue =
+			compareProportions(baseline.Errors, baseline.Requests, candidate.Errors, candidate.Requests, z)
+		if baseline.latencyCounts != nil && candidate.latencyCounts != nil {
+			comparison.MeanLatencyDiffInterval, comparison.LatencyPValue = compareLatencies(
+				baseline.MeanLatencySeconds, baseline.latencyCounts, candidate.MeanLatencySeconds, candidate.latencyCounts, z)
+		}
+		if baseline.Reward.Count > 0 && candidate.Reward.Count > 0 {
+			diff, interval, pValue := compareProportions(baseline.Reward.Sum, baseline.Reward.Count, candidate.Reward.Sum, candidate.Reward.Count, z)
+			comparison.MeanRewardDiff = &diff
+			comparison.MeanRewardDiffInterval = &interval
+			comparison.MeanRewardPValue = &pValue
+		}
+		comparisons = append(comparisons, comparison)
+	}
+	return comparisons
+}
+
+// GetExperimentStats queries Prometheus for the requests, errors and latencies of each candidate over the window,
+// adds the rewards of bandit experiments and compares each candidate with the baseline
+func (es *ExperimentStore) GetExperimentStats(experimentName string, window *time.Duration, confidenceLevel *float64) (*ExperimentStats, error) {
+	level := DefaultStatsConfidenceLevel
+	if confidenceLevel != nil {
+		level = *confidenceLevel
+	}
+	if math.IsNaN(level) || level <= 0 || level >= 1 {
+		return nil, &ExperimentStatsInvalid{experimentName: experimentName, reason: fmt.Sprintf("confidence level %v is not in (0,1)", level)}
+	}
+	es.mu.RLock()
+	experiment, ok := es.experiments[experimentName]
+	if !ok || experiment.Deleted {
+		es.mu.RUnlock()
+		return nil, &ExperimentNotFound{experimentName: experimentName}
+	}
+	stats := &ExperimentStats{
+		ExperimentName:  experimentName,
+		Window:          statsWindow(experiment, window, time.Now()),
+		ConfidenceLevel: level,
+	}
+	for _, candidate := range experiment.Candidates {
+		stats.Candidates = append(stats.Candidates, &CandidateStats{Name: candidate.Name, Reward: candidate.Reward})
+	}
+	if len(stats.Candidates) > 0 {
+		stats.Baseline = stats.Candidates[0].Name
+	}
+	if experiment.Default != nil && getCandidate(experiment, *experiment.Default) != nil {
+		stats.Baseline = *experiment.Default
+	}
+	resourceType := experiment.ResourceType
+	metricsQuerier := es.metricsQuerier
+	es.mu.RUnlock()
+
+	if metricsQuerier == nil {
+		return nil, &ExperimentStatsInvalid{experimentName: experimentName, reason: "no Prometheus to query metrics"}
+	}
+	requestsQuery, errorsQuery, latencyQuery, latencyBucket := modelRequestsQuery, modelErrorsQuery, modelLatencyQuery, modelLatencyBucket
+	if resourceType == PipelineResourceType {
+		requestsQuery, errorsQuery, latencyQuery, latencyBucket = pipelineRequestsQuery, pipelineErrorsQuery, pipelineLatencyQuery, pipelineLatencyBucket
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), statsQueryTimeout)
+	defer cancel()
+	windowSeconds := int64(stats.Window.Seconds())
+	z := zScore(level)
+	var baseline *CandidateStats
+	for _, candidate := range stats.Candidates {
+		var latencySum float64
+		for _, q := range []struct {
+			query string
+			value *float64
+		}{
+			{requestsQuery, &candidate.Requests},
+			{errorsQuery, &candidate.Errors},
+			{latencyQuery, &latencySum},
+		} {
+			value, err := metricsQuerier.Query(ctx, fmt.Sprintf(q.query, candidate.Name, windowSeconds))
+			if err != nil {
+				return nil, err
+			}
+			*q.value = value
+		}
+		if candidate.Requests > 0 {
+			candidate.ErrorRate = candidate.Errors / candidate.Requests
+			candidate.MeanLatencySeconds = latencySum / candidate.Requests
+			cumulative := make([]float64, len(metrics.DefaultHistogramBuckets)+1)
+			for idx := range cumulative {
+				bound := math.Inf(1)
+				if idx < len(metrics.DefaultHistogramBuckets) {
+					bound = metrics.DefaultHistogramBuckets[idx]
+				}
+				count, err := metricsQuerier.Query(ctx, fmt.Sprintf(latencyBucket, candidate.Name, bound, windowSeconds))
+				if err != nil {
+					return nil, err
+				}
+				cumulative[idx] = count
+			}
+			// No observations if the latency histogram is not exported
+			if cumulative[len(cumulative)-1] > 0 {
+				candidate.latencyCounts = latencyCounts(cumulative)
+				for _, quantile := range statsLatencyQuantiles {
+					candidate.LatencyQuantiles = append(candidate.LatencyQuantiles, LatencyQuantile{
+						Quantile: quantile,
+						Seconds:  latencyQuantile(quantile, candidate.latencyCounts),
+					})
+				}
+			}
+		}
+		candidate.ErrorRateInterval = wilsonInterval(candidate.Errors, candidate.Requests, z)
+		if candidate.Reward.Count > 0 {
+			interval := wilsonInterval(candidate.Reward.Sum, candidate.Reward.Count, z)
+			candidate.MeanRewardInterval = &interval
+		}
+		if candidate.Name == stats.Baseline {
+			baseline = candidate
+		}
+	}
+	if baseline != nil {
+		stats.Comparisons = computeComparisons(baseline, stats.Candidates, z)
+	}
+	return stats, nil
+}
+
+func createIntervalProto(interval *Interval) *scheduler.ConfidenceInterval {
+	if interval == nil {
+		return nil
+	}
+	return &scheduler.ConfidenceInterval{Lower: interval.Lower, Upper: interval.Upper}
+}
+
+func CreateExperimentStatsProto(stats *ExperimentStats) *scheduler.ExperimentStatsResponse {
+	response := &scheduler.ExperimentStatsResponse{
+		ExperimentName:  stats.ExperimentName,
+		WindowSeconds:   uint32(stats.Window.Seconds()),
+		ConfidenceLevel: stats.ConfidenceLevel,
+		Baseline:        stats.Baseline,
+	}
+	for _, candidate := range stats.Candidates {
+		protoCandidate := &scheduler.CandidateStats{
+			Name:               candidate.Name,
+			Requests:           candidate.Requests,
+			Errors:             candidate.Errors,
+			ErrorRate:          candidate.ErrorRate,
+			ErrorRateInterval:  createIntervalProto(&candidate.ErrorRateInterval),
+			MeanLatencySeconds: candidate.MeanLatencySeconds,
+			FeedbackCount:      candidate.Reward.Count,
+			MeanReward:         candidate.Reward.Mean(),
+			MeanRewardInterval: createIntervalProto(candidate.MeanRewardInterval),
+		}
+		for _, quantile := range candidate.LatencyQuantiles {
+			protoCandidate.LatencyQuantiles = append(protoCandidate.LatencyQuantiles, &scheduler.LatencyQuantile{
+				Quantile: quantile.Quantile,
+				Seconds:  quantile.Seconds,
+			})
+		}
+		response.Candidates = append(response.Candidates, protoCandidate)
+	}
+	for _, comparison := range stats.Comparisons {
+		response.Comparisons = append(response.Comparisons, &scheduler.CandidateComparison{
+			Candidate:               comparison.Candidate,
+			ErrorRateDiff:           comparison.ErrorRateDiff,
+			ErrorRateDiffInterval:   createIntervalProto(&comparison.ErrorRateDiffInterval),
+			ErrorRatePValue:         comparison.ErrorRatePValue,
+			MeanLatencyDiffSeconds:  comparison.MeanLatencyDiffSeconds,
+			MeanRewardDiff:          comparison.MeanRewardDiff,
+			MeanRewardDiffInterval:  createIntervalProto(comparison.MeanRewardDiffInterval),
+			MeanRewardPValue:        comparison.MeanRewardPValue,
+			MeanLatencyDiffInterval: createIntervalProto(comparison.MeanLatencyDiffInterval),
+			LatencyPValue:           comparison.LatencyPValue,
+		})
+	}
+	return response
+}
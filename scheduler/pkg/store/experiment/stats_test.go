@@ -0,0 +1,476 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package experiment
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
+)
+
+func TestZScore(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(zScore(0.95)).To(BeNumerically("~", 1.95996, 1e-4))
+	g.Expect(zScore(0.9)).To(BeNumerically("~", 1.64485, 1e-4))
+	g.Expect(zScore(0.99)).To(BeNumerically("~", 2.57583, 1e-4))
+}
+
+func TestWilsonInterval(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name      string
+		successes float64
+		n         float64
+		expected  Interval
+	}
+
+	tests := []test{
+		{
+			name:     "no samples",
+			expected: Interval{Lower: 0, Upper: 1},
+		},
+		{
+			name:      "no successes",
+			successes: 0,
+			n:         100,
+			expected:  Interval{Lower: 0, Upper: 0.037},
+		},
+		{
+			name:      "half",
+			successes: 50,
+			n:         100,
+			expected:  Interval{Lower: 0.4038, Upper: 0.5962},
+		},
+		{
+			name:      "all successes",
+			successes: 100,
+			n:         100,
+			expected:  Interval{Lower: 0.963, Upper: 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			interval := wilsonInterval(test.successes, test.n, zScore(0.95))
+			g.Expect(interval.Lower).To(BeNumerically("~", test.expected.Lower, 1e-3))
+			g.Expect(interval.Upper).To(BeNumerically("~", test.expected.Upper, 1e-3))
+		})
+	}
+}
+
+func TestCompareProportions(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name             string
+		successes1       float64
+		n1               float64
+		successes2       float64
+		n2               float64
+		expectedDiff     float64
+		expectedInterval Interval
+		expectedPValue   float64
+	}
+
+	tests := []test{
+		{
+			name:             "significant difference",
+			successes1:       10,
+			n1:               100,
+			successes2:       20,
+			n2:               100,
+			expectedDiff:     0.1,
+			expectedInterval: Interval{Lower: 0.002, Upper: 0.198},
+			expectedPValue:   0.0477,
+		},
+		{
+			name:             "no difference",
+			successes1:       10,
+			n1:               100,
+			successes2:       10,
+			n2:               100,
+			expectedDiff:     0,
+			expectedInterval: Interval{Lower: -0.0832, Upper: 0.0832},
+			expectedPValue:   1,
+		},
+		{
+			name:             "no variance",
+			successes1:       0,
+			n1:               100,
+			successes2:       0,
+			n2:               100,
+			expectedDiff:     0,
+			expectedInterval: Interval{Lower: 0, Upper: 0},
+			expectedPValue:   1,
+		},
+		{
+			name:             "no samples",
+			successes1:       10,
+			n1:               100,
+			expectedInterval: Interval{Lower: -1, Upper: 1},
+			expectedPValue:   1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diff, interval, pValue := compareProportions(test.successes1, test.n1, test.successes2, test.n2, zScore(0.95))
+			g.Expect(diff).To(BeNumerically("~", test.expectedDiff, 1e-6))
+			g.Expect(interval.Lower).To(BeNumerically("~", test.expectedInterval.Lower, 1e-3))
+			g.Expect(interval.Upper).To(BeNumerically("~", test.expectedInterval.Upper, 1e-3))
+			g.Expect(pValue).To(BeNumerically("~", test.expectedPValue, 1e-3))
+		})
+	}
+}
+
+func TestLatencyQuantile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	counts := []float64{0, 0, 200, 400, 300, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	type test struct {
+		name     string
+		quantile float64
+		counts   []float64
+		expected float64
+	}
+
+	tests := []test{
+		{
+			name:     "median",
+			quantile: 0.5,
+			counts:   counts,
+			expected: 0.04375,
+		},
+		{
+			name:     "tail",
+			quantile: 0.99,
+			counts:   counts,
+			expected: 0.0975,
+		},
+		{
+			name:     "above largest bound",
+			quantile: 0.99,
+			counts:   []float64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 90},
+			expected: 10,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(latencyQuantile(test.quantile, test.counts)).To(BeNumerically("~", test.expected, 1e-9))
+		})
+	}
+}
+
+func TestCompareLatencies(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	baseline := []float64{0, 0, 200, 400, 300, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	type test struct {
+		name             string
+		counts1          []float64
+		mean2            float64
+		counts2          []float64
+		expectedInterval *Interval
+		expectedPValue   *float64
+	}
+	getFloatPtr := func(val float64) *float64 { return &val }
+
+	tests := []test{
+		{
+			name:             "slower",
+			counts1:          baseline,
+			mean2:            0.08,
+			counts2:          []float64{0, 0, 0, 0, 300, 400, 300, 0, 0, 0, 0, 0, 0, 0, 0},
+			expectedInterval: &Interval{Lower: 0.02685, Upper: 0.03315},
+			expectedPValue:   getFloatPtr(0),
+		},
+		{
+			name:             "no significant difference",
+			counts1:          baseline,
+			mean2:            0.0505,
+			counts2:          []float64{0, 0, 190, 400, 300, 110, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			expectedInterval: &Interval{Lower: -0.00136, Upper: 0.00236},
+			expectedPValue:   getFloatPtr(0.4879),
+		},
+		{
+			name:           "all ties",
+			counts1:        []float64{0, 0, 0, 1000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			mean2:          0.05,
+			counts2:        []float64{0, 0, 0, 1000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			expectedPValue: getFloatPtr(1),
+		},
+		{
+			name:    "too few observations",
+			counts1: baseline,
+			mean2:   0.05,
+			counts2: []float64{0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			interval, pValue := compareLatencies(0.05, test.counts1, test.mean2, test.counts2, zScore(0.95))
+			if test.expectedPValue == nil {
+				g.Expect(interval).To(BeNil())
+				g.Expect(pValue).To(BeNil())
+				return
+			}
+			g.Expect(*pValue).To(BeNumerically("~", *test.expectedPValue, 1e-3))
+			if test.expectedInterval != nil {
+				g.Expect(interval.Lower).To(BeNumerically("~", test.expectedInterval.Lower, 1e-4))
+				g.Expect(interval.Upper).To(BeNumerically("~", test.expectedInterval.Upper, 1e-4))
+			}
+		})
+	}
+}
+
+func TestStatsWindow(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	started := now.Add(-30 * time.Minute)
+	getDurationPtr := func(val time.Duration) *time.Duration { return &val }
+	type test struct {
+		name       string
+		experiment *Experiment
+		requested  *time.Duration
+		expected   time.Duration
+	}
+
+	tests := []test{
+		{
+			name:       "default",
+			experiment: &Experiment{},
+			expected:   DefaultStatsWindow,
+		},
+		{
+			name:       "requested",
+			experiment: &Experiment{ScheduleState: &ScheduleState{StartedAt: &started}},
+			requested:  getDurationPtr(10 * time.Minute),
+			expected:   10 * time.Minute,
+		},
+		{
+			name:       "since scheduled start",
+			experiment: &Experiment{ScheduleState: &ScheduleState{StartedAt: &started}},
+			expected:   30 * time.Minute,
+		},
+		{
+			name:       "minimum",
+			experiment: &Experiment{},
+			requested:  getDurationPtr(time.Millisecond),
+			expected:   time.Second,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(statsWindow(test.experiment, test.requested, now)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestGetExperimentStats(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	getStrPtr := func(val string) *string { return &val }
+	getFloatPtr := func(val float64) *float64 { return &val }
+	// Cumulative latency bucket counts up to each bound, then the total
+	latencyBuckets := map[string][]float64{
+		"model1": {0, 0, 200, 600, 900, 1000, 1000, 1000, 1000, 1000, 1000, 1000, 1000, 1000, 1000},
+		"model2": {0, 0, 0, 0, 300, 700, 1000, 1000, 1000, 1000, 1000, 1000, 1000, 1000, 1000},
+	}
+	modelMetrics := map[string]float64{
+		`seldon_model_infer_total{model="model1"}[3600s]`:                1000,
+		`seldon_model_infer_total{model="model1",code!~"2..|OK"}[3600s]`: 10,
+		`seldon_model_infer_seconds_total{model="model1"}[3600s]`:        50,
+		`seldon_model_infer_total{model="model2"}[3600s]`:                1000,
+		`seldon_model_infer_total{model="model2",code!~"2..|OK"}[3600s]`: 30,
+		`seldon_model_infer_seconds_total{model="model2"}[3600s]`:        80,
+	}
+	for model, counts := range latencyBuckets {
+		for idx, count := range counts {
+			bound := math.Inf(1)
+			if idx < len(metrics.DefaultHistogramBuckets) {
+				bound = metrics.DefaultHistogramBuckets[idx]
+			}
+			modelMetrics[fmt.Sprintf(`seldon_model_infer_latency_seconds_bucket{model="%s",le="%g"}`, model, bound)] = count
+		}
+	}
+	model1Quantiles := []LatencyQuantile{{Quantile: 0.5, Seconds: 0.04375}, {Quantile: 0.95, Seconds: 0.0875}, {Quantile: 0.99, Seconds: 0.0975}}
+	model2Quantiles := []LatencyQuantile{{Quantile: 0.5, Seconds: 0.0875}, {Quantile: 0.95, Seconds: 0.225}, {Quantile: 0.99, Seconds: 0.245}}
+	type test struct {
+		name                string
+		experiment          *Experiment
+		experimentName      string
+		confidenceLevel     *float64
+		metricsQuerier      MetricsQuerier
+		expectedBaseline    string
+		expectedCandidates  []*CandidateStats
+		expectedComparisons int
+		expectedPValueBelow float64
+		expectedRewardDiff  *float64
+		expectedLatencyDiff bool
+		err                 error
+	}
+
+	tests := []test{
+		{
+			name: "model experiment compared with default",
+			experiment: &Experiment{
+				Name:       "a",
+				Default:    getStrPtr("model2"),
+				Candidates: []*Candidate{{Name: "model1", Weight: 50}, {Name: "model2", Weight: 50}},
+			},
+			experimentName:   "a",
+			metricsQuerier:   &fakeMetricsQuerier{values: modelMetrics},
+			expectedBaseline: "model2",
+			expectedCandidates: []*CandidateStats{
+				{Name: "model1", Requests: 1000, Errors: 10, ErrorRate: 0.01, MeanLatencySeconds: 0.05, LatencyQuantiles: model1Quantiles},
+				{Name: "model2", Requests: 1000, Errors: 30, ErrorRate: 0.03, MeanLatencySeconds: 0.08, LatencyQuantiles: model2Quantiles},
+			},
+			expectedComparisons: 1,
+			expectedPValueBelow: 0.01,
+			expectedLatencyDiff: true,
+		},
+		{
+			name: "bandit experiment with rewards",
+			experiment: &Experiment{
+				Name: "a",
+				Candidates: []*Candidate{
+					{Name: "model1", Weight: 50, Reward: Reward{Sum: 10, Count: 100}},
+					{Name: "model2", Weight: 50, Reward: Reward{Sum: 20, Count: 100}},
+				},
+				Config: &Config{Bandit: &Bandit{}},
+			},
+			experimentName:   "a",
+			metricsQuerier:   &fakeMetricsQuerier{values: modelMetrics},
+			expectedBaseline: "model1",
+			expectedCandidates: []*CandidateStats{
+				{Name: "model1", Requests: 1000, Errors: 10, ErrorRate: 0.01, MeanLatencySeconds: 0.05, LatencyQuantiles: model1Quantiles, Reward: Reward{Sum: 10, Count: 100}},
+				{Name: "model2", Requests: 1000, Errors: 30, ErrorRate: 0.03, MeanLatencySeconds: 0.08, LatencyQuantiles: model2Quantiles, Reward: Reward{Sum: 20, Count: 100}},
+			},
+			expectedComparisons: 1,
+			expectedPValueBelow: 0.01,
+			expectedRewardDiff:  getFloatPtr(0.1),
+			expectedLatencyDiff: true,
+		},
+		{
+			name: "pipeline experiment without traffic",
+			experiment: &Experiment{
+				Name:         "a",
+				ResourceType: PipelineResourceType,
+				Candidates:   []*Candidate{{Name: "pipeline1", Weight: 50}, {Name: "pipeline2", Weight: 50}},
+			},
+			experimentName:   "a",
+			metricsQuerier:   &fakeMetricsQuerier{values: modelMetrics},
+			expectedBaseline: "pipeline1",
+			expectedCandidates: []*CandidateStats{
+				{Name: "pipeline1"},
+				{Name: "pipeline2"},
+			},
+			expectedComparisons: 1,
+			expectedPValueBelow: 1.1,
+		},
+		{
+			name: "experiment not found",
+			experiment: &Experiment{
+				Name:       "a",
+				Candidates: []*Candidate{{Name: "model1", Weight: 50}, {Name: "model2", Weight: 50}},
+			},
+			experimentName: "b",
+			metricsQuerier: &fakeMetricsQuerier{values: modelMetrics},
+			err:            &ExperimentNotFound{},
+		},
+		{
+			name: "no Prometheus",
+			experiment: &Experiment{
+				Name:       "a",
+				Candidates: []*Candidate{{Name: "model1", Weight: 50}, {Name: "model2", Weight: 50}},
+			},
+			experimentName: "a",
+			err:            &ExperimentStatsInvalid{},
+		},
+		{
+			name: "invalid confidence level",
+			experiment: &Experiment{
+				Name:       "a",
+				Candidates: []*Candidate{{Name: "model1", Weight: 50}, {Name: "model2", Weight: 50}},
+			},
+			experimentName:  "a",
+			confidenceLevel: getFloatPtr(1),
+			metricsQuerier:  &fakeMetricsQuerier{values: modelMetrics},
+			err:             &ExperimentStatsInvalid{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			es := NewExperimentServer(logrus.New(), nil, nil, nil)
+			es.SetMetricsQuerier(test.metricsQuerier)
+			es.experiments[test.experiment.Name] = test.experiment
+			stats, err := es.GetExperimentStats(test.experimentName, nil, test.confidenceLevel)
+			if test.err != nil {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err).To(MatchError(test.err))
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(stats.Window).To(Equal(DefaultStatsWindow))
+			g.Expect(stats.ConfidenceLevel).To(Equal(DefaultStatsConfidenceLevel))
+			g.Expect(stats.Baseline).To(Equal(test.expectedBaseline))
+			g.Expect(stats.Candidates).To(HaveLen(len(test.expectedCandidates)))
+			for idx, expected := range test.expectedCandidates {
+				candidate := stats.Candidates[idx]
+				g.Expect(candidate.Name).To(Equal(expected.Name))
+				g.Expect(candidate.Requests).To(Equal(expected.Requests))
+				g.Expect(candidate.Errors).To(Equal(expected.Errors))
+				g.Expect(candidate.ErrorRate).To(BeNumerically("~", expected.ErrorRate, 1e-9))
+				g.Expect(candidate.MeanLatencySeconds).To(BeNumerically("~", expected.MeanLatencySeconds, 1e-9))
+				g.Expect(candidate.ErrorRateInterval.Lower).To(BeNumerically("<=", candidate.ErrorRate))
+				g.Expect(candidate.ErrorRateInterval.Upper).To(BeNumerically(">=", candidate.ErrorRate))
+				g.Expect(candidate.Reward).To(Equal(expected.Reward))
+				g.Expect(candidate.LatencyQuantiles).To(HaveLen(len(expected.LatencyQuantiles)))
+				for qIdx, quantile := range expected.LatencyQuantiles {
+					g.Expect(candidate.LatencyQuantiles[qIdx].Quantile).To(Equal(quantile.Quantile))
+					g.Expect(candidate.LatencyQuantiles[qIdx].Seconds).To(BeNumerically("~", quantile.Seconds, 1e-9))
+				}
+				g.Expect(candidate.MeanRewardInterval == nil).To(Equal(expected.Reward.Count == 0))
+			}
+			g.Expect(stats.Comparisons).To(HaveLen(test.expectedComparisons))
+			for _, comparison := range stats.Comparisons {
+				g.Expect(comparison.Candidate).ToNot(Equal(test.expectedBaseline))
+				g.Expect(comparison.ErrorRatePValue).To(BeNumerically("<", test.expectedPValueBelow))
+				if test.expectedRewardDiff != nil {
+					g.Expect(*comparison.MeanRewardDiff).To(BeNumerically("~", *test.expectedRewardDiff, 1e-9))
+					g.Expect(comparison.MeanRewardPValue).ToNot(BeNil())
+				} else {
+					g.Expect(comparison.MeanRewardDiff).To(BeNil())
+				}
+				if test.expectedLatencyDiff {
+					g.Expect(comparison.MeanLatencyDiffInterval.Lower).To(BeNumerically("<=", comparison.MeanLatencyDiffSeconds))
+					g.Expect(comparison.MeanLatencyDiffInterval.Upper).To(BeNumerically(">=", comparison.MeanLatencyDiffSeconds))
+					g.Expect(*comparison.LatencyPValue).To(BeNumerically("<", 0.01))
+				} else {
+					g.Expect(comparison.MeanLatencyDiffInterval).To(BeNil())
+					g.Expect(comparison.LatencyPValue).To(BeNil())
+				}
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/mitchellh/copystructure"
 	"github.com/sirupsen/logrus"
@@ -40,6 +41,11 @@ type ExperimentServer interface {
 	GetExperimentForBaselineModel(modelName string) *Experiment
 	GetExperimentForBaselinePipeline(pipelineName string) *Experiment
 	SetStatus(experimentName string, active bool, reason string) error
+	AddFeedback(experimentName string, candidateName string, requestId string, reward float64) error
+	AddShadowResult(experimentName string, result *ShadowResult) error
+	AddShadowUnmatched(experimentName string) error
+	GetShadowReport(experimentName string) *ShadowReport
+	GetExperimentStats(experimentName string, window *time.Duration, confidenceLevel *float64) (*ExperimentStats, error)
 }
 
 type ExperimentStore struct {
@@ -54,6 +60,9 @@ type ExperimentStore struct {
 	store              store.ModelStore
 	pipelineStore      pipeline.PipelineHandler
 	db                 *ExperimentDBManager
+	bandits            map[string]*banditState  // experiment name to the state of its bandit
+	schedules          map[string]chan struct{} // experiment name to the channel stopping its schedule
+	metricsQuerier     MetricsQuerier
 }
 
 func NewExperimentServer(logger logrus.FieldLogger, eventHub *coordinator.EventHub, store store.ModelStore, pipelineStore pipeline.PipelineHandler) *ExperimentStore {
@@ -65,6 +74,8 @@ func NewExperimentServer(logger logrus.FieldLogger, eventHub *coordinator.EventH
 		modelReferences:    make(map[string]map[string]*Experiment),
 		pipelineBaselines:  make(map[string]*Experiment),
 		pipelineReferences: make(map[string]map[string]*Experiment),
+		bandits:            make(map[string]*banditState),
+		schedules:          make(map[string]chan struct{}),
 		eventHub:           eventHub,
 		store:              store,
 		pipelineStore:      pipelineStore,
@@ -346,14 +357,26 @@ func (es *ExperimentStore) startExperimentImpl(experiment *Experiment) (*coordin
 		}
 
 	}
-	es.updateExperimentState(experiment)
+	previous := es.experiments[experiment.Name]
+	startSchedule(experiment, previous, time.Now())
 	if es.db != nil {
 		err := es.db.save(experiment)
 		if err != nil {
 			return nil, nil, nil, err
 		}
 	}
+	// Pending and concluded experiments keep no baseline so the default routes as usual
+	if experiment.RoutesTraffic() {
+		applyPromotion(experiment)
+		es.updateExperimentState(experiment)
+		es.startBandit(experiment, previous)
+	} else {
+		es.stopBandit(experiment.Name)
+		experiment.StatusDescription = experiment.ScheduleDescription()
+	}
+	startShadowComparison(experiment, previous)
 	es.experiments[experiment.Name] = experiment
+	es.startScheduleRunner(experiment)
 	return es.createExperimentEventMsg(experiment, true), modelEvt, pipelineEvt, nil
 }
 
@@ -386,6 +409,8 @@ func (es *ExperimentStore) stopExperimentImpl(experimentName string) (*coordinat
 		var pipelineEvt *coordinator.PipelineEventMsg
 		experiment.Deleted = true
 		experiment.Active = false
+		es.stopBandit(experimentName)
+		es.stopScheduleRunner(experimentName)
 		es.cleanExperimentState(experiment)
 		if experiment.Default != nil {
 			switch experiment.ResourceType {
@@ -372,6 +372,10 @@ func (f fakeModelStore) UnloadVersionModels(modelKey string, version uint32) (bo
 	panic("implement me")
 }
 
+func (f fakeModelStore) SetModelRollout(modelKey string, rollout *store.ModelRollout) error {
+	panic("implement me")
+}
+
 func (f fakeModelStore) UpdateModelState(modelKey string, version uint32, serverKey string, replicaIdx int, availableMemory *uint64, expectedState, desiredState store.ModelReplicaState, reason string) error {
 	panic("implement me")
 }
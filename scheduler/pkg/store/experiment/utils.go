@@ -10,6 +10,10 @@ the Change License after the Change Date as each is defined in accordance with t
 package experiment
 
 import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
 )
 
@@ -29,10 +33,19 @@ func CreateExperimentFromRequest(request *scheduler.Experiment) *Experiment {
 			Name:    request.Mirror.Name,
 			Percent: request.Mirror.Percent,
 		}
+		if request.Mirror.Comparison != nil {
+			mirror.Comparison = &ShadowComparison{
+				Outputs:   request.Mirror.Comparison.Outputs,
+				Tolerance: request.Mirror.Comparison.Tolerance,
+			}
+		}
 	}
 	if request.Config != nil {
 		config = &Config{
 			StickySessions: request.Config.StickySessions,
+			Bandit:         createBanditFromRequest(request.Config.Bandit),
+			Rules:          createRulesFromRequest(request.Config.Rules),
+			Schedule:       createScheduleFromRequest(request.Config.Schedule),
 		}
 	}
 	if request.KubernetesMeta != nil {
@@ -57,9 +70,189 @@ func CreateExperimentFromRequest(request *scheduler.Experiment) *Experiment {
 		Mirror:         mirror,
 		Config:         config,
 		KubernetesMeta: k8sMeta,
+		ScheduleState:  createScheduleStateFromRequest(request.ScheduleState),
+	}
+}
+
+func timeFromProto(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func timeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func createScheduleFromRequest(request *scheduler.ExperimentSchedule) *Schedule {
+	if request == nil {
+		return nil
+	}
+	schedule := &Schedule{
+		StartTime:          timeFromProto(request.StartTime),
+		EndTime:            timeFromProto(request.EndTime),
+		MaxDurationSeconds: request.MaxDurationSeconds,
+	}
+	if request.Promotion != nil {
+		schedule.Promotion = &Promotion{
+			Query:         request.Promotion.Query,
+			LowerIsBetter: request.Promotion.LowerIsBetter,
+		}
+	}
+	return schedule
+}
+
+func createScheduleProto(schedule *Schedule) *scheduler.ExperimentSchedule {
+	if schedule == nil {
+		return nil
+	}
+	protoSchedule := &scheduler.ExperimentSchedule{
+		StartTime:          timeToProto(schedule.StartTime),
+		EndTime:            timeToProto(schedule.EndTime),
+		MaxDurationSeconds: schedule.MaxDurationSeconds,
+	}
+	if schedule.Promotion != nil {
+		protoSchedule.Promotion = &scheduler.ExperimentPromotion{
+			Query:         schedule.Promotion.Query,
+			LowerIsBetter: schedule.Promotion.LowerIsBetter,
+		}
+	}
+	return protoSchedule
+}
+
+func createScheduleStateFromRequest(request *scheduler.ExperimentScheduleState) *ScheduleState {
+	if request == nil {
+		return nil
+	}
+	return &ScheduleState{
+		StartedAt:         timeFromProto(request.StartedAt),
+		ConcludedAt:       timeFromProto(request.ConcludedAt),
+		PromotedCandidate: request.PromotedCandidate,
+	}
+}
+
+// CreateScheduleStateProto returns the progress of the schedule of an experiment including when it concludes
+func CreateScheduleStateProto(experiment *Experiment) *scheduler.ExperimentScheduleState {
+	if experiment.ScheduleState == nil {
+		return nil
+	}
+	return &scheduler.ExperimentScheduleState{
+		StartedAt:         timeToProto(experiment.ScheduleState.StartedAt),
+		ConcludedAt:       timeToProto(experiment.ScheduleState.ConcludedAt),
+		PromotedCandidate: experiment.ScheduleState.PromotedCandidate,
+		EndsAt:            timeToProto(experiment.GetScheduleEnd()),
+	}
+}
+
+func createBanditFromRequest(request *scheduler.BanditConfig) *Bandit {
+	if request == nil {
+		return nil
+	}
+	var policy BanditPolicy
+	switch request.Policy {
+	case scheduler.BanditConfig_THOMPSON_SAMPLING:
+		policy = ThompsonSamplingPolicy
+	case scheduler.BanditConfig_EPSILON_GREEDY:
+		policy = EpsilonGreedyPolicy
+	}
+	var prometheusReward *PrometheusReward
+	if request.PrometheusReward != nil {
+		prometheusReward = &PrometheusReward{
+			RewardQuery: request.PrometheusReward.RewardQuery,
+			CountQuery:  request.PrometheusReward.CountQuery,
+		}
+	}
+	return &Bandit{
+		Policy:                policy,
+		Epsilon:               request.Epsilon,
+		MinWeight:             request.MinWeight,
+		MaxWeight:             request.MaxWeight,
+		UpdateIntervalSeconds: request.UpdateIntervalSeconds,
+		PrometheusReward:      prometheusReward,
 	}
 }
 
+func createBanditProto(bandit *Bandit) *scheduler.BanditConfig {
+	if bandit == nil {
+		return nil
+	}
+	var policy scheduler.BanditConfig_Policy
+	switch bandit.Policy {
+	case ThompsonSamplingPolicy:
+		policy = scheduler.BanditConfig_THOMPSON_SAMPLING
+	case EpsilonGreedyPolicy:
+		policy = scheduler.BanditConfig_EPSILON_GREEDY
+	}
+	var prometheusReward *scheduler.BanditPrometheusReward
+	if bandit.PrometheusReward != nil {
+		prometheusReward = &scheduler.BanditPrometheusReward{
+			RewardQuery: bandit.PrometheusReward.RewardQuery,
+			CountQuery:  bandit.PrometheusReward.CountQuery,
+		}
+	}
+	return &scheduler.BanditConfig{
+		Policy:                policy,
+		Epsilon:               bandit.Epsilon,
+		MinWeight:             bandit.MinWeight,
+		MaxWeight:             bandit.MaxWeight,
+		UpdateIntervalSeconds: bandit.UpdateIntervalSeconds,
+		PrometheusReward:      prometheusReward,
+	}
+}
+
+func createRulesFromRequest(request []*scheduler.ExperimentRule) []*Rule {
+	var rules []*Rule
+	for _, reqRule := range request {
+		rule := &Rule{Candidate: reqRule.Candidate}
+		for _, reqHeader := range reqRule.Headers {
+			header := &HeaderMatch{
+				Name:   reqHeader.Name,
+				Exact:  reqHeader.Exact,
+				Prefix: reqHeader.Prefix,
+				Regex:  reqHeader.Regex,
+			}
+			if reqHeader.Hash != nil {
+				header.Hash = &HashRange{
+					Start: reqHeader.Hash.Start,
+					End:   reqHeader.Hash.End,
+				}
+			}
+			rule.Headers = append(rule.Headers, header)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func CreateRulesProto(rules []*Rule) []*scheduler.ExperimentRule {
+	var protoRules []*scheduler.ExperimentRule
+	for _, rule := range rules {
+		protoRule := &scheduler.ExperimentRule{Candidate: rule.Candidate}
+		for _, header := range rule.Headers {
+			protoHeader := &scheduler.ExperimentHeaderMatch{
+				Name:   header.Name,
+				Exact:  header.Exact,
+				Prefix: header.Prefix,
+				Regex:  header.Regex,
+			}
+			if header.Hash != nil {
+				protoHeader.Hash = &scheduler.ExperimentHashRange{
+					Start: header.Hash.Start,
+					End:   header.Hash.End,
+				}
+			}
+			protoRule.Headers = append(protoRule.Headers, protoHeader)
+		}
+		protoRules = append(protoRules, protoRule)
+	}
+	return protoRules
+}
+
 func CreateExperimentProto(experiment *Experiment) *scheduler.Experiment {
 	var candidates []*scheduler.ExperimentCandidate
 	for _, candidate := range experiment.Candidates {
@@ -74,11 +267,20 @@ func CreateExperimentProto(experiment *Experiment) *scheduler.Experiment {
 			Name:    experiment.Mirror.Name,
 			Percent: experiment.Mirror.Percent,
 		}
+		if experiment.Mirror.Comparison != nil {
+			mirror.Comparison = &scheduler.ShadowComparison{
+				Outputs:   experiment.Mirror.Comparison.Outputs,
+				Tolerance: experiment.Mirror.Comparison.Tolerance,
+			}
+		}
 	}
 	var config *scheduler.ExperimentConfig
 	if experiment.Config != nil {
 		config = &scheduler.ExperimentConfig{
 			StickySessions: experiment.Config.StickySessions,
+			Bandit:         createBanditProto(experiment.Config.Bandit),
+			Rules:          CreateRulesProto(experiment.Config.Rules),
+			Schedule:       createScheduleProto(experiment.Config.Schedule),
 		}
 	}
 	var k8sMeta *scheduler.KubernetesMeta
@@ -88,6 +290,14 @@ func CreateExperimentProto(experiment *Experiment) *scheduler.Experiment {
 			Generation: experiment.KubernetesMeta.Generation,
 		}
 	}
+	var scheduleState *scheduler.ExperimentScheduleState
+	if experiment.ScheduleState != nil {
+		scheduleState = &scheduler.ExperimentScheduleState{
+			StartedAt:         timeToProto(experiment.ScheduleState.StartedAt),
+			ConcludedAt:       timeToProto(experiment.ScheduleState.ConcludedAt),
+			PromotedCandidate: experiment.ScheduleState.PromotedCandidate,
+		}
+	}
 	var resourceType scheduler.ResourceType
 	switch experiment.ResourceType {
 	case PipelineResourceType:
@@ -103,5 +313,6 @@ func CreateExperimentProto(experiment *Experiment) *scheduler.Experiment {
 		Mirror:         mirror,
 		Config:         config,
 		KubernetesMeta: k8sMeta,
+		ScheduleState:  scheduleState,
 	}
 }
@@ -11,8 +11,11 @@ package experiment
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
 )
@@ -26,6 +29,9 @@ func TestLoadModel(t *testing.T) {
 	}
 
 	getStrPtr := func(val string) *string { return &val }
+	getUint32Ptr := func(val uint32) *uint32 { return &val }
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
 	tests := []test{
 		{
 			name: "model",
@@ -135,6 +141,114 @@ func TestLoadModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "bandit",
+			proto: &scheduler.Experiment{
+				Name:    "foo",
+				Default: getStrPtr("model1"),
+				Candidates: []*scheduler.ExperimentCandidate{
+					{
+						Name:   "model1",
+						Weight: 50,
+					},
+					{
+						Name:   "model2",
+						Weight: 50,
+					},
+				},
+				Config: &scheduler.ExperimentConfig{
+					Bandit: &scheduler.BanditConfig{
+						Policy:                scheduler.BanditConfig_THOMPSON_SAMPLING,
+						MinWeight:             10,
+						MaxWeight:             getUint32Ptr(90),
+						UpdateIntervalSeconds: getUint32Ptr(30),
+						PrometheusReward: &scheduler.BanditPrometheusReward{
+							RewardQuery: "sum(increase(reward_sum{model=\"{{.Candidate}}\"}[{{.Interval}}]))",
+							CountQuery:  "sum(increase(reward_count{model=\"{{.Candidate}}\"}[{{.Interval}}]))",
+						},
+					},
+				},
+			},
+			experiment: &Experiment{
+				Name:         "foo",
+				Active:       false,
+				Default:      getStrPtr("model1"),
+				ResourceType: ModelResourceType,
+				Candidates: []*Candidate{
+					{
+						Name:   "model1",
+						Weight: 50,
+					},
+					{
+						Name:   "model2",
+						Weight: 50,
+					},
+				},
+				Config: &Config{
+					Bandit: &Bandit{
+						Policy:                ThompsonSamplingPolicy,
+						MinWeight:             10,
+						MaxWeight:             getUint32Ptr(90),
+						UpdateIntervalSeconds: getUint32Ptr(30),
+						PrometheusReward: &PrometheusReward{
+							RewardQuery: "sum(increase(reward_sum{model=\"{{.Candidate}}\"}[{{.Interval}}]))",
+							CountQuery:  "sum(increase(reward_count{model=\"{{.Candidate}}\"}[{{.Interval}}]))",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "rules",
+			proto: &scheduler.Experiment{
+				Name: "foo",
+				Candidates: []*scheduler.ExperimentCandidate{
+					{
+						Name:   "model1",
+						Weight: 100,
+					},
+					{
+						Name: "model2",
+					},
+				},
+				Config: &scheduler.ExperimentConfig{
+					Rules: []*scheduler.ExperimentRule{
+						{
+							Candidate: "model2",
+							Headers: []*scheduler.ExperimentHeaderMatch{
+								{Name: "x-tenant", Exact: getStrPtr("internal")},
+								{Name: "x-user", Hash: &scheduler.ExperimentHashRange{Start: 0, End: 10}},
+							},
+						},
+					},
+				},
+			},
+			experiment: &Experiment{
+				Name:         "foo",
+				Active:       false,
+				ResourceType: ModelResourceType,
+				Candidates: []*Candidate{
+					{
+						Name:   "model1",
+						Weight: 100,
+					},
+					{
+						Name: "model2",
+					},
+				},
+				Config: &Config{
+					Rules: []*Rule{
+						{
+							Candidate: "model2",
+							Headers: []*HeaderMatch{
+								{Name: "x-tenant", Exact: getStrPtr("internal")},
+								{Name: "x-user", Hash: &HashRange{Start: 0, End: 10}},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "pipeline",
 			proto: &scheduler.Experiment{
@@ -191,12 +305,117 @@ func TestLoadModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "mirror comparison",
+			proto: &scheduler.Experiment{
+				Name:    "foo",
+				Default: getStrPtr("model1"),
+				Candidates: []*scheduler.ExperimentCandidate{
+					{
+						Name:   "model1",
+						Weight: 100,
+					},
+				},
+				Mirror: &scheduler.ExperimentMirror{
+					Name:    "model2",
+					Percent: 10,
+					Comparison: &scheduler.ShadowComparison{
+						Outputs:   []string{"predict"},
+						Tolerance: 0.01,
+					},
+				},
+			},
+			experiment: &Experiment{
+				Name:         "foo",
+				Default:      getStrPtr("model1"),
+				ResourceType: ModelResourceType,
+				Candidates: []*Candidate{
+					{
+						Name:   "model1",
+						Weight: 100,
+					},
+				},
+				Mirror: &Mirror{
+					Name:    "model2",
+					Percent: 10,
+					Comparison: &ShadowComparison{
+						Outputs:   []string{"predict"},
+						Tolerance: 0.01,
+					},
+				},
+			},
+		},
+		{
+			name: "schedule",
+			proto: &scheduler.Experiment{
+				Name:    "foo",
+				Default: getStrPtr("model1"),
+				Candidates: []*scheduler.ExperimentCandidate{
+					{
+						Name:   "model1",
+						Weight: 50,
+					},
+					{
+						Name:   "model2",
+						Weight: 50,
+					},
+				},
+				Config: &scheduler.ExperimentConfig{
+					Schedule: &scheduler.ExperimentSchedule{
+						StartTime:          timestamppb.New(start),
+						EndTime:            timestamppb.New(end),
+						MaxDurationSeconds: getUint32Ptr(3600),
+						Promotion: &scheduler.ExperimentPromotion{
+							Query:         `latency{model="{{.Candidate}}"}`,
+							LowerIsBetter: true,
+						},
+					},
+				},
+				ScheduleState: &scheduler.ExperimentScheduleState{
+					StartedAt:         timestamppb.New(start),
+					ConcludedAt:       timestamppb.New(end),
+					PromotedCandidate: getStrPtr("model2"),
+				},
+			},
+			experiment: &Experiment{
+				Name:         "foo",
+				Default:      getStrPtr("model1"),
+				ResourceType: ModelResourceType,
+				Candidates: []*Candidate{
+					{
+						Name:   "model1",
+						Weight: 50,
+					},
+					{
+						Name:   "model2",
+						Weight: 50,
+					},
+				},
+				Config: &Config{
+					Schedule: &Schedule{
+						StartTime:          &start,
+						EndTime:            &end,
+						MaxDurationSeconds: getUint32Ptr(3600),
+						Promotion: &Promotion{
+							Query:         `latency{model="{{.Candidate}}"}`,
+							LowerIsBetter: true,
+						},
+					},
+				},
+				ScheduleState: &ScheduleState{
+					StartedAt:         &start,
+					ConcludedAt:       &end,
+					PromotedCandidate: getStrPtr("model2"),
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			experiment := CreateExperimentFromRequest(test.proto)
 			g.Expect(experiment).To(Equal(test.experiment))
+			g.Expect(proto.Equal(CreateExperimentProto(experiment), test.proto)).To(BeTrue())
 		})
 	}
 
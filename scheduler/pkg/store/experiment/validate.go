@@ -9,7 +9,16 @@ the Change License after the Change Date as each is defined in accordance with t
 
 package experiment
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"text/template"
+	"time"
+)
+
+// Lower case HTTP header names as matched by Envoy
+var ruleHeaderNameRegex = regexp.MustCompile(`^[a-z0-9!#$%&'*+.^_|~-]+$`)
 
 func (es *ExperimentStore) validateNoExistingDefault(experiment *Experiment) error {
 	if experiment.Default != nil {
@@ -69,6 +78,144 @@ func validateNoDuplicateNames(experiment *Experiment) error {
 	return nil
 }
 
+func validateBandit(experiment *Experiment) error {
+	bandit := experiment.GetBandit()
+	if bandit == nil {
+		return nil
+	}
+	invalid := func(format string, args ...interface{}) error {
+		return &ExperimentBanditInvalid{experimentName: experiment.Name, reason: fmt.Sprintf(format, args...)}
+	}
+	numCandidates := uint32(len(experiment.Candidates))
+	if numCandidates < 2 {
+		return invalid("at least two candidates are needed")
+	}
+	if epsilon := bandit.GetEpsilon(); epsilon < 0 || epsilon > 1 {
+		return invalid("epsilon %v is not in [0,1]", epsilon)
+	}
+	maxWeight := bandit.GetMaxWeight()
+	if maxWeight > banditTotalWeight || bandit.MinWeight > maxWeight {
+		return invalid("min weight %d and max weight %d must be in [0,%d] with min weight not above max weight", bandit.MinWeight, maxWeight, banditTotalWeight)
+	}
+	if bandit.MinWeight*numCandidates > banditTotalWeight || maxWeight*numCandidates < banditTotalWeight {
+		return invalid("weights of %d candidates between %d and %d can not add up to %d", numCandidates, bandit.MinWeight, maxWeight, banditTotalWeight)
+	}
+	if bandit.GetUpdateInterval() <= 0 {
+		return invalid("update interval must be positive")
+	}
+	if bandit.PrometheusReward != nil {
+		for _, query := range []string{bandit.PrometheusReward.RewardQuery, bandit.PrometheusReward.CountQuery} {
+			if query == "" {
+				return invalid("reward and count queries are needed for Prometheus rewards")
+			}
+			if _, err := template.New("reward").Parse(query); err != nil {
+				return invalid("failed to parse query %s: %s", query, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+func validateRules(experiment *Experiment) error {
+	invalid := func(format string, args ...interface{}) error {
+		return &ExperimentRuleInvalid{experimentName: experiment.Name, reason: fmt.Sprintf(format, args...)}
+	}
+	for idx, rule := range experiment.GetRules() {
+		found := false
+		for _, candidate := range experiment.Candidates {
+			if candidate.Name == rule.Candidate {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return invalid("rule %d candidate %s is not a candidate of the experiment", idx, rule.Candidate)
+		}
+		if len(rule.Headers) == 0 {
+			return invalid("rule %d has no headers to match", idx)
+		}
+		for _, header := range rule.Headers {
+			if !ruleHeaderNameRegex.MatchString(header.Name) {
+				return invalid("rule %d header name %q is not a lower case HTTP header name", idx, header.Name)
+			}
+			matchers := 0
+			for _, set := range []bool{header.Exact != nil, header.Prefix != nil, header.Regex != nil, header.Hash != nil} {
+				if set {
+					matchers++
+				}
+			}
+			if matchers != 1 {
+				return invalid("rule %d header %s must have exactly one of exact, prefix, regex or hash", idx, header.Name)
+			}
+			if header.Regex != nil {
+				if _, err := regexp.Compile(*header.Regex); err != nil {
+					return invalid("rule %d header %s regex %s: %s", idx, header.Name, *header.Regex, err.Error())
+				}
+			}
+			if header.Hash != nil && (header.Hash.Start >= header.Hash.End || header.Hash.End > hashBuckets) {
+				return invalid("rule %d header %s hash range [%d,%d) must be a non-empty range of the %d buckets", idx, header.Name, header.Hash.Start, header.Hash.End, hashBuckets)
+			}
+		}
+	}
+	return nil
+}
+
+func validateShadowComparison(experiment *Experiment) error {
+	if experiment.Mirror == nil || experiment.Mirror.Comparison == nil {
+		return nil
+	}
+	invalid := func(format string, args ...interface{}) error {
+		return &ExperimentShadowComparisonInvalid{experimentName: experiment.Name, reason: fmt.Sprintf(format, args...)}
+	}
+	comparison := experiment.Mirror.Comparison
+	if experiment.ResourceType != ModelResourceType {
+		return invalid("only the mirrors of model experiments can be compared")
+	}
+	if math.IsNaN(comparison.Tolerance) || comparison.Tolerance < 0 {
+		return invalid("tolerance %v must not be negative", comparison.Tolerance)
+	}
+	for _, output := range comparison.Outputs {
+		if output == "" {
+			return invalid("output names must not be empty")
+		}
+	}
+	return nil
+}
+
+func validateSchedule(experiment *Experiment) error {
+	schedule := experiment.GetSchedule()
+	if schedule == nil {
+		return nil
+	}
+	invalid := func(format string, args ...interface{}) error {
+		return &ExperimentScheduleInvalid{experimentName: experiment.Name, reason: fmt.Sprintf(format, args...)}
+	}
+	if schedule.StartTime == nil && schedule.EndTime == nil && schedule.MaxDurationSeconds == nil {
+		return invalid("at least one of start time, end time or max duration is needed")
+	}
+	if schedule.StartTime != nil && schedule.EndTime != nil && !schedule.EndTime.After(*schedule.StartTime) {
+		return invalid("end time %s must be after start time %s", schedule.EndTime.Format(time.RFC3339), schedule.StartTime.Format(time.RFC3339))
+	}
+	if schedule.MaxDurationSeconds != nil && *schedule.MaxDurationSeconds == 0 {
+		return invalid("max duration must be positive")
+	}
+	if promotion := schedule.Promotion; promotion != nil {
+		if schedule.EndTime == nil && schedule.MaxDurationSeconds == nil {
+			return invalid("an end time or max duration is needed to promote a candidate")
+		}
+		if len(experiment.Candidates) == 0 {
+			return invalid("a candidate is needed to promote")
+		}
+		if promotion.Query == "" {
+			return invalid("a query is needed to promote a candidate")
+		}
+		if _, err := template.New("promotion").Parse(promotion.Query); err != nil {
+			return invalid("failed to parse query %s: %s", promotion.Query, err.Error())
+		}
+	}
+	return nil
+}
+
 func (es *ExperimentStore) validate(experiment *Experiment) error {
 	if err := es.validateNoExistingDefault(experiment); err != nil {
 		return err
@@ -82,5 +229,17 @@ func (es *ExperimentStore) validate(experiment *Experiment) error {
 	if err := validateNoDuplicateNames(experiment); err != nil {
 		return err
 	}
+	if err := validateBandit(experiment); err != nil {
+		return err
+	}
+	if err := validateRules(experiment); err != nil {
+		return err
+	}
+	if err := validateShadowComparison(experiment); err != nil {
+		return err
+	}
+	if err := validateSchedule(experiment); err != nil {
+		return err
+	}
 	return nil
 }
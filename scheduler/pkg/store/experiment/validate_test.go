@@ -10,7 +10,11 @@ the Change License after the Change Date as each is defined in accordance with t
 package experiment
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 )
@@ -180,3 +184,344 @@ func TestValidateExperiment(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBandit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name          string
+		numCandidates int
+		bandit        *Bandit
+		err           bool
+	}
+
+	getUint32Ptr := func(val uint32) *uint32 { return &val }
+	getFloat32Ptr := func(val float32) *float32 { return &val }
+	tests := []test{
+		{
+			name:          "no bandit",
+			numCandidates: 1,
+		},
+		{
+			name:          "defaults",
+			numCandidates: 2,
+			bandit:        &Bandit{},
+		},
+		{
+			name:          "limits",
+			numCandidates: 3,
+			bandit:        &Bandit{Policy: ThompsonSamplingPolicy, MinWeight: 10, MaxWeight: getUint32Ptr(50)},
+		},
+		{
+			name:          "single candidate",
+			numCandidates: 1,
+			bandit:        &Bandit{},
+			err:           true,
+		},
+		{
+			name:          "epsilon above 1",
+			numCandidates: 2,
+			bandit:        &Bandit{Epsilon: getFloat32Ptr(1.5)},
+			err:           true,
+		},
+		{
+			name:          "min above max",
+			numCandidates: 2,
+			bandit:        &Bandit{MinWeight: 60, MaxWeight: getUint32Ptr(50)},
+			err:           true,
+		},
+		{
+			name:          "min weights above total",
+			numCandidates: 3,
+			bandit:        &Bandit{MinWeight: 40},
+			err:           true,
+		},
+		{
+			name:          "max weights below total",
+			numCandidates: 3,
+			bandit:        &Bandit{MaxWeight: getUint32Ptr(30)},
+			err:           true,
+		},
+		{
+			name:          "zero update interval",
+			numCandidates: 2,
+			bandit:        &Bandit{UpdateIntervalSeconds: getUint32Ptr(0)},
+			err:           true,
+		},
+		{
+			name:          "missing count query",
+			numCandidates: 2,
+			bandit:        &Bandit{PrometheusReward: &PrometheusReward{RewardQuery: "sum(reward)"}},
+			err:           true,
+		},
+		{
+			name:          "invalid query template",
+			numCandidates: 2,
+			bandit:        &Bandit{PrometheusReward: &PrometheusReward{RewardQuery: "sum(reward{model=\"{{.Candidate\"})", CountQuery: "sum(count)"}},
+			err:           true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			experiment := &Experiment{Name: "a", Config: &Config{Bandit: test.bandit}}
+			for i := 0; i < test.numCandidates; i++ {
+				experiment.Candidates = append(experiment.Candidates, &Candidate{Name: fmt.Sprintf("model%d", i)})
+			}
+			err := validateBandit(experiment)
+			if test.err {
+				g.Expect(errors.Is(err, &ExperimentBanditInvalid{})).To(BeTrue())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidateRules(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name  string
+		rules []*Rule
+		err   bool
+	}
+
+	getStrPtr := func(val string) *string { return &val }
+	tests := []test{
+		{
+			name: "no rules",
+		},
+		{
+			name: "header matches",
+			rules: []*Rule{
+				{Candidate: "model1", Headers: []*HeaderMatch{{Name: "x-tenant", Exact: getStrPtr("internal")}, {Name: "x-region", Prefix: getStrPtr("eu-")}}},
+				{Candidate: "model0", Headers: []*HeaderMatch{{Name: "x-cohort", Regex: getStrPtr("a|b")}}},
+				{Candidate: "model1", Headers: []*HeaderMatch{{Name: "x-user", Hash: &HashRange{Start: 90, End: 100}}}},
+			},
+		},
+		{
+			name:  "unknown candidate",
+			rules: []*Rule{{Candidate: "model2", Headers: []*HeaderMatch{{Name: "x-tenant", Exact: getStrPtr("internal")}}}},
+			err:   true,
+		},
+		{
+			name:  "no headers",
+			rules: []*Rule{{Candidate: "model1"}},
+			err:   true,
+		},
+		{
+			name:  "upper case header",
+			rules: []*Rule{{Candidate: "model1", Headers: []*HeaderMatch{{Name: "X-Tenant", Exact: getStrPtr("internal")}}}},
+			err:   true,
+		},
+		{
+			name:  "invalid header name",
+			rules: []*Rule{{Candidate: "model1", Headers: []*HeaderMatch{{Name: "x-\"tenant", Exact: getStrPtr("internal")}}}},
+			err:   true,
+		},
+		{
+			name:  "no matcher",
+			rules: []*Rule{{Candidate: "model1", Headers: []*HeaderMatch{{Name: "x-tenant"}}}},
+			err:   true,
+		},
+		{
+			name:  "two matchers",
+			rules: []*Rule{{Candidate: "model1", Headers: []*HeaderMatch{{Name: "x-tenant", Exact: getStrPtr("internal"), Prefix: getStrPtr("int")}}}},
+			err:   true,
+		},
+		{
+			name:  "invalid regex",
+			rules: []*Rule{{Candidate: "model1", Headers: []*HeaderMatch{{Name: "x-tenant", Regex: getStrPtr("(internal")}}}},
+			err:   true,
+		},
+		{
+			name:  "empty hash range",
+			rules: []*Rule{{Candidate: "model1", Headers: []*HeaderMatch{{Name: "x-user", Hash: &HashRange{Start: 10, End: 10}}}}},
+			err:   true,
+		},
+		{
+			name:  "hash range above buckets",
+			rules: []*Rule{{Candidate: "model1", Headers: []*HeaderMatch{{Name: "x-user", Hash: &HashRange{Start: 50, End: 101}}}}},
+			err:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			experiment := &Experiment{
+				Name:       "a",
+				Candidates: []*Candidate{{Name: "model0"}, {Name: "model1"}},
+				Config:     &Config{Rules: test.rules},
+			}
+			err := validateRules(experiment)
+			if test.err {
+				g.Expect(errors.Is(err, &ExperimentRuleInvalid{})).To(BeTrue())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidateShadowComparison(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name         string
+		resourceType ResourceType
+		comparison   *ShadowComparison
+		err          bool
+	}
+
+	tests := []test{
+		{
+			name:         "no comparison",
+			resourceType: PipelineResourceType,
+		},
+		{
+			name:         "all outputs",
+			resourceType: ModelResourceType,
+			comparison:   &ShadowComparison{},
+		},
+		{
+			name:         "outputs within tolerance",
+			resourceType: ModelResourceType,
+			comparison:   &ShadowComparison{Outputs: []string{"predict"}, Tolerance: 0.1},
+		},
+		{
+			name:         "pipeline mirror",
+			resourceType: PipelineResourceType,
+			comparison:   &ShadowComparison{},
+			err:          true,
+		},
+		{
+			name:         "negative tolerance",
+			resourceType: ModelResourceType,
+			comparison:   &ShadowComparison{Tolerance: -1},
+			err:          true,
+		},
+		{
+			name:         "NaN tolerance",
+			resourceType: ModelResourceType,
+			comparison:   &ShadowComparison{Tolerance: math.NaN()},
+			err:          true,
+		},
+		{
+			name:         "empty output",
+			resourceType: ModelResourceType,
+			comparison:   &ShadowComparison{Outputs: []string{""}},
+			err:          true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			experiment := &Experiment{
+				Name:         "a",
+				ResourceType: test.resourceType,
+				Candidates:   []*Candidate{{Name: "model1"}},
+				Mirror:       &Mirror{Name: "model2", Comparison: test.comparison},
+			}
+			err := validateShadowComparison(experiment)
+			if test.err {
+				g.Expect(errors.Is(err, &ExperimentShadowComparisonInvalid{})).To(BeTrue())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidateSchedule(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	getUint32Ptr := func(val uint32) *uint32 { return &val }
+	type test struct {
+		name     string
+		schedule *Schedule
+		err      bool
+	}
+
+	tests := []test{
+		{
+			name: "no schedule",
+		},
+		{
+			name:     "start time",
+			schedule: &Schedule{StartTime: &start},
+		},
+		{
+			name: "start and end time with promotion",
+			schedule: &Schedule{
+				StartTime: &start,
+				EndTime:   &end,
+				Promotion: &Promotion{Query: `latency{model="{{.Candidate}}"}`},
+			},
+		},
+		{
+			name: "max duration with promotion",
+			schedule: &Schedule{
+				MaxDurationSeconds: getUint32Ptr(60),
+				Promotion:          &Promotion{Query: `latency{model="{{.Candidate}}"}`},
+			},
+		},
+		{
+			name:     "empty schedule",
+			schedule: &Schedule{},
+			err:      true,
+		},
+		{
+			name:     "end before start",
+			schedule: &Schedule{StartTime: &end, EndTime: &start},
+			err:      true,
+		},
+		{
+			name:     "zero max duration",
+			schedule: &Schedule{MaxDurationSeconds: getUint32Ptr(0)},
+			err:      true,
+		},
+		{
+			name: "promotion without end",
+			schedule: &Schedule{
+				StartTime: &start,
+				Promotion: &Promotion{Query: `latency{model="{{.Candidate}}"}`},
+			},
+			err: true,
+		},
+		{
+			name: "promotion without query",
+			schedule: &Schedule{
+				EndTime:   &end,
+				Promotion: &Promotion{},
+			},
+			err: true,
+		},
+		{
+			name: "promotion with bad query",
+			schedule: &Schedule{
+				EndTime:   &end,
+				Promotion: &Promotion{Query: `latency{model="{{.Candidate}"}`},
+			},
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			experiment := &Experiment{
+				Name:       "a",
+				Candidates: []*Candidate{{Name: "model1"}, {Name: "model2"}},
+				Config:     &Config{Schedule: test.schedule},
+			}
+			err := validateSchedule(experiment)
+			if test.err {
+				g.Expect(errors.Is(err, &ExperimentScheduleInvalid{})).To(BeTrue())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
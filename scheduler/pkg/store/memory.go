@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
@@ -62,6 +63,7 @@ func (m *MemoryStore) GetModels() ([]*ModelSnapshot, error) {
 			Name:     name,
 			Deleted:  model.IsDeleted(),
 			Versions: model.versions,
+			Rollout:  model.getRollout(),
 		}
 		foundModels = append(foundModels, snapshot)
 	}
@@ -102,6 +104,9 @@ func (m *MemoryStore) addNextModelVersion(model *Model, pbmodel *pb.Model) {
 
 func (m *MemoryStore) UpdateModel(req *pb.LoadModelRequest) error {
 	logger := m.logger.WithField("func", "UpdateModel")
+	if err := checkModelTopicConfig(req.GetModel()); err != nil {
+		return err
+	}
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	modelName := req.GetModel().GetMeta().GetName()
@@ -129,6 +134,7 @@ func (m *MemoryStore) UpdateModel(req *pb.LoadModelRequest) error {
 		} else if meq.ModelSpecDiffers {
 			logger.Debugf("Model %s model spec differs - adding new version of model", modelName)
 			m.addNextModelVersion(model, req.GetModel())
+			model.startRollout()
 			return nil
 		} else if meq.DeploymentSpecDiffers {
 			logger.Debugf(
@@ -152,6 +158,7 @@ func (m *MemoryStore) getModelImpl(key string) *ModelSnapshot {
 			Name:     key,
 			Versions: model.versions, //TODO make a copy for safety?
 			Deleted:  model.IsDeleted(),
+			Rollout:  model.getRollout(),
 		}
 	} else {
 		return &ModelSnapshot{
@@ -441,6 +448,40 @@ func (m *MemoryStore) unloadVersionModelsImpl(modelKey string, version uint32) (
 	return nil, false, nil
 }
 
+func (m *MemoryStore) SetModelRollout(modelKey string, rollout *ModelRollout) error {
+	evt, err := m.setModelRolloutImpl(modelKey, rollout)
+	if err != nil {
+		return err
+	}
+	if m.eventHub != nil {
+		m.eventHub.PublishModelEvent(
+			modelUpdateEventSource,
+			*evt,
+		)
+	}
+	return nil
+}
+
+func (m *MemoryStore) setModelRolloutImpl(modelKey string, rollout *ModelRollout) (*coordinator.ModelEventMsg, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	model, ok := m.store.models[modelKey]
+	if !ok {
+		return nil, fmt.Errorf("failed to find model %s", modelKey)
+	}
+	if model.rollout == nil || model.rollout.Version != rollout.Version {
+		return nil, fmt.Errorf("no rollout of version %d for model %s", rollout.Version, modelKey)
+	}
+	updated := *rollout
+	updated.Timestamp = time.Now()
+	model.rollout = &updated
+	return &coordinator.ModelEventMsg{
+		ModelName:    modelKey,
+		ModelVersion: rollout.Version,
+	}, nil
+}
+
 func (m *MemoryStore) UpdateModelState(
 	modelKey string,
 	version uint32,
@@ -194,6 +194,76 @@ func TestUpdateModel(t *testing.T) {
 	}
 }
 
+func TestUpdateModelTopicConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	getUint32Ptr := func(val uint32) *uint32 { return &val }
+	getInt64Ptr := func(val int64) *int64 { return &val }
+	getStringPtr := func(val string) *string { return &val }
+	type test struct {
+		name        string
+		topicConfig *pb.KafkaTopicConfig
+		reason      string
+	}
+
+	tests := []test{
+		{
+			name: "valid",
+			topicConfig: &pb.KafkaTopicConfig{
+				Partitions:      getUint32Ptr(4),
+				RetentionMs:     getInt64Ptr(-1),
+				CleanupPolicy:   getStringPtr("compact,delete"),
+				CompressionType: getStringPtr("zstd"),
+			},
+		},
+		{
+			name:        "zero partitions",
+			topicConfig: &pb.KafkaTopicConfig{Partitions: getUint32Ptr(0)},
+			reason:      TopicPartitionsReason,
+		},
+		{
+			name:        "zero replication factor",
+			topicConfig: &pb.KafkaTopicConfig{ReplicationFactor: getUint32Ptr(0)},
+			reason:      TopicReplicationFactorReason,
+		},
+		{
+			name:        "negative retention",
+			topicConfig: &pb.KafkaTopicConfig{RetentionMs: getInt64Ptr(-2)},
+			reason:      TopicRetentionReason,
+		},
+		{
+			name:        "unknown cleanup policy",
+			topicConfig: &pb.KafkaTopicConfig{CleanupPolicy: getStringPtr("forever")},
+			reason:      TopicCleanupPolicyReason,
+		},
+		{
+			name:        "unknown compression type",
+			topicConfig: &pb.KafkaTopicConfig{CompressionType: getStringPtr("brotli")},
+			reason:      TopicCompressionTypeReason,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store := NewLocalSchedulerStore()
+			ms := NewMemoryStore(log.New(), store, nil)
+			err := ms.UpdateModel(&pb.LoadModelRequest{
+				Model: &pb.Model{
+					Meta:       &pb.MetaData{Name: "model"},
+					StreamSpec: &pb.StreamSpec{TopicConfig: test.topicConfig},
+				},
+			})
+			if test.reason == "" {
+				g.Expect(err).To(BeNil())
+				g.Expect(store.models).To(HaveKey("model"))
+			} else {
+				g.Expect(err).To(Equal(&ModelTopicConfigErr{model: "model", reason: test.reason}))
+				g.Expect(store.models).ToNot(HaveKey("model"))
+			}
+		})
+	}
+}
+
 func TestGetModel(t *testing.T) {
 	g := NewGomegaWithT(t)
 
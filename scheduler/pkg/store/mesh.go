@@ -39,6 +39,7 @@ func NewLocalSchedulerStore() *LocalSchedulerStore {
 type Model struct {
 	versions []*ModelVersion
 	deleted  atomic.Bool
+	rollout  *ModelRollout
 }
 
 type ModelVersionID struct {
@@ -53,6 +53,11 @@ type PipelineNotFoundErr struct {
 	pipeline string
 }
 
+func (pnf *PipelineNotFoundErr) Is(tgt error) bool {
+	_, ok := tgt.(*PipelineNotFoundErr)
+	return ok
+}
+
 func (pnf *PipelineNotFoundErr) Error() string {
 	return fmt.Sprintf("pipeline %s not found", pnf.pipeline)
 }
@@ -195,3 +200,94 @@ type PipelineInputErr struct {
 func (pie *PipelineInputErr) Error() string {
 	return fmt.Sprintf("pipeline %s input %s is invalid. %s", pie.pipeline, pie.input, pie.reason)
 }
+
+type PipelineStepTransformErr struct {
+	pipeline  string
+	step      string
+	transform string
+	reason    string
+}
+
+func (pst *PipelineStepTransformErr) Error() string {
+	if pst.transform == "" {
+		return fmt.Sprintf("pipeline %s step %s transforms are invalid. %s", pst.pipeline, pst.step, pst.reason)
+	}
+	return fmt.Sprintf("pipeline %s step %s transform %s is invalid. %s", pst.pipeline, pst.step, pst.transform, pst.reason)
+}
+
+type ModelNameTransformStepErr struct {
+	model    string
+	pipeline string
+}
+
+func (mnt *ModelNameTransformStepErr) Error() string {
+	return fmt.Sprintf("model %s has the name of a transform step of pipeline %s, which writes to the outputs topic of the model", mnt.model, mnt.pipeline)
+}
+
+type PipelineStepForEachErr struct {
+	pipeline string
+	step     string
+	reason   string
+}
+
+func (psf *PipelineStepForEachErr) Error() string {
+	return fmt.Sprintf("pipeline %s step %s forEach is invalid. %s", psf.pipeline, psf.step, psf.reason)
+}
+
+type PipelineJoinPartitionsErr struct {
+	pipeline string
+	join     string
+}
+
+func (pjp *PipelineJoinPartitionsErr) Error() string {
+	return fmt.Sprintf("pipeline %s %s joins topics with different numbers of partitions. "+
+		"The models and pipelines it reads from must have the same topicConfig partitions", pjp.pipeline, pjp.join)
+}
+
+type PipelineCanaryErr struct {
+	pipeline string
+	reason   string
+}
+
+func (pce *PipelineCanaryErr) Error() string {
+	return fmt.Sprintf("pipeline %s canary is invalid. %s", pce.pipeline, pce.reason)
+}
+
+type PipelineSchemaErr struct {
+	pipeline string
+	tensor   string
+	reason   string
+}
+
+func (pse *PipelineSchemaErr) Error() string {
+	return fmt.Sprintf("pipeline %s schema tensor %s is invalid. %s", pse.pipeline, pse.tensor, pse.reason)
+}
+
+type PipelineTopicConfigErr struct {
+	pipeline string
+	reason   string
+}
+
+func (ptc *PipelineTopicConfigErr) Error() string {
+	return fmt.Sprintf("pipeline %s topic config is invalid. %s", ptc.pipeline, ptc.reason)
+}
+
+type PipelineTrafficPolicyErr struct {
+	pipeline string
+	reason   string
+}
+
+func (ptp *PipelineTrafficPolicyErr) Error() string {
+	return fmt.Sprintf("pipeline %s traffic policy is invalid. %s", ptp.pipeline, ptp.reason)
+}
+
+type PipelineKafkaTopicErr struct {
+	pipeline string
+	kind     string
+	topic    string
+	reason   string
+}
+
+func (pkt *PipelineKafkaTopicErr) Error() string {
+	return fmt.Sprintf("pipeline %s kafka %s %s is invalid. %s", pkt.pipeline, pkt.kind, pkt.topic, pkt.reason)
+}
@@ -19,6 +19,9 @@ type Pipeline struct {
 	LastVersion uint32
 	Versions    []*PipelineVersion
 	Deleted     bool
+	Canary      *PipelineCanary
+	// StableVersion is the version serving traffic in place of the last one after its canary was rolled back, 0 otherwise
+	StableVersion uint32
 }
 
 func (p *Pipeline) GetPipelineVersion(versionNumber uint32) *PipelineVersion {
@@ -30,18 +33,50 @@ func (p *Pipeline) GetPipelineVersion(versionNumber uint32) *PipelineVersion {
 	return nil
 }
 
+// GetLatestPipelineVersion returns the current version of the pipeline, which is the last version added
+// unless its canary was rolled back
 func (p *Pipeline) GetLatestPipelineVersion() *PipelineVersion {
+	if p.StableVersion != 0 {
+		if pv := p.GetPipelineVersion(p.StableVersion); pv != nil {
+			return pv
+		}
+	}
 	if len(p.Versions) > 0 {
 		return p.Versions[len(p.Versions)-1]
 	}
 	return nil
 }
 
+// GetPreviousPipelineVersion returns the version serving traffic before the last one was added. Versions being
+// terminated are skipped, so after a rolled back canary this is the stable version rather than the canary.
 func (p *Pipeline) GetPreviousPipelineVersion() *PipelineVersion {
-	if len(p.Versions) > 1 {
-		return p.Versions[len(p.Versions)-2]
+	if len(p.Versions) < 2 {
+		return nil
 	}
-	return nil
+	for idx := len(p.Versions) - 2; idx >= 0; idx-- {
+		pv := p.Versions[idx]
+		if pv.State != nil {
+			switch pv.State.Status {
+			case PipelineTerminate, PipelineTerminating, PipelineTerminated:
+				continue
+			}
+		}
+		return pv
+	}
+	return p.Versions[len(p.Versions)-2]
+}
+
+// GetStablePipelineVersion returns the version kept serving the remaining traffic while the latest version is a canary
+func (p *Pipeline) GetStablePipelineVersion() *PipelineVersion {
+	if p.Canary == nil {
+		return nil
+	}
+	return p.GetPipelineVersion(p.Canary.StableVersion)
+}
+
+type PipelineCanary struct {
+	StableVersion  uint32
+	TrafficPercent uint32
 }
 
 type PipelineVersion struct {
@@ -53,12 +88,102 @@ type PipelineVersion struct {
 	State          *PipelineState
 	Output         *PipelineOutput
 	KubernetesMeta *KubernetesMeta
+	Schema         *PipelineSchema
+	TopicConfig    *TopicConfig
+	TrafficPolicy  *TrafficPolicy
+	Authorization  *AuthorizationPolicy
 }
 
 func (pv *PipelineVersion) String() string {
 	return fmt.Sprintf("%s:%d (%s)", pv.Name, pv.Version, pv.UID)
 }
 
+// PipelineSchema lists the tensors the pipeline gateway expects in the pipeline requests and responses
+type PipelineSchema struct {
+	Inputs  []*TensorSchema
+	Outputs []*TensorSchema
+}
+
+type TensorSchema struct {
+	Name     string
+	Datatype string  // empty for any datatype
+	Shape    []int64 // -1 for a dimension of any size, empty for any shape
+}
+
+// TopicConfig holds Kafka settings for the pipeline input and output topics. Nil fields use the global defaults.
+type TopicConfig struct {
+	Partitions        *uint32
+	ReplicationFactor *uint32
+	RetentionMs       *int64
+	CleanupPolicy     *string
+	CompressionType   *string
+}
+
+// GetPartitions returns the partitions set for the topics, or 0 for the default of the installation
+func (tc *TopicConfig) GetPartitions() uint32 {
+	if tc == nil || tc.Partitions == nil {
+		return 0
+	}
+	return *tc.Partitions
+}
+
+// TrafficPolicy holds the Envoy settings for requests to the pipeline. Nil fields keep the Envoy defaults.
+type TrafficPolicy struct {
+	TimeoutMs        *uint32
+	Retry            *RetryPolicy
+	CircuitBreaker   *CircuitBreaker
+	OutlierDetection *OutlierDetection
+	RateLimit        *RouteRateLimit
+}
+
+type RetryPolicy struct {
+	RetryOn         string
+	NumRetries      uint32
+	PerTryTimeoutMs *uint32
+}
+
+type CircuitBreaker struct {
+	MaxConnections     *uint32
+	MaxPendingRequests *uint32
+}
+
+type OutlierDetection struct {
+	ConsecutiveErrors  uint32
+	IntervalMs         *uint32
+	BaseEjectionTimeMs *uint32
+	MaxEjectionPercent *uint32
+}
+
+type RateLimitUnit uint32
+
+const (
+	RateLimitUnitSecond RateLimitUnit = iota
+	RateLimitUnitMinute
+	RateLimitUnitHour
+)
+
+// RouteRateLimit limits requests to the pipeline route, overall and per caller identified by a header
+type RouteRateLimit struct {
+	Unit     RateLimitUnit
+	Requests *uint32
+	Burst    *uint32
+	Header   *string
+	Quotas   []*RateLimitQuota
+	Global   bool
+}
+
+type RateLimitQuota struct {
+	Value    string
+	Requests uint32
+	Burst    *uint32
+}
+
+// AuthorizationPolicy lists the subjects and scopes of callers allowed to send requests to the pipeline
+type AuthorizationPolicy struct {
+	Subjects []string
+	Scopes   []string
+}
+
 type KubernetesMeta struct {
 	Namespace  string
 	Generation int64
@@ -111,19 +236,90 @@ type PipelineStep struct {
 	InputsJoinType   JoinType
 	TriggersJoinType JoinType
 	Batch            *Batch
+	Transforms       []*Transform
+	ForEach          *ForEach
 	Available        bool
 }
 
+// IsTransform returns true if the step runs built-in tensor transforms in the dataflow engine rather than calling a model
+func (ps *PipelineStep) IsTransform() bool {
+	return ps != nil && len(ps.Transforms) > 0
+}
+
 type Batch struct {
 	Size     *uint32
 	WindowMs *uint32
 }
 
+type ForEach struct {
+	Tensor          string
+	Axis            uint32
+	Parallelism     *uint32
+	GatherTimeoutMs *uint32
+}
+
+type TransformType uint32
+
+const (
+	TransformConcat TransformType = iota
+	TransformSplit
+	TransformSelect
+	TransformCast
+	TransformReshape
+	TransformConstant
+	TransformJsonPath
+)
+
+var transformTypeNames = [...]string{"concat", "split", "select", "cast", "reshape", "constant", "jsonPath"}
+
+func (tt TransformType) String() string {
+	if int(tt) >= len(transformTypeNames) {
+		return fmt.Sprintf("unknown(%d)", uint32(tt))
+	}
+	return transformTypeNames[tt]
+}
+
+type Transform struct {
+	Type     TransformType
+	Tensors  []string
+	Outputs  []string
+	Axis     uint32
+	Datatype string
+	Shape    []int64
+	Values   []string
+	Path     string
+}
+
+type KafkaFormat uint32
+
+const (
+	KafkaFormatJson KafkaFormat = iota
+	KafkaFormatCsv
+	KafkaFormatBytes
+)
+
+var kafkaFormatNames = [...]string{"json", "csv", "bytes"}
+
+func (kf KafkaFormat) String() string {
+	if int(kf) >= len(kafkaFormatNames) {
+		return fmt.Sprintf("unknown(%d)", uint32(kf))
+	}
+	return kafkaFormatNames[kf]
+}
+
+// KafkaTopic is an existing topic outside Seldon used as a pipeline source or sink
+type KafkaTopic struct {
+	Topic   string
+	Format  KafkaFormat
+	Tensors []*TensorSchema
+}
+
 type PipelineOutput struct {
 	Steps         []string
 	JoinWindowMs  uint32
 	StepsJoinType JoinType
 	TensorMap     map[string]string
+	KafkaSinks    []*KafkaTopic
 }
 
 type PipelineInput struct {
@@ -133,4 +329,5 @@ type PipelineInput struct {
 	JoinWindowMs     *uint32
 	InputsJoinType   JoinType
 	TriggersJoinType JoinType
+	KafkaSources     []*KafkaTopic
 }
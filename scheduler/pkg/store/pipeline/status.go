@@ -14,6 +14,8 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/coordinator"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
 )
@@ -41,6 +43,39 @@ func (ms *ModelStatusHandler) addPipelineModelStatus(pipeline *Pipeline) error {
 	return nil
 }
 
+// Transform steps write to the outputs topic of the step name, so they can not share the name of a model
+func (ms *ModelStatusHandler) checkTransformStepNames(req *scheduler.Pipeline) error {
+	if ms.store == nil {
+		return nil
+	}
+	for _, step := range req.GetSteps() {
+		if len(step.GetTransforms()) == 0 {
+			continue
+		}
+		model, err := ms.store.GetModel(step.GetName())
+		if err != nil {
+			return err
+		}
+		if model != nil && model.GetLatest() != nil {
+			return &PipelineStepTransformErr{req.GetName(), step.GetName(), "", transformModelNameReason}
+		}
+	}
+	return nil
+}
+
+// getModelTopicPartitions returns the partitions set for the topics of a model, or 0 if the model uses the default of
+// the installation or is not loaded yet
+func (ms *ModelStatusHandler) getModelTopicPartitions(modelName string) uint32 {
+	if ms.store == nil {
+		return 0
+	}
+	model, err := ms.store.GetModel(modelName)
+	if err != nil || model == nil || model.GetLatest() == nil {
+		return 0
+	}
+	return model.GetLatest().GetModel().GetStreamSpec().GetTopicConfig().GetPartitions()
+}
+
 // Change a pipeline model readiness based on a new model status
 // 1. if model is not ready pipeline can't be
 // 2. if model is ready then check all models are ready
@@ -67,6 +102,11 @@ func (ms *ModelStatusHandler) setPipelineModelsReady(pipelineVersion *PipelineVe
 	modelsReady := true
 	if pipelineVersion != nil && ms.store != nil {
 		for stepName, step := range pipelineVersion.Steps {
+			// Transform steps run inside the dataflow engine so have no model to wait for
+			if step.IsTransform() {
+				step.Available = true
+				continue
+			}
 			model, err := ms.store.GetModel(stepName)
 			if err != nil {
 				return err
@@ -119,7 +159,10 @@ func updatePipelinesFromModelAvailability(references map[string]void, modelName
 
 func (ms *ModelStatusHandler) removeModelReferences(pv *PipelineVersion) {
 	if pv != nil {
-		for step := range pv.Steps {
+		for step, pstep := range pv.Steps {
+			if pstep.IsTransform() {
+				continue
+			}
 			if members, ok := ms.modelReferences[step]; ok {
 				delete(members, pv.Name)
 				if len(members) == 0 {
@@ -138,7 +181,10 @@ func (ms *ModelStatusHandler) addModelReferences(pipeline *Pipeline) {
 		ms.removeModelReferences(pipeline.GetPreviousPipelineVersion())
 		latestVersion := pipeline.GetLatestPipelineVersion()
 		if latestVersion != nil {
-			for step := range latestVersion.Steps {
+			for step, pstep := range latestVersion.Steps {
+				if pstep.IsTransform() {
+					continue
+				}
 				if members, ok := ms.modelReferences[step]; !ok {
 					members = make(map[string]void)
 					members[pipeline.Name] = member
@@ -72,6 +72,10 @@ func (f fakeModelStore) UnloadVersionModels(modelKey string, version uint32) (bo
 	panic("implement me")
 }
 
+func (f fakeModelStore) SetModelRollout(modelKey string, rollout *store.ModelRollout) error {
+	panic("implement me")
+}
+
 func (f fakeModelStore) UpdateModelState(modelKey string, version uint32, serverKey string, replicaIdx int, availableMemory *uint64, expectedState, desiredState store.ModelReplicaState, reason string) error {
 	panic("implement me")
 }
@@ -263,6 +267,32 @@ func TestAddModelReferences(t *testing.T) {
 				"model1": 1,
 			},
 		},
+		{
+			name: "transform steps are not model references",
+			modelStatusHandler: &ModelStatusHandler{
+				logger:          logrus.New(),
+				modelReferences: map[string]map[string]void{},
+			},
+			pipeline: &Pipeline{
+				Name: "test",
+				Versions: []*PipelineVersion{
+					{
+						Name: "test",
+						Steps: map[string]*PipelineStep{
+							"model1": nil,
+							"transform1": {
+								Name:       "transform1",
+								Transforms: []*Transform{{Type: TransformSelect, Tensors: []string{"t1"}}},
+							},
+						},
+					},
+				},
+			},
+			expectedPipelineCounts: map[string]int{
+				"model1":     1,
+				"transform1": 0,
+			},
+		},
 		{
 			name: "new reference to model that already has one",
 			modelStatusHandler: &ModelStatusHandler{
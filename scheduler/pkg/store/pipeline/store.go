@@ -12,6 +12,7 @@ package pipeline
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/mitchellh/copystructure"
@@ -33,6 +34,13 @@ const (
 	modelEventHandlerName                 = "pipeline.store.models"
 )
 
+const (
+	updateCanaryPipelineEventSource = "pipeline.store.updatecanary"
+	canaryTrafficReason             = "traffic percent must be between 1 and 99"
+	canaryNotFoundReason            = "no canary version is active"
+	canaryNotReadyReason            = "canary version must be ready to be promoted"
+)
+
 type PipelineHandler interface {
 	AddPipeline(pipeline *scheduler.Pipeline) error
 	RemovePipeline(name string) error
@@ -41,6 +49,8 @@ type PipelineHandler interface {
 	GetPipelines() ([]*Pipeline, error)
 	SetPipelineState(name string, version uint32, uid string, state PipelineStatus, reason string, source string) error
 	GetAllRunningPipelineVersions() []coordinator.PipelineEventMsg
+	UpdatePipelineCanary(name string, trafficPercent uint32) error
+	CheckModelName(modelName string) error
 }
 
 type PipelineStore struct {
@@ -50,6 +60,8 @@ type PipelineStore struct {
 	pipelines          map[string]*Pipeline
 	db                 *PipelineDBManager
 	modelStatusHandler ModelStatusHandler
+	// Prefix of the topics of Seldon in the namespace, which can not be pipeline Kafka sources or sinks
+	internalTopicPrefix string
 }
 
 func NewPipelineStore(logger logrus.FieldLogger, eventHub *coordinator.EventHub, store store.ModelStore) *PipelineStore {
@@ -110,17 +122,29 @@ func (ps *PipelineStore) restorePipeline(pipeline *Pipeline) {
 	}
 	ps.pipelines[pipeline.Name] = pipeline
 	ps.mu.Unlock()
-	pv := pipeline.GetLatestPipelineVersion()
 	if ps.eventHub != nil {
-		ps.eventHub.PublishPipelineEvent(addPipelineEventSource, coordinator.PipelineEventMsg{
-			PipelineName:    pv.Name,
-			PipelineVersion: pv.Version,
-			UID:             pv.UID,
-		})
+		for _, pv := range []*PipelineVersion{pipeline.GetStablePipelineVersion(), pipeline.GetLatestPipelineVersion()} {
+			if pv == nil {
+				continue
+			}
+			ps.eventHub.PublishPipelineEvent(addPipelineEventSource, coordinator.PipelineEventMsg{
+				PipelineName:    pv.Name,
+				PipelineVersion: pv.Version,
+				UID:             pv.UID,
+			})
+		}
 	}
 }
 
-func validateAndAddPipelineVersion(req *scheduler.Pipeline, pipeline *Pipeline) error {
+// SetInternalTopicPrefix rejects pipelines with Kafka sources or sinks starting with the prefix of the topics of Seldon
+func (ps *PipelineStore) SetInternalTopicPrefix(prefix string) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.internalTopicPrefix = prefix
+}
+
+// validateAndAddPipelineVersion must be called with the lock held
+func (ps *PipelineStore) validateAndAddPipelineVersion(req *scheduler.Pipeline, pipeline *Pipeline) error {
 	pv, err := CreatePipelineVersionFromProto(req)
 	if err != nil {
 		return err
@@ -130,12 +154,56 @@ func validateAndAddPipelineVersion(req *scheduler.Pipeline, pipeline *Pipeline)
 	if err != nil {
 		return err
 	}
+	err = checkPipelineKafkaTopicsNotInternal(pv, ps.internalTopicPrefix)
+	if err != nil {
+		return err
+	}
+	err = checkJoinedTopicPartitions(pv, func(input string) uint32 { return ps.getTopicPartitions(pv, input) })
+	if err != nil {
+		return err
+	}
 	pv.State.setState(PipelineCreate, "")
 	pipeline.LastVersion = pipeline.LastVersion + 1
 	pipeline.Versions = append(pipeline.Versions, pv)
+	pipeline.StableVersion = 0
 	return nil
 }
 
+// getTopicPartitions returns the partitions set for the topic of an input of a pipeline version, or 0 for the default
+// of the installation. Topics of models are read from the model store and topics of other pipelines from their
+// latest version. It must be called with the lock held.
+func (ps *PipelineStore) getTopicPartitions(pv *PipelineVersion, input string) uint32 {
+	parts := strings.Split(input, StepNameSeperator)
+	if parts[0] == pv.Name {
+		return pv.TopicConfig.GetPartitions()
+	}
+	if step, ok := pv.Steps[parts[0]]; ok {
+		return ps.getStepTopicPartitions(step)
+	}
+	// an input of another pipeline, <pipelineName>.(inputs|outputs) or <pipelineName>.step.<stepName>.(inputs|outputs)
+	other, ok := ps.pipelines[parts[0]]
+	if !ok || other.GetLatestPipelineVersion() == nil {
+		return 0
+	}
+	otherPv := other.GetLatestPipelineVersion()
+	if len(parts) > 2 && parts[1] == PipelineStepSpecifier {
+		if step, ok := otherPv.Steps[parts[2]]; ok {
+			return ps.getStepTopicPartitions(step)
+		}
+		return ps.modelStatusHandler.getModelTopicPartitions(parts[2])
+	}
+	return otherPv.TopicConfig.GetPartitions()
+}
+
+// getStepTopicPartitions returns the partitions of the topics of the model of a step. Transform steps are run by the
+// dataflow engine, which creates their topics with the default partitions.
+func (ps *PipelineStore) getStepTopicPartitions(step *PipelineStep) uint32 {
+	if step.IsTransform() {
+		return 0
+	}
+	return ps.modelStatusHandler.getModelTopicPartitions(step.Name)
+}
+
 func (ps *PipelineStore) AddPipeline(req *scheduler.Pipeline) error {
 	evt, err := ps.addPipelineImpl(req)
 	if err != nil {
@@ -180,10 +248,18 @@ func (ps *PipelineStore) addPipelineImpl(req *scheduler.Pipeline) (*coordinator.
 			}
 		}
 	}
-	err := validateAndAddPipelineVersion(req, pipeline)
+	if req.Canary != nil && (req.Canary.TrafficPercent == 0 || req.Canary.TrafficPercent >= 100) {
+		return nil, &PipelineCanaryErr{pipeline: req.Name, reason: canaryTrafficReason}
+	}
+	err := ps.modelStatusHandler.checkTransformStepNames(req)
 	if err != nil {
 		return nil, err
 	}
+	err = ps.validateAndAddPipelineVersion(req, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	setPipelineCanary(pipeline, req.Canary)
 	err = ps.modelStatusHandler.addPipelineModelStatus(pipeline)
 	if err != nil {
 		return nil, err
@@ -203,20 +279,44 @@ func (ps *PipelineStore) addPipelineImpl(req *scheduler.Pipeline) (*coordinator.
 	}, nil
 }
 
+// setPipelineCanary keeps the current ready version serving alongside a newly added canary version.
+// A canary replacing an active canary keeps the original stable version. With no ready version to split
+// traffic with the canary is ignored and the new version replaces the old one as usual.
+func setPipelineCanary(pipeline *Pipeline, canary *scheduler.PipelineCanary) {
+	if canary == nil {
+		pipeline.Canary = nil
+		return
+	}
+	stable := pipeline.GetStablePipelineVersion()
+	if stable == nil || stable.State.Status != PipelineReady {
+		stable = pipeline.GetPreviousPipelineVersion()
+	}
+	if stable == nil || stable.State.Status != PipelineReady {
+		pipeline.Canary = nil
+		return
+	}
+	pipeline.Canary = &PipelineCanary{
+		StableVersion:  stable.Version,
+		TrafficPercent: canary.TrafficPercent,
+	}
+}
+
 func (ps *PipelineStore) RemovePipeline(name string) error {
 	logger := ps.logger.WithField("func", "RemovePipeline")
 	logger.Debugf("Attempt to remove pipeline %s", name)
-	evt, err := ps.removePipelineImpl(name)
+	evts, err := ps.removePipelineImpl(name)
 	if err != nil {
 		return err
 	}
-	if ps.eventHub != nil && evt != nil {
-		ps.eventHub.PublishPipelineEvent(removePipelineEventSource, *evt)
+	if ps.eventHub != nil {
+		for _, evt := range evts {
+			ps.eventHub.PublishPipelineEvent(removePipelineEventSource, *evt)
+		}
 	}
 	return nil
 }
 
-func (ps *PipelineStore) removePipelineImpl(name string) (*coordinator.PipelineEventMsg, error) {
+func (ps *PipelineStore) removePipelineImpl(name string) ([]*coordinator.PipelineEventMsg, error) {
 	ps.mu.Lock()
 	defer ps.mu.Unlock()
 	if pipeline, ok := ps.pipelines[name]; ok {
@@ -232,16 +332,25 @@ func (ps *PipelineStore) removePipelineImpl(name string) (*coordinator.PipelineE
 			return nil, &PipelineAlreadyTerminatedErr{pipeline: name}
 		default:
 			pipeline.Deleted = true
-			lastPipelineVersion.State.setState(PipelineTerminate, "pipeline removed")
+			terminated := []*PipelineVersion{lastPipelineVersion}
+			if stable := pipeline.GetStablePipelineVersion(); stable != nil {
+				terminated = append(terminated, stable)
+				pipeline.Canary = nil
+			}
+			var evts []*coordinator.PipelineEventMsg
+			for _, pv := range terminated {
+				pv.State.setState(PipelineTerminate, "pipeline removed")
+				evts = append(evts, &coordinator.PipelineEventMsg{
+					PipelineName:    pv.Name,
+					PipelineVersion: pv.Version,
+					UID:             pv.UID,
+				})
+			}
 			if err := ps.db.save(pipeline); err != nil {
 				ps.logger.WithError(err).Errorf("Failed to save pipeline %s", name)
 				return nil, err
 			}
-			return &coordinator.PipelineEventMsg{
-				PipelineName:    lastPipelineVersion.Name,
-				PipelineVersion: lastPipelineVersion.Version,
-				UID:             lastPipelineVersion.UID,
-			}, nil
+			return evts, nil
 		}
 	} else {
 		return nil, &PipelineNotFoundErr{pipeline: name}
@@ -284,20 +393,42 @@ func (ps *PipelineStore) GetPipeline(name string) (*Pipeline, error) {
 	}
 }
 
+// CheckModelName returns an error if a pipeline has a transform step with the name of the model, as the transforms
+// write to the outputs topic of the step name, which the model would share
+func (ps *PipelineStore) CheckModelName(modelName string) error {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+	for _, p := range ps.pipelines {
+		for _, pv := range []*PipelineVersion{p.GetStablePipelineVersion(), p.GetLatestPipelineVersion()} {
+			if pv == nil || pv.State.Status == PipelineTerminated {
+				continue
+			}
+			if step, ok := pv.Steps[modelName]; ok && step.IsTransform() {
+				return &ModelNameTransformStepErr{model: modelName, pipeline: pv.Name}
+			}
+		}
+	}
+	return nil
+}
+
 func (ps *PipelineStore) GetAllRunningPipelineVersions() []coordinator.PipelineEventMsg {
 	ps.mu.RLock()
 	defer ps.mu.RUnlock()
 	var events []coordinator.PipelineEventMsg
 	for _, p := range ps.pipelines {
-		pv := p.GetLatestPipelineVersion()
-		switch pv.State.Status {
-		// we consider PipelineTerminating as running as it is still active
-		case PipelineCreate, PipelineCreating, PipelineReady, PipelineTerminating:
-			events = append(events, coordinator.PipelineEventMsg{
-				PipelineName:    pv.Name,
-				PipelineVersion: pv.Version,
-				UID:             pv.UID,
-			})
+		for _, pv := range []*PipelineVersion{p.GetStablePipelineVersion(), p.GetLatestPipelineVersion()} {
+			if pv == nil {
+				continue
+			}
+			switch pv.State.Status {
+			// we consider PipelineTerminating as running as it is still active
+			case PipelineCreate, PipelineCreating, PipelineReady, PipelineTerminating:
+				events = append(events, coordinator.PipelineEventMsg{
+					PipelineName:    pv.Name,
+					PipelineVersion: pv.Version,
+					UID:             pv.UID,
+				})
+			}
 		}
 	}
 	return events
@@ -321,8 +452,9 @@ func (ps *PipelineStore) GetPipelines() ([]*Pipeline, error) {
 
 func (ps *PipelineStore) terminateOldUnterminatedPipelinesIfNeeded(pipeline *Pipeline) []*coordinator.PipelineEventMsg {
 	var evts []*coordinator.PipelineEventMsg
+	latest := pipeline.GetLatestPipelineVersion()
 	for _, pv := range pipeline.Versions {
-		if pv.Version != pipeline.LastVersion {
+		if pv != latest && (pipeline.Canary == nil || pv.Version != pipeline.Canary.StableVersion) {
 			switch pv.State.Status {
 			case PipelineTerminating, PipelineTerminate, PipelineTerminated:
 				continue
@@ -394,6 +526,74 @@ func (ps *PipelineStore) setPipelineStateImpl(name string, versionNumber uint32,
 	}
 }
 
+// UpdatePipelineCanary changes the traffic sent to an active canary version.
+// 100 promotes the canary and terminates the stable version, 0 rolls back by terminating the canary.
+func (ps *PipelineStore) UpdatePipelineCanary(name string, trafficPercent uint32) error {
+	logger := ps.logger.WithField("func", "UpdatePipelineCanary")
+	logger.Debugf("Attempt to update canary on pipeline %s to %d%%", name, trafficPercent)
+	evts, err := ps.updatePipelineCanaryImpl(name, trafficPercent)
+	if err != nil {
+		return err
+	}
+	if ps.eventHub != nil {
+		for _, evt := range evts {
+			ps.eventHub.PublishPipelineEvent(updateCanaryPipelineEventSource, *evt)
+		}
+	}
+	return nil
+}
+
+func (ps *PipelineStore) updatePipelineCanaryImpl(name string, trafficPercent uint32) ([]*coordinator.PipelineEventMsg, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	pipeline, ok := ps.pipelines[name]
+	if !ok {
+		return nil, &PipelineNotFoundErr{pipeline: name}
+	}
+	stable := pipeline.GetStablePipelineVersion()
+	if stable == nil || pipeline.Deleted {
+		return nil, &PipelineCanaryErr{pipeline: name, reason: canaryNotFoundReason}
+	}
+	canary := pipeline.GetLatestPipelineVersion()
+	var evts []*coordinator.PipelineEventMsg
+	switch {
+	case trafficPercent >= 100:
+		if canary.State.Status != PipelineReady {
+			return nil, &PipelineCanaryErr{pipeline: name, reason: canaryNotReadyReason}
+		}
+		pipeline.Canary = nil
+		evts = append(evts, ps.terminateOldUnterminatedPipelinesIfNeeded(pipeline)...)
+		evts = append(evts, &coordinator.PipelineEventMsg{
+			PipelineName:    canary.Name,
+			PipelineVersion: canary.Version,
+			UID:             canary.UID,
+			CanaryUpdate:    true,
+		})
+	case trafficPercent == 0:
+		pipeline.Canary = nil
+		canary.State.setState(PipelineTerminate, "canary rolled back")
+		pipeline.StableVersion = stable.Version
+		evts = append(evts, &coordinator.PipelineEventMsg{
+			PipelineName:    canary.Name,
+			PipelineVersion: canary.Version,
+			UID:             canary.UID,
+		})
+	default:
+		pipeline.Canary.TrafficPercent = trafficPercent
+		evts = append(evts, &coordinator.PipelineEventMsg{
+			PipelineName:    canary.Name,
+			PipelineVersion: canary.Version,
+			UID:             canary.UID,
+		})
+	}
+	if ps.db != nil {
+		if err := ps.db.save(pipeline); err != nil {
+			return evts, err
+		}
+	}
+	return evts, nil
+}
+
 func (ps *PipelineStore) handleModelEvents(event coordinator.ModelEventMsg) {
 	logger := ps.logger.WithField("func", "handleModelEvents")
 	logger.Infof("Received event %s", event.String())
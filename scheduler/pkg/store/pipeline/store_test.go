@@ -658,6 +658,51 @@ func TestSetPipelineState(t *testing.T) {
 			},
 			expectedPipelineVersionStats: []PipelineStatus{PipelineTerminating, PipelineReady},
 		},
+		{
+			name:            "ready with canary keeps stable version",
+			pipelineName:    "p",
+			pipelineVersion: 3,
+			uid:             "uid3",
+			status:          PipelineReady,
+			reason:          "",
+			store: &PipelineStore{
+				logger: logrus.New(),
+				pipelines: map[string]*Pipeline{
+					"p": {
+						Name:        "p",
+						LastVersion: 3,
+						Canary:      &PipelineCanary{StableVersion: 2, TrafficPercent: 10},
+						Versions: []*PipelineVersion{
+							{
+								Name:    "p",
+								Version: 1,
+								UID:     "uid1",
+								State: &PipelineState{
+									Status: PipelineReady,
+								},
+							},
+							{
+								Name:    "p",
+								Version: 2,
+								UID:     "uid2",
+								State: &PipelineState{
+									Status: PipelineReady,
+								},
+							},
+							{
+								Name:    "p",
+								Version: 3,
+								UID:     "uid3",
+								State: &PipelineState{
+									Status: PipelineCreate,
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedPipelineVersionStats: []PipelineStatus{PipelineTerminate, PipelineReady, PipelineReady},
+		},
 		{
 			name:            "name not found",
 			pipelineName:    "p2",
@@ -760,3 +805,331 @@ func TestSetPipelineState(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdatePipelineCanary(t *testing.T) {
+	g := NewGomegaWithT(t)
+	type test struct {
+		name                         string
+		canary                       *scheduler.PipelineCanary
+		stableStatus                 PipelineStatus
+		canaryStatus                 PipelineStatus
+		trafficPercent               uint32
+		expectedCanary               *PipelineCanary
+		expectedLatestVersion        uint32
+		expectedStableVersion        uint32
+		expectedPipelineVersionStats map[uint32]PipelineStatus
+		err                          error
+	}
+
+	tests := []test{
+		{
+			name:                  "update traffic",
+			canary:                &scheduler.PipelineCanary{TrafficPercent: 10},
+			stableStatus:          PipelineReady,
+			canaryStatus:          PipelineReady,
+			trafficPercent:        50,
+			expectedCanary:        &PipelineCanary{StableVersion: 1, TrafficPercent: 50},
+			expectedLatestVersion: 2,
+			expectedPipelineVersionStats: map[uint32]PipelineStatus{
+				1: PipelineReady,
+				2: PipelineReady,
+			},
+		},
+		{
+			name:                  "promote",
+			canary:                &scheduler.PipelineCanary{TrafficPercent: 10},
+			stableStatus:          PipelineReady,
+			canaryStatus:          PipelineReady,
+			trafficPercent:        100,
+			expectedLatestVersion: 2,
+			expectedPipelineVersionStats: map[uint32]PipelineStatus{
+				1: PipelineTerminate,
+				2: PipelineReady,
+			},
+		},
+		{
+			name:                  "rollback",
+			canary:                &scheduler.PipelineCanary{TrafficPercent: 10},
+			stableStatus:          PipelineReady,
+			canaryStatus:          PipelineCreating,
+			trafficPercent:        0,
+			expectedLatestVersion: 1,
+			expectedStableVersion: 1,
+			expectedPipelineVersionStats: map[uint32]PipelineStatus{
+				1: PipelineReady,
+				2: PipelineTerminate,
+			},
+		},
+		{
+			name:           "promote canary not ready",
+			canary:         &scheduler.PipelineCanary{TrafficPercent: 10},
+			stableStatus:   PipelineReady,
+			canaryStatus:   PipelineCreating,
+			trafficPercent: 100,
+			err:            &PipelineCanaryErr{pipeline: "p", reason: canaryNotReadyReason},
+		},
+		{
+			name:           "no canary",
+			stableStatus:   PipelineReady,
+			canaryStatus:   PipelineReady,
+			trafficPercent: 50,
+			err:            &PipelineCanaryErr{pipeline: "p", reason: canaryNotFoundReason},
+		},
+		{
+			name:           "canary ignored when previous version not ready",
+			canary:         &scheduler.PipelineCanary{TrafficPercent: 10},
+			stableStatus:   PipelineFailed,
+			canaryStatus:   PipelineReady,
+			trafficPercent: 50,
+			err:            &PipelineCanaryErr{pipeline: "p", reason: canaryNotFoundReason},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ps := &PipelineStore{
+				logger:    logrus.New(),
+				pipelines: map[string]*Pipeline{},
+				modelStatusHandler: ModelStatusHandler{
+					modelReferences: map[string]map[string]void{},
+					store:           fakeModelStore{status: map[string]store.ModelState{}},
+				},
+			}
+			proto := &scheduler.Pipeline{
+				Name: "p",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "step1",
+					},
+				},
+			}
+			err := ps.AddPipeline(proto)
+			g.Expect(err).To(BeNil())
+			ps.pipelines["p"].Versions[0].State.Status = test.stableStatus
+			proto.Canary = test.canary
+			err = ps.AddPipeline(proto)
+			g.Expect(err).To(BeNil())
+			ps.pipelines["p"].Versions[1].State.Status = test.canaryStatus
+
+			err = ps.UpdatePipelineCanary("p", test.trafficPercent)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+				p := ps.pipelines["p"]
+				g.Expect(p.Canary).To(Equal(test.expectedCanary))
+				g.Expect(p.GetLatestPipelineVersion().Version).To(Equal(test.expectedLatestVersion))
+				g.Expect(p.StableVersion).To(Equal(test.expectedStableVersion))
+				g.Expect(p.LastVersion).To(Equal(uint32(2)))
+				for idx, pv := range p.Versions {
+					g.Expect(pv.Version).To(Equal(uint32(idx + 1)))
+				}
+				for version, status := range test.expectedPipelineVersionStats {
+					g.Expect(p.GetPipelineVersion(version).State.Status).To(Equal(status))
+				}
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+		})
+	}
+}
+
+func TestAddPipelineAfterCanaryRollback(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ps := &PipelineStore{
+		logger:    logrus.New(),
+		pipelines: map[string]*Pipeline{},
+		modelStatusHandler: ModelStatusHandler{
+			modelReferences: map[string]map[string]void{},
+			store:           fakeModelStore{status: map[string]store.ModelState{}},
+		},
+	}
+	proto := &scheduler.Pipeline{
+		Name: "p",
+		Steps: []*scheduler.PipelineStep{
+			{
+				Name: "step1",
+			},
+		},
+	}
+	g.Expect(ps.AddPipeline(proto)).To(BeNil())
+	ps.pipelines["p"].Versions[0].State.Status = PipelineReady
+	proto.Canary = &scheduler.PipelineCanary{TrafficPercent: 10}
+	g.Expect(ps.AddPipeline(proto)).To(BeNil())
+	g.Expect(ps.UpdatePipelineCanary("p", 0)).To(BeNil())
+
+	// A new canary splits traffic with the version rolled back to rather than the rolled back canary
+	g.Expect(ps.AddPipeline(proto)).To(BeNil())
+	p := ps.pipelines["p"]
+	g.Expect(p.StableVersion).To(Equal(uint32(0)))
+	g.Expect(p.GetLatestPipelineVersion().Version).To(Equal(uint32(3)))
+	g.Expect(p.Canary).To(Equal(&PipelineCanary{StableVersion: 1, TrafficPercent: 10}))
+
+	// The rolled back version survives a restart
+	p.StableVersion = 1
+	restored, err := CreatePipelineFromSnapshot(CreatePipelineSnapshotFromPipeline(p))
+	g.Expect(err).To(BeNil())
+	g.Expect(restored.StableVersion).To(Equal(uint32(1)))
+	g.Expect(restored.GetLatestPipelineVersion().Version).To(Equal(uint32(1)))
+}
+
+func TestAddPipelineCanaryTrafficInvalid(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ps := &PipelineStore{
+		logger:    logrus.New(),
+		pipelines: map[string]*Pipeline{},
+	}
+	err := ps.AddPipeline(&scheduler.Pipeline{
+		Name: "p",
+		Steps: []*scheduler.PipelineStep{
+			{
+				Name: "step1",
+			},
+		},
+		Canary: &scheduler.PipelineCanary{TrafficPercent: 100},
+	})
+	g.Expect(err.Error()).To(Equal((&PipelineCanaryErr{pipeline: "p", reason: canaryTrafficReason}).Error()))
+}
+
+func TestAddPipelineTransformStepModelName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	modelStore := store.NewMemoryStore(logrus.New(), store.NewLocalSchedulerStore(), nil)
+	err := modelStore.UpdateModel(&scheduler.LoadModelRequest{
+		Model: &scheduler.Model{
+			Meta:           &scheduler.MetaData{Name: "model1"},
+			ModelSpec:      &scheduler.ModelSpec{Uri: "gs://model1"},
+			DeploymentSpec: &scheduler.DeploymentSpec{Replicas: 1},
+		},
+	})
+	g.Expect(err).To(BeNil())
+	ps := NewPipelineStore(logrus.New(), nil, modelStore)
+
+	transformPipeline := func(stepName string) *scheduler.Pipeline {
+		return &scheduler.Pipeline{
+			Name: "p",
+			Steps: []*scheduler.PipelineStep{
+				{
+					Name: stepName,
+					Transforms: []*scheduler.PipelineTransform{
+						{Type: scheduler.PipelineTransform_SELECT, Tensors: []string{"t1"}},
+					},
+				},
+			},
+		}
+	}
+
+	err = ps.AddPipeline(transformPipeline("model1"))
+	g.Expect(err.Error()).To(Equal((&PipelineStepTransformErr{pipeline: "p", step: "model1", reason: transformModelNameReason}).Error()))
+	g.Expect(ps.pipelines).ToNot(HaveKey("p"))
+
+	err = ps.AddPipeline(transformPipeline("select"))
+	g.Expect(err).To(BeNil())
+}
+
+func TestAddPipelineJoinedTopicPartitions(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	getUintPtr := func(val uint32) *uint32 { return &val }
+	modelStore := store.NewMemoryStore(logrus.New(), store.NewLocalSchedulerStore(), nil)
+	for _, model := range []struct {
+		name       string
+		partitions *uint32
+	}{
+		{name: "model1", partitions: getUintPtr(4)},
+		{name: "model2", partitions: getUintPtr(4)},
+		{name: "model3"},
+	} {
+		err := modelStore.UpdateModel(&scheduler.LoadModelRequest{
+			Model: &scheduler.Model{
+				Meta:           &scheduler.MetaData{Name: model.name},
+				ModelSpec:      &scheduler.ModelSpec{Uri: "gs://" + model.name},
+				DeploymentSpec: &scheduler.DeploymentSpec{Replicas: 1},
+				StreamSpec:     &scheduler.StreamSpec{TopicConfig: &scheduler.KafkaTopicConfig{Partitions: model.partitions}},
+			},
+		})
+		g.Expect(err).To(BeNil())
+	}
+	ps := NewPipelineStore(logrus.New(), nil, modelStore)
+
+	joinPipeline := func(name string, joined string) *scheduler.Pipeline {
+		return &scheduler.Pipeline{
+			Name: name,
+			Steps: []*scheduler.PipelineStep{
+				{Name: "model1"},
+				{Name: joined},
+				{Name: "join", Inputs: []string{"model1", joined}},
+			},
+		}
+	}
+
+	err := ps.AddPipeline(joinPipeline("p1", "model3"))
+	g.Expect(err.Error()).To(Equal((&PipelineJoinPartitionsErr{pipeline: "p1", join: "step join"}).Error()))
+	g.Expect(ps.pipelines).ToNot(HaveKey("p1"))
+
+	err = ps.AddPipeline(joinPipeline("p2", "model2"))
+	g.Expect(err).To(BeNil())
+
+	// the outputs of p2 use the default partitions, unlike model1
+	err = ps.AddPipeline(&scheduler.Pipeline{
+		Name: "p3",
+		Steps: []*scheduler.PipelineStep{
+			{Name: "model1"},
+		},
+		Input: &scheduler.PipelineInput{ExternalInputs: []string{"p2.step.model1"}, ExternalTriggers: []string{"p2"}},
+	})
+	g.Expect(err.Error()).To(Equal((&PipelineJoinPartitionsErr{pipeline: "p3", join: "inputs"}).Error()))
+}
+
+func TestCheckModelName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name      string
+		modelName string
+		status    PipelineStatus
+		err       error
+	}
+	tests := []test{
+		{
+			name:      "name of a transform step",
+			modelName: "select",
+			status:    PipelineReady,
+			err:       &ModelNameTransformStepErr{model: "select", pipeline: "p"},
+		},
+		{
+			name:      "name of a model step",
+			modelName: "model1",
+			status:    PipelineReady,
+		},
+		{
+			name:      "name of a transform step of a terminated pipeline",
+			modelName: "select",
+			status:    PipelineTerminated,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ps := NewPipelineStore(logrus.New(), nil, nil)
+			ps.pipelines["p"] = &Pipeline{
+				Name:        "p",
+				LastVersion: 1,
+				Versions: []*PipelineVersion{
+					{
+						Name:    "p",
+						Version: 1,
+						Steps: map[string]*PipelineStep{
+							"model1": {Name: "model1"},
+							"select": {Name: "select", Transforms: []*Transform{{Type: TransformSelect, Tensors: []string{"t1"}}}},
+						},
+						State: &PipelineState{Status: test.status},
+					},
+				},
+			}
+			err := ps.CheckModelName(test.modelName)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+		})
+	}
+}
@@ -60,6 +60,15 @@ func CreateProtoFromPipelineVersion(pv *PipelineVersion) *scheduler.Pipeline {
 				WindowMs: step.Batch.WindowMs,
 			}
 		}
+		protoStep.Transforms = createProtoTransforms(step.Transforms)
+		if step.ForEach != nil {
+			protoStep.ForEach = &scheduler.ForEach{
+				Tensor:          step.ForEach.Tensor,
+				Axis:            step.ForEach.Axis,
+				Parallelism:     step.ForEach.Parallelism,
+				GatherTimeoutMs: step.ForEach.GatherTimeoutMs,
+			}
+		}
 		protoSteps = append(protoSteps, protoStep)
 	}
 	if pv.Input != nil {
@@ -68,6 +77,7 @@ func CreateProtoFromPipelineVersion(pv *PipelineVersion) *scheduler.Pipeline {
 			ExternalTriggers: pv.Input.ExternalTriggers,
 			JoinWindowMs:     pv.Input.JoinWindowMs,
 			TensorMap:        pv.Input.TensorMap,
+			KafkaSources:     createProtoKafkaTopics(pv.Input.KafkaSources),
 		}
 		switch pv.Input.InputsJoinType {
 		case JoinInner:
@@ -91,6 +101,7 @@ func CreateProtoFromPipelineVersion(pv *PipelineVersion) *scheduler.Pipeline {
 			Steps:        pv.Output.Steps,
 			JoinWindowMs: pv.Output.JoinWindowMs,
 			TensorMap:    pv.Output.TensorMap,
+			KafkaSinks:   createProtoKafkaTopics(pv.Output.KafkaSinks),
 		}
 		switch pv.Output.StepsJoinType {
 		case JoinInner:
@@ -116,6 +127,10 @@ func CreateProtoFromPipelineVersion(pv *PipelineVersion) *scheduler.Pipeline {
 		Steps:          protoSteps,
 		Output:         protoOutput,
 		KubernetesMeta: kubernetesMeta,
+		Schema:         createProtoSchema(pv.Schema),
+		TopicConfig:    createProtoTopicConfig(pv.TopicConfig),
+		TrafficPolicy:  CreateProtoTrafficPolicy(pv.TrafficPolicy),
+		Authorization:  CreateProtoAuthorizationPolicy(pv.Authorization),
 	}
 }
 
@@ -151,6 +166,15 @@ func CreatePipelineVersionFromProto(pipelineProto *scheduler.Pipeline) (*Pipelin
 				WindowMs: stepProto.Batch.WindowMs,
 			}
 		}
+		step.Transforms = createTransformsFromProto(stepProto.Transforms)
+		if stepProto.ForEach != nil {
+			step.ForEach = &ForEach{
+				Tensor:          stepProto.ForEach.Tensor,
+				Axis:            stepProto.ForEach.Axis,
+				Parallelism:     stepProto.ForEach.Parallelism,
+				GatherTimeoutMs: stepProto.ForEach.GatherTimeoutMs,
+			}
+		}
 		if _, ok := steps[stepProto.Name]; ok {
 			return nil, &PipelineStepRepeatedErr{pipeline: pipelineProto.GetName(), step: stepProto.GetName()}
 		}
@@ -163,6 +187,7 @@ func CreatePipelineVersionFromProto(pipelineProto *scheduler.Pipeline) (*Pipelin
 			ExternalTriggers: updateExternalInputSteps(pipelineProto.Input.ExternalTriggers),
 			JoinWindowMs:     pipelineProto.Input.JoinWindowMs,
 			TensorMap:        pipelineProto.Input.TensorMap,
+			KafkaSources:     createKafkaTopicsFromProto(pipelineProto.Input.KafkaSources),
 		}
 		switch pipelineProto.Input.JoinType {
 		case scheduler.PipelineInput_INNER:
@@ -188,6 +213,7 @@ func CreatePipelineVersionFromProto(pipelineProto *scheduler.Pipeline) (*Pipelin
 			Steps:        updateInternalInputSteps(pipelineProto.Name, pipelineProto.Output.Steps),
 			JoinWindowMs: pipelineProto.Output.JoinWindowMs,
 			TensorMap:    pipelineProto.Output.TensorMap,
+			KafkaSinks:   createKafkaTopicsFromProto(pipelineProto.Output.KafkaSinks),
 		}
 		switch pipelineProto.Output.StepsJoin {
 		case scheduler.PipelineOutput_INNER:
@@ -215,6 +241,10 @@ func CreatePipelineVersionFromProto(pipelineProto *scheduler.Pipeline) (*Pipelin
 		State:          &PipelineState{},
 		Output:         output,
 		KubernetesMeta: kubernetesMeta,
+		Schema:         createSchemaFromProto(pipelineProto.Schema),
+		TopicConfig:    createTopicConfigFromProto(pipelineProto.TopicConfig),
+		TrafficPolicy:  createTrafficPolicyFromProto(pipelineProto.TrafficPolicy),
+		Authorization:  createAuthorizationPolicyFromProto(pipelineProto.Authorization),
 	}
 	// Ensure we have a UID
 	if pv.UID == "" {
@@ -223,6 +253,134 @@ func CreatePipelineVersionFromProto(pipelineProto *scheduler.Pipeline) (*Pipelin
 	return pv, nil
 }
 
+func createProtoTransforms(transforms []*Transform) []*scheduler.PipelineTransform {
+	var protoTransforms []*scheduler.PipelineTransform
+	for _, transform := range transforms {
+		protoTransforms = append(protoTransforms, &scheduler.PipelineTransform{
+			Type:     scheduler.PipelineTransform_TransformType(transform.Type),
+			Tensors:  transform.Tensors,
+			Outputs:  transform.Outputs,
+			Axis:     transform.Axis,
+			Datatype: transform.Datatype,
+			Shape:    transform.Shape,
+			Values:   transform.Values,
+			Path:     transform.Path,
+		})
+	}
+	return protoTransforms
+}
+
+func createTransformsFromProto(protoTransforms []*scheduler.PipelineTransform) []*Transform {
+	var transforms []*Transform
+	for _, protoTransform := range protoTransforms {
+		transforms = append(transforms, &Transform{
+			Type:     TransformType(protoTransform.Type),
+			Tensors:  protoTransform.Tensors,
+			Outputs:  protoTransform.Outputs,
+			Axis:     protoTransform.Axis,
+			Datatype: protoTransform.Datatype,
+			Shape:    protoTransform.Shape,
+			Values:   protoTransform.Values,
+			Path:     protoTransform.Path,
+		})
+	}
+	return transforms
+}
+
+func createProtoSchema(schema *PipelineSchema) *scheduler.PipelineSchema {
+	if schema == nil {
+		return nil
+	}
+	return &scheduler.PipelineSchema{
+		Inputs:  createProtoTensorSchemas(schema.Inputs),
+		Outputs: createProtoTensorSchemas(schema.Outputs),
+	}
+}
+
+func createProtoTensorSchemas(tensors []*TensorSchema) []*scheduler.TensorSchema {
+	var protoTensors []*scheduler.TensorSchema
+	for _, tensor := range tensors {
+		protoTensors = append(protoTensors, &scheduler.TensorSchema{
+			Name:     tensor.Name,
+			Datatype: tensor.Datatype,
+			Shape:    tensor.Shape,
+		})
+	}
+	return protoTensors
+}
+
+func createSchemaFromProto(protoSchema *scheduler.PipelineSchema) *PipelineSchema {
+	if protoSchema == nil {
+		return nil
+	}
+	return &PipelineSchema{
+		Inputs:  createTensorSchemasFromProto(protoSchema.Inputs),
+		Outputs: createTensorSchemasFromProto(protoSchema.Outputs),
+	}
+}
+
+func createTensorSchemasFromProto(protoTensors []*scheduler.TensorSchema) []*TensorSchema {
+	var tensors []*TensorSchema
+	for _, protoTensor := range protoTensors {
+		tensors = append(tensors, &TensorSchema{
+			Name:     protoTensor.Name,
+			Datatype: protoTensor.Datatype,
+			Shape:    protoTensor.Shape,
+		})
+	}
+	return tensors
+}
+
+func createProtoKafkaTopics(topics []*KafkaTopic) []*scheduler.PipelineKafkaTopic {
+	var protoTopics []*scheduler.PipelineKafkaTopic
+	for _, topic := range topics {
+		protoTopics = append(protoTopics, &scheduler.PipelineKafkaTopic{
+			Topic:   topic.Topic,
+			Format:  scheduler.PipelineKafkaTopic_Format(topic.Format),
+			Tensors: createProtoTensorSchemas(topic.Tensors),
+		})
+	}
+	return protoTopics
+}
+
+func createKafkaTopicsFromProto(protoTopics []*scheduler.PipelineKafkaTopic) []*KafkaTopic {
+	var topics []*KafkaTopic
+	for _, protoTopic := range protoTopics {
+		topics = append(topics, &KafkaTopic{
+			Topic:   protoTopic.Topic,
+			Format:  KafkaFormat(protoTopic.Format),
+			Tensors: createTensorSchemasFromProto(protoTopic.Tensors),
+		})
+	}
+	return topics
+}
+
+func createProtoTopicConfig(topicConfig *TopicConfig) *scheduler.KafkaTopicConfig {
+	if topicConfig == nil {
+		return nil
+	}
+	return &scheduler.KafkaTopicConfig{
+		Partitions:        topicConfig.Partitions,
+		ReplicationFactor: topicConfig.ReplicationFactor,
+		RetentionMs:       topicConfig.RetentionMs,
+		CleanupPolicy:     topicConfig.CleanupPolicy,
+		CompressionType:   topicConfig.CompressionType,
+	}
+}
+
+func createTopicConfigFromProto(protoTopicConfig *scheduler.KafkaTopicConfig) *TopicConfig {
+	if protoTopicConfig == nil {
+		return nil
+	}
+	return &TopicConfig{
+		Partitions:        protoTopicConfig.Partitions,
+		ReplicationFactor: protoTopicConfig.ReplicationFactor,
+		RetentionMs:       protoTopicConfig.RetentionMs,
+		CleanupPolicy:     protoTopicConfig.CleanupPolicy,
+		CompressionType:   protoTopicConfig.CompressionType,
+	}
+}
+
 func updateInternalInputSteps(pipelineName string, inputs []string) []string {
 	if len(inputs) == 0 {
 		return inputs
@@ -304,11 +462,20 @@ func CreatePipelineSnapshotFromPipeline(pipeline *Pipeline) *scheduler.PipelineS
 	for _, pv := range pipeline.Versions {
 		versions = append(versions, CreatePipelineWithState(pv))
 	}
+	var canary *scheduler.PipelineCanaryState
+	if pipeline.Canary != nil {
+		canary = &scheduler.PipelineCanaryState{
+			StableVersion:  pipeline.Canary.StableVersion,
+			TrafficPercent: pipeline.Canary.TrafficPercent,
+		}
+	}
 	return &scheduler.PipelineSnapshot{
-		Name:        pipeline.Name,
-		LastVersion: pipeline.LastVersion,
-		Versions:    versions,
-		Deleted:     pipeline.Deleted,
+		Name:          pipeline.Name,
+		LastVersion:   pipeline.LastVersion,
+		Versions:      versions,
+		Deleted:       pipeline.Deleted,
+		Canary:        canary,
+		StableVersion: pipeline.StableVersion,
 	}
 }
 
@@ -321,10 +488,133 @@ func CreatePipelineFromSnapshot(snapshot *scheduler.PipelineSnapshot) (*Pipeline
 		}
 		versions = append(versions, pv)
 	}
+	var canary *PipelineCanary
+	if snapshot.Canary != nil {
+		canary = &PipelineCanary{
+			StableVersion:  snapshot.Canary.StableVersion,
+			TrafficPercent: snapshot.Canary.TrafficPercent,
+		}
+	}
 	return &Pipeline{
-		Name:        snapshot.Name,
-		LastVersion: snapshot.LastVersion,
-		Versions:    versions,
-		Deleted:     snapshot.Deleted,
+		Name:          snapshot.Name,
+		LastVersion:   snapshot.LastVersion,
+		Versions:      versions,
+		Deleted:       snapshot.Deleted,
+		Canary:        canary,
+		StableVersion: snapshot.StableVersion,
 	}, nil
 }
+
+func CreateProtoTrafficPolicy(trafficPolicy *TrafficPolicy) *scheduler.TrafficPolicy {
+	if trafficPolicy == nil {
+		return nil
+	}
+	protoTrafficPolicy := &scheduler.TrafficPolicy{
+		TimeoutMs: trafficPolicy.TimeoutMs,
+	}
+	if trafficPolicy.Retry != nil {
+		protoTrafficPolicy.Retry = &scheduler.RetryPolicy{
+			RetryOn:         trafficPolicy.Retry.RetryOn,
+			NumRetries:      trafficPolicy.Retry.NumRetries,
+			PerTryTimeoutMs: trafficPolicy.Retry.PerTryTimeoutMs,
+		}
+	}
+	if trafficPolicy.CircuitBreaker != nil {
+		protoTrafficPolicy.CircuitBreaker = &scheduler.CircuitBreaker{
+			MaxConnections:     trafficPolicy.CircuitBreaker.MaxConnections,
+			MaxPendingRequests: trafficPolicy.CircuitBreaker.MaxPendingRequests,
+		}
+	}
+	if trafficPolicy.OutlierDetection != nil {
+		protoTrafficPolicy.OutlierDetection = &scheduler.OutlierDetection{
+			ConsecutiveErrors:  trafficPolicy.OutlierDetection.ConsecutiveErrors,
+			IntervalMs:         trafficPolicy.OutlierDetection.IntervalMs,
+			BaseEjectionTimeMs: trafficPolicy.OutlierDetection.BaseEjectionTimeMs,
+			MaxEjectionPercent: trafficPolicy.OutlierDetection.MaxEjectionPercent,
+		}
+	}
+	if trafficPolicy.RateLimit != nil {
+		protoTrafficPolicy.RateLimit = &scheduler.RouteRateLimit{
+			Unit:     scheduler.RouteRateLimit_Unit(trafficPolicy.RateLimit.Unit),
+			Requests: trafficPolicy.RateLimit.Requests,
+			Burst:    trafficPolicy.RateLimit.Burst,
+			Header:   trafficPolicy.RateLimit.Header,
+			Global:   trafficPolicy.RateLimit.Global,
+		}
+		for _, quota := range trafficPolicy.RateLimit.Quotas {
+			protoTrafficPolicy.RateLimit.Quotas = append(protoTrafficPolicy.RateLimit.Quotas, &scheduler.RateLimitQuota{
+				Value:    quota.Value,
+				Requests: quota.Requests,
+				Burst:    quota.Burst,
+			})
+		}
+	}
+	return protoTrafficPolicy
+}
+
+func createTrafficPolicyFromProto(protoTrafficPolicy *scheduler.TrafficPolicy) *TrafficPolicy {
+	if protoTrafficPolicy == nil {
+		return nil
+	}
+	trafficPolicy := &TrafficPolicy{
+		TimeoutMs: protoTrafficPolicy.TimeoutMs,
+	}
+	if protoTrafficPolicy.Retry != nil {
+		trafficPolicy.Retry = &RetryPolicy{
+			RetryOn:         protoTrafficPolicy.Retry.RetryOn,
+			NumRetries:      protoTrafficPolicy.Retry.NumRetries,
+			PerTryTimeoutMs: protoTrafficPolicy.Retry.PerTryTimeoutMs,
+		}
+	}
+	if protoTrafficPolicy.CircuitBreaker != nil {
+		trafficPolicy.CircuitBreaker = &CircuitBreaker{
+			MaxConnections:     protoTrafficPolicy.CircuitBreaker.MaxConnections,
+			MaxPendingRequests: protoTrafficPolicy.CircuitBreaker.MaxPendingRequests,
+		}
+	}
+	if protoTrafficPolicy.OutlierDetection != nil {
+		trafficPolicy.OutlierDetection = &OutlierDetection{
+			ConsecutiveErrors:  protoTrafficPolicy.OutlierDetection.ConsecutiveErrors,
+			IntervalMs:         protoTrafficPolicy.OutlierDetection.IntervalMs,
+			BaseEjectionTimeMs: protoTrafficPolicy.OutlierDetection.BaseEjectionTimeMs,
+			MaxEjectionPercent: protoTrafficPolicy.OutlierDetection.MaxEjectionPercent,
+		}
+	}
+	if protoTrafficPolicy.RateLimit != nil {
+		trafficPolicy.RateLimit = &RouteRateLimit{
+			Unit:     RateLimitUnit(protoTrafficPolicy.RateLimit.Unit),
+			Requests: protoTrafficPolicy.RateLimit.Requests,
+			Burst:    protoTrafficPolicy.RateLimit.Burst,
+			Header:   protoTrafficPolicy.RateLimit.Header,
+			Global:   protoTrafficPolicy.RateLimit.Global,
+		}
+		for _, protoQuota := range protoTrafficPolicy.RateLimit.Quotas {
+			trafficPolicy.RateLimit.Quotas = append(trafficPolicy.RateLimit.Quotas, &RateLimitQuota{
+				Value:    protoQuota.Value,
+				Requests: protoQuota.Requests,
+				Burst:    protoQuota.Burst,
+			})
+		}
+	}
+	return trafficPolicy
+}
+
+func CreateProtoAuthorizationPolicy(authz *AuthorizationPolicy) *scheduler.AuthorizationPolicy {
+	if authz == nil {
+		return nil
+	}
+	return &scheduler.AuthorizationPolicy{
+		Subjects: authz.Subjects,
+		Scopes:   authz.Scopes,
+	}
+}
+
+func createAuthorizationPolicyFromProto(protoAuthz *scheduler.AuthorizationPolicy) *AuthorizationPolicy {
+	if protoAuthz == nil {
+		return nil
+	}
+	return &AuthorizationPolicy{
+		Subjects: protoAuthz.Subjects,
+		Scopes:   protoAuthz.Scopes,
+	}
+}
@@ -52,6 +52,9 @@ func TestCreatePipelineFromProto(t *testing.T) {
 	}
 
 	getUintPtr := func(val uint32) *uint32 { return &val }
+	rateLimitHeader := "x-tenant"
+	cleanupPolicy := "compact"
+	compressionType := "zstd"
 	tests := []test{
 		{
 			name: "simple",
@@ -333,6 +336,267 @@ func TestCreatePipelineFromProto(t *testing.T) {
 				State: &PipelineState{},
 			},
 		},
+		{
+			name: "transform step",
+			proto: &scheduler.Pipeline{
+				Name: "pipeline",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name:   "step1",
+						Inputs: []string{"a"},
+						Transforms: []*scheduler.PipelineTransform{
+							{Type: scheduler.PipelineTransform_SPLIT, Tensors: []string{"t1"}, Outputs: []string{"t2", "t3"}, Axis: 1},
+							{Type: scheduler.PipelineTransform_CAST, Tensors: []string{"t2"}, Datatype: "FP64"},
+						},
+					},
+				},
+			},
+			pipeline: &PipelineVersion{
+				Name:    "pipeline",
+				Version: 1,
+				Steps: map[string]*PipelineStep{
+					"step1": {
+						Name:   "step1",
+						Inputs: []string{"a.outputs"},
+						Transforms: []*Transform{
+							{Type: TransformSplit, Tensors: []string{"t1"}, Outputs: []string{"t2", "t3"}, Axis: 1},
+							{Type: TransformCast, Tensors: []string{"t2"}, Datatype: "FP64"},
+						},
+					},
+				},
+				State: &PipelineState{},
+			},
+		},
+		{
+			name: "forEach step",
+			proto: &scheduler.Pipeline{
+				Name: "pipeline",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name:    "step1",
+						Inputs:  []string{"a"},
+						ForEach: &scheduler.ForEach{Tensor: "boxes", Axis: 1, Parallelism: getUintPtr(4), GatherTimeoutMs: getUintPtr(1000)},
+					},
+				},
+			},
+			pipeline: &PipelineVersion{
+				Name:    "pipeline",
+				Version: 1,
+				Steps: map[string]*PipelineStep{
+					"step1": {
+						Name:    "step1",
+						Inputs:  []string{"a.outputs"},
+						ForEach: &ForEach{Tensor: "boxes", Axis: 1, Parallelism: getUintPtr(4), GatherTimeoutMs: getUintPtr(1000)},
+					},
+				},
+				State: &PipelineState{},
+			},
+		},
+		{
+			name: "schema",
+			proto: &scheduler.Pipeline{
+				Name: "pipeline",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "step1",
+					},
+				},
+				Schema: &scheduler.PipelineSchema{
+					Inputs:  []*scheduler.TensorSchema{{Name: "x", Datatype: "FP32", Shape: []int64{-1, 4}}},
+					Outputs: []*scheduler.TensorSchema{{Name: "y"}},
+				},
+			},
+			pipeline: &PipelineVersion{
+				Name:    "pipeline",
+				Version: 1,
+				Steps: map[string]*PipelineStep{
+					"step1": {
+						Name: "step1",
+					},
+				},
+				Schema: &PipelineSchema{
+					Inputs:  []*TensorSchema{{Name: "x", Datatype: "FP32", Shape: []int64{-1, 4}}},
+					Outputs: []*TensorSchema{{Name: "y"}},
+				},
+				State: &PipelineState{},
+			},
+		},
+		{
+			name: "topic config",
+			proto: &scheduler.Pipeline{
+				Name: "pipeline",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "step1",
+					},
+				},
+				TopicConfig: &scheduler.KafkaTopicConfig{
+					Partitions:      getUintPtr(4),
+					CleanupPolicy:   &cleanupPolicy,
+					CompressionType: &compressionType,
+				},
+			},
+			pipeline: &PipelineVersion{
+				Name:    "pipeline",
+				Version: 1,
+				Steps: map[string]*PipelineStep{
+					"step1": {
+						Name: "step1",
+					},
+				},
+				TopicConfig: &TopicConfig{
+					Partitions:      getUintPtr(4),
+					CleanupPolicy:   &cleanupPolicy,
+					CompressionType: &compressionType,
+				},
+				State: &PipelineState{},
+			},
+		},
+		{
+			name: "traffic policy",
+			proto: &scheduler.Pipeline{
+				Name: "pipeline",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "step1",
+					},
+				},
+				TrafficPolicy: &scheduler.TrafficPolicy{
+					TimeoutMs: getUintPtr(2000),
+					Retry: &scheduler.RetryPolicy{
+						RetryOn:         "5xx",
+						NumRetries:      2,
+						PerTryTimeoutMs: getUintPtr(500),
+					},
+					CircuitBreaker: &scheduler.CircuitBreaker{
+						MaxConnections: getUintPtr(100),
+					},
+					OutlierDetection: &scheduler.OutlierDetection{
+						ConsecutiveErrors:  5,
+						MaxEjectionPercent: getUintPtr(50),
+					},
+					RateLimit: &scheduler.RouteRateLimit{
+						Unit:     scheduler.RouteRateLimit_MINUTE,
+						Requests: getUintPtr(600),
+						Header:   &rateLimitHeader,
+						Quotas:   []*scheduler.RateLimitQuota{{Value: "tenant-a", Requests: 60}},
+						Global:   true,
+					},
+				},
+			},
+			pipeline: &PipelineVersion{
+				Name:    "pipeline",
+				Version: 1,
+				Steps: map[string]*PipelineStep{
+					"step1": {
+						Name: "step1",
+					},
+				},
+				TrafficPolicy: &TrafficPolicy{
+					TimeoutMs: getUintPtr(2000),
+					Retry: &RetryPolicy{
+						RetryOn:         "5xx",
+						NumRetries:      2,
+						PerTryTimeoutMs: getUintPtr(500),
+					},
+					CircuitBreaker: &CircuitBreaker{
+						MaxConnections: getUintPtr(100),
+					},
+					OutlierDetection: &OutlierDetection{
+						ConsecutiveErrors:  5,
+						MaxEjectionPercent: getUintPtr(50),
+					},
+					RateLimit: &RouteRateLimit{
+						Unit:     RateLimitUnitMinute,
+						Requests: getUintPtr(600),
+						Header:   &rateLimitHeader,
+						Quotas:   []*RateLimitQuota{{Value: "tenant-a", Requests: 60}},
+						Global:   true,
+					},
+				},
+				State: &PipelineState{},
+			},
+		},
+		{
+			name: "authorization",
+			proto: &scheduler.Pipeline{
+				Name: "pipeline",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "step1",
+					},
+				},
+				Authorization: &scheduler.AuthorizationPolicy{
+					Subjects: []string{"team-a"},
+					Scopes:   []string{"predict"},
+				},
+			},
+			pipeline: &PipelineVersion{
+				Name:    "pipeline",
+				Version: 1,
+				Steps: map[string]*PipelineStep{
+					"step1": {
+						Name: "step1",
+					},
+				},
+				Authorization: &AuthorizationPolicy{
+					Subjects: []string{"team-a"},
+					Scopes:   []string{"predict"},
+				},
+				State: &PipelineState{},
+			},
+		},
+		{
+			name: "kafka sources and sinks",
+			proto: &scheduler.Pipeline{
+				Name: "pipeline",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "step1",
+					},
+				},
+				Input: &scheduler.PipelineInput{
+					KafkaSources: []*scheduler.PipelineKafkaTopic{
+						{
+							Topic:   "events",
+							Format:  scheduler.PipelineKafkaTopic_CSV,
+							Tensors: []*scheduler.TensorSchema{{Name: "x", Datatype: "FP32", Shape: []int64{4}}},
+						},
+					},
+				},
+				Output: &scheduler.PipelineOutput{
+					Steps: []string{"step1"},
+					KafkaSinks: []*scheduler.PipelineKafkaTopic{
+						{Topic: "predictions", Format: scheduler.PipelineKafkaTopic_JSON},
+					},
+				},
+			},
+			pipeline: &PipelineVersion{
+				Name:    "pipeline",
+				Version: 1,
+				Steps: map[string]*PipelineStep{
+					"step1": {
+						Name: "step1",
+					},
+				},
+				Input: &PipelineInput{
+					KafkaSources: []*KafkaTopic{
+						{
+							Topic:   "events",
+							Format:  KafkaFormatCsv,
+							Tensors: []*TensorSchema{{Name: "x", Datatype: "FP32", Shape: []int64{4}}},
+						},
+					},
+				},
+				Output: &PipelineOutput{
+					Steps: []string{"pipeline.step1"},
+					KafkaSinks: []*KafkaTopic{
+						{Topic: "predictions", Format: KafkaFormatJson},
+					},
+				},
+				State: &PipelineState{},
+			},
+		},
 		{
 			name: "pipeline step repeated",
 			proto: &scheduler.Pipeline{
@@ -364,6 +628,16 @@ func TestCreatePipelineFromProto(t *testing.T) {
 				for k, v := range pv.Steps {
 					g.Expect(test.pipeline.Steps[k]).To(Equal(v))
 				}
+				g.Expect(pv.Schema).To(Equal(test.pipeline.Schema))
+				g.Expect(pv.TopicConfig).To(Equal(test.pipeline.TopicConfig))
+				g.Expect(pv.TrafficPolicy).To(Equal(test.pipeline.TrafficPolicy))
+				g.Expect(pv.Authorization).To(Equal(test.pipeline.Authorization))
+				if test.pipeline.Input != nil {
+					g.Expect(pv.Input.KafkaSources).To(Equal(test.pipeline.Input.KafkaSources))
+				}
+				if test.pipeline.Output != nil {
+					g.Expect(pv.Output.KafkaSinks).To(Equal(test.pipeline.Output.KafkaSinks))
+				}
 			} else {
 				g.Expect(err.Error()).To(Equal(test.err.Error()))
 			}
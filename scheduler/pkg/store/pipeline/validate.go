@@ -10,7 +10,10 @@ the Change License after the Change Date as each is defined in accordance with t
 package pipeline
 
 import (
+	"regexp"
 	"strings"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
 )
 
 // Step inputs can be reference a previous step name and tensor output/input
@@ -54,6 +57,24 @@ func validate(pv *PipelineVersion) error {
 	if err := checkPipelineInput(pv); err != nil {
 		return err
 	}
+	if err := checkStepTransforms(pv); err != nil {
+		return err
+	}
+	if err := checkStepForEach(pv); err != nil {
+		return err
+	}
+	if err := checkPipelineSchema(pv); err != nil {
+		return err
+	}
+	if err := checkPipelineTopicConfig(pv); err != nil {
+		return err
+	}
+	if err := checkPipelineTrafficPolicy(pv); err != nil {
+		return err
+	}
+	if err := checkPipelineKafkaTopics(pv); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -229,7 +250,7 @@ const (
 
 func checkPipelineInput(pv *PipelineVersion) error {
 	if pv.Input != nil {
-		if len(pv.Input.ExternalInputs) == 0 {
+		if len(pv.Input.ExternalInputs) == 0 && len(pv.Input.KafkaSources) == 0 {
 			return &PipelineInputErr{pv.Name, "", pipelineInputEmptyErr}
 		}
 		for _, v := range pv.Input.ExternalInputs {
@@ -292,3 +313,449 @@ func checkPipelineOutputs(pv *PipelineVersion) error {
 	}
 	return nil
 }
+
+const (
+	transformNoTensorsReason       = "at least one input tensor must be specified"
+	transformNoTensorsAllowed      = "input tensors must not be specified"
+	transformSingleTensorReason    = "exactly one input tensor must be specified"
+	transformMultipleTensorsReason = "at least two input tensors must be specified"
+	transformSingleOutputReason    = "exactly one output tensor must be specified"
+	transformMultipleOutputsReason = "at least two output tensors must be specified"
+	transformNoOutputsAllowed      = "output tensors must not be specified"
+	transformOutputsMismatchReason = "output tensors must be empty or match the number of input tensors"
+	transformDatatypeReason        = "datatype must be one of BOOL, UINT8, UINT16, UINT32, UINT64, INT8, INT16, INT32, INT64, FP32, FP64, BYTES"
+	transformShapeReason           = "shape must be non-empty with positive dimensions and at most one -1"
+	transformConstantValuesReason  = "number of values must match the shape"
+	transformJsonPathReason        = "path must start with $"
+	transformBatchReason           = "batch can not be used with transforms"
+	transformUnknownTypeReason     = "unknown transform type"
+	transformModelNameReason       = "step name is the name of a model, whose outputs topic transforms can not write to"
+)
+
+var transformDatatypes = map[string]bool{
+	"BOOL":   true,
+	"UINT8":  true,
+	"UINT16": true,
+	"UINT32": true,
+	"UINT64": true,
+	"INT8":   true,
+	"INT16":  true,
+	"INT32":  true,
+	"INT64":  true,
+	"FP32":   true,
+	"FP64":   true,
+	"BYTES":  true,
+}
+
+func checkStepTransforms(pv *PipelineVersion) error {
+	for _, v := range pv.Steps {
+		if !v.IsTransform() {
+			continue
+		}
+		if v.Batch != nil {
+			return &PipelineStepTransformErr{pv.Name, v.Name, "", transformBatchReason}
+		}
+		for _, transform := range v.Transforms {
+			if reason := checkTransform(transform); reason != "" {
+				return &PipelineStepTransformErr{pv.Name, v.Name, transform.Type.String(), reason}
+			}
+		}
+	}
+	return nil
+}
+
+func checkTransform(transform *Transform) string {
+	switch transform.Type {
+	case TransformConcat:
+		if len(transform.Tensors) < 2 {
+			return transformMultipleTensorsReason
+		}
+		if len(transform.Outputs) != 1 {
+			return transformSingleOutputReason
+		}
+	case TransformSplit:
+		if len(transform.Tensors) != 1 {
+			return transformSingleTensorReason
+		}
+		if len(transform.Outputs) < 2 {
+			return transformMultipleOutputsReason
+		}
+	case TransformSelect:
+		if len(transform.Tensors) == 0 {
+			return transformNoTensorsReason
+		}
+		if len(transform.Outputs) != 0 {
+			return transformNoOutputsAllowed
+		}
+	case TransformCast:
+		if len(transform.Tensors) == 0 {
+			return transformNoTensorsReason
+		}
+		if len(transform.Outputs) != 0 && len(transform.Outputs) != len(transform.Tensors) {
+			return transformOutputsMismatchReason
+		}
+		if !transformDatatypes[transform.Datatype] {
+			return transformDatatypeReason
+		}
+	case TransformReshape:
+		if len(transform.Tensors) != 1 {
+			return transformSingleTensorReason
+		}
+		if len(transform.Outputs) > 1 {
+			return transformOutputsMismatchReason
+		}
+		if !validTransformShape(transform.Shape, true) {
+			return transformShapeReason
+		}
+	case TransformConstant:
+		if len(transform.Tensors) != 0 {
+			return transformNoTensorsAllowed
+		}
+		if len(transform.Outputs) != 1 {
+			return transformSingleOutputReason
+		}
+		if !transformDatatypes[transform.Datatype] {
+			return transformDatatypeReason
+		}
+		if !validTransformShape(transform.Shape, false) {
+			return transformShapeReason
+		}
+		numElements := int64(1)
+		for _, dim := range transform.Shape {
+			numElements *= dim
+		}
+		if int64(len(transform.Values)) != numElements {
+			return transformConstantValuesReason
+		}
+	case TransformJsonPath:
+		if len(transform.Tensors) != 1 {
+			return transformSingleTensorReason
+		}
+		if len(transform.Outputs) != 1 {
+			return transformSingleOutputReason
+		}
+		if !strings.HasPrefix(transform.Path, "$") {
+			return transformJsonPathReason
+		}
+		if transform.Datatype != "" && !transformDatatypes[transform.Datatype] {
+			return transformDatatypeReason
+		}
+	default:
+		return transformUnknownTypeReason
+	}
+	return ""
+}
+
+func validTransformShape(shape []int64, allowWildcard bool) bool {
+	if len(shape) == 0 {
+		return false
+	}
+	wildcards := 0
+	for _, dim := range shape {
+		switch {
+		case dim == -1 && allowWildcard:
+			wildcards++
+		case dim <= 0:
+			return false
+		}
+	}
+	return wildcards <= 1
+}
+
+const (
+	forEachTensorReason    = "a tensor to split must be specified"
+	forEachBatchReason     = "batch can not be used with forEach"
+	forEachTransformReason = "transforms can not be used with forEach"
+)
+
+func checkStepForEach(pv *PipelineVersion) error {
+	for _, v := range pv.Steps {
+		if v.ForEach == nil {
+			continue
+		}
+		if v.ForEach.Tensor == "" {
+			return &PipelineStepForEachErr{pv.Name, v.Name, forEachTensorReason}
+		}
+		if v.Batch != nil {
+			return &PipelineStepForEachErr{pv.Name, v.Name, forEachBatchReason}
+		}
+		if v.IsTransform() {
+			return &PipelineStepForEachErr{pv.Name, v.Name, forEachTransformReason}
+		}
+	}
+	return nil
+}
+
+const (
+	schemaNameReason     = "tensor name must be specified"
+	schemaRepeatedReason = "tensor name must be unique"
+	schemaShapeReason    = "shape dimensions must be -1 or non-negative"
+)
+
+func checkPipelineSchema(pv *PipelineVersion) error {
+	if pv.Schema == nil {
+		return nil
+	}
+	for _, tensors := range [][]*TensorSchema{pv.Schema.Inputs, pv.Schema.Outputs} {
+		names := make(map[string]bool)
+		for _, tensor := range tensors {
+			if reason := checkTensorSchema(tensor, names); reason != "" {
+				return &PipelineSchemaErr{pv.Name, tensor.Name, reason}
+			}
+			names[tensor.Name] = true
+		}
+	}
+	return nil
+}
+
+func checkTensorSchema(tensor *TensorSchema, names map[string]bool) string {
+	if tensor.Name == "" {
+		return schemaNameReason
+	}
+	if names[tensor.Name] {
+		return schemaRepeatedReason
+	}
+	if tensor.Datatype != "" && !transformDatatypes[tensor.Datatype] {
+		return transformDatatypeReason
+	}
+	for _, dim := range tensor.Shape {
+		if dim < -1 {
+			return schemaShapeReason
+		}
+	}
+	return ""
+}
+
+func checkPipelineTopicConfig(pv *PipelineVersion) error {
+	if reason := store.CheckTopicConfig(createProtoTopicConfig(pv.TopicConfig)); reason != "" {
+		return &PipelineTopicConfigErr{pv.Name, reason}
+	}
+	return nil
+}
+
+// checkJoinedTopicPartitions checks the topics joined by the inputs, steps and outputs of a pipeline have the same
+// number of partitions, as Kafka Streams only joins records read from the same partition. A forEach step also
+// gathers the outputs of its model with the requests read from its inputs. topicPartitions returns the partitions
+// set for the topic of an input, or 0 for the default of the installation. Kafka sources are not checked, as their
+// partitions are not known to the scheduler.
+func checkJoinedTopicPartitions(pv *PipelineVersion, topicPartitions func(input string) uint32) error {
+	if pv.Input != nil {
+		inputs := append(append([]string{}, pv.Input.ExternalInputs...), pv.Input.ExternalTriggers...)
+		if !haveSamePartitions(inputs, topicPartitions) {
+			return &PipelineJoinPartitionsErr{pv.Name, StepInputSpecifier}
+		}
+	}
+	for _, step := range pv.Steps {
+		inputs := step.Inputs
+		if len(inputs) == 0 {
+			inputs = []string{pv.Name + StepNameSeperator + StepInputSpecifier}
+		}
+		inputs = append(append([]string{}, inputs...), step.Triggers...)
+		if step.ForEach != nil {
+			inputs = append(inputs, step.Name+StepNameSeperator+StepOutputSpecifier)
+		}
+		if !haveSamePartitions(inputs, topicPartitions) {
+			return &PipelineJoinPartitionsErr{pv.Name, "step " + step.Name}
+		}
+	}
+	if pv.Output != nil && !haveSamePartitions(pv.Output.Steps, topicPartitions) {
+		return &PipelineJoinPartitionsErr{pv.Name, StepOutputSpecifier}
+	}
+	return nil
+}
+
+func haveSamePartitions(inputs []string, topicPartitions func(input string) uint32) bool {
+	for _, input := range inputs {
+		if topicPartitions(input) != topicPartitions(inputs[0]) {
+			return false
+		}
+	}
+	return true
+}
+
+const (
+	trafficRetryOnReason            = "retry conditions are required when retries are set"
+	trafficConsecutiveErrorsReason  = "outlier detection consecutive errors must be greater than 0"
+	trafficMaxEjectionPercentReason = "outlier detection max ejection percent must be at most 100"
+	trafficRateLimitRequestsReason  = "rate limit requests must be greater than 0"
+	trafficRateLimitHeaderReason    = "rate limit quotas require a header"
+	trafficRateLimitQuotaReason     = "rate limit quotas must have a unique value and requests greater than 0"
+)
+
+func checkPipelineTrafficPolicy(pv *PipelineVersion) error {
+	tp := pv.TrafficPolicy
+	if tp == nil {
+		return nil
+	}
+	if tp.Retry != nil && tp.Retry.NumRetries > 0 && tp.Retry.RetryOn == "" {
+		return &PipelineTrafficPolicyErr{pv.Name, trafficRetryOnReason}
+	}
+	if tp.OutlierDetection != nil {
+		if tp.OutlierDetection.ConsecutiveErrors == 0 {
+			return &PipelineTrafficPolicyErr{pv.Name, trafficConsecutiveErrorsReason}
+		}
+		if tp.OutlierDetection.MaxEjectionPercent != nil && *tp.OutlierDetection.MaxEjectionPercent > 100 {
+			return &PipelineTrafficPolicyErr{pv.Name, trafficMaxEjectionPercentReason}
+		}
+	}
+	if tp.RateLimit != nil {
+		if reason := checkRateLimit(tp.RateLimit); reason != "" {
+			return &PipelineTrafficPolicyErr{pv.Name, reason}
+		}
+	}
+	return nil
+}
+
+func checkRateLimit(rateLimit *RouteRateLimit) string {
+	if rateLimit.Requests != nil && *rateLimit.Requests == 0 {
+		return trafficRateLimitRequestsReason
+	}
+	if len(rateLimit.Quotas) > 0 && (rateLimit.Header == nil || *rateLimit.Header == "") {
+		return trafficRateLimitHeaderReason
+	}
+	values := make(map[string]bool)
+	for _, quota := range rateLimit.Quotas {
+		if quota.Requests == 0 || values[quota.Value] {
+			return trafficRateLimitQuotaReason
+		}
+		values[quota.Value] = true
+	}
+	return ""
+}
+
+const (
+	kafkaTopicNameReason       = "topic must be 1 to 249 characters of a-z, A-Z, 0-9, '.', '_' or '-'"
+	kafkaTopicRepeatedReason   = "topic must only be listed once"
+	kafkaCsvTensorsReason      = "csv sources must list at least one tensor"
+	kafkaBytesTensorsReason    = "bytes sources and sinks must list at most one tensor"
+	kafkaBytesDatatypeReason   = "bytes source tensors must have datatype BYTES"
+	kafkaSourceShapeReason     = "shape must have positive dimensions"
+	kafkaSinkTensorReason      = "sink tensors must only set a name"
+	kafkaSinkOutputStepsReason = "sinks require output steps"
+	kafkaSourceAndSinkReason   = "topic must not be both a source and a sink"
+	kafkaUnknownFormatReason   = "format must be json, csv or bytes"
+	kafkaInternalTopicReason   = "topic must not be a Seldon topic"
+)
+
+var kafkaTopicNameRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,249}$`)
+
+func checkPipelineKafkaTopics(pv *PipelineVersion) error {
+	if pv.Input != nil {
+		topics := make(map[string]bool)
+		for _, source := range pv.Input.KafkaSources {
+			if reason := checkKafkaTopic(source, topics); reason != "" {
+				return &PipelineKafkaTopicErr{pv.Name, "source", source.Topic, reason}
+			}
+			if reason := checkKafkaSourceTensors(source); reason != "" {
+				return &PipelineKafkaTopicErr{pv.Name, "source", source.Topic, reason}
+			}
+		}
+	}
+	if pv.Output != nil {
+		topics := make(map[string]bool)
+		for _, sink := range pv.Output.KafkaSinks {
+			if len(pv.Output.Steps) == 0 {
+				return &PipelineKafkaTopicErr{pv.Name, "sink", sink.Topic, kafkaSinkOutputStepsReason}
+			}
+			if reason := checkKafkaTopic(sink, topics); reason != "" {
+				return &PipelineKafkaTopicErr{pv.Name, "sink", sink.Topic, reason}
+			}
+			// The outputs of the pipeline would be read again as its inputs
+			if isKafkaSource(pv, sink.Topic) {
+				return &PipelineKafkaTopicErr{pv.Name, "sink", sink.Topic, kafkaSourceAndSinkReason}
+			}
+			if reason := checkKafkaSinkTensors(sink); reason != "" {
+				return &PipelineKafkaTopicErr{pv.Name, "sink", sink.Topic, reason}
+			}
+		}
+	}
+	return nil
+}
+
+func isKafkaSource(pv *PipelineVersion, topic string) bool {
+	if pv.Input == nil {
+		return false
+	}
+	for _, source := range pv.Input.KafkaSources {
+		if source.Topic == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// checkPipelineKafkaTopicsNotInternal rejects Kafka sources and sinks of the topics of Seldon in the namespace, as
+// pipelines would read or write the messages of models and pipelines without their headers and schema
+func checkPipelineKafkaTopicsNotInternal(pv *PipelineVersion, internalTopicPrefix string) error {
+	if internalTopicPrefix == "" {
+		return nil
+	}
+	if pv.Input != nil {
+		for _, source := range pv.Input.KafkaSources {
+			if strings.HasPrefix(source.Topic, internalTopicPrefix) {
+				return &PipelineKafkaTopicErr{pv.Name, "source", source.Topic, kafkaInternalTopicReason}
+			}
+		}
+	}
+	if pv.Output != nil {
+		for _, sink := range pv.Output.KafkaSinks {
+			if strings.HasPrefix(sink.Topic, internalTopicPrefix) {
+				return &PipelineKafkaTopicErr{pv.Name, "sink", sink.Topic, kafkaInternalTopicReason}
+			}
+		}
+	}
+	return nil
+}
+
+func checkKafkaTopic(topic *KafkaTopic, topics map[string]bool) string {
+	if !kafkaTopicNameRegex.MatchString(topic.Topic) || topic.Topic == "." || topic.Topic == ".." {
+		return kafkaTopicNameReason
+	}
+	if topics[topic.Topic] {
+		return kafkaTopicRepeatedReason
+	}
+	topics[topic.Topic] = true
+	if int(topic.Format) >= len(kafkaFormatNames) {
+		return kafkaUnknownFormatReason
+	}
+	if topic.Format == KafkaFormatBytes && len(topic.Tensors) > 1 {
+		return kafkaBytesTensorsReason
+	}
+	return ""
+}
+
+func checkKafkaSourceTensors(source *KafkaTopic) string {
+	if source.Format == KafkaFormatCsv && len(source.Tensors) == 0 {
+		return kafkaCsvTensorsReason
+	}
+	names := make(map[string]bool)
+	for _, tensor := range source.Tensors {
+		if reason := checkTensorSchema(tensor, names); reason != "" {
+			return reason
+		}
+		names[tensor.Name] = true
+		if source.Format == KafkaFormatBytes && tensor.Datatype != "" && tensor.Datatype != "BYTES" {
+			return kafkaBytesDatatypeReason
+		}
+		for _, dim := range tensor.Shape {
+			if dim <= 0 {
+				return kafkaSourceShapeReason
+			}
+		}
+	}
+	return ""
+}
+
+func checkKafkaSinkTensors(sink *KafkaTopic) string {
+	names := make(map[string]bool)
+	for _, tensor := range sink.Tensors {
+		if reason := checkTensorSchema(tensor, names); reason != "" {
+			return reason
+		}
+		names[tensor.Name] = true
+		if tensor.Datatype != "" || len(tensor.Shape) > 0 {
+			return kafkaSinkTensorReason
+		}
+	}
+	return ""
+}
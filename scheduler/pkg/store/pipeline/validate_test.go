@@ -13,6 +13,8 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
 )
 
 type validateTest struct {
@@ -553,6 +555,20 @@ func TestPipelineInput(t *testing.T) {
 			},
 			err: &PipelineInputErr{"test", "", pipelineInputEmptyErr},
 		},
+		{
+			name: "Only kafka sources",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Input: &PipelineInput{
+					KafkaSources: []*KafkaTopic{{Topic: "events"}},
+				},
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+					},
+				},
+			},
+		},
 		{
 			name: "Valid pipeline input",
 			pipelineVersion: &PipelineVersion{
@@ -845,3 +861,826 @@ func TestCheckStepOutputs(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckStepTransforms(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []validateTest{
+		{
+			name: "valid transforms",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+						Transforms: []*Transform{
+							{Type: TransformConcat, Tensors: []string{"t1", "t2"}, Outputs: []string{"t3"}},
+							{Type: TransformSplit, Tensors: []string{"t3"}, Outputs: []string{"t4", "t5"}},
+							{Type: TransformCast, Tensors: []string{"t4"}, Datatype: "FP32"},
+							{Type: TransformReshape, Tensors: []string{"t4"}, Shape: []int64{-1, 2}},
+							{Type: TransformConstant, Outputs: []string{"c"}, Datatype: "INT32", Shape: []int64{1, 2}, Values: []string{"1", "2"}},
+							{Type: TransformJsonPath, Tensors: []string{"json"}, Outputs: []string{"j"}, Path: "$.a.b[0]"},
+							{Type: TransformSelect, Tensors: []string{"t4", "t5", "c", "j"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "concat needs two tensors",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+						Transforms: []*Transform{
+							{Type: TransformConcat, Tensors: []string{"t1"}, Outputs: []string{"t3"}},
+						},
+					},
+				},
+			},
+			err: &PipelineStepTransformErr{pipeline: "test", step: "a", transform: "concat", reason: transformMultipleTensorsReason},
+		},
+		{
+			name: "split needs two outputs",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+						Transforms: []*Transform{
+							{Type: TransformSplit, Tensors: []string{"t1"}, Outputs: []string{"t2"}},
+						},
+					},
+				},
+			},
+			err: &PipelineStepTransformErr{pipeline: "test", step: "a", transform: "split", reason: transformMultipleOutputsReason},
+		},
+		{
+			name: "cast bad datatype",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+						Transforms: []*Transform{
+							{Type: TransformCast, Tensors: []string{"t1"}, Datatype: "FP16"},
+						},
+					},
+				},
+			},
+			err: &PipelineStepTransformErr{pipeline: "test", step: "a", transform: "cast", reason: transformDatatypeReason},
+		},
+		{
+			name: "reshape two wildcards",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+						Transforms: []*Transform{
+							{Type: TransformReshape, Tensors: []string{"t1"}, Shape: []int64{-1, -1}},
+						},
+					},
+				},
+			},
+			err: &PipelineStepTransformErr{pipeline: "test", step: "a", transform: "reshape", reason: transformShapeReason},
+		},
+		{
+			name: "constant values do not match shape",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+						Transforms: []*Transform{
+							{Type: TransformConstant, Outputs: []string{"c"}, Datatype: "INT32", Shape: []int64{2, 2}, Values: []string{"1", "2"}},
+						},
+					},
+				},
+			},
+			err: &PipelineStepTransformErr{pipeline: "test", step: "a", transform: "constant", reason: transformConstantValuesReason},
+		},
+		{
+			name: "json path bad path",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+						Transforms: []*Transform{
+							{Type: TransformJsonPath, Tensors: []string{"t1"}, Outputs: []string{"t2"}, Path: "a.b"},
+						},
+					},
+				},
+			},
+			err: &PipelineStepTransformErr{pipeline: "test", step: "a", transform: "jsonPath", reason: transformJsonPathReason},
+		},
+		{
+			name: "unknown transform type",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+						Transforms: []*Transform{
+							{Type: TransformType(100), Tensors: []string{"t1"}},
+						},
+					},
+				},
+			},
+			err: &PipelineStepTransformErr{pipeline: "test", step: "a", transform: "unknown(100)", reason: transformUnknownTypeReason},
+		},
+		{
+			name: "batch not allowed with transforms",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name:  "a",
+						Batch: &Batch{},
+						Transforms: []*Transform{
+							{Type: TransformSelect, Tensors: []string{"t1"}},
+						},
+					},
+				},
+			},
+			err: &PipelineStepTransformErr{pipeline: "test", step: "a", reason: transformBatchReason},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkStepTransforms(test.pipelineVersion)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+			err = validate(test.pipelineVersion)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+		})
+	}
+}
+
+func TestCheckStepForEach(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []validateTest{
+		{
+			name: "valid forEach",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name:    "a",
+						ForEach: &ForEach{Tensor: "boxes", Axis: 1},
+					},
+				},
+			},
+		},
+		{
+			name: "forEach needs tensor",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name:    "a",
+						ForEach: &ForEach{},
+					},
+				},
+			},
+			err: &PipelineStepForEachErr{pipeline: "test", step: "a", reason: forEachTensorReason},
+		},
+		{
+			name: "batch not allowed with forEach",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name:    "a",
+						Batch:   &Batch{},
+						ForEach: &ForEach{Tensor: "boxes"},
+					},
+				},
+			},
+			err: &PipelineStepForEachErr{pipeline: "test", step: "a", reason: forEachBatchReason},
+		},
+		{
+			name: "transforms not allowed with forEach",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name:    "a",
+						ForEach: &ForEach{Tensor: "boxes"},
+						Transforms: []*Transform{
+							{Type: TransformSelect, Tensors: []string{"t1"}},
+						},
+					},
+				},
+			},
+			err: &PipelineStepForEachErr{pipeline: "test", step: "a", reason: forEachTransformReason},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkStepForEach(test.pipelineVersion)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+			err = validate(test.pipelineVersion)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+		})
+	}
+}
+
+func TestCheckPipelineSchema(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []validateTest{
+		{
+			name: "valid schema",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {Name: "a"},
+				},
+				Schema: &PipelineSchema{
+					Inputs:  []*TensorSchema{{Name: "x", Datatype: "FP32", Shape: []int64{-1, 4}}, {Name: "y"}},
+					Outputs: []*TensorSchema{{Name: "x", Datatype: "INT64"}},
+				},
+			},
+		},
+		{
+			name: "tensor needs name",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {Name: "a"},
+				},
+				Schema: &PipelineSchema{
+					Inputs: []*TensorSchema{{Datatype: "FP32"}},
+				},
+			},
+			err: &PipelineSchemaErr{pipeline: "test", reason: schemaNameReason},
+		},
+		{
+			name: "repeated tensor",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {Name: "a"},
+				},
+				Schema: &PipelineSchema{
+					Outputs: []*TensorSchema{{Name: "x"}, {Name: "x"}},
+				},
+			},
+			err: &PipelineSchemaErr{pipeline: "test", tensor: "x", reason: schemaRepeatedReason},
+		},
+		{
+			name: "invalid datatype",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {Name: "a"},
+				},
+				Schema: &PipelineSchema{
+					Inputs: []*TensorSchema{{Name: "x", Datatype: "FLOAT"}},
+				},
+			},
+			err: &PipelineSchemaErr{pipeline: "test", tensor: "x", reason: transformDatatypeReason},
+		},
+		{
+			name: "invalid shape",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {Name: "a"},
+				},
+				Schema: &PipelineSchema{
+					Inputs: []*TensorSchema{{Name: "x", Shape: []int64{1, -2}}},
+				},
+			},
+			err: &PipelineSchemaErr{pipeline: "test", tensor: "x", reason: schemaShapeReason},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkPipelineSchema(test.pipelineVersion)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+			err = validate(test.pipelineVersion)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+		})
+	}
+}
+
+func TestCheckPipelineTopicConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	getUintPtr := func(val uint32) *uint32 { return &val }
+	getInt64Ptr := func(val int64) *int64 { return &val }
+	getStringPtr := func(val string) *string { return &val }
+	tests := []validateTest{
+		{
+			name: "no topic config",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+			},
+		},
+		{
+			name: "valid topic config",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				TopicConfig: &TopicConfig{
+					Partitions:        getUintPtr(4),
+					ReplicationFactor: getUintPtr(3),
+					RetentionMs:       getInt64Ptr(-1),
+					CleanupPolicy:     getStringPtr("compact,delete"),
+					CompressionType:   getStringPtr("zstd"),
+				},
+			},
+		},
+		{
+			name: "zero partitions",
+			pipelineVersion: &PipelineVersion{
+				Name:        "test",
+				TopicConfig: &TopicConfig{Partitions: getUintPtr(0)},
+			},
+			err: &PipelineTopicConfigErr{pipeline: "test", reason: store.TopicPartitionsReason},
+		},
+		{
+			name: "zero replication factor",
+			pipelineVersion: &PipelineVersion{
+				Name:        "test",
+				TopicConfig: &TopicConfig{ReplicationFactor: getUintPtr(0)},
+			},
+			err: &PipelineTopicConfigErr{pipeline: "test", reason: store.TopicReplicationFactorReason},
+		},
+		{
+			name: "invalid retention",
+			pipelineVersion: &PipelineVersion{
+				Name:        "test",
+				TopicConfig: &TopicConfig{RetentionMs: getInt64Ptr(-2)},
+			},
+			err: &PipelineTopicConfigErr{pipeline: "test", reason: store.TopicRetentionReason},
+		},
+		{
+			name: "invalid cleanup policy",
+			pipelineVersion: &PipelineVersion{
+				Name:        "test",
+				TopicConfig: &TopicConfig{CleanupPolicy: getStringPtr("forever")},
+			},
+			err: &PipelineTopicConfigErr{pipeline: "test", reason: store.TopicCleanupPolicyReason},
+		},
+		{
+			name: "invalid compression type",
+			pipelineVersion: &PipelineVersion{
+				Name:        "test",
+				TopicConfig: &TopicConfig{CompressionType: getStringPtr("brotli")},
+			},
+			err: &PipelineTopicConfigErr{pipeline: "test", reason: store.TopicCompressionTypeReason},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkPipelineTopicConfig(test.pipelineVersion)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+		})
+	}
+}
+
+func TestCheckPipelineTrafficPolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+	getUintPtr := func(val uint32) *uint32 { return &val }
+	getStrPtr := func(val string) *string { return &val }
+	tests := []validateTest{
+		{
+			name: "no traffic policy",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+			},
+		},
+		{
+			name: "valid traffic policy",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				TrafficPolicy: &TrafficPolicy{
+					TimeoutMs:      getUintPtr(1000),
+					Retry:          &RetryPolicy{RetryOn: "5xx,reset", NumRetries: 3},
+					CircuitBreaker: &CircuitBreaker{MaxPendingRequests: getUintPtr(10)},
+					OutlierDetection: &OutlierDetection{
+						ConsecutiveErrors:  5,
+						MaxEjectionPercent: getUintPtr(100),
+					},
+				},
+			},
+		},
+		{
+			name: "retries without conditions",
+			pipelineVersion: &PipelineVersion{
+				Name:          "test",
+				TrafficPolicy: &TrafficPolicy{Retry: &RetryPolicy{NumRetries: 3}},
+			},
+			err: &PipelineTrafficPolicyErr{pipeline: "test", reason: trafficRetryOnReason},
+		},
+		{
+			name: "outlier detection without consecutive errors",
+			pipelineVersion: &PipelineVersion{
+				Name:          "test",
+				TrafficPolicy: &TrafficPolicy{OutlierDetection: &OutlierDetection{}},
+			},
+			err: &PipelineTrafficPolicyErr{pipeline: "test", reason: trafficConsecutiveErrorsReason},
+		},
+		{
+			name: "max ejection percent too large",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				TrafficPolicy: &TrafficPolicy{
+					OutlierDetection: &OutlierDetection{ConsecutiveErrors: 5, MaxEjectionPercent: getUintPtr(101)},
+				},
+			},
+			err: &PipelineTrafficPolicyErr{pipeline: "test", reason: trafficMaxEjectionPercentReason},
+		},
+		{
+			name: "valid rate limit",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				TrafficPolicy: &TrafficPolicy{
+					RateLimit: &RouteRateLimit{
+						Unit:     RateLimitUnitMinute,
+						Requests: getUintPtr(600),
+						Header:   getStrPtr("x-tenant"),
+						Quotas: []*RateLimitQuota{
+							{Value: "tenant-a", Requests: 100},
+							{Value: "tenant-b", Requests: 10},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "rate limit with zero requests",
+			pipelineVersion: &PipelineVersion{
+				Name:          "test",
+				TrafficPolicy: &TrafficPolicy{RateLimit: &RouteRateLimit{Requests: getUintPtr(0)}},
+			},
+			err: &PipelineTrafficPolicyErr{pipeline: "test", reason: trafficRateLimitRequestsReason},
+		},
+		{
+			name: "rate limit quotas without header",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				TrafficPolicy: &TrafficPolicy{
+					RateLimit: &RouteRateLimit{Quotas: []*RateLimitQuota{{Value: "tenant-a", Requests: 100}}},
+				},
+			},
+			err: &PipelineTrafficPolicyErr{pipeline: "test", reason: trafficRateLimitHeaderReason},
+		},
+		{
+			name: "rate limit repeated quota",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				TrafficPolicy: &TrafficPolicy{
+					RateLimit: &RouteRateLimit{
+						Header: getStrPtr("x-tenant"),
+						Quotas: []*RateLimitQuota{
+							{Value: "tenant-a", Requests: 100},
+							{Value: "tenant-a", Requests: 10},
+						},
+					},
+				},
+			},
+			err: &PipelineTrafficPolicyErr{pipeline: "test", reason: trafficRateLimitQuotaReason},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkPipelineTrafficPolicy(test.pipelineVersion)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+		})
+	}
+}
+
+func TestCheckPipelineKafkaTopics(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []validateTest{
+		{
+			name: "no kafka topics",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+			},
+		},
+		{
+			name: "valid sources and sinks",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Input: &PipelineInput{
+					KafkaSources: []*KafkaTopic{
+						{Topic: "events", Format: KafkaFormatJson},
+						{Topic: "rows", Format: KafkaFormatCsv, Tensors: []*TensorSchema{{Name: "x", Datatype: "FP32", Shape: []int64{4}}}},
+						{Topic: "images", Format: KafkaFormatBytes, Tensors: []*TensorSchema{{Name: "image"}}},
+					},
+				},
+				Output: &PipelineOutput{
+					Steps: []string{"a"},
+					KafkaSinks: []*KafkaTopic{
+						{Topic: "predictions", Format: KafkaFormatCsv, Tensors: []*TensorSchema{{Name: "y"}}},
+						{Topic: "alerts", Format: KafkaFormatJson},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid topic name",
+			pipelineVersion: &PipelineVersion{
+				Name:  "test",
+				Input: &PipelineInput{KafkaSources: []*KafkaTopic{{Topic: "my topic"}}},
+			},
+			err: &PipelineKafkaTopicErr{pipeline: "test", kind: "source", topic: "my topic", reason: kafkaTopicNameReason},
+		},
+		{
+			name: "repeated source",
+			pipelineVersion: &PipelineVersion{
+				Name:  "test",
+				Input: &PipelineInput{KafkaSources: []*KafkaTopic{{Topic: "events"}, {Topic: "events"}}},
+			},
+			err: &PipelineKafkaTopicErr{pipeline: "test", kind: "source", topic: "events", reason: kafkaTopicRepeatedReason},
+		},
+		{
+			name: "csv source without tensors",
+			pipelineVersion: &PipelineVersion{
+				Name:  "test",
+				Input: &PipelineInput{KafkaSources: []*KafkaTopic{{Topic: "rows", Format: KafkaFormatCsv}}},
+			},
+			err: &PipelineKafkaTopicErr{pipeline: "test", kind: "source", topic: "rows", reason: kafkaCsvTensorsReason},
+		},
+		{
+			name: "bytes source with many tensors",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Input: &PipelineInput{KafkaSources: []*KafkaTopic{
+					{Topic: "images", Format: KafkaFormatBytes, Tensors: []*TensorSchema{{Name: "a"}, {Name: "b"}}},
+				}},
+			},
+			err: &PipelineKafkaTopicErr{pipeline: "test", kind: "source", topic: "images", reason: kafkaBytesTensorsReason},
+		},
+		{
+			name: "bytes source with numeric datatype",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Input: &PipelineInput{KafkaSources: []*KafkaTopic{
+					{Topic: "images", Format: KafkaFormatBytes, Tensors: []*TensorSchema{{Name: "a", Datatype: "FP32"}}},
+				}},
+			},
+			err: &PipelineKafkaTopicErr{pipeline: "test", kind: "source", topic: "images", reason: kafkaBytesDatatypeReason},
+		},
+		{
+			name: "source shape with wildcard",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Input: &PipelineInput{KafkaSources: []*KafkaTopic{
+					{Topic: "rows", Format: KafkaFormatCsv, Tensors: []*TensorSchema{{Name: "x", Shape: []int64{-1}}}},
+				}},
+			},
+			err: &PipelineKafkaTopicErr{pipeline: "test", kind: "source", topic: "rows", reason: kafkaSourceShapeReason},
+		},
+		{
+			name: "source with invalid datatype",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Input: &PipelineInput{KafkaSources: []*KafkaTopic{
+					{Topic: "events", Tensors: []*TensorSchema{{Name: "x", Datatype: "FLOAT"}}},
+				}},
+			},
+			err: &PipelineKafkaTopicErr{pipeline: "test", kind: "source", topic: "events", reason: transformDatatypeReason},
+		},
+		{
+			name: "sink tensor with datatype",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Output: &PipelineOutput{
+					Steps:      []string{"a"},
+					KafkaSinks: []*KafkaTopic{{Topic: "predictions", Tensors: []*TensorSchema{{Name: "y", Datatype: "FP32"}}}},
+				},
+			},
+			err: &PipelineKafkaTopicErr{pipeline: "test", kind: "sink", topic: "predictions", reason: kafkaSinkTensorReason},
+		},
+		{
+			name: "sink without output steps",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Output: &PipelineOutput{
+					KafkaSinks: []*KafkaTopic{{Topic: "predictions"}},
+				},
+			},
+			err: &PipelineKafkaTopicErr{pipeline: "test", kind: "sink", topic: "predictions", reason: kafkaSinkOutputStepsReason},
+		},
+		{
+			name: "unknown format",
+			pipelineVersion: &PipelineVersion{
+				Name:  "test",
+				Input: &PipelineInput{KafkaSources: []*KafkaTopic{{Topic: "events", Format: KafkaFormat(10)}}},
+			},
+			err: &PipelineKafkaTopicErr{pipeline: "test", kind: "source", topic: "events", reason: kafkaUnknownFormatReason},
+		},
+		{
+			name: "source and sink",
+			pipelineVersion: &PipelineVersion{
+				Name:  "test",
+				Input: &PipelineInput{KafkaSources: []*KafkaTopic{{Topic: "events"}}},
+				Output: &PipelineOutput{
+					Steps:      []string{"a"},
+					KafkaSinks: []*KafkaTopic{{Topic: "events"}},
+				},
+			},
+			err: &PipelineKafkaTopicErr{pipeline: "test", kind: "sink", topic: "events", reason: kafkaSourceAndSinkReason},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkPipelineKafkaTopics(test.pipelineVersion)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+		})
+	}
+}
+
+func TestCheckPipelineKafkaTopicsNotInternal(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name                string
+		internalTopicPrefix string
+		pipelineVersion     *PipelineVersion
+		err                 error
+	}
+
+	tests := []test{
+		{
+			name:                "external topics",
+			internalTopicPrefix: "seldon.default.",
+			pipelineVersion: &PipelineVersion{
+				Name:   "test",
+				Input:  &PipelineInput{KafkaSources: []*KafkaTopic{{Topic: "seldon.other.events"}}},
+				Output: &PipelineOutput{Steps: []string{"a"}, KafkaSinks: []*KafkaTopic{{Topic: "predictions"}}},
+			},
+		},
+		{
+			name: "no prefix",
+			pipelineVersion: &PipelineVersion{
+				Name:  "test",
+				Input: &PipelineInput{KafkaSources: []*KafkaTopic{{Topic: "seldon.default.model.m1.outputs"}}},
+			},
+		},
+		{
+			name:                "internal source",
+			internalTopicPrefix: "seldon.default.",
+			pipelineVersion: &PipelineVersion{
+				Name:  "test",
+				Input: &PipelineInput{KafkaSources: []*KafkaTopic{{Topic: "seldon.default.model.m1.outputs"}}},
+			},
+			err: &PipelineKafkaTopicErr{pipeline: "test", kind: "source", topic: "seldon.default.model.m1.outputs", reason: kafkaInternalTopicReason},
+		},
+		{
+			name:                "internal sink",
+			internalTopicPrefix: "seldon.default.",
+			pipelineVersion: &PipelineVersion{
+				Name:   "test",
+				Output: &PipelineOutput{Steps: []string{"a"}, KafkaSinks: []*KafkaTopic{{Topic: "seldon.default.pipeline.p1.inputs"}}},
+			},
+			err: &PipelineKafkaTopicErr{pipeline: "test", kind: "sink", topic: "seldon.default.pipeline.p1.inputs", reason: kafkaInternalTopicReason},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkPipelineKafkaTopicsNotInternal(test.pipelineVersion, test.internalTopicPrefix)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+		})
+	}
+}
+
+func TestCheckJoinedTopicPartitions(t *testing.T) {
+	g := NewGomegaWithT(t)
+	partitions := map[string]uint32{
+		"a.outputs": 4,
+		"b.outputs": 4,
+		"c.outputs": 2,
+	}
+	topicPartitions := func(input string) uint32 { return partitions[input] }
+	tests := []validateTest{
+		{
+			name: "join of topics with the same partitions",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"d": {Name: "d", Inputs: []string{"a.outputs", "b.outputs"}},
+				},
+				Output: &PipelineOutput{Steps: []string{"a.outputs", "b.outputs"}},
+			},
+		},
+		{
+			name: "single input with different partitions is not a join",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"c": {Name: "c", Inputs: []string{"a.outputs"}},
+				},
+			},
+		},
+		{
+			name: "step inputs with different partitions",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"d": {Name: "d", Inputs: []string{"a.outputs", "c.outputs"}},
+				},
+			},
+			err: &PipelineJoinPartitionsErr{pipeline: "test", join: "step d"},
+		},
+		{
+			name: "trigger with different partitions",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"d": {Name: "d", Inputs: []string{"a.outputs"}, Triggers: []string{"c.outputs"}},
+				},
+			},
+			err: &PipelineJoinPartitionsErr{pipeline: "test", join: "step d"},
+		},
+		{
+			name: "forEach model with different partitions to its inputs",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"c": {Name: "c", Inputs: []string{"a.outputs"}, ForEach: &ForEach{Tensor: "t1"}},
+				},
+			},
+			err: &PipelineJoinPartitionsErr{pipeline: "test", join: "step c"},
+		},
+		{
+			name: "forEach step without inputs reads the pipeline inputs",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"c": {Name: "c", ForEach: &ForEach{Tensor: "t1"}},
+				},
+			},
+			err: &PipelineJoinPartitionsErr{pipeline: "test", join: "step c"},
+		},
+		{
+			name: "outputs with different partitions",
+			pipelineVersion: &PipelineVersion{
+				Name:   "test",
+				Output: &PipelineOutput{Steps: []string{"a.outputs", "c.outputs"}},
+			},
+			err: &PipelineJoinPartitionsErr{pipeline: "test", join: "outputs"},
+		},
+		{
+			name: "external inputs with different partitions",
+			pipelineVersion: &PipelineVersion{
+				Name:  "test",
+				Input: &PipelineInput{ExternalInputs: []string{"a.outputs"}, ExternalTriggers: []string{"c.outputs"}},
+			},
+			err: &PipelineJoinPartitionsErr{pipeline: "test", join: "inputs"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkJoinedTopicPartitions(test.pipelineVersion, topicPartitions)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package store
+
+import (
+	"time"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+type RolloutState uint32
+
+const (
+	RolloutProgressing RolloutState = iota
+	RolloutPaused
+	RolloutRolledBack
+	RolloutSucceeded
+)
+
+func (r RolloutState) String() string {
+	return [...]string{"RolloutProgressing", "RolloutPaused", "RolloutRolledBack", "RolloutSucceeded"}[r]
+}
+
+// ModelRollout tracks the shift of traffic from the stable version of a model to a new version
+type ModelRollout struct {
+	Version       uint32
+	StableVersion uint32
+	Step          int
+	State         RolloutState
+	Reason        string
+	StepStart     time.Time // zero until the new version is available
+	Timestamp     time.Time
+}
+
+// InProgress is true while the stable version is still needed, i.e. until the rollout succeeds
+func (r *ModelRollout) InProgress() bool {
+	return r != nil && r.State != RolloutSucceeded
+}
+
+// TrafficPercent returns the percentage of traffic sent to the new version
+func (r *ModelRollout) TrafficPercent(strategy *pb.RolloutStrategy) uint32 {
+	switch r.State {
+	case RolloutRolledBack:
+		return 0
+	case RolloutSucceeded:
+		return 100
+	}
+	steps := strategy.GetSteps()
+	if r.Step < len(steps) {
+		return steps[r.Step].GetTrafficPercent()
+	}
+	return 100
+}
+
+// startRollout begins a rollout of the latest version of the model if its spec asks for one. Traffic is shifted
+// away from the stable version of any rollout still in progress, so an abandoned version never becomes stable.
+func (m *Model) startRollout() {
+	latest := m.Latest()
+	strategy := latest.GetModel().GetModelSpec().GetRollout()
+	if len(strategy.GetSteps()) == 0 {
+		m.rollout = nil
+		return
+	}
+	var stable *ModelVersion
+	if m.rollout.InProgress() {
+		stable = m.GetVersion(m.rollout.StableVersion)
+	} else {
+		stable = m.GetLastAvailableModelVersion()
+	}
+	if stable == nil || stable.GetVersion() == latest.GetVersion() {
+		// Nothing is serving traffic yet so the new version takes it all once available
+		m.rollout = nil
+		return
+	}
+	m.rollout = &ModelRollout{
+		Version:       latest.GetVersion(),
+		StableVersion: stable.GetVersion(),
+		State:         RolloutProgressing,
+		Timestamp:     time.Now(),
+	}
+}
+
+func (m *Model) getRollout() *ModelRollout {
+	if m.rollout == nil {
+		return nil
+	}
+	rollout := *m.rollout
+	return &rollout
+}
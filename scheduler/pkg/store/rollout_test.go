@@ -0,0 +1,200 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package store
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+func TestStartRollout(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	strategy := &pb.RolloutStrategy{
+		Steps: []*pb.RolloutStep{{TrafficPercent: 10, HoldSeconds: 60}},
+	}
+	createModelVersion := func(version uint32, uri string, state ModelState) *ModelVersion {
+		return NewModelVersion(
+			&pb.Model{Meta: &pb.MetaData{Name: "model"}, ModelSpec: &pb.ModelSpec{Uri: uri, Rollout: strategy}},
+			version, "server", map[int]ReplicaStatus{}, false, state)
+	}
+
+	type test struct {
+		name            string
+		model           *Model
+		loadModelReq    *pb.LoadModelRequest
+		expectedRollout *ModelRollout
+	}
+
+	tests := []test{
+		{
+			name: "rollout from last available version",
+			model: &Model{
+				versions: []*ModelVersion{
+					createModelVersion(1, "gs://models/iris", ModelAvailable),
+				},
+			},
+			loadModelReq: &pb.LoadModelRequest{
+				Model: &pb.Model{Meta: &pb.MetaData{Name: "model"}, ModelSpec: &pb.ModelSpec{Uri: "gs://models/iris2", Rollout: strategy}},
+			},
+			expectedRollout: &ModelRollout{Version: 2, StableVersion: 1, State: RolloutProgressing},
+		},
+		{
+			name: "no rollout strategy",
+			model: &Model{
+				versions: []*ModelVersion{
+					createModelVersion(1, "gs://models/iris", ModelAvailable),
+				},
+			},
+			loadModelReq: &pb.LoadModelRequest{
+				Model: &pb.Model{Meta: &pb.MetaData{Name: "model"}, ModelSpec: &pb.ModelSpec{Uri: "gs://models/iris2"}},
+			},
+		},
+		{
+			name: "no available version",
+			model: &Model{
+				versions: []*ModelVersion{
+					createModelVersion(1, "gs://models/iris", ModelFailed),
+				},
+			},
+			loadModelReq: &pb.LoadModelRequest{
+				Model: &pb.Model{Meta: &pb.MetaData{Name: "model"}, ModelSpec: &pb.ModelSpec{Uri: "gs://models/iris2", Rollout: strategy}},
+			},
+		},
+		{
+			name: "keeps stable version of rollout in progress",
+			model: &Model{
+				versions: []*ModelVersion{
+					createModelVersion(1, "gs://models/iris", ModelAvailable),
+					createModelVersion(2, "gs://models/iris2", ModelAvailable),
+				},
+				rollout: &ModelRollout{Version: 2, StableVersion: 1, Step: 1, State: RolloutPaused},
+			},
+			loadModelReq: &pb.LoadModelRequest{
+				Model: &pb.Model{Meta: &pb.MetaData{Name: "model"}, ModelSpec: &pb.ModelSpec{Uri: "gs://models/iris3", Rollout: strategy}},
+			},
+			expectedRollout: &ModelRollout{Version: 3, StableVersion: 1, State: RolloutProgressing},
+		},
+		{
+			name: "rollout from succeeded rollout",
+			model: &Model{
+				versions: []*ModelVersion{
+					createModelVersion(1, "gs://models/iris", ModelAvailable),
+					createModelVersion(2, "gs://models/iris2", ModelAvailable),
+				},
+				rollout: &ModelRollout{Version: 2, StableVersion: 1, Step: 1, State: RolloutSucceeded},
+			},
+			loadModelReq: &pb.LoadModelRequest{
+				Model: &pb.Model{Meta: &pb.MetaData{Name: "model"}, ModelSpec: &pb.ModelSpec{Uri: "gs://models/iris3", Rollout: strategy}},
+			},
+			expectedRollout: &ModelRollout{Version: 3, StableVersion: 2, State: RolloutProgressing},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schedStore := NewLocalSchedulerStore()
+			schedStore.models["model"] = test.model
+			ms := NewMemoryStore(log.New(), schedStore, nil)
+			err := ms.UpdateModel(test.loadModelReq)
+			g.Expect(err).To(BeNil())
+			model, err := ms.GetModel("model")
+			g.Expect(err).To(BeNil())
+			if test.expectedRollout == nil {
+				g.Expect(model.Rollout).To(BeNil())
+			} else {
+				g.Expect(model.Rollout).ToNot(BeNil())
+				g.Expect(model.Rollout.Version).To(Equal(test.expectedRollout.Version))
+				g.Expect(model.Rollout.StableVersion).To(Equal(test.expectedRollout.StableVersion))
+				g.Expect(model.Rollout.Step).To(Equal(test.expectedRollout.Step))
+				g.Expect(model.Rollout.State).To(Equal(test.expectedRollout.State))
+			}
+		})
+	}
+}
+
+func TestSetModelRollout(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name        string
+		rollout     *ModelRollout
+		update      *ModelRollout
+		expectedErr bool
+	}
+
+	tests := []test{
+		{
+			name:    "update rollout",
+			rollout: &ModelRollout{Version: 2, StableVersion: 1},
+			update:  &ModelRollout{Version: 2, StableVersion: 1, Step: 1},
+		},
+		{
+			name:        "no rollout",
+			update:      &ModelRollout{Version: 2, StableVersion: 1, Step: 1},
+			expectedErr: true,
+		},
+		{
+			name:        "rollout of other version",
+			rollout:     &ModelRollout{Version: 3, StableVersion: 1},
+			update:      &ModelRollout{Version: 2, StableVersion: 1, Step: 1},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schedStore := NewLocalSchedulerStore()
+			schedStore.models["model"] = &Model{rollout: test.rollout}
+			ms := NewMemoryStore(log.New(), schedStore, nil)
+			err := ms.SetModelRollout("model", test.update)
+			if test.expectedErr {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(schedStore.models["model"].rollout).To(Equal(test.rollout))
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(schedStore.models["model"].rollout.Step).To(Equal(test.update.Step))
+				g.Expect(schedStore.models["model"].rollout.Timestamp.IsZero()).To(BeFalse())
+			}
+		})
+	}
+}
+
+func TestRolloutTrafficPercent(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	strategy := &pb.RolloutStrategy{
+		Steps: []*pb.RolloutStep{{TrafficPercent: 10}, {TrafficPercent: 50}},
+	}
+
+	type test struct {
+		name     string
+		rollout  *ModelRollout
+		expected uint32
+	}
+
+	tests := []test{
+		{name: "first step", rollout: &ModelRollout{}, expected: 10},
+		{name: "second step", rollout: &ModelRollout{Step: 1}, expected: 50},
+		{name: "paused", rollout: &ModelRollout{Step: 1, State: RolloutPaused}, expected: 50},
+		{name: "rolled back", rollout: &ModelRollout{Step: 1, State: RolloutRolledBack}, expected: 0},
+		{name: "succeeded", rollout: &ModelRollout{Step: 2, State: RolloutSucceeded}, expected: 100},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(test.rollout.TrafficPercent(strategy)).To(Equal(test.expected))
+		})
+	}
+}
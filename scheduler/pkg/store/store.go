@@ -30,6 +30,7 @@ type ModelSnapshot struct {
 	Name     string
 	Versions []*ModelVersion
 	Deleted  bool
+	Rollout  *ModelRollout
 }
 
 func (m *ModelSnapshot) GetLatest() *ModelVersion {
@@ -114,6 +115,7 @@ type ModelStore interface {
 	GetServer(serverKey string, shallow bool, modelDetails bool) (*ServerSnapshot, error)
 	UpdateLoadedModels(modelKey string, version uint32, serverKey string, replicas []*ServerReplica) error
 	UnloadVersionModels(modelKey string, version uint32) (bool, error)
+	SetModelRollout(modelKey string, rollout *ModelRollout) error
 	UpdateModelState(modelKey string, version uint32, serverKey string, replicaIdx int, availableMemory *uint64, expectedState, desiredState ModelReplicaState, reason string) error
 	AddServerReplica(request *pba.AgentSubscribeRequest) error
 	ServerNotify(request *pb.ServerNotifyRequest) error
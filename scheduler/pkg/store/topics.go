@@ -0,0 +1,80 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package store
+
+import (
+	"fmt"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+const (
+	TopicPartitionsReason        = "partitions must be greater than 0"
+	TopicReplicationFactorReason = "replication factor must be greater than 0"
+	TopicRetentionReason         = "retention must be -1 or non-negative"
+	TopicCleanupPolicyReason     = "cleanup policy must be delete, compact or compact,delete"
+	TopicCompressionTypeReason   = "compression type must be uncompressed, producer, gzip, snappy, lz4 or zstd"
+)
+
+var topicCleanupPolicies = map[string]bool{
+	"delete":         true,
+	"compact":        true,
+	"compact,delete": true,
+	"delete,compact": true,
+}
+
+var topicCompressionTypes = map[string]bool{
+	"uncompressed": true,
+	"producer":     true,
+	"gzip":         true,
+	"snappy":       true,
+	"lz4":          true,
+	"zstd":         true,
+}
+
+type ModelTopicConfigErr struct {
+	model  string
+	reason string
+}
+
+func (mtc *ModelTopicConfigErr) Error() string {
+	return fmt.Sprintf("model %s topic config is invalid. %s", mtc.model, mtc.reason)
+}
+
+// CheckTopicConfig returns why the topic settings of a model or pipeline are invalid, or an empty string if they
+// are valid. Invalid settings would otherwise only fail when the model gateway creates the topics.
+func CheckTopicConfig(tc *pb.KafkaTopicConfig) string {
+	if tc == nil {
+		return ""
+	}
+	if tc.Partitions != nil && tc.GetPartitions() == 0 {
+		return TopicPartitionsReason
+	}
+	if tc.ReplicationFactor != nil && tc.GetReplicationFactor() == 0 {
+		return TopicReplicationFactorReason
+	}
+	if tc.RetentionMs != nil && tc.GetRetentionMs() < -1 {
+		return TopicRetentionReason
+	}
+	if tc.CleanupPolicy != nil && !topicCleanupPolicies[tc.GetCleanupPolicy()] {
+		return TopicCleanupPolicyReason
+	}
+	if tc.CompressionType != nil && !topicCompressionTypes[tc.GetCompressionType()] {
+		return TopicCompressionTypeReason
+	}
+	return ""
+}
+
+func checkModelTopicConfig(model *pb.Model) error {
+	if reason := CheckTopicConfig(model.GetStreamSpec().GetTopicConfig()); reason != "" {
+		return &ModelTopicConfigErr{model: model.GetMeta().GetName(), reason: reason}
+	}
+	return nil
+}
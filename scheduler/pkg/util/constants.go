@@ -31,3 +31,8 @@ const (
 	GRPCModelServerUnloadTimeout = 2 * time.Minute
 	GRPCControlPlaneTimeout      = 1 * time.Minute // For control plane operations except load/unload
 )
+
+const (
+	// API key the model and pipeline gateways send to Envoy when it checks the API key of requests
+	EnvVarEnvoyAPIKey = "SELDON_ENVOY_API_KEY"
+)